@@ -35,6 +35,12 @@ var (
 // Global references for cleanup
 var globalEventSubscriber *event.Subscriber
 var globalJobExecutor *service.JobExecutor
+var globalNotifier *service.Notifier
+var globalAuditWorker *service.AuditWorker
+var globalHealthChecker *service.CredentialHealthChecker
+var globalDriftDetector *service.DriftDetector
+var globalExpiryWatchdog *service.ExpiryWatchdog
+var globalEventPublisher *service.EventPublisher
 var globalRegHelper *registration.RegistrationHelper
 
 func newApp(
@@ -43,6 +49,12 @@ func newApp(
 	hs *kratosHttp.Server,
 	eventSubscriber *event.Subscriber,
 	jobExecutor *service.JobExecutor,
+	notifier *service.Notifier,
+	auditWorker *service.AuditWorker,
+	healthChecker *service.CredentialHealthChecker,
+	driftDetector *service.DriftDetector,
+	expiryWatchdog *service.ExpiryWatchdog,
+	eventPublisher *service.EventPublisher,
 	regClient *registration.Client,
 	_ *data.TangraClientPusher, // forces Wire to construct it so SetPusher() runs at startup
 ) *kratos.App {
@@ -54,6 +66,14 @@ func newApp(
 		}
 	}
 
+	// Start the notifier before the job executor so that no outcome is missed
+	globalNotifier = notifier
+	if notifier != nil {
+		if err := notifier.Start(); err != nil {
+			log.Warnf("Failed to start notifier: %v", err)
+		}
+	}
+
 	// Start the job executor and store reference for cleanup
 	globalJobExecutor = jobExecutor
 	if jobExecutor != nil {
@@ -62,6 +82,46 @@ func newApp(
 		}
 	}
 
+	// Start the audit verification/retention worker and store reference for cleanup
+	globalAuditWorker = auditWorker
+	if auditWorker != nil {
+		if err := auditWorker.Start(); err != nil {
+			log.Warnf("Failed to start audit worker: %v", err)
+		}
+	}
+
+	// Start the credential health checker and store reference for cleanup
+	globalHealthChecker = healthChecker
+	if healthChecker != nil {
+		if err := healthChecker.Start(); err != nil {
+			log.Warnf("Failed to start credential health checker: %v", err)
+		}
+	}
+
+	// Start the drift detector and store reference for cleanup
+	globalDriftDetector = driftDetector
+	if driftDetector != nil {
+		if err := driftDetector.Start(); err != nil {
+			log.Warnf("Failed to start drift detector: %v", err)
+		}
+	}
+
+	// Start the expiry watchdog and store reference for cleanup
+	globalExpiryWatchdog = expiryWatchdog
+	if expiryWatchdog != nil {
+		if err := expiryWatchdog.Start(); err != nil {
+			log.Warnf("Failed to start expiry watchdog: %v", err)
+		}
+	}
+
+	// Start relaying published lifecycle events and store reference for cleanup
+	globalEventPublisher = eventPublisher
+	if eventPublisher != nil {
+		if err := eventPublisher.Start(); err != nil {
+			log.Warnf("Failed to start event publisher: %v", err)
+		}
+	}
+
 	if regClient != nil {
 		// Populate the full registration config on the pre-created client
 		regClient.SetConfig(&registration.Config{
@@ -97,6 +157,36 @@ func stopServices() {
 			log.Warnf("Failed to stop job executor: %v", err)
 		}
 	}
+	if globalNotifier != nil {
+		if err := globalNotifier.Stop(); err != nil {
+			log.Warnf("Failed to stop notifier: %v", err)
+		}
+	}
+	if globalAuditWorker != nil {
+		if err := globalAuditWorker.Stop(); err != nil {
+			log.Warnf("Failed to stop audit worker: %v", err)
+		}
+	}
+	if globalHealthChecker != nil {
+		if err := globalHealthChecker.Stop(); err != nil {
+			log.Warnf("Failed to stop credential health checker: %v", err)
+		}
+	}
+	if globalDriftDetector != nil {
+		if err := globalDriftDetector.Stop(); err != nil {
+			log.Warnf("Failed to stop drift detector: %v", err)
+		}
+	}
+	if globalExpiryWatchdog != nil {
+		if err := globalExpiryWatchdog.Stop(); err != nil {
+			log.Warnf("Failed to stop expiry watchdog: %v", err)
+		}
+	}
+	if globalEventPublisher != nil {
+		if err := globalEventPublisher.Stop(); err != nil {
+			log.Warnf("Failed to stop event publisher: %v", err)
+		}
+	}
 }
 
 func runApp() error {
@@ -40,6 +40,14 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	credentialCipher, err := data.NewCredentialCipher(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	manager, err := data.NewSecretManager(context)
+	if err != nil {
+		return nil, nil, err
+	}
 	entClient, cleanup, err := data.NewEntClient(context)
 	if err != nil {
 		return nil, nil, err
@@ -47,25 +55,37 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	auditLogRepo := data.NewAuditLogRepo(context, entClient)
 	deploymentTargetRepo := data.NewDeploymentTargetRepo(context, entClient)
 	targetConfigurationRepo := data.NewTargetConfigurationRepo(context, entClient)
+	configurationRevisionRepo := data.NewConfigurationRevisionRepo(context, entClient)
+	changeRecordRepo := data.NewChangeRecordRepo(context, entClient)
 	collector := metrics.NewCollector(context)
-	deploymentTargetService := service.NewDeploymentTargetService(context, deploymentTargetRepo, targetConfigurationRepo, collector)
-	targetConfigurationService := service.NewTargetConfigurationService(context, targetConfigurationRepo, collector)
+	deploymentTargetService := service.NewDeploymentTargetService(context, deploymentTargetRepo, targetConfigurationRepo, changeRecordRepo, collector)
+	targetConfigurationService := service.NewTargetConfigurationService(context, targetConfigurationRepo, configurationRevisionRepo, changeRecordRepo, credentialCipher, manager, collector)
 	deploymentJobRepo := data.NewDeploymentJobRepo(context, entClient)
 	deploymentHistoryRepo := data.NewDeploymentHistoryRepo(context, entClient)
-	deploymentJobService := service.NewDeploymentJobService(context, deploymentJobRepo, deploymentTargetRepo, targetConfigurationRepo, deploymentHistoryRepo, collector)
-	deploymentService := service.NewDeploymentService(context, deploymentJobRepo, deploymentTargetRepo, targetConfigurationRepo, deploymentHistoryRepo, targetConfigurationService, collector)
+	retryPolicies := data.NewRetryPolicies(context)
+	deployLockRepo := data.NewDeployLockRepo(context, entClient)
+	deploymentLocks := service.NewDeploymentLocks(context, deployLockRepo, targetConfigurationService)
+	circuitBreaker := service.NewCircuitBreaker(context, targetConfigurationRepo, targetConfigurationService, collector)
+	deploymentJobService := service.NewDeploymentJobService(context, deploymentJobRepo, deploymentTargetRepo, targetConfigurationRepo, deploymentHistoryRepo, retryPolicies, collector)
 	statisticsRepo := data.NewStatisticsRepo(context, entClient)
 	statisticsService := service.NewStatisticsService(context, statisticsRepo)
 	backupService := service.NewBackupService(context, entClient)
-	grpcServer := server.NewGRPCServer(context, v, collector, auditLogRepo, deploymentTargetService, targetConfigurationService, deploymentJobService, deploymentService, statisticsService, backupService)
-	httpServer := server.NewHTTPServer(context)
+	auditLogService := service.NewAuditLogService(context, auditLogRepo)
+	changeRecordService := service.NewChangeRecordService(context, changeRecordRepo)
+	roleBindingRepo := data.NewRoleBindingRepo(context, entClient)
+	authorizer, err := data.NewAuthorizer(context, roleBindingRepo, deploymentJobRepo, targetConfigurationRepo)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	accessControlService := service.NewAccessControlService(context, roleBindingRepo, deploymentTargetRepo, authorizer)
+	driftEventRepo := data.NewDriftEventRepo(context, entClient)
+	driftService := service.NewDriftService(context, driftEventRepo)
 	client, cleanup2, err := data.NewRedisClient(context)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	handler := event.NewHandler(context, deploymentTargetRepo, deploymentJobRepo)
-	subscriber := event.NewSubscriber(context, client, handler)
 	registrationClient, err := data.NewRegistrationClient(context)
 	if err != nil {
 		cleanup2()
@@ -79,14 +99,46 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	jobExecutor := service.NewJobExecutor(context, deploymentJobRepo, targetConfigurationRepo, deploymentHistoryRepo, targetConfigurationService, lcmClient, collector)
+	externalCertificateRepo := data.NewExternalCertificateRepo(context, entClient)
+	certificateRevocationRepo := data.NewCertificateRevocationRepo(context, entClient)
+	jobExecutor := service.NewJobExecutor(context, deploymentJobRepo, targetConfigurationRepo, deploymentHistoryRepo, targetConfigurationService, lcmClient, externalCertificateRepo, manager, retryPolicies, deploymentLocks, circuitBreaker, collector)
+	deploymentService := service.NewDeploymentService(context, deploymentJobRepo, deploymentTargetRepo, targetConfigurationRepo, deploymentHistoryRepo, targetConfigurationService, lcmClient, externalCertificateRepo, retryPolicies, deploymentLocks, circuitBreaker, collector, jobExecutor)
+	notificationRuleRepo := data.NewNotificationRuleRepo(context, entClient)
+	notifier, err := service.NewNotifier(context, notificationRuleRepo, deploymentJobRepo, deploymentTargetRepo, targetConfigurationRepo, manager, jobExecutor)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	notificationService := service.NewNotificationService(context, notificationRuleRepo, deploymentTargetRepo, notifier)
+	inventorySnapshotRepo := data.NewInventorySnapshotRepo(context, entClient)
+	inventoryService := service.NewInventoryService(context, inventorySnapshotRepo, deploymentJobRepo, deploymentTargetRepo, targetConfigurationRepo, targetConfigurationService)
+	grpcServer := server.NewGRPCServer(context, v, collector, auditLogRepo, authorizer, deploymentTargetService, targetConfigurationService, deploymentJobService, deploymentService, statisticsService, backupService, auditLogService, changeRecordService, accessControlService, driftService, notificationService, inventoryService)
+	handler := event.NewHandler(context, deploymentTargetRepo, deploymentJobRepo, retryPolicies, lcmClient, externalCertificateRepo, certificateRevocationRepo)
+	ingester, err := event.NewIngester(context, handler, externalCertificateRepo, manager)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer := server.NewHTTPServer(context, v, ingester)
+	subscriber := event.NewSubscriber(context, client, handler)
 	tangraClientPusher := data.NewTangraClientPusher(context, client, lcmClient)
+	auditWorker := service.NewAuditWorker(context, auditLogRepo, auditLogService, collector)
+	leaderElection := service.NewLeaderElection(context, deployLockRepo)
+	outboxRepo := data.NewOutboxRepo(context, entClient)
+	eventPublisher := service.NewEventPublisher(context, outboxRepo, client, leaderElection, jobExecutor)
+	credentialHealthChecker := service.NewCredentialHealthChecker(context, targetConfigurationRepo, targetConfigurationService, circuitBreaker, leaderElection, collector)
+	driftDetector := service.NewDriftDetector(context, targetConfigurationRepo, deploymentJobRepo, driftEventRepo, targetConfigurationService, lcmClient, retryPolicies, leaderElection, collector, eventPublisher)
+	expiryWatchdog := service.NewExpiryWatchdog(context, targetConfigurationRepo, deploymentJobRepo, lcmClient, collector)
 
 	// Seed Prometheus metrics from database
 	seedCtx := viewer.NewSystemViewerContext(gocontext.Background())
 	collector.Seed(seedCtx, statisticsRepo)
 
-	app := newApp(context, grpcServer, httpServer, subscriber, jobExecutor, registrationClient, tangraClientPusher)
+	app := newApp(context, grpcServer, httpServer, subscriber, jobExecutor, notifier, auditWorker, credentialHealthChecker, driftDetector, expiryWatchdog, eventPublisher, registrationClient, tangraClientPusher)
 	return app, func() {
 		collector.Stop(gocontext.Background())
 		cleanup3()
@@ -36,6 +36,7 @@ const (
 	// 403
 	DeployerErrorReason_FORBIDDEN           DeployerErrorReason = 300 // Access Denied
 	DeployerErrorReason_CREDENTIALS_INVALID DeployerErrorReason = 301 // Provider credentials are invalid
+	DeployerErrorReason_PERMISSION_DENIED   DeployerErrorReason = 302 // Caller lacks the permission for the operation
 	// 404
 	DeployerErrorReason_NOT_FOUND               DeployerErrorReason = 400 // Resource not found
 	DeployerErrorReason_TARGET_NOT_FOUND        DeployerErrorReason = 401 // Deployment target not found
@@ -53,6 +54,7 @@ const (
 	DeployerErrorReason_DEPLOYMENT_FAILED    DeployerErrorReason = 1101 // Deployment failed
 	DeployerErrorReason_VERIFICATION_FAILED  DeployerErrorReason = 1102 // Verification failed
 	DeployerErrorReason_ROLLBACK_FAILED      DeployerErrorReason = 1103 // Rollback failed
+	DeployerErrorReason_SECRET_UNRESOLVED    DeployerErrorReason = 1104 // External secret reference could not be resolved
 	// 500
 	DeployerErrorReason_INTERNAL_SERVER_ERROR DeployerErrorReason = 2000 // Internal Server Error
 	DeployerErrorReason_PROVIDER_ERROR        DeployerErrorReason = 2001 // Provider returned an error
@@ -72,6 +74,7 @@ var (
 		100:  "UNAUTHORIZED",
 		300:  "FORBIDDEN",
 		301:  "CREDENTIALS_INVALID",
+		302:  "PERMISSION_DENIED",
 		400:  "NOT_FOUND",
 		401:  "TARGET_NOT_FOUND",
 		402:  "JOB_NOT_FOUND",
@@ -86,6 +89,7 @@ var (
 		1101: "DEPLOYMENT_FAILED",
 		1102: "VERIFICATION_FAILED",
 		1103: "ROLLBACK_FAILED",
+		1104: "SECRET_UNRESOLVED",
 		2000: "INTERNAL_SERVER_ERROR",
 		2001: "PROVIDER_ERROR",
 		2300: "SERVICE_UNAVAILABLE",
@@ -100,6 +104,7 @@ var (
 		"UNAUTHORIZED":              100,
 		"FORBIDDEN":                 300,
 		"CREDENTIALS_INVALID":       301,
+		"PERMISSION_DENIED":         302,
 		"NOT_FOUND":                 400,
 		"TARGET_NOT_FOUND":          401,
 		"JOB_NOT_FOUND":             402,
@@ -114,6 +119,7 @@ var (
 		"DEPLOYMENT_FAILED":         1101,
 		"VERIFICATION_FAILED":       1102,
 		"ROLLBACK_FAILED":           1103,
+		"SECRET_UNRESOLVED":         1104,
 		"INTERNAL_SERVER_ERROR":     2000,
 		"PROVIDER_ERROR":            2001,
 		"SERVICE_UNAVAILABLE":       2300,
@@ -152,7 +158,7 @@ var File_deployer_service_v1_deployer_error_proto protoreflect.FileDescriptor
 
 const file_deployer_service_v1_deployer_error_proto_rawDesc = "" +
 	"\n" +
-	"(deployer/service/v1/deployer_error.proto\x12\x13deployer.service.v1\x1a\x13errors/errors.proto*\x9e\x06\n" +
+	"(deployer/service/v1/deployer_error.proto\x12\x13deployer.service.v1\x1a\x13errors/errors.proto*\xda\x06\n" +
 	"\x13DeployerErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
 	"\x15INVALID_PROVIDER_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
@@ -161,7 +167,8 @@ const file_deployer_service_v1_deployer_error_proto_rawDesc = "" +
 	"\x13INVALID_CERTIFICATE\x10\x04\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x14\n" +
 	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x1e\n" +
-	"\x13CREDENTIALS_INVALID\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\x13CREDENTIALS_INVALID\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x1c\n" +
+	"\x11PERMISSION_DENIED\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
 	"\x10TARGET_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x18\n" +
 	"\rJOB_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12 \n" +
@@ -175,7 +182,8 @@ const file_deployer_service_v1_deployer_error_proto_rawDesc = "" +
 	"\x14UNPROCESSABLE_ENTITY\x10\xcc\b\x1a\x04\xa8E\xa6\x03\x12\x1c\n" +
 	"\x11DEPLOYMENT_FAILED\x10\xcd\b\x1a\x04\xa8E\xa6\x03\x12\x1e\n" +
 	"\x13VERIFICATION_FAILED\x10\xce\b\x1a\x04\xa8E\xa6\x03\x12\x1a\n" +
-	"\x0fROLLBACK_FAILED\x10\xcf\b\x1a\x04\xa8E\xa6\x03\x12 \n" +
+	"\x0fROLLBACK_FAILED\x10\xcf\b\x1a\x04\xa8E\xa6\x03\x12\x1c\n" +
+	"\x11SECRET_UNRESOLVED\x10\xd0\b\x1a\x04\xa8E\xa6\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xd0\x0f\x1a\x04\xa8E\xf4\x03\x12\x19\n" +
 	"\x0ePROVIDER_ERROR\x10\xd1\x0f\x1a\x04\xa8E\xf4\x03\x12\x1e\n" +
 	"\x13SERVICE_UNAVAILABLE\x10\xfc\x11\x1a\x04\xa8E\xf7\x03\x12\x1f\n" +
@@ -123,6 +123,20 @@ func ErrorCredentialsInvalid(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, DeployerErrorReason_CREDENTIALS_INVALID.String(), fmt.Sprintf(format, args...))
 }
 
+// Caller lacks the permission for the operation
+func IsPermissionDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == DeployerErrorReason_PERMISSION_DENIED.String() && e.Code == 403
+}
+
+// Caller lacks the permission for the operation
+func ErrorPermissionDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, DeployerErrorReason_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -319,6 +333,20 @@ func ErrorRollbackFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(422, DeployerErrorReason_ROLLBACK_FAILED.String(), fmt.Sprintf(format, args...))
 }
 
+// External secret reference could not be resolved
+func IsSecretUnresolved(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == DeployerErrorReason_SECRET_UNRESOLVED.String() && e.Code == 422
+}
+
+// External secret reference could not be resolved
+func ErrorSecretUnresolved(format string, args ...interface{}) *errors.Error {
+	return errors.New(422, DeployerErrorReason_SECRET_UNRESOLVED.String(), fmt.Sprintf(format, args...))
+}
+
 // 500
 func IsInternalServerError(err error) bool {
 	if err == nil {
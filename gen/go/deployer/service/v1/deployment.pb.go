@@ -109,8 +109,10 @@ type DeployRequest struct {
 	CertificateId         string                 `protobuf:"bytes,2,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
 	WaitForCompletion     *bool                  `protobuf:"varint,3,opt,name=wait_for_completion,json=waitForCompletion,proto3,oneof" json:"wait_for_completion,omitempty"`
 	TimeoutSeconds        *int32                 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Repeating a request with the same key returns the job created first
+	IdempotencyKey *string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeployRequest) Reset() {
@@ -171,6 +173,13 @@ func (x *DeployRequest) GetTimeoutSeconds() int32 {
 	return 0
 }
 
+func (x *DeployRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 type DeployResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Job           *DeploymentJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
@@ -433,6 +442,387 @@ func (x *RollbackResponse) GetResult() *DeploymentResult {
 	return nil
 }
 
+// Undeploy request - remove the deployed certificate from a target configuration
+type UndeployRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TargetConfigurationId string                 `protobuf:"bytes,1,opt,name=target_configuration_id,json=targetConfigurationId,proto3" json:"target_configuration_id,omitempty"`
+	// Certificate expected to be deployed; the request fails when another one is
+	CertificateId *string `protobuf:"bytes,2,opt,name=certificate_id,json=certificateId,proto3,oneof" json:"certificate_id,omitempty"`
+	// Repeating a request with the same key returns the job created first
+	IdempotencyKey *string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UndeployRequest) Reset() {
+	*x = UndeployRequest{}
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeployRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeployRequest) ProtoMessage() {}
+
+func (x *UndeployRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeployRequest.ProtoReflect.Descriptor instead.
+func (*UndeployRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UndeployRequest) GetTargetConfigurationId() string {
+	if x != nil {
+		return x.TargetConfigurationId
+	}
+	return ""
+}
+
+func (x *UndeployRequest) GetCertificateId() string {
+	if x != nil && x.CertificateId != nil {
+		return *x.CertificateId
+	}
+	return ""
+}
+
+func (x *UndeployRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+type UndeployResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DeploymentJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeployResponse) Reset() {
+	*x = UndeployResponse{}
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeployResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeployResponse) ProtoMessage() {}
+
+func (x *UndeployResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeployResponse.ProtoReflect.Descriptor instead.
+func (*UndeployResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UndeployResponse) GetJob() *DeploymentJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// Plan request - preview a deployment to a configuration or a target group
+type PlanDeploymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Configuration to plan for; either this or deployment_target_id is required
+	TargetConfigurationId *string `protobuf:"bytes,1,opt,name=target_configuration_id,json=targetConfigurationId,proto3,oneof" json:"target_configuration_id,omitempty"`
+	// Target group whose configurations to plan for
+	DeploymentTargetId *string `protobuf:"bytes,2,opt,name=deployment_target_id,json=deploymentTargetId,proto3,oneof" json:"deployment_target_id,omitempty"`
+	// The certificate to deploy
+	CertificateId string `protobuf:"bytes,3,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanDeploymentRequest) Reset() {
+	*x = PlanDeploymentRequest{}
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanDeploymentRequest) ProtoMessage() {}
+
+func (x *PlanDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*PlanDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlanDeploymentRequest) GetTargetConfigurationId() string {
+	if x != nil && x.TargetConfigurationId != nil {
+		return *x.TargetConfigurationId
+	}
+	return ""
+}
+
+func (x *PlanDeploymentRequest) GetDeploymentTargetId() string {
+	if x != nil && x.DeploymentTargetId != nil {
+		return *x.DeploymentTargetId
+	}
+	return ""
+}
+
+func (x *PlanDeploymentRequest) GetCertificateId() string {
+	if x != nil {
+		return x.CertificateId
+	}
+	return ""
+}
+
+// An action a deployment would take on the target
+type PlannedAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// create, update, replace, delete, switch, send or unchanged
+	Operation    string  `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	ResourceType string  `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Resource     string  `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Detail       *string `protobuf:"bytes,4,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
+	// Human readable summary, e.g. "create cert /Common/x.crt"
+	Description   string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedAction) Reset() {
+	*x = PlannedAction{}
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedAction) ProtoMessage() {}
+
+func (x *PlannedAction) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedAction.ProtoReflect.Descriptor instead.
+func (*PlannedAction) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlannedAction) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PlannedAction) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *PlannedAction) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *PlannedAction) GetDetail() string {
+	if x != nil && x.Detail != nil {
+		return *x.Detail
+	}
+	return ""
+}
+
+func (x *PlannedAction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Plan for a single configuration
+type ConfigurationPlan struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ConfigurationId   string                 `protobuf:"bytes,1,opt,name=configuration_id,json=configurationId,proto3" json:"configuration_id,omitempty"`
+	ConfigurationName string                 `protobuf:"bytes,2,opt,name=configuration_name,json=configurationName,proto3" json:"configuration_name,omitempty"`
+	ProviderType      string                 `protobuf:"bytes,3,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	// Whether the provider can preview deployments
+	Supported bool             `protobuf:"varint,4,opt,name=supported,proto3" json:"supported,omitempty"`
+	Actions   []*PlannedAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Why the target could not be inspected
+	Error         *string `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationPlan) Reset() {
+	*x = ConfigurationPlan{}
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationPlan) ProtoMessage() {}
+
+func (x *ConfigurationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationPlan.ProtoReflect.Descriptor instead.
+func (*ConfigurationPlan) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfigurationPlan) GetConfigurationId() string {
+	if x != nil {
+		return x.ConfigurationId
+	}
+	return ""
+}
+
+func (x *ConfigurationPlan) GetConfigurationName() string {
+	if x != nil {
+		return x.ConfigurationName
+	}
+	return ""
+}
+
+func (x *ConfigurationPlan) GetProviderType() string {
+	if x != nil {
+		return x.ProviderType
+	}
+	return ""
+}
+
+func (x *ConfigurationPlan) GetSupported() bool {
+	if x != nil {
+		return x.Supported
+	}
+	return false
+}
+
+func (x *ConfigurationPlan) GetActions() []*PlannedAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ConfigurationPlan) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type PlanDeploymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*ConfigurationPlan   `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanDeploymentResponse) Reset() {
+	*x = PlanDeploymentResponse{}
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanDeploymentResponse) ProtoMessage() {}
+
+func (x *PlanDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*PlanDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlanDeploymentResponse) GetPlans() []*ConfigurationPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
 // Deploy to a deployment target group (creates parent job with child jobs)
 type DeployToTargetRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -441,14 +831,16 @@ type DeployToTargetRequest struct {
 	// The certificate to deploy
 	CertificateId string `protobuf:"bytes,2,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
 	// Optional: trigger reason
-	TriggeredBy   *TriggerType `protobuf:"varint,3,opt,name=triggered_by,json=triggeredBy,proto3,enum=deployer.service.v1.TriggerType,oneof" json:"triggered_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TriggeredBy *TriggerType `protobuf:"varint,3,opt,name=triggered_by,json=triggeredBy,proto3,enum=deployer.service.v1.TriggerType,oneof" json:"triggered_by,omitempty"`
+	// Repeating a request with the same key returns the job created first
+	IdempotencyKey *string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeployToTargetRequest) Reset() {
 	*x = DeployToTargetRequest{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[7]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +852,7 @@ func (x *DeployToTargetRequest) String() string {
 func (*DeployToTargetRequest) ProtoMessage() {}
 
 func (x *DeployToTargetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[7]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +865,7 @@ func (x *DeployToTargetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployToTargetRequest.ProtoReflect.Descriptor instead.
 func (*DeployToTargetRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{7}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeployToTargetRequest) GetDeploymentTargetId() string {
@@ -497,6 +889,13 @@ func (x *DeployToTargetRequest) GetTriggeredBy() TriggerType {
 	return TriggerType_TRIGGER_TYPE_UNSPECIFIED
 }
 
+func (x *DeployToTargetRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 type DeployToTargetResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The parent job created
@@ -507,7 +906,7 @@ type DeployToTargetResponse struct {
 
 func (x *DeployToTargetResponse) Reset() {
 	*x = DeployToTargetResponse{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[8]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +918,7 @@ func (x *DeployToTargetResponse) String() string {
 func (*DeployToTargetResponse) ProtoMessage() {}
 
 func (x *DeployToTargetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[8]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +931,7 @@ func (x *DeployToTargetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployToTargetResponse.ProtoReflect.Descriptor instead.
 func (*DeployToTargetResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{8}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeployToTargetResponse) GetJob() *DeploymentJob {
@@ -550,14 +949,16 @@ type DeployToConfigurationsRequest struct {
 	// List of target configuration IDs to deploy to
 	ConfigurationIds []string `protobuf:"bytes,2,rep,name=configuration_ids,json=configurationIds,proto3" json:"configuration_ids,omitempty"`
 	// Optional: trigger reason
-	TriggeredBy   *TriggerType `protobuf:"varint,3,opt,name=triggered_by,json=triggeredBy,proto3,enum=deployer.service.v1.TriggerType,oneof" json:"triggered_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TriggeredBy *TriggerType `protobuf:"varint,3,opt,name=triggered_by,json=triggeredBy,proto3,enum=deployer.service.v1.TriggerType,oneof" json:"triggered_by,omitempty"`
+	// Repeating a request with the same key returns the jobs created first
+	IdempotencyKey *string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeployToConfigurationsRequest) Reset() {
 	*x = DeployToConfigurationsRequest{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[9]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +970,7 @@ func (x *DeployToConfigurationsRequest) String() string {
 func (*DeployToConfigurationsRequest) ProtoMessage() {}
 
 func (x *DeployToConfigurationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[9]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +983,7 @@ func (x *DeployToConfigurationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployToConfigurationsRequest.ProtoReflect.Descriptor instead.
 func (*DeployToConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{9}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeployToConfigurationsRequest) GetCertificateId() string {
@@ -606,6 +1007,13 @@ func (x *DeployToConfigurationsRequest) GetTriggeredBy() TriggerType {
 	return TriggerType_TRIGGER_TYPE_UNSPECIFIED
 }
 
+func (x *DeployToConfigurationsRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 // Result for a single configuration deployment
 type ConfigurationDeploymentResult struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -619,7 +1027,7 @@ type ConfigurationDeploymentResult struct {
 
 func (x *ConfigurationDeploymentResult) Reset() {
 	*x = ConfigurationDeploymentResult{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[10]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +1039,7 @@ func (x *ConfigurationDeploymentResult) String() string {
 func (*ConfigurationDeploymentResult) ProtoMessage() {}
 
 func (x *ConfigurationDeploymentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[10]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +1052,7 @@ func (x *ConfigurationDeploymentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigurationDeploymentResult.ProtoReflect.Descriptor instead.
 func (*ConfigurationDeploymentResult) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{10}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ConfigurationDeploymentResult) GetConfigurationId() string {
@@ -691,7 +1099,7 @@ type DeployToConfigurationsResponse struct {
 
 func (x *DeployToConfigurationsResponse) Reset() {
 	*x = DeployToConfigurationsResponse{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[11]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +1111,7 @@ func (x *DeployToConfigurationsResponse) String() string {
 func (*DeployToConfigurationsResponse) ProtoMessage() {}
 
 func (x *DeployToConfigurationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[11]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +1124,7 @@ func (x *DeployToConfigurationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployToConfigurationsResponse.ProtoReflect.Descriptor instead.
 func (*DeployToConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{11}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeployToConfigurationsResponse) GetTotal() int32 {
@@ -757,14 +1165,16 @@ type DeployToTargetsRequest struct {
 	// List of target configuration IDs to deploy to
 	TargetIds []string `protobuf:"bytes,2,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
 	// Optional: trigger reason
-	TriggeredBy   *string `protobuf:"bytes,3,opt,name=triggered_by,json=triggeredBy,proto3,oneof" json:"triggered_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TriggeredBy *string `protobuf:"bytes,3,opt,name=triggered_by,json=triggeredBy,proto3,oneof" json:"triggered_by,omitempty"`
+	// Repeating a request with the same key returns the jobs created first
+	IdempotencyKey *string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeployToTargetsRequest) Reset() {
 	*x = DeployToTargetsRequest{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[12]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -776,7 +1186,7 @@ func (x *DeployToTargetsRequest) String() string {
 func (*DeployToTargetsRequest) ProtoMessage() {}
 
 func (x *DeployToTargetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[12]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +1199,7 @@ func (x *DeployToTargetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployToTargetsRequest.ProtoReflect.Descriptor instead.
 func (*DeployToTargetsRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{12}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeployToTargetsRequest) GetCertificateId() string {
@@ -813,6 +1223,13 @@ func (x *DeployToTargetsRequest) GetTriggeredBy() string {
 	return ""
 }
 
+func (x *DeployToTargetsRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 // Legacy: Result for a single target deployment (deprecated)
 //
 // Deprecated: Marked as deprecated in deployer/service/v1/deployment.proto.
@@ -828,7 +1245,7 @@ type TargetDeploymentResult struct {
 
 func (x *TargetDeploymentResult) Reset() {
 	*x = TargetDeploymentResult{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[13]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +1257,7 @@ func (x *TargetDeploymentResult) String() string {
 func (*TargetDeploymentResult) ProtoMessage() {}
 
 func (x *TargetDeploymentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[13]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +1270,7 @@ func (x *TargetDeploymentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetDeploymentResult.ProtoReflect.Descriptor instead.
 func (*TargetDeploymentResult) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{13}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TargetDeploymentResult) GetTargetId() string {
@@ -901,7 +1318,7 @@ type DeployToTargetsResponse struct {
 
 func (x *DeployToTargetsResponse) Reset() {
 	*x = DeployToTargetsResponse{}
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[14]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -913,7 +1330,7 @@ func (x *DeployToTargetsResponse) String() string {
 func (*DeployToTargetsResponse) ProtoMessage() {}
 
 func (x *DeployToTargetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_deployment_proto_msgTypes[14]
+	mi := &file_deployer_service_v1_deployment_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1343,7 @@ func (x *DeployToTargetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployToTargetsResponse.ProtoReflect.Descriptor instead.
 func (*DeployToTargetsResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{14}
+	return file_deployer_service_v1_deployment_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeployToTargetsResponse) GetTotal() int32 {
@@ -975,14 +1392,17 @@ const file_deployer_service_v1_deployment_proto_rawDesc = "" +
 	"\f_resource_idB\n" +
 	"\n" +
 	"\b_detailsB\x0e\n" +
-	"\f_duration_ms\"\x87\x02\n" +
+	"\f_duration_ms\"\xd5\x02\n" +
 	"\rDeployRequest\x12;\n" +
 	"\x17target_configuration_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x15targetConfigurationId\x12*\n" +
 	"\x0ecertificate_id\x18\x02 \x01(\tB\x03\xe0A\x02R\rcertificateId\x123\n" +
 	"\x13wait_for_completion\x18\x03 \x01(\bH\x00R\x11waitForCompletion\x88\x01\x01\x12,\n" +
-	"\x0ftimeout_seconds\x18\x04 \x01(\x05H\x01R\x0etimeoutSeconds\x88\x01\x01B\x16\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05H\x01R\x0etimeoutSeconds\x88\x01\x01\x128\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x02R\x0eidempotencyKey\x88\x01\x01B\x16\n" +
 	"\x14_wait_for_completionB\x12\n" +
-	"\x10_timeout_seconds\"\x95\x01\n" +
+	"\x10_timeout_secondsB\x12\n" +
+	"\x10_idempotency_key\"\x95\x01\n" +
 	"\x0eDeployResponse\x124\n" +
 	"\x03job\x18\x01 \x01(\v2\".deployer.service.v1.DeploymentJobR\x03job\x12B\n" +
 	"\x06result\x18\x02 \x01(\v2%.deployer.service.v1.DeploymentResultH\x00R\x06result\x88\x01\x01B\t\n" +
@@ -1000,22 +1420,61 @@ const file_deployer_service_v1_deployment_proto_rawDesc = "" +
 	"\x10RollbackResponse\x124\n" +
 	"\x03job\x18\x01 \x01(\v2\".deployer.service.v1.DeploymentJobR\x03job\x12B\n" +
 	"\x06result\x18\x02 \x01(\v2%.deployer.service.v1.DeploymentResultH\x00R\x06result\x88\x01\x01B\t\n" +
-	"\a_result\"\xe3\x01\n" +
+	"\a_result\"\xdb\x01\n" +
+	"\x0fUndeployRequest\x12;\n" +
+	"\x17target_configuration_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x15targetConfigurationId\x12*\n" +
+	"\x0ecertificate_id\x18\x02 \x01(\tH\x00R\rcertificateId\x88\x01\x01\x128\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x01R\x0eidempotencyKey\x88\x01\x01B\x11\n" +
+	"\x0f_certificate_idB\x12\n" +
+	"\x10_idempotency_key\"H\n" +
+	"\x10UndeployResponse\x124\n" +
+	"\x03job\x18\x01 \x01(\v2\".deployer.service.v1.DeploymentJobR\x03job\"\xf3\x01\n" +
+	"\x15PlanDeploymentRequest\x12;\n" +
+	"\x17target_configuration_id\x18\x01 \x01(\tH\x00R\x15targetConfigurationId\x88\x01\x01\x125\n" +
+	"\x14deployment_target_id\x18\x02 \x01(\tH\x01R\x12deploymentTargetId\x88\x01\x01\x121\n" +
+	"\x0ecertificate_id\x18\x03 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rcertificateIdB\x1a\n" +
+	"\x18_target_configuration_idB\x17\n" +
+	"\x15_deployment_target_id\"\xb8\x01\n" +
+	"\rPlannedAction\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x1b\n" +
+	"\x06detail\x18\x04 \x01(\tH\x00R\x06detail\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescriptionB\t\n" +
+	"\a_detail\"\x93\x02\n" +
+	"\x11ConfigurationPlan\x12)\n" +
+	"\x10configuration_id\x18\x01 \x01(\tR\x0fconfigurationId\x12-\n" +
+	"\x12configuration_name\x18\x02 \x01(\tR\x11configurationName\x12#\n" +
+	"\rprovider_type\x18\x03 \x01(\tR\fproviderType\x12\x1c\n" +
+	"\tsupported\x18\x04 \x01(\bR\tsupported\x12<\n" +
+	"\aactions\x18\x05 \x03(\v2\".deployer.service.v1.PlannedActionR\aactions\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"V\n" +
+	"\x16PlanDeploymentResponse\x12<\n" +
+	"\x05plans\x18\x01 \x03(\v2&.deployer.service.v1.ConfigurationPlanR\x05plans\"\xb1\x02\n" +
 	"\x15DeployToTargetRequest\x12<\n" +
 	"\x14deployment_target_id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x12deploymentTargetId\x121\n" +
 	"\x0ecertificate_id\x18\x02 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rcertificateId\x12H\n" +
-	"\ftriggered_by\x18\x03 \x01(\x0e2 .deployer.service.v1.TriggerTypeH\x00R\vtriggeredBy\x88\x01\x01B\x0f\n" +
-	"\r_triggered_by\"N\n" +
+	"\ftriggered_by\x18\x03 \x01(\x0e2 .deployer.service.v1.TriggerTypeH\x00R\vtriggeredBy\x88\x01\x01\x128\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x01R\x0eidempotencyKey\x88\x01\x01B\x0f\n" +
+	"\r_triggered_byB\x12\n" +
+	"\x10_idempotency_key\"N\n" +
 	"\x16DeployToTargetResponse\x124\n" +
-	"\x03job\x18\x01 \x01(\v2\".deployer.service.v1.DeploymentJobR\x03job\"\xe7\x01\n" +
+	"\x03job\x18\x01 \x01(\v2\".deployer.service.v1.DeploymentJobR\x03job\"\xb5\x02\n" +
 	"\x1dDeployToConfigurationsRequest\x121\n" +
 	"\x0ecertificate_id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rcertificateId\x128\n" +
 	"\x11configuration_ids\x18\x02 \x03(\tB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\x10configurationIds\x12H\n" +
-	"\ftriggered_by\x18\x03 \x01(\x0e2 .deployer.service.v1.TriggerTypeH\x00R\vtriggeredBy\x88\x01\x01B\x0f\n" +
-	"\r_triggered_by\"\xe1\x01\n" +
+	"\ftriggered_by\x18\x03 \x01(\x0e2 .deployer.service.v1.TriggerTypeH\x00R\vtriggeredBy\x88\x01\x01\x128\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x01R\x0eidempotencyKey\x88\x01\x01B\x0f\n" +
+	"\r_triggered_byB\x12\n" +
+	"\x10_idempotency_key\"\xe1\x01\n" +
 	"\x1dConfigurationDeploymentResult\x12)\n" +
 	"\x10configuration_id\x18\x01 \x01(\tR\x0fconfigurationId\x12-\n" +
 	"\x12configuration_name\x18\x02 \x01(\tR\x11configurationName\x129\n" +
@@ -1027,14 +1486,17 @@ const file_deployer_service_v1_deployment_proto_rawDesc = "" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1c\n" +
 	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
 	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12L\n" +
-	"\aresults\x18\x04 \x03(\v22.deployer.service.v1.ConfigurationDeploymentResultR\aresults\"\xb4\x01\n" +
+	"\aresults\x18\x04 \x03(\v22.deployer.service.v1.ConfigurationDeploymentResultR\aresults\"\x82\x02\n" +
 	"\x16DeployToTargetsRequest\x121\n" +
 	"\x0ecertificate_id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rcertificateId\x12*\n" +
 	"\n" +
 	"target_ids\x18\x02 \x03(\tB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\ttargetIds\x12&\n" +
-	"\ftriggered_by\x18\x03 \x01(\tH\x00R\vtriggeredBy\x88\x01\x01:\x02\x18\x01B\x0f\n" +
-	"\r_triggered_by\"\xc2\x01\n" +
+	"\ftriggered_by\x18\x03 \x01(\tH\x00R\vtriggeredBy\x88\x01\x01\x128\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x01R\x0eidempotencyKey\x88\x01\x01:\x02\x18\x01B\x0f\n" +
+	"\r_triggered_byB\x12\n" +
+	"\x10_idempotency_key\"\xc2\x01\n" +
 	"\x16TargetDeploymentResult\x12\x1b\n" +
 	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x1f\n" +
 	"\vtarget_name\x18\x02 \x01(\tR\n" +
@@ -1047,13 +1509,15 @@ const file_deployer_service_v1_deployment_proto_rawDesc = "" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1c\n" +
 	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
 	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12E\n" +
-	"\aresults\x18\x04 \x03(\v2+.deployer.service.v1.TargetDeploymentResultR\aresults:\x02\x18\x012\xfe\x04\n" +
+	"\aresults\x18\x04 \x03(\v2+.deployer.service.v1.TargetDeploymentResultR\aresults:\x02\x18\x012\xc6\x06\n" +
 	"\x11DeploymentService\x12S\n" +
 	"\x06Deploy\x12\".deployer.service.v1.DeployRequest\x1a#.deployer.service.v1.DeployResponse\"\x00\x12k\n" +
 	"\x0eDeployToTarget\x12*.deployer.service.v1.DeployToTargetRequest\x1a+.deployer.service.v1.DeployToTargetResponse\"\x00\x12\x83\x01\n" +
 	"\x16DeployToConfigurations\x122.deployer.service.v1.DeployToConfigurationsRequest\x1a3.deployer.service.v1.DeployToConfigurationsResponse\"\x00\x12S\n" +
 	"\x06Verify\x12\".deployer.service.v1.VerifyRequest\x1a#.deployer.service.v1.VerifyResponse\"\x00\x12Y\n" +
-	"\bRollback\x12$.deployer.service.v1.RollbackRequest\x1a%.deployer.service.v1.RollbackResponse\"\x00\x12q\n" +
+	"\bRollback\x12$.deployer.service.v1.RollbackRequest\x1a%.deployer.service.v1.RollbackResponse\"\x00\x12Y\n" +
+	"\bUndeploy\x12$.deployer.service.v1.UndeployRequest\x1a%.deployer.service.v1.UndeployResponse\"\x00\x12k\n" +
+	"\x0ePlanDeployment\x12*.deployer.service.v1.PlanDeploymentRequest\x1a+.deployer.service.v1.PlanDeploymentResponse\"\x00\x12q\n" +
 	"\x0fDeployToTargets\x12+.deployer.service.v1.DeployToTargetsRequest\x1a,.deployer.service.v1.DeployToTargetsResponse\"\x03\x88\x02\x01B\xe6\x01\n" +
 	"\x17com.deployer.service.v1B\x0fDeploymentProtoP\x01ZLgithub.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1;servicev1\xa2\x02\x03DSX\xaa\x02\x13Deployer.Service.V1\xca\x02\x13Deployer\\Service\\V1\xe2\x02\x1fDeployer\\Service\\V1\\GPBMetadata\xea\x02\x15Deployer::Service::V1b\x06proto3"
 
@@ -1069,7 +1533,7 @@ func file_deployer_service_v1_deployment_proto_rawDescGZIP() []byte {
 	return file_deployer_service_v1_deployment_proto_rawDescData
 }
 
-var file_deployer_service_v1_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_deployer_service_v1_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_deployer_service_v1_deployment_proto_goTypes = []any{
 	(*DeploymentResult)(nil),               // 0: deployer.service.v1.DeploymentResult
 	(*DeployRequest)(nil),                  // 1: deployer.service.v1.DeployRequest
@@ -1078,49 +1542,62 @@ var file_deployer_service_v1_deployment_proto_goTypes = []any{
 	(*VerifyResponse)(nil),                 // 4: deployer.service.v1.VerifyResponse
 	(*RollbackRequest)(nil),                // 5: deployer.service.v1.RollbackRequest
 	(*RollbackResponse)(nil),               // 6: deployer.service.v1.RollbackResponse
-	(*DeployToTargetRequest)(nil),          // 7: deployer.service.v1.DeployToTargetRequest
-	(*DeployToTargetResponse)(nil),         // 8: deployer.service.v1.DeployToTargetResponse
-	(*DeployToConfigurationsRequest)(nil),  // 9: deployer.service.v1.DeployToConfigurationsRequest
-	(*ConfigurationDeploymentResult)(nil),  // 10: deployer.service.v1.ConfigurationDeploymentResult
-	(*DeployToConfigurationsResponse)(nil), // 11: deployer.service.v1.DeployToConfigurationsResponse
-	(*DeployToTargetsRequest)(nil),         // 12: deployer.service.v1.DeployToTargetsRequest
-	(*TargetDeploymentResult)(nil),         // 13: deployer.service.v1.TargetDeploymentResult
-	(*DeployToTargetsResponse)(nil),        // 14: deployer.service.v1.DeployToTargetsResponse
-	(*structpb.Struct)(nil),                // 15: google.protobuf.Struct
-	(*DeploymentJob)(nil),                  // 16: deployer.service.v1.DeploymentJob
-	(TriggerType)(0),                       // 17: deployer.service.v1.TriggerType
+	(*UndeployRequest)(nil),                // 7: deployer.service.v1.UndeployRequest
+	(*UndeployResponse)(nil),               // 8: deployer.service.v1.UndeployResponse
+	(*PlanDeploymentRequest)(nil),          // 9: deployer.service.v1.PlanDeploymentRequest
+	(*PlannedAction)(nil),                  // 10: deployer.service.v1.PlannedAction
+	(*ConfigurationPlan)(nil),              // 11: deployer.service.v1.ConfigurationPlan
+	(*PlanDeploymentResponse)(nil),         // 12: deployer.service.v1.PlanDeploymentResponse
+	(*DeployToTargetRequest)(nil),          // 13: deployer.service.v1.DeployToTargetRequest
+	(*DeployToTargetResponse)(nil),         // 14: deployer.service.v1.DeployToTargetResponse
+	(*DeployToConfigurationsRequest)(nil),  // 15: deployer.service.v1.DeployToConfigurationsRequest
+	(*ConfigurationDeploymentResult)(nil),  // 16: deployer.service.v1.ConfigurationDeploymentResult
+	(*DeployToConfigurationsResponse)(nil), // 17: deployer.service.v1.DeployToConfigurationsResponse
+	(*DeployToTargetsRequest)(nil),         // 18: deployer.service.v1.DeployToTargetsRequest
+	(*TargetDeploymentResult)(nil),         // 19: deployer.service.v1.TargetDeploymentResult
+	(*DeployToTargetsResponse)(nil),        // 20: deployer.service.v1.DeployToTargetsResponse
+	(*structpb.Struct)(nil),                // 21: google.protobuf.Struct
+	(*DeploymentJob)(nil),                  // 22: deployer.service.v1.DeploymentJob
+	(TriggerType)(0),                       // 23: deployer.service.v1.TriggerType
 }
 var file_deployer_service_v1_deployment_proto_depIdxs = []int32{
-	15, // 0: deployer.service.v1.DeploymentResult.details:type_name -> google.protobuf.Struct
-	16, // 1: deployer.service.v1.DeployResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	21, // 0: deployer.service.v1.DeploymentResult.details:type_name -> google.protobuf.Struct
+	22, // 1: deployer.service.v1.DeployResponse.job:type_name -> deployer.service.v1.DeploymentJob
 	0,  // 2: deployer.service.v1.DeployResponse.result:type_name -> deployer.service.v1.DeploymentResult
 	0,  // 3: deployer.service.v1.VerifyResponse.result:type_name -> deployer.service.v1.DeploymentResult
-	16, // 4: deployer.service.v1.RollbackResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	22, // 4: deployer.service.v1.RollbackResponse.job:type_name -> deployer.service.v1.DeploymentJob
 	0,  // 5: deployer.service.v1.RollbackResponse.result:type_name -> deployer.service.v1.DeploymentResult
-	17, // 6: deployer.service.v1.DeployToTargetRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
-	16, // 7: deployer.service.v1.DeployToTargetResponse.job:type_name -> deployer.service.v1.DeploymentJob
-	17, // 8: deployer.service.v1.DeployToConfigurationsRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
-	16, // 9: deployer.service.v1.ConfigurationDeploymentResult.job:type_name -> deployer.service.v1.DeploymentJob
-	10, // 10: deployer.service.v1.DeployToConfigurationsResponse.results:type_name -> deployer.service.v1.ConfigurationDeploymentResult
-	16, // 11: deployer.service.v1.TargetDeploymentResult.job:type_name -> deployer.service.v1.DeploymentJob
-	13, // 12: deployer.service.v1.DeployToTargetsResponse.results:type_name -> deployer.service.v1.TargetDeploymentResult
-	1,  // 13: deployer.service.v1.DeploymentService.Deploy:input_type -> deployer.service.v1.DeployRequest
-	7,  // 14: deployer.service.v1.DeploymentService.DeployToTarget:input_type -> deployer.service.v1.DeployToTargetRequest
-	9,  // 15: deployer.service.v1.DeploymentService.DeployToConfigurations:input_type -> deployer.service.v1.DeployToConfigurationsRequest
-	3,  // 16: deployer.service.v1.DeploymentService.Verify:input_type -> deployer.service.v1.VerifyRequest
-	5,  // 17: deployer.service.v1.DeploymentService.Rollback:input_type -> deployer.service.v1.RollbackRequest
-	12, // 18: deployer.service.v1.DeploymentService.DeployToTargets:input_type -> deployer.service.v1.DeployToTargetsRequest
-	2,  // 19: deployer.service.v1.DeploymentService.Deploy:output_type -> deployer.service.v1.DeployResponse
-	8,  // 20: deployer.service.v1.DeploymentService.DeployToTarget:output_type -> deployer.service.v1.DeployToTargetResponse
-	11, // 21: deployer.service.v1.DeploymentService.DeployToConfigurations:output_type -> deployer.service.v1.DeployToConfigurationsResponse
-	4,  // 22: deployer.service.v1.DeploymentService.Verify:output_type -> deployer.service.v1.VerifyResponse
-	6,  // 23: deployer.service.v1.DeploymentService.Rollback:output_type -> deployer.service.v1.RollbackResponse
-	14, // 24: deployer.service.v1.DeploymentService.DeployToTargets:output_type -> deployer.service.v1.DeployToTargetsResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	22, // 6: deployer.service.v1.UndeployResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	10, // 7: deployer.service.v1.ConfigurationPlan.actions:type_name -> deployer.service.v1.PlannedAction
+	11, // 8: deployer.service.v1.PlanDeploymentResponse.plans:type_name -> deployer.service.v1.ConfigurationPlan
+	23, // 9: deployer.service.v1.DeployToTargetRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
+	22, // 10: deployer.service.v1.DeployToTargetResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	23, // 11: deployer.service.v1.DeployToConfigurationsRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
+	22, // 12: deployer.service.v1.ConfigurationDeploymentResult.job:type_name -> deployer.service.v1.DeploymentJob
+	16, // 13: deployer.service.v1.DeployToConfigurationsResponse.results:type_name -> deployer.service.v1.ConfigurationDeploymentResult
+	22, // 14: deployer.service.v1.TargetDeploymentResult.job:type_name -> deployer.service.v1.DeploymentJob
+	19, // 15: deployer.service.v1.DeployToTargetsResponse.results:type_name -> deployer.service.v1.TargetDeploymentResult
+	1,  // 16: deployer.service.v1.DeploymentService.Deploy:input_type -> deployer.service.v1.DeployRequest
+	13, // 17: deployer.service.v1.DeploymentService.DeployToTarget:input_type -> deployer.service.v1.DeployToTargetRequest
+	15, // 18: deployer.service.v1.DeploymentService.DeployToConfigurations:input_type -> deployer.service.v1.DeployToConfigurationsRequest
+	3,  // 19: deployer.service.v1.DeploymentService.Verify:input_type -> deployer.service.v1.VerifyRequest
+	5,  // 20: deployer.service.v1.DeploymentService.Rollback:input_type -> deployer.service.v1.RollbackRequest
+	7,  // 21: deployer.service.v1.DeploymentService.Undeploy:input_type -> deployer.service.v1.UndeployRequest
+	9,  // 22: deployer.service.v1.DeploymentService.PlanDeployment:input_type -> deployer.service.v1.PlanDeploymentRequest
+	18, // 23: deployer.service.v1.DeploymentService.DeployToTargets:input_type -> deployer.service.v1.DeployToTargetsRequest
+	2,  // 24: deployer.service.v1.DeploymentService.Deploy:output_type -> deployer.service.v1.DeployResponse
+	14, // 25: deployer.service.v1.DeploymentService.DeployToTarget:output_type -> deployer.service.v1.DeployToTargetResponse
+	17, // 26: deployer.service.v1.DeploymentService.DeployToConfigurations:output_type -> deployer.service.v1.DeployToConfigurationsResponse
+	4,  // 27: deployer.service.v1.DeploymentService.Verify:output_type -> deployer.service.v1.VerifyResponse
+	6,  // 28: deployer.service.v1.DeploymentService.Rollback:output_type -> deployer.service.v1.RollbackResponse
+	8,  // 29: deployer.service.v1.DeploymentService.Undeploy:output_type -> deployer.service.v1.UndeployResponse
+	12, // 30: deployer.service.v1.DeploymentService.PlanDeployment:output_type -> deployer.service.v1.PlanDeploymentResponse
+	20, // 31: deployer.service.v1.DeploymentService.DeployToTargets:output_type -> deployer.service.v1.DeployToTargetsResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_deployer_service_v1_deployment_proto_init() }
@@ -1137,15 +1614,19 @@ func file_deployer_service_v1_deployment_proto_init() {
 	file_deployer_service_v1_deployment_proto_msgTypes[7].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_proto_msgTypes[9].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_proto_msgTypes[10].OneofWrappers = []any{}
-	file_deployer_service_v1_deployment_proto_msgTypes[12].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_proto_msgTypes[11].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_proto_msgTypes[13].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_proto_msgTypes[15].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_proto_msgTypes[16].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_proto_msgTypes[18].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deployer_service_v1_deployment_proto_rawDesc), len(file_deployer_service_v1_deployment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -101,6 +101,28 @@ func (s *redactedDeploymentServiceServer) Rollback(ctx context.Context, in *Roll
 	return res, err
 }
 
+// Undeploy is the redacted wrapper for the actual DeploymentServiceServer.Undeploy method
+// Unary RPC
+func (s *redactedDeploymentServiceServer) Undeploy(ctx context.Context, in *UndeployRequest) (*UndeployResponse, error) {
+	res, err := s.srv.Undeploy(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PlanDeployment is the redacted wrapper for the actual DeploymentServiceServer.PlanDeployment method
+// Unary RPC
+func (s *redactedDeploymentServiceServer) PlanDeployment(ctx context.Context, in *PlanDeploymentRequest) (*PlanDeploymentResponse, error) {
+	res, err := s.srv.PlanDeployment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // DeployToTargets is the redacted wrapper for the actual DeploymentServiceServer.DeployToTargets method
 // Unary RPC
 func (s *redactedDeploymentServiceServer) DeployToTargets(ctx context.Context, in *DeployToTargetsRequest) (*DeployToTargetsResponse, error) {
@@ -143,6 +165,8 @@ func (x *DeployRequest) Redact() string {
 	// Safe field: WaitForCompletion
 
 	// Safe field: TimeoutSeconds
+
+	// Safe field: IdempotencyKey
 	return x.String()
 }
 
@@ -206,6 +230,92 @@ func (x *RollbackResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for UndeployRequest
+func (x *UndeployRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TargetConfigurationId
+
+	// Safe field: CertificateId
+
+	// Safe field: IdempotencyKey
+	return x.String()
+}
+
+// Redact method implementation for UndeployResponse
+func (x *UndeployResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Job
+	return x.String()
+}
+
+// Redact method implementation for PlanDeploymentRequest
+func (x *PlanDeploymentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TargetConfigurationId
+
+	// Safe field: DeploymentTargetId
+
+	// Safe field: CertificateId
+	return x.String()
+}
+
+// Redact method implementation for PlannedAction
+func (x *PlannedAction) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Operation
+
+	// Safe field: ResourceType
+
+	// Safe field: Resource
+
+	// Safe field: Detail
+
+	// Safe field: Description
+	return x.String()
+}
+
+// Redact method implementation for ConfigurationPlan
+func (x *ConfigurationPlan) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ConfigurationId
+
+	// Safe field: ConfigurationName
+
+	// Safe field: ProviderType
+
+	// Safe field: Supported
+
+	// Safe field: Actions
+
+	// Safe field: Error
+	return x.String()
+}
+
+// Redact method implementation for PlanDeploymentResponse
+func (x *PlanDeploymentResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Plans
+	return x.String()
+}
+
 // Redact method implementation for DeployToTargetRequest
 func (x *DeployToTargetRequest) Redact() string {
 	if x == nil {
@@ -217,6 +327,8 @@ func (x *DeployToTargetRequest) Redact() string {
 	// Safe field: CertificateId
 
 	// Safe field: TriggeredBy
+
+	// Safe field: IdempotencyKey
 	return x.String()
 }
 
@@ -241,6 +353,8 @@ func (x *DeployToConfigurationsRequest) Redact() string {
 	// Safe field: ConfigurationIds
 
 	// Safe field: TriggeredBy
+
+	// Safe field: IdempotencyKey
 	return x.String()
 }
 
@@ -287,6 +401,8 @@ func (x *DeployToTargetsRequest) Redact() string {
 	// Safe field: TargetIds
 
 	// Safe field: TriggeredBy
+
+	// Safe field: IdempotencyKey
 	return x.String()
 }
 
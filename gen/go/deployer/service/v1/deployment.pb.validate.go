@@ -216,6 +216,10 @@ func (m *DeployRequest) validate(all bool) error {
 		// no validation rules for TimeoutSeconds
 	}
 
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
 	if len(errors) > 0 {
 		return DeployRequestMultiError(errors)
 	}
@@ -959,50 +963,52 @@ var _ interface {
 	ErrorName() string
 } = RollbackResponseValidationError{}
 
-// Validate checks the field values on DeployToTargetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeployToTargetRequest) Validate() error {
+// Validate checks the field values on UndeployRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UndeployRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeployToTargetRequest with the rules
+// ValidateAll checks the field values on UndeployRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeployToTargetRequestMultiError, or nil if none found.
-func (m *DeployToTargetRequest) ValidateAll() error {
+// UndeployRequestMultiError, or nil if none found.
+func (m *UndeployRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeployToTargetRequest) validate(all bool) error {
+func (m *UndeployRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentTargetId
+	// no validation rules for TargetConfigurationId
 
-	// no validation rules for CertificateId
+	if m.CertificateId != nil {
+		// no validation rules for CertificateId
+	}
 
-	if m.TriggeredBy != nil {
-		// no validation rules for TriggeredBy
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
 	}
 
 	if len(errors) > 0 {
-		return DeployToTargetRequestMultiError(errors)
+		return UndeployRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeployToTargetRequestMultiError is an error wrapping multiple validation
-// errors returned by DeployToTargetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeployToTargetRequestMultiError []error
+// UndeployRequestMultiError is an error wrapping multiple validation errors
+// returned by UndeployRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UndeployRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeployToTargetRequestMultiError) Error() string {
+func (m UndeployRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1011,11 +1017,11 @@ func (m DeployToTargetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeployToTargetRequestMultiError) AllErrors() []error { return m }
+func (m UndeployRequestMultiError) AllErrors() []error { return m }
 
-// DeployToTargetRequestValidationError is the validation error returned by
-// DeployToTargetRequest.Validate if the designated constraints aren't met.
-type DeployToTargetRequestValidationError struct {
+// UndeployRequestValidationError is the validation error returned by
+// UndeployRequest.Validate if the designated constraints aren't met.
+type UndeployRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1023,24 +1029,22 @@ type DeployToTargetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeployToTargetRequestValidationError) Field() string { return e.field }
+func (e UndeployRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeployToTargetRequestValidationError) Reason() string { return e.reason }
+func (e UndeployRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeployToTargetRequestValidationError) Cause() error { return e.cause }
+func (e UndeployRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeployToTargetRequestValidationError) Key() bool { return e.key }
+func (e UndeployRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeployToTargetRequestValidationError) ErrorName() string {
-	return "DeployToTargetRequestValidationError"
-}
+func (e UndeployRequestValidationError) ErrorName() string { return "UndeployRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeployToTargetRequestValidationError) Error() string {
+func (e UndeployRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1052,14 +1056,14 @@ func (e DeployToTargetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployToTargetRequest.%s: %s%s",
+		"invalid %sUndeployRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeployToTargetRequestValidationError{}
+var _ error = UndeployRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1067,24 +1071,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeployToTargetRequestValidationError{}
+} = UndeployRequestValidationError{}
 
-// Validate checks the field values on DeployToTargetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeployToTargetResponse) Validate() error {
+// Validate checks the field values on UndeployResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UndeployResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeployToTargetResponse with the rules
+// ValidateAll checks the field values on UndeployResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeployToTargetResponseMultiError, or nil if none found.
-func (m *DeployToTargetResponse) ValidateAll() error {
+// UndeployResponseMultiError, or nil if none found.
+func (m *UndeployResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeployToTargetResponse) validate(all bool) error {
+func (m *UndeployResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1095,7 +1099,7 @@ func (m *DeployToTargetResponse) validate(all bool) error {
 		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeployToTargetResponseValidationError{
+				errors = append(errors, UndeployResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1103,7 +1107,7 @@ func (m *DeployToTargetResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeployToTargetResponseValidationError{
+				errors = append(errors, UndeployResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1112,7 +1116,7 @@ func (m *DeployToTargetResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeployToTargetResponseValidationError{
+			return UndeployResponseValidationError{
 				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1121,19 +1125,19 @@ func (m *DeployToTargetResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeployToTargetResponseMultiError(errors)
+		return UndeployResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeployToTargetResponseMultiError is an error wrapping multiple validation
-// errors returned by DeployToTargetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeployToTargetResponseMultiError []error
+// UndeployResponseMultiError is an error wrapping multiple validation errors
+// returned by UndeployResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UndeployResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeployToTargetResponseMultiError) Error() string {
+func (m UndeployResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1142,11 +1146,11 @@ func (m DeployToTargetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeployToTargetResponseMultiError) AllErrors() []error { return m }
+func (m UndeployResponseMultiError) AllErrors() []error { return m }
 
-// DeployToTargetResponseValidationError is the validation error returned by
-// DeployToTargetResponse.Validate if the designated constraints aren't met.
-type DeployToTargetResponseValidationError struct {
+// UndeployResponseValidationError is the validation error returned by
+// UndeployResponse.Validate if the designated constraints aren't met.
+type UndeployResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1154,24 +1158,22 @@ type DeployToTargetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeployToTargetResponseValidationError) Field() string { return e.field }
+func (e UndeployResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeployToTargetResponseValidationError) Reason() string { return e.reason }
+func (e UndeployResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeployToTargetResponseValidationError) Cause() error { return e.cause }
+func (e UndeployResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeployToTargetResponseValidationError) Key() bool { return e.key }
+func (e UndeployResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeployToTargetResponseValidationError) ErrorName() string {
-	return "DeployToTargetResponseValidationError"
-}
+func (e UndeployResponseValidationError) ErrorName() string { return "UndeployResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeployToTargetResponseValidationError) Error() string {
+func (e UndeployResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1183,14 +1185,14 @@ func (e DeployToTargetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployToTargetResponse.%s: %s%s",
+		"invalid %sUndeployResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeployToTargetResponseValidationError{}
+var _ error = UndeployResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1198,24 +1200,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeployToTargetResponseValidationError{}
+} = UndeployResponseValidationError{}
 
-// Validate checks the field values on DeployToConfigurationsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PlanDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeployToConfigurationsRequest) Validate() error {
+func (m *PlanDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeployToConfigurationsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeployToConfigurationsRequestMultiError, or nil if none found.
-func (m *DeployToConfigurationsRequest) ValidateAll() error {
+// ValidateAll checks the field values on PlanDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanDeploymentRequestMultiError, or nil if none found.
+func (m *PlanDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeployToConfigurationsRequest) validate(all bool) error {
+func (m *PlanDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1224,24 +1226,28 @@ func (m *DeployToConfigurationsRequest) validate(all bool) error {
 
 	// no validation rules for CertificateId
 
-	if m.TriggeredBy != nil {
-		// no validation rules for TriggeredBy
+	if m.TargetConfigurationId != nil {
+		// no validation rules for TargetConfigurationId
+	}
+
+	if m.DeploymentTargetId != nil {
+		// no validation rules for DeploymentTargetId
 	}
 
 	if len(errors) > 0 {
-		return DeployToConfigurationsRequestMultiError(errors)
+		return PlanDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeployToConfigurationsRequestMultiError is an error wrapping multiple
-// validation errors returned by DeployToConfigurationsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type DeployToConfigurationsRequestMultiError []error
+// PlanDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by PlanDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PlanDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeployToConfigurationsRequestMultiError) Error() string {
+func (m PlanDeploymentRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1250,12 +1256,11 @@ func (m DeployToConfigurationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeployToConfigurationsRequestMultiError) AllErrors() []error { return m }
+func (m PlanDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// DeployToConfigurationsRequestValidationError is the validation error
-// returned by DeployToConfigurationsRequest.Validate if the designated
-// constraints aren't met.
-type DeployToConfigurationsRequestValidationError struct {
+// PlanDeploymentRequestValidationError is the validation error returned by
+// PlanDeploymentRequest.Validate if the designated constraints aren't met.
+type PlanDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1263,24 +1268,24 @@ type DeployToConfigurationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeployToConfigurationsRequestValidationError) Field() string { return e.field }
+func (e PlanDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeployToConfigurationsRequestValidationError) Reason() string { return e.reason }
+func (e PlanDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeployToConfigurationsRequestValidationError) Cause() error { return e.cause }
+func (e PlanDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeployToConfigurationsRequestValidationError) Key() bool { return e.key }
+func (e PlanDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeployToConfigurationsRequestValidationError) ErrorName() string {
-	return "DeployToConfigurationsRequestValidationError"
+func (e PlanDeploymentRequestValidationError) ErrorName() string {
+	return "PlanDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeployToConfigurationsRequestValidationError) Error() string {
+func (e PlanDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1292,14 +1297,14 @@ func (e DeployToConfigurationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployToConfigurationsRequest.%s: %s%s",
+		"invalid %sPlanDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeployToConfigurationsRequestValidationError{}
+var _ error = PlanDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1307,85 +1312,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeployToConfigurationsRequestValidationError{}
+} = PlanDeploymentRequestValidationError{}
 
-// Validate checks the field values on ConfigurationDeploymentResult with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConfigurationDeploymentResult) Validate() error {
+// Validate checks the field values on PlannedAction with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlannedAction) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConfigurationDeploymentResult with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ConfigurationDeploymentResultMultiError, or nil if none found.
-func (m *ConfigurationDeploymentResult) ValidateAll() error {
+// ValidateAll checks the field values on PlannedAction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlannedActionMultiError, or
+// nil if none found.
+func (m *PlannedAction) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConfigurationDeploymentResult) validate(all bool) error {
+func (m *PlannedAction) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConfigurationId
-
-	// no validation rules for ConfigurationName
+	// no validation rules for Operation
 
-	if m.Job != nil {
+	// no validation rules for ResourceType
 
-		if all {
-			switch v := interface{}(m.GetJob()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConfigurationDeploymentResultValidationError{
-						field:  "Job",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConfigurationDeploymentResultValidationError{
-						field:  "Job",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConfigurationDeploymentResultValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Resource
 
-	}
+	// no validation rules for Description
 
-	if m.Error != nil {
-		// no validation rules for Error
+	if m.Detail != nil {
+		// no validation rules for Detail
 	}
 
 	if len(errors) > 0 {
-		return ConfigurationDeploymentResultMultiError(errors)
+		return PlannedActionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConfigurationDeploymentResultMultiError is an error wrapping multiple
-// validation errors returned by ConfigurationDeploymentResult.ValidateAll()
-// if the designated constraints aren't met.
-type ConfigurationDeploymentResultMultiError []error
+// PlannedActionMultiError is an error wrapping multiple validation errors
+// returned by PlannedAction.ValidateAll() if the designated constraints
+// aren't met.
+type PlannedActionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConfigurationDeploymentResultMultiError) Error() string {
+func (m PlannedActionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1394,12 +1370,11 @@ func (m ConfigurationDeploymentResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConfigurationDeploymentResultMultiError) AllErrors() []error { return m }
+func (m PlannedActionMultiError) AllErrors() []error { return m }
 
-// ConfigurationDeploymentResultValidationError is the validation error
-// returned by ConfigurationDeploymentResult.Validate if the designated
-// constraints aren't met.
-type ConfigurationDeploymentResultValidationError struct {
+// PlannedActionValidationError is the validation error returned by
+// PlannedAction.Validate if the designated constraints aren't met.
+type PlannedActionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1407,24 +1382,22 @@ type ConfigurationDeploymentResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConfigurationDeploymentResultValidationError) Field() string { return e.field }
+func (e PlannedActionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConfigurationDeploymentResultValidationError) Reason() string { return e.reason }
+func (e PlannedActionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConfigurationDeploymentResultValidationError) Cause() error { return e.cause }
+func (e PlannedActionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConfigurationDeploymentResultValidationError) Key() bool { return e.key }
+func (e PlannedActionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConfigurationDeploymentResultValidationError) ErrorName() string {
-	return "ConfigurationDeploymentResultValidationError"
-}
+func (e PlannedActionValidationError) ErrorName() string { return "PlannedActionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ConfigurationDeploymentResultValidationError) Error() string {
+func (e PlannedActionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1436,14 +1409,14 @@ func (e ConfigurationDeploymentResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConfigurationDeploymentResult.%s: %s%s",
+		"invalid %sPlannedAction.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConfigurationDeploymentResultValidationError{}
+var _ error = PlannedActionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1451,53 +1424,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConfigurationDeploymentResultValidationError{}
+} = PlannedActionValidationError{}
 
-// Validate checks the field values on DeployToConfigurationsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeployToConfigurationsResponse) Validate() error {
+// Validate checks the field values on ConfigurationPlan with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfigurationPlan) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeployToConfigurationsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeployToConfigurationsResponseMultiError, or nil if none found.
-func (m *DeployToConfigurationsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ConfigurationPlan with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigurationPlanMultiError, or nil if none found.
+func (m *ConfigurationPlan) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeployToConfigurationsResponse) validate(all bool) error {
+func (m *ConfigurationPlan) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Total
+	// no validation rules for ConfigurationId
 
-	// no validation rules for Succeeded
+	// no validation rules for ConfigurationName
 
-	// no validation rules for Failed
+	// no validation rules for ProviderType
 
-	for idx, item := range m.GetResults() {
+	// no validation rules for Supported
+
+	for idx, item := range m.GetActions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DeployToConfigurationsResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
+					errors = append(errors, ConfigurationPlanValidationError{
+						field:  fmt.Sprintf("Actions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DeployToConfigurationsResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
+					errors = append(errors, ConfigurationPlanValidationError{
+						field:  fmt.Sprintf("Actions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1505,8 +1480,8 @@ func (m *DeployToConfigurationsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DeployToConfigurationsResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
+				return ConfigurationPlanValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1515,20 +1490,24 @@ func (m *DeployToConfigurationsResponse) validate(all bool) error {
 
 	}
 
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
 	if len(errors) > 0 {
-		return DeployToConfigurationsResponseMultiError(errors)
+		return ConfigurationPlanMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeployToConfigurationsResponseMultiError is an error wrapping multiple
-// validation errors returned by DeployToConfigurationsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type DeployToConfigurationsResponseMultiError []error
+// ConfigurationPlanMultiError is an error wrapping multiple validation errors
+// returned by ConfigurationPlan.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigurationPlanMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeployToConfigurationsResponseMultiError) Error() string {
+func (m ConfigurationPlanMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1537,12 +1516,11 @@ func (m DeployToConfigurationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeployToConfigurationsResponseMultiError) AllErrors() []error { return m }
+func (m ConfigurationPlanMultiError) AllErrors() []error { return m }
 
-// DeployToConfigurationsResponseValidationError is the validation error
-// returned by DeployToConfigurationsResponse.Validate if the designated
-// constraints aren't met.
-type DeployToConfigurationsResponseValidationError struct {
+// ConfigurationPlanValidationError is the validation error returned by
+// ConfigurationPlan.Validate if the designated constraints aren't met.
+type ConfigurationPlanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1550,24 +1528,24 @@ type DeployToConfigurationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeployToConfigurationsResponseValidationError) Field() string { return e.field }
+func (e ConfigurationPlanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeployToConfigurationsResponseValidationError) Reason() string { return e.reason }
+func (e ConfigurationPlanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeployToConfigurationsResponseValidationError) Cause() error { return e.cause }
+func (e ConfigurationPlanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeployToConfigurationsResponseValidationError) Key() bool { return e.key }
+func (e ConfigurationPlanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeployToConfigurationsResponseValidationError) ErrorName() string {
-	return "DeployToConfigurationsResponseValidationError"
+func (e ConfigurationPlanValidationError) ErrorName() string {
+	return "ConfigurationPlanValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeployToConfigurationsResponseValidationError) Error() string {
+func (e ConfigurationPlanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1579,14 +1557,14 @@ func (e DeployToConfigurationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployToConfigurationsResponse.%s: %s%s",
+		"invalid %sConfigurationPlan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeployToConfigurationsResponseValidationError{}
+var _ error = ConfigurationPlanValidationError{}
 
 var _ interface {
 	Field() string
@@ -1594,34 +1572,819 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeployToConfigurationsResponseValidationError{}
+} = ConfigurationPlanValidationError{}
 
-// Validate checks the field values on DeployToTargetsRequest with the rules
+// Validate checks the field values on PlanDeploymentResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeployToTargetsRequest) Validate() error {
+func (m *PlanDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeployToTargetsRequest with the rules
+// ValidateAll checks the field values on PlanDeploymentResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeployToTargetsRequestMultiError, or nil if none found.
-func (m *DeployToTargetsRequest) ValidateAll() error {
+// PlanDeploymentResponseMultiError, or nil if none found.
+func (m *PlanDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeployToTargetsRequest) validate(all bool) error {
+func (m *PlanDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CertificateId
+	for idx, item := range m.GetPlans() {
+		_, _ = idx, item
 
-	if m.TriggeredBy != nil {
-		// no validation rules for TriggeredBy
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanDeploymentResponseValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanDeploymentResponseValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanDeploymentResponseValidationError{
+					field:  fmt.Sprintf("Plans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanDeploymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by PlanDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlanDeploymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanDeploymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanDeploymentResponseMultiError) AllErrors() []error { return m }
+
+// PlanDeploymentResponseValidationError is the validation error returned by
+// PlanDeploymentResponse.Validate if the designated constraints aren't met.
+type PlanDeploymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanDeploymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanDeploymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanDeploymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanDeploymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanDeploymentResponseValidationError) ErrorName() string {
+	return "PlanDeploymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanDeploymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanDeploymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanDeploymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanDeploymentResponseValidationError{}
+
+// Validate checks the field values on DeployToTargetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeployToTargetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeployToTargetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeployToTargetRequestMultiError, or nil if none found.
+func (m *DeployToTargetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeployToTargetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentTargetId
+
+	// no validation rules for CertificateId
+
+	if m.TriggeredBy != nil {
+		// no validation rules for TriggeredBy
+	}
+
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
+	if len(errors) > 0 {
+		return DeployToTargetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeployToTargetRequestMultiError is an error wrapping multiple validation
+// errors returned by DeployToTargetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeployToTargetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeployToTargetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeployToTargetRequestMultiError) AllErrors() []error { return m }
+
+// DeployToTargetRequestValidationError is the validation error returned by
+// DeployToTargetRequest.Validate if the designated constraints aren't met.
+type DeployToTargetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeployToTargetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeployToTargetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeployToTargetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeployToTargetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeployToTargetRequestValidationError) ErrorName() string {
+	return "DeployToTargetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeployToTargetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeployToTargetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeployToTargetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeployToTargetRequestValidationError{}
+
+// Validate checks the field values on DeployToTargetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeployToTargetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeployToTargetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeployToTargetResponseMultiError, or nil if none found.
+func (m *DeployToTargetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeployToTargetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeployToTargetResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeployToTargetResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeployToTargetResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeployToTargetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeployToTargetResponseMultiError is an error wrapping multiple validation
+// errors returned by DeployToTargetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeployToTargetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeployToTargetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeployToTargetResponseMultiError) AllErrors() []error { return m }
+
+// DeployToTargetResponseValidationError is the validation error returned by
+// DeployToTargetResponse.Validate if the designated constraints aren't met.
+type DeployToTargetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeployToTargetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeployToTargetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeployToTargetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeployToTargetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeployToTargetResponseValidationError) ErrorName() string {
+	return "DeployToTargetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeployToTargetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeployToTargetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeployToTargetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeployToTargetResponseValidationError{}
+
+// Validate checks the field values on DeployToConfigurationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeployToConfigurationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeployToConfigurationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeployToConfigurationsRequestMultiError, or nil if none found.
+func (m *DeployToConfigurationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeployToConfigurationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CertificateId
+
+	if m.TriggeredBy != nil {
+		// no validation rules for TriggeredBy
+	}
+
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
+	if len(errors) > 0 {
+		return DeployToConfigurationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeployToConfigurationsRequestMultiError is an error wrapping multiple
+// validation errors returned by DeployToConfigurationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeployToConfigurationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeployToConfigurationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeployToConfigurationsRequestMultiError) AllErrors() []error { return m }
+
+// DeployToConfigurationsRequestValidationError is the validation error
+// returned by DeployToConfigurationsRequest.Validate if the designated
+// constraints aren't met.
+type DeployToConfigurationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeployToConfigurationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeployToConfigurationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeployToConfigurationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeployToConfigurationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeployToConfigurationsRequestValidationError) ErrorName() string {
+	return "DeployToConfigurationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeployToConfigurationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeployToConfigurationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeployToConfigurationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeployToConfigurationsRequestValidationError{}
+
+// Validate checks the field values on ConfigurationDeploymentResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfigurationDeploymentResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigurationDeploymentResult with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ConfigurationDeploymentResultMultiError, or nil if none found.
+func (m *ConfigurationDeploymentResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigurationDeploymentResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConfigurationId
+
+	// no validation rules for ConfigurationName
+
+	if m.Job != nil {
+
+		if all {
+			switch v := interface{}(m.GetJob()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigurationDeploymentResultValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigurationDeploymentResultValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigurationDeploymentResultValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if len(errors) > 0 {
+		return ConfigurationDeploymentResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigurationDeploymentResultMultiError is an error wrapping multiple
+// validation errors returned by ConfigurationDeploymentResult.ValidateAll()
+// if the designated constraints aren't met.
+type ConfigurationDeploymentResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigurationDeploymentResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigurationDeploymentResultMultiError) AllErrors() []error { return m }
+
+// ConfigurationDeploymentResultValidationError is the validation error
+// returned by ConfigurationDeploymentResult.Validate if the designated
+// constraints aren't met.
+type ConfigurationDeploymentResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigurationDeploymentResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigurationDeploymentResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigurationDeploymentResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigurationDeploymentResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigurationDeploymentResultValidationError) ErrorName() string {
+	return "ConfigurationDeploymentResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfigurationDeploymentResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigurationDeploymentResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigurationDeploymentResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigurationDeploymentResultValidationError{}
+
+// Validate checks the field values on DeployToConfigurationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeployToConfigurationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeployToConfigurationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeployToConfigurationsResponseMultiError, or nil if none found.
+func (m *DeployToConfigurationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeployToConfigurationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeployToConfigurationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeployToConfigurationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeployToConfigurationsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeployToConfigurationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeployToConfigurationsResponseMultiError is an error wrapping multiple
+// validation errors returned by DeployToConfigurationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeployToConfigurationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeployToConfigurationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeployToConfigurationsResponseMultiError) AllErrors() []error { return m }
+
+// DeployToConfigurationsResponseValidationError is the validation error
+// returned by DeployToConfigurationsResponse.Validate if the designated
+// constraints aren't met.
+type DeployToConfigurationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeployToConfigurationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeployToConfigurationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeployToConfigurationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeployToConfigurationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeployToConfigurationsResponseValidationError) ErrorName() string {
+	return "DeployToConfigurationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeployToConfigurationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeployToConfigurationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeployToConfigurationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeployToConfigurationsResponseValidationError{}
+
+// Validate checks the field values on DeployToTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeployToTargetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeployToTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeployToTargetsRequestMultiError, or nil if none found.
+func (m *DeployToTargetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeployToTargetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CertificateId
+
+	if m.TriggeredBy != nil {
+		// no validation rules for TriggeredBy
+	}
+
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
 	}
 
 	if len(errors) > 0 {
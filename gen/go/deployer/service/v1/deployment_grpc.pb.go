@@ -24,6 +24,8 @@ const (
 	DeploymentService_DeployToConfigurations_FullMethodName = "/deployer.service.v1.DeploymentService/DeployToConfigurations"
 	DeploymentService_Verify_FullMethodName                 = "/deployer.service.v1.DeploymentService/Verify"
 	DeploymentService_Rollback_FullMethodName               = "/deployer.service.v1.DeploymentService/Rollback"
+	DeploymentService_Undeploy_FullMethodName               = "/deployer.service.v1.DeploymentService/Undeploy"
+	DeploymentService_PlanDeployment_FullMethodName         = "/deployer.service.v1.DeploymentService/PlanDeployment"
 	DeploymentService_DeployToTargets_FullMethodName        = "/deployer.service.v1.DeploymentService/DeployToTargets"
 )
 
@@ -43,6 +45,10 @@ type DeploymentServiceClient interface {
 	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
 	// Rollback a deployment
 	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
+	// Remove the deployed certificate from a target configuration
+	Undeploy(ctx context.Context, in *UndeployRequest, opts ...grpc.CallOption) (*UndeployResponse, error)
+	// Preview the changes a deployment would make, without making them
+	PlanDeployment(ctx context.Context, in *PlanDeploymentRequest, opts ...grpc.CallOption) (*PlanDeploymentResponse, error)
 	// Deprecated: Do not use.
 	// Legacy: Deploy to multiple targets (deprecated)
 	DeployToTargets(ctx context.Context, in *DeployToTargetsRequest, opts ...grpc.CallOption) (*DeployToTargetsResponse, error)
@@ -106,6 +112,26 @@ func (c *deploymentServiceClient) Rollback(ctx context.Context, in *RollbackRequ
 	return out, nil
 }
 
+func (c *deploymentServiceClient) Undeploy(ctx context.Context, in *UndeployRequest, opts ...grpc.CallOption) (*UndeployResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeployResponse)
+	err := c.cc.Invoke(ctx, DeploymentService_Undeploy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentServiceClient) PlanDeployment(ctx context.Context, in *PlanDeploymentRequest, opts ...grpc.CallOption) (*PlanDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanDeploymentResponse)
+	err := c.cc.Invoke(ctx, DeploymentService_PlanDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *deploymentServiceClient) DeployToTargets(ctx context.Context, in *DeployToTargetsRequest, opts ...grpc.CallOption) (*DeployToTargetsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -133,6 +159,10 @@ type DeploymentServiceServer interface {
 	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
 	// Rollback a deployment
 	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	// Remove the deployed certificate from a target configuration
+	Undeploy(context.Context, *UndeployRequest) (*UndeployResponse, error)
+	// Preview the changes a deployment would make, without making them
+	PlanDeployment(context.Context, *PlanDeploymentRequest) (*PlanDeploymentResponse, error)
 	// Deprecated: Do not use.
 	// Legacy: Deploy to multiple targets (deprecated)
 	DeployToTargets(context.Context, *DeployToTargetsRequest) (*DeployToTargetsResponse, error)
@@ -161,6 +191,12 @@ func (UnimplementedDeploymentServiceServer) Verify(context.Context, *VerifyReque
 func (UnimplementedDeploymentServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Rollback not implemented")
 }
+func (UnimplementedDeploymentServiceServer) Undeploy(context.Context, *UndeployRequest) (*UndeployResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Undeploy not implemented")
+}
+func (UnimplementedDeploymentServiceServer) PlanDeployment(context.Context, *PlanDeploymentRequest) (*PlanDeploymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanDeployment not implemented")
+}
 func (UnimplementedDeploymentServiceServer) DeployToTargets(context.Context, *DeployToTargetsRequest) (*DeployToTargetsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeployToTargets not implemented")
 }
@@ -275,6 +311,42 @@ func _DeploymentService_Rollback_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeploymentService_Undeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentServiceServer).Undeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentService_Undeploy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentServiceServer).Undeploy(ctx, req.(*UndeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentService_PlanDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentServiceServer).PlanDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentService_PlanDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentServiceServer).PlanDeployment(ctx, req.(*PlanDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeploymentService_DeployToTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeployToTargetsRequest)
 	if err := dec(in); err != nil {
@@ -320,6 +392,14 @@ var DeploymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Rollback",
 			Handler:    _DeploymentService_Rollback_Handler,
 		},
+		{
+			MethodName: "Undeploy",
+			Handler:    _DeploymentService_Undeploy_Handler,
+		},
+		{
+			MethodName: "PlanDeployment",
+			Handler:    _DeploymentService_PlanDeployment_Handler,
+		},
 		{
 			MethodName: "DeployToTargets",
 			Handler:    _DeploymentService_DeployToTargets_Handler,
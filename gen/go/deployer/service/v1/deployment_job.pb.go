@@ -100,6 +100,10 @@ const (
 	TriggerType_TRIGGER_TYPE_MANUAL       TriggerType = 1
 	TriggerType_TRIGGER_TYPE_EVENT        TriggerType = 2
 	TriggerType_TRIGGER_TYPE_AUTO_RENEWAL TriggerType = 3
+	// Redeploys a certificate the drift detector found missing from the device
+	TriggerType_TRIGGER_TYPE_DRIFT_REMEDIATION TriggerType = 4
+	// Redeploys or removes a certificate LCM revoked or deleted
+	TriggerType_TRIGGER_TYPE_REVOCATION TriggerType = 5
 )
 
 // Enum value maps for TriggerType.
@@ -109,12 +113,16 @@ var (
 		1: "TRIGGER_TYPE_MANUAL",
 		2: "TRIGGER_TYPE_EVENT",
 		3: "TRIGGER_TYPE_AUTO_RENEWAL",
+		4: "TRIGGER_TYPE_DRIFT_REMEDIATION",
+		5: "TRIGGER_TYPE_REVOCATION",
 	}
 	TriggerType_value = map[string]int32{
-		"TRIGGER_TYPE_UNSPECIFIED":  0,
-		"TRIGGER_TYPE_MANUAL":       1,
-		"TRIGGER_TYPE_EVENT":        2,
-		"TRIGGER_TYPE_AUTO_RENEWAL": 3,
+		"TRIGGER_TYPE_UNSPECIFIED":       0,
+		"TRIGGER_TYPE_MANUAL":            1,
+		"TRIGGER_TYPE_EVENT":             2,
+		"TRIGGER_TYPE_AUTO_RENEWAL":      3,
+		"TRIGGER_TYPE_DRIFT_REMEDIATION": 4,
+		"TRIGGER_TYPE_REVOCATION":        5,
 	}
 )
 
@@ -145,6 +153,134 @@ func (TriggerType) EnumDescriptor() ([]byte, []int) {
 	return file_deployer_service_v1_deployment_job_proto_rawDescGZIP(), []int{1}
 }
 
+// What a child or direct job does to its configuration
+type JobAction int32
+
+const (
+	JobAction_JOB_ACTION_UNSPECIFIED JobAction = 0
+	// Deploy the certificate
+	JobAction_JOB_ACTION_DEPLOY JobAction = 1
+	// Remove the certificate from the device
+	JobAction_JOB_ACTION_REMOVE JobAction = 2
+)
+
+// Enum value maps for JobAction.
+var (
+	JobAction_name = map[int32]string{
+		0: "JOB_ACTION_UNSPECIFIED",
+		1: "JOB_ACTION_DEPLOY",
+		2: "JOB_ACTION_REMOVE",
+	}
+	JobAction_value = map[string]int32{
+		"JOB_ACTION_UNSPECIFIED": 0,
+		"JOB_ACTION_DEPLOY":      1,
+		"JOB_ACTION_REMOVE":      2,
+	}
+)
+
+func (x JobAction) Enum() *JobAction {
+	p := new(JobAction)
+	*p = x
+	return p
+}
+
+func (x JobAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployer_service_v1_deployment_job_proto_enumTypes[2].Descriptor()
+}
+
+func (JobAction) Type() protoreflect.EnumType {
+	return &file_deployer_service_v1_deployment_job_proto_enumTypes[2]
+}
+
+func (x JobAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobAction.Descriptor instead.
+func (JobAction) EnumDescriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_job_proto_rawDescGZIP(), []int{2}
+}
+
+// Classification of a deployment failure, deciding whether it is retried
+type ErrorCategory int32
+
+const (
+	ErrorCategory_ERROR_CATEGORY_UNSPECIFIED ErrorCategory = 0
+	// Unclassified failure; retried
+	ErrorCategory_ERROR_CATEGORY_UNKNOWN ErrorCategory = 1
+	// Credentials were rejected; not retried
+	ErrorCategory_ERROR_CATEGORY_AUTH ErrorCategory = 2
+	// Credentials lack a required permission; not retried
+	ErrorCategory_ERROR_CATEGORY_PERMISSION ErrorCategory = 3
+	// A resource the deployment depends on does not exist; not retried
+	ErrorCategory_ERROR_CATEGORY_NOT_FOUND ErrorCategory = 4
+	// Certificate, config or request rejected as invalid; not retried
+	ErrorCategory_ERROR_CATEGORY_VALIDATION ErrorCategory = 5
+	// Target throttled the request; retried no earlier than its retry-after
+	ErrorCategory_ERROR_CATEGORY_RATE_LIMITED ErrorCategory = 6
+	// Network failure, timeout or server-side error; retried
+	ErrorCategory_ERROR_CATEGORY_TRANSIENT ErrorCategory = 7
+	// Target refused the change because of its current state; retried
+	ErrorCategory_ERROR_CATEGORY_CONFLICT ErrorCategory = 8
+)
+
+// Enum value maps for ErrorCategory.
+var (
+	ErrorCategory_name = map[int32]string{
+		0: "ERROR_CATEGORY_UNSPECIFIED",
+		1: "ERROR_CATEGORY_UNKNOWN",
+		2: "ERROR_CATEGORY_AUTH",
+		3: "ERROR_CATEGORY_PERMISSION",
+		4: "ERROR_CATEGORY_NOT_FOUND",
+		5: "ERROR_CATEGORY_VALIDATION",
+		6: "ERROR_CATEGORY_RATE_LIMITED",
+		7: "ERROR_CATEGORY_TRANSIENT",
+		8: "ERROR_CATEGORY_CONFLICT",
+	}
+	ErrorCategory_value = map[string]int32{
+		"ERROR_CATEGORY_UNSPECIFIED":  0,
+		"ERROR_CATEGORY_UNKNOWN":      1,
+		"ERROR_CATEGORY_AUTH":         2,
+		"ERROR_CATEGORY_PERMISSION":   3,
+		"ERROR_CATEGORY_NOT_FOUND":    4,
+		"ERROR_CATEGORY_VALIDATION":   5,
+		"ERROR_CATEGORY_RATE_LIMITED": 6,
+		"ERROR_CATEGORY_TRANSIENT":    7,
+		"ERROR_CATEGORY_CONFLICT":     8,
+	}
+)
+
+func (x ErrorCategory) Enum() *ErrorCategory {
+	p := new(ErrorCategory)
+	*p = x
+	return p
+}
+
+func (x ErrorCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployer_service_v1_deployment_job_proto_enumTypes[3].Descriptor()
+}
+
+func (ErrorCategory) Type() protoreflect.EnumType {
+	return &file_deployer_service_v1_deployment_job_proto_enumTypes[3]
+}
+
+func (x ErrorCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCategory.Descriptor instead.
+func (ErrorCategory) EnumDescriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_job_proto_rawDescGZIP(), []int{3}
+}
+
 // Job type
 type JobType int32
 
@@ -185,11 +321,11 @@ func (x JobType) String() string {
 }
 
 func (JobType) Descriptor() protoreflect.EnumDescriptor {
-	return file_deployer_service_v1_deployment_job_proto_enumTypes[2].Descriptor()
+	return file_deployer_service_v1_deployment_job_proto_enumTypes[4].Descriptor()
 }
 
 func (JobType) Type() protoreflect.EnumType {
-	return &file_deployer_service_v1_deployment_job_proto_enumTypes[2]
+	return &file_deployer_service_v1_deployment_job_proto_enumTypes[4]
 }
 
 func (x JobType) Number() protoreflect.EnumNumber {
@@ -198,7 +334,7 @@ func (x JobType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobType.Descriptor instead.
 func (JobType) EnumDescriptor() ([]byte, []int) {
-	return file_deployer_service_v1_deployment_job_proto_rawDescGZIP(), []int{2}
+	return file_deployer_service_v1_deployment_job_proto_rawDescGZIP(), []int{4}
 }
 
 // Deployment job entity
@@ -230,6 +366,18 @@ type DeploymentJob struct {
 	StartedAt         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
 	CompletedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
 	NextRetryAt       *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=next_retry_at,json=nextRetryAt,proto3,oneof" json:"next_retry_at,omitempty"`
+	// For child/direct jobs: configuration revision the job executed with
+	ConfigurationRevisionId *uint32 `protobuf:"varint,22,opt,name=configuration_revision_id,json=configurationRevisionId,proto3,oneof" json:"configuration_revision_id,omitempty"`
+	// For child/direct jobs: category of the last failure
+	ErrorCategory *ErrorCategory `protobuf:"varint,23,opt,name=error_category,json=errorCategory,proto3,enum=deployer.service.v1.ErrorCategory,oneof" json:"error_category,omitempty"`
+	// Effective retry and timeout policy the job was created with
+	RetryPolicy *RetryPolicy `protobuf:"bytes,24,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Idempotency key the job was created with (parent/direct jobs)
+	IdempotencyKey *string `protobuf:"bytes,25,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	// For child/direct jobs: the newer job that replaced this one while it was queued
+	SupersededByJobId *string `protobuf:"bytes,26,opt,name=superseded_by_job_id,json=supersededByJobId,proto3,oneof" json:"superseded_by_job_id,omitempty"`
+	// For child/direct jobs: whether the job deploys or removes the certificate
+	Action *JobAction `protobuf:"varint,27,opt,name=action,proto3,enum=deployer.service.v1.JobAction,oneof" json:"action,omitempty"`
 	// For parent jobs: child job summary
 	TotalChildJobs     *int32 `protobuf:"varint,30,opt,name=total_child_jobs,json=totalChildJobs,proto3,oneof" json:"total_child_jobs,omitempty"`
 	CompletedChildJobs *int32 `protobuf:"varint,31,opt,name=completed_child_jobs,json=completedChildJobs,proto3,oneof" json:"completed_child_jobs,omitempty"`
@@ -413,6 +561,48 @@ func (x *DeploymentJob) GetNextRetryAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DeploymentJob) GetConfigurationRevisionId() uint32 {
+	if x != nil && x.ConfigurationRevisionId != nil {
+		return *x.ConfigurationRevisionId
+	}
+	return 0
+}
+
+func (x *DeploymentJob) GetErrorCategory() ErrorCategory {
+	if x != nil && x.ErrorCategory != nil {
+		return *x.ErrorCategory
+	}
+	return ErrorCategory_ERROR_CATEGORY_UNSPECIFIED
+}
+
+func (x *DeploymentJob) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *DeploymentJob) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DeploymentJob) GetSupersededByJobId() string {
+	if x != nil && x.SupersededByJobId != nil {
+		return *x.SupersededByJobId
+	}
+	return ""
+}
+
+func (x *DeploymentJob) GetAction() JobAction {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return JobAction_JOB_ACTION_UNSPECIFIED
+}
+
 func (x *DeploymentJob) GetTotalChildJobs() int32 {
 	if x != nil && x.TotalChildJobs != nil {
 		return *x.TotalChildJobs
@@ -473,8 +663,10 @@ type CreateJobRequest struct {
 	CertificateId string       `protobuf:"bytes,3,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
 	TriggeredBy   *TriggerType `protobuf:"varint,4,opt,name=triggered_by,json=triggeredBy,proto3,enum=deployer.service.v1.TriggerType,oneof" json:"triggered_by,omitempty"`
 	MaxRetries    *int32       `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Repeating a request with the same key returns the job created first
+	IdempotencyKey *string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateJobRequest) Reset() {
@@ -542,6 +734,13 @@ func (x *CreateJobRequest) GetMaxRetries() int32 {
 	return 0
 }
 
+func (x *CreateJobRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Job           *DeploymentJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
@@ -1289,7 +1488,7 @@ var File_deployer_service_v1_deployment_job_proto protoreflect.FileDescriptor
 
 const file_deployer_service_v1_deployment_job_proto_rawDesc = "" +
 	"\n" +
-	"(deployer/service/v1/deployment_job.proto\x12\x13deployer.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xf8\x0e\n" +
+	"(deployer/service/v1/deployment_job.proto\x12\x13deployer.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a&deployer/service/v1/retry_policy.proto\"\xee\x12\n" +
 	"\rDeploymentJob\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x125\n" +
@@ -1315,17 +1514,23 @@ const file_deployer_service_v1_deployment_job_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\x11R\tstartedAt\x88\x01\x01\x12B\n" +
 	"\fcompleted_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x12R\vcompletedAt\x88\x01\x01\x12C\n" +
-	"\rnext_retry_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x13R\vnextRetryAt\x88\x01\x01\x12-\n" +
-	"\x10total_child_jobs\x18\x1e \x01(\x05H\x14R\x0etotalChildJobs\x88\x01\x01\x125\n" +
-	"\x14completed_child_jobs\x18\x1f \x01(\x05H\x15R\x12completedChildJobs\x88\x01\x01\x12/\n" +
-	"\x11failed_child_jobs\x18  \x01(\x05H\x16R\x0ffailedChildJobs\x88\x01\x01\x12A\n" +
+	"\rnext_retry_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x13R\vnextRetryAt\x88\x01\x01\x12?\n" +
+	"\x19configuration_revision_id\x18\x16 \x01(\rH\x14R\x17configurationRevisionId\x88\x01\x01\x12N\n" +
+	"\x0eerror_category\x18\x17 \x01(\x0e2\".deployer.service.v1.ErrorCategoryH\x15R\rerrorCategory\x88\x01\x01\x12H\n" +
+	"\fretry_policy\x18\x18 \x01(\v2 .deployer.service.v1.RetryPolicyH\x16R\vretryPolicy\x88\x01\x01\x12,\n" +
+	"\x0fidempotency_key\x18\x19 \x01(\tH\x17R\x0eidempotencyKey\x88\x01\x01\x124\n" +
+	"\x14superseded_by_job_id\x18\x1a \x01(\tH\x18R\x11supersededByJobId\x88\x01\x01\x12;\n" +
+	"\x06action\x18\x1b \x01(\x0e2\x1e.deployer.service.v1.JobActionH\x19R\x06action\x88\x01\x01\x12-\n" +
+	"\x10total_child_jobs\x18\x1e \x01(\x05H\x1aR\x0etotalChildJobs\x88\x01\x01\x125\n" +
+	"\x14completed_child_jobs\x18\x1f \x01(\x05H\x1bR\x12completedChildJobs\x88\x01\x01\x12/\n" +
+	"\x11failed_child_jobs\x18  \x01(\x05H\x1cR\x0ffailedChildJobs\x88\x01\x01\x12A\n" +
 	"\n" +
 	"child_jobs\x18( \x03(\v2\".deployer.service.v1.DeploymentJobR\tchildJobs\x12\"\n" +
 	"\n" +
-	"created_by\x18d \x01(\rH\x17R\tcreatedBy\x88\x01\x01\x12A\n" +
-	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x18R\n" +
+	"created_by\x18d \x01(\rH\x1dR\tcreatedBy\x88\x01\x01\x12A\n" +
+	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x1eR\n" +
 	"createTime\x88\x01\x01\x12A\n" +
-	"\vupdate_time\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x19R\n" +
+	"\vupdate_time\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x1fR\n" +
 	"updateTime\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
@@ -1347,24 +1552,33 @@ const file_deployer_service_v1_deployment_job_proto_rawDesc = "" +
 	"\a_resultB\r\n" +
 	"\v_started_atB\x0f\n" +
 	"\r_completed_atB\x10\n" +
-	"\x0e_next_retry_atB\x13\n" +
+	"\x0e_next_retry_atB\x1c\n" +
+	"\x1a_configuration_revision_idB\x11\n" +
+	"\x0f_error_categoryB\x0f\n" +
+	"\r_retry_policyB\x12\n" +
+	"\x10_idempotency_keyB\x17\n" +
+	"\x15_superseded_by_job_idB\t\n" +
+	"\a_actionB\x13\n" +
 	"\x11_total_child_jobsB\x17\n" +
 	"\x15_completed_child_jobsB\x14\n" +
 	"\x12_failed_child_jobsB\r\n" +
 	"\v_created_byB\x0e\n" +
 	"\f_create_timeB\x0e\n" +
-	"\f_update_time\"\xf8\x02\n" +
+	"\f_update_time\"\xc6\x03\n" +
 	"\x10CreateJobRequest\x125\n" +
 	"\x14deployment_target_id\x18\x01 \x01(\tH\x00R\x12deploymentTargetId\x88\x01\x01\x12;\n" +
 	"\x17target_configuration_id\x18\x02 \x01(\tH\x01R\x15targetConfigurationId\x88\x01\x01\x12*\n" +
 	"\x0ecertificate_id\x18\x03 \x01(\tB\x03\xe0A\x02R\rcertificateId\x12H\n" +
 	"\ftriggered_by\x18\x04 \x01(\x0e2 .deployer.service.v1.TriggerTypeH\x02R\vtriggeredBy\x88\x01\x01\x12$\n" +
 	"\vmax_retries\x18\x05 \x01(\x05H\x03R\n" +
-	"maxRetries\x88\x01\x01B\x17\n" +
+	"maxRetries\x88\x01\x01\x128\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x04R\x0eidempotencyKey\x88\x01\x01B\x17\n" +
 	"\x15_deployment_target_idB\x1a\n" +
 	"\x18_target_configuration_idB\x0f\n" +
 	"\r_triggered_byB\x0e\n" +
-	"\f_max_retries\"I\n" +
+	"\f_max_retriesB\x12\n" +
+	"\x10_idempotency_key\"I\n" +
 	"\x11CreateJobResponse\x124\n" +
 	"\x03job\x18\x01 \x01(\v2\".deployer.service.v1.DeploymentJobR\x03job\"t\n" +
 	"\x13GetJobStatusRequest\x12\x13\n" +
@@ -1455,12 +1669,28 @@ const file_deployer_service_v1_deployment_job_proto_rawDesc = "" +
 	"\x11JOB_STATUS_FAILED\x10\x04\x12\x18\n" +
 	"\x14JOB_STATUS_CANCELLED\x10\x05\x12\x17\n" +
 	"\x13JOB_STATUS_RETRYING\x10\x06\x12\x16\n" +
-	"\x12JOB_STATUS_PARTIAL\x10\a*{\n" +
+	"\x12JOB_STATUS_PARTIAL\x10\a*\xbc\x01\n" +
 	"\vTriggerType\x12\x1c\n" +
 	"\x18TRIGGER_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TRIGGER_TYPE_MANUAL\x10\x01\x12\x16\n" +
 	"\x12TRIGGER_TYPE_EVENT\x10\x02\x12\x1d\n" +
-	"\x19TRIGGER_TYPE_AUTO_RENEWAL\x10\x03*a\n" +
+	"\x19TRIGGER_TYPE_AUTO_RENEWAL\x10\x03\x12\"\n" +
+	"\x1eTRIGGER_TYPE_DRIFT_REMEDIATION\x10\x04\x12\x1b\n" +
+	"\x17TRIGGER_TYPE_REVOCATION\x10\x05*U\n" +
+	"\tJobAction\x12\x1a\n" +
+	"\x16JOB_ACTION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11JOB_ACTION_DEPLOY\x10\x01\x12\x15\n" +
+	"\x11JOB_ACTION_REMOVE\x10\x02*\x9c\x02\n" +
+	"\rErrorCategory\x12\x1e\n" +
+	"\x1aERROR_CATEGORY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ERROR_CATEGORY_UNKNOWN\x10\x01\x12\x17\n" +
+	"\x13ERROR_CATEGORY_AUTH\x10\x02\x12\x1d\n" +
+	"\x19ERROR_CATEGORY_PERMISSION\x10\x03\x12\x1c\n" +
+	"\x18ERROR_CATEGORY_NOT_FOUND\x10\x04\x12\x1d\n" +
+	"\x19ERROR_CATEGORY_VALIDATION\x10\x05\x12\x1f\n" +
+	"\x1bERROR_CATEGORY_RATE_LIMITED\x10\x06\x12\x1c\n" +
+	"\x18ERROR_CATEGORY_TRANSIENT\x10\a\x12\x1b\n" +
+	"\x17ERROR_CATEGORY_CONFLICT\x10\b*a\n" +
 	"\aJobType\x12\x18\n" +
 	"\x14JOB_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fJOB_TYPE_PARENT\x10\x01\x12\x12\n" +
@@ -1487,72 +1717,78 @@ func file_deployer_service_v1_deployment_job_proto_rawDescGZIP() []byte {
 	return file_deployer_service_v1_deployment_job_proto_rawDescData
 }
 
-var file_deployer_service_v1_deployment_job_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_deployer_service_v1_deployment_job_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_deployer_service_v1_deployment_job_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_deployer_service_v1_deployment_job_proto_goTypes = []any{
 	(JobStatus)(0),                // 0: deployer.service.v1.JobStatus
 	(TriggerType)(0),              // 1: deployer.service.v1.TriggerType
-	(JobType)(0),                  // 2: deployer.service.v1.JobType
-	(*DeploymentJob)(nil),         // 3: deployer.service.v1.DeploymentJob
-	(*CreateJobRequest)(nil),      // 4: deployer.service.v1.CreateJobRequest
-	(*CreateJobResponse)(nil),     // 5: deployer.service.v1.CreateJobResponse
-	(*GetJobStatusRequest)(nil),   // 6: deployer.service.v1.GetJobStatusRequest
-	(*GetJobStatusResponse)(nil),  // 7: deployer.service.v1.GetJobStatusResponse
-	(*GetJobResultRequest)(nil),   // 8: deployer.service.v1.GetJobResultRequest
-	(*GetJobResultResponse)(nil),  // 9: deployer.service.v1.GetJobResultResponse
-	(*JobHistoryEntry)(nil),       // 10: deployer.service.v1.JobHistoryEntry
-	(*ListJobsRequest)(nil),       // 11: deployer.service.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),      // 12: deployer.service.v1.ListJobsResponse
-	(*CancelJobRequest)(nil),      // 13: deployer.service.v1.CancelJobRequest
-	(*CancelJobResponse)(nil),     // 14: deployer.service.v1.CancelJobResponse
-	(*RetryJobRequest)(nil),       // 15: deployer.service.v1.RetryJobRequest
-	(*RetryJobResponse)(nil),      // 16: deployer.service.v1.RetryJobResponse
-	(*structpb.Struct)(nil),       // 17: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+	(JobAction)(0),                // 2: deployer.service.v1.JobAction
+	(ErrorCategory)(0),            // 3: deployer.service.v1.ErrorCategory
+	(JobType)(0),                  // 4: deployer.service.v1.JobType
+	(*DeploymentJob)(nil),         // 5: deployer.service.v1.DeploymentJob
+	(*CreateJobRequest)(nil),      // 6: deployer.service.v1.CreateJobRequest
+	(*CreateJobResponse)(nil),     // 7: deployer.service.v1.CreateJobResponse
+	(*GetJobStatusRequest)(nil),   // 8: deployer.service.v1.GetJobStatusRequest
+	(*GetJobStatusResponse)(nil),  // 9: deployer.service.v1.GetJobStatusResponse
+	(*GetJobResultRequest)(nil),   // 10: deployer.service.v1.GetJobResultRequest
+	(*GetJobResultResponse)(nil),  // 11: deployer.service.v1.GetJobResultResponse
+	(*JobHistoryEntry)(nil),       // 12: deployer.service.v1.JobHistoryEntry
+	(*ListJobsRequest)(nil),       // 13: deployer.service.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),      // 14: deployer.service.v1.ListJobsResponse
+	(*CancelJobRequest)(nil),      // 15: deployer.service.v1.CancelJobRequest
+	(*CancelJobResponse)(nil),     // 16: deployer.service.v1.CancelJobResponse
+	(*RetryJobRequest)(nil),       // 17: deployer.service.v1.RetryJobRequest
+	(*RetryJobResponse)(nil),      // 18: deployer.service.v1.RetryJobResponse
+	(*structpb.Struct)(nil),       // 19: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
+	(*RetryPolicy)(nil),           // 21: deployer.service.v1.RetryPolicy
 }
 var file_deployer_service_v1_deployment_job_proto_depIdxs = []int32{
-	2,  // 0: deployer.service.v1.DeploymentJob.job_type:type_name -> deployer.service.v1.JobType
+	4,  // 0: deployer.service.v1.DeploymentJob.job_type:type_name -> deployer.service.v1.JobType
 	0,  // 1: deployer.service.v1.DeploymentJob.status:type_name -> deployer.service.v1.JobStatus
 	1,  // 2: deployer.service.v1.DeploymentJob.triggered_by:type_name -> deployer.service.v1.TriggerType
-	17, // 3: deployer.service.v1.DeploymentJob.result:type_name -> google.protobuf.Struct
-	18, // 4: deployer.service.v1.DeploymentJob.started_at:type_name -> google.protobuf.Timestamp
-	18, // 5: deployer.service.v1.DeploymentJob.completed_at:type_name -> google.protobuf.Timestamp
-	18, // 6: deployer.service.v1.DeploymentJob.next_retry_at:type_name -> google.protobuf.Timestamp
-	3,  // 7: deployer.service.v1.DeploymentJob.child_jobs:type_name -> deployer.service.v1.DeploymentJob
-	18, // 8: deployer.service.v1.DeploymentJob.create_time:type_name -> google.protobuf.Timestamp
-	18, // 9: deployer.service.v1.DeploymentJob.update_time:type_name -> google.protobuf.Timestamp
-	1,  // 10: deployer.service.v1.CreateJobRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
-	3,  // 11: deployer.service.v1.CreateJobResponse.job:type_name -> deployer.service.v1.DeploymentJob
-	3,  // 12: deployer.service.v1.GetJobStatusResponse.job:type_name -> deployer.service.v1.DeploymentJob
-	3,  // 13: deployer.service.v1.GetJobResultResponse.job:type_name -> deployer.service.v1.DeploymentJob
-	10, // 14: deployer.service.v1.GetJobResultResponse.history:type_name -> deployer.service.v1.JobHistoryEntry
-	17, // 15: deployer.service.v1.JobHistoryEntry.details:type_name -> google.protobuf.Struct
-	18, // 16: deployer.service.v1.JobHistoryEntry.create_time:type_name -> google.protobuf.Timestamp
-	0,  // 17: deployer.service.v1.ListJobsRequest.status:type_name -> deployer.service.v1.JobStatus
-	1,  // 18: deployer.service.v1.ListJobsRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
-	2,  // 19: deployer.service.v1.ListJobsRequest.job_type:type_name -> deployer.service.v1.JobType
-	18, // 20: deployer.service.v1.ListJobsRequest.created_after:type_name -> google.protobuf.Timestamp
-	18, // 21: deployer.service.v1.ListJobsRequest.created_before:type_name -> google.protobuf.Timestamp
-	3,  // 22: deployer.service.v1.ListJobsResponse.items:type_name -> deployer.service.v1.DeploymentJob
-	3,  // 23: deployer.service.v1.CancelJobResponse.job:type_name -> deployer.service.v1.DeploymentJob
-	3,  // 24: deployer.service.v1.RetryJobResponse.job:type_name -> deployer.service.v1.DeploymentJob
-	4,  // 25: deployer.service.v1.DeploymentJobService.CreateJob:input_type -> deployer.service.v1.CreateJobRequest
-	6,  // 26: deployer.service.v1.DeploymentJobService.GetJobStatus:input_type -> deployer.service.v1.GetJobStatusRequest
-	8,  // 27: deployer.service.v1.DeploymentJobService.GetJobResult:input_type -> deployer.service.v1.GetJobResultRequest
-	11, // 28: deployer.service.v1.DeploymentJobService.ListJobs:input_type -> deployer.service.v1.ListJobsRequest
-	13, // 29: deployer.service.v1.DeploymentJobService.CancelJob:input_type -> deployer.service.v1.CancelJobRequest
-	15, // 30: deployer.service.v1.DeploymentJobService.RetryJob:input_type -> deployer.service.v1.RetryJobRequest
-	5,  // 31: deployer.service.v1.DeploymentJobService.CreateJob:output_type -> deployer.service.v1.CreateJobResponse
-	7,  // 32: deployer.service.v1.DeploymentJobService.GetJobStatus:output_type -> deployer.service.v1.GetJobStatusResponse
-	9,  // 33: deployer.service.v1.DeploymentJobService.GetJobResult:output_type -> deployer.service.v1.GetJobResultResponse
-	12, // 34: deployer.service.v1.DeploymentJobService.ListJobs:output_type -> deployer.service.v1.ListJobsResponse
-	14, // 35: deployer.service.v1.DeploymentJobService.CancelJob:output_type -> deployer.service.v1.CancelJobResponse
-	16, // 36: deployer.service.v1.DeploymentJobService.RetryJob:output_type -> deployer.service.v1.RetryJobResponse
-	31, // [31:37] is the sub-list for method output_type
-	25, // [25:31] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	19, // 3: deployer.service.v1.DeploymentJob.result:type_name -> google.protobuf.Struct
+	20, // 4: deployer.service.v1.DeploymentJob.started_at:type_name -> google.protobuf.Timestamp
+	20, // 5: deployer.service.v1.DeploymentJob.completed_at:type_name -> google.protobuf.Timestamp
+	20, // 6: deployer.service.v1.DeploymentJob.next_retry_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: deployer.service.v1.DeploymentJob.error_category:type_name -> deployer.service.v1.ErrorCategory
+	21, // 8: deployer.service.v1.DeploymentJob.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	2,  // 9: deployer.service.v1.DeploymentJob.action:type_name -> deployer.service.v1.JobAction
+	5,  // 10: deployer.service.v1.DeploymentJob.child_jobs:type_name -> deployer.service.v1.DeploymentJob
+	20, // 11: deployer.service.v1.DeploymentJob.create_time:type_name -> google.protobuf.Timestamp
+	20, // 12: deployer.service.v1.DeploymentJob.update_time:type_name -> google.protobuf.Timestamp
+	1,  // 13: deployer.service.v1.CreateJobRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
+	5,  // 14: deployer.service.v1.CreateJobResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	5,  // 15: deployer.service.v1.GetJobStatusResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	5,  // 16: deployer.service.v1.GetJobResultResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	12, // 17: deployer.service.v1.GetJobResultResponse.history:type_name -> deployer.service.v1.JobHistoryEntry
+	19, // 18: deployer.service.v1.JobHistoryEntry.details:type_name -> google.protobuf.Struct
+	20, // 19: deployer.service.v1.JobHistoryEntry.create_time:type_name -> google.protobuf.Timestamp
+	0,  // 20: deployer.service.v1.ListJobsRequest.status:type_name -> deployer.service.v1.JobStatus
+	1,  // 21: deployer.service.v1.ListJobsRequest.triggered_by:type_name -> deployer.service.v1.TriggerType
+	4,  // 22: deployer.service.v1.ListJobsRequest.job_type:type_name -> deployer.service.v1.JobType
+	20, // 23: deployer.service.v1.ListJobsRequest.created_after:type_name -> google.protobuf.Timestamp
+	20, // 24: deployer.service.v1.ListJobsRequest.created_before:type_name -> google.protobuf.Timestamp
+	5,  // 25: deployer.service.v1.ListJobsResponse.items:type_name -> deployer.service.v1.DeploymentJob
+	5,  // 26: deployer.service.v1.CancelJobResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	5,  // 27: deployer.service.v1.RetryJobResponse.job:type_name -> deployer.service.v1.DeploymentJob
+	6,  // 28: deployer.service.v1.DeploymentJobService.CreateJob:input_type -> deployer.service.v1.CreateJobRequest
+	8,  // 29: deployer.service.v1.DeploymentJobService.GetJobStatus:input_type -> deployer.service.v1.GetJobStatusRequest
+	10, // 30: deployer.service.v1.DeploymentJobService.GetJobResult:input_type -> deployer.service.v1.GetJobResultRequest
+	13, // 31: deployer.service.v1.DeploymentJobService.ListJobs:input_type -> deployer.service.v1.ListJobsRequest
+	15, // 32: deployer.service.v1.DeploymentJobService.CancelJob:input_type -> deployer.service.v1.CancelJobRequest
+	17, // 33: deployer.service.v1.DeploymentJobService.RetryJob:input_type -> deployer.service.v1.RetryJobRequest
+	7,  // 34: deployer.service.v1.DeploymentJobService.CreateJob:output_type -> deployer.service.v1.CreateJobResponse
+	9,  // 35: deployer.service.v1.DeploymentJobService.GetJobStatus:output_type -> deployer.service.v1.GetJobStatusResponse
+	11, // 36: deployer.service.v1.DeploymentJobService.GetJobResult:output_type -> deployer.service.v1.GetJobResultResponse
+	14, // 37: deployer.service.v1.DeploymentJobService.ListJobs:output_type -> deployer.service.v1.ListJobsResponse
+	16, // 38: deployer.service.v1.DeploymentJobService.CancelJob:output_type -> deployer.service.v1.CancelJobResponse
+	18, // 39: deployer.service.v1.DeploymentJobService.RetryJob:output_type -> deployer.service.v1.RetryJobResponse
+	34, // [34:40] is the sub-list for method output_type
+	28, // [28:34] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_deployer_service_v1_deployment_job_proto_init() }
@@ -1560,6 +1796,7 @@ func file_deployer_service_v1_deployment_job_proto_init() {
 	if File_deployer_service_v1_deployment_job_proto != nil {
 		return
 	}
+	file_deployer_service_v1_retry_policy_proto_init()
 	file_deployer_service_v1_deployment_job_proto_msgTypes[0].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_job_proto_msgTypes[1].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_job_proto_msgTypes[3].OneofWrappers = []any{}
@@ -1573,7 +1810,7 @@ func file_deployer_service_v1_deployment_job_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deployer_service_v1_deployment_job_proto_rawDesc), len(file_deployer_service_v1_deployment_job_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
@@ -160,6 +160,18 @@ func (x *DeploymentJob) Redact() string {
 
 	// Safe field: NextRetryAt
 
+	// Safe field: ConfigurationRevisionId
+
+	// Safe field: ErrorCategory
+
+	// Safe field: RetryPolicy
+
+	// Safe field: IdempotencyKey
+
+	// Safe field: SupersededByJobId
+
+	// Safe field: Action
+
 	// Safe field: TotalChildJobs
 
 	// Safe field: CompletedChildJobs
@@ -191,6 +203,8 @@ func (x *CreateJobRequest) Redact() string {
 	// Safe field: TriggeredBy
 
 	// Safe field: MaxRetries
+
+	// Safe field: IdempotencyKey
 	return x.String()
 }
 
@@ -287,6 +287,59 @@ func (m *DeploymentJob) validate(all bool) error {
 
 	}
 
+	if m.ConfigurationRevisionId != nil {
+		// no validation rules for ConfigurationRevisionId
+	}
+
+	if m.ErrorCategory != nil {
+		// no validation rules for ErrorCategory
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentJobValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentJobValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentJobValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
+	if m.SupersededByJobId != nil {
+		// no validation rules for SupersededByJobId
+	}
+
+	if m.Action != nil {
+		// no validation rules for Action
+	}
+
 	if m.TotalChildJobs != nil {
 		// no validation rules for TotalChildJobs
 	}
@@ -487,6 +540,10 @@ func (m *CreateJobRequest) validate(all bool) error {
 		// no validation rules for MaxRetries
 	}
 
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
 	if len(errors) > 0 {
 		return CreateJobRequestMultiError(errors)
 	}
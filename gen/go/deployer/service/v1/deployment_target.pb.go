@@ -25,6 +25,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What happens to configurations of a target serving a certificate LCM
+// revoked or deleted
+type RevocationPolicy int32
+
+const (
+	RevocationPolicy_REVOCATION_POLICY_UNSPECIFIED RevocationPolicy = 0
+	// Deploy the newest valid certificate with the same common name
+	RevocationPolicy_REVOCATION_POLICY_REDEPLOY RevocationPolicy = 1
+	// Remove the certificate from the device
+	RevocationPolicy_REVOCATION_POLICY_REMOVE RevocationPolicy = 2
+)
+
+// Enum value maps for RevocationPolicy.
+var (
+	RevocationPolicy_name = map[int32]string{
+		0: "REVOCATION_POLICY_UNSPECIFIED",
+		1: "REVOCATION_POLICY_REDEPLOY",
+		2: "REVOCATION_POLICY_REMOVE",
+	}
+	RevocationPolicy_value = map[string]int32{
+		"REVOCATION_POLICY_UNSPECIFIED": 0,
+		"REVOCATION_POLICY_REDEPLOY":    1,
+		"REVOCATION_POLICY_REMOVE":      2,
+	}
+)
+
+func (x RevocationPolicy) Enum() *RevocationPolicy {
+	p := new(RevocationPolicy)
+	*p = x
+	return p
+}
+
+func (x RevocationPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevocationPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_deployer_service_v1_deployment_target_proto_enumTypes[0].Descriptor()
+}
+
+func (RevocationPolicy) Type() protoreflect.EnumType {
+	return &file_deployer_service_v1_deployment_target_proto_enumTypes[0]
+}
+
+func (x RevocationPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevocationPolicy.Descriptor instead.
+func (RevocationPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_deployer_service_v1_deployment_target_proto_rawDescGZIP(), []int{0}
+}
+
 // Certificate filter for auto-deployment
 // All specified fields must match (AND logic). Empty fields are ignored.
 type CertificateFilter struct {
@@ -147,6 +200,10 @@ type DeploymentTarget struct {
 	Description         *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	AutoDeployOnRenewal *bool                  `protobuf:"varint,5,opt,name=auto_deploy_on_renewal,json=autoDeployOnRenewal,proto3,oneof" json:"auto_deploy_on_renewal,omitempty"`
 	CertificateFilters  []*CertificateFilter   `protobuf:"bytes,6,rep,name=certificate_filters,json=certificateFilters,proto3" json:"certificate_filters,omitempty"`
+	// Retry and timeout overrides for jobs deploying to this target
+	RetryPolicy *RetryPolicy `protobuf:"bytes,7,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Handling of revoked and deleted certificates
+	RevocationPolicy *RevocationPolicy `protobuf:"varint,8,opt,name=revocation_policy,json=revocationPolicy,proto3,enum=deployer.service.v1.RevocationPolicy,oneof" json:"revocation_policy,omitempty"`
 	// Linked target configurations (populated when requested)
 	Configurations []*TargetConfiguration `protobuf:"bytes,10,rep,name=configurations,proto3" json:"configurations,omitempty"`
 	// Count of linked configurations
@@ -231,6 +288,20 @@ func (x *DeploymentTarget) GetCertificateFilters() []*CertificateFilter {
 	return nil
 }
 
+func (x *DeploymentTarget) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *DeploymentTarget) GetRevocationPolicy() RevocationPolicy {
+	if x != nil && x.RevocationPolicy != nil {
+		return *x.RevocationPolicy
+	}
+	return RevocationPolicy_REVOCATION_POLICY_UNSPECIFIED
+}
+
 func (x *DeploymentTarget) GetConfigurations() []*TargetConfiguration {
 	if x != nil {
 		return x.Configurations
@@ -283,8 +354,14 @@ type CreateTargetRequest struct {
 	CertificateFilters  []*CertificateFilter   `protobuf:"bytes,5,rep,name=certificate_filters,json=certificateFilters,proto3" json:"certificate_filters,omitempty"`
 	// Optional: link configurations during creation
 	ConfigurationIds []string `protobuf:"bytes,6,rep,name=configuration_ids,json=configurationIds,proto3" json:"configuration_ids,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Retry and timeout overrides for jobs deploying to this target
+	RetryPolicy *RetryPolicy `protobuf:"bytes,7,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Handling of revoked and deleted certificates; defaults to redeploy
+	RevocationPolicy *RevocationPolicy `protobuf:"varint,8,opt,name=revocation_policy,json=revocationPolicy,proto3,enum=deployer.service.v1.RevocationPolicy,oneof" json:"revocation_policy,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTargetRequest) Reset() {
@@ -359,6 +436,27 @@ func (x *CreateTargetRequest) GetConfigurationIds() []string {
 	return nil
 }
 
+func (x *CreateTargetRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateTargetRequest) GetRevocationPolicy() RevocationPolicy {
+	if x != nil && x.RevocationPolicy != nil {
+		return *x.RevocationPolicy
+	}
+	return RevocationPolicy_REVOCATION_POLICY_UNSPECIFIED
+}
+
+func (x *CreateTargetRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type CreateTargetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Target        *DeploymentTarget      `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
@@ -639,8 +737,14 @@ type UpdateTargetRequest struct {
 	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	AutoDeployOnRenewal *bool                  `protobuf:"varint,4,opt,name=auto_deploy_on_renewal,json=autoDeployOnRenewal,proto3,oneof" json:"auto_deploy_on_renewal,omitempty"`
 	CertificateFilters  []*CertificateFilter   `protobuf:"bytes,5,rep,name=certificate_filters,json=certificateFilters,proto3" json:"certificate_filters,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Replaces the retry and timeout overrides; an empty policy removes them
+	RetryPolicy *RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Handling of revoked and deleted certificates
+	RevocationPolicy *RevocationPolicy `protobuf:"varint,7,opt,name=revocation_policy,json=revocationPolicy,proto3,enum=deployer.service.v1.RevocationPolicy,oneof" json:"revocation_policy,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateTargetRequest) Reset() {
@@ -708,6 +812,27 @@ func (x *UpdateTargetRequest) GetCertificateFilters() []*CertificateFilter {
 	return nil
 }
 
+func (x *UpdateTargetRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *UpdateTargetRequest) GetRevocationPolicy() RevocationPolicy {
+	if x != nil && x.RevocationPolicy != nil {
+		return *x.RevocationPolicy
+	}
+	return RevocationPolicy_REVOCATION_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateTargetRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type UpdateTargetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Target        *DeploymentTarget      `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
@@ -754,8 +879,10 @@ func (x *UpdateTargetResponse) GetTarget() *DeploymentTarget {
 
 // Delete a deployment target
 type DeleteTargetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -797,13 +924,22 @@ func (x *DeleteTargetRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteTargetRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 // Add configurations to a deployment target
 type AddConfigurationsRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ConfigurationIds []string               `protobuf:"bytes,2,rep,name=configuration_ids,json=configurationIds,proto3" json:"configuration_ids,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddConfigurationsRequest) Reset() {
@@ -850,6 +986,13 @@ func (x *AddConfigurationsRequest) GetConfigurationIds() []string {
 	return nil
 }
 
+func (x *AddConfigurationsRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type AddConfigurationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Target        *DeploymentTarget      `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
@@ -899,8 +1042,10 @@ type RemoveConfigurationsRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ConfigurationIds []string               `protobuf:"bytes,2,rep,name=configuration_ids,json=configurationIds,proto3" json:"configuration_ids,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoveConfigurationsRequest) Reset() {
@@ -947,6 +1092,13 @@ func (x *RemoveConfigurationsRequest) GetConfigurationIds() []string {
 	return nil
 }
 
+func (x *RemoveConfigurationsRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type RemoveConfigurationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Target        *DeploymentTarget      `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
@@ -1108,7 +1260,7 @@ var File_deployer_service_v1_deployment_target_proto protoreflect.FileDescriptor
 
 const file_deployer_service_v1_deployment_target_proto_rawDesc = "" +
 	"\n" +
-	"+deployer/service/v1/deployment_target.proto\x12\x13deployer.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.deployer/service/v1/target_configuration.proto\"\xfe\x03\n" +
+	"+deployer/service/v1/deployment_target.proto\x12\x13deployer.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a&deployer/service/v1/retry_policy.proto\x1a.deployer/service/v1/target_configuration.proto\"\xfe\x03\n" +
 	"\x11CertificateFilter\x12$\n" +
 	"\vissuer_name\x18\x01 \x01(\tH\x00R\n" +
 	"issuerName\x88\x01\x01\x123\n" +
@@ -1127,45 +1279,56 @@ const file_deployer_service_v1_deployment_target_proto_rawDesc = "" +
 	"\x15_subject_organizationB\x13\n" +
 	"\x11_subject_org_unitB\x12\n" +
 	"\x10_subject_countryB\x11\n" +
-	"\x0f_domain_pattern\"\x91\x06\n" +
+	"\x0f_domain_pattern\"\xdb\a\n" +
 	"\x10DeploymentTarget\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x04 \x01(\tH\x03R\vdescription\x88\x01\x01\x128\n" +
 	"\x16auto_deploy_on_renewal\x18\x05 \x01(\bH\x04R\x13autoDeployOnRenewal\x88\x01\x01\x12W\n" +
-	"\x13certificate_filters\x18\x06 \x03(\v2&.deployer.service.v1.CertificateFilterR\x12certificateFilters\x12P\n" +
+	"\x13certificate_filters\x18\x06 \x03(\v2&.deployer.service.v1.CertificateFilterR\x12certificateFilters\x12H\n" +
+	"\fretry_policy\x18\a \x01(\v2 .deployer.service.v1.RetryPolicyH\x05R\vretryPolicy\x88\x01\x01\x12W\n" +
+	"\x11revocation_policy\x18\b \x01(\x0e2%.deployer.service.v1.RevocationPolicyH\x06R\x10revocationPolicy\x88\x01\x01\x12P\n" +
 	"\x0econfigurations\x18\n" +
 	" \x03(\v2(.deployer.service.v1.TargetConfigurationR\x0econfigurations\x124\n" +
-	"\x13configuration_count\x18\v \x01(\x05H\x05R\x12configurationCount\x88\x01\x01\x12\"\n" +
+	"\x13configuration_count\x18\v \x01(\x05H\aR\x12configurationCount\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"created_by\x18d \x01(\rH\x06R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"created_by\x18d \x01(\rH\bR\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rH\aR\tupdatedBy\x88\x01\x01\x12A\n" +
-	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\bR\n" +
+	"updated_by\x18e \x01(\rH\tR\tupdatedBy\x88\x01\x01\x12A\n" +
+	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\n" +
+	"R\n" +
 	"createTime\x88\x01\x01\x12A\n" +
-	"\vupdate_time\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampH\tR\n" +
+	"\vupdate_time\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampH\vR\n" +
 	"updateTime\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_tenant_idB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x19\n" +
-	"\x17_auto_deploy_on_renewalB\x16\n" +
+	"\x17_auto_deploy_on_renewalB\x0f\n" +
+	"\r_retry_policyB\x14\n" +
+	"\x12_revocation_policyB\x16\n" +
 	"\x14_configuration_countB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\x0e\n" +
 	"\f_create_timeB\x0e\n" +
-	"\f_update_time\"\xf6\x02\n" +
+	"\f_update_time\"\xf2\x04\n" +
 	"\x13CreateTargetRequest\x12 \n" +
 	"\ttenant_id\x18\x01 \x01(\rB\x03\xe0A\x02R\btenantId\x12!\n" +
 	"\x04name\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04name\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x00R\vdescription\x88\x01\x01\x128\n" +
 	"\x16auto_deploy_on_renewal\x18\x04 \x01(\bH\x01R\x13autoDeployOnRenewal\x88\x01\x01\x12W\n" +
 	"\x13certificate_filters\x18\x05 \x03(\v2&.deployer.service.v1.CertificateFilterR\x12certificateFilters\x12+\n" +
-	"\x11configuration_ids\x18\x06 \x03(\tR\x10configurationIdsB\x0e\n" +
+	"\x11configuration_ids\x18\x06 \x03(\tR\x10configurationIds\x12H\n" +
+	"\fretry_policy\x18\a \x01(\v2 .deployer.service.v1.RetryPolicyH\x02R\vretryPolicy\x88\x01\x01\x12W\n" +
+	"\x11revocation_policy\x18\b \x01(\x0e2%.deployer.service.v1.RevocationPolicyH\x03R\x10revocationPolicy\x88\x01\x01\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x04R\x06reason\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\x19\n" +
-	"\x17_auto_deploy_on_renewal\"U\n" +
+	"\x17_auto_deploy_on_renewalB\x0f\n" +
+	"\r_retry_policyB\x14\n" +
+	"\x12_revocation_policyB\t\n" +
+	"\a_reason\"U\n" +
 	"\x14CreateTargetResponse\x12=\n" +
 	"\x06target\x18\x01 \x01(\v2%.deployer.service.v1.DeploymentTargetR\x06target\"~\n" +
 	"\x10GetTargetRequest\x12\x13\n" +
@@ -1190,29 +1353,41 @@ const file_deployer_service_v1_deployment_target_proto_rawDesc = "" +
 	"_page_size\"h\n" +
 	"\x13ListTargetsResponse\x12;\n" +
 	"\x05items\x18\x01 \x03(\v2%.deployer.service.v1.DeploymentTargetR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x04R\x05total\"\xc7\x02\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xc3\x04\n" +
 	"\x13UpdateTargetRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\x80\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x01R\vdescription\x88\x01\x01\x128\n" +
 	"\x16auto_deploy_on_renewal\x18\x04 \x01(\bH\x02R\x13autoDeployOnRenewal\x88\x01\x01\x12W\n" +
-	"\x13certificate_filters\x18\x05 \x03(\v2&.deployer.service.v1.CertificateFilterR\x12certificateFiltersB\a\n" +
+	"\x13certificate_filters\x18\x05 \x03(\v2&.deployer.service.v1.CertificateFilterR\x12certificateFilters\x12H\n" +
+	"\fretry_policy\x18\x06 \x01(\v2 .deployer.service.v1.RetryPolicyH\x03R\vretryPolicy\x88\x01\x01\x12W\n" +
+	"\x11revocation_policy\x18\a \x01(\x0e2%.deployer.service.v1.RevocationPolicyH\x04R\x10revocationPolicy\x88\x01\x01\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x05R\x06reason\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x19\n" +
-	"\x17_auto_deploy_on_renewal\"U\n" +
+	"\x17_auto_deploy_on_renewalB\x0f\n" +
+	"\r_retry_policyB\x14\n" +
+	"\x12_revocation_policyB\t\n" +
+	"\a_reason\"U\n" +
 	"\x14UpdateTargetResponse\x12=\n" +
-	"\x06target\x18\x01 \x01(\v2%.deployer.service.v1.DeploymentTargetR\x06target\"*\n" +
+	"\x06target\x18\x01 \x01(\v2%.deployer.service.v1.DeploymentTargetR\x06target\"\\\n" +
 	"\x13DeleteTargetRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"i\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\x9b\x01\n" +
 	"\x18AddConfigurationsRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x128\n" +
-	"\x11configuration_ids\x18\x02 \x03(\tB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\x10configurationIds\"Z\n" +
+	"\x11configuration_ids\x18\x02 \x03(\tB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\x10configurationIds\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"Z\n" +
 	"\x19AddConfigurationsResponse\x12=\n" +
-	"\x06target\x18\x01 \x01(\v2%.deployer.service.v1.DeploymentTargetR\x06target\"l\n" +
+	"\x06target\x18\x01 \x01(\v2%.deployer.service.v1.DeploymentTargetR\x06target\"\x9e\x01\n" +
 	"\x1bRemoveConfigurationsRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x128\n" +
-	"\x11configuration_ids\x18\x02 \x03(\tB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\x10configurationIds\"]\n" +
+	"\x11configuration_ids\x18\x02 \x03(\tB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\x10configurationIds\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"]\n" +
 	"\x1cRemoveConfigurationsResponse\x12=\n" +
 	"\x06target\x18\x01 \x01(\v2%.deployer.service.v1.DeploymentTargetR\x06target\"\x88\x01\n" +
 	"\x1fListTargetConfigurationsRequest\x12\x13\n" +
@@ -1225,7 +1400,11 @@ const file_deployer_service_v1_deployment_target_proto_rawDesc = "" +
 	"_page_size\"x\n" +
 	" ListTargetConfigurationsResponse\x12>\n" +
 	"\x05items\x18\x01 \x03(\v2(.deployer.service.v1.TargetConfigurationR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x04R\x05total2\xc7\t\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total*s\n" +
+	"\x10RevocationPolicy\x12!\n" +
+	"\x1dREVOCATION_POLICY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aREVOCATION_POLICY_REDEPLOY\x10\x01\x12\x1c\n" +
+	"\x18REVOCATION_POLICY_REMOVE\x10\x022\xc7\t\n" +
 	"\x17DeploymentTargetService\x12\x86\x01\n" +
 	"\fCreateTarget\x12(.deployer.service.v1.CreateTargetRequest\x1a).deployer.service.v1.CreateTargetResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/deployment-targets\x12\x7f\n" +
 	"\tGetTarget\x12%.deployer.service.v1.GetTargetRequest\x1a&.deployer.service.v1.GetTargetResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/deployment-targets/{id}\x12\x80\x01\n" +
@@ -1249,64 +1428,73 @@ func file_deployer_service_v1_deployment_target_proto_rawDescGZIP() []byte {
 	return file_deployer_service_v1_deployment_target_proto_rawDescData
 }
 
+var file_deployer_service_v1_deployment_target_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_deployer_service_v1_deployment_target_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_deployer_service_v1_deployment_target_proto_goTypes = []any{
-	(*CertificateFilter)(nil),                // 0: deployer.service.v1.CertificateFilter
-	(*DeploymentTarget)(nil),                 // 1: deployer.service.v1.DeploymentTarget
-	(*CreateTargetRequest)(nil),              // 2: deployer.service.v1.CreateTargetRequest
-	(*CreateTargetResponse)(nil),             // 3: deployer.service.v1.CreateTargetResponse
-	(*GetTargetRequest)(nil),                 // 4: deployer.service.v1.GetTargetRequest
-	(*GetTargetResponse)(nil),                // 5: deployer.service.v1.GetTargetResponse
-	(*ListTargetsRequest)(nil),               // 6: deployer.service.v1.ListTargetsRequest
-	(*ListTargetsResponse)(nil),              // 7: deployer.service.v1.ListTargetsResponse
-	(*UpdateTargetRequest)(nil),              // 8: deployer.service.v1.UpdateTargetRequest
-	(*UpdateTargetResponse)(nil),             // 9: deployer.service.v1.UpdateTargetResponse
-	(*DeleteTargetRequest)(nil),              // 10: deployer.service.v1.DeleteTargetRequest
-	(*AddConfigurationsRequest)(nil),         // 11: deployer.service.v1.AddConfigurationsRequest
-	(*AddConfigurationsResponse)(nil),        // 12: deployer.service.v1.AddConfigurationsResponse
-	(*RemoveConfigurationsRequest)(nil),      // 13: deployer.service.v1.RemoveConfigurationsRequest
-	(*RemoveConfigurationsResponse)(nil),     // 14: deployer.service.v1.RemoveConfigurationsResponse
-	(*ListTargetConfigurationsRequest)(nil),  // 15: deployer.service.v1.ListTargetConfigurationsRequest
-	(*ListTargetConfigurationsResponse)(nil), // 16: deployer.service.v1.ListTargetConfigurationsResponse
-	(*TargetConfiguration)(nil),              // 17: deployer.service.v1.TargetConfiguration
-	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 19: google.protobuf.Empty
+	(RevocationPolicy)(0),                    // 0: deployer.service.v1.RevocationPolicy
+	(*CertificateFilter)(nil),                // 1: deployer.service.v1.CertificateFilter
+	(*DeploymentTarget)(nil),                 // 2: deployer.service.v1.DeploymentTarget
+	(*CreateTargetRequest)(nil),              // 3: deployer.service.v1.CreateTargetRequest
+	(*CreateTargetResponse)(nil),             // 4: deployer.service.v1.CreateTargetResponse
+	(*GetTargetRequest)(nil),                 // 5: deployer.service.v1.GetTargetRequest
+	(*GetTargetResponse)(nil),                // 6: deployer.service.v1.GetTargetResponse
+	(*ListTargetsRequest)(nil),               // 7: deployer.service.v1.ListTargetsRequest
+	(*ListTargetsResponse)(nil),              // 8: deployer.service.v1.ListTargetsResponse
+	(*UpdateTargetRequest)(nil),              // 9: deployer.service.v1.UpdateTargetRequest
+	(*UpdateTargetResponse)(nil),             // 10: deployer.service.v1.UpdateTargetResponse
+	(*DeleteTargetRequest)(nil),              // 11: deployer.service.v1.DeleteTargetRequest
+	(*AddConfigurationsRequest)(nil),         // 12: deployer.service.v1.AddConfigurationsRequest
+	(*AddConfigurationsResponse)(nil),        // 13: deployer.service.v1.AddConfigurationsResponse
+	(*RemoveConfigurationsRequest)(nil),      // 14: deployer.service.v1.RemoveConfigurationsRequest
+	(*RemoveConfigurationsResponse)(nil),     // 15: deployer.service.v1.RemoveConfigurationsResponse
+	(*ListTargetConfigurationsRequest)(nil),  // 16: deployer.service.v1.ListTargetConfigurationsRequest
+	(*ListTargetConfigurationsResponse)(nil), // 17: deployer.service.v1.ListTargetConfigurationsResponse
+	(*RetryPolicy)(nil),                      // 18: deployer.service.v1.RetryPolicy
+	(*TargetConfiguration)(nil),              // 19: deployer.service.v1.TargetConfiguration
+	(*timestamppb.Timestamp)(nil),            // 20: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 21: google.protobuf.Empty
 }
 var file_deployer_service_v1_deployment_target_proto_depIdxs = []int32{
-	0,  // 0: deployer.service.v1.DeploymentTarget.certificate_filters:type_name -> deployer.service.v1.CertificateFilter
-	17, // 1: deployer.service.v1.DeploymentTarget.configurations:type_name -> deployer.service.v1.TargetConfiguration
-	18, // 2: deployer.service.v1.DeploymentTarget.create_time:type_name -> google.protobuf.Timestamp
-	18, // 3: deployer.service.v1.DeploymentTarget.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 4: deployer.service.v1.CreateTargetRequest.certificate_filters:type_name -> deployer.service.v1.CertificateFilter
-	1,  // 5: deployer.service.v1.CreateTargetResponse.target:type_name -> deployer.service.v1.DeploymentTarget
-	1,  // 6: deployer.service.v1.GetTargetResponse.target:type_name -> deployer.service.v1.DeploymentTarget
-	1,  // 7: deployer.service.v1.ListTargetsResponse.items:type_name -> deployer.service.v1.DeploymentTarget
-	0,  // 8: deployer.service.v1.UpdateTargetRequest.certificate_filters:type_name -> deployer.service.v1.CertificateFilter
-	1,  // 9: deployer.service.v1.UpdateTargetResponse.target:type_name -> deployer.service.v1.DeploymentTarget
-	1,  // 10: deployer.service.v1.AddConfigurationsResponse.target:type_name -> deployer.service.v1.DeploymentTarget
-	1,  // 11: deployer.service.v1.RemoveConfigurationsResponse.target:type_name -> deployer.service.v1.DeploymentTarget
-	17, // 12: deployer.service.v1.ListTargetConfigurationsResponse.items:type_name -> deployer.service.v1.TargetConfiguration
-	2,  // 13: deployer.service.v1.DeploymentTargetService.CreateTarget:input_type -> deployer.service.v1.CreateTargetRequest
-	4,  // 14: deployer.service.v1.DeploymentTargetService.GetTarget:input_type -> deployer.service.v1.GetTargetRequest
-	6,  // 15: deployer.service.v1.DeploymentTargetService.ListTargets:input_type -> deployer.service.v1.ListTargetsRequest
-	8,  // 16: deployer.service.v1.DeploymentTargetService.UpdateTarget:input_type -> deployer.service.v1.UpdateTargetRequest
-	10, // 17: deployer.service.v1.DeploymentTargetService.DeleteTarget:input_type -> deployer.service.v1.DeleteTargetRequest
-	11, // 18: deployer.service.v1.DeploymentTargetService.AddConfigurations:input_type -> deployer.service.v1.AddConfigurationsRequest
-	13, // 19: deployer.service.v1.DeploymentTargetService.RemoveConfigurations:input_type -> deployer.service.v1.RemoveConfigurationsRequest
-	15, // 20: deployer.service.v1.DeploymentTargetService.ListTargetConfigurations:input_type -> deployer.service.v1.ListTargetConfigurationsRequest
-	3,  // 21: deployer.service.v1.DeploymentTargetService.CreateTarget:output_type -> deployer.service.v1.CreateTargetResponse
-	5,  // 22: deployer.service.v1.DeploymentTargetService.GetTarget:output_type -> deployer.service.v1.GetTargetResponse
-	7,  // 23: deployer.service.v1.DeploymentTargetService.ListTargets:output_type -> deployer.service.v1.ListTargetsResponse
-	9,  // 24: deployer.service.v1.DeploymentTargetService.UpdateTarget:output_type -> deployer.service.v1.UpdateTargetResponse
-	19, // 25: deployer.service.v1.DeploymentTargetService.DeleteTarget:output_type -> google.protobuf.Empty
-	12, // 26: deployer.service.v1.DeploymentTargetService.AddConfigurations:output_type -> deployer.service.v1.AddConfigurationsResponse
-	14, // 27: deployer.service.v1.DeploymentTargetService.RemoveConfigurations:output_type -> deployer.service.v1.RemoveConfigurationsResponse
-	16, // 28: deployer.service.v1.DeploymentTargetService.ListTargetConfigurations:output_type -> deployer.service.v1.ListTargetConfigurationsResponse
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	1,  // 0: deployer.service.v1.DeploymentTarget.certificate_filters:type_name -> deployer.service.v1.CertificateFilter
+	18, // 1: deployer.service.v1.DeploymentTarget.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	0,  // 2: deployer.service.v1.DeploymentTarget.revocation_policy:type_name -> deployer.service.v1.RevocationPolicy
+	19, // 3: deployer.service.v1.DeploymentTarget.configurations:type_name -> deployer.service.v1.TargetConfiguration
+	20, // 4: deployer.service.v1.DeploymentTarget.create_time:type_name -> google.protobuf.Timestamp
+	20, // 5: deployer.service.v1.DeploymentTarget.update_time:type_name -> google.protobuf.Timestamp
+	1,  // 6: deployer.service.v1.CreateTargetRequest.certificate_filters:type_name -> deployer.service.v1.CertificateFilter
+	18, // 7: deployer.service.v1.CreateTargetRequest.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	0,  // 8: deployer.service.v1.CreateTargetRequest.revocation_policy:type_name -> deployer.service.v1.RevocationPolicy
+	2,  // 9: deployer.service.v1.CreateTargetResponse.target:type_name -> deployer.service.v1.DeploymentTarget
+	2,  // 10: deployer.service.v1.GetTargetResponse.target:type_name -> deployer.service.v1.DeploymentTarget
+	2,  // 11: deployer.service.v1.ListTargetsResponse.items:type_name -> deployer.service.v1.DeploymentTarget
+	1,  // 12: deployer.service.v1.UpdateTargetRequest.certificate_filters:type_name -> deployer.service.v1.CertificateFilter
+	18, // 13: deployer.service.v1.UpdateTargetRequest.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	0,  // 14: deployer.service.v1.UpdateTargetRequest.revocation_policy:type_name -> deployer.service.v1.RevocationPolicy
+	2,  // 15: deployer.service.v1.UpdateTargetResponse.target:type_name -> deployer.service.v1.DeploymentTarget
+	2,  // 16: deployer.service.v1.AddConfigurationsResponse.target:type_name -> deployer.service.v1.DeploymentTarget
+	2,  // 17: deployer.service.v1.RemoveConfigurationsResponse.target:type_name -> deployer.service.v1.DeploymentTarget
+	19, // 18: deployer.service.v1.ListTargetConfigurationsResponse.items:type_name -> deployer.service.v1.TargetConfiguration
+	3,  // 19: deployer.service.v1.DeploymentTargetService.CreateTarget:input_type -> deployer.service.v1.CreateTargetRequest
+	5,  // 20: deployer.service.v1.DeploymentTargetService.GetTarget:input_type -> deployer.service.v1.GetTargetRequest
+	7,  // 21: deployer.service.v1.DeploymentTargetService.ListTargets:input_type -> deployer.service.v1.ListTargetsRequest
+	9,  // 22: deployer.service.v1.DeploymentTargetService.UpdateTarget:input_type -> deployer.service.v1.UpdateTargetRequest
+	11, // 23: deployer.service.v1.DeploymentTargetService.DeleteTarget:input_type -> deployer.service.v1.DeleteTargetRequest
+	12, // 24: deployer.service.v1.DeploymentTargetService.AddConfigurations:input_type -> deployer.service.v1.AddConfigurationsRequest
+	14, // 25: deployer.service.v1.DeploymentTargetService.RemoveConfigurations:input_type -> deployer.service.v1.RemoveConfigurationsRequest
+	16, // 26: deployer.service.v1.DeploymentTargetService.ListTargetConfigurations:input_type -> deployer.service.v1.ListTargetConfigurationsRequest
+	4,  // 27: deployer.service.v1.DeploymentTargetService.CreateTarget:output_type -> deployer.service.v1.CreateTargetResponse
+	6,  // 28: deployer.service.v1.DeploymentTargetService.GetTarget:output_type -> deployer.service.v1.GetTargetResponse
+	8,  // 29: deployer.service.v1.DeploymentTargetService.ListTargets:output_type -> deployer.service.v1.ListTargetsResponse
+	10, // 30: deployer.service.v1.DeploymentTargetService.UpdateTarget:output_type -> deployer.service.v1.UpdateTargetResponse
+	21, // 31: deployer.service.v1.DeploymentTargetService.DeleteTarget:output_type -> google.protobuf.Empty
+	13, // 32: deployer.service.v1.DeploymentTargetService.AddConfigurations:output_type -> deployer.service.v1.AddConfigurationsResponse
+	15, // 33: deployer.service.v1.DeploymentTargetService.RemoveConfigurations:output_type -> deployer.service.v1.RemoveConfigurationsResponse
+	17, // 34: deployer.service.v1.DeploymentTargetService.ListTargetConfigurations:output_type -> deployer.service.v1.ListTargetConfigurationsResponse
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_deployer_service_v1_deployment_target_proto_init() }
@@ -1314,6 +1502,7 @@ func file_deployer_service_v1_deployment_target_proto_init() {
 	if File_deployer_service_v1_deployment_target_proto != nil {
 		return
 	}
+	file_deployer_service_v1_retry_policy_proto_init()
 	file_deployer_service_v1_target_configuration_proto_init()
 	file_deployer_service_v1_deployment_target_proto_msgTypes[0].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_target_proto_msgTypes[1].OneofWrappers = []any{}
@@ -1321,19 +1510,23 @@ func file_deployer_service_v1_deployment_target_proto_init() {
 	file_deployer_service_v1_deployment_target_proto_msgTypes[4].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_target_proto_msgTypes[6].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_target_proto_msgTypes[8].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_target_proto_msgTypes[10].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_target_proto_msgTypes[11].OneofWrappers = []any{}
+	file_deployer_service_v1_deployment_target_proto_msgTypes[13].OneofWrappers = []any{}
 	file_deployer_service_v1_deployment_target_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deployer_service_v1_deployment_target_proto_rawDesc), len(file_deployer_service_v1_deployment_target_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_deployer_service_v1_deployment_target_proto_goTypes,
 		DependencyIndexes: file_deployer_service_v1_deployment_target_proto_depIdxs,
+		EnumInfos:         file_deployer_service_v1_deployment_target_proto_enumTypes,
 		MessageInfos:      file_deployer_service_v1_deployment_target_proto_msgTypes,
 	}.Build()
 	File_deployer_service_v1_deployment_target_proto = out.File
@@ -176,6 +176,10 @@ func (x *DeploymentTarget) Redact() string {
 
 	// Safe field: CertificateFilters
 
+	// Safe field: RetryPolicy
+
+	// Safe field: RevocationPolicy
+
 	// Safe field: Configurations
 
 	// Safe field: ConfigurationCount
@@ -207,6 +211,12 @@ func (x *CreateTargetRequest) Redact() string {
 	// Safe field: CertificateFilters
 
 	// Safe field: ConfigurationIds
+
+	// Safe field: RetryPolicy
+
+	// Safe field: RevocationPolicy
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -287,6 +297,12 @@ func (x *UpdateTargetRequest) Redact() string {
 	// Safe field: AutoDeployOnRenewal
 
 	// Safe field: CertificateFilters
+
+	// Safe field: RetryPolicy
+
+	// Safe field: RevocationPolicy
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -307,6 +323,8 @@ func (x *DeleteTargetRequest) Redact() string {
 	}
 
 	// Safe field: Id
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -319,6 +337,8 @@ func (x *AddConfigurationsRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: ConfigurationIds
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -341,6 +361,8 @@ func (x *RemoveConfigurationsRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: ConfigurationIds
+
+	// Safe field: Reason
 	return x.String()
 }
 
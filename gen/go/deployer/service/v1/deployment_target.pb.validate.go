@@ -275,6 +275,43 @@ func (m *DeploymentTarget) validate(all bool) error {
 		// no validation rules for AutoDeployOnRenewal
 	}
 
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentTargetValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentTargetValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentTargetValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RevocationPolicy != nil {
+		// no validation rules for RevocationPolicy
+	}
+
 	if m.ConfigurationCount != nil {
 		// no validation rules for ConfigurationCount
 	}
@@ -499,6 +536,47 @@ func (m *CreateTargetRequest) validate(all bool) error {
 		// no validation rules for AutoDeployOnRenewal
 	}
 
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTargetRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTargetRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTargetRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RevocationPolicy != nil {
+		// no validation rules for RevocationPolicy
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
 		return CreateTargetRequestMultiError(errors)
 	}
@@ -1277,6 +1355,47 @@ func (m *UpdateTargetRequest) validate(all bool) error {
 		// no validation rules for AutoDeployOnRenewal
 	}
 
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateTargetRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateTargetRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateTargetRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RevocationPolicy != nil {
+		// no validation rules for RevocationPolicy
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
 		return UpdateTargetRequestMultiError(errors)
 	}
@@ -1512,6 +1631,10 @@ func (m *DeleteTargetRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
 		return DeleteTargetRequestMultiError(errors)
 	}
@@ -1616,6 +1739,10 @@ func (m *AddConfigurationsRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
 		return AddConfigurationsRequestMultiError(errors)
 	}
@@ -1851,6 +1978,10 @@ func (m *RemoveConfigurationsRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
 		return RemoveConfigurationsRequestMultiError(errors)
 	}
@@ -196,9 +196,11 @@ type JobStatistics struct {
 	// Jobs in the last 24 hours
 	Last_24Hours *JobTimeBreakdown `protobuf:"bytes,11,opt,name=last_24_hours,json=last24Hours,proto3" json:"last_24_hours,omitempty"`
 	// Jobs in the last 7 days
-	Last_7Days    *JobTimeBreakdown `protobuf:"bytes,12,opt,name=last_7_days,json=last7Days,proto3" json:"last_7_days,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Last_7Days *JobTimeBreakdown `protobuf:"bytes,12,opt,name=last_7_days,json=last7Days,proto3" json:"last_7_days,omitempty"`
+	// Failed jobs grouped by error category (auth, transient, ...)
+	FailuresByCategory map[string]int64 `protobuf:"bytes,13,rep,name=failures_by_category,json=failuresByCategory,proto3" json:"failures_by_category,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *JobStatistics) Reset() {
@@ -315,6 +317,13 @@ func (x *JobStatistics) GetLast_7Days() *JobTimeBreakdown {
 	return nil
 }
 
+func (x *JobStatistics) GetFailuresByCategory() map[string]int64 {
+	if x != nil {
+		return x.FailuresByCategory
+	}
+	return nil
+}
+
 // JobTimeBreakdown provides job counts within a time period
 type JobTimeBreakdown struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -561,7 +570,9 @@ type RecentError struct {
 	// Tenant ID
 	TenantId uint32 `protobuf:"varint,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	// Provider type
-	ProviderType  string `protobuf:"bytes,8,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	ProviderType string `protobuf:"bytes,8,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	// Error category (auth, transient, ...)
+	ErrorCategory string `protobuf:"bytes,9,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -652,6 +663,13 @@ func (x *RecentError) GetProviderType() string {
 	return ""
 }
 
+func (x *RecentError) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
 // GetTenantStatisticsRequest is the request for GetTenantStatistics
 type GetTenantStatisticsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -788,7 +806,7 @@ const file_deployer_service_v1_statistics_proto_rawDesc = "" +
 	"\x0econfigurations\x18\x03 \x01(\v2,.deployer.service.v1.ConfigurationStatisticsR\x0econfigurations\x12E\n" +
 	"\rrecent_errors\x18\x04 \x03(\v2 .deployer.service.v1.RecentErrorR\frecentErrors\x12P\n" +
 	"\x10tenant_breakdown\x18\x05 \x03(\v2%.deployer.service.v1.TenantStatisticsR\x0ftenantBreakdown\x12=\n" +
-	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\x80\x06\n" +
+	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xb5\a\n" +
 	"\rJobStatistics\x12\x1f\n" +
 	"\vtotal_count\x18\x01 \x01(\x03R\n" +
 	"totalCount\x12#\n" +
@@ -803,12 +821,16 @@ const file_deployer_service_v1_statistics_proto_rawDesc = "" +
 	"\x0fby_trigger_type\x18\n" +
 	" \x03(\v25.deployer.service.v1.JobStatistics.ByTriggerTypeEntryR\rbyTriggerType\x12I\n" +
 	"\rlast_24_hours\x18\v \x01(\v2%.deployer.service.v1.JobTimeBreakdownR\vlast24Hours\x12E\n" +
-	"\vlast_7_days\x18\f \x01(\v2%.deployer.service.v1.JobTimeBreakdownR\tlast7Days\x1a;\n" +
+	"\vlast_7_days\x18\f \x01(\v2%.deployer.service.v1.JobTimeBreakdownR\tlast7Days\x12l\n" +
+	"\x14failures_by_category\x18\r \x03(\v2:.deployer.service.v1.JobStatistics.FailuresByCategoryEntryR\x12failuresByCategory\x1a;\n" +
 	"\rByStatusEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a@\n" +
 	"\x12ByTriggerTypeEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aE\n" +
+	"\x17FailuresByCategoryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x81\x01\n" +
 	"\x10JobTimeBreakdown\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1c\n" +
@@ -834,7 +856,7 @@ const file_deployer_service_v1_statistics_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aA\n" +
 	"\x13ByProviderTypeEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xc9\x02\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xf0\x02\n" +
 	"\vRecentError\x12;\n" +
 	"\voccurred_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"occurredAt\x12\x15\n" +
@@ -844,7 +866,8 @@ const file_deployer_service_v1_statistics_proto_rawDesc = "" +
 	"\x0ecertificate_id\x18\x05 \x01(\tR\rcertificateId\x12#\n" +
 	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12\x1b\n" +
 	"\ttenant_id\x18\a \x01(\rR\btenantId\x12#\n" +
-	"\rprovider_type\x18\b \x01(\tR\fproviderType\"J\n" +
+	"\rprovider_type\x18\b \x01(\tR\fproviderType\x12%\n" +
+	"\x0eerror_category\x18\t \x01(\tR\rerrorCategory\"J\n" +
 	"\x1aGetTenantStatisticsRequest\x12,\n" +
 	"\ttenant_id\x18\x01 \x01(\rB\x0f\xbaG\f\x92\x02\tTenant IDR\btenantId\"\xfe\x01\n" +
 	"\x10TenantStatistics\x12\x1b\n" +
@@ -869,7 +892,7 @@ func file_deployer_service_v1_statistics_proto_rawDescGZIP() []byte {
 	return file_deployer_service_v1_statistics_proto_rawDescData
 }
 
-var file_deployer_service_v1_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_deployer_service_v1_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_deployer_service_v1_statistics_proto_goTypes = []any{
 	(*GetStatisticsRequest)(nil),       // 0: deployer.service.v1.GetStatisticsRequest
 	(*GetStatisticsResponse)(nil),      // 1: deployer.service.v1.GetStatisticsResponse
@@ -882,9 +905,10 @@ var file_deployer_service_v1_statistics_proto_goTypes = []any{
 	(*TenantStatistics)(nil),           // 8: deployer.service.v1.TenantStatistics
 	nil,                                // 9: deployer.service.v1.JobStatistics.ByStatusEntry
 	nil,                                // 10: deployer.service.v1.JobStatistics.ByTriggerTypeEntry
-	nil,                                // 11: deployer.service.v1.ConfigurationStatistics.ByStatusEntry
-	nil,                                // 12: deployer.service.v1.ConfigurationStatistics.ByProviderTypeEntry
-	(*timestamppb.Timestamp)(nil),      // 13: google.protobuf.Timestamp
+	nil,                                // 11: deployer.service.v1.JobStatistics.FailuresByCategoryEntry
+	nil,                                // 12: deployer.service.v1.ConfigurationStatistics.ByStatusEntry
+	nil,                                // 13: deployer.service.v1.ConfigurationStatistics.ByProviderTypeEntry
+	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
 }
 var file_deployer_service_v1_statistics_proto_depIdxs = []int32{
 	2,  // 0: deployer.service.v1.GetStatisticsResponse.jobs:type_name -> deployer.service.v1.JobStatistics
@@ -892,26 +916,27 @@ var file_deployer_service_v1_statistics_proto_depIdxs = []int32{
 	5,  // 2: deployer.service.v1.GetStatisticsResponse.configurations:type_name -> deployer.service.v1.ConfigurationStatistics
 	6,  // 3: deployer.service.v1.GetStatisticsResponse.recent_errors:type_name -> deployer.service.v1.RecentError
 	8,  // 4: deployer.service.v1.GetStatisticsResponse.tenant_breakdown:type_name -> deployer.service.v1.TenantStatistics
-	13, // 5: deployer.service.v1.GetStatisticsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	14, // 5: deployer.service.v1.GetStatisticsResponse.generated_at:type_name -> google.protobuf.Timestamp
 	9,  // 6: deployer.service.v1.JobStatistics.by_status:type_name -> deployer.service.v1.JobStatistics.ByStatusEntry
 	10, // 7: deployer.service.v1.JobStatistics.by_trigger_type:type_name -> deployer.service.v1.JobStatistics.ByTriggerTypeEntry
 	3,  // 8: deployer.service.v1.JobStatistics.last_24_hours:type_name -> deployer.service.v1.JobTimeBreakdown
 	3,  // 9: deployer.service.v1.JobStatistics.last_7_days:type_name -> deployer.service.v1.JobTimeBreakdown
-	11, // 10: deployer.service.v1.ConfigurationStatistics.by_status:type_name -> deployer.service.v1.ConfigurationStatistics.ByStatusEntry
-	12, // 11: deployer.service.v1.ConfigurationStatistics.by_provider_type:type_name -> deployer.service.v1.ConfigurationStatistics.ByProviderTypeEntry
-	13, // 12: deployer.service.v1.RecentError.occurred_at:type_name -> google.protobuf.Timestamp
-	2,  // 13: deployer.service.v1.TenantStatistics.jobs:type_name -> deployer.service.v1.JobStatistics
-	4,  // 14: deployer.service.v1.TenantStatistics.targets:type_name -> deployer.service.v1.TargetStatistics
-	5,  // 15: deployer.service.v1.TenantStatistics.configurations:type_name -> deployer.service.v1.ConfigurationStatistics
-	0,  // 16: deployer.service.v1.DeployerStatisticsService.GetStatistics:input_type -> deployer.service.v1.GetStatisticsRequest
-	7,  // 17: deployer.service.v1.DeployerStatisticsService.GetTenantStatistics:input_type -> deployer.service.v1.GetTenantStatisticsRequest
-	1,  // 18: deployer.service.v1.DeployerStatisticsService.GetStatistics:output_type -> deployer.service.v1.GetStatisticsResponse
-	8,  // 19: deployer.service.v1.DeployerStatisticsService.GetTenantStatistics:output_type -> deployer.service.v1.TenantStatistics
-	18, // [18:20] is the sub-list for method output_type
-	16, // [16:18] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	11, // 10: deployer.service.v1.JobStatistics.failures_by_category:type_name -> deployer.service.v1.JobStatistics.FailuresByCategoryEntry
+	12, // 11: deployer.service.v1.ConfigurationStatistics.by_status:type_name -> deployer.service.v1.ConfigurationStatistics.ByStatusEntry
+	13, // 12: deployer.service.v1.ConfigurationStatistics.by_provider_type:type_name -> deployer.service.v1.ConfigurationStatistics.ByProviderTypeEntry
+	14, // 13: deployer.service.v1.RecentError.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 14: deployer.service.v1.TenantStatistics.jobs:type_name -> deployer.service.v1.JobStatistics
+	4,  // 15: deployer.service.v1.TenantStatistics.targets:type_name -> deployer.service.v1.TargetStatistics
+	5,  // 16: deployer.service.v1.TenantStatistics.configurations:type_name -> deployer.service.v1.ConfigurationStatistics
+	0,  // 17: deployer.service.v1.DeployerStatisticsService.GetStatistics:input_type -> deployer.service.v1.GetStatisticsRequest
+	7,  // 18: deployer.service.v1.DeployerStatisticsService.GetTenantStatistics:input_type -> deployer.service.v1.GetTenantStatisticsRequest
+	1,  // 19: deployer.service.v1.DeployerStatisticsService.GetStatistics:output_type -> deployer.service.v1.GetStatisticsResponse
+	8,  // 20: deployer.service.v1.DeployerStatisticsService.GetTenantStatistics:output_type -> deployer.service.v1.TenantStatistics
+	19, // [19:21] is the sub-list for method output_type
+	17, // [17:19] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_deployer_service_v1_statistics_proto_init() }
@@ -926,7 +951,7 @@ func file_deployer_service_v1_statistics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deployer_service_v1_statistics_proto_rawDesc), len(file_deployer_service_v1_statistics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -123,6 +123,8 @@ func (x *JobStatistics) Redact() string {
 	// Safe field: Last_24Hours
 
 	// Safe field: Last_7Days
+
+	// Safe field: FailuresByCategory
 	return x.String()
 }
 
@@ -197,6 +199,8 @@ func (x *RecentError) Redact() string {
 	// Safe field: TenantId
 
 	// Safe field: ProviderType
+
+	// Safe field: ErrorCategory
 	return x.String()
 }
 
@@ -531,6 +531,8 @@ func (m *JobStatistics) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for FailuresByCategory
+
 	if len(errors) > 0 {
 		return JobStatisticsMultiError(errors)
 	}
@@ -1002,6 +1004,8 @@ func (m *RecentError) validate(all bool) error {
 
 	// no validation rules for ProviderType
 
+	// no validation rules for ErrorCategory
+
 	if len(errors) > 0 {
 		return RecentErrorMultiError(errors)
 	}
@@ -80,6 +80,69 @@ func (ConfigurationStatus) EnumDescriptor() ([]byte, []int) {
 	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{0}
 }
 
+// Write-only view of a credential field: whether it is set, never its value
+type CredentialFieldStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Set   bool                   `protobuf:"varint,1,opt,name=set,proto3" json:"set,omitempty"`
+	// Required by the provider
+	Required bool `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	// Absent for credentials stored before rotation tracking
+	LastRotatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_rotated_at,json=lastRotatedAt,proto3,oneof" json:"last_rotated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialFieldStatus) Reset() {
+	*x = CredentialFieldStatus{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialFieldStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialFieldStatus) ProtoMessage() {}
+
+func (x *CredentialFieldStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialFieldStatus.ProtoReflect.Descriptor instead.
+func (*CredentialFieldStatus) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CredentialFieldStatus) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+func (x *CredentialFieldStatus) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CredentialFieldStatus) GetLastRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotatedAt
+	}
+	return nil
+}
+
 // Target configuration entity - represents a single deployment endpoint
 type TargetConfiguration struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -92,17 +155,43 @@ type TargetConfiguration struct {
 	Status           *ConfigurationStatus   `protobuf:"varint,7,opt,name=status,proto3,enum=deployer.service.v1.ConfigurationStatus,oneof" json:"status,omitempty"`
 	StatusMessage    *string                `protobuf:"bytes,8,opt,name=status_message,json=statusMessage,proto3,oneof" json:"status_message,omitempty"`
 	LastDeploymentAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_deployment_at,json=lastDeploymentAt,proto3,oneof" json:"last_deployment_at,omitempty"`
-	CreatedBy        *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	UpdatedBy        *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
-	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
-	UpdateTime       *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Current revision number
+	Revision *uint32 `protobuf:"varint,10,opt,name=revision,proto3,oneof" json:"revision,omitempty"`
+	// Credential fields keyed by name: the set fields and the provider's required ones
+	Credentials map[string]*CredentialFieldStatus `protobuf:"bytes,11,rep,name=credentials,proto3" json:"credentials,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Retry and timeout overrides for jobs deploying to this configuration
+	RetryPolicy *RetryPolicy `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Deployment attempts failed in a row; the circuit opens (status ERROR) at the configured threshold
+	ConsecutiveFailures *int32 `protobuf:"varint,13,opt,name=consecutive_failures,json=consecutiveFailures,proto3,oneof" json:"consecutive_failures,omitempty"`
+	// When an open circuit is next probed by validating the credentials
+	NextProbeAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=next_probe_at,json=nextProbeAt,proto3,oneof" json:"next_probe_at,omitempty"`
+	// When the scheduled health check last validated the credentials against the device
+	LastValidatedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_validated_at,json=lastValidatedAt,proto3,oneof" json:"last_validated_at,omitempty"`
+	// Error of the last credential validation; empty when it passed
+	LastValidationError *string `protobuf:"bytes,16,opt,name=last_validation_error,json=lastValidationError,proto3,oneof" json:"last_validation_error,omitempty"`
+	// When the next scheduled credential health check is due
+	NextValidationAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=next_validation_at,json=nextValidationAt,proto3,oneof" json:"next_validation_at,omitempty"`
+	// host:port whose served certificate the drift detector compares with the deployed one
+	TlsProbeAddress *string `protobuf:"bytes,18,opt,name=tls_probe_address,json=tlsProbeAddress,proto3,oneof" json:"tls_probe_address,omitempty"`
+	// When the drift detector last checked the device
+	LastDriftCheckAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_drift_check_at,json=lastDriftCheckAt,proto3,oneof" json:"last_drift_check_at,omitempty"`
+	// When the drift detector next checks the device
+	NextDriftCheckAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=next_drift_check_at,json=nextDriftCheckAt,proto3,oneof" json:"next_drift_check_at,omitempty"`
+	// Certificate of the last successful deployment
+	DeployedCertificateId *string `protobuf:"bytes,21,opt,name=deployed_certificate_id,json=deployedCertificateId,proto3,oneof" json:"deployed_certificate_id,omitempty"`
+	// Expiry of the deployed certificate; unset until known
+	DeployedCertificateExpiresAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=deployed_certificate_expires_at,json=deployedCertificateExpiresAt,proto3,oneof" json:"deployed_certificate_expires_at,omitempty"`
+	CreatedBy                    *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy                    *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	CreateTime                   *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
+	UpdateTime                   *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *TargetConfiguration) Reset() {
 	*x = TargetConfiguration{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[0]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -114,7 +203,7 @@ func (x *TargetConfiguration) String() string {
 func (*TargetConfiguration) ProtoMessage() {}
 
 func (x *TargetConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[0]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -127,7 +216,7 @@ func (x *TargetConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetConfiguration.ProtoReflect.Descriptor instead.
 func (*TargetConfiguration) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{0}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *TargetConfiguration) GetId() string {
@@ -193,6 +282,97 @@ func (x *TargetConfiguration) GetLastDeploymentAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TargetConfiguration) GetRevision() uint32 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+func (x *TargetConfiguration) GetCredentials() map[string]*CredentialFieldStatus {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetConsecutiveFailures() int32 {
+	if x != nil && x.ConsecutiveFailures != nil {
+		return *x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *TargetConfiguration) GetNextProbeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProbeAt
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetLastValidatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastValidatedAt
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetLastValidationError() string {
+	if x != nil && x.LastValidationError != nil {
+		return *x.LastValidationError
+	}
+	return ""
+}
+
+func (x *TargetConfiguration) GetNextValidationAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextValidationAt
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetTlsProbeAddress() string {
+	if x != nil && x.TlsProbeAddress != nil {
+		return *x.TlsProbeAddress
+	}
+	return ""
+}
+
+func (x *TargetConfiguration) GetLastDriftCheckAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDriftCheckAt
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetNextDriftCheckAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDriftCheckAt
+	}
+	return nil
+}
+
+func (x *TargetConfiguration) GetDeployedCertificateId() string {
+	if x != nil && x.DeployedCertificateId != nil {
+		return *x.DeployedCertificateId
+	}
+	return ""
+}
+
+func (x *TargetConfiguration) GetDeployedCertificateExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeployedCertificateExpiresAt
+	}
+	return nil
+}
+
 func (x *TargetConfiguration) GetCreatedBy() uint32 {
 	if x != nil && x.CreatedBy != nil {
 		return *x.CreatedBy
@@ -231,13 +411,23 @@ type ProviderInfo struct {
 	SupportsRollback         bool                   `protobuf:"varint,5,opt,name=supports_rollback,json=supportsRollback,proto3" json:"supports_rollback,omitempty"`
 	RequiredConfigFields     []string               `protobuf:"bytes,6,rep,name=required_config_fields,json=requiredConfigFields,proto3" json:"required_config_fields,omitempty"`
 	RequiredCredentialFields []string               `protobuf:"bytes,7,rep,name=required_credential_fields,json=requiredCredentialFields,proto3" json:"required_credential_fields,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// JSON Schema of the configuration, with descriptions and defaults of every field
+	ConfigSchema *structpb.Struct `protobuf:"bytes,8,opt,name=config_schema,json=configSchema,proto3,oneof" json:"config_schema,omitempty"`
+	// JSON Schema of the credentials
+	CredentialSchema *structpb.Struct `protobuf:"bytes,9,opt,name=credential_schema,json=credentialSchema,proto3,oneof" json:"credential_schema,omitempty"`
+	// Whether deployed certificates can be removed from the target
+	SupportsRemoval bool `protobuf:"varint,10,opt,name=supports_removal,json=supportsRemoval,proto3" json:"supports_removal,omitempty"`
+	// Whether deployments can be previewed with PlanDeployment
+	SupportsPlan bool `protobuf:"varint,11,opt,name=supports_plan,json=supportsPlan,proto3" json:"supports_plan,omitempty"`
+	// Whether the certificates on the device can be listed with DiscoverInventory
+	SupportsInventory bool `protobuf:"varint,12,opt,name=supports_inventory,json=supportsInventory,proto3" json:"supports_inventory,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ProviderInfo) Reset() {
 	*x = ProviderInfo{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[1]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -249,7 +439,7 @@ func (x *ProviderInfo) String() string {
 func (*ProviderInfo) ProtoMessage() {}
 
 func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[1]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +452,7 @@ func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderInfo.ProtoReflect.Descriptor instead.
 func (*ProviderInfo) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{1}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ProviderInfo) GetType() string {
@@ -314,22 +504,63 @@ func (x *ProviderInfo) GetRequiredCredentialFields() []string {
 	return nil
 }
 
+func (x *ProviderInfo) GetConfigSchema() *structpb.Struct {
+	if x != nil {
+		return x.ConfigSchema
+	}
+	return nil
+}
+
+func (x *ProviderInfo) GetCredentialSchema() *structpb.Struct {
+	if x != nil {
+		return x.CredentialSchema
+	}
+	return nil
+}
+
+func (x *ProviderInfo) GetSupportsRemoval() bool {
+	if x != nil {
+		return x.SupportsRemoval
+	}
+	return false
+}
+
+func (x *ProviderInfo) GetSupportsPlan() bool {
+	if x != nil {
+		return x.SupportsPlan
+	}
+	return false
+}
+
+func (x *ProviderInfo) GetSupportsInventory() bool {
+	if x != nil {
+		return x.SupportsInventory
+	}
+	return false
+}
+
 // Create a new target configuration
 type CreateConfigurationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	ProviderType  string                 `protobuf:"bytes,4,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
-	Credentials   *structpb.Struct       `protobuf:"bytes,5,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,6,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TenantId     uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ProviderType string                 `protobuf:"bytes,4,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	Credentials  *structpb.Struct       `protobuf:"bytes,5,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Config       *structpb.Struct       `protobuf:"bytes,6,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	// Retry and timeout overrides for jobs deploying to this configuration
+	RetryPolicy *RetryPolicy `protobuf:"bytes,7,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// host:port whose served certificate the drift detector compares with the deployed one
+	TlsProbeAddress *string `protobuf:"bytes,8,opt,name=tls_probe_address,json=tlsProbeAddress,proto3,oneof" json:"tls_probe_address,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateConfigurationRequest) Reset() {
 	*x = CreateConfigurationRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[2]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -341,7 +572,7 @@ func (x *CreateConfigurationRequest) String() string {
 func (*CreateConfigurationRequest) ProtoMessage() {}
 
 func (x *CreateConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[2]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +585,7 @@ func (x *CreateConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*CreateConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{2}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateConfigurationRequest) GetTenantId() uint32 {
@@ -399,6 +630,27 @@ func (x *CreateConfigurationRequest) GetConfig() *structpb.Struct {
 	return nil
 }
 
+func (x *CreateConfigurationRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateConfigurationRequest) GetTlsProbeAddress() string {
+	if x != nil && x.TlsProbeAddress != nil {
+		return *x.TlsProbeAddress
+	}
+	return ""
+}
+
+func (x *CreateConfigurationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type CreateConfigurationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Configuration *TargetConfiguration   `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
@@ -408,7 +660,7 @@ type CreateConfigurationResponse struct {
 
 func (x *CreateConfigurationResponse) Reset() {
 	*x = CreateConfigurationResponse{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[3]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +672,7 @@ func (x *CreateConfigurationResponse) String() string {
 func (*CreateConfigurationResponse) ProtoMessage() {}
 
 func (x *CreateConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[3]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +685,7 @@ func (x *CreateConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*CreateConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{3}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateConfigurationResponse) GetConfiguration() *TargetConfiguration {
@@ -453,7 +705,7 @@ type GetConfigurationRequest struct {
 
 func (x *GetConfigurationRequest) Reset() {
 	*x = GetConfigurationRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[4]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +717,7 @@ func (x *GetConfigurationRequest) String() string {
 func (*GetConfigurationRequest) ProtoMessage() {}
 
 func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[4]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +730,7 @@ func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{4}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetConfigurationRequest) GetId() string {
@@ -497,7 +749,7 @@ type GetConfigurationResponse struct {
 
 func (x *GetConfigurationResponse) Reset() {
 	*x = GetConfigurationResponse{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[5]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +761,7 @@ func (x *GetConfigurationResponse) String() string {
 func (*GetConfigurationResponse) ProtoMessage() {}
 
 func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[5]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +774,7 @@ func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{5}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetConfigurationResponse) GetConfiguration() *TargetConfiguration {
@@ -546,7 +798,7 @@ type ListConfigurationsRequest struct {
 
 func (x *ListConfigurationsRequest) Reset() {
 	*x = ListConfigurationsRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[6]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -558,7 +810,7 @@ func (x *ListConfigurationsRequest) String() string {
 func (*ListConfigurationsRequest) ProtoMessage() {}
 
 func (x *ListConfigurationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[6]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +823,7 @@ func (x *ListConfigurationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConfigurationsRequest.ProtoReflect.Descriptor instead.
 func (*ListConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{6}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListConfigurationsRequest) GetTenantId() uint32 {
@@ -619,7 +871,7 @@ type ListConfigurationsResponse struct {
 
 func (x *ListConfigurationsResponse) Reset() {
 	*x = ListConfigurationsResponse{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[7]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +883,7 @@ func (x *ListConfigurationsResponse) String() string {
 func (*ListConfigurationsResponse) ProtoMessage() {}
 
 func (x *ListConfigurationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[7]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +896,7 @@ func (x *ListConfigurationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConfigurationsResponse.ProtoReflect.Descriptor instead.
 func (*ListConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{7}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListConfigurationsResponse) GetItems() []*TargetConfiguration {
@@ -663,20 +915,31 @@ func (x *ListConfigurationsResponse) GetTotal() uint64 {
 
 // Update a target configuration
 type UpdateConfigurationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Credentials   *structpb.Struct       `protobuf:"bytes,4,opt,name=credentials,proto3,oneof" json:"credentials,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
-	Status        *ConfigurationStatus   `protobuf:"varint,6,opt,name=status,proto3,enum=deployer.service.v1.ConfigurationStatus,oneof" json:"status,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Replaces all credentials
+	Credentials *structpb.Struct     `protobuf:"bytes,4,opt,name=credentials,proto3,oneof" json:"credentials,omitempty"`
+	Config      *structpb.Struct     `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	Status      *ConfigurationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=deployer.service.v1.ConfigurationStatus,oneof" json:"status,omitempty"`
+	// Sets the given credential fields and keeps the others; cannot be combined with credentials
+	CredentialPatch *structpb.Struct `protobuf:"bytes,7,opt,name=credential_patch,json=credentialPatch,proto3,oneof" json:"credential_patch,omitempty"`
+	// Removes the given credential fields; required fields cannot be cleared
+	ClearCredentials []string `protobuf:"bytes,8,rep,name=clear_credentials,json=clearCredentials,proto3" json:"clear_credentials,omitempty"`
+	// Replaces the retry and timeout overrides; an empty policy removes them
+	RetryPolicy *RetryPolicy `protobuf:"bytes,9,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Replaces the TLS probe address of the drift detector; empty removes it
+	TlsProbeAddress *string `protobuf:"bytes,10,opt,name=tls_probe_address,json=tlsProbeAddress,proto3,oneof" json:"tls_probe_address,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateConfigurationRequest) Reset() {
 	*x = UpdateConfigurationRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[8]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +951,7 @@ func (x *UpdateConfigurationRequest) String() string {
 func (*UpdateConfigurationRequest) ProtoMessage() {}
 
 func (x *UpdateConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[8]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +964,7 @@ func (x *UpdateConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{8}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateConfigurationRequest) GetId() string {
@@ -746,6 +1009,41 @@ func (x *UpdateConfigurationRequest) GetStatus() ConfigurationStatus {
 	return ConfigurationStatus_CONFIG_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateConfigurationRequest) GetCredentialPatch() *structpb.Struct {
+	if x != nil {
+		return x.CredentialPatch
+	}
+	return nil
+}
+
+func (x *UpdateConfigurationRequest) GetClearCredentials() []string {
+	if x != nil {
+		return x.ClearCredentials
+	}
+	return nil
+}
+
+func (x *UpdateConfigurationRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *UpdateConfigurationRequest) GetTlsProbeAddress() string {
+	if x != nil && x.TlsProbeAddress != nil {
+		return *x.TlsProbeAddress
+	}
+	return ""
+}
+
+func (x *UpdateConfigurationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type UpdateConfigurationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Configuration *TargetConfiguration   `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
@@ -755,7 +1053,7 @@ type UpdateConfigurationResponse struct {
 
 func (x *UpdateConfigurationResponse) Reset() {
 	*x = UpdateConfigurationResponse{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[9]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -767,7 +1065,7 @@ func (x *UpdateConfigurationResponse) String() string {
 func (*UpdateConfigurationResponse) ProtoMessage() {}
 
 func (x *UpdateConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[9]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +1078,7 @@ func (x *UpdateConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{9}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateConfigurationResponse) GetConfiguration() *TargetConfiguration {
@@ -792,15 +1090,17 @@ func (x *UpdateConfigurationResponse) GetConfiguration() *TargetConfiguration {
 
 // Delete a target configuration
 type DeleteConfigurationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteConfigurationRequest) Reset() {
 	*x = DeleteConfigurationRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[10]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +1112,7 @@ func (x *DeleteConfigurationRequest) String() string {
 func (*DeleteConfigurationRequest) ProtoMessage() {}
 
 func (x *DeleteConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[10]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +1125,7 @@ func (x *DeleteConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{10}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteConfigurationRequest) GetId() string {
@@ -835,32 +1135,850 @@ func (x *DeleteConfigurationRequest) GetId() string {
 	return ""
 }
 
-// Validate credentials
-type ValidateConfigurationCredentialsRequest struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	ProviderType string                 `protobuf:"bytes,1,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
-	Credentials  *structpb.Struct       `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	// Optional config for context-aware validation (e.g., zone_id for Cloudflare)
-	Config        *structpb.Struct `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+func (x *DeleteConfigurationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// Immutable snapshot of a target configuration.
+// Credentials are stored encrypted with the revision and never returned.
+type ConfigurationRevision struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId        *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	ConfigurationId string                 `protobuf:"bytes,3,opt,name=configuration_id,json=configurationId,proto3" json:"configuration_id,omitempty"`
+	Revision        uint32                 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	Name            string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description     *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ProviderType    string                 `protobuf:"bytes,7,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	Config          *structpb.Struct       `protobuf:"bytes,8,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	// Set when this revision was created by reverting to an older one
+	RevertedFrom *uint32 `protobuf:"varint,9,opt,name=reverted_from,json=revertedFrom,proto3,oneof" json:"reverted_from,omitempty"`
+	// True for the revision the configuration currently holds
+	Current       bool                   `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+	ActorId       *uint32                `protobuf:"varint,20,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	ActorName     *string                `protobuf:"bytes,21,opt,name=actor_name,json=actorName,proto3,oneof" json:"actor_name,omitempty"`
+	Reason        *string                `protobuf:"bytes,22,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateConfigurationCredentialsRequest) Reset() {
-	*x = ValidateConfigurationCredentialsRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[11]
+func (x *ConfigurationRevision) Reset() {
+	*x = ConfigurationRevision{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateConfigurationCredentialsRequest) String() string {
+func (x *ConfigurationRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateConfigurationCredentialsRequest) ProtoMessage() {}
+func (*ConfigurationRevision) ProtoMessage() {}
 
-func (x *ValidateConfigurationCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[11]
+func (x *ConfigurationRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationRevision.ProtoReflect.Descriptor instead.
+func (*ConfigurationRevision) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfigurationRevision) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigurationRevision) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ConfigurationRevision) GetConfigurationId() string {
+	if x != nil {
+		return x.ConfigurationId
+	}
+	return ""
+}
+
+func (x *ConfigurationRevision) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigurationRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigurationRevision) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ConfigurationRevision) GetProviderType() string {
+	if x != nil {
+		return x.ProviderType
+	}
+	return ""
+}
+
+func (x *ConfigurationRevision) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConfigurationRevision) GetRevertedFrom() uint32 {
+	if x != nil && x.RevertedFrom != nil {
+		return *x.RevertedFrom
+	}
+	return 0
+}
+
+func (x *ConfigurationRevision) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *ConfigurationRevision) GetActorId() uint32 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *ConfigurationRevision) GetActorName() string {
+	if x != nil && x.ActorName != nil {
+		return *x.ActorName
+	}
+	return ""
+}
+
+func (x *ConfigurationRevision) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ConfigurationRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// List revisions of a target configuration
+type ListConfigurationRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          *uint32                `protobuf:"varint,10,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationRevisionsRequest) Reset() {
+	*x = ListConfigurationRevisionsRequest{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationRevisionsRequest) ProtoMessage() {}
+
+func (x *ListConfigurationRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigurationRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListConfigurationRevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListConfigurationRevisionsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListConfigurationRevisionsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListConfigurationRevisionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*ConfigurationRevision `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationRevisionsResponse) Reset() {
+	*x = ListConfigurationRevisionsResponse{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationRevisionsResponse) ProtoMessage() {}
+
+func (x *ListConfigurationRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigurationRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListConfigurationRevisionsResponse) GetItems() []*ConfigurationRevision {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListConfigurationRevisionsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Diff two revisions of a target configuration
+type DiffConfigurationRevisionsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromRevision uint32                 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// Defaults to the current revision
+	ToRevision    *uint32 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3,oneof" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffConfigurationRevisionsRequest) Reset() {
+	*x = DiffConfigurationRevisionsRequest{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffConfigurationRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigurationRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffConfigurationRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigurationRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffConfigurationRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiffConfigurationRevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffConfigurationRevisionsRequest) GetFromRevision() uint32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigurationRevisionsRequest) GetToRevision() uint32 {
+	if x != nil && x.ToRevision != nil {
+		return *x.ToRevision
+	}
+	return 0
+}
+
+type DiffConfigurationRevisionsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FromRevision uint32                 `protobuf:"varint,1,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision   uint32                 `protobuf:"varint,2,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	// Field-level differences; credential values are masked
+	Changes       []*FieldChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffConfigurationRevisionsResponse) Reset() {
+	*x = DiffConfigurationRevisionsResponse{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffConfigurationRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigurationRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffConfigurationRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigurationRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffConfigurationRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiffConfigurationRevisionsResponse) GetFromRevision() uint32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigurationRevisionsResponse) GetToRevision() uint32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigurationRevisionsResponse) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Revert a target configuration to an earlier revision
+type RevertConfigurationRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision uint32                 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Free-text reason recorded on the change record
+	Reason        *string `protobuf:"bytes,50,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertConfigurationRequest) Reset() {
+	*x = RevertConfigurationRequest{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertConfigurationRequest) ProtoMessage() {}
+
+func (x *RevertConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*RevertConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevertConfigurationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertConfigurationRequest) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RevertConfigurationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type RevertConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configuration *TargetConfiguration   `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertConfigurationResponse) Reset() {
+	*x = RevertConfigurationResponse{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertConfigurationResponse) ProtoMessage() {}
+
+func (x *RevertConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*RevertConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevertConfigurationResponse) GetConfiguration() *TargetConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+// Re-wrap all stored credentials with the active key-encryption key (platform admin only)
+type RotateEncryptionKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Count what would be re-wrapped without writing anything
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyRequest) Reset() {
+	*x = RotateEncryptionKeyRequest{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RotateEncryptionKeyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RotateEncryptionKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key-encryption key all credentials are wrapped with afterwards
+	ActiveKeyId             string `protobuf:"bytes,1,opt,name=active_key_id,json=activeKeyId,proto3" json:"active_key_id,omitempty"`
+	ConfigurationsRewrapped uint32 `protobuf:"varint,2,opt,name=configurations_rewrapped,json=configurationsRewrapped,proto3" json:"configurations_rewrapped,omitempty"`
+	RevisionsRewrapped      uint32 `protobuf:"varint,3,opt,name=revisions_rewrapped,json=revisionsRewrapped,proto3" json:"revisions_rewrapped,omitempty"`
+	// Records that already used the active key
+	AlreadyCurrent uint32 `protobuf:"varint,4,opt,name=already_current,json=alreadyCurrent,proto3" json:"already_current,omitempty"`
+	// Records that could not be decrypted with any configured key
+	Failed        uint32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun        bool   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyResponse) Reset() {
+	*x = RotateEncryptionKeyResponse{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateEncryptionKeyResponse) GetActiveKeyId() string {
+	if x != nil {
+		return x.ActiveKeyId
+	}
+	return ""
+}
+
+func (x *RotateEncryptionKeyResponse) GetConfigurationsRewrapped() uint32 {
+	if x != nil {
+		return x.ConfigurationsRewrapped
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetRevisionsRewrapped() uint32 {
+	if x != nil {
+		return x.RevisionsRewrapped
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetAlreadyCurrent() uint32 {
+	if x != nil {
+		return x.AlreadyCurrent
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// List configurations whose deployed certificate expires soon, soonest first
+type ListExpiringConfigurationsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Window in days, including certificates already expired (default: 30)
+	WithinDays    *uint32 `protobuf:"varint,2,opt,name=within_days,json=withinDays,proto3,oneof" json:"within_days,omitempty"`
+	Page          *uint32 `protobuf:"varint,10,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32 `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringConfigurationsRequest) Reset() {
+	*x = ListExpiringConfigurationsRequest{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringConfigurationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringConfigurationsRequest) ProtoMessage() {}
+
+func (x *ListExpiringConfigurationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringConfigurationsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringConfigurationsRequest) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListExpiringConfigurationsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ListExpiringConfigurationsRequest) GetWithinDays() uint32 {
+	if x != nil && x.WithinDays != nil {
+		return *x.WithinDays
+	}
+	return 0
+}
+
+func (x *ListExpiringConfigurationsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListExpiringConfigurationsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ExpiringConfiguration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configuration *TargetConfiguration   `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// Negative once the deployed certificate has expired
+	DaysUntilExpiry float64 `protobuf:"fixed64,2,opt,name=days_until_expiry,json=daysUntilExpiry,proto3" json:"days_until_expiry,omitempty"`
+	// Deployment targets grouping the configuration
+	DeploymentTargetIds []string `protobuf:"bytes,3,rep,name=deployment_target_ids,json=deploymentTargetIds,proto3" json:"deployment_target_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExpiringConfiguration) Reset() {
+	*x = ExpiringConfiguration{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpiringConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringConfiguration) ProtoMessage() {}
+
+func (x *ExpiringConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringConfiguration.ProtoReflect.Descriptor instead.
+func (*ExpiringConfiguration) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExpiringConfiguration) GetConfiguration() *TargetConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *ExpiringConfiguration) GetDaysUntilExpiry() float64 {
+	if x != nil {
+		return x.DaysUntilExpiry
+	}
+	return 0
+}
+
+func (x *ExpiringConfiguration) GetDeploymentTargetIds() []string {
+	if x != nil {
+		return x.DeploymentTargetIds
+	}
+	return nil
+}
+
+type ListExpiringConfigurationsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*ExpiringConfiguration `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringConfigurationsResponse) Reset() {
+	*x = ListExpiringConfigurationsResponse{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringConfigurationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringConfigurationsResponse) ProtoMessage() {}
+
+func (x *ListExpiringConfigurationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringConfigurationsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringConfigurationsResponse) Descriptor() ([]byte, []int) {
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListExpiringConfigurationsResponse) GetItems() []*ExpiringConfiguration {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListExpiringConfigurationsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Validate credentials
+type ValidateConfigurationCredentialsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProviderType string                 `protobuf:"bytes,1,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	Credentials  *structpb.Struct       `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// Optional config for context-aware validation (e.g., zone_id for Cloudflare)
+	Config        *structpb.Struct `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationCredentialsRequest) Reset() {
+	*x = ValidateConfigurationCredentialsRequest{}
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationCredentialsRequest) ProtoMessage() {}
+
+func (x *ValidateConfigurationCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1991,7 @@ func (x *ValidateConfigurationCredentialsRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ValidateConfigurationCredentialsRequest.ProtoReflect.Descriptor instead.
 func (*ValidateConfigurationCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{11}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ValidateConfigurationCredentialsRequest) GetProviderType() string {
@@ -907,7 +2025,7 @@ type ValidateConfigurationCredentialsResponse struct {
 
 func (x *ValidateConfigurationCredentialsResponse) Reset() {
 	*x = ValidateConfigurationCredentialsResponse{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[12]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -919,7 +2037,7 @@ func (x *ValidateConfigurationCredentialsResponse) String() string {
 func (*ValidateConfigurationCredentialsResponse) ProtoMessage() {}
 
 func (x *ValidateConfigurationCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[12]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +2050,7 @@ func (x *ValidateConfigurationCredentialsResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ValidateConfigurationCredentialsResponse.ProtoReflect.Descriptor instead.
 func (*ValidateConfigurationCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{12}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ValidateConfigurationCredentialsResponse) GetValid() bool {
@@ -958,7 +2076,7 @@ type ListConfigurationProvidersRequest struct {
 
 func (x *ListConfigurationProvidersRequest) Reset() {
 	*x = ListConfigurationProvidersRequest{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[13]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -970,7 +2088,7 @@ func (x *ListConfigurationProvidersRequest) String() string {
 func (*ListConfigurationProvidersRequest) ProtoMessage() {}
 
 func (x *ListConfigurationProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[13]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +2101,7 @@ func (x *ListConfigurationProvidersRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListConfigurationProvidersRequest.ProtoReflect.Descriptor instead.
 func (*ListConfigurationProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{13}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{26}
 }
 
 type ListConfigurationProvidersResponse struct {
@@ -995,7 +2113,7 @@ type ListConfigurationProvidersResponse struct {
 
 func (x *ListConfigurationProvidersResponse) Reset() {
 	*x = ListConfigurationProvidersResponse{}
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[14]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1007,7 +2125,7 @@ func (x *ListConfigurationProvidersResponse) String() string {
 func (*ListConfigurationProvidersResponse) ProtoMessage() {}
 
 func (x *ListConfigurationProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[14]
+	mi := &file_deployer_service_v1_target_configuration_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +2138,7 @@ func (x *ListConfigurationProvidersResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListConfigurationProvidersResponse.ProtoReflect.Descriptor instead.
 func (*ListConfigurationProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{14}
+	return file_deployer_service_v1_target_configuration_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListConfigurationProvidersResponse) GetProviders() []*ProviderInfo {
@@ -1034,7 +2152,12 @@ var File_deployer_service_v1_target_configuration_proto protoreflect.FileDescrip
 
 const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\n" +
-	".deployer/service/v1/target_configuration.proto\x12\x13deployer.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x16redact/v3/redact.proto\"\xba\x06\n" +
+	".deployer/service/v1/target_configuration.proto\x12\x13deployer.service.v1\x1a\x1bbuf/validate/validate.proto\x1a'deployer/service/v1/change_record.proto\x1a&deployer/service/v1/retry_policy.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x16redact/v3/redact.proto\"\xa2\x01\n" +
+	"\x15CredentialFieldStatus\x12\x10\n" +
+	"\x03set\x18\x01 \x01(\bR\x03set\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x12G\n" +
+	"\x0flast_rotated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rlastRotatedAt\x88\x01\x01B\x12\n" +
+	"\x10_last_rotated_at\"\xcc\x10\n" +
 	"\x13TargetConfiguration\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x17\n" +
@@ -1044,16 +2167,33 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\x06config\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x05R\x06config\x88\x01\x01\x12E\n" +
 	"\x06status\x18\a \x01(\x0e2(.deployer.service.v1.ConfigurationStatusH\x06R\x06status\x88\x01\x01\x12*\n" +
 	"\x0estatus_message\x18\b \x01(\tH\aR\rstatusMessage\x88\x01\x01\x12M\n" +
-	"\x12last_deployment_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\bR\x10lastDeploymentAt\x88\x01\x01\x12\"\n" +
+	"\x12last_deployment_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\bR\x10lastDeploymentAt\x88\x01\x01\x12\x1f\n" +
+	"\brevision\x18\n" +
+	" \x01(\rH\tR\brevision\x88\x01\x01\x12[\n" +
+	"\vcredentials\x18\v \x03(\v29.deployer.service.v1.TargetConfiguration.CredentialsEntryR\vcredentials\x12H\n" +
+	"\fretry_policy\x18\f \x01(\v2 .deployer.service.v1.RetryPolicyH\n" +
+	"R\vretryPolicy\x88\x01\x01\x126\n" +
+	"\x14consecutive_failures\x18\r \x01(\x05H\vR\x13consecutiveFailures\x88\x01\x01\x12C\n" +
+	"\rnext_probe_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\fR\vnextProbeAt\x88\x01\x01\x12K\n" +
+	"\x11last_validated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\rR\x0flastValidatedAt\x88\x01\x01\x127\n" +
+	"\x15last_validation_error\x18\x10 \x01(\tH\x0eR\x13lastValidationError\x88\x01\x01\x12M\n" +
+	"\x12next_validation_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x0fR\x10nextValidationAt\x88\x01\x01\x12/\n" +
+	"\x11tls_probe_address\x18\x12 \x01(\tH\x10R\x0ftlsProbeAddress\x88\x01\x01\x12N\n" +
+	"\x13last_drift_check_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\x11R\x10lastDriftCheckAt\x88\x01\x01\x12N\n" +
+	"\x13next_drift_check_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x12R\x10nextDriftCheckAt\x88\x01\x01\x12;\n" +
+	"\x17deployed_certificate_id\x18\x15 \x01(\tH\x13R\x15deployedCertificateId\x88\x01\x01\x12f\n" +
+	"\x1fdeployed_certificate_expires_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\x14R\x1cdeployedCertificateExpiresAt\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"created_by\x18d \x01(\rH\tR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"created_by\x18d \x01(\rH\x15R\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rH\n" +
-	"R\tupdatedBy\x88\x01\x01\x12A\n" +
-	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\vR\n" +
+	"updated_by\x18e \x01(\rH\x16R\tupdatedBy\x88\x01\x01\x12A\n" +
+	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x17R\n" +
 	"createTime\x88\x01\x01\x12A\n" +
-	"\vupdate_time\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampH\fR\n" +
-	"updateTime\x88\x01\x01B\x05\n" +
+	"\vupdate_time\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x18R\n" +
+	"updateTime\x88\x01\x01\x1aj\n" +
+	"\x10CredentialsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.deployer.service.v1.CredentialFieldStatusR\x05value:\x028\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_tenant_idB\a\n" +
@@ -1063,11 +2203,23 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\a_configB\t\n" +
 	"\a_statusB\x11\n" +
 	"\x0f_status_messageB\x15\n" +
-	"\x13_last_deployment_atB\r\n" +
+	"\x13_last_deployment_atB\v\n" +
+	"\t_revisionB\x0f\n" +
+	"\r_retry_policyB\x17\n" +
+	"\x15_consecutive_failuresB\x10\n" +
+	"\x0e_next_probe_atB\x14\n" +
+	"\x12_last_validated_atB\x18\n" +
+	"\x16_last_validation_errorB\x15\n" +
+	"\x13_next_validation_atB\x14\n" +
+	"\x12_tls_probe_addressB\x16\n" +
+	"\x14_last_drift_check_atB\x16\n" +
+	"\x14_next_drift_check_atB\x1a\n" +
+	"\x18_deployed_certificate_idB\"\n" +
+	" _deployed_certificate_expires_atB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\x0e\n" +
 	"\f_create_timeB\x0e\n" +
-	"\f_update_time\"\xbd\x02\n" +
+	"\f_update_time\"\xf2\x04\n" +
 	"\fProviderInfo\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -1075,7 +2227,15 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\x15supports_verification\x18\x04 \x01(\bR\x14supportsVerification\x12+\n" +
 	"\x11supports_rollback\x18\x05 \x01(\bR\x10supportsRollback\x124\n" +
 	"\x16required_config_fields\x18\x06 \x03(\tR\x14requiredConfigFields\x12<\n" +
-	"\x1arequired_credential_fields\x18\a \x03(\tR\x18requiredCredentialFields\"\xdd\x02\n" +
+	"\x1arequired_credential_fields\x18\a \x03(\tR\x18requiredCredentialFields\x12A\n" +
+	"\rconfig_schema\x18\b \x01(\v2\x17.google.protobuf.StructH\x00R\fconfigSchema\x88\x01\x01\x12I\n" +
+	"\x11credential_schema\x18\t \x01(\v2\x17.google.protobuf.StructH\x01R\x10credentialSchema\x88\x01\x01\x12)\n" +
+	"\x10supports_removal\x18\n" +
+	" \x01(\bR\x0fsupportsRemoval\x12#\n" +
+	"\rsupports_plan\x18\v \x01(\bR\fsupportsPlan\x12-\n" +
+	"\x12supports_inventory\x18\f \x01(\bR\x11supportsInventoryB\x10\n" +
+	"\x0e_config_schemaB\x14\n" +
+	"\x12_credential_schema\"\xbb\x04\n" +
 	"\x1aCreateConfigurationRequest\x12 \n" +
 	"\ttenant_id\x18\x01 \x01(\rB\x03\xe0A\x02R\btenantId\x12!\n" +
 	"\x04name\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04name\x12/\n" +
@@ -1083,9 +2243,15 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\rprovider_type\x18\x04 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\fproviderType\x12G\n" +
 	"\vcredentials\x18\x05 \x01(\v2\x17.google.protobuf.StructB\f\xe0A\x02ڶ\x1a\x05\x9a\x01\x02\x10\x01R\vcredentials\x124\n" +
-	"\x06config\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x01R\x06config\x88\x01\x01B\x0e\n" +
+	"\x06config\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x01R\x06config\x88\x01\x01\x12H\n" +
+	"\fretry_policy\x18\a \x01(\v2 .deployer.service.v1.RetryPolicyH\x02R\vretryPolicy\x88\x01\x01\x129\n" +
+	"\x11tls_probe_address\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\x0ftlsProbeAddress\x88\x01\x01\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x04R\x06reason\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\t\n" +
-	"\a_config\"m\n" +
+	"\a_configB\x0f\n" +
+	"\r_retry_policyB\x14\n" +
+	"\x12_tls_probe_addressB\t\n" +
+	"\a_reason\"m\n" +
 	"\x1bCreateConfigurationResponse\x12N\n" +
 	"\rconfiguration\x18\x01 \x01(\v2(.deployer.service.v1.TargetConfigurationR\rconfiguration\".\n" +
 	"\x17GetConfigurationRequest\x12\x13\n" +
@@ -1108,7 +2274,7 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"_page_size\"r\n" +
 	"\x1aListConfigurationsResponse\x12>\n" +
 	"\x05items\x18\x01 \x03(\v2(.deployer.service.v1.TargetConfigurationR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x04R\x05total\"\x8e\x03\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\x92\x06\n" +
 	"\x1aUpdateConfigurationRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
@@ -1116,16 +2282,115 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04H\x01R\vdescription\x88\x01\x01\x12I\n" +
 	"\vcredentials\x18\x04 \x01(\v2\x17.google.protobuf.StructB\tڶ\x1a\x05\x9a\x01\x02\x10\x01H\x02R\vcredentials\x88\x01\x01\x124\n" +
 	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructH\x03R\x06config\x88\x01\x01\x12E\n" +
-	"\x06status\x18\x06 \x01(\x0e2(.deployer.service.v1.ConfigurationStatusH\x04R\x06status\x88\x01\x01B\a\n" +
+	"\x06status\x18\x06 \x01(\x0e2(.deployer.service.v1.ConfigurationStatusH\x04R\x06status\x88\x01\x01\x12R\n" +
+	"\x10credential_patch\x18\a \x01(\v2\x17.google.protobuf.StructB\tڶ\x1a\x05\x9a\x01\x02\x10\x01H\x05R\x0fcredentialPatch\x88\x01\x01\x12;\n" +
+	"\x11clear_credentials\x18\b \x03(\tB\x0e\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01R\x10clearCredentials\x12H\n" +
+	"\fretry_policy\x18\t \x01(\v2 .deployer.service.v1.RetryPolicyH\x06R\vretryPolicy\x88\x01\x01\x129\n" +
+	"\x11tls_probe_address\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\aR\x0ftlsProbeAddress\x88\x01\x01\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\bR\x06reason\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_credentialsB\t\n" +
 	"\a_configB\t\n" +
-	"\a_status\"m\n" +
+	"\a_statusB\x13\n" +
+	"\x11_credential_patchB\x0f\n" +
+	"\r_retry_policyB\x14\n" +
+	"\x12_tls_probe_addressB\t\n" +
+	"\a_reason\"m\n" +
 	"\x1bUpdateConfigurationResponse\x12N\n" +
-	"\rconfiguration\x18\x01 \x01(\v2(.deployer.service.v1.TargetConfigurationR\rconfiguration\"1\n" +
+	"\rconfiguration\x18\x01 \x01(\v2(.deployer.service.v1.TargetConfigurationR\rconfiguration\"c\n" +
 	"\x1aDeleteConfigurationRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xcd\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\x80\x05\n" +
+	"\x15ConfigurationRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\rH\x00R\btenantId\x88\x01\x01\x12)\n" +
+	"\x10configuration_id\x18\x03 \x01(\tR\x0fconfigurationId\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\rR\brevision\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x01R\vdescription\x88\x01\x01\x12#\n" +
+	"\rprovider_type\x18\a \x01(\tR\fproviderType\x124\n" +
+	"\x06config\x18\b \x01(\v2\x17.google.protobuf.StructH\x02R\x06config\x88\x01\x01\x12(\n" +
+	"\rreverted_from\x18\t \x01(\rH\x03R\frevertedFrom\x88\x01\x01\x12\x18\n" +
+	"\acurrent\x18\n" +
+	" \x01(\bR\acurrent\x12\x1e\n" +
+	"\bactor_id\x18\x14 \x01(\rH\x04R\aactorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"actor_name\x18\x15 \x01(\tH\x05R\tactorName\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x16 \x01(\tH\x06R\x06reason\x88\x01\x01\x12A\n" +
+	"\vcreate_time\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampH\aR\n" +
+	"createTime\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_configB\x10\n" +
+	"\x0e_reverted_fromB\v\n" +
+	"\t_actor_idB\r\n" +
+	"\v_actor_nameB\t\n" +
+	"\a_reasonB\x0e\n" +
+	"\f_create_time\"\x8a\x01\n" +
+	"!ListConfigurationRevisionsRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x17\n" +
+	"\x04page\x18\n" +
+	" \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\v \x01(\rH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"|\n" +
+	"\"ListConfigurationRevisionsResponse\x12@\n" +
+	"\x05items\x18\x01 \x03(\v2*.deployer.service.v1.ConfigurationRevisionR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\x9f\x01\n" +
+	"!DiffConfigurationRevisionsRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12/\n" +
+	"\rfrom_revision\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02 \x00R\ffromRevision\x12$\n" +
+	"\vto_revision\x18\x03 \x01(\rH\x00R\n" +
+	"toRevision\x88\x01\x01B\x0e\n" +
+	"\f_to_revision\"\xa6\x01\n" +
+	"\"DiffConfigurationRevisionsResponse\x12#\n" +
+	"\rfrom_revision\x18\x01 \x01(\rR\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x02 \x01(\rR\n" +
+	"toRevision\x12:\n" +
+	"\achanges\x18\x03 \x03(\v2 .deployer.service.v1.FieldChangeR\achanges\"\x8b\x01\n" +
+	"\x1aRevertConfigurationRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12&\n" +
+	"\brevision\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02 \x00R\brevision\x12%\n" +
+	"\x06reason\x182 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"m\n" +
+	"\x1bRevertConfigurationResponse\x12N\n" +
+	"\rconfiguration\x18\x01 \x01(\v2(.deployer.service.v1.TargetConfigurationR\rconfiguration\"5\n" +
+	"\x1aRotateEncryptionKeyRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\x87\x02\n" +
+	"\x1bRotateEncryptionKeyResponse\x12\"\n" +
+	"\ractive_key_id\x18\x01 \x01(\tR\vactiveKeyId\x129\n" +
+	"\x18configurations_rewrapped\x18\x02 \x01(\rR\x17configurationsRewrapped\x12/\n" +
+	"\x13revisions_rewrapped\x18\x03 \x01(\rR\x12revisionsRewrapped\x12'\n" +
+	"\x0falready_current\x18\x04 \x01(\rR\x0ealreadyCurrent\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\rR\x06failed\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\xe5\x01\n" +
+	"!ListExpiringConfigurationsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12.\n" +
+	"\vwithin_days\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xc2\x1cH\x01R\n" +
+	"withinDays\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\n" +
+	" \x01(\rH\x02R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\v \x01(\rH\x03R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\x0e\n" +
+	"\f_within_daysB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xc7\x01\n" +
+	"\x15ExpiringConfiguration\x12N\n" +
+	"\rconfiguration\x18\x01 \x01(\v2(.deployer.service.v1.TargetConfigurationR\rconfiguration\x12*\n" +
+	"\x11days_until_expiry\x18\x02 \x01(\x01R\x0fdaysUntilExpiry\x122\n" +
+	"\x15deployment_target_ids\x18\x03 \x03(\tR\x13deploymentTargetIds\"|\n" +
+	"\"ListExpiringConfigurationsResponse\x12@\n" +
+	"\x05items\x18\x01 \x03(\v2*.deployer.service.v1.ExpiringConfigurationR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xcd\x01\n" +
 	"'ValidateConfigurationCredentialsRequest\x12(\n" +
 	"\rprovider_type\x18\x01 \x01(\tB\x03\xe0A\x02R\fproviderType\x12G\n" +
 	"\vcredentials\x18\x02 \x01(\v2\x17.google.protobuf.StructB\f\xe0A\x02ڶ\x1a\x05\x9a\x01\x02\x10\x01R\vcredentials\x12/\n" +
@@ -1142,14 +2407,19 @@ const file_deployer_service_v1_target_configuration_proto_rawDesc = "" +
 	"\x19CONFIG_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14CONFIG_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
 	"\x16CONFIG_STATUS_INACTIVE\x10\x02\x12\x17\n" +
-	"\x13CONFIG_STATUS_ERROR\x10\x032\xa1\t\n" +
+	"\x13CONFIG_STATUS_ERROR\x10\x032\xca\x10\n" +
 	"\x1aTargetConfigurationService\x12\x9e\x01\n" +
 	"\x13CreateConfiguration\x12/.deployer.service.v1.CreateConfigurationRequest\x1a0.deployer.service.v1.CreateConfigurationResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/target-configurations\x12\x97\x01\n" +
 	"\x10GetConfiguration\x12,.deployer.service.v1.GetConfigurationRequest\x1a-.deployer.service.v1.GetConfigurationResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/target-configurations/{id}\x12\x98\x01\n" +
 	"\x12ListConfigurations\x12..deployer.service.v1.ListConfigurationsRequest\x1a/.deployer.service.v1.ListConfigurationsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/target-configurations\x12\xa3\x01\n" +
 	"\x13UpdateConfiguration\x12/.deployer.service.v1.UpdateConfigurationRequest\x1a0.deployer.service.v1.UpdateConfigurationResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/target-configurations/{id}\x12\x86\x01\n" +
 	"\x13DeleteConfiguration\x12/.deployer.service.v1.DeleteConfigurationRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/target-configurations/{id}\x12\xcd\x01\n" +
-	"\x13ValidateCredentials\x12<.deployer.service.v1.ValidateConfigurationCredentialsRequest\x1a=.deployer.service.v1.ValidateConfigurationCredentialsResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/target-configurations/validate-credentials\x12\xad\x01\n" +
+	"\x13ValidateCredentials\x12<.deployer.service.v1.ValidateConfigurationCredentialsRequest\x1a=.deployer.service.v1.ValidateConfigurationCredentialsResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/target-configurations/validate-credentials\x12\xbf\x01\n" +
+	"\x1aListConfigurationRevisions\x126.deployer.service.v1.ListConfigurationRevisionsRequest\x1a7.deployer.service.v1.ListConfigurationRevisionsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/target-configurations/{id}/revisions\x12\xc4\x01\n" +
+	"\x1aDiffConfigurationRevisions\x126.deployer.service.v1.DiffConfigurationRevisionsRequest\x1a7.deployer.service.v1.DiffConfigurationRevisionsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/target-configurations/{id}/revisions/diff\x12\xaa\x01\n" +
+	"\x13RevertConfiguration\x12/.deployer.service.v1.RevertConfigurationRequest\x1a0.deployer.service.v1.RevertConfigurationResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/target-configurations/{id}/revert\x12\xb4\x01\n" +
+	"\x13RotateEncryptionKey\x12/.deployer.service.v1.RotateEncryptionKeyRequest\x1a0.deployer.service.v1.RotateEncryptionKeyResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/target-configurations/rotate-encryption-key\x12\xb9\x01\n" +
+	"\x1aListExpiringConfigurations\x126.deployer.service.v1.ListExpiringConfigurationsRequest\x1a7.deployer.service.v1.ListExpiringConfigurationsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/target-configurations/expiring\x12\xad\x01\n" +
 	"\rListProviders\x126.deployer.service.v1.ListConfigurationProvidersRequest\x1a7.deployer.service.v1.ListConfigurationProvidersResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/target-configurations/providersB\xef\x01\n" +
 	"\x17com.deployer.service.v1B\x18TargetConfigurationProtoP\x01ZLgithub.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1;servicev1\xa2\x02\x03DSX\xaa\x02\x13Deployer.Service.V1\xca\x02\x13Deployer\\Service\\V1\xe2\x02\x1fDeployer\\Service\\V1\\GPBMetadata\xea\x02\x15Deployer::Service::V1b\x06proto3"
 
@@ -1166,66 +2436,114 @@ func file_deployer_service_v1_target_configuration_proto_rawDescGZIP() []byte {
 }
 
 var file_deployer_service_v1_target_configuration_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_deployer_service_v1_target_configuration_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_deployer_service_v1_target_configuration_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_deployer_service_v1_target_configuration_proto_goTypes = []any{
 	(ConfigurationStatus)(0),                         // 0: deployer.service.v1.ConfigurationStatus
-	(*TargetConfiguration)(nil),                      // 1: deployer.service.v1.TargetConfiguration
-	(*ProviderInfo)(nil),                             // 2: deployer.service.v1.ProviderInfo
-	(*CreateConfigurationRequest)(nil),               // 3: deployer.service.v1.CreateConfigurationRequest
-	(*CreateConfigurationResponse)(nil),              // 4: deployer.service.v1.CreateConfigurationResponse
-	(*GetConfigurationRequest)(nil),                  // 5: deployer.service.v1.GetConfigurationRequest
-	(*GetConfigurationResponse)(nil),                 // 6: deployer.service.v1.GetConfigurationResponse
-	(*ListConfigurationsRequest)(nil),                // 7: deployer.service.v1.ListConfigurationsRequest
-	(*ListConfigurationsResponse)(nil),               // 8: deployer.service.v1.ListConfigurationsResponse
-	(*UpdateConfigurationRequest)(nil),               // 9: deployer.service.v1.UpdateConfigurationRequest
-	(*UpdateConfigurationResponse)(nil),              // 10: deployer.service.v1.UpdateConfigurationResponse
-	(*DeleteConfigurationRequest)(nil),               // 11: deployer.service.v1.DeleteConfigurationRequest
-	(*ValidateConfigurationCredentialsRequest)(nil),  // 12: deployer.service.v1.ValidateConfigurationCredentialsRequest
-	(*ValidateConfigurationCredentialsResponse)(nil), // 13: deployer.service.v1.ValidateConfigurationCredentialsResponse
-	(*ListConfigurationProvidersRequest)(nil),        // 14: deployer.service.v1.ListConfigurationProvidersRequest
-	(*ListConfigurationProvidersResponse)(nil),       // 15: deployer.service.v1.ListConfigurationProvidersResponse
-	(*structpb.Struct)(nil),                          // 16: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),                    // 17: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                            // 18: google.protobuf.Empty
+	(*CredentialFieldStatus)(nil),                    // 1: deployer.service.v1.CredentialFieldStatus
+	(*TargetConfiguration)(nil),                      // 2: deployer.service.v1.TargetConfiguration
+	(*ProviderInfo)(nil),                             // 3: deployer.service.v1.ProviderInfo
+	(*CreateConfigurationRequest)(nil),               // 4: deployer.service.v1.CreateConfigurationRequest
+	(*CreateConfigurationResponse)(nil),              // 5: deployer.service.v1.CreateConfigurationResponse
+	(*GetConfigurationRequest)(nil),                  // 6: deployer.service.v1.GetConfigurationRequest
+	(*GetConfigurationResponse)(nil),                 // 7: deployer.service.v1.GetConfigurationResponse
+	(*ListConfigurationsRequest)(nil),                // 8: deployer.service.v1.ListConfigurationsRequest
+	(*ListConfigurationsResponse)(nil),               // 9: deployer.service.v1.ListConfigurationsResponse
+	(*UpdateConfigurationRequest)(nil),               // 10: deployer.service.v1.UpdateConfigurationRequest
+	(*UpdateConfigurationResponse)(nil),              // 11: deployer.service.v1.UpdateConfigurationResponse
+	(*DeleteConfigurationRequest)(nil),               // 12: deployer.service.v1.DeleteConfigurationRequest
+	(*ConfigurationRevision)(nil),                    // 13: deployer.service.v1.ConfigurationRevision
+	(*ListConfigurationRevisionsRequest)(nil),        // 14: deployer.service.v1.ListConfigurationRevisionsRequest
+	(*ListConfigurationRevisionsResponse)(nil),       // 15: deployer.service.v1.ListConfigurationRevisionsResponse
+	(*DiffConfigurationRevisionsRequest)(nil),        // 16: deployer.service.v1.DiffConfigurationRevisionsRequest
+	(*DiffConfigurationRevisionsResponse)(nil),       // 17: deployer.service.v1.DiffConfigurationRevisionsResponse
+	(*RevertConfigurationRequest)(nil),               // 18: deployer.service.v1.RevertConfigurationRequest
+	(*RevertConfigurationResponse)(nil),              // 19: deployer.service.v1.RevertConfigurationResponse
+	(*RotateEncryptionKeyRequest)(nil),               // 20: deployer.service.v1.RotateEncryptionKeyRequest
+	(*RotateEncryptionKeyResponse)(nil),              // 21: deployer.service.v1.RotateEncryptionKeyResponse
+	(*ListExpiringConfigurationsRequest)(nil),        // 22: deployer.service.v1.ListExpiringConfigurationsRequest
+	(*ExpiringConfiguration)(nil),                    // 23: deployer.service.v1.ExpiringConfiguration
+	(*ListExpiringConfigurationsResponse)(nil),       // 24: deployer.service.v1.ListExpiringConfigurationsResponse
+	(*ValidateConfigurationCredentialsRequest)(nil),  // 25: deployer.service.v1.ValidateConfigurationCredentialsRequest
+	(*ValidateConfigurationCredentialsResponse)(nil), // 26: deployer.service.v1.ValidateConfigurationCredentialsResponse
+	(*ListConfigurationProvidersRequest)(nil),        // 27: deployer.service.v1.ListConfigurationProvidersRequest
+	(*ListConfigurationProvidersResponse)(nil),       // 28: deployer.service.v1.ListConfigurationProvidersResponse
+	nil,                           // 29: deployer.service.v1.TargetConfiguration.CredentialsEntry
+	(*timestamppb.Timestamp)(nil), // 30: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 31: google.protobuf.Struct
+	(*RetryPolicy)(nil),           // 32: deployer.service.v1.RetryPolicy
+	(*FieldChange)(nil),           // 33: deployer.service.v1.FieldChange
+	(*emptypb.Empty)(nil),         // 34: google.protobuf.Empty
 }
 var file_deployer_service_v1_target_configuration_proto_depIdxs = []int32{
-	16, // 0: deployer.service.v1.TargetConfiguration.config:type_name -> google.protobuf.Struct
-	0,  // 1: deployer.service.v1.TargetConfiguration.status:type_name -> deployer.service.v1.ConfigurationStatus
-	17, // 2: deployer.service.v1.TargetConfiguration.last_deployment_at:type_name -> google.protobuf.Timestamp
-	17, // 3: deployer.service.v1.TargetConfiguration.create_time:type_name -> google.protobuf.Timestamp
-	17, // 4: deployer.service.v1.TargetConfiguration.update_time:type_name -> google.protobuf.Timestamp
-	16, // 5: deployer.service.v1.CreateConfigurationRequest.credentials:type_name -> google.protobuf.Struct
-	16, // 6: deployer.service.v1.CreateConfigurationRequest.config:type_name -> google.protobuf.Struct
-	1,  // 7: deployer.service.v1.CreateConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
-	1,  // 8: deployer.service.v1.GetConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
-	0,  // 9: deployer.service.v1.ListConfigurationsRequest.status:type_name -> deployer.service.v1.ConfigurationStatus
-	1,  // 10: deployer.service.v1.ListConfigurationsResponse.items:type_name -> deployer.service.v1.TargetConfiguration
-	16, // 11: deployer.service.v1.UpdateConfigurationRequest.credentials:type_name -> google.protobuf.Struct
-	16, // 12: deployer.service.v1.UpdateConfigurationRequest.config:type_name -> google.protobuf.Struct
-	0,  // 13: deployer.service.v1.UpdateConfigurationRequest.status:type_name -> deployer.service.v1.ConfigurationStatus
-	1,  // 14: deployer.service.v1.UpdateConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
-	16, // 15: deployer.service.v1.ValidateConfigurationCredentialsRequest.credentials:type_name -> google.protobuf.Struct
-	16, // 16: deployer.service.v1.ValidateConfigurationCredentialsRequest.config:type_name -> google.protobuf.Struct
-	2,  // 17: deployer.service.v1.ListConfigurationProvidersResponse.providers:type_name -> deployer.service.v1.ProviderInfo
-	3,  // 18: deployer.service.v1.TargetConfigurationService.CreateConfiguration:input_type -> deployer.service.v1.CreateConfigurationRequest
-	5,  // 19: deployer.service.v1.TargetConfigurationService.GetConfiguration:input_type -> deployer.service.v1.GetConfigurationRequest
-	7,  // 20: deployer.service.v1.TargetConfigurationService.ListConfigurations:input_type -> deployer.service.v1.ListConfigurationsRequest
-	9,  // 21: deployer.service.v1.TargetConfigurationService.UpdateConfiguration:input_type -> deployer.service.v1.UpdateConfigurationRequest
-	11, // 22: deployer.service.v1.TargetConfigurationService.DeleteConfiguration:input_type -> deployer.service.v1.DeleteConfigurationRequest
-	12, // 23: deployer.service.v1.TargetConfigurationService.ValidateCredentials:input_type -> deployer.service.v1.ValidateConfigurationCredentialsRequest
-	14, // 24: deployer.service.v1.TargetConfigurationService.ListProviders:input_type -> deployer.service.v1.ListConfigurationProvidersRequest
-	4,  // 25: deployer.service.v1.TargetConfigurationService.CreateConfiguration:output_type -> deployer.service.v1.CreateConfigurationResponse
-	6,  // 26: deployer.service.v1.TargetConfigurationService.GetConfiguration:output_type -> deployer.service.v1.GetConfigurationResponse
-	8,  // 27: deployer.service.v1.TargetConfigurationService.ListConfigurations:output_type -> deployer.service.v1.ListConfigurationsResponse
-	10, // 28: deployer.service.v1.TargetConfigurationService.UpdateConfiguration:output_type -> deployer.service.v1.UpdateConfigurationResponse
-	18, // 29: deployer.service.v1.TargetConfigurationService.DeleteConfiguration:output_type -> google.protobuf.Empty
-	13, // 30: deployer.service.v1.TargetConfigurationService.ValidateCredentials:output_type -> deployer.service.v1.ValidateConfigurationCredentialsResponse
-	15, // 31: deployer.service.v1.TargetConfigurationService.ListProviders:output_type -> deployer.service.v1.ListConfigurationProvidersResponse
-	25, // [25:32] is the sub-list for method output_type
-	18, // [18:25] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	30, // 0: deployer.service.v1.CredentialFieldStatus.last_rotated_at:type_name -> google.protobuf.Timestamp
+	31, // 1: deployer.service.v1.TargetConfiguration.config:type_name -> google.protobuf.Struct
+	0,  // 2: deployer.service.v1.TargetConfiguration.status:type_name -> deployer.service.v1.ConfigurationStatus
+	30, // 3: deployer.service.v1.TargetConfiguration.last_deployment_at:type_name -> google.protobuf.Timestamp
+	29, // 4: deployer.service.v1.TargetConfiguration.credentials:type_name -> deployer.service.v1.TargetConfiguration.CredentialsEntry
+	32, // 5: deployer.service.v1.TargetConfiguration.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	30, // 6: deployer.service.v1.TargetConfiguration.next_probe_at:type_name -> google.protobuf.Timestamp
+	30, // 7: deployer.service.v1.TargetConfiguration.last_validated_at:type_name -> google.protobuf.Timestamp
+	30, // 8: deployer.service.v1.TargetConfiguration.next_validation_at:type_name -> google.protobuf.Timestamp
+	30, // 9: deployer.service.v1.TargetConfiguration.last_drift_check_at:type_name -> google.protobuf.Timestamp
+	30, // 10: deployer.service.v1.TargetConfiguration.next_drift_check_at:type_name -> google.protobuf.Timestamp
+	30, // 11: deployer.service.v1.TargetConfiguration.deployed_certificate_expires_at:type_name -> google.protobuf.Timestamp
+	30, // 12: deployer.service.v1.TargetConfiguration.create_time:type_name -> google.protobuf.Timestamp
+	30, // 13: deployer.service.v1.TargetConfiguration.update_time:type_name -> google.protobuf.Timestamp
+	31, // 14: deployer.service.v1.ProviderInfo.config_schema:type_name -> google.protobuf.Struct
+	31, // 15: deployer.service.v1.ProviderInfo.credential_schema:type_name -> google.protobuf.Struct
+	31, // 16: deployer.service.v1.CreateConfigurationRequest.credentials:type_name -> google.protobuf.Struct
+	31, // 17: deployer.service.v1.CreateConfigurationRequest.config:type_name -> google.protobuf.Struct
+	32, // 18: deployer.service.v1.CreateConfigurationRequest.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	2,  // 19: deployer.service.v1.CreateConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
+	2,  // 20: deployer.service.v1.GetConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
+	0,  // 21: deployer.service.v1.ListConfigurationsRequest.status:type_name -> deployer.service.v1.ConfigurationStatus
+	2,  // 22: deployer.service.v1.ListConfigurationsResponse.items:type_name -> deployer.service.v1.TargetConfiguration
+	31, // 23: deployer.service.v1.UpdateConfigurationRequest.credentials:type_name -> google.protobuf.Struct
+	31, // 24: deployer.service.v1.UpdateConfigurationRequest.config:type_name -> google.protobuf.Struct
+	0,  // 25: deployer.service.v1.UpdateConfigurationRequest.status:type_name -> deployer.service.v1.ConfigurationStatus
+	31, // 26: deployer.service.v1.UpdateConfigurationRequest.credential_patch:type_name -> google.protobuf.Struct
+	32, // 27: deployer.service.v1.UpdateConfigurationRequest.retry_policy:type_name -> deployer.service.v1.RetryPolicy
+	2,  // 28: deployer.service.v1.UpdateConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
+	31, // 29: deployer.service.v1.ConfigurationRevision.config:type_name -> google.protobuf.Struct
+	30, // 30: deployer.service.v1.ConfigurationRevision.create_time:type_name -> google.protobuf.Timestamp
+	13, // 31: deployer.service.v1.ListConfigurationRevisionsResponse.items:type_name -> deployer.service.v1.ConfigurationRevision
+	33, // 32: deployer.service.v1.DiffConfigurationRevisionsResponse.changes:type_name -> deployer.service.v1.FieldChange
+	2,  // 33: deployer.service.v1.RevertConfigurationResponse.configuration:type_name -> deployer.service.v1.TargetConfiguration
+	2,  // 34: deployer.service.v1.ExpiringConfiguration.configuration:type_name -> deployer.service.v1.TargetConfiguration
+	23, // 35: deployer.service.v1.ListExpiringConfigurationsResponse.items:type_name -> deployer.service.v1.ExpiringConfiguration
+	31, // 36: deployer.service.v1.ValidateConfigurationCredentialsRequest.credentials:type_name -> google.protobuf.Struct
+	31, // 37: deployer.service.v1.ValidateConfigurationCredentialsRequest.config:type_name -> google.protobuf.Struct
+	3,  // 38: deployer.service.v1.ListConfigurationProvidersResponse.providers:type_name -> deployer.service.v1.ProviderInfo
+	1,  // 39: deployer.service.v1.TargetConfiguration.CredentialsEntry.value:type_name -> deployer.service.v1.CredentialFieldStatus
+	4,  // 40: deployer.service.v1.TargetConfigurationService.CreateConfiguration:input_type -> deployer.service.v1.CreateConfigurationRequest
+	6,  // 41: deployer.service.v1.TargetConfigurationService.GetConfiguration:input_type -> deployer.service.v1.GetConfigurationRequest
+	8,  // 42: deployer.service.v1.TargetConfigurationService.ListConfigurations:input_type -> deployer.service.v1.ListConfigurationsRequest
+	10, // 43: deployer.service.v1.TargetConfigurationService.UpdateConfiguration:input_type -> deployer.service.v1.UpdateConfigurationRequest
+	12, // 44: deployer.service.v1.TargetConfigurationService.DeleteConfiguration:input_type -> deployer.service.v1.DeleteConfigurationRequest
+	25, // 45: deployer.service.v1.TargetConfigurationService.ValidateCredentials:input_type -> deployer.service.v1.ValidateConfigurationCredentialsRequest
+	14, // 46: deployer.service.v1.TargetConfigurationService.ListConfigurationRevisions:input_type -> deployer.service.v1.ListConfigurationRevisionsRequest
+	16, // 47: deployer.service.v1.TargetConfigurationService.DiffConfigurationRevisions:input_type -> deployer.service.v1.DiffConfigurationRevisionsRequest
+	18, // 48: deployer.service.v1.TargetConfigurationService.RevertConfiguration:input_type -> deployer.service.v1.RevertConfigurationRequest
+	20, // 49: deployer.service.v1.TargetConfigurationService.RotateEncryptionKey:input_type -> deployer.service.v1.RotateEncryptionKeyRequest
+	22, // 50: deployer.service.v1.TargetConfigurationService.ListExpiringConfigurations:input_type -> deployer.service.v1.ListExpiringConfigurationsRequest
+	27, // 51: deployer.service.v1.TargetConfigurationService.ListProviders:input_type -> deployer.service.v1.ListConfigurationProvidersRequest
+	5,  // 52: deployer.service.v1.TargetConfigurationService.CreateConfiguration:output_type -> deployer.service.v1.CreateConfigurationResponse
+	7,  // 53: deployer.service.v1.TargetConfigurationService.GetConfiguration:output_type -> deployer.service.v1.GetConfigurationResponse
+	9,  // 54: deployer.service.v1.TargetConfigurationService.ListConfigurations:output_type -> deployer.service.v1.ListConfigurationsResponse
+	11, // 55: deployer.service.v1.TargetConfigurationService.UpdateConfiguration:output_type -> deployer.service.v1.UpdateConfigurationResponse
+	34, // 56: deployer.service.v1.TargetConfigurationService.DeleteConfiguration:output_type -> google.protobuf.Empty
+	26, // 57: deployer.service.v1.TargetConfigurationService.ValidateCredentials:output_type -> deployer.service.v1.ValidateConfigurationCredentialsResponse
+	15, // 58: deployer.service.v1.TargetConfigurationService.ListConfigurationRevisions:output_type -> deployer.service.v1.ListConfigurationRevisionsResponse
+	17, // 59: deployer.service.v1.TargetConfigurationService.DiffConfigurationRevisions:output_type -> deployer.service.v1.DiffConfigurationRevisionsResponse
+	19, // 60: deployer.service.v1.TargetConfigurationService.RevertConfiguration:output_type -> deployer.service.v1.RevertConfigurationResponse
+	21, // 61: deployer.service.v1.TargetConfigurationService.RotateEncryptionKey:output_type -> deployer.service.v1.RotateEncryptionKeyResponse
+	24, // 62: deployer.service.v1.TargetConfigurationService.ListExpiringConfigurations:output_type -> deployer.service.v1.ListExpiringConfigurationsResponse
+	28, // 63: deployer.service.v1.TargetConfigurationService.ListProviders:output_type -> deployer.service.v1.ListConfigurationProvidersResponse
+	52, // [52:64] is the sub-list for method output_type
+	40, // [40:52] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_deployer_service_v1_target_configuration_proto_init() }
@@ -1233,18 +2551,28 @@ func file_deployer_service_v1_target_configuration_proto_init() {
 	if File_deployer_service_v1_target_configuration_proto != nil {
 		return
 	}
+	file_deployer_service_v1_change_record_proto_init()
+	file_deployer_service_v1_retry_policy_proto_init()
 	file_deployer_service_v1_target_configuration_proto_msgTypes[0].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[1].OneofWrappers = []any{}
 	file_deployer_service_v1_target_configuration_proto_msgTypes[2].OneofWrappers = []any{}
-	file_deployer_service_v1_target_configuration_proto_msgTypes[6].OneofWrappers = []any{}
-	file_deployer_service_v1_target_configuration_proto_msgTypes[8].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[3].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[7].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[9].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[11].OneofWrappers = []any{}
 	file_deployer_service_v1_target_configuration_proto_msgTypes[12].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[13].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[15].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[17].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[21].OneofWrappers = []any{}
+	file_deployer_service_v1_target_configuration_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deployer_service_v1_target_configuration_proto_rawDesc), len(file_deployer_service_v1_target_configuration_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -115,6 +115,61 @@ func (s *redactedTargetConfigurationServiceServer) ValidateCredentials(ctx conte
 	return res, err
 }
 
+// ListConfigurationRevisions is the redacted wrapper for the actual TargetConfigurationServiceServer.ListConfigurationRevisions method
+// Unary RPC
+func (s *redactedTargetConfigurationServiceServer) ListConfigurationRevisions(ctx context.Context, in *ListConfigurationRevisionsRequest) (*ListConfigurationRevisionsResponse, error) {
+	res, err := s.srv.ListConfigurationRevisions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DiffConfigurationRevisions is the redacted wrapper for the actual TargetConfigurationServiceServer.DiffConfigurationRevisions method
+// Unary RPC
+func (s *redactedTargetConfigurationServiceServer) DiffConfigurationRevisions(ctx context.Context, in *DiffConfigurationRevisionsRequest) (*DiffConfigurationRevisionsResponse, error) {
+	res, err := s.srv.DiffConfigurationRevisions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevertConfiguration is the redacted wrapper for the actual TargetConfigurationServiceServer.RevertConfiguration method
+// Unary RPC
+func (s *redactedTargetConfigurationServiceServer) RevertConfiguration(ctx context.Context, in *RevertConfigurationRequest) (*RevertConfigurationResponse, error) {
+	res, err := s.srv.RevertConfiguration(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RotateEncryptionKey is the redacted wrapper for the actual TargetConfigurationServiceServer.RotateEncryptionKey method
+// Unary RPC
+func (s *redactedTargetConfigurationServiceServer) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error) {
+	res, err := s.srv.RotateEncryptionKey(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListExpiringConfigurations is the redacted wrapper for the actual TargetConfigurationServiceServer.ListExpiringConfigurations method
+// Unary RPC
+func (s *redactedTargetConfigurationServiceServer) ListExpiringConfigurations(ctx context.Context, in *ListExpiringConfigurationsRequest) (*ListExpiringConfigurationsResponse, error) {
+	res, err := s.srv.ListExpiringConfigurations(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // ListProviders is the redacted wrapper for the actual TargetConfigurationServiceServer.ListProviders method
 // Unary RPC
 func (s *redactedTargetConfigurationServiceServer) ListProviders(ctx context.Context, in *ListConfigurationProvidersRequest) (*ListConfigurationProvidersResponse, error) {
@@ -126,6 +181,20 @@ func (s *redactedTargetConfigurationServiceServer) ListProviders(ctx context.Con
 	return res, err
 }
 
+// Redact method implementation for CredentialFieldStatus
+func (x *CredentialFieldStatus) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Set
+
+	// Safe field: Required
+
+	// Safe field: LastRotatedAt
+	return x.String()
+}
+
 // Redact method implementation for TargetConfiguration
 func (x *TargetConfiguration) Redact() string {
 	if x == nil {
@@ -150,6 +219,32 @@ func (x *TargetConfiguration) Redact() string {
 
 	// Safe field: LastDeploymentAt
 
+	// Safe field: Revision
+
+	// Safe field: Credentials
+
+	// Safe field: RetryPolicy
+
+	// Safe field: ConsecutiveFailures
+
+	// Safe field: NextProbeAt
+
+	// Safe field: LastValidatedAt
+
+	// Safe field: LastValidationError
+
+	// Safe field: NextValidationAt
+
+	// Safe field: TlsProbeAddress
+
+	// Safe field: LastDriftCheckAt
+
+	// Safe field: NextDriftCheckAt
+
+	// Safe field: DeployedCertificateId
+
+	// Safe field: DeployedCertificateExpiresAt
+
 	// Safe field: CreatedBy
 
 	// Safe field: UpdatedBy
@@ -179,6 +274,16 @@ func (x *ProviderInfo) Redact() string {
 	// Safe field: RequiredConfigFields
 
 	// Safe field: RequiredCredentialFields
+
+	// Safe field: ConfigSchema
+
+	// Safe field: CredentialSchema
+
+	// Safe field: SupportsRemoval
+
+	// Safe field: SupportsPlan
+
+	// Safe field: SupportsInventory
 	return x.String()
 }
 
@@ -200,6 +305,12 @@ func (x *CreateConfigurationRequest) Redact() string {
 	x.Credentials = &structpb.Struct{}
 
 	// Safe field: Config
+
+	// Safe field: RetryPolicy
+
+	// Safe field: TlsProbeAddress
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -281,6 +392,17 @@ func (x *UpdateConfigurationRequest) Redact() string {
 	// Safe field: Config
 
 	// Safe field: Status
+
+	// Redacting field: CredentialPatch
+	x.CredentialPatch = &structpb.Struct{}
+
+	// Safe field: ClearCredentials
+
+	// Safe field: RetryPolicy
+
+	// Safe field: TlsProbeAddress
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -301,6 +423,194 @@ func (x *DeleteConfigurationRequest) Redact() string {
 	}
 
 	// Safe field: Id
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for ConfigurationRevision
+func (x *ConfigurationRevision) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: ConfigurationId
+
+	// Safe field: Revision
+
+	// Safe field: Name
+
+	// Safe field: Description
+
+	// Safe field: ProviderType
+
+	// Safe field: Config
+
+	// Safe field: RevertedFrom
+
+	// Safe field: Current
+
+	// Safe field: ActorId
+
+	// Safe field: ActorName
+
+	// Safe field: Reason
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for ListConfigurationRevisionsRequest
+func (x *ListConfigurationRevisionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListConfigurationRevisionsResponse
+func (x *ListConfigurationRevisionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for DiffConfigurationRevisionsRequest
+func (x *DiffConfigurationRevisionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: FromRevision
+
+	// Safe field: ToRevision
+	return x.String()
+}
+
+// Redact method implementation for DiffConfigurationRevisionsResponse
+func (x *DiffConfigurationRevisionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FromRevision
+
+	// Safe field: ToRevision
+
+	// Safe field: Changes
+	return x.String()
+}
+
+// Redact method implementation for RevertConfigurationRequest
+func (x *RevertConfigurationRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Revision
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for RevertConfigurationResponse
+func (x *RevertConfigurationResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Configuration
+	return x.String()
+}
+
+// Redact method implementation for RotateEncryptionKeyRequest
+func (x *RotateEncryptionKeyRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for RotateEncryptionKeyResponse
+func (x *RotateEncryptionKeyResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ActiveKeyId
+
+	// Safe field: ConfigurationsRewrapped
+
+	// Safe field: RevisionsRewrapped
+
+	// Safe field: AlreadyCurrent
+
+	// Safe field: Failed
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for ListExpiringConfigurationsRequest
+func (x *ListExpiringConfigurationsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: WithinDays
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ExpiringConfiguration
+func (x *ExpiringConfiguration) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Configuration
+
+	// Safe field: DaysUntilExpiry
+
+	// Safe field: DeploymentTargetIds
+	return x.String()
+}
+
+// Redact method implementation for ListExpiringConfigurationsResponse
+func (x *ListExpiringConfigurationsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
 	return x.String()
 }
 
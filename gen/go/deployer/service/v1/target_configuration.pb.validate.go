@@ -35,6 +35,145 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on CredentialFieldStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CredentialFieldStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CredentialFieldStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CredentialFieldStatusMultiError, or nil if none found.
+func (m *CredentialFieldStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CredentialFieldStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Set
+
+	// no validation rules for Required
+
+	if m.LastRotatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastRotatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CredentialFieldStatusValidationError{
+						field:  "LastRotatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CredentialFieldStatusValidationError{
+						field:  "LastRotatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastRotatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CredentialFieldStatusValidationError{
+					field:  "LastRotatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CredentialFieldStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// CredentialFieldStatusMultiError is an error wrapping multiple validation
+// errors returned by CredentialFieldStatus.ValidateAll() if the designated
+// constraints aren't met.
+type CredentialFieldStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CredentialFieldStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CredentialFieldStatusMultiError) AllErrors() []error { return m }
+
+// CredentialFieldStatusValidationError is the validation error returned by
+// CredentialFieldStatus.Validate if the designated constraints aren't met.
+type CredentialFieldStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CredentialFieldStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CredentialFieldStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CredentialFieldStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CredentialFieldStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CredentialFieldStatusValidationError) ErrorName() string {
+	return "CredentialFieldStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CredentialFieldStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCredentialFieldStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CredentialFieldStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CredentialFieldStatusValidationError{}
+
 // Validate checks the field values on TargetConfiguration with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -57,6 +196,52 @@ func (m *TargetConfiguration) validate(all bool) error {
 
 	var errors []error
 
+	{
+		sorted_keys := make([]string, len(m.GetCredentials()))
+		i := 0
+		for key := range m.GetCredentials() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCredentials()[key]
+			_ = val
+
+			// no validation rules for Credentials[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, TargetConfigurationValidationError{
+							field:  fmt.Sprintf("Credentials[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, TargetConfigurationValidationError{
+							field:  fmt.Sprintf("Credentials[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return TargetConfigurationValidationError{
+						field:  fmt.Sprintf("Credentials[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if m.Id != nil {
 		// no validation rules for Id
 	}
@@ -151,22 +336,55 @@ func (m *TargetConfiguration) validate(all bool) error {
 
 	}
 
-	if m.CreatedBy != nil {
-		// no validation rules for CreatedBy
+	if m.Revision != nil {
+		// no validation rules for Revision
 	}
 
-	if m.UpdatedBy != nil {
-		// no validation rules for UpdatedBy
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	if m.CreateTime != nil {
+	if m.ConsecutiveFailures != nil {
+		// no validation rules for ConsecutiveFailures
+	}
+
+	if m.NextProbeAt != nil {
 
 		if all {
-			switch v := interface{}(m.GetCreateTime()).(type) {
+			switch v := interface{}(m.GetNextProbeAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, TargetConfigurationValidationError{
-						field:  "CreateTime",
+						field:  "NextProbeAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -174,16 +392,123 @@ func (m *TargetConfiguration) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, TargetConfigurationValidationError{
-						field:  "CreateTime",
+						field:  "NextProbeAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetNextProbeAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return TargetConfigurationValidationError{
-					field:  "CreateTime",
+					field:  "NextProbeAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LastValidatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastValidatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "LastValidatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "LastValidatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastValidatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "LastValidatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LastValidationError != nil {
+		// no validation rules for LastValidationError
+	}
+
+	if m.NextValidationAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextValidationAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "NextValidationAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "NextValidationAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextValidationAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "NextValidationAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TlsProbeAddress != nil {
+		// no validation rules for TlsProbeAddress
+	}
+
+	if m.LastDriftCheckAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastDriftCheckAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "LastDriftCheckAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "LastDriftCheckAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastDriftCheckAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "LastDriftCheckAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -192,31 +517,1865 @@ func (m *TargetConfiguration) validate(all bool) error {
 
 	}
 
-	if m.UpdateTime != nil {
+	if m.NextDriftCheckAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextDriftCheckAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "NextDriftCheckAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "NextDriftCheckAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextDriftCheckAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "NextDriftCheckAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DeployedCertificateId != nil {
+		// no validation rules for DeployedCertificateId
+	}
+
+	if m.DeployedCertificateExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeployedCertificateExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "DeployedCertificateExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "DeployedCertificateExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeployedCertificateExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "DeployedCertificateExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
+	if m.CreateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "CreateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "CreateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.UpdateTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdateTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetConfigurationValidationError{
+						field:  "UpdateTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetConfigurationValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TargetConfigurationMultiError(errors)
+	}
+
+	return nil
+}
+
+// TargetConfigurationMultiError is an error wrapping multiple validation
+// errors returned by TargetConfiguration.ValidateAll() if the designated
+// constraints aren't met.
+type TargetConfigurationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TargetConfigurationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TargetConfigurationMultiError) AllErrors() []error { return m }
+
+// TargetConfigurationValidationError is the validation error returned by
+// TargetConfiguration.Validate if the designated constraints aren't met.
+type TargetConfigurationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TargetConfigurationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TargetConfigurationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TargetConfigurationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TargetConfigurationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TargetConfigurationValidationError) ErrorName() string {
+	return "TargetConfigurationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TargetConfigurationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTargetConfiguration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TargetConfigurationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TargetConfigurationValidationError{}
+
+// Validate checks the field values on ProviderInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProviderInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProviderInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProviderInfoMultiError, or
+// nil if none found.
+func (m *ProviderInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProviderInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for SupportsVerification
+
+	// no validation rules for SupportsRollback
+
+	// no validation rules for SupportsRemoval
+
+	// no validation rules for SupportsPlan
+
+	// no validation rules for SupportsInventory
+
+	if m.ConfigSchema != nil {
+
+		if all {
+			switch v := interface{}(m.GetConfigSchema()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProviderInfoValidationError{
+						field:  "ConfigSchema",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProviderInfoValidationError{
+						field:  "ConfigSchema",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfigSchema()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProviderInfoValidationError{
+					field:  "ConfigSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CredentialSchema != nil {
+
+		if all {
+			switch v := interface{}(m.GetCredentialSchema()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProviderInfoValidationError{
+						field:  "CredentialSchema",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProviderInfoValidationError{
+						field:  "CredentialSchema",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredentialSchema()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProviderInfoValidationError{
+					field:  "CredentialSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProviderInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProviderInfoMultiError is an error wrapping multiple validation errors
+// returned by ProviderInfo.ValidateAll() if the designated constraints aren't met.
+type ProviderInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProviderInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProviderInfoMultiError) AllErrors() []error { return m }
+
+// ProviderInfoValidationError is the validation error returned by
+// ProviderInfo.Validate if the designated constraints aren't met.
+type ProviderInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProviderInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProviderInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProviderInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProviderInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProviderInfoValidationError) ErrorName() string { return "ProviderInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProviderInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProviderInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProviderInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProviderInfoValidationError{}
+
+// Validate checks the field values on CreateConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigurationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigurationRequestMultiError, or nil if none found.
+func (m *CreateConfigurationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigurationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Name
+
+	// no validation rules for ProviderType
+
+	if all {
+		switch v := interface{}(m.GetCredentials()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigurationRequestValidationError{
+					field:  "Credentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigurationRequestValidationError{
+					field:  "Credentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigurationRequestValidationError{
+				field:  "Credentials",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Config != nil {
+
+		if all {
+			switch v := interface{}(m.GetConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateConfigurationRequestValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateConfigurationRequestValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateConfigurationRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateConfigurationRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateConfigurationRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateConfigurationRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TlsProbeAddress != nil {
+		// no validation rules for TlsProbeAddress
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigurationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigurationRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateConfigurationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateConfigurationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigurationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigurationRequestMultiError) AllErrors() []error { return m }
+
+// CreateConfigurationRequestValidationError is the validation error returned
+// by CreateConfigurationRequest.Validate if the designated constraints aren't met.
+type CreateConfigurationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigurationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigurationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigurationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigurationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigurationRequestValidationError) ErrorName() string {
+	return "CreateConfigurationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigurationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigurationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigurationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigurationRequestValidationError{}
+
+// Validate checks the field values on CreateConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigurationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigurationResponseMultiError, or nil if none found.
+func (m *CreateConfigurationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigurationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfiguration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigurationResponseValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigurationResponseValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigurationResponseValidationError{
+				field:  "Configuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigurationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigurationResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateConfigurationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateConfigurationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigurationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigurationResponseMultiError) AllErrors() []error { return m }
+
+// CreateConfigurationResponseValidationError is the validation error returned
+// by CreateConfigurationResponse.Validate if the designated constraints
+// aren't met.
+type CreateConfigurationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigurationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigurationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigurationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigurationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigurationResponseValidationError) ErrorName() string {
+	return "CreateConfigurationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigurationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigurationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigurationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigurationResponseValidationError{}
+
+// Validate checks the field values on GetConfigurationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigurationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigurationRequestMultiError, or nil if none found.
+func (m *GetConfigurationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigurationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetConfigurationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigurationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetConfigurationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConfigurationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigurationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigurationRequestMultiError) AllErrors() []error { return m }
+
+// GetConfigurationRequestValidationError is the validation error returned by
+// GetConfigurationRequest.Validate if the designated constraints aren't met.
+type GetConfigurationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigurationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigurationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigurationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigurationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigurationRequestValidationError) ErrorName() string {
+	return "GetConfigurationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConfigurationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigurationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigurationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigurationRequestValidationError{}
+
+// Validate checks the field values on GetConfigurationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigurationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigurationResponseMultiError, or nil if none found.
+func (m *GetConfigurationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigurationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfiguration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConfigurationResponseValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConfigurationResponseValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConfigurationResponseValidationError{
+				field:  "Configuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetConfigurationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigurationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetConfigurationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConfigurationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigurationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigurationResponseMultiError) AllErrors() []error { return m }
+
+// GetConfigurationResponseValidationError is the validation error returned by
+// GetConfigurationResponse.Validate if the designated constraints aren't met.
+type GetConfigurationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigurationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigurationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigurationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigurationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigurationResponseValidationError) ErrorName() string {
+	return "GetConfigurationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConfigurationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigurationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigurationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigurationResponseValidationError{}
+
+// Validate checks the field values on ListConfigurationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigurationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigurationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigurationsRequestMultiError, or nil if none found.
+func (m *ListConfigurationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigurationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.ProviderType != nil {
+		// no validation rules for ProviderType
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListConfigurationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigurationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListConfigurationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListConfigurationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigurationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigurationsRequestMultiError) AllErrors() []error { return m }
+
+// ListConfigurationsRequestValidationError is the validation error returned by
+// ListConfigurationsRequest.Validate if the designated constraints aren't met.
+type ListConfigurationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigurationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigurationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigurationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigurationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigurationsRequestValidationError) ErrorName() string {
+	return "ListConfigurationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigurationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigurationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigurationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigurationsRequestValidationError{}
+
+// Validate checks the field values on ListConfigurationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigurationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigurationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigurationsResponseMultiError, or nil if none found.
+func (m *ListConfigurationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigurationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConfigurationsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConfigurationsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConfigurationsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListConfigurationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigurationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListConfigurationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListConfigurationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigurationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigurationsResponseMultiError) AllErrors() []error { return m }
+
+// ListConfigurationsResponseValidationError is the validation error returned
+// by ListConfigurationsResponse.Validate if the designated constraints aren't met.
+type ListConfigurationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigurationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigurationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigurationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigurationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigurationsResponseValidationError) ErrorName() string {
+	return "ListConfigurationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigurationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigurationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigurationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigurationsResponseValidationError{}
+
+// Validate checks the field values on UpdateConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateConfigurationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateConfigurationRequestMultiError, or nil if none found.
+func (m *UpdateConfigurationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConfigurationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Credentials != nil {
+
+		if all {
+			switch v := interface{}(m.GetCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateConfigurationRequestValidationError{
+					field:  "Credentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Config != nil {
+
+		if all {
+			switch v := interface{}(m.GetConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateConfigurationRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.CredentialPatch != nil {
+
+		if all {
+			switch v := interface{}(m.GetCredentialPatch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "CredentialPatch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "CredentialPatch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredentialPatch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateConfigurationRequestValidationError{
+					field:  "CredentialPatch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateConfigurationRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateConfigurationRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TlsProbeAddress != nil {
+		// no validation rules for TlsProbeAddress
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return UpdateConfigurationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConfigurationRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateConfigurationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateConfigurationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConfigurationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConfigurationRequestMultiError) AllErrors() []error { return m }
+
+// UpdateConfigurationRequestValidationError is the validation error returned
+// by UpdateConfigurationRequest.Validate if the designated constraints aren't met.
+type UpdateConfigurationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConfigurationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConfigurationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConfigurationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConfigurationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConfigurationRequestValidationError) ErrorName() string {
+	return "UpdateConfigurationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConfigurationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConfigurationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConfigurationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConfigurationRequestValidationError{}
+
+// Validate checks the field values on UpdateConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateConfigurationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateConfigurationResponseMultiError, or nil if none found.
+func (m *UpdateConfigurationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConfigurationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfiguration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigurationResponseValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigurationResponseValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigurationResponseValidationError{
+				field:  "Configuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateConfigurationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConfigurationResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateConfigurationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateConfigurationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConfigurationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConfigurationResponseMultiError) AllErrors() []error { return m }
+
+// UpdateConfigurationResponseValidationError is the validation error returned
+// by UpdateConfigurationResponse.Validate if the designated constraints
+// aren't met.
+type UpdateConfigurationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConfigurationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConfigurationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConfigurationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConfigurationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConfigurationResponseValidationError) ErrorName() string {
+	return "UpdateConfigurationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConfigurationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConfigurationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConfigurationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConfigurationResponseValidationError{}
+
+// Validate checks the field values on DeleteConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteConfigurationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteConfigurationRequestMultiError, or nil if none found.
+func (m *DeleteConfigurationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConfigurationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return DeleteConfigurationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteConfigurationRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteConfigurationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteConfigurationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteConfigurationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteConfigurationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteConfigurationRequestValidationError is the validation error returned
+// by DeleteConfigurationRequest.Validate if the designated constraints aren't met.
+type DeleteConfigurationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConfigurationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConfigurationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConfigurationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConfigurationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConfigurationRequestValidationError) ErrorName() string {
+	return "DeleteConfigurationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConfigurationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConfigurationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConfigurationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConfigurationRequestValidationError{}
+
+// Validate checks the field values on ConfigurationRevision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfigurationRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigurationRevision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigurationRevisionMultiError, or nil if none found.
+func (m *ConfigurationRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigurationRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ConfigurationId
+
+	// no validation rules for Revision
+
+	// no validation rules for Name
+
+	// no validation rules for ProviderType
+
+	// no validation rules for Current
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Config != nil {
+
+		if all {
+			switch v := interface{}(m.GetConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigurationRevisionValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigurationRevisionValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigurationRevisionValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RevertedFrom != nil {
+		// no validation rules for RevertedFrom
+	}
+
+	if m.ActorId != nil {
+		// no validation rules for ActorId
+	}
+
+	if m.ActorName != nil {
+		// no validation rules for ActorName
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if m.CreateTime != nil {
 
 		if all {
-			switch v := interface{}(m.GetUpdateTime()).(type) {
+			switch v := interface{}(m.GetCreateTime()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TargetConfigurationValidationError{
-						field:  "UpdateTime",
+					errors = append(errors, ConfigurationRevisionValidationError{
+						field:  "CreateTime",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TargetConfigurationValidationError{
-						field:  "UpdateTime",
+					errors = append(errors, ConfigurationRevisionValidationError{
+						field:  "CreateTime",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TargetConfigurationValidationError{
-					field:  "UpdateTime",
+				return ConfigurationRevisionValidationError{
+					field:  "CreateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -226,19 +2385,19 @@ func (m *TargetConfiguration) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TargetConfigurationMultiError(errors)
+		return ConfigurationRevisionMultiError(errors)
 	}
 
 	return nil
 }
 
-// TargetConfigurationMultiError is an error wrapping multiple validation
-// errors returned by TargetConfiguration.ValidateAll() if the designated
+// ConfigurationRevisionMultiError is an error wrapping multiple validation
+// errors returned by ConfigurationRevision.ValidateAll() if the designated
 // constraints aren't met.
-type TargetConfigurationMultiError []error
+type ConfigurationRevisionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TargetConfigurationMultiError) Error() string {
+func (m ConfigurationRevisionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -247,11 +2406,11 @@ func (m TargetConfigurationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TargetConfigurationMultiError) AllErrors() []error { return m }
+func (m ConfigurationRevisionMultiError) AllErrors() []error { return m }
 
-// TargetConfigurationValidationError is the validation error returned by
-// TargetConfiguration.Validate if the designated constraints aren't met.
-type TargetConfigurationValidationError struct {
+// ConfigurationRevisionValidationError is the validation error returned by
+// ConfigurationRevision.Validate if the designated constraints aren't met.
+type ConfigurationRevisionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -259,24 +2418,24 @@ type TargetConfigurationValidationError struct {
 }
 
 // Field function returns field value.
-func (e TargetConfigurationValidationError) Field() string { return e.field }
+func (e ConfigurationRevisionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TargetConfigurationValidationError) Reason() string { return e.reason }
+func (e ConfigurationRevisionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TargetConfigurationValidationError) Cause() error { return e.cause }
+func (e ConfigurationRevisionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TargetConfigurationValidationError) Key() bool { return e.key }
+func (e ConfigurationRevisionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TargetConfigurationValidationError) ErrorName() string {
-	return "TargetConfigurationValidationError"
+func (e ConfigurationRevisionValidationError) ErrorName() string {
+	return "ConfigurationRevisionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TargetConfigurationValidationError) Error() string {
+func (e ConfigurationRevisionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -288,14 +2447,14 @@ func (e TargetConfigurationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTargetConfiguration.%s: %s%s",
+		"invalid %sConfigurationRevision.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TargetConfigurationValidationError{}
+var _ error = ConfigurationRevisionValidationError{}
 
 var _ interface {
 	Field() string
@@ -303,53 +2462,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TargetConfigurationValidationError{}
+} = ConfigurationRevisionValidationError{}
 
-// Validate checks the field values on ProviderInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ProviderInfo) Validate() error {
+// Validate checks the field values on ListConfigurationRevisionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListConfigurationRevisionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProviderInfo with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ProviderInfoMultiError, or
-// nil if none found.
-func (m *ProviderInfo) ValidateAll() error {
+// ValidateAll checks the field values on ListConfigurationRevisionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListConfigurationRevisionsRequestMultiError, or nil if none found.
+func (m *ListConfigurationRevisionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProviderInfo) validate(all bool) error {
+func (m *ListConfigurationRevisionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
+	// no validation rules for Id
 
-	// no validation rules for SupportsVerification
+	if m.Page != nil {
+		// no validation rules for Page
+	}
 
-	// no validation rules for SupportsRollback
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
 
 	if len(errors) > 0 {
-		return ProviderInfoMultiError(errors)
+		return ListConfigurationRevisionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProviderInfoMultiError is an error wrapping multiple validation errors
-// returned by ProviderInfo.ValidateAll() if the designated constraints aren't met.
-type ProviderInfoMultiError []error
+// ListConfigurationRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListConfigurationRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConfigurationRevisionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProviderInfoMultiError) Error() string {
+func (m ListConfigurationRevisionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -358,11 +2520,12 @@ func (m ProviderInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProviderInfoMultiError) AllErrors() []error { return m }
+func (m ListConfigurationRevisionsRequestMultiError) AllErrors() []error { return m }
 
-// ProviderInfoValidationError is the validation error returned by
-// ProviderInfo.Validate if the designated constraints aren't met.
-type ProviderInfoValidationError struct {
+// ListConfigurationRevisionsRequestValidationError is the validation error
+// returned by ListConfigurationRevisionsRequest.Validate if the designated
+// constraints aren't met.
+type ListConfigurationRevisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -370,22 +2533,24 @@ type ProviderInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProviderInfoValidationError) Field() string { return e.field }
+func (e ListConfigurationRevisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProviderInfoValidationError) Reason() string { return e.reason }
+func (e ListConfigurationRevisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProviderInfoValidationError) Cause() error { return e.cause }
+func (e ListConfigurationRevisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProviderInfoValidationError) Key() bool { return e.key }
+func (e ListConfigurationRevisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProviderInfoValidationError) ErrorName() string { return "ProviderInfoValidationError" }
+func (e ListConfigurationRevisionsRequestValidationError) ErrorName() string {
+	return "ListConfigurationRevisionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProviderInfoValidationError) Error() string {
+func (e ListConfigurationRevisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -397,14 +2562,14 @@ func (e ProviderInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProviderInfo.%s: %s%s",
+		"invalid %sListConfigurationRevisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProviderInfoValidationError{}
+var _ error = ListConfigurationRevisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -412,94 +2577,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProviderInfoValidationError{}
+} = ListConfigurationRevisionsRequestValidationError{}
 
-// Validate checks the field values on CreateConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateConfigurationRequest) Validate() error {
+// Validate checks the field values on ListConfigurationRevisionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListConfigurationRevisionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateConfigurationRequestMultiError, or nil if none found.
-func (m *CreateConfigurationRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListConfigurationRevisionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListConfigurationRevisionsResponseMultiError, or nil if none found.
+func (m *ListConfigurationRevisionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateConfigurationRequest) validate(all bool) error {
+func (m *ListConfigurationRevisionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
-
-	// no validation rules for Name
-
-	// no validation rules for ProviderType
-
-	if all {
-		switch v := interface{}(m.GetCredentials()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateConfigurationRequestValidationError{
-					field:  "Credentials",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateConfigurationRequestValidationError{
-					field:  "Credentials",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateConfigurationRequestValidationError{
-				field:  "Credentials",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.Description != nil {
-		// no validation rules for Description
-	}
-
-	if m.Config != nil {
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetConfig()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateConfigurationRequestValidationError{
-						field:  "Config",
+					errors = append(errors, ListConfigurationRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateConfigurationRequestValidationError{
-						field:  "Config",
+					errors = append(errors, ListConfigurationRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateConfigurationRequestValidationError{
-					field:  "Config",
+				return ListConfigurationRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -508,20 +2636,136 @@ func (m *CreateConfigurationRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return CreateConfigurationRequestMultiError(errors)
+		return ListConfigurationRevisionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateConfigurationRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateConfigurationRequest.ValidateAll() if
-// the designated constraints aren't met.
-type CreateConfigurationRequestMultiError []error
+// ListConfigurationRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListConfigurationRevisionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListConfigurationRevisionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateConfigurationRequestMultiError) Error() string {
+func (m ListConfigurationRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigurationRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListConfigurationRevisionsResponseValidationError is the validation error
+// returned by ListConfigurationRevisionsResponse.Validate if the designated
+// constraints aren't met.
+type ListConfigurationRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigurationRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigurationRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigurationRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigurationRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigurationRevisionsResponseValidationError) ErrorName() string {
+	return "ListConfigurationRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigurationRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigurationRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigurationRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigurationRevisionsResponseValidationError{}
+
+// Validate checks the field values on DiffConfigurationRevisionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DiffConfigurationRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffConfigurationRevisionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DiffConfigurationRevisionsRequestMultiError, or nil if none found.
+func (m *DiffConfigurationRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffConfigurationRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FromRevision
+
+	if m.ToRevision != nil {
+		// no validation rules for ToRevision
+	}
+
+	if len(errors) > 0 {
+		return DiffConfigurationRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffConfigurationRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DiffConfigurationRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiffConfigurationRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffConfigurationRevisionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -530,11 +2774,12 @@ func (m CreateConfigurationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateConfigurationRequestMultiError) AllErrors() []error { return m }
+func (m DiffConfigurationRevisionsRequestMultiError) AllErrors() []error { return m }
 
-// CreateConfigurationRequestValidationError is the validation error returned
-// by CreateConfigurationRequest.Validate if the designated constraints aren't met.
-type CreateConfigurationRequestValidationError struct {
+// DiffConfigurationRevisionsRequestValidationError is the validation error
+// returned by DiffConfigurationRevisionsRequest.Validate if the designated
+// constraints aren't met.
+type DiffConfigurationRevisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -542,24 +2787,24 @@ type CreateConfigurationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateConfigurationRequestValidationError) Field() string { return e.field }
+func (e DiffConfigurationRevisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateConfigurationRequestValidationError) Reason() string { return e.reason }
+func (e DiffConfigurationRevisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateConfigurationRequestValidationError) Cause() error { return e.cause }
+func (e DiffConfigurationRevisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateConfigurationRequestValidationError) Key() bool { return e.key }
+func (e DiffConfigurationRevisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateConfigurationRequestValidationError) ErrorName() string {
-	return "CreateConfigurationRequestValidationError"
+func (e DiffConfigurationRevisionsRequestValidationError) ErrorName() string {
+	return "DiffConfigurationRevisionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateConfigurationRequestValidationError) Error() string {
+func (e DiffConfigurationRevisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -571,14 +2816,14 @@ func (e CreateConfigurationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateConfigurationRequest.%s: %s%s",
+		"invalid %sDiffConfigurationRevisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateConfigurationRequestValidationError{}
+var _ error = DiffConfigurationRevisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -586,73 +2831,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateConfigurationRequestValidationError{}
+} = DiffConfigurationRevisionsRequestValidationError{}
 
-// Validate checks the field values on CreateConfigurationResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateConfigurationResponse) Validate() error {
+// Validate checks the field values on DiffConfigurationRevisionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DiffConfigurationRevisionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateConfigurationResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateConfigurationResponseMultiError, or nil if none found.
-func (m *CreateConfigurationResponse) ValidateAll() error {
+// ValidateAll checks the field values on DiffConfigurationRevisionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DiffConfigurationRevisionsResponseMultiError, or nil if none found.
+func (m *DiffConfigurationRevisionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateConfigurationResponse) validate(all bool) error {
+func (m *DiffConfigurationRevisionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfiguration()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateConfigurationResponseValidationError{
-					field:  "Configuration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for FromRevision
+
+	// no validation rules for ToRevision
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffConfigurationRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffConfigurationRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateConfigurationResponseValidationError{
-					field:  "Configuration",
+				return DiffConfigurationRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateConfigurationResponseValidationError{
-				field:  "Configuration",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateConfigurationResponseMultiError(errors)
+		return DiffConfigurationRevisionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateConfigurationResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateConfigurationResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateConfigurationResponseMultiError []error
+// DiffConfigurationRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DiffConfigurationRevisionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiffConfigurationRevisionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateConfigurationResponseMultiError) Error() string {
+func (m DiffConfigurationRevisionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -661,12 +2917,12 @@ func (m CreateConfigurationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateConfigurationResponseMultiError) AllErrors() []error { return m }
+func (m DiffConfigurationRevisionsResponseMultiError) AllErrors() []error { return m }
 
-// CreateConfigurationResponseValidationError is the validation error returned
-// by CreateConfigurationResponse.Validate if the designated constraints
-// aren't met.
-type CreateConfigurationResponseValidationError struct {
+// DiffConfigurationRevisionsResponseValidationError is the validation error
+// returned by DiffConfigurationRevisionsResponse.Validate if the designated
+// constraints aren't met.
+type DiffConfigurationRevisionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -674,24 +2930,24 @@ type CreateConfigurationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateConfigurationResponseValidationError) Field() string { return e.field }
+func (e DiffConfigurationRevisionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateConfigurationResponseValidationError) Reason() string { return e.reason }
+func (e DiffConfigurationRevisionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateConfigurationResponseValidationError) Cause() error { return e.cause }
+func (e DiffConfigurationRevisionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateConfigurationResponseValidationError) Key() bool { return e.key }
+func (e DiffConfigurationRevisionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateConfigurationResponseValidationError) ErrorName() string {
-	return "CreateConfigurationResponseValidationError"
+func (e DiffConfigurationRevisionsResponseValidationError) ErrorName() string {
+	return "DiffConfigurationRevisionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateConfigurationResponseValidationError) Error() string {
+func (e DiffConfigurationRevisionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -703,14 +2959,14 @@ func (e CreateConfigurationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateConfigurationResponse.%s: %s%s",
+		"invalid %sDiffConfigurationRevisionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateConfigurationResponseValidationError{}
+var _ error = DiffConfigurationRevisionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -718,24 +2974,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateConfigurationResponseValidationError{}
+} = DiffConfigurationRevisionsResponseValidationError{}
 
-// Validate checks the field values on GetConfigurationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevertConfigurationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConfigurationRequest) Validate() error {
+func (m *RevertConfigurationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConfigurationRequest with the
+// ValidateAll checks the field values on RevertConfigurationRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetConfigurationRequestMultiError, or nil if none found.
-func (m *GetConfigurationRequest) ValidateAll() error {
+// RevertConfigurationRequestMultiError, or nil if none found.
+func (m *RevertConfigurationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConfigurationRequest) validate(all bool) error {
+func (m *RevertConfigurationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -744,20 +3000,26 @@ func (m *GetConfigurationRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Revision
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
-		return GetConfigurationRequestMultiError(errors)
+		return RevertConfigurationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConfigurationRequestMultiError is an error wrapping multiple validation
-// errors returned by GetConfigurationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetConfigurationRequestMultiError []error
+// RevertConfigurationRequestMultiError is an error wrapping multiple
+// validation errors returned by RevertConfigurationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevertConfigurationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConfigurationRequestMultiError) Error() string {
+func (m RevertConfigurationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -766,11 +3028,11 @@ func (m GetConfigurationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConfigurationRequestMultiError) AllErrors() []error { return m }
+func (m RevertConfigurationRequestMultiError) AllErrors() []error { return m }
 
-// GetConfigurationRequestValidationError is the validation error returned by
-// GetConfigurationRequest.Validate if the designated constraints aren't met.
-type GetConfigurationRequestValidationError struct {
+// RevertConfigurationRequestValidationError is the validation error returned
+// by RevertConfigurationRequest.Validate if the designated constraints aren't met.
+type RevertConfigurationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -778,24 +3040,24 @@ type GetConfigurationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConfigurationRequestValidationError) Field() string { return e.field }
+func (e RevertConfigurationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConfigurationRequestValidationError) Reason() string { return e.reason }
+func (e RevertConfigurationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConfigurationRequestValidationError) Cause() error { return e.cause }
+func (e RevertConfigurationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConfigurationRequestValidationError) Key() bool { return e.key }
+func (e RevertConfigurationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConfigurationRequestValidationError) ErrorName() string {
-	return "GetConfigurationRequestValidationError"
+func (e RevertConfigurationRequestValidationError) ErrorName() string {
+	return "RevertConfigurationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConfigurationRequestValidationError) Error() string {
+func (e RevertConfigurationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -807,14 +3069,14 @@ func (e GetConfigurationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConfigurationRequest.%s: %s%s",
+		"invalid %sRevertConfigurationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConfigurationRequestValidationError{}
+var _ error = RevertConfigurationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -822,24 +3084,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConfigurationRequestValidationError{}
+} = RevertConfigurationRequestValidationError{}
 
-// Validate checks the field values on GetConfigurationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevertConfigurationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConfigurationResponse) Validate() error {
+func (m *RevertConfigurationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConfigurationResponse with the
+// ValidateAll checks the field values on RevertConfigurationResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetConfigurationResponseMultiError, or nil if none found.
-func (m *GetConfigurationResponse) ValidateAll() error {
+// RevertConfigurationResponseMultiError, or nil if none found.
+func (m *RevertConfigurationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConfigurationResponse) validate(all bool) error {
+func (m *RevertConfigurationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -850,7 +3112,7 @@ func (m *GetConfigurationResponse) validate(all bool) error {
 		switch v := interface{}(m.GetConfiguration()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConfigurationResponseValidationError{
+				errors = append(errors, RevertConfigurationResponseValidationError{
 					field:  "Configuration",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -858,7 +3120,7 @@ func (m *GetConfigurationResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConfigurationResponseValidationError{
+				errors = append(errors, RevertConfigurationResponseValidationError{
 					field:  "Configuration",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -867,7 +3129,7 @@ func (m *GetConfigurationResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetConfigurationResponseValidationError{
+			return RevertConfigurationResponseValidationError{
 				field:  "Configuration",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -876,19 +3138,19 @@ func (m *GetConfigurationResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetConfigurationResponseMultiError(errors)
+		return RevertConfigurationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConfigurationResponseMultiError is an error wrapping multiple validation
-// errors returned by GetConfigurationResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetConfigurationResponseMultiError []error
+// RevertConfigurationResponseMultiError is an error wrapping multiple
+// validation errors returned by RevertConfigurationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevertConfigurationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConfigurationResponseMultiError) Error() string {
+func (m RevertConfigurationResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -897,11 +3159,12 @@ func (m GetConfigurationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConfigurationResponseMultiError) AllErrors() []error { return m }
+func (m RevertConfigurationResponseMultiError) AllErrors() []error { return m }
 
-// GetConfigurationResponseValidationError is the validation error returned by
-// GetConfigurationResponse.Validate if the designated constraints aren't met.
-type GetConfigurationResponseValidationError struct {
+// RevertConfigurationResponseValidationError is the validation error returned
+// by RevertConfigurationResponse.Validate if the designated constraints
+// aren't met.
+type RevertConfigurationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -909,24 +3172,24 @@ type GetConfigurationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConfigurationResponseValidationError) Field() string { return e.field }
+func (e RevertConfigurationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConfigurationResponseValidationError) Reason() string { return e.reason }
+func (e RevertConfigurationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConfigurationResponseValidationError) Cause() error { return e.cause }
+func (e RevertConfigurationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConfigurationResponseValidationError) Key() bool { return e.key }
+func (e RevertConfigurationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConfigurationResponseValidationError) ErrorName() string {
-	return "GetConfigurationResponseValidationError"
+func (e RevertConfigurationResponseValidationError) ErrorName() string {
+	return "RevertConfigurationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConfigurationResponseValidationError) Error() string {
+func (e RevertConfigurationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -938,14 +3201,14 @@ func (e GetConfigurationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConfigurationResponse.%s: %s%s",
+		"invalid %sRevertConfigurationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConfigurationResponseValidationError{}
+var _ error = RevertConfigurationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -953,64 +3216,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConfigurationResponseValidationError{}
+} = RevertConfigurationResponseValidationError{}
 
-// Validate checks the field values on ListConfigurationsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RotateEncryptionKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConfigurationsRequest) Validate() error {
+func (m *RotateEncryptionKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConfigurationsRequest with the
+// ValidateAll checks the field values on RotateEncryptionKeyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListConfigurationsRequestMultiError, or nil if none found.
-func (m *ListConfigurationsRequest) ValidateAll() error {
+// RotateEncryptionKeyRequestMultiError, or nil if none found.
+func (m *RotateEncryptionKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConfigurationsRequest) validate(all bool) error {
+func (m *RotateEncryptionKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TenantId != nil {
-		// no validation rules for TenantId
-	}
-
-	if m.ProviderType != nil {
-		// no validation rules for ProviderType
-	}
-
-	if m.Status != nil {
-		// no validation rules for Status
-	}
-
-	if m.Page != nil {
-		// no validation rules for Page
-	}
-
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return ListConfigurationsRequestMultiError(errors)
+		return RotateEncryptionKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConfigurationsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListConfigurationsRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListConfigurationsRequestMultiError []error
+// RotateEncryptionKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by RotateEncryptionKeyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RotateEncryptionKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConfigurationsRequestMultiError) Error() string {
+func (m RotateEncryptionKeyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1019,11 +3264,11 @@ func (m ListConfigurationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConfigurationsRequestMultiError) AllErrors() []error { return m }
+func (m RotateEncryptionKeyRequestMultiError) AllErrors() []error { return m }
 
-// ListConfigurationsRequestValidationError is the validation error returned by
-// ListConfigurationsRequest.Validate if the designated constraints aren't met.
-type ListConfigurationsRequestValidationError struct {
+// RotateEncryptionKeyRequestValidationError is the validation error returned
+// by RotateEncryptionKeyRequest.Validate if the designated constraints aren't met.
+type RotateEncryptionKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1031,24 +3276,24 @@ type ListConfigurationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConfigurationsRequestValidationError) Field() string { return e.field }
+func (e RotateEncryptionKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConfigurationsRequestValidationError) Reason() string { return e.reason }
+func (e RotateEncryptionKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConfigurationsRequestValidationError) Cause() error { return e.cause }
+func (e RotateEncryptionKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConfigurationsRequestValidationError) Key() bool { return e.key }
+func (e RotateEncryptionKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConfigurationsRequestValidationError) ErrorName() string {
-	return "ListConfigurationsRequestValidationError"
+func (e RotateEncryptionKeyRequestValidationError) ErrorName() string {
+	return "RotateEncryptionKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConfigurationsRequestValidationError) Error() string {
+func (e RotateEncryptionKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1060,14 +3305,14 @@ func (e ListConfigurationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConfigurationsRequest.%s: %s%s",
+		"invalid %sRotateEncryptionKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConfigurationsRequestValidationError{}
+var _ error = RotateEncryptionKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1075,80 +3320,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConfigurationsRequestValidationError{}
+} = RotateEncryptionKeyRequestValidationError{}
 
-// Validate checks the field values on ListConfigurationsResponse with the
+// Validate checks the field values on RotateEncryptionKeyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConfigurationsResponse) Validate() error {
+func (m *RotateEncryptionKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConfigurationsResponse with the
+// ValidateAll checks the field values on RotateEncryptionKeyResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListConfigurationsResponseMultiError, or nil if none found.
-func (m *ListConfigurationsResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListConfigurationsResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListConfigurationsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListConfigurationsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListConfigurationsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// violated, the result is a list of violation errors wrapped in
+// RotateEncryptionKeyResponseMultiError, or nil if none found.
+func (m *RotateEncryptionKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *RotateEncryptionKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for Total
+	var errors []error
+
+	// no validation rules for ActiveKeyId
+
+	// no validation rules for ConfigurationsRewrapped
+
+	// no validation rules for RevisionsRewrapped
+
+	// no validation rules for AlreadyCurrent
+
+	// no validation rules for Failed
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return ListConfigurationsResponseMultiError(errors)
+		return RotateEncryptionKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConfigurationsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListConfigurationsResponse.ValidateAll() if
+// RotateEncryptionKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by RotateEncryptionKeyResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListConfigurationsResponseMultiError []error
+type RotateEncryptionKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConfigurationsResponseMultiError) Error() string {
+func (m RotateEncryptionKeyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1157,11 +3378,12 @@ func (m ListConfigurationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConfigurationsResponseMultiError) AllErrors() []error { return m }
+func (m RotateEncryptionKeyResponseMultiError) AllErrors() []error { return m }
 
-// ListConfigurationsResponseValidationError is the validation error returned
-// by ListConfigurationsResponse.Validate if the designated constraints aren't met.
-type ListConfigurationsResponseValidationError struct {
+// RotateEncryptionKeyResponseValidationError is the validation error returned
+// by RotateEncryptionKeyResponse.Validate if the designated constraints
+// aren't met.
+type RotateEncryptionKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1169,24 +3391,24 @@ type ListConfigurationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConfigurationsResponseValidationError) Field() string { return e.field }
+func (e RotateEncryptionKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConfigurationsResponseValidationError) Reason() string { return e.reason }
+func (e RotateEncryptionKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConfigurationsResponseValidationError) Cause() error { return e.cause }
+func (e RotateEncryptionKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConfigurationsResponseValidationError) Key() bool { return e.key }
+func (e RotateEncryptionKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConfigurationsResponseValidationError) ErrorName() string {
-	return "ListConfigurationsResponseValidationError"
+func (e RotateEncryptionKeyResponseValidationError) ErrorName() string {
+	return "RotateEncryptionKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConfigurationsResponseValidationError) Error() string {
+func (e RotateEncryptionKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1198,14 +3420,14 @@ func (e ListConfigurationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConfigurationsResponse.%s: %s%s",
+		"invalid %sRotateEncryptionKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConfigurationsResponseValidationError{}
+var _ error = RotateEncryptionKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1213,124 +3435,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConfigurationsResponseValidationError{}
+} = RotateEncryptionKeyResponseValidationError{}
 
-// Validate checks the field values on UpdateConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateConfigurationRequest) Validate() error {
+// Validate checks the field values on ListExpiringConfigurationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListExpiringConfigurationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateConfigurationRequestMultiError, or nil if none found.
-func (m *UpdateConfigurationRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListExpiringConfigurationsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListExpiringConfigurationsRequestMultiError, or nil if none found.
+func (m *ListExpiringConfigurationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateConfigurationRequest) validate(all bool) error {
+func (m *ListExpiringConfigurationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.Name != nil {
-		// no validation rules for Name
-	}
-
-	if m.Description != nil {
-		// no validation rules for Description
+	if m.TenantId != nil {
+		// no validation rules for TenantId
 	}
 
-	if m.Credentials != nil {
-
-		if all {
-			switch v := interface{}(m.GetCredentials()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateConfigurationRequestValidationError{
-						field:  "Credentials",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateConfigurationRequestValidationError{
-						field:  "Credentials",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateConfigurationRequestValidationError{
-					field:  "Credentials",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+	if m.WithinDays != nil {
+		// no validation rules for WithinDays
 	}
 
-	if m.Config != nil {
-
-		if all {
-			switch v := interface{}(m.GetConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateConfigurationRequestValidationError{
-						field:  "Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateConfigurationRequestValidationError{
-						field:  "Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateConfigurationRequestValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+	if m.Page != nil {
+		// no validation rules for Page
 	}
 
-	if m.Status != nil {
-		// no validation rules for Status
+	if m.PageSize != nil {
+		// no validation rules for PageSize
 	}
 
 	if len(errors) > 0 {
-		return UpdateConfigurationRequestMultiError(errors)
+		return ListExpiringConfigurationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateConfigurationRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateConfigurationRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateConfigurationRequestMultiError []error
+// ListExpiringConfigurationsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListExpiringConfigurationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListExpiringConfigurationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateConfigurationRequestMultiError) Error() string {
+func (m ListExpiringConfigurationsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1339,11 +3499,12 @@ func (m UpdateConfigurationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateConfigurationRequestMultiError) AllErrors() []error { return m }
+func (m ListExpiringConfigurationsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateConfigurationRequestValidationError is the validation error returned
-// by UpdateConfigurationRequest.Validate if the designated constraints aren't met.
-type UpdateConfigurationRequestValidationError struct {
+// ListExpiringConfigurationsRequestValidationError is the validation error
+// returned by ListExpiringConfigurationsRequest.Validate if the designated
+// constraints aren't met.
+type ListExpiringConfigurationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1351,24 +3512,24 @@ type UpdateConfigurationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateConfigurationRequestValidationError) Field() string { return e.field }
+func (e ListExpiringConfigurationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateConfigurationRequestValidationError) Reason() string { return e.reason }
+func (e ListExpiringConfigurationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateConfigurationRequestValidationError) Cause() error { return e.cause }
+func (e ListExpiringConfigurationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateConfigurationRequestValidationError) Key() bool { return e.key }
+func (e ListExpiringConfigurationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateConfigurationRequestValidationError) ErrorName() string {
-	return "UpdateConfigurationRequestValidationError"
+func (e ListExpiringConfigurationsRequestValidationError) ErrorName() string {
+	return "ListExpiringConfigurationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateConfigurationRequestValidationError) Error() string {
+func (e ListExpiringConfigurationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1380,14 +3541,14 @@ func (e UpdateConfigurationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateConfigurationRequest.%s: %s%s",
+		"invalid %sListExpiringConfigurationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateConfigurationRequestValidationError{}
+var _ error = ListExpiringConfigurationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1395,24 +3556,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateConfigurationRequestValidationError{}
+} = ListExpiringConfigurationsRequestValidationError{}
 
-// Validate checks the field values on UpdateConfigurationResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExpiringConfiguration with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateConfigurationResponse) Validate() error {
+func (m *ExpiringConfiguration) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateConfigurationResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExpiringConfiguration with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateConfigurationResponseMultiError, or nil if none found.
-func (m *UpdateConfigurationResponse) ValidateAll() error {
+// ExpiringConfigurationMultiError, or nil if none found.
+func (m *ExpiringConfiguration) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateConfigurationResponse) validate(all bool) error {
+func (m *ExpiringConfiguration) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1423,7 +3584,7 @@ func (m *UpdateConfigurationResponse) validate(all bool) error {
 		switch v := interface{}(m.GetConfiguration()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateConfigurationResponseValidationError{
+				errors = append(errors, ExpiringConfigurationValidationError{
 					field:  "Configuration",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1431,7 +3592,7 @@ func (m *UpdateConfigurationResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateConfigurationResponseValidationError{
+				errors = append(errors, ExpiringConfigurationValidationError{
 					field:  "Configuration",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1440,7 +3601,7 @@ func (m *UpdateConfigurationResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateConfigurationResponseValidationError{
+			return ExpiringConfigurationValidationError{
 				field:  "Configuration",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1448,20 +3609,22 @@ func (m *UpdateConfigurationResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for DaysUntilExpiry
+
 	if len(errors) > 0 {
-		return UpdateConfigurationResponseMultiError(errors)
+		return ExpiringConfigurationMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateConfigurationResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateConfigurationResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateConfigurationResponseMultiError []error
+// ExpiringConfigurationMultiError is an error wrapping multiple validation
+// errors returned by ExpiringConfiguration.ValidateAll() if the designated
+// constraints aren't met.
+type ExpiringConfigurationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateConfigurationResponseMultiError) Error() string {
+func (m ExpiringConfigurationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1470,12 +3633,11 @@ func (m UpdateConfigurationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateConfigurationResponseMultiError) AllErrors() []error { return m }
+func (m ExpiringConfigurationMultiError) AllErrors() []error { return m }
 
-// UpdateConfigurationResponseValidationError is the validation error returned
-// by UpdateConfigurationResponse.Validate if the designated constraints
-// aren't met.
-type UpdateConfigurationResponseValidationError struct {
+// ExpiringConfigurationValidationError is the validation error returned by
+// ExpiringConfiguration.Validate if the designated constraints aren't met.
+type ExpiringConfigurationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1483,24 +3645,24 @@ type UpdateConfigurationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateConfigurationResponseValidationError) Field() string { return e.field }
+func (e ExpiringConfigurationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateConfigurationResponseValidationError) Reason() string { return e.reason }
+func (e ExpiringConfigurationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateConfigurationResponseValidationError) Cause() error { return e.cause }
+func (e ExpiringConfigurationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateConfigurationResponseValidationError) Key() bool { return e.key }
+func (e ExpiringConfigurationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateConfigurationResponseValidationError) ErrorName() string {
-	return "UpdateConfigurationResponseValidationError"
+func (e ExpiringConfigurationValidationError) ErrorName() string {
+	return "ExpiringConfigurationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateConfigurationResponseValidationError) Error() string {
+func (e ExpiringConfigurationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1512,14 +3674,14 @@ func (e UpdateConfigurationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateConfigurationResponse.%s: %s%s",
+		"invalid %sExpiringConfiguration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateConfigurationResponseValidationError{}
+var _ error = ExpiringConfigurationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1527,46 +3689,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateConfigurationResponseValidationError{}
+} = ExpiringConfigurationValidationError{}
 
-// Validate checks the field values on DeleteConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteConfigurationRequest) Validate() error {
+// Validate checks the field values on ListExpiringConfigurationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListExpiringConfigurationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteConfigurationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteConfigurationRequestMultiError, or nil if none found.
-func (m *DeleteConfigurationRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListExpiringConfigurationsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListExpiringConfigurationsResponseMultiError, or nil if none found.
+func (m *ListExpiringConfigurationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteConfigurationRequest) validate(all bool) error {
+func (m *ListExpiringConfigurationsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExpiringConfigurationsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExpiringConfigurationsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExpiringConfigurationsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return DeleteConfigurationRequestMultiError(errors)
+		return ListExpiringConfigurationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteConfigurationRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteConfigurationRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteConfigurationRequestMultiError []error
+// ListExpiringConfigurationsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListExpiringConfigurationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListExpiringConfigurationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteConfigurationRequestMultiError) Error() string {
+func (m ListExpiringConfigurationsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1575,11 +3773,12 @@ func (m DeleteConfigurationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteConfigurationRequestMultiError) AllErrors() []error { return m }
+func (m ListExpiringConfigurationsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteConfigurationRequestValidationError is the validation error returned
-// by DeleteConfigurationRequest.Validate if the designated constraints aren't met.
-type DeleteConfigurationRequestValidationError struct {
+// ListExpiringConfigurationsResponseValidationError is the validation error
+// returned by ListExpiringConfigurationsResponse.Validate if the designated
+// constraints aren't met.
+type ListExpiringConfigurationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1587,24 +3786,24 @@ type DeleteConfigurationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteConfigurationRequestValidationError) Field() string { return e.field }
+func (e ListExpiringConfigurationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteConfigurationRequestValidationError) Reason() string { return e.reason }
+func (e ListExpiringConfigurationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteConfigurationRequestValidationError) Cause() error { return e.cause }
+func (e ListExpiringConfigurationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteConfigurationRequestValidationError) Key() bool { return e.key }
+func (e ListExpiringConfigurationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteConfigurationRequestValidationError) ErrorName() string {
-	return "DeleteConfigurationRequestValidationError"
+func (e ListExpiringConfigurationsResponseValidationError) ErrorName() string {
+	return "ListExpiringConfigurationsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteConfigurationRequestValidationError) Error() string {
+func (e ListExpiringConfigurationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1616,14 +3815,14 @@ func (e DeleteConfigurationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteConfigurationRequest.%s: %s%s",
+		"invalid %sListExpiringConfigurationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteConfigurationRequestValidationError{}
+var _ error = ListExpiringConfigurationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1631,7 +3830,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteConfigurationRequestValidationError{}
+} = ListExpiringConfigurationsResponseValidationError{}
 
 // Validate checks the field values on ValidateConfigurationCredentialsRequest
 // with the rules defined in the proto definition for this message. If any
@@ -20,13 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TargetConfigurationService_CreateConfiguration_FullMethodName = "/deployer.service.v1.TargetConfigurationService/CreateConfiguration"
-	TargetConfigurationService_GetConfiguration_FullMethodName    = "/deployer.service.v1.TargetConfigurationService/GetConfiguration"
-	TargetConfigurationService_ListConfigurations_FullMethodName  = "/deployer.service.v1.TargetConfigurationService/ListConfigurations"
-	TargetConfigurationService_UpdateConfiguration_FullMethodName = "/deployer.service.v1.TargetConfigurationService/UpdateConfiguration"
-	TargetConfigurationService_DeleteConfiguration_FullMethodName = "/deployer.service.v1.TargetConfigurationService/DeleteConfiguration"
-	TargetConfigurationService_ValidateCredentials_FullMethodName = "/deployer.service.v1.TargetConfigurationService/ValidateCredentials"
-	TargetConfigurationService_ListProviders_FullMethodName       = "/deployer.service.v1.TargetConfigurationService/ListProviders"
+	TargetConfigurationService_CreateConfiguration_FullMethodName        = "/deployer.service.v1.TargetConfigurationService/CreateConfiguration"
+	TargetConfigurationService_GetConfiguration_FullMethodName           = "/deployer.service.v1.TargetConfigurationService/GetConfiguration"
+	TargetConfigurationService_ListConfigurations_FullMethodName         = "/deployer.service.v1.TargetConfigurationService/ListConfigurations"
+	TargetConfigurationService_UpdateConfiguration_FullMethodName        = "/deployer.service.v1.TargetConfigurationService/UpdateConfiguration"
+	TargetConfigurationService_DeleteConfiguration_FullMethodName        = "/deployer.service.v1.TargetConfigurationService/DeleteConfiguration"
+	TargetConfigurationService_ValidateCredentials_FullMethodName        = "/deployer.service.v1.TargetConfigurationService/ValidateCredentials"
+	TargetConfigurationService_ListConfigurationRevisions_FullMethodName = "/deployer.service.v1.TargetConfigurationService/ListConfigurationRevisions"
+	TargetConfigurationService_DiffConfigurationRevisions_FullMethodName = "/deployer.service.v1.TargetConfigurationService/DiffConfigurationRevisions"
+	TargetConfigurationService_RevertConfiguration_FullMethodName        = "/deployer.service.v1.TargetConfigurationService/RevertConfiguration"
+	TargetConfigurationService_RotateEncryptionKey_FullMethodName        = "/deployer.service.v1.TargetConfigurationService/RotateEncryptionKey"
+	TargetConfigurationService_ListExpiringConfigurations_FullMethodName = "/deployer.service.v1.TargetConfigurationService/ListExpiringConfigurations"
+	TargetConfigurationService_ListProviders_FullMethodName              = "/deployer.service.v1.TargetConfigurationService/ListProviders"
 )
 
 // TargetConfigurationServiceClient is the client API for TargetConfigurationService service.
@@ -47,6 +52,16 @@ type TargetConfigurationServiceClient interface {
 	DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Validate provider credentials
 	ValidateCredentials(ctx context.Context, in *ValidateConfigurationCredentialsRequest, opts ...grpc.CallOption) (*ValidateConfigurationCredentialsResponse, error)
+	// List revisions of a target configuration, newest first
+	ListConfigurationRevisions(ctx context.Context, in *ListConfigurationRevisionsRequest, opts ...grpc.CallOption) (*ListConfigurationRevisionsResponse, error)
+	// Diff two revisions of a target configuration
+	DiffConfigurationRevisions(ctx context.Context, in *DiffConfigurationRevisionsRequest, opts ...grpc.CallOption) (*DiffConfigurationRevisionsResponse, error)
+	// Revert a target configuration to an earlier revision (creates a new revision)
+	RevertConfiguration(ctx context.Context, in *RevertConfigurationRequest, opts ...grpc.CallOption) (*RevertConfigurationResponse, error)
+	// Re-wrap all stored credentials with the active key-encryption key
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error)
+	// List configurations whose deployed certificate expires soon
+	ListExpiringConfigurations(ctx context.Context, in *ListExpiringConfigurationsRequest, opts ...grpc.CallOption) (*ListExpiringConfigurationsResponse, error)
 	// List available providers
 	ListProviders(ctx context.Context, in *ListConfigurationProvidersRequest, opts ...grpc.CallOption) (*ListConfigurationProvidersResponse, error)
 }
@@ -119,6 +134,56 @@ func (c *targetConfigurationServiceClient) ValidateCredentials(ctx context.Conte
 	return out, nil
 }
 
+func (c *targetConfigurationServiceClient) ListConfigurationRevisions(ctx context.Context, in *ListConfigurationRevisionsRequest, opts ...grpc.CallOption) (*ListConfigurationRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigurationRevisionsResponse)
+	err := c.cc.Invoke(ctx, TargetConfigurationService_ListConfigurationRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetConfigurationServiceClient) DiffConfigurationRevisions(ctx context.Context, in *DiffConfigurationRevisionsRequest, opts ...grpc.CallOption) (*DiffConfigurationRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffConfigurationRevisionsResponse)
+	err := c.cc.Invoke(ctx, TargetConfigurationService_DiffConfigurationRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetConfigurationServiceClient) RevertConfiguration(ctx context.Context, in *RevertConfigurationRequest, opts ...grpc.CallOption) (*RevertConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertConfigurationResponse)
+	err := c.cc.Invoke(ctx, TargetConfigurationService_RevertConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetConfigurationServiceClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, TargetConfigurationService_RotateEncryptionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetConfigurationServiceClient) ListExpiringConfigurations(ctx context.Context, in *ListExpiringConfigurationsRequest, opts ...grpc.CallOption) (*ListExpiringConfigurationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringConfigurationsResponse)
+	err := c.cc.Invoke(ctx, TargetConfigurationService_ListExpiringConfigurations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *targetConfigurationServiceClient) ListProviders(ctx context.Context, in *ListConfigurationProvidersRequest, opts ...grpc.CallOption) (*ListConfigurationProvidersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListConfigurationProvidersResponse)
@@ -147,6 +212,16 @@ type TargetConfigurationServiceServer interface {
 	DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*emptypb.Empty, error)
 	// Validate provider credentials
 	ValidateCredentials(context.Context, *ValidateConfigurationCredentialsRequest) (*ValidateConfigurationCredentialsResponse, error)
+	// List revisions of a target configuration, newest first
+	ListConfigurationRevisions(context.Context, *ListConfigurationRevisionsRequest) (*ListConfigurationRevisionsResponse, error)
+	// Diff two revisions of a target configuration
+	DiffConfigurationRevisions(context.Context, *DiffConfigurationRevisionsRequest) (*DiffConfigurationRevisionsResponse, error)
+	// Revert a target configuration to an earlier revision (creates a new revision)
+	RevertConfiguration(context.Context, *RevertConfigurationRequest) (*RevertConfigurationResponse, error)
+	// Re-wrap all stored credentials with the active key-encryption key
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error)
+	// List configurations whose deployed certificate expires soon
+	ListExpiringConfigurations(context.Context, *ListExpiringConfigurationsRequest) (*ListExpiringConfigurationsResponse, error)
 	// List available providers
 	ListProviders(context.Context, *ListConfigurationProvidersRequest) (*ListConfigurationProvidersResponse, error)
 	mustEmbedUnimplementedTargetConfigurationServiceServer()
@@ -177,6 +252,21 @@ func (UnimplementedTargetConfigurationServiceServer) DeleteConfiguration(context
 func (UnimplementedTargetConfigurationServiceServer) ValidateCredentials(context.Context, *ValidateConfigurationCredentialsRequest) (*ValidateConfigurationCredentialsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateCredentials not implemented")
 }
+func (UnimplementedTargetConfigurationServiceServer) ListConfigurationRevisions(context.Context, *ListConfigurationRevisionsRequest) (*ListConfigurationRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConfigurationRevisions not implemented")
+}
+func (UnimplementedTargetConfigurationServiceServer) DiffConfigurationRevisions(context.Context, *DiffConfigurationRevisionsRequest) (*DiffConfigurationRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffConfigurationRevisions not implemented")
+}
+func (UnimplementedTargetConfigurationServiceServer) RevertConfiguration(context.Context, *RevertConfigurationRequest) (*RevertConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevertConfiguration not implemented")
+}
+func (UnimplementedTargetConfigurationServiceServer) RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateEncryptionKey not implemented")
+}
+func (UnimplementedTargetConfigurationServiceServer) ListExpiringConfigurations(context.Context, *ListExpiringConfigurationsRequest) (*ListExpiringConfigurationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExpiringConfigurations not implemented")
+}
 func (UnimplementedTargetConfigurationServiceServer) ListProviders(context.Context, *ListConfigurationProvidersRequest) (*ListConfigurationProvidersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProviders not implemented")
 }
@@ -310,6 +400,96 @@ func _TargetConfigurationService_ValidateCredentials_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetConfigurationService_ListConfigurationRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigurationRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetConfigurationServiceServer).ListConfigurationRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetConfigurationService_ListConfigurationRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetConfigurationServiceServer).ListConfigurationRevisions(ctx, req.(*ListConfigurationRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetConfigurationService_DiffConfigurationRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfigurationRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetConfigurationServiceServer).DiffConfigurationRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetConfigurationService_DiffConfigurationRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetConfigurationServiceServer).DiffConfigurationRevisions(ctx, req.(*DiffConfigurationRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetConfigurationService_RevertConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetConfigurationServiceServer).RevertConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetConfigurationService_RevertConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetConfigurationServiceServer).RevertConfiguration(ctx, req.(*RevertConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetConfigurationService_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetConfigurationServiceServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetConfigurationService_RotateEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetConfigurationServiceServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetConfigurationService_ListExpiringConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetConfigurationServiceServer).ListExpiringConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetConfigurationService_ListExpiringConfigurations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetConfigurationServiceServer).ListExpiringConfigurations(ctx, req.(*ListExpiringConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TargetConfigurationService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListConfigurationProvidersRequest)
 	if err := dec(in); err != nil {
@@ -359,6 +539,26 @@ var TargetConfigurationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateCredentials",
 			Handler:    _TargetConfigurationService_ValidateCredentials_Handler,
 		},
+		{
+			MethodName: "ListConfigurationRevisions",
+			Handler:    _TargetConfigurationService_ListConfigurationRevisions_Handler,
+		},
+		{
+			MethodName: "DiffConfigurationRevisions",
+			Handler:    _TargetConfigurationService_DiffConfigurationRevisions_Handler,
+		},
+		{
+			MethodName: "RevertConfiguration",
+			Handler:    _TargetConfigurationService_RevertConfiguration_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _TargetConfigurationService_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "ListExpiringConfigurations",
+			Handler:    _TargetConfigurationService_ListExpiringConfigurations_Handler,
+		},
 		{
 			MethodName: "ListProviders",
 			Handler:    _TargetConfigurationService_ListProviders_Handler,
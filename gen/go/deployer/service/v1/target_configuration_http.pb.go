@@ -22,9 +22,14 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationTargetConfigurationServiceCreateConfiguration = "/deployer.service.v1.TargetConfigurationService/CreateConfiguration"
 const OperationTargetConfigurationServiceDeleteConfiguration = "/deployer.service.v1.TargetConfigurationService/DeleteConfiguration"
+const OperationTargetConfigurationServiceDiffConfigurationRevisions = "/deployer.service.v1.TargetConfigurationService/DiffConfigurationRevisions"
 const OperationTargetConfigurationServiceGetConfiguration = "/deployer.service.v1.TargetConfigurationService/GetConfiguration"
+const OperationTargetConfigurationServiceListConfigurationRevisions = "/deployer.service.v1.TargetConfigurationService/ListConfigurationRevisions"
 const OperationTargetConfigurationServiceListConfigurations = "/deployer.service.v1.TargetConfigurationService/ListConfigurations"
+const OperationTargetConfigurationServiceListExpiringConfigurations = "/deployer.service.v1.TargetConfigurationService/ListExpiringConfigurations"
 const OperationTargetConfigurationServiceListProviders = "/deployer.service.v1.TargetConfigurationService/ListProviders"
+const OperationTargetConfigurationServiceRevertConfiguration = "/deployer.service.v1.TargetConfigurationService/RevertConfiguration"
+const OperationTargetConfigurationServiceRotateEncryptionKey = "/deployer.service.v1.TargetConfigurationService/RotateEncryptionKey"
 const OperationTargetConfigurationServiceUpdateConfiguration = "/deployer.service.v1.TargetConfigurationService/UpdateConfiguration"
 const OperationTargetConfigurationServiceValidateCredentials = "/deployer.service.v1.TargetConfigurationService/ValidateCredentials"
 
@@ -33,12 +38,22 @@ type TargetConfigurationServiceHTTPServer interface {
 	CreateConfiguration(context.Context, *CreateConfigurationRequest) (*CreateConfigurationResponse, error)
 	// DeleteConfiguration Delete a target configuration
 	DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*emptypb.Empty, error)
+	// DiffConfigurationRevisions Diff two revisions of a target configuration
+	DiffConfigurationRevisions(context.Context, *DiffConfigurationRevisionsRequest) (*DiffConfigurationRevisionsResponse, error)
 	// GetConfiguration Get a target configuration by ID
 	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	// ListConfigurationRevisions List revisions of a target configuration, newest first
+	ListConfigurationRevisions(context.Context, *ListConfigurationRevisionsRequest) (*ListConfigurationRevisionsResponse, error)
 	// ListConfigurations List target configurations
 	ListConfigurations(context.Context, *ListConfigurationsRequest) (*ListConfigurationsResponse, error)
+	// ListExpiringConfigurations List configurations whose deployed certificate expires soon
+	ListExpiringConfigurations(context.Context, *ListExpiringConfigurationsRequest) (*ListExpiringConfigurationsResponse, error)
 	// ListProviders List available providers
 	ListProviders(context.Context, *ListConfigurationProvidersRequest) (*ListConfigurationProvidersResponse, error)
+	// RevertConfiguration Revert a target configuration to an earlier revision (creates a new revision)
+	RevertConfiguration(context.Context, *RevertConfigurationRequest) (*RevertConfigurationResponse, error)
+	// RotateEncryptionKey Re-wrap all stored credentials with the active key-encryption key
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error)
 	// UpdateConfiguration Update a target configuration
 	UpdateConfiguration(context.Context, *UpdateConfigurationRequest) (*UpdateConfigurationResponse, error)
 	// ValidateCredentials Validate provider credentials
@@ -53,6 +68,11 @@ func RegisterTargetConfigurationServiceHTTPServer(s *http.Server, srv TargetConf
 	r.PUT("/v1/target-configurations/{id}", _TargetConfigurationService_UpdateConfiguration0_HTTP_Handler(srv))
 	r.DELETE("/v1/target-configurations/{id}", _TargetConfigurationService_DeleteConfiguration0_HTTP_Handler(srv))
 	r.POST("/v1/target-configurations/validate-credentials", _TargetConfigurationService_ValidateCredentials0_HTTP_Handler(srv))
+	r.GET("/v1/target-configurations/{id}/revisions", _TargetConfigurationService_ListConfigurationRevisions0_HTTP_Handler(srv))
+	r.GET("/v1/target-configurations/{id}/revisions/diff", _TargetConfigurationService_DiffConfigurationRevisions0_HTTP_Handler(srv))
+	r.POST("/v1/target-configurations/{id}/revert", _TargetConfigurationService_RevertConfiguration0_HTTP_Handler(srv))
+	r.POST("/v1/target-configurations/rotate-encryption-key", _TargetConfigurationService_RotateEncryptionKey0_HTTP_Handler(srv))
+	r.GET("/v1/target-configurations/expiring", _TargetConfigurationService_ListExpiringConfigurations0_HTTP_Handler(srv))
 	r.GET("/v1/target-configurations/providers", _TargetConfigurationService_ListProviders0_HTTP_Handler(srv))
 }
 
@@ -188,6 +208,116 @@ func _TargetConfigurationService_ValidateCredentials0_HTTP_Handler(srv TargetCon
 	}
 }
 
+func _TargetConfigurationService_ListConfigurationRevisions0_HTTP_Handler(srv TargetConfigurationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListConfigurationRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTargetConfigurationServiceListConfigurationRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListConfigurationRevisions(ctx, req.(*ListConfigurationRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListConfigurationRevisionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TargetConfigurationService_DiffConfigurationRevisions0_HTTP_Handler(srv TargetConfigurationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffConfigurationRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTargetConfigurationServiceDiffConfigurationRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffConfigurationRevisions(ctx, req.(*DiffConfigurationRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffConfigurationRevisionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TargetConfigurationService_RevertConfiguration0_HTTP_Handler(srv TargetConfigurationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevertConfigurationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTargetConfigurationServiceRevertConfiguration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevertConfiguration(ctx, req.(*RevertConfigurationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevertConfigurationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TargetConfigurationService_RotateEncryptionKey0_HTTP_Handler(srv TargetConfigurationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateEncryptionKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTargetConfigurationServiceRotateEncryptionKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateEncryptionKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TargetConfigurationService_ListExpiringConfigurations0_HTTP_Handler(srv TargetConfigurationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListExpiringConfigurationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTargetConfigurationServiceListExpiringConfigurations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListExpiringConfigurations(ctx, req.(*ListExpiringConfigurationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListExpiringConfigurationsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _TargetConfigurationService_ListProviders0_HTTP_Handler(srv TargetConfigurationServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListConfigurationProvidersRequest
@@ -212,12 +342,22 @@ type TargetConfigurationServiceHTTPClient interface {
 	CreateConfiguration(ctx context.Context, req *CreateConfigurationRequest, opts ...http.CallOption) (rsp *CreateConfigurationResponse, err error)
 	// DeleteConfiguration Delete a target configuration
 	DeleteConfiguration(ctx context.Context, req *DeleteConfigurationRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DiffConfigurationRevisions Diff two revisions of a target configuration
+	DiffConfigurationRevisions(ctx context.Context, req *DiffConfigurationRevisionsRequest, opts ...http.CallOption) (rsp *DiffConfigurationRevisionsResponse, err error)
 	// GetConfiguration Get a target configuration by ID
 	GetConfiguration(ctx context.Context, req *GetConfigurationRequest, opts ...http.CallOption) (rsp *GetConfigurationResponse, err error)
+	// ListConfigurationRevisions List revisions of a target configuration, newest first
+	ListConfigurationRevisions(ctx context.Context, req *ListConfigurationRevisionsRequest, opts ...http.CallOption) (rsp *ListConfigurationRevisionsResponse, err error)
 	// ListConfigurations List target configurations
 	ListConfigurations(ctx context.Context, req *ListConfigurationsRequest, opts ...http.CallOption) (rsp *ListConfigurationsResponse, err error)
+	// ListExpiringConfigurations List configurations whose deployed certificate expires soon
+	ListExpiringConfigurations(ctx context.Context, req *ListExpiringConfigurationsRequest, opts ...http.CallOption) (rsp *ListExpiringConfigurationsResponse, err error)
 	// ListProviders List available providers
 	ListProviders(ctx context.Context, req *ListConfigurationProvidersRequest, opts ...http.CallOption) (rsp *ListConfigurationProvidersResponse, err error)
+	// RevertConfiguration Revert a target configuration to an earlier revision (creates a new revision)
+	RevertConfiguration(ctx context.Context, req *RevertConfigurationRequest, opts ...http.CallOption) (rsp *RevertConfigurationResponse, err error)
+	// RotateEncryptionKey Re-wrap all stored credentials with the active key-encryption key
+	RotateEncryptionKey(ctx context.Context, req *RotateEncryptionKeyRequest, opts ...http.CallOption) (rsp *RotateEncryptionKeyResponse, err error)
 	// UpdateConfiguration Update a target configuration
 	UpdateConfiguration(ctx context.Context, req *UpdateConfigurationRequest, opts ...http.CallOption) (rsp *UpdateConfigurationResponse, err error)
 	// ValidateCredentials Validate provider credentials
@@ -260,6 +400,20 @@ func (c *TargetConfigurationServiceHTTPClientImpl) DeleteConfiguration(ctx conte
 	return &out, nil
 }
 
+// DiffConfigurationRevisions Diff two revisions of a target configuration
+func (c *TargetConfigurationServiceHTTPClientImpl) DiffConfigurationRevisions(ctx context.Context, in *DiffConfigurationRevisionsRequest, opts ...http.CallOption) (*DiffConfigurationRevisionsResponse, error) {
+	var out DiffConfigurationRevisionsResponse
+	pattern := "/v1/target-configurations/{id}/revisions/diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTargetConfigurationServiceDiffConfigurationRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetConfiguration Get a target configuration by ID
 func (c *TargetConfigurationServiceHTTPClientImpl) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...http.CallOption) (*GetConfigurationResponse, error) {
 	var out GetConfigurationResponse
@@ -274,6 +428,20 @@ func (c *TargetConfigurationServiceHTTPClientImpl) GetConfiguration(ctx context.
 	return &out, nil
 }
 
+// ListConfigurationRevisions List revisions of a target configuration, newest first
+func (c *TargetConfigurationServiceHTTPClientImpl) ListConfigurationRevisions(ctx context.Context, in *ListConfigurationRevisionsRequest, opts ...http.CallOption) (*ListConfigurationRevisionsResponse, error) {
+	var out ListConfigurationRevisionsResponse
+	pattern := "/v1/target-configurations/{id}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTargetConfigurationServiceListConfigurationRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListConfigurations List target configurations
 func (c *TargetConfigurationServiceHTTPClientImpl) ListConfigurations(ctx context.Context, in *ListConfigurationsRequest, opts ...http.CallOption) (*ListConfigurationsResponse, error) {
 	var out ListConfigurationsResponse
@@ -288,6 +456,20 @@ func (c *TargetConfigurationServiceHTTPClientImpl) ListConfigurations(ctx contex
 	return &out, nil
 }
 
+// ListExpiringConfigurations List configurations whose deployed certificate expires soon
+func (c *TargetConfigurationServiceHTTPClientImpl) ListExpiringConfigurations(ctx context.Context, in *ListExpiringConfigurationsRequest, opts ...http.CallOption) (*ListExpiringConfigurationsResponse, error) {
+	var out ListExpiringConfigurationsResponse
+	pattern := "/v1/target-configurations/expiring"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTargetConfigurationServiceListExpiringConfigurations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListProviders List available providers
 func (c *TargetConfigurationServiceHTTPClientImpl) ListProviders(ctx context.Context, in *ListConfigurationProvidersRequest, opts ...http.CallOption) (*ListConfigurationProvidersResponse, error) {
 	var out ListConfigurationProvidersResponse
@@ -302,6 +484,34 @@ func (c *TargetConfigurationServiceHTTPClientImpl) ListProviders(ctx context.Con
 	return &out, nil
 }
 
+// RevertConfiguration Revert a target configuration to an earlier revision (creates a new revision)
+func (c *TargetConfigurationServiceHTTPClientImpl) RevertConfiguration(ctx context.Context, in *RevertConfigurationRequest, opts ...http.CallOption) (*RevertConfigurationResponse, error) {
+	var out RevertConfigurationResponse
+	pattern := "/v1/target-configurations/{id}/revert"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTargetConfigurationServiceRevertConfiguration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RotateEncryptionKey Re-wrap all stored credentials with the active key-encryption key
+func (c *TargetConfigurationServiceHTTPClientImpl) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...http.CallOption) (*RotateEncryptionKeyResponse, error) {
+	var out RotateEncryptionKeyResponse
+	pattern := "/v1/target-configurations/rotate-encryption-key"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTargetConfigurationServiceRotateEncryptionKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateConfiguration Update a target configuration
 func (c *TargetConfigurationServiceHTTPClientImpl) UpdateConfiguration(ctx context.Context, in *UpdateConfigurationRequest, opts ...http.CallOption) (*UpdateConfigurationResponse, error) {
 	var out UpdateConfigurationResponse
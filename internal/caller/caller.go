@@ -0,0 +1,119 @@
+// Package caller extracts the identity of whoever is invoking an RPC.
+//
+// End users reach the deployer through the admin gateway, which forwards
+// their identity as global gRPC metadata next to the tenant ID. Other
+// modules call in directly over mTLS and are identified by the common name
+// of their client certificate.
+package caller
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+)
+
+// Metadata keys forwarded by the admin gateway
+const (
+	MetadataUserID   = "x-md-global-user-id"
+	MetadataUsername = "x-md-global-username"
+)
+
+// Identity describes the caller of an RPC
+type Identity struct {
+	TenantID uint32
+	UserID   uint32
+	Username string
+	// ClientCN is the mTLS client certificate common name (module-to-module calls)
+	ClientCN string
+	// PlatformAdmin callers may act on every tenant
+	PlatformAdmin bool
+	// Roles are the role claims of the caller's JWT, forwarded by the admin gateway
+	Roles []string
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying id, together with an ent privacy
+// viewer that scopes every repository query and mutation to id's tenant
+func NewContext(ctx context.Context, id Identity) context.Context {
+	ctx = context.WithValue(ctx, identityKey{}, id)
+	return viewer.WithContext(ctx, &tenantViewer{id: id})
+}
+
+// FromContext returns the identity of the caller. The identity attached by
+// NewContext wins; otherwise it is read from the request metadata.
+func FromContext(ctx context.Context) Identity {
+	if id, ok := ctx.Value(identityKey{}).(Identity); ok {
+		return id
+	}
+
+	id := Identity{
+		TenantID:      grpcx.GetTenantIDFromContext(ctx),
+		PlatformAdmin: grpcx.IsPlatformAdmin(ctx),
+		Roles:         grpcx.GetRolesFromContext(ctx),
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := first(md, MetadataUserID); v != "" {
+			if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+				id.UserID = uint32(n)
+			}
+		}
+		id.Username = first(md, MetadataUsername)
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.AuthInfo != nil {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			id.ClientCN = tlsInfo.State.PeerCertificates[0].Subject.CommonName
+		}
+	}
+
+	return id
+}
+
+// TenantScope returns the tenant filter for a list request: platform admins may
+// filter on any tenant or none, everyone else always sees their own tenant
+func (i Identity) TenantScope(requested *uint32) *uint32 {
+	if i.PlatformAdmin {
+		return requested
+	}
+	tenantID := i.TenantID
+	return &tenantID
+}
+
+// UserIDPtr returns the user ID, or nil when the caller is not a known user
+func (i Identity) UserIDPtr() *uint32 {
+	if i.UserID == 0 {
+		return nil
+	}
+	id := i.UserID
+	return &id
+}
+
+// Name returns a human readable name for the caller
+func (i Identity) Name() string {
+	switch {
+	case i.Username != "":
+		return i.Username
+	case i.UserID != 0:
+		return "user:" + strconv.FormatUint(uint64(i.UserID), 10)
+	case i.ClientCN != "":
+		return i.ClientCN
+	default:
+		return "system"
+	}
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+package caller
+
+import (
+	"github.com/tx7do/go-crud/viewer"
+)
+
+// tenantViewer is the ent privacy viewer of an RPC caller. Platform admins get
+// the platform view (no tenant filter); everyone else is confined to their
+// tenant by the TenantID and TenantScope mixin policies.
+type tenantViewer struct {
+	id Identity
+}
+
+var _ viewer.Context = (*tenantViewer)(nil)
+
+func (v *tenantViewer) UserID() uint64    { return uint64(v.id.UserID) }
+func (v *tenantViewer) TenantID() uint64  { return uint64(v.id.TenantID) }
+func (v *tenantViewer) OrgUnitID() uint64 { return 0 }
+
+func (v *tenantViewer) Permissions() []string { return nil }
+func (v *tenantViewer) Roles() []string       { return nil }
+
+func (v *tenantViewer) DataScope() []viewer.DataScope {
+	return []viewer.DataScope{{ScopeType: viewer.ScopeTypeAll}}
+}
+
+func (v *tenantViewer) TraceID() string { return "" }
+
+func (v *tenantViewer) HasPermission(_, _ string) bool { return false }
+
+func (v *tenantViewer) IsPlatformContext() bool { return v.id.PlatformAdmin }
+func (v *tenantViewer) IsTenantContext() bool   { return !v.id.PlatformAdmin }
+func (v *tenantViewer) IsSystemContext() bool   { return false }
+
+func (v *tenantViewer) ShouldAudit() bool { return true }
@@ -23,10 +23,19 @@ const (
 
 type Deployer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataDir       string                 `protobuf:"bytes,1,opt,name=data_dir,json=dataDir,proto3" json:"data_dir,omitempty"` // Data directory for the deployer service
-	Events        *EventConfig           `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`                  // Event subscription configuration
-	Jobs          *JobConfig             `protobuf:"bytes,3,opt,name=jobs,proto3" json:"jobs,omitempty"`                      // Job execution configuration
-	Encryption    *EncryptionConfig      `protobuf:"bytes,4,opt,name=encryption,proto3" json:"encryption,omitempty"`          // Credentials encryption configuration
+	DataDir       string                 `protobuf:"bytes,1,opt,name=data_dir,json=dataDir,proto3" json:"data_dir,omitempty"`             // Data directory for the deployer service
+	Events        *EventConfig           `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`                              // Event subscription configuration
+	Jobs          *JobConfig             `protobuf:"bytes,3,opt,name=jobs,proto3" json:"jobs,omitempty"`                                  // Job execution configuration
+	Encryption    *EncryptionConfig      `protobuf:"bytes,4,opt,name=encryption,proto3" json:"encryption,omitempty"`                      // Credentials encryption configuration
+	Audit         *AuditConfig           `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`                                // Audit log integrity and retention configuration
+	Secrets       *SecretsConfig         `protobuf:"bytes,6,opt,name=secrets,proto3" json:"secrets,omitempty"`                            // External secret references in credentials
+	Rbac          *RbacConfig            `protobuf:"bytes,7,opt,name=rbac,proto3" json:"rbac,omitempty"`                                  // Role-based access control
+	HealthCheck   *HealthCheckConfig     `protobuf:"bytes,8,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"` // Scheduled credential health checks
+	Drift         *DriftConfig           `protobuf:"bytes,9,opt,name=drift,proto3" json:"drift,omitempty"`                                // Drift detection against live devices
+	Expiry        *ExpiryConfig          `protobuf:"bytes,10,opt,name=expiry,proto3" json:"expiry,omitempty"`                             // Expiry watchdog of deployed certificates
+	Notifications *NotificationConfig    `protobuf:"bytes,11,opt,name=notifications,proto3" json:"notifications,omitempty"`               // Notifications about job outcomes
+	Publish       *PublishConfig         `protobuf:"bytes,12,opt,name=publish,proto3" json:"publish,omitempty"`                           // Lifecycle events published to the event bus
+	Ingest        *IngestConfig          `protobuf:"bytes,13,opt,name=ingest,proto3" json:"ingest,omitempty"`                             // CloudEvents accepted over HTTP from other certificate sources
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +98,69 @@ func (x *Deployer) GetEncryption() *EncryptionConfig {
 	return nil
 }
 
+func (x *Deployer) GetAudit() *AuditConfig {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *Deployer) GetSecrets() *SecretsConfig {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *Deployer) GetRbac() *RbacConfig {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
+func (x *Deployer) GetHealthCheck() *HealthCheckConfig {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *Deployer) GetDrift() *DriftConfig {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *Deployer) GetExpiry() *ExpiryConfig {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *Deployer) GetNotifications() *NotificationConfig {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *Deployer) GetPublish() *PublishConfig {
+	if x != nil {
+		return x.Publish
+	}
+	return nil
+}
+
+func (x *Deployer) GetIngest() *IngestConfig {
+	if x != nil {
+		return x.Ingest
+	}
+	return nil
+}
+
 // Configuration for event subscriptions via Redis pub/sub
 type EventConfig struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -152,15 +224,23 @@ func (x *EventConfig) GetSubscribeEvents() []string {
 
 // Configuration for job execution
 type JobConfig struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	WorkerCount            int32                  `protobuf:"varint,1,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`                                     // Number of concurrent job workers (default: 5)
-	MaxRetries             int32                  `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`                                        // Default max retries for jobs (default: 3)
-	RetryDelaySeconds      int32                  `protobuf:"varint,3,opt,name=retry_delay_seconds,json=retryDelaySeconds,proto3" json:"retry_delay_seconds,omitempty"`                 // Initial retry delay in seconds (default: 60)
-	RetryBackoffMultiplier float32                `protobuf:"fixed32,4,opt,name=retry_backoff_multiplier,json=retryBackoffMultiplier,proto3" json:"retry_backoff_multiplier,omitempty"` // Backoff multiplier for retries (default: 2.0)
-	JobTimeoutSeconds      int32                  `protobuf:"varint,5,opt,name=job_timeout_seconds,json=jobTimeoutSeconds,proto3" json:"job_timeout_seconds,omitempty"`                 // Default job timeout in seconds (default: 300)
-	CleanupDays            int32                  `protobuf:"varint,6,opt,name=cleanup_days,json=cleanupDays,proto3" json:"cleanup_days,omitempty"`                                     // Days to keep completed jobs (default: 30)
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                      protoimpl.MessageState  `protogen:"open.v1"`
+	WorkerCount                int32                   `protobuf:"varint,1,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`                                                                                                    // Number of concurrent job workers (default: 5)
+	MaxRetries                 int32                   `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`                                                                                                       // Default max retries for jobs (default: 3)
+	RetryDelaySeconds          int32                   `protobuf:"varint,3,opt,name=retry_delay_seconds,json=retryDelaySeconds,proto3" json:"retry_delay_seconds,omitempty"`                                                                                // Initial retry delay in seconds (default: 60)
+	RetryBackoffMultiplier     float32                 `protobuf:"fixed32,4,opt,name=retry_backoff_multiplier,json=retryBackoffMultiplier,proto3" json:"retry_backoff_multiplier,omitempty"`                                                                // Backoff multiplier for retries (default: 2.0)
+	JobTimeoutSeconds          int32                   `protobuf:"varint,5,opt,name=job_timeout_seconds,json=jobTimeoutSeconds,proto3" json:"job_timeout_seconds,omitempty"`                                                                                // Default job timeout in seconds (default: 300)
+	CleanupDays                int32                   `protobuf:"varint,6,opt,name=cleanup_days,json=cleanupDays,proto3" json:"cleanup_days,omitempty"`                                                                                                    // Days to keep completed jobs (default: 30)
+	RetryJitter                float32                 `protobuf:"fixed32,7,opt,name=retry_jitter,json=retryJitter,proto3" json:"retry_jitter,omitempty"`                                                                                                   // Fraction (0-1) by which retry delays are randomized in either direction (default: 0)
+	MaxRetryDelaySeconds       int32                   `protobuf:"varint,8,opt,name=max_retry_delay_seconds,json=maxRetryDelaySeconds,proto3" json:"max_retry_delay_seconds,omitempty"`                                                                     // Upper bound for a single retry delay (default: 0, no cap)
+	ProviderPolicies           map[string]*RetryPolicy `protobuf:"bytes,9,rep,name=provider_policies,json=providerPolicies,proto3" json:"provider_policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`            // Retry policy overrides keyed by provider type
+	ProviderConcurrency        map[string]int32        `protobuf:"bytes,10,rep,name=provider_concurrency,json=providerConcurrency,proto3" json:"provider_concurrency,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Max deployments running at once per provider type across all replicas (unset: unlimited)
+	SerializeByHost            bool                    `protobuf:"varint,11,opt,name=serialize_by_host,json=serializeByHost,proto3" json:"serialize_by_host,omitempty"`                                                                                     // Also serialize deployments of different configurations to the same device host
+	CircuitBreakerThreshold    int32                   `protobuf:"varint,12,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3" json:"circuit_breaker_threshold,omitempty"`                                                             // Consecutive failed attempts that put a configuration in ERROR (default: 5, negative disables)
+	CircuitBreakerProbeSeconds int32                   `protobuf:"varint,13,opt,name=circuit_breaker_probe_seconds,json=circuitBreakerProbeSeconds,proto3" json:"circuit_breaker_probe_seconds,omitempty"`                                                  // Interval between credential probes of a configuration in ERROR (default: 300)
+	CircuitBreakerFailFast     bool                    `protobuf:"varint,14,opt,name=circuit_breaker_fail_fast,json=circuitBreakerFailFast,proto3" json:"circuit_breaker_fail_fast,omitempty"`                                                              // Fail jobs for a configuration in ERROR instead of keeping them queued
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *JobConfig) Reset() {
@@ -235,28 +315,89 @@ func (x *JobConfig) GetCleanupDays() int32 {
 	return 0
 }
 
-// Configuration for credentials encryption
-type EncryptionConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // AES encryption key (32 bytes for AES-256)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JobConfig) GetRetryJitter() float32 {
+	if x != nil {
+		return x.RetryJitter
+	}
+	return 0
 }
 
-func (x *EncryptionConfig) Reset() {
-	*x = EncryptionConfig{}
+func (x *JobConfig) GetMaxRetryDelaySeconds() int32 {
+	if x != nil {
+		return x.MaxRetryDelaySeconds
+	}
+	return 0
+}
+
+func (x *JobConfig) GetProviderPolicies() map[string]*RetryPolicy {
+	if x != nil {
+		return x.ProviderPolicies
+	}
+	return nil
+}
+
+func (x *JobConfig) GetProviderConcurrency() map[string]int32 {
+	if x != nil {
+		return x.ProviderConcurrency
+	}
+	return nil
+}
+
+func (x *JobConfig) GetSerializeByHost() bool {
+	if x != nil {
+		return x.SerializeByHost
+	}
+	return false
+}
+
+func (x *JobConfig) GetCircuitBreakerThreshold() int32 {
+	if x != nil {
+		return x.CircuitBreakerThreshold
+	}
+	return 0
+}
+
+func (x *JobConfig) GetCircuitBreakerProbeSeconds() int32 {
+	if x != nil {
+		return x.CircuitBreakerProbeSeconds
+	}
+	return 0
+}
+
+func (x *JobConfig) GetCircuitBreakerFailFast() bool {
+	if x != nil {
+		return x.CircuitBreakerFailFast
+	}
+	return false
+}
+
+// Retry and timeout policy override; unset fields inherit from the jobs defaults
+type RetryPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MaxRetries            *int32                 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"`                                    // Retries after the first attempt
+	InitialDelaySeconds   *int32                 `protobuf:"varint,2,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3,oneof" json:"initial_delay_seconds,omitempty"`       // Delay before the first retry
+	BackoffMultiplier     *float32               `protobuf:"fixed32,3,opt,name=backoff_multiplier,json=backoffMultiplier,proto3,oneof" json:"backoff_multiplier,omitempty"`              // Growth of the delay after every retry
+	Jitter                *float32               `protobuf:"fixed32,4,opt,name=jitter,proto3,oneof" json:"jitter,omitempty"`                                                             // Fraction (0-1) by which delays are randomized
+	MaxDelaySeconds       *int32                 `protobuf:"varint,5,opt,name=max_delay_seconds,json=maxDelaySeconds,proto3,oneof" json:"max_delay_seconds,omitempty"`                   // Upper bound for a single retry delay (0 means no cap)
+	AttemptTimeoutSeconds *int32                 `protobuf:"varint,6,opt,name=attempt_timeout_seconds,json=attemptTimeoutSeconds,proto3,oneof" json:"attempt_timeout_seconds,omitempty"` // Timeout of a single deployment attempt
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
 	mi := &file_conf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EncryptionConfig) String() string {
+func (x *RetryPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptionConfig) ProtoMessage() {}
+func (*RetryPolicy) ProtoMessage() {}
 
-func (x *EncryptionConfig) ProtoReflect() protoreflect.Message {
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -268,46 +409,1209 @@ func (x *EncryptionConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptionConfig.ProtoReflect.Descriptor instead.
-func (*EncryptionConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
 	return file_conf_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EncryptionConfig) GetKey() string {
+func (x *RetryPolicy) GetMaxRetries() int32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialDelaySeconds() int32 {
+	if x != nil && x.InitialDelaySeconds != nil {
+		return *x.InitialDelaySeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffMultiplier() float32 {
+	if x != nil && x.BackoffMultiplier != nil {
+		return *x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float32 {
+	if x != nil && x.Jitter != nil {
+		return *x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelaySeconds() int32 {
+	if x != nil && x.MaxDelaySeconds != nil {
+		return *x.MaxDelaySeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetAttemptTimeoutSeconds() int32 {
+	if x != nil && x.AttemptTimeoutSeconds != nil {
+		return *x.AttemptTimeoutSeconds
+	}
+	return 0
+}
+
+// Configuration for the scheduled credential health checks of active configurations
+type HealthCheckConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalMinutes int32                  `protobuf:"varint,1,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"` // Interval between checks of a configuration (default: 360, 0 disables)
+	Jitter          float32                `protobuf:"fixed32,2,opt,name=jitter,proto3" json:"jitter,omitempty"`                                         // Fraction (0-1) by which the interval is randomized per check (default: 0.1)
+	Concurrency     int32                  `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                                // Checks running at once (default: 5)
+	TimeoutSeconds  int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`    // Timeout of a single check (default: 60)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HealthCheckConfig) Reset() {
+	*x = HealthCheckConfig{}
+	mi := &file_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckConfig) ProtoMessage() {}
+
+func (x *HealthCheckConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[4]
 	if x != nil {
-		return x.Key
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-var File_conf_proto protoreflect.FileDescriptor
+// Deprecated: Use HealthCheckConfig.ProtoReflect.Descriptor instead.
+func (*HealthCheckConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{4}
+}
 
-const file_conf_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"conf.proto\x12\n" +
-	"kratos.api\"\xbf\x01\n" +
-	"\bDeployer\x12\x19\n" +
-	"\bdata_dir\x18\x01 \x01(\tR\adataDir\x12/\n" +
-	"\x06events\x18\x02 \x01(\v2\x17.kratos.api.EventConfigR\x06events\x12)\n" +
-	"\x04jobs\x18\x03 \x01(\v2\x15.kratos.api.JobConfigR\x04jobs\x12<\n" +
-	"\n" +
-	"encryption\x18\x04 \x01(\v2\x1c.kratos.api.EncryptionConfigR\n" +
-	"encryption\"u\n" +
-	"\vEventConfig\x12\x18\n" +
-	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
-	"\ftopic_prefix\x18\x02 \x01(\tR\vtopicPrefix\x12)\n" +
-	"\x10subscribe_events\x18\x03 \x03(\tR\x0fsubscribeEvents\"\x8c\x02\n" +
-	"\tJobConfig\x12!\n" +
-	"\fworker_count\x18\x01 \x01(\x05R\vworkerCount\x12\x1f\n" +
-	"\vmax_retries\x18\x02 \x01(\x05R\n" +
-	"maxRetries\x12.\n" +
-	"\x13retry_delay_seconds\x18\x03 \x01(\x05R\x11retryDelaySeconds\x128\n" +
-	"\x18retry_backoff_multiplier\x18\x04 \x01(\x02R\x16retryBackoffMultiplier\x12.\n" +
-	"\x13job_timeout_seconds\x18\x05 \x01(\x05R\x11jobTimeoutSeconds\x12!\n" +
-	"\fcleanup_days\x18\x06 \x01(\x05R\vcleanupDays\"$\n" +
-	"\x10EncryptionConfig\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03keyB7Z5go-wind-admin/app/deployer/service/internal/conf;confb\x06proto3"
+func (x *HealthCheckConfig) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *HealthCheckConfig) GetJitter() float32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *HealthCheckConfig) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *HealthCheckConfig) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// Configuration for detecting devices that no longer serve the last deployed certificate
+type DriftConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalMinutes int32                  `protobuf:"varint,1,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"` // Interval between checks of a configuration (default: 720, 0 disables)
+	Jitter          float32                `protobuf:"fixed32,2,opt,name=jitter,proto3" json:"jitter,omitempty"`                                         // Fraction (0-1) by which the interval is randomized per check (default: 0.1)
+	Concurrency     int32                  `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                                // Checks running at once (default: 5)
+	TimeoutSeconds  int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`    // Timeout of a single check (default: 60)
+	AutoRemediate   bool                   `protobuf:"varint,5,opt,name=auto_remediate,json=autoRemediate,proto3" json:"auto_remediate,omitempty"`       // Create a job redeploying the expected certificate when drift is found
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DriftConfig) Reset() {
+	*x = DriftConfig{}
+	mi := &file_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftConfig) ProtoMessage() {}
+
+func (x *DriftConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftConfig.ProtoReflect.Descriptor instead.
+func (*DriftConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DriftConfig) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *DriftConfig) GetJitter() float32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *DriftConfig) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *DriftConfig) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *DriftConfig) GetAutoRemediate() bool {
+	if x != nil {
+		return x.AutoRemediate
+	}
+	return false
+}
+
+// Configuration for the expiry watchdog of deployed certificates
+type ExpiryConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalMinutes int32                  `protobuf:"varint,1,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"` // Interval between refreshes of the expiry gauges (default: 15, 0 disables)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExpiryConfig) Reset() {
+	*x = ExpiryConfig{}
+	mi := &file_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpiryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiryConfig) ProtoMessage() {}
+
+func (x *ExpiryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiryConfig.ProtoReflect.Descriptor instead.
+func (*ExpiryConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExpiryConfig) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+// Configuration for publishing lifecycle events to the event bus through an outbox table
+type PublishConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Enabled             bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                      // Publish deployer.job.* and deployer.drift.detected events (default: false)
+	TopicPrefix         string                 `protobuf:"bytes,2,opt,name=topic_prefix,json=topicPrefix,proto3" json:"topic_prefix,omitempty"`                            // Prefix of the channels events are published on (default: "deployer")
+	PollIntervalSeconds int32                  `protobuf:"varint,3,opt,name=poll_interval_seconds,json=pollIntervalSeconds,proto3" json:"poll_interval_seconds,omitempty"` // Interval between relays of pending events (default: 2)
+	BatchSize           int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                                 // Events relayed per poll (default: 100)
+	RetentionHours      int32                  `protobuf:"varint,5,opt,name=retention_hours,json=retentionHours,proto3" json:"retention_hours,omitempty"`                  // Hours published events are kept in the outbox (default: 24)
+	Format              string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`                                                         // Envelope of published events: "lcm" or "cloudevents" (default: "lcm")
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PublishConfig) Reset() {
+	*x = PublishConfig{}
+	mi := &file_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConfig) ProtoMessage() {}
+
+func (x *PublishConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConfig.ProtoReflect.Descriptor instead.
+func (*PublishConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PublishConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PublishConfig) GetTopicPrefix() string {
+	if x != nil {
+		return x.TopicPrefix
+	}
+	return ""
+}
+
+func (x *PublishConfig) GetPollIntervalSeconds() int32 {
+	if x != nil {
+		return x.PollIntervalSeconds
+	}
+	return 0
+}
+
+func (x *PublishConfig) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *PublishConfig) GetRetentionHours() int32 {
+	if x != nil {
+		return x.RetentionHours
+	}
+	return 0
+}
+
+func (x *PublishConfig) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Configuration for the HTTP ingestion of CloudEvents from certificate sources other than LCM
+type IngestConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Enabled             bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                        // Accept events on POST /v1/events (default: false)
+	Sources             []*IngestSource        `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`                                                         // Senders allowed to post events
+	MaxBodyBytes        int64                  `protobuf:"varint,3,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`                        // Largest accepted request body (default: 1 MiB)
+	MaxClockSkewSeconds int32                  `protobuf:"varint,4,opt,name=max_clock_skew_seconds,json=maxClockSkewSeconds,proto3" json:"max_clock_skew_seconds,omitempty"` // Largest age of a signed request (default: 300)
+	Mtls                bool                   `protobuf:"varint,5,opt,name=mtls,proto3" json:"mtls,omitempty"`                                                              // Serve HTTP with TLS and accept client certificates of the module CA
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IngestConfig) Reset() {
+	*x = IngestConfig{}
+	mi := &file_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestConfig) ProtoMessage() {}
+
+func (x *IngestConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestConfig.ProtoReflect.Descriptor instead.
+func (*IngestConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IngestConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *IngestConfig) GetSources() []*IngestSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *IngestConfig) GetMaxBodyBytes() int64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *IngestConfig) GetMaxClockSkewSeconds() int32 {
+	if x != nil {
+		return x.MaxClockSkewSeconds
+	}
+	return 0
+}
+
+func (x *IngestConfig) GetMtls() bool {
+	if x != nil {
+		return x.Mtls
+	}
+	return false
+}
+
+// Sender of ingested events, authenticated by an HMAC signature or an mTLS client certificate
+type IngestSource struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                   // Source name, sent in the X-Deployer-Source header of signed requests
+	TenantId         uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                          // Tenant whose deployments the events of this source trigger
+	HmacSecret       string                 `protobuf:"bytes,3,opt,name=hmac_secret,json=hmacSecret,proto3" json:"hmac_secret,omitempty"`                     // Shared signing secret, or an env:/file:/vault: secret reference
+	ClientCommonName string                 `protobuf:"bytes,4,opt,name=client_common_name,json=clientCommonName,proto3" json:"client_common_name,omitempty"` // Common name of the mTLS client certificate of this source
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *IngestSource) Reset() {
+	*x = IngestSource{}
+	mi := &file_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestSource) ProtoMessage() {}
+
+func (x *IngestSource) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestSource.ProtoReflect.Descriptor instead.
+func (*IngestSource) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IngestSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngestSource) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *IngestSource) GetHmacSecret() string {
+	if x != nil {
+		return x.HmacSecret
+	}
+	return ""
+}
+
+func (x *IngestSource) GetClientCommonName() string {
+	if x != nil {
+		return x.ClientCommonName
+	}
+	return ""
+}
+
+// Configuration for notifications about job outcomes
+type NotificationConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                // Send notifications (default: false)
+	DigestSeconds     int32                  `protobuf:"varint,2,opt,name=digest_seconds,json=digestSeconds,proto3" json:"digest_seconds,omitempty"`               // Window collecting events of a rule into one message (default: 60, negative sends each event on its own)
+	MaxAttempts       int32                  `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                     // Delivery attempts of a message (default: 3)
+	RetryDelaySeconds int32                  `protobuf:"varint,4,opt,name=retry_delay_seconds,json=retryDelaySeconds,proto3" json:"retry_delay_seconds,omitempty"` // Delay before the first retry, doubled after each (default: 10)
+	QueueSize         int32                  `protobuf:"varint,5,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`                           // Job transitions buffered for matching; further ones are dropped (default: 1000)
+	Smtp              *SMTPConfig            `protobuf:"bytes,6,opt,name=smtp,proto3" json:"smtp,omitempty"`                                                       // Mail server of email rules (email rules fail when unset)
+	SubjectTemplate   string                 `protobuf:"bytes,7,opt,name=subject_template,json=subjectTemplate,proto3" json:"subject_template,omitempty"`          // Go text/template of message subjects (default: built-in)
+	BodyTemplate      string                 `protobuf:"bytes,8,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`                   // Go text/template of message bodies (default: built-in)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationConfig) Reset() {
+	*x = NotificationConfig{}
+	mi := &file_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationConfig) ProtoMessage() {}
+
+func (x *NotificationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationConfig.ProtoReflect.Descriptor instead.
+func (*NotificationConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NotificationConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationConfig) GetDigestSeconds() int32 {
+	if x != nil {
+		return x.DigestSeconds
+	}
+	return 0
+}
+
+func (x *NotificationConfig) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *NotificationConfig) GetRetryDelaySeconds() int32 {
+	if x != nil {
+		return x.RetryDelaySeconds
+	}
+	return 0
+}
+
+func (x *NotificationConfig) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *NotificationConfig) GetSmtp() *SMTPConfig {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *NotificationConfig) GetSubjectTemplate() string {
+	if x != nil {
+		return x.SubjectTemplate
+	}
+	return ""
+}
+
+func (x *NotificationConfig) GetBodyTemplate() string {
+	if x != nil {
+		return x.BodyTemplate
+	}
+	return ""
+}
+
+// Configuration for the mail server of email notifications
+type SMTPConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`                                // Server host
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`                               // Server port (default: 587)
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`                        // Login; no authentication when empty
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                        // Password, or an env:/file:/vault: secret reference
+	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`                                // Sender address, e.g. "Deployer <deployer@example.com>"
+	RequireTls    bool                   `protobuf:"varint,6,opt,name=require_tls,json=requireTls,proto3" json:"require_tls,omitempty"` // Refuse servers that do not offer STARTTLS
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMTPConfig) Reset() {
+	*x = SMTPConfig{}
+	mi := &file_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMTPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMTPConfig) ProtoMessage() {}
+
+func (x *SMTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMTPConfig.ProtoReflect.Descriptor instead.
+func (*SMTPConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SMTPConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SMTPConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SMTPConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SMTPConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SMTPConfig) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SMTPConfig) GetRequireTls() bool {
+	if x != nil {
+		return x.RequireTls
+	}
+	return false
+}
+
+// Configuration for credentials encryption
+type EncryptionConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                      // Legacy AES key; the sole KEK when keys is empty, otherwise only used to decrypt records pending rotation
+	Keys          []*EncryptionKey       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`                                    // Key-encryption keys (KEKs) wrapping the per-record data keys
+	ActiveKeyId   string                 `protobuf:"bytes,3,opt,name=active_key_id,json=activeKeyId,proto3" json:"active_key_id,omitempty"` // ID of the KEK used for new records and rotation (default: first entry of keys)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptionConfig) Reset() {
+	*x = EncryptionConfig{}
+	mi := &file_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionConfig) ProtoMessage() {}
+
+func (x *EncryptionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionConfig.ProtoReflect.Descriptor instead.
+func (*EncryptionConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EncryptionConfig) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EncryptionConfig) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *EncryptionConfig) GetActiveKeyId() string {
+	if x != nil {
+		return x.ActiveKeyId
+	}
+	return ""
+}
+
+// Key-encryption key
+type EncryptionKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`   // Key identifier stored with every ciphertext (max 64 bytes)
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // Base64-encoded 32-byte AES-256 key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptionKey) Reset() {
+	*x = EncryptionKey{}
+	mi := &file_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKey) ProtoMessage() {}
+
+func (x *EncryptionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKey.ProtoReflect.Descriptor instead.
+func (*EncryptionKey) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EncryptionKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Configuration for audit log integrity verification and retention
+type AuditConfig struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SigningKeyFile        string                 `protobuf:"bytes,1,opt,name=signing_key_file,json=signingKeyFile,proto3" json:"signing_key_file,omitempty"`                       // PEM-encoded ECDSA private key used to sign audit rows (unsigned when empty)
+	VerifyIntervalMinutes int32                  `protobuf:"varint,2,opt,name=verify_interval_minutes,json=verifyIntervalMinutes,proto3" json:"verify_interval_minutes,omitempty"` // Interval between background integrity checks (default: 60, 0 disables)
+	VerifyWindowHours     int32                  `protobuf:"varint,3,opt,name=verify_window_hours,json=verifyWindowHours,proto3" json:"verify_window_hours,omitempty"`             // Time window covered by each background check (default: 24)
+	RetentionDays         int32                  `protobuf:"varint,4,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`                           // Days to keep audit rows in the database (default: 0, keep forever)
+	ArchiveDir            string                 `protobuf:"bytes,5,opt,name=archive_dir,json=archiveDir,proto3" json:"archive_dir,omitempty"`                                     // Directory for compressed archives of expired rows (default: <data_dir>/audit-archive)
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AuditConfig) Reset() {
+	*x = AuditConfig{}
+	mi := &file_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditConfig) ProtoMessage() {}
+
+func (x *AuditConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditConfig.ProtoReflect.Descriptor instead.
+func (*AuditConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditConfig) GetSigningKeyFile() string {
+	if x != nil {
+		return x.SigningKeyFile
+	}
+	return ""
+}
+
+func (x *AuditConfig) GetVerifyIntervalMinutes() int32 {
+	if x != nil {
+		return x.VerifyIntervalMinutes
+	}
+	return 0
+}
+
+func (x *AuditConfig) GetVerifyWindowHours() int32 {
+	if x != nil {
+		return x.VerifyWindowHours
+	}
+	return 0
+}
+
+func (x *AuditConfig) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *AuditConfig) GetArchiveDir() string {
+	if x != nil {
+		return x.ArchiveDir
+	}
+	return ""
+}
+
+// Configuration for resolving external secret references (env:, file:, vault:) in credentials
+type SecretsConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CacheTtlSeconds int32                  `protobuf:"varint,1,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"` // How long resolved values are cached (default: 300, negative disables caching)
+	EnvPrefix       string                 `protobuf:"bytes,2,opt,name=env_prefix,json=envPrefix,proto3" json:"env_prefix,omitempty"`                      // Only environment variables with this prefix may be referenced (default: "DEPLOYER_SECRET_")
+	FileDir         string                 `protobuf:"bytes,3,opt,name=file_dir,json=fileDir,proto3" json:"file_dir,omitempty"`                            // Only files below this directory may be referenced (default: "/run/secrets")
+	Vault           *VaultConfig           `protobuf:"bytes,4,opt,name=vault,proto3" json:"vault,omitempty"`                                               // HashiCorp Vault backend (vault: references are rejected when unset)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SecretsConfig) Reset() {
+	*x = SecretsConfig{}
+	mi := &file_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsConfig) ProtoMessage() {}
+
+func (x *SecretsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsConfig.ProtoReflect.Descriptor instead.
+func (*SecretsConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SecretsConfig) GetCacheTtlSeconds() int32 {
+	if x != nil {
+		return x.CacheTtlSeconds
+	}
+	return 0
+}
+
+func (x *SecretsConfig) GetEnvPrefix() string {
+	if x != nil {
+		return x.EnvPrefix
+	}
+	return ""
+}
+
+func (x *SecretsConfig) GetFileDir() string {
+	if x != nil {
+		return x.FileDir
+	}
+	return ""
+}
+
+func (x *SecretsConfig) GetVault() *VaultConfig {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+// Configuration for the HashiCorp Vault secret backend
+type VaultConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Address        string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                                      // Vault address, e.g. https://vault.example.com:8200
+	Token          string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                          // Vault token
+	TokenFile      string                 `protobuf:"bytes,3,opt,name=token_file,json=tokenFile,proto3" json:"token_file,omitempty"`                 // File holding the Vault token, re-read on every request (takes precedence over token)
+	Namespace      string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                                  // Vault Enterprise namespace
+	KvVersion      int32                  `protobuf:"varint,5,opt,name=kv_version,json=kvVersion,proto3" json:"kv_version,omitempty"`                // KV secrets engine version, 1 or 2 (default: 2)
+	TimeoutSeconds int32                  `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Request timeout (default: 10)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VaultConfig) Reset() {
+	*x = VaultConfig{}
+	mi := &file_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultConfig) ProtoMessage() {}
+
+func (x *VaultConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultConfig.ProtoReflect.Descriptor instead.
+func (*VaultConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VaultConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VaultConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VaultConfig) GetTokenFile() string {
+	if x != nil {
+		return x.TokenFile
+	}
+	return ""
+}
+
+func (x *VaultConfig) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VaultConfig) GetKvVersion() int32 {
+	if x != nil {
+		return x.KvVersion
+	}
+	return 0
+}
+
+func (x *VaultConfig) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// Configuration for role-based access control
+type RbacConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                           // Enforce per-RPC permissions (default: false, every authenticated caller has full access within its tenant)
+	DefaultRole   string                 `protobuf:"bytes,2,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"` // Role of callers without any binding in their tenant: "", "viewer", "operator" or "admin" (default: "", no access)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RbacConfig) Reset() {
+	*x = RbacConfig{}
+	mi := &file_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RbacConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RbacConfig) ProtoMessage() {}
+
+func (x *RbacConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RbacConfig.ProtoReflect.Descriptor instead.
+func (*RbacConfig) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RbacConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RbacConfig) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+var File_conf_proto protoreflect.FileDescriptor
+
+const file_conf_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"conf.proto\x12\n" +
+	"kratos.api\"\x9f\x05\n" +
+	"\bDeployer\x12\x19\n" +
+	"\bdata_dir\x18\x01 \x01(\tR\adataDir\x12/\n" +
+	"\x06events\x18\x02 \x01(\v2\x17.kratos.api.EventConfigR\x06events\x12)\n" +
+	"\x04jobs\x18\x03 \x01(\v2\x15.kratos.api.JobConfigR\x04jobs\x12<\n" +
+	"\n" +
+	"encryption\x18\x04 \x01(\v2\x1c.kratos.api.EncryptionConfigR\n" +
+	"encryption\x12-\n" +
+	"\x05audit\x18\x05 \x01(\v2\x17.kratos.api.AuditConfigR\x05audit\x123\n" +
+	"\asecrets\x18\x06 \x01(\v2\x19.kratos.api.SecretsConfigR\asecrets\x12*\n" +
+	"\x04rbac\x18\a \x01(\v2\x16.kratos.api.RbacConfigR\x04rbac\x12@\n" +
+	"\fhealth_check\x18\b \x01(\v2\x1d.kratos.api.HealthCheckConfigR\vhealthCheck\x12-\n" +
+	"\x05drift\x18\t \x01(\v2\x17.kratos.api.DriftConfigR\x05drift\x120\n" +
+	"\x06expiry\x18\n" +
+	" \x01(\v2\x18.kratos.api.ExpiryConfigR\x06expiry\x12D\n" +
+	"\rnotifications\x18\v \x01(\v2\x1e.kratos.api.NotificationConfigR\rnotifications\x123\n" +
+	"\apublish\x18\f \x01(\v2\x19.kratos.api.PublishConfigR\apublish\x120\n" +
+	"\x06ingest\x18\r \x01(\v2\x18.kratos.api.IngestConfigR\x06ingest\"u\n" +
+	"\vEventConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\ftopic_prefix\x18\x02 \x01(\tR\vtopicPrefix\x12)\n" +
+	"\x10subscribe_events\x18\x03 \x03(\tR\x0fsubscribeEvents\"\xaf\a\n" +
+	"\tJobConfig\x12!\n" +
+	"\fworker_count\x18\x01 \x01(\x05R\vworkerCount\x12\x1f\n" +
+	"\vmax_retries\x18\x02 \x01(\x05R\n" +
+	"maxRetries\x12.\n" +
+	"\x13retry_delay_seconds\x18\x03 \x01(\x05R\x11retryDelaySeconds\x128\n" +
+	"\x18retry_backoff_multiplier\x18\x04 \x01(\x02R\x16retryBackoffMultiplier\x12.\n" +
+	"\x13job_timeout_seconds\x18\x05 \x01(\x05R\x11jobTimeoutSeconds\x12!\n" +
+	"\fcleanup_days\x18\x06 \x01(\x05R\vcleanupDays\x12!\n" +
+	"\fretry_jitter\x18\a \x01(\x02R\vretryJitter\x125\n" +
+	"\x17max_retry_delay_seconds\x18\b \x01(\x05R\x14maxRetryDelaySeconds\x12X\n" +
+	"\x11provider_policies\x18\t \x03(\v2+.kratos.api.JobConfig.ProviderPoliciesEntryR\x10providerPolicies\x12a\n" +
+	"\x14provider_concurrency\x18\n" +
+	" \x03(\v2..kratos.api.JobConfig.ProviderConcurrencyEntryR\x13providerConcurrency\x12*\n" +
+	"\x11serialize_by_host\x18\v \x01(\bR\x0fserializeByHost\x12:\n" +
+	"\x19circuit_breaker_threshold\x18\f \x01(\x05R\x17circuitBreakerThreshold\x12A\n" +
+	"\x1dcircuit_breaker_probe_seconds\x18\r \x01(\x05R\x1acircuitBreakerProbeSeconds\x129\n" +
+	"\x19circuit_breaker_fail_fast\x18\x0e \x01(\bR\x16circuitBreakerFailFast\x1a\\\n" +
+	"\x15ProviderPoliciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.kratos.api.RetryPolicyR\x05value:\x028\x01\x1aF\n" +
+	"\x18ProviderConcurrencyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa9\x03\n" +
+	"\vRetryPolicy\x12$\n" +
+	"\vmax_retries\x18\x01 \x01(\x05H\x00R\n" +
+	"maxRetries\x88\x01\x01\x127\n" +
+	"\x15initial_delay_seconds\x18\x02 \x01(\x05H\x01R\x13initialDelaySeconds\x88\x01\x01\x122\n" +
+	"\x12backoff_multiplier\x18\x03 \x01(\x02H\x02R\x11backoffMultiplier\x88\x01\x01\x12\x1b\n" +
+	"\x06jitter\x18\x04 \x01(\x02H\x03R\x06jitter\x88\x01\x01\x12/\n" +
+	"\x11max_delay_seconds\x18\x05 \x01(\x05H\x04R\x0fmaxDelaySeconds\x88\x01\x01\x12;\n" +
+	"\x17attempt_timeout_seconds\x18\x06 \x01(\x05H\x05R\x15attemptTimeoutSeconds\x88\x01\x01B\x0e\n" +
+	"\f_max_retriesB\x18\n" +
+	"\x16_initial_delay_secondsB\x15\n" +
+	"\x13_backoff_multiplierB\t\n" +
+	"\a_jitterB\x14\n" +
+	"\x12_max_delay_secondsB\x1a\n" +
+	"\x18_attempt_timeout_seconds\"\xa1\x01\n" +
+	"\x11HealthCheckConfig\x12)\n" +
+	"\x10interval_minutes\x18\x01 \x01(\x05R\x0fintervalMinutes\x12\x16\n" +
+	"\x06jitter\x18\x02 \x01(\x02R\x06jitter\x12 \n" +
+	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\"\xc2\x01\n" +
+	"\vDriftConfig\x12)\n" +
+	"\x10interval_minutes\x18\x01 \x01(\x05R\x0fintervalMinutes\x12\x16\n" +
+	"\x06jitter\x18\x02 \x01(\x02R\x06jitter\x12 \n" +
+	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x12%\n" +
+	"\x0eauto_remediate\x18\x05 \x01(\bR\rautoRemediate\"9\n" +
+	"\fExpiryConfig\x12)\n" +
+	"\x10interval_minutes\x18\x01 \x01(\x05R\x0fintervalMinutes\"\xe0\x01\n" +
+	"\rPublishConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\ftopic_prefix\x18\x02 \x01(\tR\vtopicPrefix\x122\n" +
+	"\x15poll_interval_seconds\x18\x03 \x01(\x05R\x13pollIntervalSeconds\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12'\n" +
+	"\x0fretention_hours\x18\x05 \x01(\x05R\x0eretentionHours\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\"\xcb\x01\n" +
+	"\fIngestConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x122\n" +
+	"\asources\x18\x02 \x03(\v2\x18.kratos.api.IngestSourceR\asources\x12$\n" +
+	"\x0emax_body_bytes\x18\x03 \x01(\x03R\fmaxBodyBytes\x123\n" +
+	"\x16max_clock_skew_seconds\x18\x04 \x01(\x05R\x13maxClockSkewSeconds\x12\x12\n" +
+	"\x04mtls\x18\x05 \x01(\bR\x04mtls\"\x8e\x01\n" +
+	"\fIngestSource\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x1f\n" +
+	"\vhmac_secret\x18\x03 \x01(\tR\n" +
+	"hmacSecret\x12,\n" +
+	"\x12client_common_name\x18\x04 \x01(\tR\x10clientCommonName\"\xc3\x02\n" +
+	"\x12NotificationConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12%\n" +
+	"\x0edigest_seconds\x18\x02 \x01(\x05R\rdigestSeconds\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x12.\n" +
+	"\x13retry_delay_seconds\x18\x04 \x01(\x05R\x11retryDelaySeconds\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x05 \x01(\x05R\tqueueSize\x12*\n" +
+	"\x04smtp\x18\x06 \x01(\v2\x16.kratos.api.SMTPConfigR\x04smtp\x12)\n" +
+	"\x10subject_template\x18\a \x01(\tR\x0fsubjectTemplate\x12#\n" +
+	"\rbody_template\x18\b \x01(\tR\fbodyTemplate\"\xa1\x01\n" +
+	"\n" +
+	"SMTPConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x1f\n" +
+	"\vrequire_tls\x18\x06 \x01(\bR\n" +
+	"requireTls\"w\n" +
+	"\x10EncryptionConfig\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x04keys\x18\x02 \x03(\v2\x19.kratos.api.EncryptionKeyR\x04keys\x12\"\n" +
+	"\ractive_key_id\x18\x03 \x01(\tR\vactiveKeyId\"1\n" +
+	"\rEncryptionKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\xe7\x01\n" +
+	"\vAuditConfig\x12(\n" +
+	"\x10signing_key_file\x18\x01 \x01(\tR\x0esigningKeyFile\x126\n" +
+	"\x17verify_interval_minutes\x18\x02 \x01(\x05R\x15verifyIntervalMinutes\x12.\n" +
+	"\x13verify_window_hours\x18\x03 \x01(\x05R\x11verifyWindowHours\x12%\n" +
+	"\x0eretention_days\x18\x04 \x01(\x05R\rretentionDays\x12\x1f\n" +
+	"\varchive_dir\x18\x05 \x01(\tR\n" +
+	"archiveDir\"\xa4\x01\n" +
+	"\rSecretsConfig\x12*\n" +
+	"\x11cache_ttl_seconds\x18\x01 \x01(\x05R\x0fcacheTtlSeconds\x12\x1d\n" +
+	"\n" +
+	"env_prefix\x18\x02 \x01(\tR\tenvPrefix\x12\x19\n" +
+	"\bfile_dir\x18\x03 \x01(\tR\afileDir\x12-\n" +
+	"\x05vault\x18\x04 \x01(\v2\x17.kratos.api.VaultConfigR\x05vault\"\xc2\x01\n" +
+	"\vVaultConfig\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"token_file\x18\x03 \x01(\tR\ttokenFile\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"kv_version\x18\x05 \x01(\x05R\tkvVersion\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x05R\x0etimeoutSeconds\"I\n" +
+	"\n" +
+	"RbacConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\fdefault_role\x18\x02 \x01(\tR\vdefaultRoleB7Z5go-wind-admin/app/deployer/service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_proto_rawDescOnce sync.Once
@@ -321,22 +1625,54 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_conf_proto_goTypes = []any{
-	(*Deployer)(nil),         // 0: kratos.api.Deployer
-	(*EventConfig)(nil),      // 1: kratos.api.EventConfig
-	(*JobConfig)(nil),        // 2: kratos.api.JobConfig
-	(*EncryptionConfig)(nil), // 3: kratos.api.EncryptionConfig
+	(*Deployer)(nil),           // 0: kratos.api.Deployer
+	(*EventConfig)(nil),        // 1: kratos.api.EventConfig
+	(*JobConfig)(nil),          // 2: kratos.api.JobConfig
+	(*RetryPolicy)(nil),        // 3: kratos.api.RetryPolicy
+	(*HealthCheckConfig)(nil),  // 4: kratos.api.HealthCheckConfig
+	(*DriftConfig)(nil),        // 5: kratos.api.DriftConfig
+	(*ExpiryConfig)(nil),       // 6: kratos.api.ExpiryConfig
+	(*PublishConfig)(nil),      // 7: kratos.api.PublishConfig
+	(*IngestConfig)(nil),       // 8: kratos.api.IngestConfig
+	(*IngestSource)(nil),       // 9: kratos.api.IngestSource
+	(*NotificationConfig)(nil), // 10: kratos.api.NotificationConfig
+	(*SMTPConfig)(nil),         // 11: kratos.api.SMTPConfig
+	(*EncryptionConfig)(nil),   // 12: kratos.api.EncryptionConfig
+	(*EncryptionKey)(nil),      // 13: kratos.api.EncryptionKey
+	(*AuditConfig)(nil),        // 14: kratos.api.AuditConfig
+	(*SecretsConfig)(nil),      // 15: kratos.api.SecretsConfig
+	(*VaultConfig)(nil),        // 16: kratos.api.VaultConfig
+	(*RbacConfig)(nil),         // 17: kratos.api.RbacConfig
+	nil,                        // 18: kratos.api.JobConfig.ProviderPoliciesEntry
+	nil,                        // 19: kratos.api.JobConfig.ProviderConcurrencyEntry
 }
 var file_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.Deployer.events:type_name -> kratos.api.EventConfig
-	2, // 1: kratos.api.Deployer.jobs:type_name -> kratos.api.JobConfig
-	3, // 2: kratos.api.Deployer.encryption:type_name -> kratos.api.EncryptionConfig
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: kratos.api.Deployer.events:type_name -> kratos.api.EventConfig
+	2,  // 1: kratos.api.Deployer.jobs:type_name -> kratos.api.JobConfig
+	12, // 2: kratos.api.Deployer.encryption:type_name -> kratos.api.EncryptionConfig
+	14, // 3: kratos.api.Deployer.audit:type_name -> kratos.api.AuditConfig
+	15, // 4: kratos.api.Deployer.secrets:type_name -> kratos.api.SecretsConfig
+	17, // 5: kratos.api.Deployer.rbac:type_name -> kratos.api.RbacConfig
+	4,  // 6: kratos.api.Deployer.health_check:type_name -> kratos.api.HealthCheckConfig
+	5,  // 7: kratos.api.Deployer.drift:type_name -> kratos.api.DriftConfig
+	6,  // 8: kratos.api.Deployer.expiry:type_name -> kratos.api.ExpiryConfig
+	10, // 9: kratos.api.Deployer.notifications:type_name -> kratos.api.NotificationConfig
+	7,  // 10: kratos.api.Deployer.publish:type_name -> kratos.api.PublishConfig
+	8,  // 11: kratos.api.Deployer.ingest:type_name -> kratos.api.IngestConfig
+	18, // 12: kratos.api.JobConfig.provider_policies:type_name -> kratos.api.JobConfig.ProviderPoliciesEntry
+	19, // 13: kratos.api.JobConfig.provider_concurrency:type_name -> kratos.api.JobConfig.ProviderConcurrencyEntry
+	9,  // 14: kratos.api.IngestConfig.sources:type_name -> kratos.api.IngestSource
+	11, // 15: kratos.api.NotificationConfig.smtp:type_name -> kratos.api.SMTPConfig
+	13, // 16: kratos.api.EncryptionConfig.keys:type_name -> kratos.api.EncryptionKey
+	16, // 17: kratos.api.SecretsConfig.vault:type_name -> kratos.api.VaultConfig
+	3,  // 18: kratos.api.JobConfig.ProviderPoliciesEntry.value:type_name -> kratos.api.RetryPolicy
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -344,13 +1680,14 @@ func file_conf_proto_init() {
 	if File_conf_proto != nil {
 		return
 	}
+	file_conf_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
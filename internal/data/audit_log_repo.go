@@ -2,94 +2,243 @@ package data
 
 import (
 	"context"
+	stdsql "database/sql"
+	"errors"
 	"time"
 
+	"entgo.io/ent/dialect"
+
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	entCrud "github.com/tx7do/go-crud/entgo"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditlog"
 
 	"github.com/go-tangra/go-tangra-common/middleware/audit"
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
 
 // AuditLogRepo implements audit.AuditLogRepository for deployer
 type AuditLogRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	sealer    *AuditSealer
 	log       *log.Helper
 }
 
 // NewAuditLogRepo creates a new AuditLogRepo
 func NewAuditLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditLogRepo {
+	l := ctx.NewLoggerHelper("deployer/audit_log_repo")
+
+	var keyFile string
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok && deployerCfg.Audit != nil {
+			keyFile = deployerCfg.Audit.SigningKeyFile
+		}
+	}
+
+	sealer, err := NewAuditSealer(keyFile)
+	if err != nil {
+		// Keep auditing with hashes only rather than refusing to start
+		l.Errorf("Failed to load audit signing key, audit rows will be unsigned: %v", err)
+		sealer = &AuditSealer{}
+	} else if !sealer.SigningEnabled() {
+		l.Warn("No audit signing key configured, audit rows will be hashed but not signed")
+	}
+
 	return &AuditLogRepo{
-		log:       ctx.NewLoggerHelper("deployer/audit_log_repo"),
+		log:       l,
 		entClient: entClient,
+		sealer:    sealer,
 	}
 }
 
+// Sealer returns the sealer used to hash and sign audit rows
+func (r *AuditLogRepo) Sealer() *AuditSealer {
+	return r.sealer
+}
+
 // CreateFromEntry implements audit.AuditLogRepository
 func (r *AuditLogRepo) CreateFromEntry(ctx context.Context, entry *audit.AuditLogEntry) error {
-	builder := r.entClient.Client().AuditLog.Create().
-		SetAuditID(entry.AuditID).
-		SetOperation(entry.Operation).
-		SetServiceName(entry.ServiceName).
-		SetSuccess(entry.Success).
-		SetIsAuthenticated(entry.IsAuthenticated).
-		SetLatencyMs(entry.LatencyMs).
-		SetCreateTime(entry.Timestamp)
+	row := auditLogFromEntry(entry)
 
-	if entry.TenantID > 0 {
-		builder.SetTenantID(entry.TenantID)
+	// The row is chained to the latest one of any tenant. The chain head stays
+	// locked until the row is committed, so concurrent writers of all replicas
+	// append one after the other.
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return err
 	}
-	if entry.RequestID != "" {
-		builder.SetRequestID(entry.RequestID)
+	head, err := r.lockChainHead(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("lock audit chain head failed: %s", err.Error())
+		return err
 	}
-	if entry.ClientID != "" {
-		builder.SetClientID(entry.ClientID)
+	if head.LastID != nil && head.LastHash != "" {
+		row.PrevID = head.LastID
+		row.PrevHash = head.LastHash
 	}
-	if entry.ClientCommonName != "" {
-		builder.SetClientCommonName(entry.ClientCommonName)
+
+	logHash, signature, err := r.sealer.Seal(row)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("seal audit log failed: %s", err.Error())
+		return err
 	}
-	if entry.ClientOrganization != "" {
-		builder.SetClientOrganization(entry.ClientOrganization)
+
+	builder := tx.AuditLog.Create().
+		SetAuditID(row.AuditID).
+		SetOperation(row.Operation).
+		SetServiceName(row.ServiceName).
+		SetSuccess(row.Success).
+		SetIsAuthenticated(row.IsAuthenticated).
+		SetLatencyMs(row.LatencyMs).
+		SetCreateTime(*row.CreateTime).
+		SetLogHash(logHash).
+		SetNillableTenantID(row.TenantID).
+		SetNillableErrorCode(row.ErrorCode).
+		SetNillablePrevID(row.PrevID)
+
+	if row.RequestID != "" {
+		builder.SetRequestID(row.RequestID)
 	}
-	if entry.ClientSerialNumber != "" {
-		builder.SetClientSerialNumber(entry.ClientSerialNumber)
+	if row.ClientID != "" {
+		builder.SetClientID(row.ClientID)
 	}
-	if entry.ErrorCode != 0 {
-		builder.SetErrorCode(entry.ErrorCode)
+	if row.ClientCommonName != "" {
+		builder.SetClientCommonName(row.ClientCommonName)
+	}
+	if row.ClientOrganization != "" {
+		builder.SetClientOrganization(row.ClientOrganization)
 	}
-	if entry.ErrorMessage != "" {
-		builder.SetErrorMessage(entry.ErrorMessage)
+	if row.ClientSerialNumber != "" {
+		builder.SetClientSerialNumber(row.ClientSerialNumber)
 	}
-	if entry.PeerAddress != "" {
-		builder.SetPeerAddress(entry.PeerAddress)
+	if row.ErrorMessage != "" {
+		builder.SetErrorMessage(row.ErrorMessage)
 	}
-	if entry.GeoLocation != nil {
-		builder.SetGeoLocation(entry.GeoLocation)
+	if row.PeerAddress != "" {
+		builder.SetPeerAddress(row.PeerAddress)
 	}
-	if entry.LogHash != "" {
-		builder.SetLogHash(entry.LogHash)
+	if row.GeoLocation != nil {
+		builder.SetGeoLocation(row.GeoLocation)
 	}
-	if entry.Signature != nil {
-		builder.SetSignature(entry.Signature)
+	if signature != nil {
+		builder.SetSignature(signature)
 	}
-	if entry.Metadata != nil {
-		builder.SetMetadata(entry.Metadata)
+	if row.PrevHash != "" {
+		builder.SetPrevHash(row.PrevHash)
+	}
+	if row.Metadata != nil {
+		builder.SetMetadata(row.Metadata)
 	}
 
-	_, err := builder.Save(ctx)
+	created, err := builder.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		r.log.Errorf("create audit log failed: %s", err.Error())
 		return err
 	}
 
+	if err := tx.AuditChainHead.UpdateOneID(chainHeadID).
+		SetLastID(created.ID).
+		SetLastHash(logHash).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("advance audit chain head failed: %s", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit audit log failed: %s", err.Error())
+		return err
+	}
 	return nil
 }
 
+// chainHeadID is the ID of the single audit chain head row
+const chainHeadID = 1
+
+// lockChainHead returns the audit chain head locked for the transaction. A
+// missing head is created pointing at the latest audit row.
+func (r *AuditLogRepo) lockChainHead(ctx context.Context, tx *ent.Tx) (*ent.AuditChainHead, error) {
+	query := func() (*ent.AuditChainHead, error) {
+		q := tx.AuditChainHead.Query().Where(auditchainhead.IDEQ(chainHeadID))
+		// SQLite has no row locks; the database lock serializes its writers
+		if r.entClient.Driver().Dialect() != dialect.SQLite {
+			q.ForUpdate()
+		}
+		return q.Only(ctx)
+	}
+
+	head, err := query()
+	if !ent.IsNotFound(err) {
+		return head, err
+	}
+
+	prev, err := tx.AuditLog.Query().
+		Order(ent.Desc(auditlog.FieldID)).
+		Select(auditlog.FieldID, auditlog.FieldLogHash).
+		First(appViewer.NewSystemViewerContext(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	create := tx.AuditChainHead.Create().SetID(chainHeadID)
+	if prev != nil {
+		create.SetLastID(prev.ID).SetLastHash(prev.LogHash)
+	}
+	// A concurrent writer may create the head first: keep its row
+	if err := create.OnConflictColumns(auditchainhead.FieldID).DoNothing().Exec(ctx); err != nil &&
+		!errors.Is(err, stdsql.ErrNoRows) {
+		return nil, err
+	}
+	return query()
+}
+
+// auditLogFromEntry maps a middleware entry onto the row that will be persisted,
+// so the seal is computed over the same values the database stores
+func auditLogFromEntry(entry *audit.AuditLogEntry) *ent.AuditLog {
+	createTime := entry.Timestamp
+	if createTime.IsZero() {
+		createTime = time.Now()
+	}
+	createTime = createTime.Truncate(time.Second)
+
+	row := &ent.AuditLog{
+		CreateTime:         &createTime,
+		AuditID:            entry.AuditID,
+		RequestID:          entry.RequestID,
+		Operation:          entry.Operation,
+		ServiceName:        entry.ServiceName,
+		ClientID:           entry.ClientID,
+		ClientCommonName:   entry.ClientCommonName,
+		ClientOrganization: entry.ClientOrganization,
+		ClientSerialNumber: entry.ClientSerialNumber,
+		IsAuthenticated:    entry.IsAuthenticated,
+		Success:            entry.Success,
+		ErrorMessage:       entry.ErrorMessage,
+		LatencyMs:          entry.LatencyMs,
+		PeerAddress:        entry.PeerAddress,
+		GeoLocation:        entry.GeoLocation,
+		Metadata:           entry.Metadata,
+	}
+	if entry.TenantID > 0 {
+		tenantID := entry.TenantID
+		row.TenantID = &tenantID
+	}
+	if entry.ErrorCode != 0 {
+		errorCode := entry.ErrorCode
+		row.ErrorCode = &errorCode
+	}
+	return row
+}
+
 // GetByAuditID retrieves an audit log by its audit ID
 func (r *AuditLogRepo) GetByAuditID(ctx context.Context, auditID string) (*ent.AuditLog, error) {
 	entity, err := r.entClient.Client().AuditLog.Query().
@@ -198,3 +347,79 @@ func (r *AuditLogRepo) DeleteOlderThan(ctx context.Context, before time.Time) (i
 	}
 	return deleted, nil
 }
+
+// AuditLogRangeOptions selects a batch of audit logs in ascending ID order
+type AuditLogRangeOptions struct {
+	TenantID  *uint32
+	StartTime *time.Time // inclusive
+	EndTime   *time.Time // exclusive
+	AfterID   uint32
+	Limit     int
+}
+
+// ListRange retrieves audit logs in ascending ID order, for keyset pagination
+// by the integrity checker and the retention archiver
+func (r *AuditLogRepo) ListRange(ctx context.Context, opts *AuditLogRangeOptions) ([]*ent.AuditLog, error) {
+	query := r.entClient.Client().AuditLog.Query().
+		Where(auditlog.IDGT(opts.AfterID))
+
+	if opts.TenantID != nil {
+		query = query.Where(auditlog.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.StartTime != nil {
+		query = query.Where(auditlog.CreateTimeGTE(*opts.StartTime))
+	}
+	if opts.EndTime != nil {
+		query = query.Where(auditlog.CreateTimeLT(*opts.EndTime))
+	}
+
+	query = query.Order(ent.Asc(auditlog.FieldID))
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	entities, err := query.All(ctx)
+	if err != nil {
+		r.log.Errorf("list audit log range failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list audit log range failed")
+	}
+	return entities, nil
+}
+
+// ChainHashes returns the log hashes of the rows with the given IDs, of any
+// tenant, keyed by ID. Rows that do not exist are absent.
+func (r *AuditLogRepo) ChainHashes(ctx context.Context, ids []uint32) (map[uint32]string, error) {
+	hashes := make(map[uint32]string, len(ids))
+	if len(ids) == 0 {
+		return hashes, nil
+	}
+	rows, err := r.entClient.Client().AuditLog.Query().
+		Where(auditlog.IDIn(ids...)).
+		Select(auditlog.FieldID, auditlog.FieldLogHash).
+		All(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query audit log chain failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query audit log chain failed")
+	}
+	for _, row := range rows {
+		hashes[row.ID] = row.LogHash
+	}
+	return hashes, nil
+}
+
+// OldestID returns the ID of the oldest row of any tenant, or 0 when there is
+// none. Rows before it were removed by retention.
+func (r *AuditLogRepo) OldestID(ctx context.Context) (uint32, error) {
+	row, err := r.entClient.Client().AuditLog.Query().
+		Order(ent.Asc(auditlog.FieldID)).
+		Select(auditlog.FieldID).
+		First(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		r.log.Errorf("query oldest audit log failed: %s", err.Error())
+		return 0, deployerV1.ErrorInternalServerError("query oldest audit log failed")
+	}
+	return row.ID, nil
+}
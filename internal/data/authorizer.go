@@ -0,0 +1,37 @@
+package data
+
+import (
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/rbac"
+)
+
+// NewAuthorizer creates the RPC permission checker backed by the stored role
+// bindings. Enforcement is off unless rbac.enabled is set.
+func NewAuthorizer(ctx *bootstrap.Context, roleBindingRepo *RoleBindingRepo, jobRepo *DeploymentJobRepo,
+	configRepo *TargetConfigurationRepo) (*rbac.Authorizer, error) {
+	var cfg *conf.RbacConfig
+	if c, ok := ctx.GetCustomConfig("deployer"); ok && c != nil {
+		if deployerCfg, ok := c.(*conf.Deployer); ok {
+			cfg = deployerCfg.Rbac
+		}
+	}
+
+	a, err := rbac.NewAuthorizer(rbac.Options{
+		Enabled:     cfg.GetEnabled(),
+		DefaultRole: rbac.Role(cfg.GetDefaultRole()),
+	}, roleBindingRepo, jobRepo, configRepo, ctx.GetLogger())
+	if err != nil {
+		return nil, err
+	}
+
+	l := ctx.NewLoggerHelper("authorizer/data")
+	if a.Enabled() {
+		l.Infof("RBAC enabled (default role: %q)", cfg.GetDefaultRole())
+	} else {
+		l.Info("RBAC disabled, callers hold every permission within their tenant")
+	}
+
+	return a, nil
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/certificaterevocation"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// CertificateRevocationRepo records revoked and deleted certificates
+type CertificateRevocationRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewCertificateRevocationRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *CertificateRevocationRepo {
+	return &CertificateRevocationRepo{
+		log:       ctx.NewLoggerHelper("certificate_revocation/repo"),
+		entClient: entClient,
+	}
+}
+
+// Record records the revocation of a certificate serial, or of every serial
+// of the certificate when serial is empty. Repeated revocations are recorded
+// once.
+func (r *CertificateRevocationRepo) Record(ctx context.Context, tenantID uint32, certificateID, serial string) error {
+	serial = revocationSerial(serial)
+	exists, err := r.entClient.Client().CertificateRevocation.Query().
+		Where(
+			certificaterevocation.TenantIDEQ(tenantID),
+			certificaterevocation.CertificateIDEQ(certificateID),
+			certificaterevocation.SerialNumberEQ(serial),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query certificate revocation failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("query certificate revocation failed")
+	}
+	if exists {
+		return nil
+	}
+
+	if err := r.entClient.Client().CertificateRevocation.Create().
+		SetTenantID(tenantID).
+		SetCertificateID(certificateID).
+		SetSerialNumber(serial).
+		SetCreateTime(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("create certificate revocation failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("create certificate revocation failed")
+	}
+	return nil
+}
+
+// IsRevoked reports whether a revocation of the tenant covers the certificate
+// or serial: one of the serial, or one of the certificate for all its serials
+// or for a serial that is not known
+func (r *CertificateRevocationRepo) IsRevoked(ctx context.Context, tenantID uint32, certificateID, serial string) (bool, error) {
+	serial = revocationSerial(serial)
+	var match []predicate.CertificateRevocation
+	if certificateID != "" {
+		ofCertificate := certificaterevocation.CertificateIDEQ(certificateID)
+		if serial != "" {
+			ofCertificate = certificaterevocation.And(ofCertificate, certificaterevocation.SerialNumberEQ(""))
+		}
+		match = append(match, ofCertificate)
+	}
+	if serial != "" {
+		match = append(match, certificaterevocation.SerialNumberEQ(serial))
+	}
+	if len(match) == 0 {
+		return false, nil
+	}
+
+	revoked, err := r.entClient.Client().CertificateRevocation.Query().
+		Where(
+			certificaterevocation.TenantIDEQ(tenantID),
+			certificaterevocation.Or(match...),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query certificate revocation failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("query certificate revocation failed")
+	}
+	return revoked, nil
+}
+
+// revocationSerial returns the stored form of a serial, keeping unknown ones empty
+func revocationSerial(serial string) string {
+	if serial == "" {
+		return ""
+	}
+	return registry.NormalizeSerial(serial)
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+type ChangeRecordRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewChangeRecordRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ChangeRecordRepo {
+	return &ChangeRecordRepo{
+		log:       ctx.NewLoggerHelper("change_record/repo"),
+		entClient: entClient,
+	}
+}
+
+// ChangeRecordInput describes a single business-level change
+type ChangeRecordInput struct {
+	TenantID     uint32
+	ResourceType changerecord.ResourceType
+	ResourceID   string
+	ResourceName string
+	Action       changerecord.Action
+	ActorID      *uint32
+	ActorName    string
+	Changes      []schema.FieldChange
+	Reason       string
+}
+
+// Create stores a change record
+func (r *ChangeRecordRepo) Create(ctx context.Context, in *ChangeRecordInput) (*ent.ChangeRecord, error) {
+	builder := r.entClient.Client().ChangeRecord.Create().
+		SetTenantID(in.TenantID).
+		SetResourceType(in.ResourceType).
+		SetResourceID(in.ResourceID).
+		SetAction(in.Action).
+		SetNillableActorID(in.ActorID).
+		SetCreateTime(time.Now())
+
+	if in.ResourceName != "" {
+		builder.SetResourceName(in.ResourceName)
+	}
+	if in.ActorName != "" {
+		builder.SetActorName(in.ActorName)
+	}
+	if len(in.Changes) > 0 {
+		builder.SetChanges(in.Changes)
+	}
+	if in.Reason != "" {
+		builder.SetReason(in.Reason)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create change record failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create change record failed")
+	}
+	return entity, nil
+}
+
+// ChangeRecordListOptions contains options for listing change records
+type ChangeRecordListOptions struct {
+	TenantID      *uint32
+	ResourceType  *changerecord.ResourceType
+	ResourceID    *string
+	ActorID       *uint32
+	Action        *changerecord.Action
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Page          uint32
+	PageSize      uint32
+}
+
+// List lists change records, newest first
+func (r *ChangeRecordRepo) List(ctx context.Context, opts *ChangeRecordListOptions) ([]*ent.ChangeRecord, int, error) {
+	query := r.entClient.Client().ChangeRecord.Query()
+
+	if opts.TenantID != nil {
+		query = query.Where(changerecord.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.ResourceType != nil {
+		query = query.Where(changerecord.ResourceTypeEQ(*opts.ResourceType))
+	}
+	if opts.ResourceID != nil {
+		query = query.Where(changerecord.ResourceIDEQ(*opts.ResourceID))
+	}
+	if opts.ActorID != nil {
+		query = query.Where(changerecord.ActorIDEQ(*opts.ActorID))
+	}
+	if opts.Action != nil {
+		query = query.Where(changerecord.ActionEQ(*opts.Action))
+	}
+	if opts.CreatedAfter != nil {
+		query = query.Where(changerecord.CreateTimeGTE(*opts.CreatedAfter))
+	}
+	if opts.CreatedBefore != nil {
+		query = query.Where(changerecord.CreateTimeLTE(*opts.CreatedBefore))
+	}
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count change records failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count change records failed")
+	}
+
+	// Apply pagination
+	if opts.Page > 0 && opts.PageSize > 0 {
+		offset := int((opts.Page - 1) * opts.PageSize)
+		query = query.Offset(offset).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(changerecord.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list change records failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list change records failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.ChangeRecord to deployerV1.ChangeRecord
+func (r *ChangeRecordRepo) ToProto(entity *ent.ChangeRecord) *deployerV1.ChangeRecord {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &deployerV1.ChangeRecord{
+		Id:           entity.ID,
+		TenantId:     entity.TenantID,
+		ResourceType: deployerV1.ChangeResourceType(deployerV1.ChangeResourceType_value[string(entity.ResourceType)]),
+		ResourceId:   entity.ResourceID,
+		Action:       deployerV1.ChangeAction(deployerV1.ChangeAction_value[string(entity.Action)]),
+		ActorId:      entity.ActorID,
+	}
+
+	if entity.ResourceName != "" {
+		proto.ResourceName = &entity.ResourceName
+	}
+	if entity.ActorName != "" {
+		proto.ActorName = &entity.ActorName
+	}
+	if entity.Reason != "" {
+		proto.Reason = &entity.Reason
+	}
+
+	for _, c := range entity.Changes {
+		proto.Changes = append(proto.Changes, &deployerV1.FieldChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+			Masked: c.Masked,
+		})
+	}
+
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// ConfigurationRevisionRepo reads configuration revisions. Revisions are
+// written by TargetConfigurationRepo in the same transaction as the
+// configuration change they capture.
+type ConfigurationRevisionRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewConfigurationRevisionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ConfigurationRevisionRepo {
+	return &ConfigurationRevisionRepo{
+		log:       ctx.NewLoggerHelper("configuration_revision/repo"),
+		entClient: entClient,
+	}
+}
+
+// GetByID retrieves a revision by its ID
+func (r *ConfigurationRevisionRepo) GetByID(ctx context.Context, id uint32) (*ent.ConfigurationRevision, error) {
+	entity, err := r.entClient.Client().ConfigurationRevision.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get configuration revision failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get configuration revision failed")
+	}
+	return entity, nil
+}
+
+// GetByNumber retrieves a revision of a configuration by its revision number
+func (r *ConfigurationRevisionRepo) GetByNumber(ctx context.Context, configID string, revision uint32) (*ent.ConfigurationRevision, error) {
+	entity, err := r.entClient.Client().ConfigurationRevision.Query().
+		Where(
+			configurationrevision.TargetConfigurationIDEQ(configID),
+			configurationrevision.RevisionEQ(revision),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get configuration revision by number failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get configuration revision failed")
+	}
+	return entity, nil
+}
+
+// List lists revisions of a configuration, newest first
+func (r *ConfigurationRevisionRepo) List(ctx context.Context, configID string, page, pageSize uint32) ([]*ent.ConfigurationRevision, int, error) {
+	query := r.entClient.Client().ConfigurationRevision.Query().
+		Where(configurationrevision.TargetConfigurationIDEQ(configID))
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count configuration revisions failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count configuration revisions failed")
+	}
+
+	// Apply pagination
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(configurationrevision.FieldRevision)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list configuration revisions failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list configuration revisions failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListCredentialsAfter lists revisions ordered by ID, loading only the
+// encrypted credentials. Used to walk all records during key rotation.
+func (r *ConfigurationRevisionRepo) ListCredentialsAfter(ctx context.Context, afterID uint32, limit int) ([]*ent.ConfigurationRevision, error) {
+	entities, err := r.entClient.Client().ConfigurationRevision.Query().
+		Where(configurationrevision.IDGT(afterID)).
+		Order(ent.Asc(configurationrevision.FieldID)).
+		Limit(limit).
+		Select(configurationrevision.FieldID, configurationrevision.FieldCredentialsEncrypted).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list configuration revision credentials failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list configuration revision credentials failed")
+	}
+	return entities, nil
+}
+
+// ReplaceCredentialsEncrypted swaps the stored ciphertext of a revision for an
+// equivalent one (same plaintext) and returns whether the row was updated
+func (r *ConfigurationRevisionRepo) ReplaceCredentialsEncrypted(ctx context.Context, id uint32, old, replacement []byte) (bool, error) {
+	affected, err := r.entClient.Client().ConfigurationRevision.Update().
+		Where(
+			configurationrevision.IDEQ(id),
+			configurationrevision.CredentialsEncryptedEQ(old),
+		).
+		SetCredentialsEncrypted(replacement).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("replace configuration revision credentials failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("replace configuration revision credentials failed")
+	}
+	return affected > 0, nil
+}
+
+// ToProto converts an ent.ConfigurationRevision to deployerV1.ConfigurationRevision.
+// currentRevision is the revision number the configuration currently holds.
+func (r *ConfigurationRevisionRepo) ToProto(entity *ent.ConfigurationRevision, currentRevision uint32) *deployerV1.ConfigurationRevision {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &deployerV1.ConfigurationRevision{
+		Id:              entity.ID,
+		TenantId:        entity.TenantID,
+		ConfigurationId: entity.TargetConfigurationID,
+		Revision:        entity.Revision,
+		Name:            entity.Name,
+		ProviderType:    entity.ProviderType,
+		RevertedFrom:    entity.RevertedFrom,
+		Current:         entity.Revision == currentRevision,
+		ActorId:         entity.ActorID,
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.ActorName != "" {
+		proto.ActorName = &entity.ActorName
+	}
+	if entity.Reason != "" {
+		proto.Reason = &entity.Reason
+	}
+
+	// Convert config
+	if entity.Config != nil {
+		configStruct, err := structpb.NewStruct(entity.Config)
+		if err == nil {
+			proto.Config = configStruct
+		}
+	}
+
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
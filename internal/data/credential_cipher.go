@@ -0,0 +1,386 @@
+package data
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+)
+
+const (
+	// kekSize is the required size of key-encryption keys (AES-256)
+	kekSize = 32
+	// dekSize is the size of the per-record data keys (AES-256)
+	dekSize = 32
+	// minKeyEntropyBits is the minimum Shannon entropy per byte accepted for a key.
+	// 32 random bytes practically never fall below 4.3; repeated or text keys do.
+	minKeyEntropyBits = 4.1
+	// maxKeyIDLen bounds the key ID stored in every envelope
+	maxKeyIDLen = 64
+	// defaultLegacyKeyID names the KEK derived from the legacy key
+	defaultLegacyKeyID = "default"
+)
+
+// envelopeMagic prefixes envelope ciphertexts. Records written before envelope
+// encryption start with a random GCM nonce instead.
+var envelopeMagic = []byte("TGE1")
+
+// CredentialCipher encrypts stored credentials with envelope encryption.
+//
+// Every record gets a fresh data key (DEK) that encrypts the credentials; the
+// DEK is wrapped with a key-encryption key (KEK) whose ID is stored alongside:
+//
+//	"TGE1" | kidLen(1) | kid | wrappedLen(2) | wrapped DEK | nonce | ciphertext
+//
+// Rotating the KEK only re-wraps the DEK; the credential ciphertext is unchanged.
+type CredentialCipher struct {
+	keks        map[string][]byte
+	activeKeyID string
+	// legacyKey decrypts records written before envelope encryption (zero-padded or truncated to 32 bytes)
+	legacyKey []byte
+}
+
+// NewCredentialCipher builds the cipher from the deployer encryption config and
+// refuses keys of insufficient length or entropy
+func NewCredentialCipher(ctx *bootstrap.Context) (*CredentialCipher, error) {
+	var cfg *conf.EncryptionConfig
+	if c, ok := ctx.GetCustomConfig("deployer"); ok && c != nil {
+		if deployerCfg, ok := c.(*conf.Deployer); ok {
+			cfg = deployerCfg.Encryption
+		}
+	}
+
+	c, err := NewCredentialCipherFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	l := ctx.NewLoggerHelper("credential_cipher/data")
+	l.Infof("Credential encryption ready: %d key(s), active key %q", len(c.keks), c.activeKeyID)
+	if c.legacyKey != nil && len(cfg.GetKeys()) > 0 {
+		l.Warn("Legacy encryption key is configured; run RotateEncryptionKey and remove it once all credentials are re-wrapped")
+	}
+	return c, nil
+}
+
+// NewCredentialCipherFromConfig builds the cipher from an encryption config
+func NewCredentialCipherFromConfig(cfg *conf.EncryptionConfig) (*CredentialCipher, error) {
+	c := &CredentialCipher{keks: map[string][]byte{}}
+
+	legacy, err := keyValue(cfg.GetKey())
+	if err != nil {
+		return nil, fmt.Errorf("deployer.encryption.key: %w", err)
+	}
+	if legacy != "" {
+		c.legacyKey = legacyAESKey(legacy)
+	}
+
+	for i, k := range cfg.GetKeys() {
+		if k.GetId() == "" || len(k.GetId()) > maxKeyIDLen {
+			return nil, fmt.Errorf("encryption.keys[%d]: id must be 1-%d bytes", i, maxKeyIDLen)
+		}
+		if _, dup := c.keks[k.GetId()]; dup {
+			return nil, fmt.Errorf("encryption.keys[%d]: duplicate key id %q", i, k.GetId())
+		}
+		value, err := keyValue(k.GetKey())
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q: %w", k.GetId(), err)
+		}
+		kek, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q: not valid base64: %w", k.GetId(), err)
+		}
+		if err := checkKeyStrength(kek); err != nil {
+			return nil, fmt.Errorf("encryption key %q: %w", k.GetId(), err)
+		}
+		c.keks[k.GetId()] = kek
+		if c.activeKeyID == "" {
+			c.activeKeyID = k.GetId()
+		}
+	}
+
+	if len(c.keks) == 0 {
+		// Only the legacy raw key is configured: it becomes the sole KEK and
+		// must be strong enough on its own
+		raw := []byte(legacy)
+		if len(raw) == 0 {
+			return nil, errors.New("no credential encryption key configured (deployer.encryption.keys); generate one with: openssl rand -base64 32")
+		}
+		if len(raw) < kekSize {
+			return nil, fmt.Errorf("deployer.encryption.key is %d bytes, at least %d are required; configure deployer.encryption.keys instead", len(raw), kekSize)
+		}
+		if err := checkKeyStrength(raw[:kekSize]); err != nil {
+			return nil, fmt.Errorf("deployer.encryption.key: %w; configure deployer.encryption.keys instead", err)
+		}
+		c.activeKeyID = defaultLegacyKeyID
+	}
+
+	// Envelopes written while only the legacy key was configured carry its
+	// derived KEK; keep it so they stay readable after keys are added
+	if raw := legacy; raw != "" {
+		if _, ok := c.keks[defaultLegacyKeyID]; !ok {
+			kek := sha256.Sum256([]byte(raw))
+			c.keks[defaultLegacyKeyID] = kek[:]
+		}
+	}
+
+	if id := cfg.GetActiveKeyId(); id != "" {
+		if _, ok := c.keks[id]; !ok {
+			return nil, fmt.Errorf("deployer.encryption.active_key_id %q does not match any configured key", id)
+		}
+		c.activeKeyID = id
+	}
+
+	return c, nil
+}
+
+// keyValue returns a configured key, read from the environment when given as
+// env:NAME. Config placeholders cannot be used: they only see other config
+// values, never the environment.
+func keyValue(value string) (string, error) {
+	name, ok := strings.CutPrefix(value, "env:")
+	if !ok {
+		return value, nil
+	}
+	key, set := os.LookupEnv(name)
+	if !set || key == "" {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return key, nil
+}
+
+// ActiveKeyID returns the ID of the KEK used for new records
+func (c *CredentialCipher) ActiveKeyID() string {
+	return c.activeKeyID
+}
+
+// Encrypt encrypts plaintext under a fresh data key wrapped with the active KEK
+func (c *CredentialCipher) Encrypt(plaintext []byte) ([]byte, error) {
+	dek := make([]byte, dekSize)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return nil, err
+	}
+
+	wrapped, err := c.wrap(c.activeKeyID, dek)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := gcmSeal(dek, plaintext, envelopeMagic)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeEnvelope(c.activeKeyID, wrapped, sealed), nil
+}
+
+// Decrypt decrypts an envelope, or a legacy record when a legacy key is configured
+func (c *CredentialCipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	if kid, wrapped, sealed, ok := decodeEnvelope(ciphertext); ok {
+		dek, err := c.unwrap(kid, wrapped)
+		if err == nil {
+			return gcmOpen(dek, sealed, envelopeMagic)
+		}
+		// A legacy nonce can start with the magic by chance; fall through
+		if c.legacyKey == nil {
+			return nil, err
+		}
+		if plaintext, legacyErr := gcmOpen(c.legacyKey, ciphertext, nil); legacyErr == nil {
+			return plaintext, nil
+		}
+		return nil, err
+	}
+
+	if c.legacyKey == nil {
+		return nil, errors.New("credentials were encrypted without a key ID and no legacy key is configured")
+	}
+	return gcmOpen(c.legacyKey, ciphertext, nil)
+}
+
+// KeyID returns the KEK ID of an envelope, or "" for legacy records
+func (c *CredentialCipher) KeyID(ciphertext []byte) string {
+	kid, _, _, ok := decodeEnvelope(ciphertext)
+	if !ok {
+		return ""
+	}
+	return kid
+}
+
+// Rewrap re-wraps the data key of a record with the active KEK. Legacy records
+// are re-encrypted as envelopes. changed is false when the record already uses
+// the active KEK.
+func (c *CredentialCipher) Rewrap(ciphertext []byte) (out []byte, changed bool, err error) {
+	if kid, wrapped, sealed, ok := decodeEnvelope(ciphertext); ok {
+		if kid == c.activeKeyID {
+			return ciphertext, false, nil
+		}
+		dek, err := c.unwrap(kid, wrapped)
+		if err == nil {
+			rewrapped, err := c.wrap(c.activeKeyID, dek)
+			if err != nil {
+				return nil, false, err
+			}
+			return encodeEnvelope(c.activeKeyID, rewrapped, sealed), true, nil
+		}
+		if c.legacyKey == nil {
+			return nil, false, err
+		}
+	}
+
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil {
+		return nil, false, err
+	}
+	out, err = c.Encrypt(plaintext)
+	if err != nil {
+		return nil, false, err
+	}
+	return out, true, nil
+}
+
+// wrap encrypts a data key with the KEK kid, binding the key ID as associated data
+func (c *CredentialCipher) wrap(kid string, dek []byte) ([]byte, error) {
+	kek, ok := c.keks[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %q", kid)
+	}
+	return gcmSeal(kek, dek, wrapAAD(kid))
+}
+
+// unwrap decrypts a data key wrapped with the KEK kid
+func (c *CredentialCipher) unwrap(kid string, wrapped []byte) ([]byte, error) {
+	kek, ok := c.keks[kid]
+	if !ok {
+		return nil, fmt.Errorf("credentials are encrypted with unknown key %q", kid)
+	}
+	return gcmOpen(kek, wrapped, wrapAAD(kid))
+}
+
+func wrapAAD(kid string) []byte {
+	return append(append([]byte{}, envelopeMagic...), kid...)
+}
+
+func encodeEnvelope(kid string, wrapped, sealed []byte) []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(envelopeMagic) + 1 + len(kid) + 2 + len(wrapped) + len(sealed))
+	buf.Write(envelopeMagic)
+	buf.WriteByte(byte(len(kid)))
+	buf.WriteString(kid)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(len(wrapped)))
+	buf.Write(wrapped)
+	buf.Write(sealed)
+	return buf.Bytes()
+}
+
+func decodeEnvelope(data []byte) (kid string, wrapped, sealed []byte, ok bool) {
+	if !bytes.HasPrefix(data, envelopeMagic) {
+		return "", nil, nil, false
+	}
+	rest := data[len(envelopeMagic):]
+
+	if len(rest) < 1 {
+		return "", nil, nil, false
+	}
+	kidLen := int(rest[0])
+	rest = rest[1:]
+	if kidLen == 0 || len(rest) < kidLen+2 {
+		return "", nil, nil, false
+	}
+	kid = string(rest[:kidLen])
+	rest = rest[kidLen:]
+
+	wrappedLen := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if len(rest) < wrappedLen {
+		return "", nil, nil, false
+	}
+
+	return kid, rest[:wrappedLen], rest[wrappedLen:], true
+}
+
+// gcmSeal encrypts with AES-GCM and prepends the random nonce
+func gcmSeal(key, plaintext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// gcmOpen decrypts a nonce-prefixed AES-GCM ciphertext
+func gcmOpen(key, ciphertext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("invalid ciphertext")
+	}
+	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
+
+// legacyAESKey reproduces the pre-envelope key handling: zero-padded or truncated to 32 bytes
+func legacyAESKey(key string) []byte {
+	k := make([]byte, kekSize)
+	copy(k, key)
+	return k
+}
+
+// checkKeyStrength rejects keys of the wrong length or with too little entropy
+func checkKeyStrength(key []byte) error {
+	if len(key) != kekSize {
+		return fmt.Errorf("key must be exactly %d bytes, got %d", kekSize, len(key))
+	}
+	if bits := shannonEntropy(key); bits < minKeyEntropyBits {
+		return fmt.Errorf("key has too little entropy (%.2f bits/byte, need %.1f); generate it with a CSPRNG", bits, minKeyEntropyBits)
+	}
+	return nil
+}
+
+// shannonEntropy returns the Shannon entropy of data in bits per byte
+func shannonEntropy(data []byte) float64 {
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+
+	n := float64(len(data))
+	var h float64
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		h -= p * math.Log2(p)
+	}
+	return h
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+)
+
+// legacyTestKey is a raw legacy key with enough entropy to be the sole KEK
+const legacyTestKey = "abcdefghijklmnopqrstuvwxyzABCDEF"
+
+func randomKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, kekSize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("rand: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func newTestCipher(t *testing.T, cfg *conf.EncryptionConfig) *CredentialCipher {
+	t.Helper()
+	c, err := NewCredentialCipherFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewCredentialCipherFromConfig: %v", err)
+	}
+	return c
+}
+
+func TestCredentialCipher_EnvelopeRoundTrip(t *testing.T) {
+	c := newTestCipher(t, &conf.EncryptionConfig{
+		Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: randomKey(t)}},
+	})
+
+	ciphertext, err := c.Encrypt([]byte(`{"password":"secret"}`))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if c.KeyID(ciphertext) != "kek-1" {
+		t.Fatalf("KeyID = %q, want kek-1", c.KeyID(ciphertext))
+	}
+	if bytes.Contains(ciphertext, []byte("secret")) {
+		t.Fatal("ciphertext contains the plaintext")
+	}
+
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil || string(plaintext) != `{"password":"secret"}` {
+		t.Fatalf("Decrypt = %q, %v", plaintext, err)
+	}
+
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := c.Decrypt(tampered); err == nil {
+		t.Fatal("Decrypt accepted a tampered ciphertext")
+	}
+
+	if out, changed, err := c.Rewrap(ciphertext); err != nil || changed || !bytes.Equal(out, ciphertext) {
+		t.Fatalf("Rewrap under the active key: changed=%v, %v", changed, err)
+	}
+}
+
+func TestCredentialCipher_UnknownKeyID(t *testing.T) {
+	c1 := newTestCipher(t, &conf.EncryptionConfig{
+		Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: randomKey(t)}},
+	})
+	c2 := newTestCipher(t, &conf.EncryptionConfig{
+		Keys: []*conf.EncryptionKey{{Id: "kek-2", Key: randomKey(t)}},
+	})
+
+	ciphertext, err := c1.Encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if _, err := c2.Decrypt(ciphertext); err == nil || !strings.Contains(err.Error(), `unknown key "kek-1"`) {
+		t.Fatalf("Decrypt with unknown kid: %v", err)
+	}
+	if _, _, err := c2.Rewrap(ciphertext); err == nil {
+		t.Fatal("Rewrap succeeded without the record's key")
+	}
+}
+
+func TestCredentialCipher_LegacyRecords(t *testing.T) {
+	// A record written before envelope encryption
+	legacy, err := gcmSeal(legacyAESKey(legacyTestKey), []byte("secret"), nil)
+	if err != nil {
+		t.Fatalf("gcmSeal: %v", err)
+	}
+
+	c := newTestCipher(t, &conf.EncryptionConfig{
+		Key:  legacyTestKey,
+		Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: randomKey(t)}},
+	})
+	if c.KeyID(legacy) != "" {
+		t.Fatalf("KeyID of a legacy record = %q", c.KeyID(legacy))
+	}
+	if plaintext, err := c.Decrypt(legacy); err != nil || string(plaintext) != "secret" {
+		t.Fatalf("Decrypt legacy = %q, %v", plaintext, err)
+	}
+
+	out, changed, err := c.Rewrap(legacy)
+	if err != nil || !changed || c.KeyID(out) != "kek-1" {
+		t.Fatalf("Rewrap legacy: kid %q, changed=%v, %v", c.KeyID(out), changed, err)
+	}
+	if plaintext, err := c.Decrypt(out); err != nil || string(plaintext) != "secret" {
+		t.Fatalf("Decrypt rewrapped = %q, %v", plaintext, err)
+	}
+
+	// Without the legacy key the record cannot be read
+	noLegacy := newTestCipher(t, &conf.EncryptionConfig{
+		Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: randomKey(t)}},
+	})
+	if _, err := noLegacy.Decrypt(legacy); err == nil {
+		t.Fatal("Decrypt of a legacy record succeeded without the legacy key")
+	}
+}
+
+func TestCredentialCipher_MigrateFromLegacyKey(t *testing.T) {
+	// Started with only the legacy key: envelopes use its derived KEK
+	legacyOnly := newTestCipher(t, &conf.EncryptionConfig{Key: legacyTestKey})
+	if legacyOnly.ActiveKeyID() != defaultLegacyKeyID {
+		t.Fatalf("active key = %q, want %q", legacyOnly.ActiveKeyID(), defaultLegacyKeyID)
+	}
+	written, err := legacyOnly.Encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	// Keys added and made active, legacy key still configured
+	kek1 := randomKey(t)
+	migrated := newTestCipher(t, &conf.EncryptionConfig{
+		Key:         legacyTestKey,
+		Keys:        []*conf.EncryptionKey{{Id: "kek-1", Key: kek1}},
+		ActiveKeyId: "kek-1",
+	})
+	if plaintext, err := migrated.Decrypt(written); err != nil || string(plaintext) != "secret" {
+		t.Fatalf("Decrypt after adding keys = %q, %v", plaintext, err)
+	}
+
+	// Rotation re-wraps the record under the new key
+	rewrapped, changed, err := migrated.Rewrap(written)
+	if err != nil || !changed || migrated.KeyID(rewrapped) != "kek-1" {
+		t.Fatalf("Rewrap: kid %q, changed=%v, %v", migrated.KeyID(rewrapped), changed, err)
+	}
+
+	// The legacy key can then be removed
+	final := newTestCipher(t, &conf.EncryptionConfig{
+		Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: kek1}},
+	})
+	if plaintext, err := final.Decrypt(rewrapped); err != nil || string(plaintext) != "secret" {
+		t.Fatalf("Decrypt after removing the legacy key = %q, %v", plaintext, err)
+	}
+}
+
+func TestNewCredentialCipherFromConfig_RejectsWeakKeys(t *testing.T) {
+	for name, cfg := range map[string]*conf.EncryptionConfig{
+		"no key":            {},
+		"empty key":         {Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: ""}}},
+		"repeated bytes":    {Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, kekSize))}}},
+		"short legacy key":  {Key: "too-short"},
+		"unknown active id": {Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: randomKey(t)}}, ActiveKeyId: "kek-2"},
+		"unset env key":     {Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: "env:DEPLOYER_TEST_KEK_UNSET"}}},
+	} {
+		if _, err := NewCredentialCipherFromConfig(cfg); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+}
+
+func TestNewCredentialCipherFromConfig_ReadsKeysFromEnvironment(t *testing.T) {
+	key := randomKey(t)
+	t.Setenv("DEPLOYER_TEST_KEK", key)
+
+	fromEnv := newTestCipher(t, &conf.EncryptionConfig{
+		Keys:        []*conf.EncryptionKey{{Id: "kek-1", Key: "env:DEPLOYER_TEST_KEK"}},
+		ActiveKeyId: "kek-1",
+	})
+	literal := newTestCipher(t, &conf.EncryptionConfig{
+		Keys: []*conf.EncryptionKey{{Id: "kek-1", Key: key}},
+	})
+
+	ciphertext, err := fromEnv.Encrypt([]byte(`{"password":"secret"}`))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	plaintext, err := literal.Decrypt(ciphertext)
+	if err != nil || string(plaintext) != `{"password":"secret"}` {
+		t.Fatalf("Decrypt with the literal key: %q, %v", plaintext, err)
+	}
+}
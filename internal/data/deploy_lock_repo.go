@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploylock"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// DeployLockRepo hands out leases that serialize deployments across replicas
+type DeployLockRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewDeployLockRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *DeployLockRepo {
+	return &DeployLockRepo{
+		log:       ctx.NewLoggerHelper("deploy_lock/repo"),
+		entClient: entClient,
+	}
+}
+
+// TryAcquire takes all keys for holder until expiresAt. It takes none of them
+// and returns false when any key is held by an unexpired lease, including one
+// of the same holder.
+func (r *DeployLockRepo) TryAcquire(ctx context.Context, holder string, expiresAt time.Time, keys ...string) (bool, error) {
+	if len(keys) == 0 {
+		return true, nil
+	}
+
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("acquire deploy lock failed")
+	}
+
+	now := time.Now()
+	if _, err := tx.DeployLock.Delete().
+		Where(deploylock.IDIn(keys...), deploylock.ExpiresAtLTE(now)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("delete expired deploy locks failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("acquire deploy lock failed")
+	}
+
+	builders := make([]*ent.DeployLockCreate, 0, len(keys))
+	for _, key := range keys {
+		builders = append(builders, tx.DeployLock.Create().
+			SetID(key).
+			SetHolder(holder).
+			SetExpiresAt(expiresAt).
+			SetCreateTime(now))
+	}
+	if err := tx.DeployLock.CreateBulk(builders...).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		r.log.Errorf("acquire deploy locks %s failed: %s", strings.Join(keys, ","), err.Error())
+		return false, deployerV1.ErrorInternalServerError("acquire deploy lock failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit deploy locks failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("acquire deploy lock failed")
+	}
+	return true, nil
+}
+
+// Renew extends the unexpired lease of holder on key until expiresAt. It
+// returns false when holder does not hold key.
+func (r *DeployLockRepo) Renew(ctx context.Context, holder, key string, expiresAt time.Time) (bool, error) {
+	affected, err := r.entClient.Client().DeployLock.Update().
+		Where(
+			deploylock.IDEQ(key),
+			deploylock.HolderEQ(holder),
+			deploylock.ExpiresAtGT(time.Now()),
+		).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("renew deploy lock %s failed: %s", key, err.Error())
+		return false, deployerV1.ErrorInternalServerError("renew deploy lock failed")
+	}
+	return affected > 0, nil
+}
+
+// Release drops all leases held by holder
+func (r *DeployLockRepo) Release(ctx context.Context, holder string) error {
+	if _, err := r.entClient.Client().DeployLock.Delete().
+		Where(deploylock.HolderEQ(holder)).
+		Exec(ctx); err != nil {
+		r.log.Errorf("release deploy locks of %s failed: %s", holder, err.Error())
+		return deployerV1.ErrorInternalServerError("release deploy lock failed")
+	}
+	return nil
+}
+
+// HeldKeys lists the keys with the given prefix that are held by an unexpired
+// lease, without the prefix
+func (r *DeployLockRepo) HeldKeys(ctx context.Context, prefix string) ([]string, error) {
+	ids, err := r.entClient.Client().DeployLock.Query().
+		Where(
+			predicate.DeployLock(sql.FieldHasPrefix(deploylock.FieldID, prefix)),
+			deploylock.ExpiresAtGT(time.Now()),
+		).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("list deploy locks failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list deploy locks failed")
+	}
+	for i, id := range ids {
+		ids[i] = strings.TrimPrefix(id, prefix)
+	}
+	return ids, nil
+}
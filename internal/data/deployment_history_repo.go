@@ -123,6 +123,8 @@ func (r *DeploymentHistoryRepo) ToProto(entity *ent.DeploymentHistory) *deployer
 		actionStr = "verify"
 	case deploymenthistory.ActionACTION_ROLLBACK:
 		actionStr = "rollback"
+	case deploymenthistory.ActionACTION_REMOVE:
+		actionStr = "remove"
 	}
 	proto.Action = &actionStr
 
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
@@ -31,8 +34,8 @@ func NewDeploymentJobRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*
 }
 
 // CreateParentJob creates a new parent job for deploying to a target group
-func (r *DeploymentJobRepo) CreateParentJob(ctx context.Context, tenantID uint32, deploymentTargetID, certificateID, certificateSerial string,
-	triggeredBy deploymentjob.TriggeredBy, maxRetries int32) (*ent.DeploymentJob, error) {
+func (r *DeploymentJobRepo) CreateParentJob(ctx context.Context, tenantID uint32, deploymentTargetID, certificateID, certificateSerial, idempotencyKey string,
+	triggeredBy deploymentjob.TriggeredBy, policy *registry.RetryPolicy) (*ent.DeploymentJob, error) {
 
 	id := uuid.New().String()
 
@@ -43,7 +46,8 @@ func (r *DeploymentJobRepo) CreateParentJob(ctx context.Context, tenantID uint32
 		SetCertificateID(certificateID).
 		SetStatus(deploymentjob.StatusJOB_STATUS_PENDING).
 		SetTriggeredBy(triggeredBy).
-		SetMaxRetries(maxRetries).
+		SetMaxRetries(policy.Retries()).
+		SetRetryPolicy(policy).
 		SetProgress(0).
 		SetRetryCount(0).
 		SetCreateTime(time.Now())
@@ -51,9 +55,15 @@ func (r *DeploymentJobRepo) CreateParentJob(ctx context.Context, tenantID uint32
 	if certificateSerial != "" {
 		builder.SetCertificateSerial(certificateSerial)
 	}
+	if idempotencyKey != "" {
+		builder.SetIdempotencyKey(idempotencyKey)
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) && idempotencyKey != "" {
+			return nil, deployerV1.ErrorConflict("a job with this idempotency key is being created")
+		}
 		r.log.Errorf("create parent job failed: %s", err.Error())
 		return nil, deployerV1.ErrorInternalServerError("create parent job failed")
 	}
@@ -63,7 +73,7 @@ func (r *DeploymentJobRepo) CreateParentJob(ctx context.Context, tenantID uint32
 
 // CreateChildJob creates a child job for a parent job
 func (r *DeploymentJobRepo) CreateChildJob(ctx context.Context, tenantID uint32, parentJobID, targetConfigurationID, certificateID, certificateSerial string,
-	triggeredBy deploymentjob.TriggeredBy, maxRetries int32) (*ent.DeploymentJob, error) {
+	triggeredBy deploymentjob.TriggeredBy, policy *registry.RetryPolicy) (*ent.DeploymentJob, error) {
 
 	id := uuid.New().String()
 
@@ -75,7 +85,8 @@ func (r *DeploymentJobRepo) CreateChildJob(ctx context.Context, tenantID uint32,
 		SetCertificateID(certificateID).
 		SetStatus(deploymentjob.StatusJOB_STATUS_PENDING).
 		SetTriggeredBy(triggeredBy).
-		SetMaxRetries(maxRetries).
+		SetMaxRetries(policy.Retries()).
+		SetRetryPolicy(policy).
 		SetProgress(0).
 		SetRetryCount(0).
 		SetCreateTime(time.Now())
@@ -94,8 +105,8 @@ func (r *DeploymentJobRepo) CreateChildJob(ctx context.Context, tenantID uint32,
 }
 
 // CreateDirectJob creates a direct job to a single target configuration (legacy/manual)
-func (r *DeploymentJobRepo) CreateDirectJob(ctx context.Context, tenantID uint32, targetConfigurationID, certificateID, certificateSerial string,
-	triggeredBy deploymentjob.TriggeredBy, maxRetries int32) (*ent.DeploymentJob, error) {
+func (r *DeploymentJobRepo) CreateDirectJob(ctx context.Context, tenantID uint32, targetConfigurationID, certificateID, certificateSerial, idempotencyKey string,
+	triggeredBy deploymentjob.TriggeredBy, policy *registry.RetryPolicy) (*ent.DeploymentJob, error) {
 
 	id := uuid.New().String()
 
@@ -106,7 +117,8 @@ func (r *DeploymentJobRepo) CreateDirectJob(ctx context.Context, tenantID uint32
 		SetCertificateID(certificateID).
 		SetStatus(deploymentjob.StatusJOB_STATUS_PENDING).
 		SetTriggeredBy(triggeredBy).
-		SetMaxRetries(maxRetries).
+		SetMaxRetries(policy.Retries()).
+		SetRetryPolicy(policy).
 		SetProgress(0).
 		SetRetryCount(0).
 		SetCreateTime(time.Now())
@@ -114,9 +126,15 @@ func (r *DeploymentJobRepo) CreateDirectJob(ctx context.Context, tenantID uint32
 	if certificateSerial != "" {
 		builder.SetCertificateSerial(certificateSerial)
 	}
+	if idempotencyKey != "" {
+		builder.SetIdempotencyKey(idempotencyKey)
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) && idempotencyKey != "" {
+			return nil, deployerV1.ErrorConflict("a job with this idempotency key is being created")
+		}
 		r.log.Errorf("create direct job failed: %s", err.Error())
 		return nil, deployerV1.ErrorInternalServerError("create direct job failed")
 	}
@@ -142,6 +160,200 @@ func (r *DeploymentJobRepo) GetByID(ctx context.Context, id string) (*ent.Deploy
 	return entity, nil
 }
 
+// GetByIdempotencyKey retrieves the parent or direct job created with an idempotency key
+func (r *DeploymentJobRepo) GetByIdempotencyKey(ctx context.Context, tenantID uint32, key string) (*ent.DeploymentJob, error) {
+	entity, err := r.entClient.Client().DeploymentJob.Query().
+		Where(
+			deploymentjob.TenantIDEQ(tenantID),
+			deploymentjob.IdempotencyKeyEQ(key),
+		).
+		WithDeploymentTarget().
+		WithTargetConfiguration().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query job by idempotency key failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query job failed")
+	}
+	return entity, nil
+}
+
+// CreateRemovalJob creates a direct job removing a certificate from a target
+// configuration
+func (r *DeploymentJobRepo) CreateRemovalJob(ctx context.Context, tenantID uint32, targetConfigurationID, certificateID, certificateSerial, commonName, idempotencyKey string,
+	triggeredBy deploymentjob.TriggeredBy, policy *registry.RetryPolicy) (*ent.DeploymentJob, error) {
+
+	builder := r.entClient.Client().DeploymentJob.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetTargetConfigurationID(targetConfigurationID).
+		SetCertificateID(certificateID).
+		SetCertificateSerial(certificateSerial).
+		SetCertificateCommonName(commonName).
+		SetAction(deploymentjob.ActionJOB_ACTION_REMOVE).
+		SetStatus(deploymentjob.StatusJOB_STATUS_PENDING).
+		SetTriggeredBy(triggeredBy).
+		SetMaxRetries(policy.Retries()).
+		SetRetryPolicy(policy).
+		SetCreateTime(time.Now())
+
+	if idempotencyKey != "" {
+		builder.SetIdempotencyKey(idempotencyKey)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) && idempotencyKey != "" {
+			return nil, deployerV1.ErrorConflict("a job with this idempotency key is being created")
+		}
+		r.log.Errorf("create removal job failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create removal job failed")
+	}
+
+	return entity, nil
+}
+
+// LastCompleted returns the most recently completed job of a configuration, or
+// nil when nothing was deployed to it successfully or the certificate was
+// removed since
+func (r *DeploymentJobRepo) LastCompleted(ctx context.Context, configID string) (*ent.DeploymentJob, error) {
+	entity, err := r.entClient.Client().DeploymentJob.Query().
+		Where(
+			deploymentjob.TargetConfigurationIDEQ(configID),
+			deploymentjob.StatusEQ(deploymentjob.StatusJOB_STATUS_COMPLETED),
+		).
+		Order(ent.Desc(deploymentjob.FieldCompletedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query last completed job failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query job failed")
+	}
+	if entity.Action == deploymentjob.ActionJOB_ACTION_REMOVE {
+		return nil, nil
+	}
+	return entity, nil
+}
+
+// ListServing returns the last completed job of each configuration of a tenant
+// currently serving a certificate, with the configuration and its deployment
+// targets loaded. The certificate is matched by serial, or by ID when serial
+// is empty.
+func (r *DeploymentJobRepo) ListServing(ctx context.Context, tenantID uint32, certificateID, serial string) ([]*ent.DeploymentJob, error) {
+	match := deploymentjob.CertificateIDEQ(certificateID)
+	if serial != "" {
+		match = deploymentjob.CertificateSerialEQ(serial)
+	}
+	configIDs, err := r.entClient.Client().DeploymentJob.Query().
+		Where(
+			deploymentjob.TenantIDEQ(tenantID),
+			deploymentjob.TargetConfigurationIDNotNil(),
+			deploymentjob.StatusEQ(deploymentjob.StatusJOB_STATUS_COMPLETED),
+			deploymentjob.ActionEQ(deploymentjob.ActionJOB_ACTION_DEPLOY),
+			match,
+		).
+		Unique(true).
+		Select(deploymentjob.FieldTargetConfigurationID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("query configurations serving certificate failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query jobs failed")
+	}
+
+	var serving []*ent.DeploymentJob
+	for _, configID := range configIDs {
+		last, err := r.LastCompleted(ctx, configID)
+		if err != nil {
+			return nil, err
+		}
+		if last == nil || (serial != "" && last.CertificateSerial != serial) || (serial == "" && last.CertificateID != certificateID) {
+			continue
+		}
+		last, err = r.entClient.Client().DeploymentJob.Query().
+			Where(deploymentjob.IDEQ(last.ID)).
+			WithTargetConfiguration(func(q *ent.TargetConfigurationQuery) {
+				q.WithDeploymentTargets()
+			}).
+			Only(ctx)
+		if err != nil {
+			r.log.Errorf("query job configuration failed: %s", err.Error())
+			return nil, deployerV1.ErrorInternalServerError("query job failed")
+		}
+		serving = append(serving, last)
+	}
+	return serving, nil
+}
+
+// ListDeployed returns the completed deploy jobs of a configuration, newest
+// first
+func (r *DeploymentJobRepo) ListDeployed(ctx context.Context, configID string) ([]*ent.DeploymentJob, error) {
+	entities, err := r.entClient.Client().DeploymentJob.Query().
+		Where(
+			deploymentjob.TargetConfigurationIDEQ(configID),
+			deploymentjob.StatusEQ(deploymentjob.StatusJOB_STATUS_COMPLETED),
+			deploymentjob.ActionEQ(deploymentjob.ActionJOB_ACTION_DEPLOY),
+		).
+		Order(ent.Desc(deploymentjob.FieldCompletedAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query deployed jobs failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query jobs failed")
+	}
+	return entities, nil
+}
+
+// RecentCertificateIDs returns the IDs of the certificates a tenant deployed
+// most recently, newest first
+func (r *DeploymentJobRepo) RecentCertificateIDs(ctx context.Context, tenantID uint32, limit int) ([]string, error) {
+	jobs, err := r.entClient.Client().DeploymentJob.Query().
+		Where(
+			deploymentjob.TenantIDEQ(tenantID),
+			deploymentjob.ActionEQ(deploymentjob.ActionJOB_ACTION_DEPLOY),
+		).
+		Order(ent.Desc(deploymentjob.FieldCreateTime)).
+		Select(deploymentjob.FieldCertificateID).
+		Limit(limit * 10).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query recent certificates failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query jobs failed")
+	}
+
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, job := range jobs {
+		if _, ok := seen[job.CertificateID]; ok {
+			continue
+		}
+		seen[job.CertificateID] = struct{}{}
+		ids = append(ids, job.CertificateID)
+		if len(ids) == limit {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// JobTargetID returns the deployment target of a job (the parent's target for
+// child jobs), or "" when the job does not exist or belongs to no target
+func (r *DeploymentJobRepo) JobTargetID(ctx context.Context, id string) (string, error) {
+	entity, err := r.GetByID(ctx, id)
+	if err != nil || entity == nil {
+		return "", err
+	}
+	if entity.DeploymentTargetID != nil {
+		return *entity.DeploymentTargetID, nil
+	}
+	if parent := entity.Edges.ParentJob; parent != nil && parent.DeploymentTargetID != nil {
+		return *parent.DeploymentTargetID, nil
+	}
+	return "", nil
+}
+
 // GetByIDWithChildJobs retrieves a deployment job by ID with child jobs
 func (r *DeploymentJobRepo) GetByIDWithChildJobs(ctx context.Context, id string) (*ent.DeploymentJob, error) {
 	entity, err := r.entClient.Client().DeploymentJob.Query().
@@ -247,12 +459,13 @@ func (r *DeploymentJobRepo) List(ctx context.Context, tenantID *uint32, deployme
 }
 
 // ListPending lists pending jobs ordered by creation time (excludes parent jobs)
-func (r *DeploymentJobRepo) ListPending(ctx context.Context, limit int) ([]*ent.DeploymentJob, error) {
+func (r *DeploymentJobRepo) ListPending(ctx context.Context, limit int, excludeConfigIDs []string) ([]*ent.DeploymentJob, error) {
 	entities, err := r.entClient.Client().DeploymentJob.Query().
 		Where(
 			deploymentjob.StatusEQ(deploymentjob.StatusJOB_STATUS_PENDING),
 			// Only child/direct jobs - parent jobs don't execute directly
 			deploymentjob.TargetConfigurationIDNotNil(),
+			deploymentjob.TargetConfigurationIDNotIn(excludeConfigIDs...),
 		).
 		Order(ent.Asc(deploymentjob.FieldCreateTime)).
 		Limit(limit).
@@ -266,7 +479,7 @@ func (r *DeploymentJobRepo) ListPending(ctx context.Context, limit int) ([]*ent.
 }
 
 // ListRetryable lists jobs that are due for retry
-func (r *DeploymentJobRepo) ListRetryable(ctx context.Context, limit int) ([]*ent.DeploymentJob, error) {
+func (r *DeploymentJobRepo) ListRetryable(ctx context.Context, limit int, excludeConfigIDs []string) ([]*ent.DeploymentJob, error) {
 	now := time.Now()
 	entities, err := r.entClient.Client().DeploymentJob.Query().
 		Where(
@@ -274,6 +487,7 @@ func (r *DeploymentJobRepo) ListRetryable(ctx context.Context, limit int) ([]*en
 			deploymentjob.NextRetryAtLTE(now),
 			// Only child/direct jobs
 			deploymentjob.TargetConfigurationIDNotNil(),
+			deploymentjob.TargetConfigurationIDNotIn(excludeConfigIDs...),
 		).
 		Order(ent.Asc(deploymentjob.FieldNextRetryAt)).
 		Limit(limit).
@@ -300,7 +514,8 @@ func (r *DeploymentJobRepo) ListChildJobs(ctx context.Context, parentJobID strin
 	return entities, nil
 }
 
-// GetChildJobCounts returns the count of total, completed, and failed child jobs
+// GetChildJobCounts returns the count of total, completed, and failed child jobs,
+// ignoring superseded ones
 func (r *DeploymentJobRepo) GetChildJobCounts(ctx context.Context, parentJobID string) (total, completed, failed int, err error) {
 	childJobs, err := r.ListChildJobs(ctx, parentJobID)
 	if err != nil {
@@ -308,6 +523,10 @@ func (r *DeploymentJobRepo) GetChildJobCounts(ctx context.Context, parentJobID s
 	}
 
 	for _, job := range childJobs {
+		// Superseded children were replaced by a job of another parent
+		if job.SupersededByJobID != nil {
+			continue
+		}
 		total++
 		switch job.Status {
 		case deploymentjob.StatusJOB_STATUS_COMPLETED:
@@ -333,6 +552,31 @@ func (r *DeploymentJobRepo) UpdateCertificateSerial(ctx context.Context, id stri
 	return entity, nil
 }
 
+// SetCertificateCommonName records the common name of the certificate of a job
+func (r *DeploymentJobRepo) SetCertificateCommonName(ctx context.Context, id string, commonName string) error {
+	err := r.entClient.Client().DeploymentJob.UpdateOneID(id).
+		SetCertificateCommonName(commonName).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set job certificate common name failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("update job failed")
+	}
+	return nil
+}
+
+// SetConfigurationRevision records the configuration revision a job executes with
+func (r *DeploymentJobRepo) SetConfigurationRevision(ctx context.Context, id string, revisionID uint32) error {
+	err := r.entClient.Client().DeploymentJob.UpdateOneID(id).
+		SetConfigurationRevisionID(revisionID).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set job configuration revision failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("set job configuration revision failed")
+	}
+	return nil
+}
+
 // UpdateStatus updates the status of a deployment job
 func (r *DeploymentJobRepo) UpdateStatus(ctx context.Context, id string, status deploymentjob.Status, message string, progress int32) (*ent.DeploymentJob, error) {
 	builder := r.entClient.Client().DeploymentJob.UpdateOneID(id).
@@ -351,7 +595,9 @@ func (r *DeploymentJobRepo) UpdateStatus(ctx context.Context, id string, status
 	switch status {
 	case deploymentjob.StatusJOB_STATUS_PROCESSING:
 		builder.SetStartedAt(now)
-	case deploymentjob.StatusJOB_STATUS_COMPLETED, deploymentjob.StatusJOB_STATUS_FAILED, deploymentjob.StatusJOB_STATUS_CANCELLED, deploymentjob.StatusJOB_STATUS_PARTIAL:
+	case deploymentjob.StatusJOB_STATUS_COMPLETED:
+		builder.SetCompletedAt(now).ClearErrorCategory()
+	case deploymentjob.StatusJOB_STATUS_FAILED, deploymentjob.StatusJOB_STATUS_CANCELLED, deploymentjob.StatusJOB_STATUS_PARTIAL:
 		builder.SetCompletedAt(now)
 	}
 
@@ -376,10 +622,30 @@ func (r *DeploymentJobRepo) SetResult(ctx context.Context, id string, result map
 	return nil
 }
 
-// MarkForRetry marks a job for retry
-func (r *DeploymentJobRepo) MarkForRetry(ctx context.Context, id string, nextRetryAt time.Time) (*ent.DeploymentJob, error) {
+// MarkFailed marks a job as failed with the category of its failure
+func (r *DeploymentJobRepo) MarkFailed(ctx context.Context, id string, message string, category registry.ErrorCategory) (*ent.DeploymentJob, error) {
+	now := time.Now()
+	entity, err := r.entClient.Client().DeploymentJob.UpdateOneID(id).
+		SetStatus(deploymentjob.StatusJOB_STATUS_FAILED).
+		SetStatusMessage(message).
+		SetProgress(0).
+		SetErrorCategory(JobErrorCategory(category)).
+		SetCompletedAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark job failed failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("mark job failed failed")
+	}
+	return entity, nil
+}
+
+// MarkForRetry marks a job for retry after a failure of the given category
+func (r *DeploymentJobRepo) MarkForRetry(ctx context.Context, id string, nextRetryAt time.Time, message string, category registry.ErrorCategory) (*ent.DeploymentJob, error) {
 	entity, err := r.entClient.Client().DeploymentJob.UpdateOneID(id).
 		SetStatus(deploymentjob.StatusJOB_STATUS_RETRYING).
+		SetStatusMessage(message).
+		SetErrorCategory(JobErrorCategory(category)).
 		SetNextRetryAt(nextRetryAt).
 		AddRetryCount(1).
 		SetUpdateTime(time.Now()).
@@ -412,6 +678,223 @@ func (r *DeploymentJobRepo) ClaimJob(ctx context.Context, id string, expectedSta
 	return affected > 0, nil
 }
 
+// JobStatusChange is a status transition made as a side effect of another operation
+type JobStatusChange struct {
+	JobID    string
+	From, To deploymentjob.Status
+}
+
+// SupersedeQueued records the certificate lineage of a new child or direct job
+// and cancels the older pending and retrying jobs that deploy the same or an
+// older certificate of the same lineage to the same configuration, so a queued
+// older serial never overwrites a newer one. Certificates are ordered by when
+// they joined the lineage: redeploying an older certificate leaves the queued
+// jobs of its renewals alone. previousCertificateID links a renewed
+// certificate to the one it replaces. Parents left without active children
+// are refreshed.
+func (r *DeploymentJobRepo) SupersedeQueued(ctx context.Context, job *ent.DeploymentJob, previousCertificateID string) ([]JobStatusChange, error) {
+	if job.TargetConfigurationID == nil {
+		return nil, nil
+	}
+	client := r.entClient.Client()
+
+	lineage, err := r.certificateLineage(ctx, job.ID, job.CertificateID, previousCertificateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.DeploymentJob.UpdateOneID(job.ID).
+		SetCertificateLineage(lineage).
+		Exec(ctx); err != nil {
+		r.log.Errorf("set certificate lineage failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("set certificate lineage failed")
+	}
+
+	// Jobs created before lineages were recorded only match by certificate
+	sameLineage := []predicate.DeploymentJob{
+		deploymentjob.CertificateLineageEQ(lineage),
+		deploymentjob.CertificateIDEQ(job.CertificateID),
+	}
+	if previousCertificateID != "" {
+		sameLineage = append(sameLineage, deploymentjob.CertificateIDEQ(previousCertificateID))
+	}
+	where := []predicate.DeploymentJob{
+		deploymentjob.IDNEQ(job.ID),
+		deploymentjob.TargetConfigurationIDEQ(*job.TargetConfigurationID),
+		deploymentjob.StatusIn(deploymentjob.StatusJOB_STATUS_PENDING, deploymentjob.StatusJOB_STATUS_RETRYING),
+		deploymentjob.Or(sameLineage...),
+	}
+	if job.CreateTime != nil {
+		where = append(where, deploymentjob.CreateTimeLTE(*job.CreateTime))
+	}
+	queued, err := client.DeploymentJob.Query().Where(where...).All(ctx)
+	if err != nil {
+		r.log.Errorf("query queued jobs failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("query queued jobs failed")
+	}
+
+	var changes []JobStatusChange
+	parents := make(map[string]struct{})
+	now := time.Now()
+	joined := make(map[string]time.Time)
+	for _, old := range queued {
+		if old.CertificateID != job.CertificateID && old.CertificateID != previousCertificateID {
+			newer, err := r.joinedLater(ctx, joined, old.CertificateID, job.CertificateID)
+			if err != nil {
+				return changes, err
+			}
+			if newer {
+				continue
+			}
+		}
+
+		// Only supersede jobs no worker claimed in the meantime
+		affected, err := client.DeploymentJob.Update().
+			Where(deploymentjob.IDEQ(old.ID), deploymentjob.StatusEQ(old.Status)).
+			SetStatus(deploymentjob.StatusJOB_STATUS_CANCELLED).
+			SetStatusMessage("Superseded by job " + job.ID).
+			SetSupersededByJobID(job.ID).
+			ClearNextRetryAt().
+			SetCompletedAt(now).
+			SetUpdateTime(now).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("supersede job %s failed: %s", old.ID, err.Error())
+			return changes, deployerV1.ErrorInternalServerError("supersede job failed")
+		}
+		if affected == 0 {
+			continue
+		}
+		changes = append(changes, JobStatusChange{JobID: old.ID, From: old.Status, To: deploymentjob.StatusJOB_STATUS_CANCELLED})
+		if old.ParentJobID != nil {
+			parents[*old.ParentJobID] = struct{}{}
+		}
+	}
+
+	for parentID := range parents {
+		change, err := r.RefreshParentStatus(ctx, parentID)
+		if err != nil {
+			return changes, err
+		}
+		if change.From != change.To {
+			changes = append(changes, *change)
+		}
+	}
+	return changes, nil
+}
+
+// joinedLater reports whether certificateID was first deployed after than,
+// i.e. is the newer certificate of their lineage. joined caches the times.
+func (r *DeploymentJobRepo) joinedLater(ctx context.Context, joined map[string]time.Time, certificateID, than string) (bool, error) {
+	first := func(id string) (time.Time, error) {
+		if t, ok := joined[id]; ok {
+			return t, nil
+		}
+		earliest, err := r.entClient.Client().DeploymentJob.Query().
+			Where(deploymentjob.CertificateIDEQ(id)).
+			Order(ent.Asc(deploymentjob.FieldCreateTime)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			r.log.Errorf("query first job of certificate failed: %s", err.Error())
+			return time.Time{}, deployerV1.ErrorInternalServerError("query first job of certificate failed")
+		}
+		var t time.Time
+		if earliest != nil && earliest.CreateTime != nil {
+			t = *earliest.CreateTime
+		}
+		joined[id] = t
+		return t, nil
+	}
+
+	certificate, err := first(certificateID)
+	if err != nil {
+		return false, err
+	}
+	other, err := first(than)
+	if err != nil {
+		return false, err
+	}
+	return certificate.After(other), nil
+}
+
+// certificateLineage returns the lineage a job for certificateID joins: that of
+// the latest other job for the certificate it renews, or for the certificate
+// itself, falling back to the oldest known certificate ID
+func (r *DeploymentJobRepo) certificateLineage(ctx context.Context, jobID, certificateID, previousCertificateID string) (string, error) {
+	root := certificateID
+	if previousCertificateID != "" {
+		root = previousCertificateID
+	}
+	known, err := r.entClient.Client().DeploymentJob.Query().
+		Where(
+			deploymentjob.IDNEQ(jobID),
+			deploymentjob.CertificateIDEQ(root),
+			deploymentjob.CertificateLineageNEQ(""),
+		).
+		Order(ent.Desc(deploymentjob.FieldCreateTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return root, nil
+		}
+		r.log.Errorf("query certificate lineage failed: %s", err.Error())
+		return "", deployerV1.ErrorInternalServerError("query certificate lineage failed")
+	}
+	return known.CertificateLineage, nil
+}
+
+// RefreshParentStatus derives the status of a parent job from its child jobs
+func (r *DeploymentJobRepo) RefreshParentStatus(ctx context.Context, parentJobID string) (*JobStatusChange, error) {
+	parentJob, err := r.GetByID(ctx, parentJobID)
+	if err != nil {
+		return nil, err
+	}
+	if parentJob == nil {
+		return nil, deployerV1.ErrorJobNotFound("parent job not found")
+	}
+
+	total, completed, failed, err := r.GetChildJobCounts(ctx, parentJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status deploymentjob.Status
+	var message string
+	var progress int32
+
+	finishedCount := completed + failed
+
+	if total == 0 {
+		// Every child was replaced by a newer deployment
+		status = deploymentjob.StatusJOB_STATUS_CANCELLED
+		message = "All deployments superseded"
+	} else if finishedCount < total {
+		// Still processing
+		status = deploymentjob.StatusJOB_STATUS_PROCESSING
+		progress = int32((finishedCount * 100) / total)
+		message = "Deploying to targets"
+	} else if failed == 0 {
+		// All completed successfully
+		status = deploymentjob.StatusJOB_STATUS_COMPLETED
+		progress = 100
+		message = "All deployments completed successfully"
+	} else if completed == 0 {
+		// All failed
+		status = deploymentjob.StatusJOB_STATUS_FAILED
+		progress = 0
+		message = "All deployments failed"
+	} else {
+		// Partial success
+		status = deploymentjob.StatusJOB_STATUS_PARTIAL
+		progress = int32((completed * 100) / total)
+		message = "Some deployments failed"
+	}
+
+	if _, err := r.UpdateStatus(ctx, parentJobID, status, message, progress); err != nil {
+		return nil, err
+	}
+	return &JobStatusChange{JobID: parentJobID, From: parentJob.Status, To: status}, nil
+}
+
 // Cancel cancels a pending or processing job
 func (r *DeploymentJobRepo) Cancel(ctx context.Context, id string, cancelChildJobs bool) (*ent.DeploymentJob, error) {
 	job, err := r.GetByID(ctx, id)
@@ -570,6 +1053,12 @@ func (r *DeploymentJobRepo) ToProto(entity *ent.DeploymentJob) *deployerV1.Deplo
 	case deploymentjob.TriggeredByTRIGGER_TYPE_AUTO_RENEWAL:
 		t := deployerV1.TriggerType_TRIGGER_TYPE_AUTO_RENEWAL
 		proto.TriggeredBy = &t
+	case deploymentjob.TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION:
+		t := deployerV1.TriggerType_TRIGGER_TYPE_DRIFT_REMEDIATION
+		proto.TriggeredBy = &t
+	case deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION:
+		t := deployerV1.TriggerType_TRIGGER_TYPE_REVOCATION
+		proto.TriggeredBy = &t
 	default:
 		t := deployerV1.TriggerType_TRIGGER_TYPE_UNSPECIFIED
 		proto.TriggeredBy = &t
@@ -612,6 +1101,20 @@ func (r *DeploymentJobRepo) ToProto(entity *ent.DeploymentJob) *deployerV1.Deplo
 	if entity.NextRetryAt != nil {
 		proto.NextRetryAt = timestamppb.New(*entity.NextRetryAt)
 	}
+	if entity.ConfigurationRevisionID != nil {
+		proto.ConfigurationRevisionId = entity.ConfigurationRevisionID
+	}
+	if entity.ErrorCategory != nil {
+		c := deployerV1.ErrorCategory(deployerV1.ErrorCategory_value[string(*entity.ErrorCategory)])
+		proto.ErrorCategory = &c
+	}
+	proto.RetryPolicy = RetryPolicyToProto(entity.RetryPolicy)
+	proto.IdempotencyKey = entity.IdempotencyKey
+	proto.SupersededByJobId = entity.SupersededByJobID
+	if entity.TargetConfigurationID != nil {
+		a := deployerV1.JobAction(deployerV1.JobAction_value[string(entity.Action)])
+		proto.Action = &a
+	}
 	if entity.CreateBy != nil {
 		proto.CreatedBy = entity.CreateBy
 	}
@@ -624,3 +1127,16 @@ func (r *DeploymentJobRepo) ToProto(entity *ent.DeploymentJob) *deployerV1.Deplo
 
 	return proto
 }
+
+// JobErrorCategory maps a provider error category to the stored job value
+func JobErrorCategory(category registry.ErrorCategory) deploymentjob.ErrorCategory {
+	if category == "" {
+		category = registry.CategoryUnknown
+	}
+	return deploymentjob.ErrorCategory("ERROR_CATEGORY_" + strings.ToUpper(string(category)))
+}
+
+// ErrorCategoryName maps a stored job error category back to its provider name (e.g. "auth")
+func ErrorCategoryName(category deploymentjob.ErrorCategory) string {
+	return strings.ToLower(strings.TrimPrefix(string(category), "ERROR_CATEGORY_"))
+}
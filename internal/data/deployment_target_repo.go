@@ -14,6 +14,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
@@ -30,9 +31,11 @@ func NewDeploymentTargetRepo(ctx *bootstrap.Context, entClient *entCrud.EntClien
 	}
 }
 
-// Create creates a new deployment target (group)
+// Create creates a new deployment target (group). An empty revocation policy
+// keeps the default.
 func (r *DeploymentTargetRepo) Create(ctx context.Context, tenantID uint32, name, description string,
-	autoDeployOnRenewal bool, filters []schema.CertificateFilter, configIDs []string) (*ent.DeploymentTarget, error) {
+	autoDeployOnRenewal bool, revocationPolicy deploymenttarget.RevocationPolicy, filters []schema.CertificateFilter,
+	retryPolicy *registry.RetryPolicy, configIDs []string) (*ent.DeploymentTarget, error) {
 
 	id := uuid.New().String()
 
@@ -46,9 +49,15 @@ func (r *DeploymentTargetRepo) Create(ctx context.Context, tenantID uint32, name
 	if description != "" {
 		builder.SetDescription(description)
 	}
+	if revocationPolicy != "" {
+		builder.SetRevocationPolicy(revocationPolicy)
+	}
 	if filters != nil {
 		builder.SetCertificateFilters(filters)
 	}
+	if !retryPolicy.IsZero() {
+		builder.SetRetryPolicy(retryPolicy)
+	}
 
 	// Link configurations if provided
 	if len(configIDs) > 0 {
@@ -164,9 +173,10 @@ func (r *DeploymentTargetRepo) ListByAutoDeployEnabled(ctx context.Context) ([]*
 	return entities, nil
 }
 
-// Update updates a deployment target
+// Update updates a deployment target. An empty retry policy removes the override.
 func (r *DeploymentTargetRepo) Update(ctx context.Context, id string, name, description *string,
-	autoDeployOnRenewal *bool, filters []schema.CertificateFilter) (*ent.DeploymentTarget, error) {
+	autoDeployOnRenewal *bool, revocationPolicy *deploymenttarget.RevocationPolicy, filters []schema.CertificateFilter,
+	retryPolicy *registry.RetryPolicy) (*ent.DeploymentTarget, error) {
 
 	builder := r.entClient.Client().DeploymentTarget.UpdateOneID(id).
 		SetUpdateTime(time.Now())
@@ -180,9 +190,19 @@ func (r *DeploymentTargetRepo) Update(ctx context.Context, id string, name, desc
 	if autoDeployOnRenewal != nil {
 		builder.SetAutoDeployOnRenewal(*autoDeployOnRenewal)
 	}
+	if revocationPolicy != nil {
+		builder.SetRevocationPolicy(*revocationPolicy)
+	}
 	if filters != nil {
 		builder.SetCertificateFilters(filters)
 	}
+	if retryPolicy != nil {
+		if retryPolicy.IsZero() {
+			builder.ClearRetryPolicy()
+		} else {
+			builder.SetRetryPolicy(retryPolicy)
+		}
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
@@ -300,6 +320,9 @@ func (r *DeploymentTargetRepo) ToProto(entity *ent.DeploymentTarget, configRepo
 		AutoDeployOnRenewal: &entity.AutoDeployOnRenewal,
 	}
 
+	revocationPolicy := deployerV1.RevocationPolicy(deployerV1.RevocationPolicy_value[string(entity.RevocationPolicy)])
+	proto.RevocationPolicy = &revocationPolicy
+
 	if entity.Description != "" {
 		proto.Description = &entity.Description
 	}
@@ -335,6 +358,7 @@ func (r *DeploymentTargetRepo) ToProto(entity *ent.DeploymentTarget, configRepo
 			proto.CertificateFilters = append(proto.CertificateFilters, filter)
 		}
 	}
+	proto.RetryPolicy = RetryPolicyToProto(entity.RetryPolicy)
 
 	// Include configurations if loaded
 	if entity.Edges.Configurations != nil {
@@ -363,3 +387,21 @@ func (r *DeploymentTargetRepo) ToProto(entity *ent.DeploymentTarget, configRepo
 
 	return proto
 }
+
+// RevocationPolicyFromProto maps a requested revocation policy to the stored
+// value; nil and unspecified map to nil
+func RevocationPolicyFromProto(policy *deployerV1.RevocationPolicy) *deploymenttarget.RevocationPolicy {
+	if policy == nil {
+		return nil
+	}
+	var p deploymenttarget.RevocationPolicy
+	switch *policy {
+	case deployerV1.RevocationPolicy_REVOCATION_POLICY_REDEPLOY:
+		p = deploymenttarget.RevocationPolicyREVOCATION_POLICY_REDEPLOY
+	case deployerV1.RevocationPolicy_REVOCATION_POLICY_REMOVE:
+		p = deploymenttarget.RevocationPolicyREVOCATION_POLICY_REMOVE
+	default:
+		return nil
+	}
+	return &p
+}
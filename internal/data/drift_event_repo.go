@@ -0,0 +1,210 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+type DriftEventRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewDriftEventRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *DriftEventRepo {
+	return &DriftEventRepo{
+		log:       ctx.NewLoggerHelper("drift_event/repo"),
+		entClient: entClient,
+	}
+}
+
+// DriftEventInput describes a drift found on a device
+type DriftEventInput struct {
+	TenantID              uint32
+	TargetConfigurationID string
+	JobID                 string
+	CertificateID         string
+	CertificateSerial     string
+	Source                driftevent.Source
+	Message               string
+	ObservedSerial        string
+	Details               map[string]any
+}
+
+// Create stores a drift event
+func (r *DriftEventRepo) Create(ctx context.Context, in *DriftEventInput) (*ent.DriftEvent, error) {
+	builder := r.entClient.Client().DriftEvent.Create().
+		SetTenantID(in.TenantID).
+		SetTargetConfigurationID(in.TargetConfigurationID).
+		SetJobID(in.JobID).
+		SetCertificateID(in.CertificateID).
+		SetSource(in.Source).
+		SetCreateTime(time.Now())
+
+	if in.CertificateSerial != "" {
+		builder.SetCertificateSerial(in.CertificateSerial)
+	}
+	if in.Message != "" {
+		builder.SetMessage(in.Message)
+	}
+	if in.ObservedSerial != "" {
+		builder.SetObservedSerial(in.ObservedSerial)
+	}
+	if len(in.Details) > 0 {
+		builder.SetDetails(in.Details)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create drift event failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create drift event failed")
+	}
+	return entity, nil
+}
+
+// GetOpen returns the unresolved drift event of a configuration, or nil
+func (r *DriftEventRepo) GetOpen(ctx context.Context, configID string) (*ent.DriftEvent, error) {
+	entity, err := r.entClient.Client().DriftEvent.Query().
+		Where(
+			driftevent.TargetConfigurationIDEQ(configID),
+			driftevent.ResolvedAtIsNil(),
+		).
+		Order(ent.Desc(driftevent.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get open drift event failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get drift event failed")
+	}
+	return entity, nil
+}
+
+// SetRemediationJob records the job redeploying the expected certificate
+func (r *DriftEventRepo) SetRemediationJob(ctx context.Context, id uint32, jobID string) error {
+	if err := r.entClient.Client().DriftEvent.UpdateOneID(id).
+		SetRemediationJobID(jobID).
+		SetUpdateTime(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("set drift remediation job failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("update drift event failed")
+	}
+	return nil
+}
+
+// ResolveOpen resolves the unresolved drift events of a configuration and
+// returns how many there were
+func (r *DriftEventRepo) ResolveOpen(ctx context.Context, configID string) (int, error) {
+	now := time.Now()
+	affected, err := r.entClient.Client().DriftEvent.Update().
+		Where(
+			driftevent.TargetConfigurationIDEQ(configID),
+			driftevent.ResolvedAtIsNil(),
+		).
+		SetResolvedAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("resolve drift events failed: %s", err.Error())
+		return 0, deployerV1.ErrorInternalServerError("resolve drift events failed")
+	}
+	return affected, nil
+}
+
+// DriftEventListOptions contains options for listing drift events
+type DriftEventListOptions struct {
+	TenantID              *uint32
+	TargetConfigurationID *string
+	UnresolvedOnly        bool
+	Page                  uint32
+	PageSize              uint32
+}
+
+// List lists drift events, newest first
+func (r *DriftEventRepo) List(ctx context.Context, opts *DriftEventListOptions) ([]*ent.DriftEvent, int, error) {
+	query := r.entClient.Client().DriftEvent.Query()
+
+	if opts.TenantID != nil {
+		query = query.Where(driftevent.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.TargetConfigurationID != nil {
+		query = query.Where(driftevent.TargetConfigurationIDEQ(*opts.TargetConfigurationID))
+	}
+	if opts.UnresolvedOnly {
+		query = query.Where(driftevent.ResolvedAtIsNil())
+	}
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count drift events failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count drift events failed")
+	}
+
+	// Apply pagination
+	if opts.Page > 0 && opts.PageSize > 0 {
+		offset := int((opts.Page - 1) * opts.PageSize)
+		query = query.Offset(offset).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(driftevent.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list drift events failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list drift events failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.DriftEvent to deployerV1.DriftEvent
+func (r *DriftEventRepo) ToProto(entity *ent.DriftEvent) *deployerV1.DriftEvent {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &deployerV1.DriftEvent{
+		Id:                    entity.ID,
+		TenantId:              entity.TenantID,
+		TargetConfigurationId: entity.TargetConfigurationID,
+		JobId:                 entity.JobID,
+		CertificateId:         entity.CertificateID,
+		Source:                deployerV1.DriftSource(deployerV1.DriftSource_value[string(entity.Source)]),
+		RemediationJobId:      entity.RemediationJobID,
+	}
+
+	if entity.CertificateSerial != "" {
+		proto.CertificateSerial = &entity.CertificateSerial
+	}
+	if entity.Message != "" {
+		proto.Message = &entity.Message
+	}
+	if entity.ObservedSerial != "" {
+		proto.ObservedSerial = &entity.ObservedSerial
+	}
+	if entity.Details != nil {
+		if details, err := structpb.NewStruct(entity.Details); err == nil {
+			proto.Details = details
+		}
+	}
+
+	if entity.ResolvedAt != nil {
+		proto.ResolvedAt = timestamppb.New(*entity.ResolvedAt)
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditchainhead
+
+import (
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldLTE(FieldID, id))
+}
+
+// LastID applies equality check predicate on the "last_id" field. It's identical to LastIDEQ.
+func LastID(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEQ(FieldLastID, v))
+}
+
+// LastHash applies equality check predicate on the "last_hash" field. It's identical to LastHashEQ.
+func LastHash(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEQ(FieldLastHash, v))
+}
+
+// LastIDEQ applies the EQ predicate on the "last_id" field.
+func LastIDEQ(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEQ(FieldLastID, v))
+}
+
+// LastIDNEQ applies the NEQ predicate on the "last_id" field.
+func LastIDNEQ(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNEQ(FieldLastID, v))
+}
+
+// LastIDIn applies the In predicate on the "last_id" field.
+func LastIDIn(vs ...uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldIn(FieldLastID, vs...))
+}
+
+// LastIDNotIn applies the NotIn predicate on the "last_id" field.
+func LastIDNotIn(vs ...uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNotIn(FieldLastID, vs...))
+}
+
+// LastIDGT applies the GT predicate on the "last_id" field.
+func LastIDGT(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldGT(FieldLastID, v))
+}
+
+// LastIDGTE applies the GTE predicate on the "last_id" field.
+func LastIDGTE(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldGTE(FieldLastID, v))
+}
+
+// LastIDLT applies the LT predicate on the "last_id" field.
+func LastIDLT(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldLT(FieldLastID, v))
+}
+
+// LastIDLTE applies the LTE predicate on the "last_id" field.
+func LastIDLTE(v uint32) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldLTE(FieldLastID, v))
+}
+
+// LastIDIsNil applies the IsNil predicate on the "last_id" field.
+func LastIDIsNil() predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldIsNull(FieldLastID))
+}
+
+// LastIDNotNil applies the NotNil predicate on the "last_id" field.
+func LastIDNotNil() predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNotNull(FieldLastID))
+}
+
+// LastHashEQ applies the EQ predicate on the "last_hash" field.
+func LastHashEQ(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEQ(FieldLastHash, v))
+}
+
+// LastHashNEQ applies the NEQ predicate on the "last_hash" field.
+func LastHashNEQ(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNEQ(FieldLastHash, v))
+}
+
+// LastHashIn applies the In predicate on the "last_hash" field.
+func LastHashIn(vs ...string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldIn(FieldLastHash, vs...))
+}
+
+// LastHashNotIn applies the NotIn predicate on the "last_hash" field.
+func LastHashNotIn(vs ...string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNotIn(FieldLastHash, vs...))
+}
+
+// LastHashGT applies the GT predicate on the "last_hash" field.
+func LastHashGT(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldGT(FieldLastHash, v))
+}
+
+// LastHashGTE applies the GTE predicate on the "last_hash" field.
+func LastHashGTE(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldGTE(FieldLastHash, v))
+}
+
+// LastHashLT applies the LT predicate on the "last_hash" field.
+func LastHashLT(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldLT(FieldLastHash, v))
+}
+
+// LastHashLTE applies the LTE predicate on the "last_hash" field.
+func LastHashLTE(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldLTE(FieldLastHash, v))
+}
+
+// LastHashContains applies the Contains predicate on the "last_hash" field.
+func LastHashContains(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldContains(FieldLastHash, v))
+}
+
+// LastHashHasPrefix applies the HasPrefix predicate on the "last_hash" field.
+func LastHashHasPrefix(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldHasPrefix(FieldLastHash, v))
+}
+
+// LastHashHasSuffix applies the HasSuffix predicate on the "last_hash" field.
+func LastHashHasSuffix(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldHasSuffix(FieldLastHash, v))
+}
+
+// LastHashIsNil applies the IsNil predicate on the "last_hash" field.
+func LastHashIsNil() predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldIsNull(FieldLastHash))
+}
+
+// LastHashNotNil applies the NotNil predicate on the "last_hash" field.
+func LastHashNotNil() predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldNotNull(FieldLastHash))
+}
+
+// LastHashEqualFold applies the EqualFold predicate on the "last_hash" field.
+func LastHashEqualFold(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldEqualFold(FieldLastHash, v))
+}
+
+// LastHashContainsFold applies the ContainsFold predicate on the "last_hash" field.
+func LastHashContainsFold(v string) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.FieldContainsFold(FieldLastHash, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditChainHead) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditChainHead) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditChainHead) predicate.AuditChainHead {
+	return predicate.AuditChainHead(sql.NotPredicates(p))
+}
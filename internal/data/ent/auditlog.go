@@ -62,6 +62,10 @@ type AuditLog struct {
 	LogHash string `json:"log_hash,omitempty"`
 	// ECDSA signature for integrity verification
 	Signature []byte `json:"signature,omitempty"`
+	// ID of the row this row is chained to
+	PrevID *uint32 `json:"prev_id,omitempty"`
+	// log_hash of the row this row is chained to, covered by log_hash
+	PrevHash string `json:"prev_hash,omitempty"`
 	// Additional metadata
 	Metadata     map[string]string `json:"metadata,omitempty"`
 	selectValues sql.SelectValues
@@ -76,9 +80,9 @@ func (*AuditLog) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case auditlog.FieldIsAuthenticated, auditlog.FieldSuccess:
 			values[i] = new(sql.NullBool)
-		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldErrorCode, auditlog.FieldLatencyMs:
+		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldErrorCode, auditlog.FieldLatencyMs, auditlog.FieldPrevID:
 			values[i] = new(sql.NullInt64)
-		case auditlog.FieldAuditID, auditlog.FieldRequestID, auditlog.FieldOperation, auditlog.FieldServiceName, auditlog.FieldClientID, auditlog.FieldClientCommonName, auditlog.FieldClientOrganization, auditlog.FieldClientSerialNumber, auditlog.FieldErrorMessage, auditlog.FieldPeerAddress, auditlog.FieldLogHash:
+		case auditlog.FieldAuditID, auditlog.FieldRequestID, auditlog.FieldOperation, auditlog.FieldServiceName, auditlog.FieldClientID, auditlog.FieldClientCommonName, auditlog.FieldClientOrganization, auditlog.FieldClientSerialNumber, auditlog.FieldErrorMessage, auditlog.FieldPeerAddress, auditlog.FieldLogHash, auditlog.FieldPrevHash:
 			values[i] = new(sql.NullString)
 		case auditlog.FieldCreateTime, auditlog.FieldUpdateTime, auditlog.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -236,6 +240,19 @@ func (_m *AuditLog) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.Signature = *value
 			}
+		case auditlog.FieldPrevID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prev_id", values[i])
+			} else if value.Valid {
+				_m.PrevID = new(uint32)
+				*_m.PrevID = uint32(value.Int64)
+			}
+		case auditlog.FieldPrevHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prev_hash", values[i])
+			} else if value.Valid {
+				_m.PrevHash = value.String
+			}
 		case auditlog.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -353,6 +370,14 @@ func (_m *AuditLog) String() string {
 	builder.WriteString("signature=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Signature))
 	builder.WriteString(", ")
+	if v := _m.PrevID; v != nil {
+		builder.WriteString("prev_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("prev_hash=")
+	builder.WriteString(_m.PrevHash)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteByte(')')
@@ -54,6 +54,10 @@ const (
 	FieldLogHash = "log_hash"
 	// FieldSignature holds the string denoting the signature field in the database.
 	FieldSignature = "signature"
+	// FieldPrevID holds the string denoting the prev_id field in the database.
+	FieldPrevID = "prev_id"
+	// FieldPrevHash holds the string denoting the prev_hash field in the database.
+	FieldPrevHash = "prev_hash"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// Table holds the table name of the auditlog in the database.
@@ -84,6 +88,8 @@ var Columns = []string{
 	FieldGeoLocation,
 	FieldLogHash,
 	FieldSignature,
+	FieldPrevID,
+	FieldPrevHash,
 	FieldMetadata,
 }
 
@@ -225,3 +231,13 @@ func ByPeerAddress(opts ...sql.OrderTermOption) OrderOption {
 func ByLogHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLogHash, opts...).ToFunc()
 }
+
+// ByPrevID orders the results by the prev_id field.
+func ByPrevID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrevID, opts...).ToFunc()
+}
+
+// ByPrevHash orders the results by the prev_hash field.
+func ByPrevHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrevHash, opts...).ToFunc()
+}
@@ -155,6 +155,16 @@ func Signature(v []byte) predicate.AuditLog {
 	return predicate.AuditLog(sql.FieldEQ(FieldSignature, v))
 }
 
+// PrevID applies equality check predicate on the "prev_id" field. It's identical to PrevIDEQ.
+func PrevID(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldPrevID, v))
+}
+
+// PrevHash applies equality check predicate on the "prev_hash" field. It's identical to PrevHashEQ.
+func PrevHash(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldPrevHash, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.AuditLog {
 	return predicate.AuditLog(sql.FieldEQ(FieldCreateTime, v))
@@ -1320,6 +1330,131 @@ func SignatureNotNil() predicate.AuditLog {
 	return predicate.AuditLog(sql.FieldNotNull(FieldSignature))
 }
 
+// PrevIDEQ applies the EQ predicate on the "prev_id" field.
+func PrevIDEQ(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldPrevID, v))
+}
+
+// PrevIDNEQ applies the NEQ predicate on the "prev_id" field.
+func PrevIDNEQ(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldPrevID, v))
+}
+
+// PrevIDIn applies the In predicate on the "prev_id" field.
+func PrevIDIn(vs ...uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldPrevID, vs...))
+}
+
+// PrevIDNotIn applies the NotIn predicate on the "prev_id" field.
+func PrevIDNotIn(vs ...uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldPrevID, vs...))
+}
+
+// PrevIDGT applies the GT predicate on the "prev_id" field.
+func PrevIDGT(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldPrevID, v))
+}
+
+// PrevIDGTE applies the GTE predicate on the "prev_id" field.
+func PrevIDGTE(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldPrevID, v))
+}
+
+// PrevIDLT applies the LT predicate on the "prev_id" field.
+func PrevIDLT(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldPrevID, v))
+}
+
+// PrevIDLTE applies the LTE predicate on the "prev_id" field.
+func PrevIDLTE(v uint32) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldPrevID, v))
+}
+
+// PrevIDIsNil applies the IsNil predicate on the "prev_id" field.
+func PrevIDIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldPrevID))
+}
+
+// PrevIDNotNil applies the NotNil predicate on the "prev_id" field.
+func PrevIDNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldPrevID))
+}
+
+// PrevHashEQ applies the EQ predicate on the "prev_hash" field.
+func PrevHashEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldPrevHash, v))
+}
+
+// PrevHashNEQ applies the NEQ predicate on the "prev_hash" field.
+func PrevHashNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldPrevHash, v))
+}
+
+// PrevHashIn applies the In predicate on the "prev_hash" field.
+func PrevHashIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldPrevHash, vs...))
+}
+
+// PrevHashNotIn applies the NotIn predicate on the "prev_hash" field.
+func PrevHashNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldPrevHash, vs...))
+}
+
+// PrevHashGT applies the GT predicate on the "prev_hash" field.
+func PrevHashGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldPrevHash, v))
+}
+
+// PrevHashGTE applies the GTE predicate on the "prev_hash" field.
+func PrevHashGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldPrevHash, v))
+}
+
+// PrevHashLT applies the LT predicate on the "prev_hash" field.
+func PrevHashLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldPrevHash, v))
+}
+
+// PrevHashLTE applies the LTE predicate on the "prev_hash" field.
+func PrevHashLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldPrevHash, v))
+}
+
+// PrevHashContains applies the Contains predicate on the "prev_hash" field.
+func PrevHashContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldPrevHash, v))
+}
+
+// PrevHashHasPrefix applies the HasPrefix predicate on the "prev_hash" field.
+func PrevHashHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldPrevHash, v))
+}
+
+// PrevHashHasSuffix applies the HasSuffix predicate on the "prev_hash" field.
+func PrevHashHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldPrevHash, v))
+}
+
+// PrevHashIsNil applies the IsNil predicate on the "prev_hash" field.
+func PrevHashIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldPrevHash))
+}
+
+// PrevHashNotNil applies the NotNil predicate on the "prev_hash" field.
+func PrevHashNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldPrevHash))
+}
+
+// PrevHashEqualFold applies the EqualFold predicate on the "prev_hash" field.
+func PrevHashEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldPrevHash, v))
+}
+
+// PrevHashContainsFold applies the ContainsFold predicate on the "prev_hash" field.
+func PrevHashContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldPrevHash, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.AuditLog {
 	return predicate.AuditLog(sql.FieldIsNull(FieldMetadata))
@@ -285,6 +285,34 @@ func (_c *AuditLogCreate) SetSignature(v []byte) *AuditLogCreate {
 	return _c
 }
 
+// SetPrevID sets the "prev_id" field.
+func (_c *AuditLogCreate) SetPrevID(v uint32) *AuditLogCreate {
+	_c.mutation.SetPrevID(v)
+	return _c
+}
+
+// SetNillablePrevID sets the "prev_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillablePrevID(v *uint32) *AuditLogCreate {
+	if v != nil {
+		_c.SetPrevID(*v)
+	}
+	return _c
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_c *AuditLogCreate) SetPrevHash(v string) *AuditLogCreate {
+	_c.mutation.SetPrevHash(v)
+	return _c
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillablePrevHash(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetPrevHash(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *AuditLogCreate) SetMetadata(v map[string]string) *AuditLogCreate {
 	_c.mutation.SetMetadata(v)
@@ -509,6 +537,14 @@ func (_c *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(auditlog.FieldSignature, field.TypeBytes, value)
 		_node.Signature = value
 	}
+	if value, ok := _c.mutation.PrevID(); ok {
+		_spec.SetField(auditlog.FieldPrevID, field.TypeUint32, value)
+		_node.PrevID = &value
+	}
+	if value, ok := _c.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(auditlog.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -883,6 +919,48 @@ func (u *AuditLogUpsert) ClearSignature() *AuditLogUpsert {
 	return u
 }
 
+// SetPrevID sets the "prev_id" field.
+func (u *AuditLogUpsert) SetPrevID(v uint32) *AuditLogUpsert {
+	u.Set(auditlog.FieldPrevID, v)
+	return u
+}
+
+// UpdatePrevID sets the "prev_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePrevID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPrevID)
+	return u
+}
+
+// AddPrevID adds v to the "prev_id" field.
+func (u *AuditLogUpsert) AddPrevID(v uint32) *AuditLogUpsert {
+	u.Add(auditlog.FieldPrevID, v)
+	return u
+}
+
+// ClearPrevID clears the value of the "prev_id" field.
+func (u *AuditLogUpsert) ClearPrevID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPrevID)
+	return u
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (u *AuditLogUpsert) SetPrevHash(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPrevHash, v)
+	return u
+}
+
+// UpdatePrevHash sets the "prev_hash" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePrevHash() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPrevHash)
+	return u
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (u *AuditLogUpsert) ClearPrevHash() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPrevHash)
+	return u
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *AuditLogUpsert) SetMetadata(v map[string]string) *AuditLogUpsert {
 	u.Set(auditlog.FieldMetadata, v)
@@ -1326,6 +1404,55 @@ func (u *AuditLogUpsertOne) ClearSignature() *AuditLogUpsertOne {
 	})
 }
 
+// SetPrevID sets the "prev_id" field.
+func (u *AuditLogUpsertOne) SetPrevID(v uint32) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrevID(v)
+	})
+}
+
+// AddPrevID adds v to the "prev_id" field.
+func (u *AuditLogUpsertOne) AddPrevID(v uint32) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddPrevID(v)
+	})
+}
+
+// UpdatePrevID sets the "prev_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePrevID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrevID()
+	})
+}
+
+// ClearPrevID clears the value of the "prev_id" field.
+func (u *AuditLogUpsertOne) ClearPrevID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrevID()
+	})
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (u *AuditLogUpsertOne) SetPrevHash(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrevHash(v)
+	})
+}
+
+// UpdatePrevHash sets the "prev_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePrevHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrevHash()
+	})
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (u *AuditLogUpsertOne) ClearPrevHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrevHash()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *AuditLogUpsertOne) SetMetadata(v map[string]string) *AuditLogUpsertOne {
 	return u.Update(func(s *AuditLogUpsert) {
@@ -1938,6 +2065,55 @@ func (u *AuditLogUpsertBulk) ClearSignature() *AuditLogUpsertBulk {
 	})
 }
 
+// SetPrevID sets the "prev_id" field.
+func (u *AuditLogUpsertBulk) SetPrevID(v uint32) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrevID(v)
+	})
+}
+
+// AddPrevID adds v to the "prev_id" field.
+func (u *AuditLogUpsertBulk) AddPrevID(v uint32) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddPrevID(v)
+	})
+}
+
+// UpdatePrevID sets the "prev_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePrevID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrevID()
+	})
+}
+
+// ClearPrevID clears the value of the "prev_id" field.
+func (u *AuditLogUpsertBulk) ClearPrevID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrevID()
+	})
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (u *AuditLogUpsertBulk) SetPrevHash(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrevHash(v)
+	})
+}
+
+// UpdatePrevHash sets the "prev_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePrevHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrevHash()
+	})
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (u *AuditLogUpsertBulk) ClearPrevHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrevHash()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *AuditLogUpsertBulk) SetMetadata(v map[string]string) *AuditLogUpsertBulk {
 	return u.Update(func(s *AuditLogUpsert) {
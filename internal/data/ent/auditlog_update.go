@@ -372,6 +372,53 @@ func (_u *AuditLogUpdate) ClearSignature() *AuditLogUpdate {
 	return _u
 }
 
+// SetPrevID sets the "prev_id" field.
+func (_u *AuditLogUpdate) SetPrevID(v uint32) *AuditLogUpdate {
+	_u.mutation.ResetPrevID()
+	_u.mutation.SetPrevID(v)
+	return _u
+}
+
+// SetNillablePrevID sets the "prev_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillablePrevID(v *uint32) *AuditLogUpdate {
+	if v != nil {
+		_u.SetPrevID(*v)
+	}
+	return _u
+}
+
+// AddPrevID adds value to the "prev_id" field.
+func (_u *AuditLogUpdate) AddPrevID(v int32) *AuditLogUpdate {
+	_u.mutation.AddPrevID(v)
+	return _u
+}
+
+// ClearPrevID clears the value of the "prev_id" field.
+func (_u *AuditLogUpdate) ClearPrevID() *AuditLogUpdate {
+	_u.mutation.ClearPrevID()
+	return _u
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_u *AuditLogUpdate) SetPrevHash(v string) *AuditLogUpdate {
+	_u.mutation.SetPrevHash(v)
+	return _u
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillablePrevHash(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetPrevHash(*v)
+	}
+	return _u
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (_u *AuditLogUpdate) ClearPrevHash() *AuditLogUpdate {
+	_u.mutation.ClearPrevHash()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *AuditLogUpdate) SetMetadata(v map[string]string) *AuditLogUpdate {
 	_u.mutation.SetMetadata(v)
@@ -557,6 +604,21 @@ func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SignatureCleared() {
 		_spec.ClearField(auditlog.FieldSignature, field.TypeBytes)
 	}
+	if value, ok := _u.mutation.PrevID(); ok {
+		_spec.SetField(auditlog.FieldPrevID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedPrevID(); ok {
+		_spec.AddField(auditlog.FieldPrevID, field.TypeUint32, value)
+	}
+	if _u.mutation.PrevIDCleared() {
+		_spec.ClearField(auditlog.FieldPrevID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+	}
+	if _u.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(auditlog.FieldMetadata, field.TypeJSON, value)
 	}
@@ -927,6 +989,53 @@ func (_u *AuditLogUpdateOne) ClearSignature() *AuditLogUpdateOne {
 	return _u
 }
 
+// SetPrevID sets the "prev_id" field.
+func (_u *AuditLogUpdateOne) SetPrevID(v uint32) *AuditLogUpdateOne {
+	_u.mutation.ResetPrevID()
+	_u.mutation.SetPrevID(v)
+	return _u
+}
+
+// SetNillablePrevID sets the "prev_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillablePrevID(v *uint32) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetPrevID(*v)
+	}
+	return _u
+}
+
+// AddPrevID adds value to the "prev_id" field.
+func (_u *AuditLogUpdateOne) AddPrevID(v int32) *AuditLogUpdateOne {
+	_u.mutation.AddPrevID(v)
+	return _u
+}
+
+// ClearPrevID clears the value of the "prev_id" field.
+func (_u *AuditLogUpdateOne) ClearPrevID() *AuditLogUpdateOne {
+	_u.mutation.ClearPrevID()
+	return _u
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_u *AuditLogUpdateOne) SetPrevHash(v string) *AuditLogUpdateOne {
+	_u.mutation.SetPrevHash(v)
+	return _u
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillablePrevHash(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetPrevHash(*v)
+	}
+	return _u
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (_u *AuditLogUpdateOne) ClearPrevHash() *AuditLogUpdateOne {
+	_u.mutation.ClearPrevHash()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *AuditLogUpdateOne) SetMetadata(v map[string]string) *AuditLogUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -1142,6 +1251,21 @@ func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err
 	if _u.mutation.SignatureCleared() {
 		_spec.ClearField(auditlog.FieldSignature, field.TypeBytes)
 	}
+	if value, ok := _u.mutation.PrevID(); ok {
+		_spec.SetField(auditlog.FieldPrevID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedPrevID(); ok {
+		_spec.AddField(auditlog.FieldPrevID, field.TypeUint32, value)
+	}
+	if _u.mutation.PrevIDCleared() {
+		_spec.ClearField(auditlog.FieldPrevID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+	}
+	if _u.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(auditlog.FieldMetadata, field.TypeJSON, value)
 	}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificaterevocation
+
+import (
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotNull(FieldTenantID))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDGT applies the GT predicate on the "certificate_id" field.
+func CertificateIDGT(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldCertificateID, v))
+}
+
+// CertificateIDGTE applies the GTE predicate on the "certificate_id" field.
+func CertificateIDGTE(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldCertificateID, v))
+}
+
+// CertificateIDLT applies the LT predicate on the "certificate_id" field.
+func CertificateIDLT(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldCertificateID, v))
+}
+
+// CertificateIDLTE applies the LTE predicate on the "certificate_id" field.
+func CertificateIDLTE(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldCertificateID, v))
+}
+
+// CertificateIDContains applies the Contains predicate on the "certificate_id" field.
+func CertificateIDContains(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldContains(FieldCertificateID, v))
+}
+
+// CertificateIDHasPrefix applies the HasPrefix predicate on the "certificate_id" field.
+func CertificateIDHasPrefix(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldHasPrefix(FieldCertificateID, v))
+}
+
+// CertificateIDHasSuffix applies the HasSuffix predicate on the "certificate_id" field.
+func CertificateIDHasSuffix(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldHasSuffix(FieldCertificateID, v))
+}
+
+// CertificateIDIsNil applies the IsNil predicate on the "certificate_id" field.
+func CertificateIDIsNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIsNull(FieldCertificateID))
+}
+
+// CertificateIDNotNil applies the NotNil predicate on the "certificate_id" field.
+func CertificateIDNotNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotNull(FieldCertificateID))
+}
+
+// CertificateIDEqualFold applies the EqualFold predicate on the "certificate_id" field.
+func CertificateIDEqualFold(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEqualFold(FieldCertificateID, v))
+}
+
+// CertificateIDContainsFold applies the ContainsFold predicate on the "certificate_id" field.
+func CertificateIDContainsFold(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldContainsFold(FieldCertificateID, v))
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGT(FieldSerialNumber, v))
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldGTE(FieldSerialNumber, v))
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLT(FieldSerialNumber, v))
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldLTE(FieldSerialNumber, v))
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldContains(FieldSerialNumber, v))
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldHasPrefix(FieldSerialNumber, v))
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldHasSuffix(FieldSerialNumber, v))
+}
+
+// SerialNumberIsNil applies the IsNil predicate on the "serial_number" field.
+func SerialNumberIsNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldIsNull(FieldSerialNumber))
+}
+
+// SerialNumberNotNil applies the NotNil predicate on the "serial_number" field.
+func SerialNumberNotNil() predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldNotNull(FieldSerialNumber))
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldEqualFold(FieldSerialNumber, v))
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.FieldContainsFold(FieldSerialNumber, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CertificateRevocation) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CertificateRevocation) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CertificateRevocation) predicate.CertificateRevocation {
+	return predicate.CertificateRevocation(sql.NotPredicates(p))
+}
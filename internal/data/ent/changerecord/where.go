@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package changerecord
+
+import (
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceName applies equality check predicate on the "resource_name" field. It's identical to ResourceNameEQ.
+func ResourceName(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldResourceName, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorName applies equality check predicate on the "actor_name" field. It's identical to ActorNameEQ.
+func ActorName(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldActorName, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldTenantID))
+}
+
+// ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
+func ResourceTypeEQ(v ResourceType) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldResourceType, v))
+}
+
+// ResourceTypeNEQ applies the NEQ predicate on the "resource_type" field.
+func ResourceTypeNEQ(v ResourceType) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldResourceType, v))
+}
+
+// ResourceTypeIn applies the In predicate on the "resource_type" field.
+func ResourceTypeIn(vs ...ResourceType) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeNotIn applies the NotIn predicate on the "resource_type" field.
+func ResourceTypeNotIn(vs ...ResourceType) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldResourceType, vs...))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ResourceNameEQ applies the EQ predicate on the "resource_name" field.
+func ResourceNameEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldResourceName, v))
+}
+
+// ResourceNameNEQ applies the NEQ predicate on the "resource_name" field.
+func ResourceNameNEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldResourceName, v))
+}
+
+// ResourceNameIn applies the In predicate on the "resource_name" field.
+func ResourceNameIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldResourceName, vs...))
+}
+
+// ResourceNameNotIn applies the NotIn predicate on the "resource_name" field.
+func ResourceNameNotIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldResourceName, vs...))
+}
+
+// ResourceNameGT applies the GT predicate on the "resource_name" field.
+func ResourceNameGT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldResourceName, v))
+}
+
+// ResourceNameGTE applies the GTE predicate on the "resource_name" field.
+func ResourceNameGTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldResourceName, v))
+}
+
+// ResourceNameLT applies the LT predicate on the "resource_name" field.
+func ResourceNameLT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldResourceName, v))
+}
+
+// ResourceNameLTE applies the LTE predicate on the "resource_name" field.
+func ResourceNameLTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldResourceName, v))
+}
+
+// ResourceNameContains applies the Contains predicate on the "resource_name" field.
+func ResourceNameContains(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContains(FieldResourceName, v))
+}
+
+// ResourceNameHasPrefix applies the HasPrefix predicate on the "resource_name" field.
+func ResourceNameHasPrefix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasPrefix(FieldResourceName, v))
+}
+
+// ResourceNameHasSuffix applies the HasSuffix predicate on the "resource_name" field.
+func ResourceNameHasSuffix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasSuffix(FieldResourceName, v))
+}
+
+// ResourceNameIsNil applies the IsNil predicate on the "resource_name" field.
+func ResourceNameIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldResourceName))
+}
+
+// ResourceNameNotNil applies the NotNil predicate on the "resource_name" field.
+func ResourceNameNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldResourceName))
+}
+
+// ResourceNameEqualFold applies the EqualFold predicate on the "resource_name" field.
+func ResourceNameEqualFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEqualFold(FieldResourceName, v))
+}
+
+// ResourceNameContainsFold applies the ContainsFold predicate on the "resource_name" field.
+func ResourceNameContainsFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContainsFold(FieldResourceName, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uint32) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldActorID))
+}
+
+// ActorNameEQ applies the EQ predicate on the "actor_name" field.
+func ActorNameEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldActorName, v))
+}
+
+// ActorNameNEQ applies the NEQ predicate on the "actor_name" field.
+func ActorNameNEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldActorName, v))
+}
+
+// ActorNameIn applies the In predicate on the "actor_name" field.
+func ActorNameIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldActorName, vs...))
+}
+
+// ActorNameNotIn applies the NotIn predicate on the "actor_name" field.
+func ActorNameNotIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldActorName, vs...))
+}
+
+// ActorNameGT applies the GT predicate on the "actor_name" field.
+func ActorNameGT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldActorName, v))
+}
+
+// ActorNameGTE applies the GTE predicate on the "actor_name" field.
+func ActorNameGTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldActorName, v))
+}
+
+// ActorNameLT applies the LT predicate on the "actor_name" field.
+func ActorNameLT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldActorName, v))
+}
+
+// ActorNameLTE applies the LTE predicate on the "actor_name" field.
+func ActorNameLTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldActorName, v))
+}
+
+// ActorNameContains applies the Contains predicate on the "actor_name" field.
+func ActorNameContains(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContains(FieldActorName, v))
+}
+
+// ActorNameHasPrefix applies the HasPrefix predicate on the "actor_name" field.
+func ActorNameHasPrefix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasPrefix(FieldActorName, v))
+}
+
+// ActorNameHasSuffix applies the HasSuffix predicate on the "actor_name" field.
+func ActorNameHasSuffix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasSuffix(FieldActorName, v))
+}
+
+// ActorNameIsNil applies the IsNil predicate on the "actor_name" field.
+func ActorNameIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldActorName))
+}
+
+// ActorNameNotNil applies the NotNil predicate on the "actor_name" field.
+func ActorNameNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldActorName))
+}
+
+// ActorNameEqualFold applies the EqualFold predicate on the "actor_name" field.
+func ActorNameEqualFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEqualFold(FieldActorName, v))
+}
+
+// ActorNameContainsFold applies the ContainsFold predicate on the "actor_name" field.
+func ActorNameContainsFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContainsFold(FieldActorName, v))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldChanges))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChangeRecord) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChangeRecord) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChangeRecord) predicate.ChangeRecord {
+	return predicate.ChangeRecord(sql.NotPredicates(p))
+}
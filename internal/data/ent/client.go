@@ -11,10 +11,21 @@ import (
 
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/migrate"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/certificaterevocation"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploylock"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenthistory"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/externalcertificate"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/inventorysnapshot"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/rolebinding"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
 
 	"entgo.io/ent"
@@ -28,14 +39,36 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditChainHead is the client for interacting with the AuditChainHead builders.
+	AuditChainHead *AuditChainHeadClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// CertificateRevocation is the client for interacting with the CertificateRevocation builders.
+	CertificateRevocation *CertificateRevocationClient
+	// ChangeRecord is the client for interacting with the ChangeRecord builders.
+	ChangeRecord *ChangeRecordClient
+	// ConfigurationRevision is the client for interacting with the ConfigurationRevision builders.
+	ConfigurationRevision *ConfigurationRevisionClient
+	// DeployLock is the client for interacting with the DeployLock builders.
+	DeployLock *DeployLockClient
 	// DeploymentHistory is the client for interacting with the DeploymentHistory builders.
 	DeploymentHistory *DeploymentHistoryClient
 	// DeploymentJob is the client for interacting with the DeploymentJob builders.
 	DeploymentJob *DeploymentJobClient
 	// DeploymentTarget is the client for interacting with the DeploymentTarget builders.
 	DeploymentTarget *DeploymentTargetClient
+	// DriftEvent is the client for interacting with the DriftEvent builders.
+	DriftEvent *DriftEventClient
+	// ExternalCertificate is the client for interacting with the ExternalCertificate builders.
+	ExternalCertificate *ExternalCertificateClient
+	// InventorySnapshot is the client for interacting with the InventorySnapshot builders.
+	InventorySnapshot *InventorySnapshotClient
+	// NotificationRule is the client for interacting with the NotificationRule builders.
+	NotificationRule *NotificationRuleClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// RoleBinding is the client for interacting with the RoleBinding builders.
+	RoleBinding *RoleBindingClient
 	// TargetConfiguration is the client for interacting with the TargetConfiguration builders.
 	TargetConfiguration *TargetConfigurationClient
 }
@@ -49,10 +82,21 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditChainHead = NewAuditChainHeadClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.CertificateRevocation = NewCertificateRevocationClient(c.config)
+	c.ChangeRecord = NewChangeRecordClient(c.config)
+	c.ConfigurationRevision = NewConfigurationRevisionClient(c.config)
+	c.DeployLock = NewDeployLockClient(c.config)
 	c.DeploymentHistory = NewDeploymentHistoryClient(c.config)
 	c.DeploymentJob = NewDeploymentJobClient(c.config)
 	c.DeploymentTarget = NewDeploymentTargetClient(c.config)
+	c.DriftEvent = NewDriftEventClient(c.config)
+	c.ExternalCertificate = NewExternalCertificateClient(c.config)
+	c.InventorySnapshot = NewInventorySnapshotClient(c.config)
+	c.NotificationRule = NewNotificationRuleClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.RoleBinding = NewRoleBindingClient(c.config)
 	c.TargetConfiguration = NewTargetConfigurationClient(c.config)
 }
 
@@ -144,13 +188,24 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		AuditLog:            NewAuditLogClient(cfg),
-		DeploymentHistory:   NewDeploymentHistoryClient(cfg),
-		DeploymentJob:       NewDeploymentJobClient(cfg),
-		DeploymentTarget:    NewDeploymentTargetClient(cfg),
-		TargetConfiguration: NewTargetConfigurationClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AuditChainHead:        NewAuditChainHeadClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		CertificateRevocation: NewCertificateRevocationClient(cfg),
+		ChangeRecord:          NewChangeRecordClient(cfg),
+		ConfigurationRevision: NewConfigurationRevisionClient(cfg),
+		DeployLock:            NewDeployLockClient(cfg),
+		DeploymentHistory:     NewDeploymentHistoryClient(cfg),
+		DeploymentJob:         NewDeploymentJobClient(cfg),
+		DeploymentTarget:      NewDeploymentTargetClient(cfg),
+		DriftEvent:            NewDriftEventClient(cfg),
+		ExternalCertificate:   NewExternalCertificateClient(cfg),
+		InventorySnapshot:     NewInventorySnapshotClient(cfg),
+		NotificationRule:      NewNotificationRuleClient(cfg),
+		OutboxEvent:           NewOutboxEventClient(cfg),
+		RoleBinding:           NewRoleBindingClient(cfg),
+		TargetConfiguration:   NewTargetConfigurationClient(cfg),
 	}, nil
 }
 
@@ -168,20 +223,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		AuditLog:            NewAuditLogClient(cfg),
-		DeploymentHistory:   NewDeploymentHistoryClient(cfg),
-		DeploymentJob:       NewDeploymentJobClient(cfg),
-		DeploymentTarget:    NewDeploymentTargetClient(cfg),
-		TargetConfiguration: NewTargetConfigurationClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AuditChainHead:        NewAuditChainHeadClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		CertificateRevocation: NewCertificateRevocationClient(cfg),
+		ChangeRecord:          NewChangeRecordClient(cfg),
+		ConfigurationRevision: NewConfigurationRevisionClient(cfg),
+		DeployLock:            NewDeployLockClient(cfg),
+		DeploymentHistory:     NewDeploymentHistoryClient(cfg),
+		DeploymentJob:         NewDeploymentJobClient(cfg),
+		DeploymentTarget:      NewDeploymentTargetClient(cfg),
+		DriftEvent:            NewDriftEventClient(cfg),
+		ExternalCertificate:   NewExternalCertificateClient(cfg),
+		InventorySnapshot:     NewInventorySnapshotClient(cfg),
+		NotificationRule:      NewNotificationRuleClient(cfg),
+		OutboxEvent:           NewOutboxEventClient(cfg),
+		RoleBinding:           NewRoleBindingClient(cfg),
+		TargetConfiguration:   NewTargetConfigurationClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLog.
+//		AuditChainHead.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -203,34 +269,62 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AuditLog.Use(hooks...)
-	c.DeploymentHistory.Use(hooks...)
-	c.DeploymentJob.Use(hooks...)
-	c.DeploymentTarget.Use(hooks...)
-	c.TargetConfiguration.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditChainHead, c.AuditLog, c.CertificateRevocation, c.ChangeRecord,
+		c.ConfigurationRevision, c.DeployLock, c.DeploymentHistory, c.DeploymentJob,
+		c.DeploymentTarget, c.DriftEvent, c.ExternalCertificate, c.InventorySnapshot,
+		c.NotificationRule, c.OutboxEvent, c.RoleBinding, c.TargetConfiguration,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AuditLog.Intercept(interceptors...)
-	c.DeploymentHistory.Intercept(interceptors...)
-	c.DeploymentJob.Intercept(interceptors...)
-	c.DeploymentTarget.Intercept(interceptors...)
-	c.TargetConfiguration.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditChainHead, c.AuditLog, c.CertificateRevocation, c.ChangeRecord,
+		c.ConfigurationRevision, c.DeployLock, c.DeploymentHistory, c.DeploymentJob,
+		c.DeploymentTarget, c.DriftEvent, c.ExternalCertificate, c.InventorySnapshot,
+		c.NotificationRule, c.OutboxEvent, c.RoleBinding, c.TargetConfiguration,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditChainHeadMutation:
+		return c.AuditChainHead.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *CertificateRevocationMutation:
+		return c.CertificateRevocation.mutate(ctx, m)
+	case *ChangeRecordMutation:
+		return c.ChangeRecord.mutate(ctx, m)
+	case *ConfigurationRevisionMutation:
+		return c.ConfigurationRevision.mutate(ctx, m)
+	case *DeployLockMutation:
+		return c.DeployLock.mutate(ctx, m)
 	case *DeploymentHistoryMutation:
 		return c.DeploymentHistory.mutate(ctx, m)
 	case *DeploymentJobMutation:
 		return c.DeploymentJob.mutate(ctx, m)
 	case *DeploymentTargetMutation:
 		return c.DeploymentTarget.mutate(ctx, m)
+	case *DriftEventMutation:
+		return c.DriftEvent.mutate(ctx, m)
+	case *ExternalCertificateMutation:
+		return c.ExternalCertificate.mutate(ctx, m)
+	case *InventorySnapshotMutation:
+		return c.InventorySnapshot.mutate(ctx, m)
+	case *NotificationRuleMutation:
+		return c.NotificationRule.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
+	case *RoleBindingMutation:
+		return c.RoleBinding.mutate(ctx, m)
 	case *TargetConfigurationMutation:
 		return c.TargetConfiguration.mutate(ctx, m)
 	default:
@@ -238,6 +332,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AuditChainHeadClient is a client for the AuditChainHead schema.
+type AuditChainHeadClient struct {
+	config
+}
+
+// NewAuditChainHeadClient returns a client for the AuditChainHead from the given config.
+func NewAuditChainHeadClient(c config) *AuditChainHeadClient {
+	return &AuditChainHeadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditchainhead.Hooks(f(g(h())))`.
+func (c *AuditChainHeadClient) Use(hooks ...Hook) {
+	c.hooks.AuditChainHead = append(c.hooks.AuditChainHead, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditchainhead.Intercept(f(g(h())))`.
+func (c *AuditChainHeadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditChainHead = append(c.inters.AuditChainHead, interceptors...)
+}
+
+// Create returns a builder for creating a AuditChainHead entity.
+func (c *AuditChainHeadClient) Create() *AuditChainHeadCreate {
+	mutation := newAuditChainHeadMutation(c.config, OpCreate)
+	return &AuditChainHeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditChainHead entities.
+func (c *AuditChainHeadClient) CreateBulk(builders ...*AuditChainHeadCreate) *AuditChainHeadCreateBulk {
+	return &AuditChainHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditChainHeadClient) MapCreateBulk(slice any, setFunc func(*AuditChainHeadCreate, int)) *AuditChainHeadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditChainHeadCreateBulk{err: fmt.Errorf("calling to AuditChainHeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditChainHeadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditChainHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditChainHead.
+func (c *AuditChainHeadClient) Update() *AuditChainHeadUpdate {
+	mutation := newAuditChainHeadMutation(c.config, OpUpdate)
+	return &AuditChainHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditChainHeadClient) UpdateOne(_m *AuditChainHead) *AuditChainHeadUpdateOne {
+	mutation := newAuditChainHeadMutation(c.config, OpUpdateOne, withAuditChainHead(_m))
+	return &AuditChainHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditChainHeadClient) UpdateOneID(id int) *AuditChainHeadUpdateOne {
+	mutation := newAuditChainHeadMutation(c.config, OpUpdateOne, withAuditChainHeadID(id))
+	return &AuditChainHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditChainHead.
+func (c *AuditChainHeadClient) Delete() *AuditChainHeadDelete {
+	mutation := newAuditChainHeadMutation(c.config, OpDelete)
+	return &AuditChainHeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditChainHeadClient) DeleteOne(_m *AuditChainHead) *AuditChainHeadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditChainHeadClient) DeleteOneID(id int) *AuditChainHeadDeleteOne {
+	builder := c.Delete().Where(auditchainhead.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditChainHeadDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditChainHead.
+func (c *AuditChainHeadClient) Query() *AuditChainHeadQuery {
+	return &AuditChainHeadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditChainHead},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditChainHead entity by its id.
+func (c *AuditChainHeadClient) Get(ctx context.Context, id int) (*AuditChainHead, error) {
+	return c.Query().Where(auditchainhead.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditChainHeadClient) GetX(ctx context.Context, id int) *AuditChainHead {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditChainHeadClient) Hooks() []Hook {
+	return c.hooks.AuditChainHead
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditChainHeadClient) Interceptors() []Interceptor {
+	return c.inters.AuditChainHead
+}
+
+func (c *AuditChainHeadClient) mutate(ctx context.Context, m *AuditChainHeadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditChainHeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditChainHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditChainHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditChainHeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditChainHead mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -372,107 +599,107 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
-// DeploymentHistoryClient is a client for the DeploymentHistory schema.
-type DeploymentHistoryClient struct {
+// CertificateRevocationClient is a client for the CertificateRevocation schema.
+type CertificateRevocationClient struct {
 	config
 }
 
-// NewDeploymentHistoryClient returns a client for the DeploymentHistory from the given config.
-func NewDeploymentHistoryClient(c config) *DeploymentHistoryClient {
-	return &DeploymentHistoryClient{config: c}
+// NewCertificateRevocationClient returns a client for the CertificateRevocation from the given config.
+func NewCertificateRevocationClient(c config) *CertificateRevocationClient {
+	return &CertificateRevocationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deploymenthistory.Hooks(f(g(h())))`.
-func (c *DeploymentHistoryClient) Use(hooks ...Hook) {
-	c.hooks.DeploymentHistory = append(c.hooks.DeploymentHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `certificaterevocation.Hooks(f(g(h())))`.
+func (c *CertificateRevocationClient) Use(hooks ...Hook) {
+	c.hooks.CertificateRevocation = append(c.hooks.CertificateRevocation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deploymenthistory.Intercept(f(g(h())))`.
-func (c *DeploymentHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeploymentHistory = append(c.inters.DeploymentHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `certificaterevocation.Intercept(f(g(h())))`.
+func (c *CertificateRevocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertificateRevocation = append(c.inters.CertificateRevocation, interceptors...)
 }
 
-// Create returns a builder for creating a DeploymentHistory entity.
-func (c *DeploymentHistoryClient) Create() *DeploymentHistoryCreate {
-	mutation := newDeploymentHistoryMutation(c.config, OpCreate)
-	return &DeploymentHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CertificateRevocation entity.
+func (c *CertificateRevocationClient) Create() *CertificateRevocationCreate {
+	mutation := newCertificateRevocationMutation(c.config, OpCreate)
+	return &CertificateRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeploymentHistory entities.
-func (c *DeploymentHistoryClient) CreateBulk(builders ...*DeploymentHistoryCreate) *DeploymentHistoryCreateBulk {
-	return &DeploymentHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CertificateRevocation entities.
+func (c *CertificateRevocationClient) CreateBulk(builders ...*CertificateRevocationCreate) *CertificateRevocationCreateBulk {
+	return &CertificateRevocationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeploymentHistoryClient) MapCreateBulk(slice any, setFunc func(*DeploymentHistoryCreate, int)) *DeploymentHistoryCreateBulk {
+func (c *CertificateRevocationClient) MapCreateBulk(slice any, setFunc func(*CertificateRevocationCreate, int)) *CertificateRevocationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeploymentHistoryCreateBulk{err: fmt.Errorf("calling to DeploymentHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CertificateRevocationCreateBulk{err: fmt.Errorf("calling to CertificateRevocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeploymentHistoryCreate, rv.Len())
+	builders := make([]*CertificateRevocationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeploymentHistoryCreateBulk{config: c.config, builders: builders}
+	return &CertificateRevocationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeploymentHistory.
-func (c *DeploymentHistoryClient) Update() *DeploymentHistoryUpdate {
-	mutation := newDeploymentHistoryMutation(c.config, OpUpdate)
-	return &DeploymentHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CertificateRevocation.
+func (c *CertificateRevocationClient) Update() *CertificateRevocationUpdate {
+	mutation := newCertificateRevocationMutation(c.config, OpUpdate)
+	return &CertificateRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeploymentHistoryClient) UpdateOne(_m *DeploymentHistory) *DeploymentHistoryUpdateOne {
-	mutation := newDeploymentHistoryMutation(c.config, OpUpdateOne, withDeploymentHistory(_m))
-	return &DeploymentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CertificateRevocationClient) UpdateOne(_m *CertificateRevocation) *CertificateRevocationUpdateOne {
+	mutation := newCertificateRevocationMutation(c.config, OpUpdateOne, withCertificateRevocation(_m))
+	return &CertificateRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeploymentHistoryClient) UpdateOneID(id uint32) *DeploymentHistoryUpdateOne {
-	mutation := newDeploymentHistoryMutation(c.config, OpUpdateOne, withDeploymentHistoryID(id))
-	return &DeploymentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CertificateRevocationClient) UpdateOneID(id uint32) *CertificateRevocationUpdateOne {
+	mutation := newCertificateRevocationMutation(c.config, OpUpdateOne, withCertificateRevocationID(id))
+	return &CertificateRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeploymentHistory.
-func (c *DeploymentHistoryClient) Delete() *DeploymentHistoryDelete {
-	mutation := newDeploymentHistoryMutation(c.config, OpDelete)
-	return &DeploymentHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CertificateRevocation.
+func (c *CertificateRevocationClient) Delete() *CertificateRevocationDelete {
+	mutation := newCertificateRevocationMutation(c.config, OpDelete)
+	return &CertificateRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeploymentHistoryClient) DeleteOne(_m *DeploymentHistory) *DeploymentHistoryDeleteOne {
+func (c *CertificateRevocationClient) DeleteOne(_m *CertificateRevocation) *CertificateRevocationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeploymentHistoryClient) DeleteOneID(id uint32) *DeploymentHistoryDeleteOne {
-	builder := c.Delete().Where(deploymenthistory.ID(id))
+func (c *CertificateRevocationClient) DeleteOneID(id uint32) *CertificateRevocationDeleteOne {
+	builder := c.Delete().Where(certificaterevocation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeploymentHistoryDeleteOne{builder}
+	return &CertificateRevocationDeleteOne{builder}
 }
 
-// Query returns a query builder for DeploymentHistory.
-func (c *DeploymentHistoryClient) Query() *DeploymentHistoryQuery {
-	return &DeploymentHistoryQuery{
+// Query returns a query builder for CertificateRevocation.
+func (c *CertificateRevocationClient) Query() *CertificateRevocationQuery {
+	return &CertificateRevocationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeploymentHistory},
+		ctx:    &QueryContext{Type: TypeCertificateRevocation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeploymentHistory entity by its id.
-func (c *DeploymentHistoryClient) Get(ctx context.Context, id uint32) (*DeploymentHistory, error) {
-	return c.Query().Where(deploymenthistory.ID(id)).Only(ctx)
+// Get returns a CertificateRevocation entity by its id.
+func (c *CertificateRevocationClient) Get(ctx context.Context, id uint32) (*CertificateRevocation, error) {
+	return c.Query().Where(certificaterevocation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeploymentHistoryClient) GetX(ctx context.Context, id uint32) *DeploymentHistory {
+func (c *CertificateRevocationClient) GetX(ctx context.Context, id uint32) *CertificateRevocation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -480,148 +707,133 @@ func (c *DeploymentHistoryClient) GetX(ctx context.Context, id uint32) *Deployme
 	return obj
 }
 
-// QueryJob queries the job edge of a DeploymentHistory.
-func (c *DeploymentHistoryClient) QueryJob(_m *DeploymentHistory) *DeploymentJobQuery {
-	query := (&DeploymentJobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymenthistory.Table, deploymenthistory.FieldID, id),
-			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deploymenthistory.JobTable, deploymenthistory.JobColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DeploymentHistoryClient) Hooks() []Hook {
-	return c.hooks.DeploymentHistory
+func (c *CertificateRevocationClient) Hooks() []Hook {
+	hooks := c.hooks.CertificateRevocation
+	return append(hooks[:len(hooks):len(hooks)], certificaterevocation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeploymentHistoryClient) Interceptors() []Interceptor {
-	return c.inters.DeploymentHistory
+func (c *CertificateRevocationClient) Interceptors() []Interceptor {
+	return c.inters.CertificateRevocation
 }
 
-func (c *DeploymentHistoryClient) mutate(ctx context.Context, m *DeploymentHistoryMutation) (Value, error) {
+func (c *CertificateRevocationClient) mutate(ctx context.Context, m *CertificateRevocationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeploymentHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CertificateRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeploymentHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CertificateRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeploymentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CertificateRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeploymentHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CertificateRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeploymentHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CertificateRevocation mutation op: %q", m.Op())
 	}
 }
 
-// DeploymentJobClient is a client for the DeploymentJob schema.
-type DeploymentJobClient struct {
+// ChangeRecordClient is a client for the ChangeRecord schema.
+type ChangeRecordClient struct {
 	config
 }
 
-// NewDeploymentJobClient returns a client for the DeploymentJob from the given config.
-func NewDeploymentJobClient(c config) *DeploymentJobClient {
-	return &DeploymentJobClient{config: c}
+// NewChangeRecordClient returns a client for the ChangeRecord from the given config.
+func NewChangeRecordClient(c config) *ChangeRecordClient {
+	return &ChangeRecordClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deploymentjob.Hooks(f(g(h())))`.
-func (c *DeploymentJobClient) Use(hooks ...Hook) {
-	c.hooks.DeploymentJob = append(c.hooks.DeploymentJob, hooks...)
+// A call to `Use(f, g, h)` equals to `changerecord.Hooks(f(g(h())))`.
+func (c *ChangeRecordClient) Use(hooks ...Hook) {
+	c.hooks.ChangeRecord = append(c.hooks.ChangeRecord, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deploymentjob.Intercept(f(g(h())))`.
-func (c *DeploymentJobClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeploymentJob = append(c.inters.DeploymentJob, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `changerecord.Intercept(f(g(h())))`.
+func (c *ChangeRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChangeRecord = append(c.inters.ChangeRecord, interceptors...)
 }
 
-// Create returns a builder for creating a DeploymentJob entity.
-func (c *DeploymentJobClient) Create() *DeploymentJobCreate {
-	mutation := newDeploymentJobMutation(c.config, OpCreate)
-	return &DeploymentJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ChangeRecord entity.
+func (c *ChangeRecordClient) Create() *ChangeRecordCreate {
+	mutation := newChangeRecordMutation(c.config, OpCreate)
+	return &ChangeRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeploymentJob entities.
-func (c *DeploymentJobClient) CreateBulk(builders ...*DeploymentJobCreate) *DeploymentJobCreateBulk {
-	return &DeploymentJobCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ChangeRecord entities.
+func (c *ChangeRecordClient) CreateBulk(builders ...*ChangeRecordCreate) *ChangeRecordCreateBulk {
+	return &ChangeRecordCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeploymentJobClient) MapCreateBulk(slice any, setFunc func(*DeploymentJobCreate, int)) *DeploymentJobCreateBulk {
+func (c *ChangeRecordClient) MapCreateBulk(slice any, setFunc func(*ChangeRecordCreate, int)) *ChangeRecordCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeploymentJobCreateBulk{err: fmt.Errorf("calling to DeploymentJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChangeRecordCreateBulk{err: fmt.Errorf("calling to ChangeRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeploymentJobCreate, rv.Len())
+	builders := make([]*ChangeRecordCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeploymentJobCreateBulk{config: c.config, builders: builders}
+	return &ChangeRecordCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeploymentJob.
-func (c *DeploymentJobClient) Update() *DeploymentJobUpdate {
-	mutation := newDeploymentJobMutation(c.config, OpUpdate)
-	return &DeploymentJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ChangeRecord.
+func (c *ChangeRecordClient) Update() *ChangeRecordUpdate {
+	mutation := newChangeRecordMutation(c.config, OpUpdate)
+	return &ChangeRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeploymentJobClient) UpdateOne(_m *DeploymentJob) *DeploymentJobUpdateOne {
-	mutation := newDeploymentJobMutation(c.config, OpUpdateOne, withDeploymentJob(_m))
-	return &DeploymentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChangeRecordClient) UpdateOne(_m *ChangeRecord) *ChangeRecordUpdateOne {
+	mutation := newChangeRecordMutation(c.config, OpUpdateOne, withChangeRecord(_m))
+	return &ChangeRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeploymentJobClient) UpdateOneID(id string) *DeploymentJobUpdateOne {
-	mutation := newDeploymentJobMutation(c.config, OpUpdateOne, withDeploymentJobID(id))
-	return &DeploymentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChangeRecordClient) UpdateOneID(id uint32) *ChangeRecordUpdateOne {
+	mutation := newChangeRecordMutation(c.config, OpUpdateOne, withChangeRecordID(id))
+	return &ChangeRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeploymentJob.
-func (c *DeploymentJobClient) Delete() *DeploymentJobDelete {
-	mutation := newDeploymentJobMutation(c.config, OpDelete)
-	return &DeploymentJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ChangeRecord.
+func (c *ChangeRecordClient) Delete() *ChangeRecordDelete {
+	mutation := newChangeRecordMutation(c.config, OpDelete)
+	return &ChangeRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeploymentJobClient) DeleteOne(_m *DeploymentJob) *DeploymentJobDeleteOne {
+func (c *ChangeRecordClient) DeleteOne(_m *ChangeRecord) *ChangeRecordDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeploymentJobClient) DeleteOneID(id string) *DeploymentJobDeleteOne {
-	builder := c.Delete().Where(deploymentjob.ID(id))
+func (c *ChangeRecordClient) DeleteOneID(id uint32) *ChangeRecordDeleteOne {
+	builder := c.Delete().Where(changerecord.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeploymentJobDeleteOne{builder}
+	return &ChangeRecordDeleteOne{builder}
 }
 
-// Query returns a query builder for DeploymentJob.
-func (c *DeploymentJobClient) Query() *DeploymentJobQuery {
-	return &DeploymentJobQuery{
+// Query returns a query builder for ChangeRecord.
+func (c *ChangeRecordClient) Query() *ChangeRecordQuery {
+	return &ChangeRecordQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeploymentJob},
+		ctx:    &QueryContext{Type: TypeChangeRecord},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeploymentJob entity by its id.
-func (c *DeploymentJobClient) Get(ctx context.Context, id string) (*DeploymentJob, error) {
-	return c.Query().Where(deploymentjob.ID(id)).Only(ctx)
+// Get returns a ChangeRecord entity by its id.
+func (c *ChangeRecordClient) Get(ctx context.Context, id uint32) (*ChangeRecord, error) {
+	return c.Query().Where(changerecord.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeploymentJobClient) GetX(ctx context.Context, id string) *DeploymentJob {
+func (c *ChangeRecordClient) GetX(ctx context.Context, id uint32) *ChangeRecord {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -629,213 +841,1465 @@ func (c *DeploymentJobClient) GetX(ctx context.Context, id string) *DeploymentJo
 	return obj
 }
 
-// QueryDeploymentTarget queries the deployment_target edge of a DeploymentJob.
-func (c *DeploymentJobClient) QueryDeploymentTarget(_m *DeploymentJob) *DeploymentTargetQuery {
-	query := (&DeploymentTargetClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
-			sqlgraph.To(deploymenttarget.Table, deploymenttarget.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deploymentjob.DeploymentTargetTable, deploymentjob.DeploymentTargetColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *ChangeRecordClient) Hooks() []Hook {
+	hooks := c.hooks.ChangeRecord
+	return append(hooks[:len(hooks):len(hooks)], changerecord.Hooks[:]...)
 }
 
-// QueryTargetConfiguration queries the target_configuration edge of a DeploymentJob.
-func (c *DeploymentJobClient) QueryTargetConfiguration(_m *DeploymentJob) *TargetConfigurationQuery {
-	query := (&TargetConfigurationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
-			sqlgraph.To(targetconfiguration.Table, targetconfiguration.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deploymentjob.TargetConfigurationTable, deploymentjob.TargetConfigurationColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *ChangeRecordClient) Interceptors() []Interceptor {
+	return c.inters.ChangeRecord
 }
 
-// QueryParentJob queries the parent_job edge of a DeploymentJob.
-func (c *DeploymentJobClient) QueryParentJob(_m *DeploymentJob) *DeploymentJobQuery {
-	query := (&DeploymentJobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
-			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deploymentjob.ParentJobTable, deploymentjob.ParentJobColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+func (c *ChangeRecordClient) mutate(ctx context.Context, m *ChangeRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChangeRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChangeRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChangeRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChangeRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChangeRecord mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryChildJobs queries the child_jobs edge of a DeploymentJob.
-func (c *DeploymentJobClient) QueryChildJobs(_m *DeploymentJob) *DeploymentJobQuery {
-	query := (&DeploymentJobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
-			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
+// ConfigurationRevisionClient is a client for the ConfigurationRevision schema.
+type ConfigurationRevisionClient struct {
+	config
+}
+
+// NewConfigurationRevisionClient returns a client for the ConfigurationRevision from the given config.
+func NewConfigurationRevisionClient(c config) *ConfigurationRevisionClient {
+	return &ConfigurationRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configurationrevision.Hooks(f(g(h())))`.
+func (c *ConfigurationRevisionClient) Use(hooks ...Hook) {
+	c.hooks.ConfigurationRevision = append(c.hooks.ConfigurationRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configurationrevision.Intercept(f(g(h())))`.
+func (c *ConfigurationRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigurationRevision = append(c.inters.ConfigurationRevision, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigurationRevision entity.
+func (c *ConfigurationRevisionClient) Create() *ConfigurationRevisionCreate {
+	mutation := newConfigurationRevisionMutation(c.config, OpCreate)
+	return &ConfigurationRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigurationRevision entities.
+func (c *ConfigurationRevisionClient) CreateBulk(builders ...*ConfigurationRevisionCreate) *ConfigurationRevisionCreateBulk {
+	return &ConfigurationRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigurationRevisionClient) MapCreateBulk(slice any, setFunc func(*ConfigurationRevisionCreate, int)) *ConfigurationRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigurationRevisionCreateBulk{err: fmt.Errorf("calling to ConfigurationRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigurationRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigurationRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigurationRevision.
+func (c *ConfigurationRevisionClient) Update() *ConfigurationRevisionUpdate {
+	mutation := newConfigurationRevisionMutation(c.config, OpUpdate)
+	return &ConfigurationRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigurationRevisionClient) UpdateOne(_m *ConfigurationRevision) *ConfigurationRevisionUpdateOne {
+	mutation := newConfigurationRevisionMutation(c.config, OpUpdateOne, withConfigurationRevision(_m))
+	return &ConfigurationRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigurationRevisionClient) UpdateOneID(id uint32) *ConfigurationRevisionUpdateOne {
+	mutation := newConfigurationRevisionMutation(c.config, OpUpdateOne, withConfigurationRevisionID(id))
+	return &ConfigurationRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigurationRevision.
+func (c *ConfigurationRevisionClient) Delete() *ConfigurationRevisionDelete {
+	mutation := newConfigurationRevisionMutation(c.config, OpDelete)
+	return &ConfigurationRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigurationRevisionClient) DeleteOne(_m *ConfigurationRevision) *ConfigurationRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigurationRevisionClient) DeleteOneID(id uint32) *ConfigurationRevisionDeleteOne {
+	builder := c.Delete().Where(configurationrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigurationRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigurationRevision.
+func (c *ConfigurationRevisionClient) Query() *ConfigurationRevisionQuery {
+	return &ConfigurationRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigurationRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigurationRevision entity by its id.
+func (c *ConfigurationRevisionClient) Get(ctx context.Context, id uint32) (*ConfigurationRevision, error) {
+	return c.Query().Where(configurationrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigurationRevisionClient) GetX(ctx context.Context, id uint32) *ConfigurationRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigurationRevisionClient) Hooks() []Hook {
+	hooks := c.hooks.ConfigurationRevision
+	return append(hooks[:len(hooks):len(hooks)], configurationrevision.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigurationRevisionClient) Interceptors() []Interceptor {
+	return c.inters.ConfigurationRevision
+}
+
+func (c *ConfigurationRevisionClient) mutate(ctx context.Context, m *ConfigurationRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigurationRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigurationRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigurationRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigurationRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfigurationRevision mutation op: %q", m.Op())
+	}
+}
+
+// DeployLockClient is a client for the DeployLock schema.
+type DeployLockClient struct {
+	config
+}
+
+// NewDeployLockClient returns a client for the DeployLock from the given config.
+func NewDeployLockClient(c config) *DeployLockClient {
+	return &DeployLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deploylock.Hooks(f(g(h())))`.
+func (c *DeployLockClient) Use(hooks ...Hook) {
+	c.hooks.DeployLock = append(c.hooks.DeployLock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deploylock.Intercept(f(g(h())))`.
+func (c *DeployLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeployLock = append(c.inters.DeployLock, interceptors...)
+}
+
+// Create returns a builder for creating a DeployLock entity.
+func (c *DeployLockClient) Create() *DeployLockCreate {
+	mutation := newDeployLockMutation(c.config, OpCreate)
+	return &DeployLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeployLock entities.
+func (c *DeployLockClient) CreateBulk(builders ...*DeployLockCreate) *DeployLockCreateBulk {
+	return &DeployLockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeployLockClient) MapCreateBulk(slice any, setFunc func(*DeployLockCreate, int)) *DeployLockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeployLockCreateBulk{err: fmt.Errorf("calling to DeployLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeployLockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeployLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeployLock.
+func (c *DeployLockClient) Update() *DeployLockUpdate {
+	mutation := newDeployLockMutation(c.config, OpUpdate)
+	return &DeployLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeployLockClient) UpdateOne(_m *DeployLock) *DeployLockUpdateOne {
+	mutation := newDeployLockMutation(c.config, OpUpdateOne, withDeployLock(_m))
+	return &DeployLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeployLockClient) UpdateOneID(id string) *DeployLockUpdateOne {
+	mutation := newDeployLockMutation(c.config, OpUpdateOne, withDeployLockID(id))
+	return &DeployLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeployLock.
+func (c *DeployLockClient) Delete() *DeployLockDelete {
+	mutation := newDeployLockMutation(c.config, OpDelete)
+	return &DeployLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeployLockClient) DeleteOne(_m *DeployLock) *DeployLockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeployLockClient) DeleteOneID(id string) *DeployLockDeleteOne {
+	builder := c.Delete().Where(deploylock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeployLockDeleteOne{builder}
+}
+
+// Query returns a query builder for DeployLock.
+func (c *DeployLockClient) Query() *DeployLockQuery {
+	return &DeployLockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeployLock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeployLock entity by its id.
+func (c *DeployLockClient) Get(ctx context.Context, id string) (*DeployLock, error) {
+	return c.Query().Where(deploylock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeployLockClient) GetX(ctx context.Context, id string) *DeployLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeployLockClient) Hooks() []Hook {
+	return c.hooks.DeployLock
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeployLockClient) Interceptors() []Interceptor {
+	return c.inters.DeployLock
+}
+
+func (c *DeployLockClient) mutate(ctx context.Context, m *DeployLockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeployLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeployLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeployLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeployLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeployLock mutation op: %q", m.Op())
+	}
+}
+
+// DeploymentHistoryClient is a client for the DeploymentHistory schema.
+type DeploymentHistoryClient struct {
+	config
+}
+
+// NewDeploymentHistoryClient returns a client for the DeploymentHistory from the given config.
+func NewDeploymentHistoryClient(c config) *DeploymentHistoryClient {
+	return &DeploymentHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deploymenthistory.Hooks(f(g(h())))`.
+func (c *DeploymentHistoryClient) Use(hooks ...Hook) {
+	c.hooks.DeploymentHistory = append(c.hooks.DeploymentHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deploymenthistory.Intercept(f(g(h())))`.
+func (c *DeploymentHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeploymentHistory = append(c.inters.DeploymentHistory, interceptors...)
+}
+
+// Create returns a builder for creating a DeploymentHistory entity.
+func (c *DeploymentHistoryClient) Create() *DeploymentHistoryCreate {
+	mutation := newDeploymentHistoryMutation(c.config, OpCreate)
+	return &DeploymentHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeploymentHistory entities.
+func (c *DeploymentHistoryClient) CreateBulk(builders ...*DeploymentHistoryCreate) *DeploymentHistoryCreateBulk {
+	return &DeploymentHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeploymentHistoryClient) MapCreateBulk(slice any, setFunc func(*DeploymentHistoryCreate, int)) *DeploymentHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeploymentHistoryCreateBulk{err: fmt.Errorf("calling to DeploymentHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeploymentHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeploymentHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeploymentHistory.
+func (c *DeploymentHistoryClient) Update() *DeploymentHistoryUpdate {
+	mutation := newDeploymentHistoryMutation(c.config, OpUpdate)
+	return &DeploymentHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeploymentHistoryClient) UpdateOne(_m *DeploymentHistory) *DeploymentHistoryUpdateOne {
+	mutation := newDeploymentHistoryMutation(c.config, OpUpdateOne, withDeploymentHistory(_m))
+	return &DeploymentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeploymentHistoryClient) UpdateOneID(id uint32) *DeploymentHistoryUpdateOne {
+	mutation := newDeploymentHistoryMutation(c.config, OpUpdateOne, withDeploymentHistoryID(id))
+	return &DeploymentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeploymentHistory.
+func (c *DeploymentHistoryClient) Delete() *DeploymentHistoryDelete {
+	mutation := newDeploymentHistoryMutation(c.config, OpDelete)
+	return &DeploymentHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeploymentHistoryClient) DeleteOne(_m *DeploymentHistory) *DeploymentHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeploymentHistoryClient) DeleteOneID(id uint32) *DeploymentHistoryDeleteOne {
+	builder := c.Delete().Where(deploymenthistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeploymentHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for DeploymentHistory.
+func (c *DeploymentHistoryClient) Query() *DeploymentHistoryQuery {
+	return &DeploymentHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeploymentHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeploymentHistory entity by its id.
+func (c *DeploymentHistoryClient) Get(ctx context.Context, id uint32) (*DeploymentHistory, error) {
+	return c.Query().Where(deploymenthistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeploymentHistoryClient) GetX(ctx context.Context, id uint32) *DeploymentHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJob queries the job edge of a DeploymentHistory.
+func (c *DeploymentHistoryClient) QueryJob(_m *DeploymentHistory) *DeploymentJobQuery {
+	query := (&DeploymentJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymenthistory.Table, deploymenthistory.FieldID, id),
+			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymenthistory.JobTable, deploymenthistory.JobColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeploymentHistoryClient) Hooks() []Hook {
+	return c.hooks.DeploymentHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeploymentHistoryClient) Interceptors() []Interceptor {
+	return c.inters.DeploymentHistory
+}
+
+func (c *DeploymentHistoryClient) mutate(ctx context.Context, m *DeploymentHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeploymentHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeploymentHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeploymentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeploymentHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeploymentHistory mutation op: %q", m.Op())
+	}
+}
+
+// DeploymentJobClient is a client for the DeploymentJob schema.
+type DeploymentJobClient struct {
+	config
+}
+
+// NewDeploymentJobClient returns a client for the DeploymentJob from the given config.
+func NewDeploymentJobClient(c config) *DeploymentJobClient {
+	return &DeploymentJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deploymentjob.Hooks(f(g(h())))`.
+func (c *DeploymentJobClient) Use(hooks ...Hook) {
+	c.hooks.DeploymentJob = append(c.hooks.DeploymentJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deploymentjob.Intercept(f(g(h())))`.
+func (c *DeploymentJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeploymentJob = append(c.inters.DeploymentJob, interceptors...)
+}
+
+// Create returns a builder for creating a DeploymentJob entity.
+func (c *DeploymentJobClient) Create() *DeploymentJobCreate {
+	mutation := newDeploymentJobMutation(c.config, OpCreate)
+	return &DeploymentJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeploymentJob entities.
+func (c *DeploymentJobClient) CreateBulk(builders ...*DeploymentJobCreate) *DeploymentJobCreateBulk {
+	return &DeploymentJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeploymentJobClient) MapCreateBulk(slice any, setFunc func(*DeploymentJobCreate, int)) *DeploymentJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeploymentJobCreateBulk{err: fmt.Errorf("calling to DeploymentJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeploymentJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeploymentJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeploymentJob.
+func (c *DeploymentJobClient) Update() *DeploymentJobUpdate {
+	mutation := newDeploymentJobMutation(c.config, OpUpdate)
+	return &DeploymentJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeploymentJobClient) UpdateOne(_m *DeploymentJob) *DeploymentJobUpdateOne {
+	mutation := newDeploymentJobMutation(c.config, OpUpdateOne, withDeploymentJob(_m))
+	return &DeploymentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeploymentJobClient) UpdateOneID(id string) *DeploymentJobUpdateOne {
+	mutation := newDeploymentJobMutation(c.config, OpUpdateOne, withDeploymentJobID(id))
+	return &DeploymentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeploymentJob.
+func (c *DeploymentJobClient) Delete() *DeploymentJobDelete {
+	mutation := newDeploymentJobMutation(c.config, OpDelete)
+	return &DeploymentJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeploymentJobClient) DeleteOne(_m *DeploymentJob) *DeploymentJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeploymentJobClient) DeleteOneID(id string) *DeploymentJobDeleteOne {
+	builder := c.Delete().Where(deploymentjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeploymentJobDeleteOne{builder}
+}
+
+// Query returns a query builder for DeploymentJob.
+func (c *DeploymentJobClient) Query() *DeploymentJobQuery {
+	return &DeploymentJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeploymentJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeploymentJob entity by its id.
+func (c *DeploymentJobClient) Get(ctx context.Context, id string) (*DeploymentJob, error) {
+	return c.Query().Where(deploymentjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeploymentJobClient) GetX(ctx context.Context, id string) *DeploymentJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeploymentTarget queries the deployment_target edge of a DeploymentJob.
+func (c *DeploymentJobClient) QueryDeploymentTarget(_m *DeploymentJob) *DeploymentTargetQuery {
+	query := (&DeploymentTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
+			sqlgraph.To(deploymenttarget.Table, deploymenttarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymentjob.DeploymentTargetTable, deploymentjob.DeploymentTargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetConfiguration queries the target_configuration edge of a DeploymentJob.
+func (c *DeploymentJobClient) QueryTargetConfiguration(_m *DeploymentJob) *TargetConfigurationQuery {
+	query := (&TargetConfigurationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
+			sqlgraph.To(targetconfiguration.Table, targetconfiguration.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymentjob.TargetConfigurationTable, deploymentjob.TargetConfigurationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentJob queries the parent_job edge of a DeploymentJob.
+func (c *DeploymentJobClient) QueryParentJob(_m *DeploymentJob) *DeploymentJobQuery {
+	query := (&DeploymentJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
+			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymentjob.ParentJobTable, deploymentjob.ParentJobColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildJobs queries the child_jobs edge of a DeploymentJob.
+func (c *DeploymentJobClient) QueryChildJobs(_m *DeploymentJob) *DeploymentJobQuery {
+	query := (&DeploymentJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
+			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, deploymentjob.ChildJobsTable, deploymentjob.ChildJobsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// QueryHistory queries the history edge of a DeploymentJob.
+func (c *DeploymentJobClient) QueryHistory(_m *DeploymentJob) *DeploymentHistoryQuery {
+	query := (&DeploymentHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
+			sqlgraph.To(deploymenthistory.Table, deploymenthistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deploymentjob.HistoryTable, deploymentjob.HistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeploymentJobClient) Hooks() []Hook {
+	hooks := c.hooks.DeploymentJob
+	return append(hooks[:len(hooks):len(hooks)], deploymentjob.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeploymentJobClient) Interceptors() []Interceptor {
+	return c.inters.DeploymentJob
+}
+
+func (c *DeploymentJobClient) mutate(ctx context.Context, m *DeploymentJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeploymentJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeploymentJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeploymentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeploymentJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeploymentJob mutation op: %q", m.Op())
+	}
+}
+
+// DeploymentTargetClient is a client for the DeploymentTarget schema.
+type DeploymentTargetClient struct {
+	config
+}
+
+// NewDeploymentTargetClient returns a client for the DeploymentTarget from the given config.
+func NewDeploymentTargetClient(c config) *DeploymentTargetClient {
+	return &DeploymentTargetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deploymenttarget.Hooks(f(g(h())))`.
+func (c *DeploymentTargetClient) Use(hooks ...Hook) {
+	c.hooks.DeploymentTarget = append(c.hooks.DeploymentTarget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deploymenttarget.Intercept(f(g(h())))`.
+func (c *DeploymentTargetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeploymentTarget = append(c.inters.DeploymentTarget, interceptors...)
+}
+
+// Create returns a builder for creating a DeploymentTarget entity.
+func (c *DeploymentTargetClient) Create() *DeploymentTargetCreate {
+	mutation := newDeploymentTargetMutation(c.config, OpCreate)
+	return &DeploymentTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeploymentTarget entities.
+func (c *DeploymentTargetClient) CreateBulk(builders ...*DeploymentTargetCreate) *DeploymentTargetCreateBulk {
+	return &DeploymentTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeploymentTargetClient) MapCreateBulk(slice any, setFunc func(*DeploymentTargetCreate, int)) *DeploymentTargetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeploymentTargetCreateBulk{err: fmt.Errorf("calling to DeploymentTargetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeploymentTargetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeploymentTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeploymentTarget.
+func (c *DeploymentTargetClient) Update() *DeploymentTargetUpdate {
+	mutation := newDeploymentTargetMutation(c.config, OpUpdate)
+	return &DeploymentTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeploymentTargetClient) UpdateOne(_m *DeploymentTarget) *DeploymentTargetUpdateOne {
+	mutation := newDeploymentTargetMutation(c.config, OpUpdateOne, withDeploymentTarget(_m))
+	return &DeploymentTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeploymentTargetClient) UpdateOneID(id string) *DeploymentTargetUpdateOne {
+	mutation := newDeploymentTargetMutation(c.config, OpUpdateOne, withDeploymentTargetID(id))
+	return &DeploymentTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeploymentTarget.
+func (c *DeploymentTargetClient) Delete() *DeploymentTargetDelete {
+	mutation := newDeploymentTargetMutation(c.config, OpDelete)
+	return &DeploymentTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeploymentTargetClient) DeleteOne(_m *DeploymentTarget) *DeploymentTargetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeploymentTargetClient) DeleteOneID(id string) *DeploymentTargetDeleteOne {
+	builder := c.Delete().Where(deploymenttarget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeploymentTargetDeleteOne{builder}
+}
+
+// Query returns a query builder for DeploymentTarget.
+func (c *DeploymentTargetClient) Query() *DeploymentTargetQuery {
+	return &DeploymentTargetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeploymentTarget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeploymentTarget entity by its id.
+func (c *DeploymentTargetClient) Get(ctx context.Context, id string) (*DeploymentTarget, error) {
+	return c.Query().Where(deploymenttarget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeploymentTargetClient) GetX(ctx context.Context, id string) *DeploymentTarget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConfigurations queries the configurations edge of a DeploymentTarget.
+func (c *DeploymentTargetClient) QueryConfigurations(_m *DeploymentTarget) *TargetConfigurationQuery {
+	query := (&TargetConfigurationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymenttarget.Table, deploymenttarget.FieldID, id),
+			sqlgraph.To(targetconfiguration.Table, targetconfiguration.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, deploymenttarget.ConfigurationsTable, deploymenttarget.ConfigurationsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a DeploymentTarget.
+func (c *DeploymentTargetClient) QueryJobs(_m *DeploymentTarget) *DeploymentJobQuery {
+	query := (&DeploymentJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymenttarget.Table, deploymenttarget.FieldID, id),
+			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deploymenttarget.JobsTable, deploymenttarget.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeploymentTargetClient) Hooks() []Hook {
+	hooks := c.hooks.DeploymentTarget
+	return append(hooks[:len(hooks):len(hooks)], deploymenttarget.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeploymentTargetClient) Interceptors() []Interceptor {
+	return c.inters.DeploymentTarget
+}
+
+func (c *DeploymentTargetClient) mutate(ctx context.Context, m *DeploymentTargetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeploymentTargetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeploymentTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeploymentTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeploymentTargetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeploymentTarget mutation op: %q", m.Op())
+	}
+}
+
+// DriftEventClient is a client for the DriftEvent schema.
+type DriftEventClient struct {
+	config
+}
+
+// NewDriftEventClient returns a client for the DriftEvent from the given config.
+func NewDriftEventClient(c config) *DriftEventClient {
+	return &DriftEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `driftevent.Hooks(f(g(h())))`.
+func (c *DriftEventClient) Use(hooks ...Hook) {
+	c.hooks.DriftEvent = append(c.hooks.DriftEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `driftevent.Intercept(f(g(h())))`.
+func (c *DriftEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DriftEvent = append(c.inters.DriftEvent, interceptors...)
+}
+
+// Create returns a builder for creating a DriftEvent entity.
+func (c *DriftEventClient) Create() *DriftEventCreate {
+	mutation := newDriftEventMutation(c.config, OpCreate)
+	return &DriftEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DriftEvent entities.
+func (c *DriftEventClient) CreateBulk(builders ...*DriftEventCreate) *DriftEventCreateBulk {
+	return &DriftEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DriftEventClient) MapCreateBulk(slice any, setFunc func(*DriftEventCreate, int)) *DriftEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DriftEventCreateBulk{err: fmt.Errorf("calling to DriftEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DriftEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DriftEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DriftEvent.
+func (c *DriftEventClient) Update() *DriftEventUpdate {
+	mutation := newDriftEventMutation(c.config, OpUpdate)
+	return &DriftEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DriftEventClient) UpdateOne(_m *DriftEvent) *DriftEventUpdateOne {
+	mutation := newDriftEventMutation(c.config, OpUpdateOne, withDriftEvent(_m))
+	return &DriftEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DriftEventClient) UpdateOneID(id uint32) *DriftEventUpdateOne {
+	mutation := newDriftEventMutation(c.config, OpUpdateOne, withDriftEventID(id))
+	return &DriftEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DriftEvent.
+func (c *DriftEventClient) Delete() *DriftEventDelete {
+	mutation := newDriftEventMutation(c.config, OpDelete)
+	return &DriftEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DriftEventClient) DeleteOne(_m *DriftEvent) *DriftEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DriftEventClient) DeleteOneID(id uint32) *DriftEventDeleteOne {
+	builder := c.Delete().Where(driftevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DriftEventDeleteOne{builder}
+}
+
+// Query returns a query builder for DriftEvent.
+func (c *DriftEventClient) Query() *DriftEventQuery {
+	return &DriftEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDriftEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DriftEvent entity by its id.
+func (c *DriftEventClient) Get(ctx context.Context, id uint32) (*DriftEvent, error) {
+	return c.Query().Where(driftevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DriftEventClient) GetX(ctx context.Context, id uint32) *DriftEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DriftEventClient) Hooks() []Hook {
+	hooks := c.hooks.DriftEvent
+	return append(hooks[:len(hooks):len(hooks)], driftevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DriftEventClient) Interceptors() []Interceptor {
+	return c.inters.DriftEvent
+}
+
+func (c *DriftEventClient) mutate(ctx context.Context, m *DriftEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DriftEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DriftEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DriftEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DriftEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DriftEvent mutation op: %q", m.Op())
+	}
+}
+
+// ExternalCertificateClient is a client for the ExternalCertificate schema.
+type ExternalCertificateClient struct {
+	config
+}
+
+// NewExternalCertificateClient returns a client for the ExternalCertificate from the given config.
+func NewExternalCertificateClient(c config) *ExternalCertificateClient {
+	return &ExternalCertificateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `externalcertificate.Hooks(f(g(h())))`.
+func (c *ExternalCertificateClient) Use(hooks ...Hook) {
+	c.hooks.ExternalCertificate = append(c.hooks.ExternalCertificate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `externalcertificate.Intercept(f(g(h())))`.
+func (c *ExternalCertificateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExternalCertificate = append(c.inters.ExternalCertificate, interceptors...)
+}
+
+// Create returns a builder for creating a ExternalCertificate entity.
+func (c *ExternalCertificateClient) Create() *ExternalCertificateCreate {
+	mutation := newExternalCertificateMutation(c.config, OpCreate)
+	return &ExternalCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExternalCertificate entities.
+func (c *ExternalCertificateClient) CreateBulk(builders ...*ExternalCertificateCreate) *ExternalCertificateCreateBulk {
+	return &ExternalCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExternalCertificateClient) MapCreateBulk(slice any, setFunc func(*ExternalCertificateCreate, int)) *ExternalCertificateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExternalCertificateCreateBulk{err: fmt.Errorf("calling to ExternalCertificateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExternalCertificateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExternalCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExternalCertificate.
+func (c *ExternalCertificateClient) Update() *ExternalCertificateUpdate {
+	mutation := newExternalCertificateMutation(c.config, OpUpdate)
+	return &ExternalCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExternalCertificateClient) UpdateOne(_m *ExternalCertificate) *ExternalCertificateUpdateOne {
+	mutation := newExternalCertificateMutation(c.config, OpUpdateOne, withExternalCertificate(_m))
+	return &ExternalCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExternalCertificateClient) UpdateOneID(id uint32) *ExternalCertificateUpdateOne {
+	mutation := newExternalCertificateMutation(c.config, OpUpdateOne, withExternalCertificateID(id))
+	return &ExternalCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExternalCertificate.
+func (c *ExternalCertificateClient) Delete() *ExternalCertificateDelete {
+	mutation := newExternalCertificateMutation(c.config, OpDelete)
+	return &ExternalCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExternalCertificateClient) DeleteOne(_m *ExternalCertificate) *ExternalCertificateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExternalCertificateClient) DeleteOneID(id uint32) *ExternalCertificateDeleteOne {
+	builder := c.Delete().Where(externalcertificate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExternalCertificateDeleteOne{builder}
+}
+
+// Query returns a query builder for ExternalCertificate.
+func (c *ExternalCertificateClient) Query() *ExternalCertificateQuery {
+	return &ExternalCertificateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExternalCertificate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExternalCertificate entity by its id.
+func (c *ExternalCertificateClient) Get(ctx context.Context, id uint32) (*ExternalCertificate, error) {
+	return c.Query().Where(externalcertificate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExternalCertificateClient) GetX(ctx context.Context, id uint32) *ExternalCertificate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExternalCertificateClient) Hooks() []Hook {
+	hooks := c.hooks.ExternalCertificate
+	return append(hooks[:len(hooks):len(hooks)], externalcertificate.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExternalCertificateClient) Interceptors() []Interceptor {
+	return c.inters.ExternalCertificate
+}
+
+func (c *ExternalCertificateClient) mutate(ctx context.Context, m *ExternalCertificateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExternalCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExternalCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExternalCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExternalCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExternalCertificate mutation op: %q", m.Op())
+	}
+}
+
+// InventorySnapshotClient is a client for the InventorySnapshot schema.
+type InventorySnapshotClient struct {
+	config
+}
+
+// NewInventorySnapshotClient returns a client for the InventorySnapshot from the given config.
+func NewInventorySnapshotClient(c config) *InventorySnapshotClient {
+	return &InventorySnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventorysnapshot.Hooks(f(g(h())))`.
+func (c *InventorySnapshotClient) Use(hooks ...Hook) {
+	c.hooks.InventorySnapshot = append(c.hooks.InventorySnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inventorysnapshot.Intercept(f(g(h())))`.
+func (c *InventorySnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InventorySnapshot = append(c.inters.InventorySnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a InventorySnapshot entity.
+func (c *InventorySnapshotClient) Create() *InventorySnapshotCreate {
+	mutation := newInventorySnapshotMutation(c.config, OpCreate)
+	return &InventorySnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventorySnapshot entities.
+func (c *InventorySnapshotClient) CreateBulk(builders ...*InventorySnapshotCreate) *InventorySnapshotCreateBulk {
+	return &InventorySnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InventorySnapshotClient) MapCreateBulk(slice any, setFunc func(*InventorySnapshotCreate, int)) *InventorySnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InventorySnapshotCreateBulk{err: fmt.Errorf("calling to InventorySnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InventorySnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InventorySnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventorySnapshot.
+func (c *InventorySnapshotClient) Update() *InventorySnapshotUpdate {
+	mutation := newInventorySnapshotMutation(c.config, OpUpdate)
+	return &InventorySnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventorySnapshotClient) UpdateOne(_m *InventorySnapshot) *InventorySnapshotUpdateOne {
+	mutation := newInventorySnapshotMutation(c.config, OpUpdateOne, withInventorySnapshot(_m))
+	return &InventorySnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventorySnapshotClient) UpdateOneID(id uint32) *InventorySnapshotUpdateOne {
+	mutation := newInventorySnapshotMutation(c.config, OpUpdateOne, withInventorySnapshotID(id))
+	return &InventorySnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventorySnapshot.
+func (c *InventorySnapshotClient) Delete() *InventorySnapshotDelete {
+	mutation := newInventorySnapshotMutation(c.config, OpDelete)
+	return &InventorySnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventorySnapshotClient) DeleteOne(_m *InventorySnapshot) *InventorySnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InventorySnapshotClient) DeleteOneID(id uint32) *InventorySnapshotDeleteOne {
+	builder := c.Delete().Where(inventorysnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventorySnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for InventorySnapshot.
+func (c *InventorySnapshotClient) Query() *InventorySnapshotQuery {
+	return &InventorySnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInventorySnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InventorySnapshot entity by its id.
+func (c *InventorySnapshotClient) Get(ctx context.Context, id uint32) (*InventorySnapshot, error) {
+	return c.Query().Where(inventorysnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventorySnapshotClient) GetX(ctx context.Context, id uint32) *InventorySnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// QueryHistory queries the history edge of a DeploymentJob.
-func (c *DeploymentJobClient) QueryHistory(_m *DeploymentJob) *DeploymentHistoryQuery {
-	query := (&DeploymentHistoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymentjob.Table, deploymentjob.FieldID, id),
-			sqlgraph.To(deploymenthistory.Table, deploymenthistory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, deploymentjob.HistoryTable, deploymentjob.HistoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *InventorySnapshotClient) Hooks() []Hook {
+	hooks := c.hooks.InventorySnapshot
+	return append(hooks[:len(hooks):len(hooks)], inventorysnapshot.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InventorySnapshotClient) Interceptors() []Interceptor {
+	return c.inters.InventorySnapshot
+}
+
+func (c *InventorySnapshotClient) mutate(ctx context.Context, m *InventorySnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InventorySnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InventorySnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InventorySnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InventorySnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InventorySnapshot mutation op: %q", m.Op())
 	}
-	return query
+}
+
+// NotificationRuleClient is a client for the NotificationRule schema.
+type NotificationRuleClient struct {
+	config
+}
+
+// NewNotificationRuleClient returns a client for the NotificationRule from the given config.
+func NewNotificationRuleClient(c config) *NotificationRuleClient {
+	return &NotificationRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationrule.Hooks(f(g(h())))`.
+func (c *NotificationRuleClient) Use(hooks ...Hook) {
+	c.hooks.NotificationRule = append(c.hooks.NotificationRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationrule.Intercept(f(g(h())))`.
+func (c *NotificationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationRule = append(c.inters.NotificationRule, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationRule entity.
+func (c *NotificationRuleClient) Create() *NotificationRuleCreate {
+	mutation := newNotificationRuleMutation(c.config, OpCreate)
+	return &NotificationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationRule entities.
+func (c *NotificationRuleClient) CreateBulk(builders ...*NotificationRuleCreate) *NotificationRuleCreateBulk {
+	return &NotificationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationRuleClient) MapCreateBulk(slice any, setFunc func(*NotificationRuleCreate, int)) *NotificationRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationRuleCreateBulk{err: fmt.Errorf("calling to NotificationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationRule.
+func (c *NotificationRuleClient) Update() *NotificationRuleUpdate {
+	mutation := newNotificationRuleMutation(c.config, OpUpdate)
+	return &NotificationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationRuleClient) UpdateOne(_m *NotificationRule) *NotificationRuleUpdateOne {
+	mutation := newNotificationRuleMutation(c.config, OpUpdateOne, withNotificationRule(_m))
+	return &NotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationRuleClient) UpdateOneID(id uint32) *NotificationRuleUpdateOne {
+	mutation := newNotificationRuleMutation(c.config, OpUpdateOne, withNotificationRuleID(id))
+	return &NotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationRule.
+func (c *NotificationRuleClient) Delete() *NotificationRuleDelete {
+	mutation := newNotificationRuleMutation(c.config, OpDelete)
+	return &NotificationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationRuleClient) DeleteOne(_m *NotificationRule) *NotificationRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationRuleClient) DeleteOneID(id uint32) *NotificationRuleDeleteOne {
+	builder := c.Delete().Where(notificationrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationRule.
+func (c *NotificationRuleClient) Query() *NotificationRuleQuery {
+	return &NotificationRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationRule entity by its id.
+func (c *NotificationRuleClient) Get(ctx context.Context, id uint32) (*NotificationRule, error) {
+	return c.Query().Where(notificationrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationRuleClient) GetX(ctx context.Context, id uint32) *NotificationRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *DeploymentJobClient) Hooks() []Hook {
-	hooks := c.hooks.DeploymentJob
-	return append(hooks[:len(hooks):len(hooks)], deploymentjob.Hooks[:]...)
+func (c *NotificationRuleClient) Hooks() []Hook {
+	hooks := c.hooks.NotificationRule
+	return append(hooks[:len(hooks):len(hooks)], notificationrule.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeploymentJobClient) Interceptors() []Interceptor {
-	return c.inters.DeploymentJob
+func (c *NotificationRuleClient) Interceptors() []Interceptor {
+	return c.inters.NotificationRule
 }
 
-func (c *DeploymentJobClient) mutate(ctx context.Context, m *DeploymentJobMutation) (Value, error) {
+func (c *NotificationRuleClient) mutate(ctx context.Context, m *NotificationRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeploymentJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeploymentJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeploymentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeploymentJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeploymentJob mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NotificationRule mutation op: %q", m.Op())
 	}
 }
 
-// DeploymentTargetClient is a client for the DeploymentTarget schema.
-type DeploymentTargetClient struct {
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
 	config
 }
 
-// NewDeploymentTargetClient returns a client for the DeploymentTarget from the given config.
-func NewDeploymentTargetClient(c config) *DeploymentTargetClient {
-	return &DeploymentTargetClient{config: c}
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deploymenttarget.Hooks(f(g(h())))`.
-func (c *DeploymentTargetClient) Use(hooks ...Hook) {
-	c.hooks.DeploymentTarget = append(c.hooks.DeploymentTarget, hooks...)
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deploymenttarget.Intercept(f(g(h())))`.
-func (c *DeploymentTargetClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeploymentTarget = append(c.inters.DeploymentTarget, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
 }
 
-// Create returns a builder for creating a DeploymentTarget entity.
-func (c *DeploymentTargetClient) Create() *DeploymentTargetCreate {
-	mutation := newDeploymentTargetMutation(c.config, OpCreate)
-	return &DeploymentTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeploymentTarget entities.
-func (c *DeploymentTargetClient) CreateBulk(builders ...*DeploymentTargetCreate) *DeploymentTargetCreateBulk {
-	return &DeploymentTargetCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeploymentTargetClient) MapCreateBulk(slice any, setFunc func(*DeploymentTargetCreate, int)) *DeploymentTargetCreateBulk {
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeploymentTargetCreateBulk{err: fmt.Errorf("calling to DeploymentTargetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeploymentTargetCreate, rv.Len())
+	builders := make([]*OutboxEventCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeploymentTargetCreateBulk{config: c.config, builders: builders}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeploymentTarget.
-func (c *DeploymentTargetClient) Update() *DeploymentTargetUpdate {
-	mutation := newDeploymentTargetMutation(c.config, OpUpdate)
-	return &DeploymentTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeploymentTargetClient) UpdateOne(_m *DeploymentTarget) *DeploymentTargetUpdateOne {
-	mutation := newDeploymentTargetMutation(c.config, OpUpdateOne, withDeploymentTarget(_m))
-	return &DeploymentTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeploymentTargetClient) UpdateOneID(id string) *DeploymentTargetUpdateOne {
-	mutation := newDeploymentTargetMutation(c.config, OpUpdateOne, withDeploymentTargetID(id))
-	return &DeploymentTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OutboxEventClient) UpdateOneID(id uint32) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeploymentTarget.
-func (c *DeploymentTargetClient) Delete() *DeploymentTargetDelete {
-	mutation := newDeploymentTargetMutation(c.config, OpDelete)
-	return &DeploymentTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeploymentTargetClient) DeleteOne(_m *DeploymentTarget) *DeploymentTargetDeleteOne {
+func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeploymentTargetClient) DeleteOneID(id string) *DeploymentTargetDeleteOne {
-	builder := c.Delete().Where(deploymenttarget.ID(id))
+func (c *OutboxEventClient) DeleteOneID(id uint32) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeploymentTargetDeleteOne{builder}
+	return &OutboxEventDeleteOne{builder}
 }
 
-// Query returns a query builder for DeploymentTarget.
-func (c *DeploymentTargetClient) Query() *DeploymentTargetQuery {
-	return &DeploymentTargetQuery{
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeploymentTarget},
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeploymentTarget entity by its id.
-func (c *DeploymentTargetClient) Get(ctx context.Context, id string) (*DeploymentTarget, error) {
-	return c.Query().Where(deploymenttarget.ID(id)).Only(ctx)
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id uint32) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeploymentTargetClient) GetX(ctx context.Context, id string) *DeploymentTarget {
+func (c *OutboxEventClient) GetX(ctx context.Context, id uint32) *OutboxEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -843,61 +2307,162 @@ func (c *DeploymentTargetClient) GetX(ctx context.Context, id string) *Deploymen
 	return obj
 }
 
-// QueryConfigurations queries the configurations edge of a DeploymentTarget.
-func (c *DeploymentTargetClient) QueryConfigurations(_m *DeploymentTarget) *TargetConfigurationQuery {
-	query := (&TargetConfigurationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymenttarget.Table, deploymenttarget.FieldID, id),
-			sqlgraph.To(targetconfiguration.Table, targetconfiguration.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, deploymenttarget.ConfigurationsTable, deploymenttarget.ConfigurationsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryJobs queries the jobs edge of a DeploymentTarget.
-func (c *DeploymentTargetClient) QueryJobs(_m *DeploymentTarget) *DeploymentJobQuery {
-	query := (&DeploymentJobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymenttarget.Table, deploymenttarget.FieldID, id),
-			sqlgraph.To(deploymentjob.Table, deploymentjob.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, deploymenttarget.JobsTable, deploymenttarget.JobsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// RoleBindingClient is a client for the RoleBinding schema.
+type RoleBindingClient struct {
+	config
+}
+
+// NewRoleBindingClient returns a client for the RoleBinding from the given config.
+func NewRoleBindingClient(c config) *RoleBindingClient {
+	return &RoleBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolebinding.Hooks(f(g(h())))`.
+func (c *RoleBindingClient) Use(hooks ...Hook) {
+	c.hooks.RoleBinding = append(c.hooks.RoleBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolebinding.Intercept(f(g(h())))`.
+func (c *RoleBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleBinding = append(c.inters.RoleBinding, interceptors...)
+}
+
+// Create returns a builder for creating a RoleBinding entity.
+func (c *RoleBindingClient) Create() *RoleBindingCreate {
+	mutation := newRoleBindingMutation(c.config, OpCreate)
+	return &RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleBinding entities.
+func (c *RoleBindingClient) CreateBulk(builders ...*RoleBindingCreate) *RoleBindingCreateBulk {
+	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleBindingClient) MapCreateBulk(slice any, setFunc func(*RoleBindingCreate, int)) *RoleBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleBindingCreateBulk{err: fmt.Errorf("calling to RoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*RoleBindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleBinding.
+func (c *RoleBindingClient) Update() *RoleBindingUpdate {
+	mutation := newRoleBindingMutation(c.config, OpUpdate)
+	return &RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleBindingClient) UpdateOne(_m *RoleBinding) *RoleBindingUpdateOne {
+	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBinding(_m))
+	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleBindingClient) UpdateOneID(id uint32) *RoleBindingUpdateOne {
+	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBindingID(id))
+	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleBinding.
+func (c *RoleBindingClient) Delete() *RoleBindingDelete {
+	mutation := newRoleBindingMutation(c.config, OpDelete)
+	return &RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleBindingClient) DeleteOne(_m *RoleBinding) *RoleBindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleBindingClient) DeleteOneID(id uint32) *RoleBindingDeleteOne {
+	builder := c.Delete().Where(rolebinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleBindingDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleBinding.
+func (c *RoleBindingClient) Query() *RoleBindingQuery {
+	return &RoleBindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleBinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleBinding entity by its id.
+func (c *RoleBindingClient) Get(ctx context.Context, id uint32) (*RoleBinding, error) {
+	return c.Query().Where(rolebinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleBindingClient) GetX(ctx context.Context, id uint32) *RoleBinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *DeploymentTargetClient) Hooks() []Hook {
-	hooks := c.hooks.DeploymentTarget
-	return append(hooks[:len(hooks):len(hooks)], deploymenttarget.Hooks[:]...)
+func (c *RoleBindingClient) Hooks() []Hook {
+	hooks := c.hooks.RoleBinding
+	return append(hooks[:len(hooks):len(hooks)], rolebinding.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeploymentTargetClient) Interceptors() []Interceptor {
-	return c.inters.DeploymentTarget
+func (c *RoleBindingClient) Interceptors() []Interceptor {
+	return c.inters.RoleBinding
 }
 
-func (c *DeploymentTargetClient) mutate(ctx context.Context, m *DeploymentTargetMutation) (Value, error) {
+func (c *RoleBindingClient) mutate(ctx context.Context, m *RoleBindingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeploymentTargetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeploymentTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeploymentTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeploymentTargetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeploymentTarget mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RoleBinding mutation op: %q", m.Op())
 	}
 }
 
@@ -1070,11 +2635,16 @@ func (c *TargetConfigurationClient) mutate(ctx context.Context, m *TargetConfigu
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, DeploymentHistory, DeploymentJob, DeploymentTarget,
-		TargetConfiguration []ent.Hook
+		AuditChainHead, AuditLog, CertificateRevocation, ChangeRecord,
+		ConfigurationRevision, DeployLock, DeploymentHistory, DeploymentJob,
+		DeploymentTarget, DriftEvent, ExternalCertificate, InventorySnapshot,
+		NotificationRule, OutboxEvent, RoleBinding, TargetConfiguration []ent.Hook
 	}
 	inters struct {
-		AuditLog, DeploymentHistory, DeploymentJob, DeploymentTarget,
+		AuditChainHead, AuditLog, CertificateRevocation, ChangeRecord,
+		ConfigurationRevision, DeployLock, DeploymentHistory, DeploymentJob,
+		DeploymentTarget, DriftEvent, ExternalCertificate, InventorySnapshot,
+		NotificationRule, OutboxEvent, RoleBinding,
 		TargetConfiguration []ent.Interceptor
 	}
 )
@@ -0,0 +1,951 @@
+// Code generated by ent, DO NOT EDIT.
+
+package configurationrevision
+
+import (
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TargetConfigurationID applies equality check predicate on the "target_configuration_id" field. It's identical to TargetConfigurationIDEQ.
+func TargetConfigurationID(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldTargetConfigurationID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldDescription, v))
+}
+
+// ProviderType applies equality check predicate on the "provider_type" field. It's identical to ProviderTypeEQ.
+func ProviderType(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldProviderType, v))
+}
+
+// CredentialsEncrypted applies equality check predicate on the "credentials_encrypted" field. It's identical to CredentialsEncryptedEQ.
+func CredentialsEncrypted(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldCredentialsEncrypted, v))
+}
+
+// RevertedFrom applies equality check predicate on the "reverted_from" field. It's identical to RevertedFromEQ.
+func RevertedFrom(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldRevertedFrom, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorName applies equality check predicate on the "actor_name" field. It's identical to ActorNameEQ.
+func ActorName(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldActorName, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldTenantID))
+}
+
+// TargetConfigurationIDEQ applies the EQ predicate on the "target_configuration_id" field.
+func TargetConfigurationIDEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDNEQ applies the NEQ predicate on the "target_configuration_id" field.
+func TargetConfigurationIDNEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDIn applies the In predicate on the "target_configuration_id" field.
+func TargetConfigurationIDIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldTargetConfigurationID, vs...))
+}
+
+// TargetConfigurationIDNotIn applies the NotIn predicate on the "target_configuration_id" field.
+func TargetConfigurationIDNotIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldTargetConfigurationID, vs...))
+}
+
+// TargetConfigurationIDGT applies the GT predicate on the "target_configuration_id" field.
+func TargetConfigurationIDGT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDGTE applies the GTE predicate on the "target_configuration_id" field.
+func TargetConfigurationIDGTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDLT applies the LT predicate on the "target_configuration_id" field.
+func TargetConfigurationIDLT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDLTE applies the LTE predicate on the "target_configuration_id" field.
+func TargetConfigurationIDLTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDContains applies the Contains predicate on the "target_configuration_id" field.
+func TargetConfigurationIDContains(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContains(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDHasPrefix applies the HasPrefix predicate on the "target_configuration_id" field.
+func TargetConfigurationIDHasPrefix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasPrefix(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDHasSuffix applies the HasSuffix predicate on the "target_configuration_id" field.
+func TargetConfigurationIDHasSuffix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasSuffix(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDEqualFold applies the EqualFold predicate on the "target_configuration_id" field.
+func TargetConfigurationIDEqualFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEqualFold(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDContainsFold applies the ContainsFold predicate on the "target_configuration_id" field.
+func TargetConfigurationIDContainsFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContainsFold(FieldTargetConfigurationID, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ProviderTypeEQ applies the EQ predicate on the "provider_type" field.
+func ProviderTypeEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldProviderType, v))
+}
+
+// ProviderTypeNEQ applies the NEQ predicate on the "provider_type" field.
+func ProviderTypeNEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldProviderType, v))
+}
+
+// ProviderTypeIn applies the In predicate on the "provider_type" field.
+func ProviderTypeIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldProviderType, vs...))
+}
+
+// ProviderTypeNotIn applies the NotIn predicate on the "provider_type" field.
+func ProviderTypeNotIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldProviderType, vs...))
+}
+
+// ProviderTypeGT applies the GT predicate on the "provider_type" field.
+func ProviderTypeGT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldProviderType, v))
+}
+
+// ProviderTypeGTE applies the GTE predicate on the "provider_type" field.
+func ProviderTypeGTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldProviderType, v))
+}
+
+// ProviderTypeLT applies the LT predicate on the "provider_type" field.
+func ProviderTypeLT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldProviderType, v))
+}
+
+// ProviderTypeLTE applies the LTE predicate on the "provider_type" field.
+func ProviderTypeLTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldProviderType, v))
+}
+
+// ProviderTypeContains applies the Contains predicate on the "provider_type" field.
+func ProviderTypeContains(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContains(FieldProviderType, v))
+}
+
+// ProviderTypeHasPrefix applies the HasPrefix predicate on the "provider_type" field.
+func ProviderTypeHasPrefix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasPrefix(FieldProviderType, v))
+}
+
+// ProviderTypeHasSuffix applies the HasSuffix predicate on the "provider_type" field.
+func ProviderTypeHasSuffix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasSuffix(FieldProviderType, v))
+}
+
+// ProviderTypeEqualFold applies the EqualFold predicate on the "provider_type" field.
+func ProviderTypeEqualFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEqualFold(FieldProviderType, v))
+}
+
+// ProviderTypeContainsFold applies the ContainsFold predicate on the "provider_type" field.
+func ProviderTypeContainsFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContainsFold(FieldProviderType, v))
+}
+
+// CredentialsEncryptedEQ applies the EQ predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedEQ(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldCredentialsEncrypted, v))
+}
+
+// CredentialsEncryptedNEQ applies the NEQ predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedNEQ(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldCredentialsEncrypted, v))
+}
+
+// CredentialsEncryptedIn applies the In predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedIn(vs ...[]byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldCredentialsEncrypted, vs...))
+}
+
+// CredentialsEncryptedNotIn applies the NotIn predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedNotIn(vs ...[]byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldCredentialsEncrypted, vs...))
+}
+
+// CredentialsEncryptedGT applies the GT predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedGT(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldCredentialsEncrypted, v))
+}
+
+// CredentialsEncryptedGTE applies the GTE predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedGTE(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldCredentialsEncrypted, v))
+}
+
+// CredentialsEncryptedLT applies the LT predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedLT(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldCredentialsEncrypted, v))
+}
+
+// CredentialsEncryptedLTE applies the LTE predicate on the "credentials_encrypted" field.
+func CredentialsEncryptedLTE(v []byte) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldCredentialsEncrypted, v))
+}
+
+// ConfigIsNil applies the IsNil predicate on the "config" field.
+func ConfigIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldConfig))
+}
+
+// ConfigNotNil applies the NotNil predicate on the "config" field.
+func ConfigNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldConfig))
+}
+
+// RevertedFromEQ applies the EQ predicate on the "reverted_from" field.
+func RevertedFromEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldRevertedFrom, v))
+}
+
+// RevertedFromNEQ applies the NEQ predicate on the "reverted_from" field.
+func RevertedFromNEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldRevertedFrom, v))
+}
+
+// RevertedFromIn applies the In predicate on the "reverted_from" field.
+func RevertedFromIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldRevertedFrom, vs...))
+}
+
+// RevertedFromNotIn applies the NotIn predicate on the "reverted_from" field.
+func RevertedFromNotIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldRevertedFrom, vs...))
+}
+
+// RevertedFromGT applies the GT predicate on the "reverted_from" field.
+func RevertedFromGT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldRevertedFrom, v))
+}
+
+// RevertedFromGTE applies the GTE predicate on the "reverted_from" field.
+func RevertedFromGTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldRevertedFrom, v))
+}
+
+// RevertedFromLT applies the LT predicate on the "reverted_from" field.
+func RevertedFromLT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldRevertedFrom, v))
+}
+
+// RevertedFromLTE applies the LTE predicate on the "reverted_from" field.
+func RevertedFromLTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldRevertedFrom, v))
+}
+
+// RevertedFromIsNil applies the IsNil predicate on the "reverted_from" field.
+func RevertedFromIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldRevertedFrom))
+}
+
+// RevertedFromNotNil applies the NotNil predicate on the "reverted_from" field.
+func RevertedFromNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldRevertedFrom))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uint32) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldActorID))
+}
+
+// ActorNameEQ applies the EQ predicate on the "actor_name" field.
+func ActorNameEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldActorName, v))
+}
+
+// ActorNameNEQ applies the NEQ predicate on the "actor_name" field.
+func ActorNameNEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldActorName, v))
+}
+
+// ActorNameIn applies the In predicate on the "actor_name" field.
+func ActorNameIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldActorName, vs...))
+}
+
+// ActorNameNotIn applies the NotIn predicate on the "actor_name" field.
+func ActorNameNotIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldActorName, vs...))
+}
+
+// ActorNameGT applies the GT predicate on the "actor_name" field.
+func ActorNameGT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldActorName, v))
+}
+
+// ActorNameGTE applies the GTE predicate on the "actor_name" field.
+func ActorNameGTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldActorName, v))
+}
+
+// ActorNameLT applies the LT predicate on the "actor_name" field.
+func ActorNameLT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldActorName, v))
+}
+
+// ActorNameLTE applies the LTE predicate on the "actor_name" field.
+func ActorNameLTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldActorName, v))
+}
+
+// ActorNameContains applies the Contains predicate on the "actor_name" field.
+func ActorNameContains(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContains(FieldActorName, v))
+}
+
+// ActorNameHasPrefix applies the HasPrefix predicate on the "actor_name" field.
+func ActorNameHasPrefix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasPrefix(FieldActorName, v))
+}
+
+// ActorNameHasSuffix applies the HasSuffix predicate on the "actor_name" field.
+func ActorNameHasSuffix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasSuffix(FieldActorName, v))
+}
+
+// ActorNameIsNil applies the IsNil predicate on the "actor_name" field.
+func ActorNameIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldActorName))
+}
+
+// ActorNameNotNil applies the NotNil predicate on the "actor_name" field.
+func ActorNameNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldActorName))
+}
+
+// ActorNameEqualFold applies the EqualFold predicate on the "actor_name" field.
+func ActorNameEqualFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEqualFold(FieldActorName, v))
+}
+
+// ActorNameContainsFold applies the ContainsFold predicate on the "actor_name" field.
+func ActorNameContainsFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContainsFold(FieldActorName, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConfigurationRevision) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConfigurationRevision) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConfigurationRevision) predicate.ConfigurationRevision {
+	return predicate.ConfigurationRevision(sql.NotPredicates(p))
+}
@@ -86,6 +86,7 @@ const (
 	ActionACTION_DEPLOY   Action = "ACTION_DEPLOY"
 	ActionACTION_VERIFY   Action = "ACTION_VERIFY"
 	ActionACTION_ROLLBACK Action = "ACTION_ROLLBACK"
+	ActionACTION_REMOVE   Action = "ACTION_REMOVE"
 )
 
 func (a Action) String() string {
@@ -95,7 +96,7 @@ func (a Action) String() string {
 // ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
 func ActionValidator(a Action) error {
 	switch a {
-	case ActionACTION_DEPLOY, ActionACTION_VERIFY, ActionACTION_ROLLBACK:
+	case ActionACTION_DEPLOY, ActionACTION_VERIFY, ActionACTION_ROLLBACK, ActionACTION_REMOVE:
 		return nil
 	default:
 		return fmt.Errorf("deploymenthistory: invalid enum value for action field: %q", a)
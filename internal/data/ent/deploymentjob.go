@@ -11,6 +11,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -42,6 +43,14 @@ type DeploymentJob struct {
 	CertificateID string `json:"certificate_id,omitempty"`
 	// Certificate serial number
 	CertificateSerial string `json:"certificate_serial,omitempty"`
+	// Certificate common name, kept to remove certificates LCM no longer knows (child/direct jobs)
+	CertificateCommonName string `json:"certificate_common_name,omitempty"`
+	// ID of the first certificate of the renewal chain (child/direct jobs)
+	CertificateLineage string `json:"certificate_lineage,omitempty"`
+	// Client key that coalesces repeated create requests (parent/direct jobs)
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+	// Job that replaced this queued job (child/direct jobs)
+	SupersededByJobID *string `json:"superseded_by_job_id,omitempty"`
 	// Job status
 	Status deploymentjob.Status `json:"status,omitempty"`
 	// Status message
@@ -52,8 +61,12 @@ type DeploymentJob struct {
 	RetryCount int32 `json:"retry_count,omitempty"`
 	// Maximum retry attempts
 	MaxRetries int32 `json:"max_retries,omitempty"`
+	// Effective retry and timeout policy the job was created with
+	RetryPolicy *registry.RetryPolicy `json:"retry_policy,omitempty"`
 	// How the job was triggered
 	TriggeredBy deploymentjob.TriggeredBy `json:"triggered_by,omitempty"`
+	// Whether the job deploys or removes the certificate (child/direct jobs)
+	Action deploymentjob.Action `json:"action,omitempty"`
 	// Deployment result details
 	Result map[string]interface{} `json:"result,omitempty"`
 	// Job start time
@@ -62,6 +75,10 @@ type DeploymentJob struct {
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// Next retry time
 	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+	// Configuration revision the job executed with (child/direct jobs)
+	ConfigurationRevisionID *uint32 `json:"configuration_revision_id,omitempty"`
+	// Category of the last failure (child/direct jobs)
+	ErrorCategory *deploymentjob.ErrorCategory `json:"error_category,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeploymentJobQuery when eager-loading is set.
 	Edges        DeploymentJobEdges `json:"edges"`
@@ -141,11 +158,11 @@ func (*DeploymentJob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deploymentjob.FieldResult:
+		case deploymentjob.FieldRetryPolicy, deploymentjob.FieldResult:
 			values[i] = new([]byte)
-		case deploymentjob.FieldCreateBy, deploymentjob.FieldTenantID, deploymentjob.FieldProgress, deploymentjob.FieldRetryCount, deploymentjob.FieldMaxRetries:
+		case deploymentjob.FieldCreateBy, deploymentjob.FieldTenantID, deploymentjob.FieldProgress, deploymentjob.FieldRetryCount, deploymentjob.FieldMaxRetries, deploymentjob.FieldConfigurationRevisionID:
 			values[i] = new(sql.NullInt64)
-		case deploymentjob.FieldID, deploymentjob.FieldDeploymentTargetID, deploymentjob.FieldTargetConfigurationID, deploymentjob.FieldParentJobID, deploymentjob.FieldCertificateID, deploymentjob.FieldCertificateSerial, deploymentjob.FieldStatus, deploymentjob.FieldStatusMessage, deploymentjob.FieldTriggeredBy:
+		case deploymentjob.FieldID, deploymentjob.FieldDeploymentTargetID, deploymentjob.FieldTargetConfigurationID, deploymentjob.FieldParentJobID, deploymentjob.FieldCertificateID, deploymentjob.FieldCertificateSerial, deploymentjob.FieldCertificateCommonName, deploymentjob.FieldCertificateLineage, deploymentjob.FieldIdempotencyKey, deploymentjob.FieldSupersededByJobID, deploymentjob.FieldStatus, deploymentjob.FieldStatusMessage, deploymentjob.FieldTriggeredBy, deploymentjob.FieldAction, deploymentjob.FieldErrorCategory:
 			values[i] = new(sql.NullString)
 		case deploymentjob.FieldCreateTime, deploymentjob.FieldUpdateTime, deploymentjob.FieldDeleteTime, deploymentjob.FieldStartedAt, deploymentjob.FieldCompletedAt, deploymentjob.FieldNextRetryAt:
 			values[i] = new(sql.NullTime)
@@ -238,6 +255,32 @@ func (_m *DeploymentJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CertificateSerial = value.String
 			}
+		case deploymentjob.FieldCertificateCommonName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_common_name", values[i])
+			} else if value.Valid {
+				_m.CertificateCommonName = value.String
+			}
+		case deploymentjob.FieldCertificateLineage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_lineage", values[i])
+			} else if value.Valid {
+				_m.CertificateLineage = value.String
+			}
+		case deploymentjob.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = new(string)
+				*_m.IdempotencyKey = value.String
+			}
+		case deploymentjob.FieldSupersededByJobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field superseded_by_job_id", values[i])
+			} else if value.Valid {
+				_m.SupersededByJobID = new(string)
+				*_m.SupersededByJobID = value.String
+			}
 		case deploymentjob.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -268,12 +311,26 @@ func (_m *DeploymentJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MaxRetries = int32(value.Int64)
 			}
+		case deploymentjob.FieldRetryPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetryPolicy); err != nil {
+					return fmt.Errorf("unmarshal field retry_policy: %w", err)
+				}
+			}
 		case deploymentjob.FieldTriggeredBy:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field triggered_by", values[i])
 			} else if value.Valid {
 				_m.TriggeredBy = deploymentjob.TriggeredBy(value.String)
 			}
+		case deploymentjob.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = deploymentjob.Action(value.String)
+			}
 		case deploymentjob.FieldResult:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field result", values[i])
@@ -303,6 +360,20 @@ func (_m *DeploymentJob) assignValues(columns []string, values []any) error {
 				_m.NextRetryAt = new(time.Time)
 				*_m.NextRetryAt = value.Time
 			}
+		case deploymentjob.FieldConfigurationRevisionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field configuration_revision_id", values[i])
+			} else if value.Valid {
+				_m.ConfigurationRevisionID = new(uint32)
+				*_m.ConfigurationRevisionID = uint32(value.Int64)
+			}
+		case deploymentjob.FieldErrorCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_category", values[i])
+			} else if value.Valid {
+				_m.ErrorCategory = new(deploymentjob.ErrorCategory)
+				*_m.ErrorCategory = deploymentjob.ErrorCategory(value.String)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -410,6 +481,22 @@ func (_m *DeploymentJob) String() string {
 	builder.WriteString("certificate_serial=")
 	builder.WriteString(_m.CertificateSerial)
 	builder.WriteString(", ")
+	builder.WriteString("certificate_common_name=")
+	builder.WriteString(_m.CertificateCommonName)
+	builder.WriteString(", ")
+	builder.WriteString("certificate_lineage=")
+	builder.WriteString(_m.CertificateLineage)
+	builder.WriteString(", ")
+	if v := _m.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SupersededByJobID; v != nil {
+		builder.WriteString("superseded_by_job_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
@@ -425,9 +512,15 @@ func (_m *DeploymentJob) String() string {
 	builder.WriteString("max_retries=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MaxRetries))
 	builder.WriteString(", ")
+	builder.WriteString("retry_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryPolicy))
+	builder.WriteString(", ")
 	builder.WriteString("triggered_by=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TriggeredBy))
 	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
 	builder.WriteString("result=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Result))
 	builder.WriteString(", ")
@@ -445,6 +538,16 @@ func (_m *DeploymentJob) String() string {
 		builder.WriteString("next_retry_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.ConfigurationRevisionID; v != nil {
+		builder.WriteString("configuration_revision_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ErrorCategory; v != nil {
+		builder.WriteString("error_category=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,14 @@ const (
 	FieldCertificateID = "certificate_id"
 	// FieldCertificateSerial holds the string denoting the certificate_serial field in the database.
 	FieldCertificateSerial = "certificate_serial"
+	// FieldCertificateCommonName holds the string denoting the certificate_common_name field in the database.
+	FieldCertificateCommonName = "certificate_common_name"
+	// FieldCertificateLineage holds the string denoting the certificate_lineage field in the database.
+	FieldCertificateLineage = "certificate_lineage"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldSupersededByJobID holds the string denoting the superseded_by_job_id field in the database.
+	FieldSupersededByJobID = "superseded_by_job_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldStatusMessage holds the string denoting the status_message field in the database.
@@ -45,8 +53,12 @@ const (
 	FieldRetryCount = "retry_count"
 	// FieldMaxRetries holds the string denoting the max_retries field in the database.
 	FieldMaxRetries = "max_retries"
+	// FieldRetryPolicy holds the string denoting the retry_policy field in the database.
+	FieldRetryPolicy = "retry_policy"
 	// FieldTriggeredBy holds the string denoting the triggered_by field in the database.
 	FieldTriggeredBy = "triggered_by"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
 	// FieldResult holds the string denoting the result field in the database.
 	FieldResult = "result"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
@@ -55,6 +67,10 @@ const (
 	FieldCompletedAt = "completed_at"
 	// FieldNextRetryAt holds the string denoting the next_retry_at field in the database.
 	FieldNextRetryAt = "next_retry_at"
+	// FieldConfigurationRevisionID holds the string denoting the configuration_revision_id field in the database.
+	FieldConfigurationRevisionID = "configuration_revision_id"
+	// FieldErrorCategory holds the string denoting the error_category field in the database.
+	FieldErrorCategory = "error_category"
 	// EdgeDeploymentTarget holds the string denoting the deployment_target edge name in mutations.
 	EdgeDeploymentTarget = "deployment_target"
 	// EdgeTargetConfiguration holds the string denoting the target_configuration edge name in mutations.
@@ -111,16 +127,24 @@ var Columns = []string{
 	FieldParentJobID,
 	FieldCertificateID,
 	FieldCertificateSerial,
+	FieldCertificateCommonName,
+	FieldCertificateLineage,
+	FieldIdempotencyKey,
+	FieldSupersededByJobID,
 	FieldStatus,
 	FieldStatusMessage,
 	FieldProgress,
 	FieldRetryCount,
 	FieldMaxRetries,
+	FieldRetryPolicy,
 	FieldTriggeredBy,
+	FieldAction,
 	FieldResult,
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldNextRetryAt,
+	FieldConfigurationRevisionID,
+	FieldErrorCategory,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -145,6 +169,8 @@ var (
 	DefaultTenantID uint32
 	// CertificateIDValidator is a validator for the "certificate_id" field. It is called by the builders before save.
 	CertificateIDValidator func(string) error
+	// IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	IdempotencyKeyValidator func(string) error
 	// DefaultProgress holds the default value on creation for the "progress" field.
 	DefaultProgress int32
 	// DefaultRetryCount holds the default value on creation for the "retry_count" field.
@@ -195,10 +221,12 @@ const DefaultTriggeredBy = TriggeredByTRIGGER_TYPE_MANUAL
 
 // TriggeredBy values.
 const (
-	TriggeredByTRIGGER_TYPE_UNSPECIFIED  TriggeredBy = "TRIGGER_TYPE_UNSPECIFIED"
-	TriggeredByTRIGGER_TYPE_MANUAL       TriggeredBy = "TRIGGER_TYPE_MANUAL"
-	TriggeredByTRIGGER_TYPE_EVENT        TriggeredBy = "TRIGGER_TYPE_EVENT"
-	TriggeredByTRIGGER_TYPE_AUTO_RENEWAL TriggeredBy = "TRIGGER_TYPE_AUTO_RENEWAL"
+	TriggeredByTRIGGER_TYPE_UNSPECIFIED       TriggeredBy = "TRIGGER_TYPE_UNSPECIFIED"
+	TriggeredByTRIGGER_TYPE_MANUAL            TriggeredBy = "TRIGGER_TYPE_MANUAL"
+	TriggeredByTRIGGER_TYPE_EVENT             TriggeredBy = "TRIGGER_TYPE_EVENT"
+	TriggeredByTRIGGER_TYPE_AUTO_RENEWAL      TriggeredBy = "TRIGGER_TYPE_AUTO_RENEWAL"
+	TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION TriggeredBy = "TRIGGER_TYPE_DRIFT_REMEDIATION"
+	TriggeredByTRIGGER_TYPE_REVOCATION        TriggeredBy = "TRIGGER_TYPE_REVOCATION"
 )
 
 func (tb TriggeredBy) String() string {
@@ -208,13 +236,68 @@ func (tb TriggeredBy) String() string {
 // TriggeredByValidator is a validator for the "triggered_by" field enum values. It is called by the builders before save.
 func TriggeredByValidator(tb TriggeredBy) error {
 	switch tb {
-	case TriggeredByTRIGGER_TYPE_UNSPECIFIED, TriggeredByTRIGGER_TYPE_MANUAL, TriggeredByTRIGGER_TYPE_EVENT, TriggeredByTRIGGER_TYPE_AUTO_RENEWAL:
+	case TriggeredByTRIGGER_TYPE_UNSPECIFIED, TriggeredByTRIGGER_TYPE_MANUAL, TriggeredByTRIGGER_TYPE_EVENT, TriggeredByTRIGGER_TYPE_AUTO_RENEWAL, TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION, TriggeredByTRIGGER_TYPE_REVOCATION:
 		return nil
 	default:
 		return fmt.Errorf("deploymentjob: invalid enum value for triggered_by field: %q", tb)
 	}
 }
 
+// Action defines the type for the "action" enum field.
+type Action string
+
+// ActionJOB_ACTION_DEPLOY is the default value of the Action enum.
+const DefaultAction = ActionJOB_ACTION_DEPLOY
+
+// Action values.
+const (
+	ActionJOB_ACTION_DEPLOY Action = "JOB_ACTION_DEPLOY"
+	ActionJOB_ACTION_REMOVE Action = "JOB_ACTION_REMOVE"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionJOB_ACTION_DEPLOY, ActionJOB_ACTION_REMOVE:
+		return nil
+	default:
+		return fmt.Errorf("deploymentjob: invalid enum value for action field: %q", a)
+	}
+}
+
+// ErrorCategory defines the type for the "error_category" enum field.
+type ErrorCategory string
+
+// ErrorCategory values.
+const (
+	ErrorCategoryERROR_CATEGORY_UNKNOWN      ErrorCategory = "ERROR_CATEGORY_UNKNOWN"
+	ErrorCategoryERROR_CATEGORY_AUTH         ErrorCategory = "ERROR_CATEGORY_AUTH"
+	ErrorCategoryERROR_CATEGORY_PERMISSION   ErrorCategory = "ERROR_CATEGORY_PERMISSION"
+	ErrorCategoryERROR_CATEGORY_NOT_FOUND    ErrorCategory = "ERROR_CATEGORY_NOT_FOUND"
+	ErrorCategoryERROR_CATEGORY_VALIDATION   ErrorCategory = "ERROR_CATEGORY_VALIDATION"
+	ErrorCategoryERROR_CATEGORY_RATE_LIMITED ErrorCategory = "ERROR_CATEGORY_RATE_LIMITED"
+	ErrorCategoryERROR_CATEGORY_TRANSIENT    ErrorCategory = "ERROR_CATEGORY_TRANSIENT"
+	ErrorCategoryERROR_CATEGORY_CONFLICT     ErrorCategory = "ERROR_CATEGORY_CONFLICT"
+)
+
+func (ec ErrorCategory) String() string {
+	return string(ec)
+}
+
+// ErrorCategoryValidator is a validator for the "error_category" field enum values. It is called by the builders before save.
+func ErrorCategoryValidator(ec ErrorCategory) error {
+	switch ec {
+	case ErrorCategoryERROR_CATEGORY_UNKNOWN, ErrorCategoryERROR_CATEGORY_AUTH, ErrorCategoryERROR_CATEGORY_PERMISSION, ErrorCategoryERROR_CATEGORY_NOT_FOUND, ErrorCategoryERROR_CATEGORY_VALIDATION, ErrorCategoryERROR_CATEGORY_RATE_LIMITED, ErrorCategoryERROR_CATEGORY_TRANSIENT, ErrorCategoryERROR_CATEGORY_CONFLICT:
+		return nil
+	default:
+		return fmt.Errorf("deploymentjob: invalid enum value for error_category field: %q", ec)
+	}
+}
+
 // OrderOption defines the ordering options for the DeploymentJob queries.
 type OrderOption func(*sql.Selector)
 
@@ -273,6 +356,26 @@ func ByCertificateSerial(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCertificateSerial, opts...).ToFunc()
 }
 
+// ByCertificateCommonName orders the results by the certificate_common_name field.
+func ByCertificateCommonName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateCommonName, opts...).ToFunc()
+}
+
+// ByCertificateLineage orders the results by the certificate_lineage field.
+func ByCertificateLineage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateLineage, opts...).ToFunc()
+}
+
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
+// BySupersededByJobID orders the results by the superseded_by_job_id field.
+func BySupersededByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSupersededByJobID, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -303,6 +406,11 @@ func ByTriggeredBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTriggeredBy, opts...).ToFunc()
 }
 
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
@@ -318,6 +426,16 @@ func ByNextRetryAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNextRetryAt, opts...).ToFunc()
 }
 
+// ByConfigurationRevisionID orders the results by the configuration_revision_id field.
+func ByConfigurationRevisionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfigurationRevisionID, opts...).ToFunc()
+}
+
+// ByErrorCategory orders the results by the error_category field.
+func ByErrorCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorCategory, opts...).ToFunc()
+}
+
 // ByDeploymentTargetField orders the results by deployment_target field.
 func ByDeploymentTargetField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -116,6 +116,26 @@ func CertificateSerial(v string) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldEQ(FieldCertificateSerial, v))
 }
 
+// CertificateCommonName applies equality check predicate on the "certificate_common_name" field. It's identical to CertificateCommonNameEQ.
+func CertificateCommonName(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldCertificateCommonName, v))
+}
+
+// CertificateLineage applies equality check predicate on the "certificate_lineage" field. It's identical to CertificateLineageEQ.
+func CertificateLineage(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldCertificateLineage, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// SupersededByJobID applies equality check predicate on the "superseded_by_job_id" field. It's identical to SupersededByJobIDEQ.
+func SupersededByJobID(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldSupersededByJobID, v))
+}
+
 // StatusMessage applies equality check predicate on the "status_message" field. It's identical to StatusMessageEQ.
 func StatusMessage(v string) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldEQ(FieldStatusMessage, v))
@@ -151,6 +171,11 @@ func NextRetryAt(v time.Time) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldEQ(FieldNextRetryAt, v))
 }
 
+// ConfigurationRevisionID applies equality check predicate on the "configuration_revision_id" field. It's identical to ConfigurationRevisionIDEQ.
+func ConfigurationRevisionID(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldConfigurationRevisionID, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldEQ(FieldCreateBy, v))
@@ -766,6 +791,306 @@ func CertificateSerialContainsFold(v string) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldContainsFold(FieldCertificateSerial, v))
 }
 
+// CertificateCommonNameEQ applies the EQ predicate on the "certificate_common_name" field.
+func CertificateCommonNameEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameNEQ applies the NEQ predicate on the "certificate_common_name" field.
+func CertificateCommonNameNEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameIn applies the In predicate on the "certificate_common_name" field.
+func CertificateCommonNameIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldCertificateCommonName, vs...))
+}
+
+// CertificateCommonNameNotIn applies the NotIn predicate on the "certificate_common_name" field.
+func CertificateCommonNameNotIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldCertificateCommonName, vs...))
+}
+
+// CertificateCommonNameGT applies the GT predicate on the "certificate_common_name" field.
+func CertificateCommonNameGT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGT(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameGTE applies the GTE predicate on the "certificate_common_name" field.
+func CertificateCommonNameGTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGTE(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameLT applies the LT predicate on the "certificate_common_name" field.
+func CertificateCommonNameLT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLT(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameLTE applies the LTE predicate on the "certificate_common_name" field.
+func CertificateCommonNameLTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLTE(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameContains applies the Contains predicate on the "certificate_common_name" field.
+func CertificateCommonNameContains(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContains(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameHasPrefix applies the HasPrefix predicate on the "certificate_common_name" field.
+func CertificateCommonNameHasPrefix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasPrefix(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameHasSuffix applies the HasSuffix predicate on the "certificate_common_name" field.
+func CertificateCommonNameHasSuffix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasSuffix(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameIsNil applies the IsNil predicate on the "certificate_common_name" field.
+func CertificateCommonNameIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldCertificateCommonName))
+}
+
+// CertificateCommonNameNotNil applies the NotNil predicate on the "certificate_common_name" field.
+func CertificateCommonNameNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldCertificateCommonName))
+}
+
+// CertificateCommonNameEqualFold applies the EqualFold predicate on the "certificate_common_name" field.
+func CertificateCommonNameEqualFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEqualFold(FieldCertificateCommonName, v))
+}
+
+// CertificateCommonNameContainsFold applies the ContainsFold predicate on the "certificate_common_name" field.
+func CertificateCommonNameContainsFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContainsFold(FieldCertificateCommonName, v))
+}
+
+// CertificateLineageEQ applies the EQ predicate on the "certificate_lineage" field.
+func CertificateLineageEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldCertificateLineage, v))
+}
+
+// CertificateLineageNEQ applies the NEQ predicate on the "certificate_lineage" field.
+func CertificateLineageNEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldCertificateLineage, v))
+}
+
+// CertificateLineageIn applies the In predicate on the "certificate_lineage" field.
+func CertificateLineageIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldCertificateLineage, vs...))
+}
+
+// CertificateLineageNotIn applies the NotIn predicate on the "certificate_lineage" field.
+func CertificateLineageNotIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldCertificateLineage, vs...))
+}
+
+// CertificateLineageGT applies the GT predicate on the "certificate_lineage" field.
+func CertificateLineageGT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGT(FieldCertificateLineage, v))
+}
+
+// CertificateLineageGTE applies the GTE predicate on the "certificate_lineage" field.
+func CertificateLineageGTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGTE(FieldCertificateLineage, v))
+}
+
+// CertificateLineageLT applies the LT predicate on the "certificate_lineage" field.
+func CertificateLineageLT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLT(FieldCertificateLineage, v))
+}
+
+// CertificateLineageLTE applies the LTE predicate on the "certificate_lineage" field.
+func CertificateLineageLTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLTE(FieldCertificateLineage, v))
+}
+
+// CertificateLineageContains applies the Contains predicate on the "certificate_lineage" field.
+func CertificateLineageContains(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContains(FieldCertificateLineage, v))
+}
+
+// CertificateLineageHasPrefix applies the HasPrefix predicate on the "certificate_lineage" field.
+func CertificateLineageHasPrefix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasPrefix(FieldCertificateLineage, v))
+}
+
+// CertificateLineageHasSuffix applies the HasSuffix predicate on the "certificate_lineage" field.
+func CertificateLineageHasSuffix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasSuffix(FieldCertificateLineage, v))
+}
+
+// CertificateLineageIsNil applies the IsNil predicate on the "certificate_lineage" field.
+func CertificateLineageIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldCertificateLineage))
+}
+
+// CertificateLineageNotNil applies the NotNil predicate on the "certificate_lineage" field.
+func CertificateLineageNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldCertificateLineage))
+}
+
+// CertificateLineageEqualFold applies the EqualFold predicate on the "certificate_lineage" field.
+func CertificateLineageEqualFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEqualFold(FieldCertificateLineage, v))
+}
+
+// CertificateLineageContainsFold applies the ContainsFold predicate on the "certificate_lineage" field.
+func CertificateLineageContainsFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContainsFold(FieldCertificateLineage, v))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// SupersededByJobIDEQ applies the EQ predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDNEQ applies the NEQ predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDNEQ(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDIn applies the In predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldSupersededByJobID, vs...))
+}
+
+// SupersededByJobIDNotIn applies the NotIn predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDNotIn(vs ...string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldSupersededByJobID, vs...))
+}
+
+// SupersededByJobIDGT applies the GT predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDGT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGT(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDGTE applies the GTE predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDGTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGTE(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDLT applies the LT predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDLT(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLT(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDLTE applies the LTE predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDLTE(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLTE(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDContains applies the Contains predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDContains(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContains(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDHasPrefix applies the HasPrefix predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDHasPrefix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasPrefix(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDHasSuffix applies the HasSuffix predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDHasSuffix(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldHasSuffix(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDIsNil applies the IsNil predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldSupersededByJobID))
+}
+
+// SupersededByJobIDNotNil applies the NotNil predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldSupersededByJobID))
+}
+
+// SupersededByJobIDEqualFold applies the EqualFold predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDEqualFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEqualFold(FieldSupersededByJobID, v))
+}
+
+// SupersededByJobIDContainsFold applies the ContainsFold predicate on the "superseded_by_job_id" field.
+func SupersededByJobIDContainsFold(v string) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldContainsFold(FieldSupersededByJobID, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldEQ(FieldStatus, v))
@@ -981,6 +1306,16 @@ func MaxRetriesLTE(v int32) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldLTE(FieldMaxRetries, v))
 }
 
+// RetryPolicyIsNil applies the IsNil predicate on the "retry_policy" field.
+func RetryPolicyIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldRetryPolicy))
+}
+
+// RetryPolicyNotNil applies the NotNil predicate on the "retry_policy" field.
+func RetryPolicyNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldRetryPolicy))
+}
+
 // TriggeredByEQ applies the EQ predicate on the "triggered_by" field.
 func TriggeredByEQ(v TriggeredBy) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldEQ(FieldTriggeredBy, v))
@@ -1001,6 +1336,26 @@ func TriggeredByNotIn(vs ...TriggeredBy) predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldNotIn(FieldTriggeredBy, vs...))
 }
 
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldAction, vs...))
+}
+
 // ResultIsNil applies the IsNil predicate on the "result" field.
 func ResultIsNil() predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldIsNull(FieldResult))
@@ -1161,6 +1516,86 @@ func NextRetryAtNotNil() predicate.DeploymentJob {
 	return predicate.DeploymentJob(sql.FieldNotNull(FieldNextRetryAt))
 }
 
+// ConfigurationRevisionIDEQ applies the EQ predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDEQ(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldConfigurationRevisionID, v))
+}
+
+// ConfigurationRevisionIDNEQ applies the NEQ predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDNEQ(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldConfigurationRevisionID, v))
+}
+
+// ConfigurationRevisionIDIn applies the In predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDIn(vs ...uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldConfigurationRevisionID, vs...))
+}
+
+// ConfigurationRevisionIDNotIn applies the NotIn predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDNotIn(vs ...uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldConfigurationRevisionID, vs...))
+}
+
+// ConfigurationRevisionIDGT applies the GT predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDGT(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGT(FieldConfigurationRevisionID, v))
+}
+
+// ConfigurationRevisionIDGTE applies the GTE predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDGTE(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldGTE(FieldConfigurationRevisionID, v))
+}
+
+// ConfigurationRevisionIDLT applies the LT predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDLT(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLT(FieldConfigurationRevisionID, v))
+}
+
+// ConfigurationRevisionIDLTE applies the LTE predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDLTE(v uint32) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldLTE(FieldConfigurationRevisionID, v))
+}
+
+// ConfigurationRevisionIDIsNil applies the IsNil predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldConfigurationRevisionID))
+}
+
+// ConfigurationRevisionIDNotNil applies the NotNil predicate on the "configuration_revision_id" field.
+func ConfigurationRevisionIDNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldConfigurationRevisionID))
+}
+
+// ErrorCategoryEQ applies the EQ predicate on the "error_category" field.
+func ErrorCategoryEQ(v ErrorCategory) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldEQ(FieldErrorCategory, v))
+}
+
+// ErrorCategoryNEQ applies the NEQ predicate on the "error_category" field.
+func ErrorCategoryNEQ(v ErrorCategory) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNEQ(FieldErrorCategory, v))
+}
+
+// ErrorCategoryIn applies the In predicate on the "error_category" field.
+func ErrorCategoryIn(vs ...ErrorCategory) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIn(FieldErrorCategory, vs...))
+}
+
+// ErrorCategoryNotIn applies the NotIn predicate on the "error_category" field.
+func ErrorCategoryNotIn(vs ...ErrorCategory) predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotIn(FieldErrorCategory, vs...))
+}
+
+// ErrorCategoryIsNil applies the IsNil predicate on the "error_category" field.
+func ErrorCategoryIsNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldIsNull(FieldErrorCategory))
+}
+
+// ErrorCategoryNotNil applies the NotNil predicate on the "error_category" field.
+func ErrorCategoryNotNil() predicate.DeploymentJob {
+	return predicate.DeploymentJob(sql.FieldNotNull(FieldErrorCategory))
+}
+
 // HasDeploymentTarget applies the HasEdge predicate on the "deployment_target" edge.
 func HasDeploymentTarget() predicate.DeploymentJob {
 	return predicate.DeploymentJob(func(s *sql.Selector) {
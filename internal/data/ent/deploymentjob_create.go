@@ -12,6 +12,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -159,6 +160,62 @@ func (_c *DeploymentJobCreate) SetNillableCertificateSerial(v *string) *Deployme
 	return _c
 }
 
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (_c *DeploymentJobCreate) SetCertificateCommonName(v string) *DeploymentJobCreate {
+	_c.mutation.SetCertificateCommonName(v)
+	return _c
+}
+
+// SetNillableCertificateCommonName sets the "certificate_common_name" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableCertificateCommonName(v *string) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetCertificateCommonName(*v)
+	}
+	return _c
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (_c *DeploymentJobCreate) SetCertificateLineage(v string) *DeploymentJobCreate {
+	_c.mutation.SetCertificateLineage(v)
+	return _c
+}
+
+// SetNillableCertificateLineage sets the "certificate_lineage" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableCertificateLineage(v *string) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetCertificateLineage(*v)
+	}
+	return _c
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *DeploymentJobCreate) SetIdempotencyKey(v string) *DeploymentJobCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableIdempotencyKey(v *string) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetIdempotencyKey(*v)
+	}
+	return _c
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (_c *DeploymentJobCreate) SetSupersededByJobID(v string) *DeploymentJobCreate {
+	_c.mutation.SetSupersededByJobID(v)
+	return _c
+}
+
+// SetNillableSupersededByJobID sets the "superseded_by_job_id" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableSupersededByJobID(v *string) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetSupersededByJobID(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *DeploymentJobCreate) SetStatus(v deploymentjob.Status) *DeploymentJobCreate {
 	_c.mutation.SetStatus(v)
@@ -229,6 +286,12 @@ func (_c *DeploymentJobCreate) SetNillableMaxRetries(v *int32) *DeploymentJobCre
 	return _c
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_c *DeploymentJobCreate) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentJobCreate {
+	_c.mutation.SetRetryPolicy(v)
+	return _c
+}
+
 // SetTriggeredBy sets the "triggered_by" field.
 func (_c *DeploymentJobCreate) SetTriggeredBy(v deploymentjob.TriggeredBy) *DeploymentJobCreate {
 	_c.mutation.SetTriggeredBy(v)
@@ -243,6 +306,20 @@ func (_c *DeploymentJobCreate) SetNillableTriggeredBy(v *deploymentjob.Triggered
 	return _c
 }
 
+// SetAction sets the "action" field.
+func (_c *DeploymentJobCreate) SetAction(v deploymentjob.Action) *DeploymentJobCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableAction(v *deploymentjob.Action) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
 // SetResult sets the "result" field.
 func (_c *DeploymentJobCreate) SetResult(v map[string]interface{}) *DeploymentJobCreate {
 	_c.mutation.SetResult(v)
@@ -291,6 +368,34 @@ func (_c *DeploymentJobCreate) SetNillableNextRetryAt(v *time.Time) *DeploymentJ
 	return _c
 }
 
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (_c *DeploymentJobCreate) SetConfigurationRevisionID(v uint32) *DeploymentJobCreate {
+	_c.mutation.SetConfigurationRevisionID(v)
+	return _c
+}
+
+// SetNillableConfigurationRevisionID sets the "configuration_revision_id" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableConfigurationRevisionID(v *uint32) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetConfigurationRevisionID(*v)
+	}
+	return _c
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (_c *DeploymentJobCreate) SetErrorCategory(v deploymentjob.ErrorCategory) *DeploymentJobCreate {
+	_c.mutation.SetErrorCategory(v)
+	return _c
+}
+
+// SetNillableErrorCategory sets the "error_category" field if the given value is not nil.
+func (_c *DeploymentJobCreate) SetNillableErrorCategory(v *deploymentjob.ErrorCategory) *DeploymentJobCreate {
+	if v != nil {
+		_c.SetErrorCategory(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DeploymentJobCreate) SetID(v string) *DeploymentJobCreate {
 	_c.mutation.SetID(v)
@@ -403,6 +508,10 @@ func (_c *DeploymentJobCreate) defaults() error {
 		v := deploymentjob.DefaultTriggeredBy
 		_c.mutation.SetTriggeredBy(v)
 	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := deploymentjob.DefaultAction
+		_c.mutation.SetAction(v)
+	}
 	return nil
 }
 
@@ -416,6 +525,11 @@ func (_c *DeploymentJobCreate) check() error {
 			return &ValidationError{Name: "certificate_id", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.certificate_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.IdempotencyKey(); ok {
+		if err := deploymentjob.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.idempotency_key": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeploymentJob.status"`)}
 	}
@@ -441,6 +555,19 @@ func (_c *DeploymentJobCreate) check() error {
 			return &ValidationError{Name: "triggered_by", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.triggered_by": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "DeploymentJob.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := deploymentjob.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ErrorCategory(); ok {
+		if err := deploymentjob.ErrorCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "error_category", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.error_category": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := deploymentjob.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.id": %w`, err)}
@@ -510,6 +637,22 @@ func (_c *DeploymentJobCreate) createSpec() (*DeploymentJob, *sqlgraph.CreateSpe
 		_spec.SetField(deploymentjob.FieldCertificateSerial, field.TypeString, value)
 		_node.CertificateSerial = value
 	}
+	if value, ok := _c.mutation.CertificateCommonName(); ok {
+		_spec.SetField(deploymentjob.FieldCertificateCommonName, field.TypeString, value)
+		_node.CertificateCommonName = value
+	}
+	if value, ok := _c.mutation.CertificateLineage(); ok {
+		_spec.SetField(deploymentjob.FieldCertificateLineage, field.TypeString, value)
+		_node.CertificateLineage = value
+	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(deploymentjob.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
+	if value, ok := _c.mutation.SupersededByJobID(); ok {
+		_spec.SetField(deploymentjob.FieldSupersededByJobID, field.TypeString, value)
+		_node.SupersededByJobID = &value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(deploymentjob.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -530,10 +673,18 @@ func (_c *DeploymentJobCreate) createSpec() (*DeploymentJob, *sqlgraph.CreateSpe
 		_spec.SetField(deploymentjob.FieldMaxRetries, field.TypeInt32, value)
 		_node.MaxRetries = value
 	}
+	if value, ok := _c.mutation.RetryPolicy(); ok {
+		_spec.SetField(deploymentjob.FieldRetryPolicy, field.TypeJSON, value)
+		_node.RetryPolicy = value
+	}
 	if value, ok := _c.mutation.TriggeredBy(); ok {
 		_spec.SetField(deploymentjob.FieldTriggeredBy, field.TypeEnum, value)
 		_node.TriggeredBy = value
 	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(deploymentjob.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
 	if value, ok := _c.mutation.Result(); ok {
 		_spec.SetField(deploymentjob.FieldResult, field.TypeJSON, value)
 		_node.Result = value
@@ -550,6 +701,14 @@ func (_c *DeploymentJobCreate) createSpec() (*DeploymentJob, *sqlgraph.CreateSpe
 		_spec.SetField(deploymentjob.FieldNextRetryAt, field.TypeTime, value)
 		_node.NextRetryAt = &value
 	}
+	if value, ok := _c.mutation.ConfigurationRevisionID(); ok {
+		_spec.SetField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32, value)
+		_node.ConfigurationRevisionID = &value
+	}
+	if value, ok := _c.mutation.ErrorCategory(); ok {
+		_spec.SetField(deploymentjob.FieldErrorCategory, field.TypeEnum, value)
+		_node.ErrorCategory = &value
+	}
 	if nodes := _c.mutation.DeploymentTargetIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -829,6 +988,78 @@ func (u *DeploymentJobUpsert) ClearCertificateSerial() *DeploymentJobUpsert {
 	return u
 }
 
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (u *DeploymentJobUpsert) SetCertificateCommonName(v string) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldCertificateCommonName, v)
+	return u
+}
+
+// UpdateCertificateCommonName sets the "certificate_common_name" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateCertificateCommonName() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldCertificateCommonName)
+	return u
+}
+
+// ClearCertificateCommonName clears the value of the "certificate_common_name" field.
+func (u *DeploymentJobUpsert) ClearCertificateCommonName() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldCertificateCommonName)
+	return u
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (u *DeploymentJobUpsert) SetCertificateLineage(v string) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldCertificateLineage, v)
+	return u
+}
+
+// UpdateCertificateLineage sets the "certificate_lineage" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateCertificateLineage() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldCertificateLineage)
+	return u
+}
+
+// ClearCertificateLineage clears the value of the "certificate_lineage" field.
+func (u *DeploymentJobUpsert) ClearCertificateLineage() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldCertificateLineage)
+	return u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *DeploymentJobUpsert) SetIdempotencyKey(v string) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldIdempotencyKey, v)
+	return u
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateIdempotencyKey() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldIdempotencyKey)
+	return u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *DeploymentJobUpsert) ClearIdempotencyKey() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldIdempotencyKey)
+	return u
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (u *DeploymentJobUpsert) SetSupersededByJobID(v string) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldSupersededByJobID, v)
+	return u
+}
+
+// UpdateSupersededByJobID sets the "superseded_by_job_id" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateSupersededByJobID() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldSupersededByJobID)
+	return u
+}
+
+// ClearSupersededByJobID clears the value of the "superseded_by_job_id" field.
+func (u *DeploymentJobUpsert) ClearSupersededByJobID() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldSupersededByJobID)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *DeploymentJobUpsert) SetStatus(v deploymentjob.Status) *DeploymentJobUpsert {
 	u.Set(deploymentjob.FieldStatus, v)
@@ -913,6 +1144,24 @@ func (u *DeploymentJobUpsert) AddMaxRetries(v int32) *DeploymentJobUpsert {
 	return u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *DeploymentJobUpsert) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldRetryPolicy, v)
+	return u
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateRetryPolicy() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldRetryPolicy)
+	return u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *DeploymentJobUpsert) ClearRetryPolicy() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldRetryPolicy)
+	return u
+}
+
 // SetTriggeredBy sets the "triggered_by" field.
 func (u *DeploymentJobUpsert) SetTriggeredBy(v deploymentjob.TriggeredBy) *DeploymentJobUpsert {
 	u.Set(deploymentjob.FieldTriggeredBy, v)
@@ -925,6 +1174,18 @@ func (u *DeploymentJobUpsert) UpdateTriggeredBy() *DeploymentJobUpsert {
 	return u
 }
 
+// SetAction sets the "action" field.
+func (u *DeploymentJobUpsert) SetAction(v deploymentjob.Action) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateAction() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldAction)
+	return u
+}
+
 // SetResult sets the "result" field.
 func (u *DeploymentJobUpsert) SetResult(v map[string]interface{}) *DeploymentJobUpsert {
 	u.Set(deploymentjob.FieldResult, v)
@@ -997,6 +1258,48 @@ func (u *DeploymentJobUpsert) ClearNextRetryAt() *DeploymentJobUpsert {
 	return u
 }
 
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (u *DeploymentJobUpsert) SetConfigurationRevisionID(v uint32) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldConfigurationRevisionID, v)
+	return u
+}
+
+// UpdateConfigurationRevisionID sets the "configuration_revision_id" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateConfigurationRevisionID() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldConfigurationRevisionID)
+	return u
+}
+
+// AddConfigurationRevisionID adds v to the "configuration_revision_id" field.
+func (u *DeploymentJobUpsert) AddConfigurationRevisionID(v uint32) *DeploymentJobUpsert {
+	u.Add(deploymentjob.FieldConfigurationRevisionID, v)
+	return u
+}
+
+// ClearConfigurationRevisionID clears the value of the "configuration_revision_id" field.
+func (u *DeploymentJobUpsert) ClearConfigurationRevisionID() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldConfigurationRevisionID)
+	return u
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (u *DeploymentJobUpsert) SetErrorCategory(v deploymentjob.ErrorCategory) *DeploymentJobUpsert {
+	u.Set(deploymentjob.FieldErrorCategory, v)
+	return u
+}
+
+// UpdateErrorCategory sets the "error_category" field to the value that was provided on create.
+func (u *DeploymentJobUpsert) UpdateErrorCategory() *DeploymentJobUpsert {
+	u.SetExcluded(deploymentjob.FieldErrorCategory)
+	return u
+}
+
+// ClearErrorCategory clears the value of the "error_category" field.
+func (u *DeploymentJobUpsert) ClearErrorCategory() *DeploymentJobUpsert {
+	u.SetNull(deploymentjob.FieldErrorCategory)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1219,6 +1522,90 @@ func (u *DeploymentJobUpsertOne) ClearCertificateSerial() *DeploymentJobUpsertOn
 	})
 }
 
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (u *DeploymentJobUpsertOne) SetCertificateCommonName(v string) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetCertificateCommonName(v)
+	})
+}
+
+// UpdateCertificateCommonName sets the "certificate_common_name" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateCertificateCommonName() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateCertificateCommonName()
+	})
+}
+
+// ClearCertificateCommonName clears the value of the "certificate_common_name" field.
+func (u *DeploymentJobUpsertOne) ClearCertificateCommonName() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearCertificateCommonName()
+	})
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (u *DeploymentJobUpsertOne) SetCertificateLineage(v string) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetCertificateLineage(v)
+	})
+}
+
+// UpdateCertificateLineage sets the "certificate_lineage" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateCertificateLineage() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateCertificateLineage()
+	})
+}
+
+// ClearCertificateLineage clears the value of the "certificate_lineage" field.
+func (u *DeploymentJobUpsertOne) ClearCertificateLineage() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearCertificateLineage()
+	})
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *DeploymentJobUpsertOne) SetIdempotencyKey(v string) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateIdempotencyKey() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *DeploymentJobUpsertOne) ClearIdempotencyKey() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (u *DeploymentJobUpsertOne) SetSupersededByJobID(v string) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetSupersededByJobID(v)
+	})
+}
+
+// UpdateSupersededByJobID sets the "superseded_by_job_id" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateSupersededByJobID() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateSupersededByJobID()
+	})
+}
+
+// ClearSupersededByJobID clears the value of the "superseded_by_job_id" field.
+func (u *DeploymentJobUpsertOne) ClearSupersededByJobID() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearSupersededByJobID()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *DeploymentJobUpsertOne) SetStatus(v deploymentjob.Status) *DeploymentJobUpsertOne {
 	return u.Update(func(s *DeploymentJobUpsert) {
@@ -1317,6 +1704,27 @@ func (u *DeploymentJobUpsertOne) UpdateMaxRetries() *DeploymentJobUpsertOne {
 	})
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *DeploymentJobUpsertOne) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateRetryPolicy() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *DeploymentJobUpsertOne) ClearRetryPolicy() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
 // SetTriggeredBy sets the "triggered_by" field.
 func (u *DeploymentJobUpsertOne) SetTriggeredBy(v deploymentjob.TriggeredBy) *DeploymentJobUpsertOne {
 	return u.Update(func(s *DeploymentJobUpsert) {
@@ -1331,6 +1739,20 @@ func (u *DeploymentJobUpsertOne) UpdateTriggeredBy() *DeploymentJobUpsertOne {
 	})
 }
 
+// SetAction sets the "action" field.
+func (u *DeploymentJobUpsertOne) SetAction(v deploymentjob.Action) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateAction() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateAction()
+	})
+}
+
 // SetResult sets the "result" field.
 func (u *DeploymentJobUpsertOne) SetResult(v map[string]interface{}) *DeploymentJobUpsertOne {
 	return u.Update(func(s *DeploymentJobUpsert) {
@@ -1415,6 +1837,55 @@ func (u *DeploymentJobUpsertOne) ClearNextRetryAt() *DeploymentJobUpsertOne {
 	})
 }
 
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (u *DeploymentJobUpsertOne) SetConfigurationRevisionID(v uint32) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetConfigurationRevisionID(v)
+	})
+}
+
+// AddConfigurationRevisionID adds v to the "configuration_revision_id" field.
+func (u *DeploymentJobUpsertOne) AddConfigurationRevisionID(v uint32) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.AddConfigurationRevisionID(v)
+	})
+}
+
+// UpdateConfigurationRevisionID sets the "configuration_revision_id" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateConfigurationRevisionID() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateConfigurationRevisionID()
+	})
+}
+
+// ClearConfigurationRevisionID clears the value of the "configuration_revision_id" field.
+func (u *DeploymentJobUpsertOne) ClearConfigurationRevisionID() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearConfigurationRevisionID()
+	})
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (u *DeploymentJobUpsertOne) SetErrorCategory(v deploymentjob.ErrorCategory) *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetErrorCategory(v)
+	})
+}
+
+// UpdateErrorCategory sets the "error_category" field to the value that was provided on create.
+func (u *DeploymentJobUpsertOne) UpdateErrorCategory() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateErrorCategory()
+	})
+}
+
+// ClearErrorCategory clears the value of the "error_category" field.
+func (u *DeploymentJobUpsertOne) ClearErrorCategory() *DeploymentJobUpsertOne {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearErrorCategory()
+	})
+}
+
 // Exec executes the query.
 func (u *DeploymentJobUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1804,6 +2275,90 @@ func (u *DeploymentJobUpsertBulk) ClearCertificateSerial() *DeploymentJobUpsertB
 	})
 }
 
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (u *DeploymentJobUpsertBulk) SetCertificateCommonName(v string) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetCertificateCommonName(v)
+	})
+}
+
+// UpdateCertificateCommonName sets the "certificate_common_name" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateCertificateCommonName() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateCertificateCommonName()
+	})
+}
+
+// ClearCertificateCommonName clears the value of the "certificate_common_name" field.
+func (u *DeploymentJobUpsertBulk) ClearCertificateCommonName() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearCertificateCommonName()
+	})
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (u *DeploymentJobUpsertBulk) SetCertificateLineage(v string) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetCertificateLineage(v)
+	})
+}
+
+// UpdateCertificateLineage sets the "certificate_lineage" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateCertificateLineage() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateCertificateLineage()
+	})
+}
+
+// ClearCertificateLineage clears the value of the "certificate_lineage" field.
+func (u *DeploymentJobUpsertBulk) ClearCertificateLineage() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearCertificateLineage()
+	})
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *DeploymentJobUpsertBulk) SetIdempotencyKey(v string) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateIdempotencyKey() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *DeploymentJobUpsertBulk) ClearIdempotencyKey() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (u *DeploymentJobUpsertBulk) SetSupersededByJobID(v string) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetSupersededByJobID(v)
+	})
+}
+
+// UpdateSupersededByJobID sets the "superseded_by_job_id" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateSupersededByJobID() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateSupersededByJobID()
+	})
+}
+
+// ClearSupersededByJobID clears the value of the "superseded_by_job_id" field.
+func (u *DeploymentJobUpsertBulk) ClearSupersededByJobID() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearSupersededByJobID()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *DeploymentJobUpsertBulk) SetStatus(v deploymentjob.Status) *DeploymentJobUpsertBulk {
 	return u.Update(func(s *DeploymentJobUpsert) {
@@ -1902,6 +2457,27 @@ func (u *DeploymentJobUpsertBulk) UpdateMaxRetries() *DeploymentJobUpsertBulk {
 	})
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *DeploymentJobUpsertBulk) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateRetryPolicy() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *DeploymentJobUpsertBulk) ClearRetryPolicy() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
 // SetTriggeredBy sets the "triggered_by" field.
 func (u *DeploymentJobUpsertBulk) SetTriggeredBy(v deploymentjob.TriggeredBy) *DeploymentJobUpsertBulk {
 	return u.Update(func(s *DeploymentJobUpsert) {
@@ -1916,6 +2492,20 @@ func (u *DeploymentJobUpsertBulk) UpdateTriggeredBy() *DeploymentJobUpsertBulk {
 	})
 }
 
+// SetAction sets the "action" field.
+func (u *DeploymentJobUpsertBulk) SetAction(v deploymentjob.Action) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateAction() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateAction()
+	})
+}
+
 // SetResult sets the "result" field.
 func (u *DeploymentJobUpsertBulk) SetResult(v map[string]interface{}) *DeploymentJobUpsertBulk {
 	return u.Update(func(s *DeploymentJobUpsert) {
@@ -2000,6 +2590,55 @@ func (u *DeploymentJobUpsertBulk) ClearNextRetryAt() *DeploymentJobUpsertBulk {
 	})
 }
 
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (u *DeploymentJobUpsertBulk) SetConfigurationRevisionID(v uint32) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetConfigurationRevisionID(v)
+	})
+}
+
+// AddConfigurationRevisionID adds v to the "configuration_revision_id" field.
+func (u *DeploymentJobUpsertBulk) AddConfigurationRevisionID(v uint32) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.AddConfigurationRevisionID(v)
+	})
+}
+
+// UpdateConfigurationRevisionID sets the "configuration_revision_id" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateConfigurationRevisionID() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateConfigurationRevisionID()
+	})
+}
+
+// ClearConfigurationRevisionID clears the value of the "configuration_revision_id" field.
+func (u *DeploymentJobUpsertBulk) ClearConfigurationRevisionID() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearConfigurationRevisionID()
+	})
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (u *DeploymentJobUpsertBulk) SetErrorCategory(v deploymentjob.ErrorCategory) *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.SetErrorCategory(v)
+	})
+}
+
+// UpdateErrorCategory sets the "error_category" field to the value that was provided on create.
+func (u *DeploymentJobUpsertBulk) UpdateErrorCategory() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.UpdateErrorCategory()
+	})
+}
+
+// ClearErrorCategory clears the value of the "error_category" field.
+func (u *DeploymentJobUpsertBulk) ClearErrorCategory() *DeploymentJobUpsertBulk {
+	return u.Update(func(s *DeploymentJobUpsert) {
+		s.ClearErrorCategory()
+	})
+}
+
 // Exec executes the query.
 func (u *DeploymentJobUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
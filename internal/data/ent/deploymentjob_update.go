@@ -13,6 +13,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -194,6 +195,86 @@ func (_u *DeploymentJobUpdate) ClearCertificateSerial() *DeploymentJobUpdate {
 	return _u
 }
 
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (_u *DeploymentJobUpdate) SetCertificateCommonName(v string) *DeploymentJobUpdate {
+	_u.mutation.SetCertificateCommonName(v)
+	return _u
+}
+
+// SetNillableCertificateCommonName sets the "certificate_common_name" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableCertificateCommonName(v *string) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetCertificateCommonName(*v)
+	}
+	return _u
+}
+
+// ClearCertificateCommonName clears the value of the "certificate_common_name" field.
+func (_u *DeploymentJobUpdate) ClearCertificateCommonName() *DeploymentJobUpdate {
+	_u.mutation.ClearCertificateCommonName()
+	return _u
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (_u *DeploymentJobUpdate) SetCertificateLineage(v string) *DeploymentJobUpdate {
+	_u.mutation.SetCertificateLineage(v)
+	return _u
+}
+
+// SetNillableCertificateLineage sets the "certificate_lineage" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableCertificateLineage(v *string) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetCertificateLineage(*v)
+	}
+	return _u
+}
+
+// ClearCertificateLineage clears the value of the "certificate_lineage" field.
+func (_u *DeploymentJobUpdate) ClearCertificateLineage() *DeploymentJobUpdate {
+	_u.mutation.ClearCertificateLineage()
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *DeploymentJobUpdate) SetIdempotencyKey(v string) *DeploymentJobUpdate {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableIdempotencyKey(v *string) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *DeploymentJobUpdate) ClearIdempotencyKey() *DeploymentJobUpdate {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (_u *DeploymentJobUpdate) SetSupersededByJobID(v string) *DeploymentJobUpdate {
+	_u.mutation.SetSupersededByJobID(v)
+	return _u
+}
+
+// SetNillableSupersededByJobID sets the "superseded_by_job_id" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableSupersededByJobID(v *string) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetSupersededByJobID(*v)
+	}
+	return _u
+}
+
+// ClearSupersededByJobID clears the value of the "superseded_by_job_id" field.
+func (_u *DeploymentJobUpdate) ClearSupersededByJobID() *DeploymentJobUpdate {
+	_u.mutation.ClearSupersededByJobID()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *DeploymentJobUpdate) SetStatus(v deploymentjob.Status) *DeploymentJobUpdate {
 	_u.mutation.SetStatus(v)
@@ -291,6 +372,18 @@ func (_u *DeploymentJobUpdate) AddMaxRetries(v int32) *DeploymentJobUpdate {
 	return _u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *DeploymentJobUpdate) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentJobUpdate {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *DeploymentJobUpdate) ClearRetryPolicy() *DeploymentJobUpdate {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
 // SetTriggeredBy sets the "triggered_by" field.
 func (_u *DeploymentJobUpdate) SetTriggeredBy(v deploymentjob.TriggeredBy) *DeploymentJobUpdate {
 	_u.mutation.SetTriggeredBy(v)
@@ -305,6 +398,20 @@ func (_u *DeploymentJobUpdate) SetNillableTriggeredBy(v *deploymentjob.Triggered
 	return _u
 }
 
+// SetAction sets the "action" field.
+func (_u *DeploymentJobUpdate) SetAction(v deploymentjob.Action) *DeploymentJobUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableAction(v *deploymentjob.Action) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
 // SetResult sets the "result" field.
 func (_u *DeploymentJobUpdate) SetResult(v map[string]interface{}) *DeploymentJobUpdate {
 	_u.mutation.SetResult(v)
@@ -377,6 +484,53 @@ func (_u *DeploymentJobUpdate) ClearNextRetryAt() *DeploymentJobUpdate {
 	return _u
 }
 
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (_u *DeploymentJobUpdate) SetConfigurationRevisionID(v uint32) *DeploymentJobUpdate {
+	_u.mutation.ResetConfigurationRevisionID()
+	_u.mutation.SetConfigurationRevisionID(v)
+	return _u
+}
+
+// SetNillableConfigurationRevisionID sets the "configuration_revision_id" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableConfigurationRevisionID(v *uint32) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetConfigurationRevisionID(*v)
+	}
+	return _u
+}
+
+// AddConfigurationRevisionID adds value to the "configuration_revision_id" field.
+func (_u *DeploymentJobUpdate) AddConfigurationRevisionID(v int32) *DeploymentJobUpdate {
+	_u.mutation.AddConfigurationRevisionID(v)
+	return _u
+}
+
+// ClearConfigurationRevisionID clears the value of the "configuration_revision_id" field.
+func (_u *DeploymentJobUpdate) ClearConfigurationRevisionID() *DeploymentJobUpdate {
+	_u.mutation.ClearConfigurationRevisionID()
+	return _u
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (_u *DeploymentJobUpdate) SetErrorCategory(v deploymentjob.ErrorCategory) *DeploymentJobUpdate {
+	_u.mutation.SetErrorCategory(v)
+	return _u
+}
+
+// SetNillableErrorCategory sets the "error_category" field if the given value is not nil.
+func (_u *DeploymentJobUpdate) SetNillableErrorCategory(v *deploymentjob.ErrorCategory) *DeploymentJobUpdate {
+	if v != nil {
+		_u.SetErrorCategory(*v)
+	}
+	return _u
+}
+
+// ClearErrorCategory clears the value of the "error_category" field.
+func (_u *DeploymentJobUpdate) ClearErrorCategory() *DeploymentJobUpdate {
+	_u.mutation.ClearErrorCategory()
+	return _u
+}
+
 // SetDeploymentTarget sets the "deployment_target" edge to the DeploymentTarget entity.
 func (_u *DeploymentJobUpdate) SetDeploymentTarget(v *DeploymentTarget) *DeploymentJobUpdate {
 	return _u.SetDeploymentTargetID(v.ID)
@@ -521,6 +675,11 @@ func (_u *DeploymentJobUpdate) check() error {
 			return &ValidationError{Name: "certificate_id", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.certificate_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := deploymentjob.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.idempotency_key": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := deploymentjob.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.status": %w`, err)}
@@ -531,6 +690,16 @@ func (_u *DeploymentJobUpdate) check() error {
 			return &ValidationError{Name: "triggered_by", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.triggered_by": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := deploymentjob.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorCategory(); ok {
+		if err := deploymentjob.ErrorCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "error_category", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.error_category": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -588,6 +757,30 @@ func (_u *DeploymentJobUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.CertificateSerialCleared() {
 		_spec.ClearField(deploymentjob.FieldCertificateSerial, field.TypeString)
 	}
+	if value, ok := _u.mutation.CertificateCommonName(); ok {
+		_spec.SetField(deploymentjob.FieldCertificateCommonName, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCommonNameCleared() {
+		_spec.ClearField(deploymentjob.FieldCertificateCommonName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertificateLineage(); ok {
+		_spec.SetField(deploymentjob.FieldCertificateLineage, field.TypeString, value)
+	}
+	if _u.mutation.CertificateLineageCleared() {
+		_spec.ClearField(deploymentjob.FieldCertificateLineage, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(deploymentjob.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(deploymentjob.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SupersededByJobID(); ok {
+		_spec.SetField(deploymentjob.FieldSupersededByJobID, field.TypeString, value)
+	}
+	if _u.mutation.SupersededByJobIDCleared() {
+		_spec.ClearField(deploymentjob.FieldSupersededByJobID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(deploymentjob.FieldStatus, field.TypeEnum, value)
 	}
@@ -615,9 +808,18 @@ func (_u *DeploymentJobUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedMaxRetries(); ok {
 		_spec.AddField(deploymentjob.FieldMaxRetries, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(deploymentjob.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(deploymentjob.FieldRetryPolicy, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.TriggeredBy(); ok {
 		_spec.SetField(deploymentjob.FieldTriggeredBy, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(deploymentjob.FieldAction, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Result(); ok {
 		_spec.SetField(deploymentjob.FieldResult, field.TypeJSON, value)
 	}
@@ -642,6 +844,21 @@ func (_u *DeploymentJobUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.NextRetryAtCleared() {
 		_spec.ClearField(deploymentjob.FieldNextRetryAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ConfigurationRevisionID(); ok {
+		_spec.SetField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedConfigurationRevisionID(); ok {
+		_spec.AddField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32, value)
+	}
+	if _u.mutation.ConfigurationRevisionIDCleared() {
+		_spec.ClearField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ErrorCategory(); ok {
+		_spec.SetField(deploymentjob.FieldErrorCategory, field.TypeEnum, value)
+	}
+	if _u.mutation.ErrorCategoryCleared() {
+		_spec.ClearField(deploymentjob.FieldErrorCategory, field.TypeEnum)
+	}
 	if _u.mutation.DeploymentTargetCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1002,6 +1219,86 @@ func (_u *DeploymentJobUpdateOne) ClearCertificateSerial() *DeploymentJobUpdateO
 	return _u
 }
 
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (_u *DeploymentJobUpdateOne) SetCertificateCommonName(v string) *DeploymentJobUpdateOne {
+	_u.mutation.SetCertificateCommonName(v)
+	return _u
+}
+
+// SetNillableCertificateCommonName sets the "certificate_common_name" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableCertificateCommonName(v *string) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetCertificateCommonName(*v)
+	}
+	return _u
+}
+
+// ClearCertificateCommonName clears the value of the "certificate_common_name" field.
+func (_u *DeploymentJobUpdateOne) ClearCertificateCommonName() *DeploymentJobUpdateOne {
+	_u.mutation.ClearCertificateCommonName()
+	return _u
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (_u *DeploymentJobUpdateOne) SetCertificateLineage(v string) *DeploymentJobUpdateOne {
+	_u.mutation.SetCertificateLineage(v)
+	return _u
+}
+
+// SetNillableCertificateLineage sets the "certificate_lineage" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableCertificateLineage(v *string) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetCertificateLineage(*v)
+	}
+	return _u
+}
+
+// ClearCertificateLineage clears the value of the "certificate_lineage" field.
+func (_u *DeploymentJobUpdateOne) ClearCertificateLineage() *DeploymentJobUpdateOne {
+	_u.mutation.ClearCertificateLineage()
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *DeploymentJobUpdateOne) SetIdempotencyKey(v string) *DeploymentJobUpdateOne {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableIdempotencyKey(v *string) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *DeploymentJobUpdateOne) ClearIdempotencyKey() *DeploymentJobUpdateOne {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (_u *DeploymentJobUpdateOne) SetSupersededByJobID(v string) *DeploymentJobUpdateOne {
+	_u.mutation.SetSupersededByJobID(v)
+	return _u
+}
+
+// SetNillableSupersededByJobID sets the "superseded_by_job_id" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableSupersededByJobID(v *string) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetSupersededByJobID(*v)
+	}
+	return _u
+}
+
+// ClearSupersededByJobID clears the value of the "superseded_by_job_id" field.
+func (_u *DeploymentJobUpdateOne) ClearSupersededByJobID() *DeploymentJobUpdateOne {
+	_u.mutation.ClearSupersededByJobID()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *DeploymentJobUpdateOne) SetStatus(v deploymentjob.Status) *DeploymentJobUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -1099,6 +1396,18 @@ func (_u *DeploymentJobUpdateOne) AddMaxRetries(v int32) *DeploymentJobUpdateOne
 	return _u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *DeploymentJobUpdateOne) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentJobUpdateOne {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *DeploymentJobUpdateOne) ClearRetryPolicy() *DeploymentJobUpdateOne {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
 // SetTriggeredBy sets the "triggered_by" field.
 func (_u *DeploymentJobUpdateOne) SetTriggeredBy(v deploymentjob.TriggeredBy) *DeploymentJobUpdateOne {
 	_u.mutation.SetTriggeredBy(v)
@@ -1113,6 +1422,20 @@ func (_u *DeploymentJobUpdateOne) SetNillableTriggeredBy(v *deploymentjob.Trigge
 	return _u
 }
 
+// SetAction sets the "action" field.
+func (_u *DeploymentJobUpdateOne) SetAction(v deploymentjob.Action) *DeploymentJobUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableAction(v *deploymentjob.Action) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
 // SetResult sets the "result" field.
 func (_u *DeploymentJobUpdateOne) SetResult(v map[string]interface{}) *DeploymentJobUpdateOne {
 	_u.mutation.SetResult(v)
@@ -1185,6 +1508,53 @@ func (_u *DeploymentJobUpdateOne) ClearNextRetryAt() *DeploymentJobUpdateOne {
 	return _u
 }
 
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (_u *DeploymentJobUpdateOne) SetConfigurationRevisionID(v uint32) *DeploymentJobUpdateOne {
+	_u.mutation.ResetConfigurationRevisionID()
+	_u.mutation.SetConfigurationRevisionID(v)
+	return _u
+}
+
+// SetNillableConfigurationRevisionID sets the "configuration_revision_id" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableConfigurationRevisionID(v *uint32) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetConfigurationRevisionID(*v)
+	}
+	return _u
+}
+
+// AddConfigurationRevisionID adds value to the "configuration_revision_id" field.
+func (_u *DeploymentJobUpdateOne) AddConfigurationRevisionID(v int32) *DeploymentJobUpdateOne {
+	_u.mutation.AddConfigurationRevisionID(v)
+	return _u
+}
+
+// ClearConfigurationRevisionID clears the value of the "configuration_revision_id" field.
+func (_u *DeploymentJobUpdateOne) ClearConfigurationRevisionID() *DeploymentJobUpdateOne {
+	_u.mutation.ClearConfigurationRevisionID()
+	return _u
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (_u *DeploymentJobUpdateOne) SetErrorCategory(v deploymentjob.ErrorCategory) *DeploymentJobUpdateOne {
+	_u.mutation.SetErrorCategory(v)
+	return _u
+}
+
+// SetNillableErrorCategory sets the "error_category" field if the given value is not nil.
+func (_u *DeploymentJobUpdateOne) SetNillableErrorCategory(v *deploymentjob.ErrorCategory) *DeploymentJobUpdateOne {
+	if v != nil {
+		_u.SetErrorCategory(*v)
+	}
+	return _u
+}
+
+// ClearErrorCategory clears the value of the "error_category" field.
+func (_u *DeploymentJobUpdateOne) ClearErrorCategory() *DeploymentJobUpdateOne {
+	_u.mutation.ClearErrorCategory()
+	return _u
+}
+
 // SetDeploymentTarget sets the "deployment_target" edge to the DeploymentTarget entity.
 func (_u *DeploymentJobUpdateOne) SetDeploymentTarget(v *DeploymentTarget) *DeploymentJobUpdateOne {
 	return _u.SetDeploymentTargetID(v.ID)
@@ -1342,6 +1712,11 @@ func (_u *DeploymentJobUpdateOne) check() error {
 			return &ValidationError{Name: "certificate_id", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.certificate_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := deploymentjob.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.idempotency_key": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := deploymentjob.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.status": %w`, err)}
@@ -1352,6 +1727,16 @@ func (_u *DeploymentJobUpdateOne) check() error {
 			return &ValidationError{Name: "triggered_by", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.triggered_by": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := deploymentjob.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorCategory(); ok {
+		if err := deploymentjob.ErrorCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "error_category", err: fmt.Errorf(`ent: validator failed for field "DeploymentJob.error_category": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1426,6 +1811,30 @@ func (_u *DeploymentJobUpdateOne) sqlSave(ctx context.Context) (_node *Deploymen
 	if _u.mutation.CertificateSerialCleared() {
 		_spec.ClearField(deploymentjob.FieldCertificateSerial, field.TypeString)
 	}
+	if value, ok := _u.mutation.CertificateCommonName(); ok {
+		_spec.SetField(deploymentjob.FieldCertificateCommonName, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCommonNameCleared() {
+		_spec.ClearField(deploymentjob.FieldCertificateCommonName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertificateLineage(); ok {
+		_spec.SetField(deploymentjob.FieldCertificateLineage, field.TypeString, value)
+	}
+	if _u.mutation.CertificateLineageCleared() {
+		_spec.ClearField(deploymentjob.FieldCertificateLineage, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(deploymentjob.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(deploymentjob.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SupersededByJobID(); ok {
+		_spec.SetField(deploymentjob.FieldSupersededByJobID, field.TypeString, value)
+	}
+	if _u.mutation.SupersededByJobIDCleared() {
+		_spec.ClearField(deploymentjob.FieldSupersededByJobID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(deploymentjob.FieldStatus, field.TypeEnum, value)
 	}
@@ -1453,9 +1862,18 @@ func (_u *DeploymentJobUpdateOne) sqlSave(ctx context.Context) (_node *Deploymen
 	if value, ok := _u.mutation.AddedMaxRetries(); ok {
 		_spec.AddField(deploymentjob.FieldMaxRetries, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(deploymentjob.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(deploymentjob.FieldRetryPolicy, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.TriggeredBy(); ok {
 		_spec.SetField(deploymentjob.FieldTriggeredBy, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(deploymentjob.FieldAction, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Result(); ok {
 		_spec.SetField(deploymentjob.FieldResult, field.TypeJSON, value)
 	}
@@ -1480,6 +1898,21 @@ func (_u *DeploymentJobUpdateOne) sqlSave(ctx context.Context) (_node *Deploymen
 	if _u.mutation.NextRetryAtCleared() {
 		_spec.ClearField(deploymentjob.FieldNextRetryAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ConfigurationRevisionID(); ok {
+		_spec.SetField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedConfigurationRevisionID(); ok {
+		_spec.AddField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32, value)
+	}
+	if _u.mutation.ConfigurationRevisionIDCleared() {
+		_spec.ClearField(deploymentjob.FieldConfigurationRevisionID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ErrorCategory(); ok {
+		_spec.SetField(deploymentjob.FieldErrorCategory, field.TypeEnum, value)
+	}
+	if _u.mutation.ErrorCategoryCleared() {
+		_spec.ClearField(deploymentjob.FieldErrorCategory, field.TypeEnum)
+	}
 	if _u.mutation.DeploymentTargetCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
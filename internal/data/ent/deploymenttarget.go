@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -41,6 +42,10 @@ type DeploymentTarget struct {
 	AutoDeployOnRenewal bool `json:"auto_deploy_on_renewal,omitempty"`
 	// Filters for auto-deployment
 	CertificateFilters []schema.CertificateFilter `json:"certificate_filters,omitempty"`
+	// Retry and timeout overrides for jobs deploying to this target
+	RetryPolicy *registry.RetryPolicy `json:"retry_policy,omitempty"`
+	// Whether revoked or deleted certificates are replaced or removed
+	RevocationPolicy deploymenttarget.RevocationPolicy `json:"revocation_policy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeploymentTargetQuery when eager-loading is set.
 	Edges        DeploymentTargetEdges `json:"edges"`
@@ -81,13 +86,13 @@ func (*DeploymentTarget) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deploymenttarget.FieldCertificateFilters:
+		case deploymenttarget.FieldCertificateFilters, deploymenttarget.FieldRetryPolicy:
 			values[i] = new([]byte)
 		case deploymenttarget.FieldAutoDeployOnRenewal:
 			values[i] = new(sql.NullBool)
 		case deploymenttarget.FieldCreateBy, deploymenttarget.FieldUpdateBy, deploymenttarget.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case deploymenttarget.FieldID, deploymenttarget.FieldName, deploymenttarget.FieldDescription:
+		case deploymenttarget.FieldID, deploymenttarget.FieldName, deploymenttarget.FieldDescription, deploymenttarget.FieldRevocationPolicy:
 			values[i] = new(sql.NullString)
 		case deploymenttarget.FieldCreateTime, deploymenttarget.FieldUpdateTime, deploymenttarget.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -180,6 +185,20 @@ func (_m *DeploymentTarget) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field certificate_filters: %w", err)
 				}
 			}
+		case deploymenttarget.FieldRetryPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetryPolicy); err != nil {
+					return fmt.Errorf("unmarshal field retry_policy: %w", err)
+				}
+			}
+		case deploymenttarget.FieldRevocationPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revocation_policy", values[i])
+			} else if value.Valid {
+				_m.RevocationPolicy = deploymenttarget.RevocationPolicy(value.String)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -267,6 +286,12 @@ func (_m *DeploymentTarget) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("certificate_filters=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CertificateFilters))
+	builder.WriteString(", ")
+	builder.WriteString("retry_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("revocation_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RevocationPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package deploymenttarget
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -33,6 +35,10 @@ const (
 	FieldAutoDeployOnRenewal = "auto_deploy_on_renewal"
 	// FieldCertificateFilters holds the string denoting the certificate_filters field in the database.
 	FieldCertificateFilters = "certificate_filters"
+	// FieldRetryPolicy holds the string denoting the retry_policy field in the database.
+	FieldRetryPolicy = "retry_policy"
+	// FieldRevocationPolicy holds the string denoting the revocation_policy field in the database.
+	FieldRevocationPolicy = "revocation_policy"
 	// EdgeConfigurations holds the string denoting the configurations edge name in mutations.
 	EdgeConfigurations = "configurations"
 	// EdgeJobs holds the string denoting the jobs edge name in mutations.
@@ -66,6 +72,8 @@ var Columns = []string{
 	FieldDescription,
 	FieldAutoDeployOnRenewal,
 	FieldCertificateFilters,
+	FieldRetryPolicy,
+	FieldRevocationPolicy,
 }
 
 var (
@@ -102,6 +110,32 @@ var (
 	IDValidator func(string) error
 )
 
+// RevocationPolicy defines the type for the "revocation_policy" enum field.
+type RevocationPolicy string
+
+// RevocationPolicyREVOCATION_POLICY_REDEPLOY is the default value of the RevocationPolicy enum.
+const DefaultRevocationPolicy = RevocationPolicyREVOCATION_POLICY_REDEPLOY
+
+// RevocationPolicy values.
+const (
+	RevocationPolicyREVOCATION_POLICY_REDEPLOY RevocationPolicy = "REVOCATION_POLICY_REDEPLOY"
+	RevocationPolicyREVOCATION_POLICY_REMOVE   RevocationPolicy = "REVOCATION_POLICY_REMOVE"
+)
+
+func (rp RevocationPolicy) String() string {
+	return string(rp)
+}
+
+// RevocationPolicyValidator is a validator for the "revocation_policy" field enum values. It is called by the builders before save.
+func RevocationPolicyValidator(rp RevocationPolicy) error {
+	switch rp {
+	case RevocationPolicyREVOCATION_POLICY_REDEPLOY, RevocationPolicyREVOCATION_POLICY_REMOVE:
+		return nil
+	default:
+		return fmt.Errorf("deploymenttarget: invalid enum value for revocation_policy field: %q", rp)
+	}
+}
+
 // OrderOption defines the ordering options for the DeploymentTarget queries.
 type OrderOption func(*sql.Selector)
 
@@ -155,6 +189,11 @@ func ByAutoDeployOnRenewal(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAutoDeployOnRenewal, opts...).ToFunc()
 }
 
+// ByRevocationPolicy orders the results by the revocation_policy field.
+func ByRevocationPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevocationPolicy, opts...).ToFunc()
+}
+
 // ByConfigurationsCount orders the results by configurations count.
 func ByConfigurationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -571,6 +571,36 @@ func CertificateFiltersNotNil() predicate.DeploymentTarget {
 	return predicate.DeploymentTarget(sql.FieldNotNull(FieldCertificateFilters))
 }
 
+// RetryPolicyIsNil applies the IsNil predicate on the "retry_policy" field.
+func RetryPolicyIsNil() predicate.DeploymentTarget {
+	return predicate.DeploymentTarget(sql.FieldIsNull(FieldRetryPolicy))
+}
+
+// RetryPolicyNotNil applies the NotNil predicate on the "retry_policy" field.
+func RetryPolicyNotNil() predicate.DeploymentTarget {
+	return predicate.DeploymentTarget(sql.FieldNotNull(FieldRetryPolicy))
+}
+
+// RevocationPolicyEQ applies the EQ predicate on the "revocation_policy" field.
+func RevocationPolicyEQ(v RevocationPolicy) predicate.DeploymentTarget {
+	return predicate.DeploymentTarget(sql.FieldEQ(FieldRevocationPolicy, v))
+}
+
+// RevocationPolicyNEQ applies the NEQ predicate on the "revocation_policy" field.
+func RevocationPolicyNEQ(v RevocationPolicy) predicate.DeploymentTarget {
+	return predicate.DeploymentTarget(sql.FieldNEQ(FieldRevocationPolicy, v))
+}
+
+// RevocationPolicyIn applies the In predicate on the "revocation_policy" field.
+func RevocationPolicyIn(vs ...RevocationPolicy) predicate.DeploymentTarget {
+	return predicate.DeploymentTarget(sql.FieldIn(FieldRevocationPolicy, vs...))
+}
+
+// RevocationPolicyNotIn applies the NotIn predicate on the "revocation_policy" field.
+func RevocationPolicyNotIn(vs ...RevocationPolicy) predicate.DeploymentTarget {
+	return predicate.DeploymentTarget(sql.FieldNotIn(FieldRevocationPolicy, vs...))
+}
+
 // HasConfigurations applies the HasEdge predicate on the "configurations" edge.
 func HasConfigurations() predicate.DeploymentTarget {
 	return predicate.DeploymentTarget(func(s *sql.Selector) {
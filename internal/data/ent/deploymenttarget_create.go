@@ -12,6 +12,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -151,6 +152,26 @@ func (_c *DeploymentTargetCreate) SetCertificateFilters(v []schema.CertificateFi
 	return _c
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_c *DeploymentTargetCreate) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentTargetCreate {
+	_c.mutation.SetRetryPolicy(v)
+	return _c
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (_c *DeploymentTargetCreate) SetRevocationPolicy(v deploymenttarget.RevocationPolicy) *DeploymentTargetCreate {
+	_c.mutation.SetRevocationPolicy(v)
+	return _c
+}
+
+// SetNillableRevocationPolicy sets the "revocation_policy" field if the given value is not nil.
+func (_c *DeploymentTargetCreate) SetNillableRevocationPolicy(v *deploymenttarget.RevocationPolicy) *DeploymentTargetCreate {
+	if v != nil {
+		_c.SetRevocationPolicy(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DeploymentTargetCreate) SetID(v string) *DeploymentTargetCreate {
 	_c.mutation.SetID(v)
@@ -232,6 +253,10 @@ func (_c *DeploymentTargetCreate) defaults() error {
 		v := deploymenttarget.DefaultAutoDeployOnRenewal
 		_c.mutation.SetAutoDeployOnRenewal(v)
 	}
+	if _, ok := _c.mutation.RevocationPolicy(); !ok {
+		v := deploymenttarget.DefaultRevocationPolicy
+		_c.mutation.SetRevocationPolicy(v)
+	}
 	return nil
 }
 
@@ -248,6 +273,14 @@ func (_c *DeploymentTargetCreate) check() error {
 	if _, ok := _c.mutation.AutoDeployOnRenewal(); !ok {
 		return &ValidationError{Name: "auto_deploy_on_renewal", err: errors.New(`ent: missing required field "DeploymentTarget.auto_deploy_on_renewal"`)}
 	}
+	if _, ok := _c.mutation.RevocationPolicy(); !ok {
+		return &ValidationError{Name: "revocation_policy", err: errors.New(`ent: missing required field "DeploymentTarget.revocation_policy"`)}
+	}
+	if v, ok := _c.mutation.RevocationPolicy(); ok {
+		if err := deploymenttarget.RevocationPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "revocation_policy", err: fmt.Errorf(`ent: validator failed for field "DeploymentTarget.revocation_policy": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := deploymenttarget.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DeploymentTarget.id": %w`, err)}
@@ -329,6 +362,14 @@ func (_c *DeploymentTargetCreate) createSpec() (*DeploymentTarget, *sqlgraph.Cre
 		_spec.SetField(deploymenttarget.FieldCertificateFilters, field.TypeJSON, value)
 		_node.CertificateFilters = value
 	}
+	if value, ok := _c.mutation.RetryPolicy(); ok {
+		_spec.SetField(deploymenttarget.FieldRetryPolicy, field.TypeJSON, value)
+		_node.RetryPolicy = value
+	}
+	if value, ok := _c.mutation.RevocationPolicy(); ok {
+		_spec.SetField(deploymenttarget.FieldRevocationPolicy, field.TypeEnum, value)
+		_node.RevocationPolicy = value
+	}
 	if nodes := _c.mutation.ConfigurationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -557,6 +598,36 @@ func (u *DeploymentTargetUpsert) ClearCertificateFilters() *DeploymentTargetUpse
 	return u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *DeploymentTargetUpsert) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentTargetUpsert {
+	u.Set(deploymenttarget.FieldRetryPolicy, v)
+	return u
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *DeploymentTargetUpsert) UpdateRetryPolicy() *DeploymentTargetUpsert {
+	u.SetExcluded(deploymenttarget.FieldRetryPolicy)
+	return u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *DeploymentTargetUpsert) ClearRetryPolicy() *DeploymentTargetUpsert {
+	u.SetNull(deploymenttarget.FieldRetryPolicy)
+	return u
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (u *DeploymentTargetUpsert) SetRevocationPolicy(v deploymenttarget.RevocationPolicy) *DeploymentTargetUpsert {
+	u.Set(deploymenttarget.FieldRevocationPolicy, v)
+	return u
+}
+
+// UpdateRevocationPolicy sets the "revocation_policy" field to the value that was provided on create.
+func (u *DeploymentTargetUpsert) UpdateRevocationPolicy() *DeploymentTargetUpsert {
+	u.SetExcluded(deploymenttarget.FieldRevocationPolicy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -779,6 +850,41 @@ func (u *DeploymentTargetUpsertOne) ClearCertificateFilters() *DeploymentTargetU
 	})
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *DeploymentTargetUpsertOne) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentTargetUpsertOne {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *DeploymentTargetUpsertOne) UpdateRetryPolicy() *DeploymentTargetUpsertOne {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *DeploymentTargetUpsertOne) ClearRetryPolicy() *DeploymentTargetUpsertOne {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (u *DeploymentTargetUpsertOne) SetRevocationPolicy(v deploymenttarget.RevocationPolicy) *DeploymentTargetUpsertOne {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.SetRevocationPolicy(v)
+	})
+}
+
+// UpdateRevocationPolicy sets the "revocation_policy" field to the value that was provided on create.
+func (u *DeploymentTargetUpsertOne) UpdateRevocationPolicy() *DeploymentTargetUpsertOne {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.UpdateRevocationPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *DeploymentTargetUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1168,6 +1274,41 @@ func (u *DeploymentTargetUpsertBulk) ClearCertificateFilters() *DeploymentTarget
 	})
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *DeploymentTargetUpsertBulk) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentTargetUpsertBulk {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *DeploymentTargetUpsertBulk) UpdateRetryPolicy() *DeploymentTargetUpsertBulk {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *DeploymentTargetUpsertBulk) ClearRetryPolicy() *DeploymentTargetUpsertBulk {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (u *DeploymentTargetUpsertBulk) SetRevocationPolicy(v deploymenttarget.RevocationPolicy) *DeploymentTargetUpsertBulk {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.SetRevocationPolicy(v)
+	})
+}
+
+// UpdateRevocationPolicy sets the "revocation_policy" field to the value that was provided on create.
+func (u *DeploymentTargetUpsertBulk) UpdateRevocationPolicy() *DeploymentTargetUpsertBulk {
+	return u.Update(func(s *DeploymentTargetUpsert) {
+		s.UpdateRevocationPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *DeploymentTargetUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
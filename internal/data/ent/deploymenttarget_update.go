@@ -13,6 +13,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -194,6 +195,32 @@ func (_u *DeploymentTargetUpdate) ClearCertificateFilters() *DeploymentTargetUpd
 	return _u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *DeploymentTargetUpdate) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentTargetUpdate {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *DeploymentTargetUpdate) ClearRetryPolicy() *DeploymentTargetUpdate {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (_u *DeploymentTargetUpdate) SetRevocationPolicy(v deploymenttarget.RevocationPolicy) *DeploymentTargetUpdate {
+	_u.mutation.SetRevocationPolicy(v)
+	return _u
+}
+
+// SetNillableRevocationPolicy sets the "revocation_policy" field if the given value is not nil.
+func (_u *DeploymentTargetUpdate) SetNillableRevocationPolicy(v *deploymenttarget.RevocationPolicy) *DeploymentTargetUpdate {
+	if v != nil {
+		_u.SetRevocationPolicy(*v)
+	}
+	return _u
+}
+
 // AddConfigurationIDs adds the "configurations" edge to the TargetConfiguration entity by IDs.
 func (_u *DeploymentTargetUpdate) AddConfigurationIDs(ids ...string) *DeploymentTargetUpdate {
 	_u.mutation.AddConfigurationIDs(ids...)
@@ -305,6 +332,11 @@ func (_u *DeploymentTargetUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeploymentTarget.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RevocationPolicy(); ok {
+		if err := deploymenttarget.RevocationPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "revocation_policy", err: fmt.Errorf(`ent: validator failed for field "DeploymentTarget.revocation_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -385,6 +417,15 @@ func (_u *DeploymentTargetUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.CertificateFiltersCleared() {
 		_spec.ClearField(deploymenttarget.FieldCertificateFilters, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(deploymenttarget.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(deploymenttarget.FieldRetryPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RevocationPolicy(); ok {
+		_spec.SetField(deploymenttarget.FieldRevocationPolicy, field.TypeEnum, value)
+	}
 	if _u.mutation.ConfigurationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -657,6 +698,32 @@ func (_u *DeploymentTargetUpdateOne) ClearCertificateFilters() *DeploymentTarget
 	return _u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *DeploymentTargetUpdateOne) SetRetryPolicy(v *registry.RetryPolicy) *DeploymentTargetUpdateOne {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *DeploymentTargetUpdateOne) ClearRetryPolicy() *DeploymentTargetUpdateOne {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (_u *DeploymentTargetUpdateOne) SetRevocationPolicy(v deploymenttarget.RevocationPolicy) *DeploymentTargetUpdateOne {
+	_u.mutation.SetRevocationPolicy(v)
+	return _u
+}
+
+// SetNillableRevocationPolicy sets the "revocation_policy" field if the given value is not nil.
+func (_u *DeploymentTargetUpdateOne) SetNillableRevocationPolicy(v *deploymenttarget.RevocationPolicy) *DeploymentTargetUpdateOne {
+	if v != nil {
+		_u.SetRevocationPolicy(*v)
+	}
+	return _u
+}
+
 // AddConfigurationIDs adds the "configurations" edge to the TargetConfiguration entity by IDs.
 func (_u *DeploymentTargetUpdateOne) AddConfigurationIDs(ids ...string) *DeploymentTargetUpdateOne {
 	_u.mutation.AddConfigurationIDs(ids...)
@@ -781,6 +848,11 @@ func (_u *DeploymentTargetUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeploymentTarget.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RevocationPolicy(); ok {
+		if err := deploymenttarget.RevocationPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "revocation_policy", err: fmt.Errorf(`ent: validator failed for field "DeploymentTarget.revocation_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -878,6 +950,15 @@ func (_u *DeploymentTargetUpdateOne) sqlSave(ctx context.Context) (_node *Deploy
 	if _u.mutation.CertificateFiltersCleared() {
 		_spec.ClearField(deploymenttarget.FieldCertificateFilters, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(deploymenttarget.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(deploymenttarget.FieldRetryPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RevocationPolicy(); ok {
+		_spec.SetField(deploymenttarget.FieldRevocationPolicy, field.TypeEnum, value)
+	}
 	if _u.mutation.ConfigurationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
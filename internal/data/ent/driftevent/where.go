@@ -0,0 +1,906 @@
+// Code generated by ent, DO NOT EDIT.
+
+package driftevent
+
+import (
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TargetConfigurationID applies equality check predicate on the "target_configuration_id" field. It's identical to TargetConfigurationIDEQ.
+func TargetConfigurationID(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldTargetConfigurationID, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldJobID, v))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateSerial applies equality check predicate on the "certificate_serial" field. It's identical to CertificateSerialEQ.
+func CertificateSerial(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldCertificateSerial, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldMessage, v))
+}
+
+// ObservedSerial applies equality check predicate on the "observed_serial" field. It's identical to ObservedSerialEQ.
+func ObservedSerial(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldObservedSerial, v))
+}
+
+// RemediationJobID applies equality check predicate on the "remediation_job_id" field. It's identical to RemediationJobIDEQ.
+func RemediationJobID(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldRemediationJobID, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldTenantID))
+}
+
+// TargetConfigurationIDEQ applies the EQ predicate on the "target_configuration_id" field.
+func TargetConfigurationIDEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDNEQ applies the NEQ predicate on the "target_configuration_id" field.
+func TargetConfigurationIDNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDIn applies the In predicate on the "target_configuration_id" field.
+func TargetConfigurationIDIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldTargetConfigurationID, vs...))
+}
+
+// TargetConfigurationIDNotIn applies the NotIn predicate on the "target_configuration_id" field.
+func TargetConfigurationIDNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldTargetConfigurationID, vs...))
+}
+
+// TargetConfigurationIDGT applies the GT predicate on the "target_configuration_id" field.
+func TargetConfigurationIDGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDGTE applies the GTE predicate on the "target_configuration_id" field.
+func TargetConfigurationIDGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDLT applies the LT predicate on the "target_configuration_id" field.
+func TargetConfigurationIDLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDLTE applies the LTE predicate on the "target_configuration_id" field.
+func TargetConfigurationIDLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDContains applies the Contains predicate on the "target_configuration_id" field.
+func TargetConfigurationIDContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDHasPrefix applies the HasPrefix predicate on the "target_configuration_id" field.
+func TargetConfigurationIDHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDHasSuffix applies the HasSuffix predicate on the "target_configuration_id" field.
+func TargetConfigurationIDHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDEqualFold applies the EqualFold predicate on the "target_configuration_id" field.
+func TargetConfigurationIDEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDContainsFold applies the ContainsFold predicate on the "target_configuration_id" field.
+func TargetConfigurationIDContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldTargetConfigurationID, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDContains applies the Contains predicate on the "job_id" field.
+func JobIDContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldJobID, v))
+}
+
+// JobIDHasPrefix applies the HasPrefix predicate on the "job_id" field.
+func JobIDHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldJobID, v))
+}
+
+// JobIDHasSuffix applies the HasSuffix predicate on the "job_id" field.
+func JobIDHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldJobID, v))
+}
+
+// JobIDEqualFold applies the EqualFold predicate on the "job_id" field.
+func JobIDEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldJobID, v))
+}
+
+// JobIDContainsFold applies the ContainsFold predicate on the "job_id" field.
+func JobIDContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldJobID, v))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDGT applies the GT predicate on the "certificate_id" field.
+func CertificateIDGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldCertificateID, v))
+}
+
+// CertificateIDGTE applies the GTE predicate on the "certificate_id" field.
+func CertificateIDGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldCertificateID, v))
+}
+
+// CertificateIDLT applies the LT predicate on the "certificate_id" field.
+func CertificateIDLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldCertificateID, v))
+}
+
+// CertificateIDLTE applies the LTE predicate on the "certificate_id" field.
+func CertificateIDLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldCertificateID, v))
+}
+
+// CertificateIDContains applies the Contains predicate on the "certificate_id" field.
+func CertificateIDContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldCertificateID, v))
+}
+
+// CertificateIDHasPrefix applies the HasPrefix predicate on the "certificate_id" field.
+func CertificateIDHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldCertificateID, v))
+}
+
+// CertificateIDHasSuffix applies the HasSuffix predicate on the "certificate_id" field.
+func CertificateIDHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldCertificateID, v))
+}
+
+// CertificateIDEqualFold applies the EqualFold predicate on the "certificate_id" field.
+func CertificateIDEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldCertificateID, v))
+}
+
+// CertificateIDContainsFold applies the ContainsFold predicate on the "certificate_id" field.
+func CertificateIDContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldCertificateID, v))
+}
+
+// CertificateSerialEQ applies the EQ predicate on the "certificate_serial" field.
+func CertificateSerialEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldCertificateSerial, v))
+}
+
+// CertificateSerialNEQ applies the NEQ predicate on the "certificate_serial" field.
+func CertificateSerialNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldCertificateSerial, v))
+}
+
+// CertificateSerialIn applies the In predicate on the "certificate_serial" field.
+func CertificateSerialIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldCertificateSerial, vs...))
+}
+
+// CertificateSerialNotIn applies the NotIn predicate on the "certificate_serial" field.
+func CertificateSerialNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldCertificateSerial, vs...))
+}
+
+// CertificateSerialGT applies the GT predicate on the "certificate_serial" field.
+func CertificateSerialGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldCertificateSerial, v))
+}
+
+// CertificateSerialGTE applies the GTE predicate on the "certificate_serial" field.
+func CertificateSerialGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldCertificateSerial, v))
+}
+
+// CertificateSerialLT applies the LT predicate on the "certificate_serial" field.
+func CertificateSerialLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldCertificateSerial, v))
+}
+
+// CertificateSerialLTE applies the LTE predicate on the "certificate_serial" field.
+func CertificateSerialLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldCertificateSerial, v))
+}
+
+// CertificateSerialContains applies the Contains predicate on the "certificate_serial" field.
+func CertificateSerialContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldCertificateSerial, v))
+}
+
+// CertificateSerialHasPrefix applies the HasPrefix predicate on the "certificate_serial" field.
+func CertificateSerialHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldCertificateSerial, v))
+}
+
+// CertificateSerialHasSuffix applies the HasSuffix predicate on the "certificate_serial" field.
+func CertificateSerialHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldCertificateSerial, v))
+}
+
+// CertificateSerialIsNil applies the IsNil predicate on the "certificate_serial" field.
+func CertificateSerialIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldCertificateSerial))
+}
+
+// CertificateSerialNotNil applies the NotNil predicate on the "certificate_serial" field.
+func CertificateSerialNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldCertificateSerial))
+}
+
+// CertificateSerialEqualFold applies the EqualFold predicate on the "certificate_serial" field.
+func CertificateSerialEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldCertificateSerial, v))
+}
+
+// CertificateSerialContainsFold applies the ContainsFold predicate on the "certificate_serial" field.
+func CertificateSerialContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldCertificateSerial, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ObservedSerialEQ applies the EQ predicate on the "observed_serial" field.
+func ObservedSerialEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldObservedSerial, v))
+}
+
+// ObservedSerialNEQ applies the NEQ predicate on the "observed_serial" field.
+func ObservedSerialNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldObservedSerial, v))
+}
+
+// ObservedSerialIn applies the In predicate on the "observed_serial" field.
+func ObservedSerialIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldObservedSerial, vs...))
+}
+
+// ObservedSerialNotIn applies the NotIn predicate on the "observed_serial" field.
+func ObservedSerialNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldObservedSerial, vs...))
+}
+
+// ObservedSerialGT applies the GT predicate on the "observed_serial" field.
+func ObservedSerialGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldObservedSerial, v))
+}
+
+// ObservedSerialGTE applies the GTE predicate on the "observed_serial" field.
+func ObservedSerialGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldObservedSerial, v))
+}
+
+// ObservedSerialLT applies the LT predicate on the "observed_serial" field.
+func ObservedSerialLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldObservedSerial, v))
+}
+
+// ObservedSerialLTE applies the LTE predicate on the "observed_serial" field.
+func ObservedSerialLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldObservedSerial, v))
+}
+
+// ObservedSerialContains applies the Contains predicate on the "observed_serial" field.
+func ObservedSerialContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldObservedSerial, v))
+}
+
+// ObservedSerialHasPrefix applies the HasPrefix predicate on the "observed_serial" field.
+func ObservedSerialHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldObservedSerial, v))
+}
+
+// ObservedSerialHasSuffix applies the HasSuffix predicate on the "observed_serial" field.
+func ObservedSerialHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldObservedSerial, v))
+}
+
+// ObservedSerialIsNil applies the IsNil predicate on the "observed_serial" field.
+func ObservedSerialIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldObservedSerial))
+}
+
+// ObservedSerialNotNil applies the NotNil predicate on the "observed_serial" field.
+func ObservedSerialNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldObservedSerial))
+}
+
+// ObservedSerialEqualFold applies the EqualFold predicate on the "observed_serial" field.
+func ObservedSerialEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldObservedSerial, v))
+}
+
+// ObservedSerialContainsFold applies the ContainsFold predicate on the "observed_serial" field.
+func ObservedSerialContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldObservedSerial, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldDetails))
+}
+
+// RemediationJobIDEQ applies the EQ predicate on the "remediation_job_id" field.
+func RemediationJobIDEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDNEQ applies the NEQ predicate on the "remediation_job_id" field.
+func RemediationJobIDNEQ(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDIn applies the In predicate on the "remediation_job_id" field.
+func RemediationJobIDIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldRemediationJobID, vs...))
+}
+
+// RemediationJobIDNotIn applies the NotIn predicate on the "remediation_job_id" field.
+func RemediationJobIDNotIn(vs ...string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldRemediationJobID, vs...))
+}
+
+// RemediationJobIDGT applies the GT predicate on the "remediation_job_id" field.
+func RemediationJobIDGT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDGTE applies the GTE predicate on the "remediation_job_id" field.
+func RemediationJobIDGTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDLT applies the LT predicate on the "remediation_job_id" field.
+func RemediationJobIDLT(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDLTE applies the LTE predicate on the "remediation_job_id" field.
+func RemediationJobIDLTE(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDContains applies the Contains predicate on the "remediation_job_id" field.
+func RemediationJobIDContains(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContains(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDHasPrefix applies the HasPrefix predicate on the "remediation_job_id" field.
+func RemediationJobIDHasPrefix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasPrefix(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDHasSuffix applies the HasSuffix predicate on the "remediation_job_id" field.
+func RemediationJobIDHasSuffix(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldHasSuffix(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDIsNil applies the IsNil predicate on the "remediation_job_id" field.
+func RemediationJobIDIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldRemediationJobID))
+}
+
+// RemediationJobIDNotNil applies the NotNil predicate on the "remediation_job_id" field.
+func RemediationJobIDNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldRemediationJobID))
+}
+
+// RemediationJobIDEqualFold applies the EqualFold predicate on the "remediation_job_id" field.
+func RemediationJobIDEqualFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEqualFold(FieldRemediationJobID, v))
+}
+
+// RemediationJobIDContainsFold applies the ContainsFold predicate on the "remediation_job_id" field.
+func RemediationJobIDContainsFold(v string) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldContainsFold(FieldRemediationJobID, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.DriftEvent {
+	return predicate.DriftEvent(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DriftEvent) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DriftEvent) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DriftEvent) predicate.DriftEvent {
+	return predicate.DriftEvent(sql.NotPredicates(p))
+}
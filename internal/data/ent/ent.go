@@ -9,10 +9,21 @@ import (
 	"reflect"
 	"sync"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/certificaterevocation"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploylock"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenthistory"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/externalcertificate"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/inventorysnapshot"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/rolebinding"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
 
 	"entgo.io/ent"
@@ -78,11 +89,22 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:            auditlog.ValidColumn,
-			deploymenthistory.Table:   deploymenthistory.ValidColumn,
-			deploymentjob.Table:       deploymentjob.ValidColumn,
-			deploymenttarget.Table:    deploymenttarget.ValidColumn,
-			targetconfiguration.Table: targetconfiguration.ValidColumn,
+			auditchainhead.Table:        auditchainhead.ValidColumn,
+			auditlog.Table:              auditlog.ValidColumn,
+			certificaterevocation.Table: certificaterevocation.ValidColumn,
+			changerecord.Table:          changerecord.ValidColumn,
+			configurationrevision.Table: configurationrevision.ValidColumn,
+			deploylock.Table:            deploylock.ValidColumn,
+			deploymenthistory.Table:     deploymenthistory.ValidColumn,
+			deploymentjob.Table:         deploymentjob.ValidColumn,
+			deploymenttarget.Table:      deploymenttarget.ValidColumn,
+			driftevent.Table:            driftevent.ValidColumn,
+			externalcertificate.Table:   externalcertificate.ValidColumn,
+			inventorysnapshot.Table:     inventorysnapshot.ValidColumn,
+			notificationrule.Table:      notificationrule.ValidColumn,
+			outboxevent.Table:           outboxevent.ValidColumn,
+			rolebinding.Table:           rolebinding.ValidColumn,
+			targetconfiguration.Table:   targetconfiguration.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,886 @@
+// Code generated by ent, DO NOT EDIT.
+
+package externalcertificate
+
+import (
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldSource, v))
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// CommonName applies equality check predicate on the "common_name" field. It's identical to CommonNameEQ.
+func CommonName(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCommonName, v))
+}
+
+// CertificatePem applies equality check predicate on the "certificate_pem" field. It's identical to CertificatePemEQ.
+func CertificatePem(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCertificatePem, v))
+}
+
+// CaCertificatePem applies equality check predicate on the "ca_certificate_pem" field. It's identical to CaCertificatePemEQ.
+func CaCertificatePem(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCaCertificatePem, v))
+}
+
+// PrivateKeyRef applies equality check predicate on the "private_key_ref" field. It's identical to PrivateKeyRefEQ.
+func PrivateKeyRef(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldPrivateKeyRef, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldTenantID))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDGT applies the GT predicate on the "certificate_id" field.
+func CertificateIDGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldCertificateID, v))
+}
+
+// CertificateIDGTE applies the GTE predicate on the "certificate_id" field.
+func CertificateIDGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldCertificateID, v))
+}
+
+// CertificateIDLT applies the LT predicate on the "certificate_id" field.
+func CertificateIDLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldCertificateID, v))
+}
+
+// CertificateIDLTE applies the LTE predicate on the "certificate_id" field.
+func CertificateIDLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldCertificateID, v))
+}
+
+// CertificateIDContains applies the Contains predicate on the "certificate_id" field.
+func CertificateIDContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldCertificateID, v))
+}
+
+// CertificateIDHasPrefix applies the HasPrefix predicate on the "certificate_id" field.
+func CertificateIDHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldCertificateID, v))
+}
+
+// CertificateIDHasSuffix applies the HasSuffix predicate on the "certificate_id" field.
+func CertificateIDHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldCertificateID, v))
+}
+
+// CertificateIDEqualFold applies the EqualFold predicate on the "certificate_id" field.
+func CertificateIDEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldCertificateID, v))
+}
+
+// CertificateIDContainsFold applies the ContainsFold predicate on the "certificate_id" field.
+func CertificateIDContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldCertificateID, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldSource, v))
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldSerialNumber, v))
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldSerialNumber, v))
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldSerialNumber, v))
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldSerialNumber, v))
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldSerialNumber, v))
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldSerialNumber, v))
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldSerialNumber, v))
+}
+
+// SerialNumberIsNil applies the IsNil predicate on the "serial_number" field.
+func SerialNumberIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldSerialNumber))
+}
+
+// SerialNumberNotNil applies the NotNil predicate on the "serial_number" field.
+func SerialNumberNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldSerialNumber))
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldSerialNumber, v))
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldSerialNumber, v))
+}
+
+// CommonNameEQ applies the EQ predicate on the "common_name" field.
+func CommonNameEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCommonName, v))
+}
+
+// CommonNameNEQ applies the NEQ predicate on the "common_name" field.
+func CommonNameNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldCommonName, v))
+}
+
+// CommonNameIn applies the In predicate on the "common_name" field.
+func CommonNameIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldCommonName, vs...))
+}
+
+// CommonNameNotIn applies the NotIn predicate on the "common_name" field.
+func CommonNameNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldCommonName, vs...))
+}
+
+// CommonNameGT applies the GT predicate on the "common_name" field.
+func CommonNameGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldCommonName, v))
+}
+
+// CommonNameGTE applies the GTE predicate on the "common_name" field.
+func CommonNameGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldCommonName, v))
+}
+
+// CommonNameLT applies the LT predicate on the "common_name" field.
+func CommonNameLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldCommonName, v))
+}
+
+// CommonNameLTE applies the LTE predicate on the "common_name" field.
+func CommonNameLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldCommonName, v))
+}
+
+// CommonNameContains applies the Contains predicate on the "common_name" field.
+func CommonNameContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldCommonName, v))
+}
+
+// CommonNameHasPrefix applies the HasPrefix predicate on the "common_name" field.
+func CommonNameHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldCommonName, v))
+}
+
+// CommonNameHasSuffix applies the HasSuffix predicate on the "common_name" field.
+func CommonNameHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldCommonName, v))
+}
+
+// CommonNameIsNil applies the IsNil predicate on the "common_name" field.
+func CommonNameIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldCommonName))
+}
+
+// CommonNameNotNil applies the NotNil predicate on the "common_name" field.
+func CommonNameNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldCommonName))
+}
+
+// CommonNameEqualFold applies the EqualFold predicate on the "common_name" field.
+func CommonNameEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldCommonName, v))
+}
+
+// CommonNameContainsFold applies the ContainsFold predicate on the "common_name" field.
+func CommonNameContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldCommonName, v))
+}
+
+// SansIsNil applies the IsNil predicate on the "sans" field.
+func SansIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldSans))
+}
+
+// SansNotNil applies the NotNil predicate on the "sans" field.
+func SansNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldSans))
+}
+
+// CertificatePemEQ applies the EQ predicate on the "certificate_pem" field.
+func CertificatePemEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCertificatePem, v))
+}
+
+// CertificatePemNEQ applies the NEQ predicate on the "certificate_pem" field.
+func CertificatePemNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldCertificatePem, v))
+}
+
+// CertificatePemIn applies the In predicate on the "certificate_pem" field.
+func CertificatePemIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldCertificatePem, vs...))
+}
+
+// CertificatePemNotIn applies the NotIn predicate on the "certificate_pem" field.
+func CertificatePemNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldCertificatePem, vs...))
+}
+
+// CertificatePemGT applies the GT predicate on the "certificate_pem" field.
+func CertificatePemGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldCertificatePem, v))
+}
+
+// CertificatePemGTE applies the GTE predicate on the "certificate_pem" field.
+func CertificatePemGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldCertificatePem, v))
+}
+
+// CertificatePemLT applies the LT predicate on the "certificate_pem" field.
+func CertificatePemLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldCertificatePem, v))
+}
+
+// CertificatePemLTE applies the LTE predicate on the "certificate_pem" field.
+func CertificatePemLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldCertificatePem, v))
+}
+
+// CertificatePemContains applies the Contains predicate on the "certificate_pem" field.
+func CertificatePemContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldCertificatePem, v))
+}
+
+// CertificatePemHasPrefix applies the HasPrefix predicate on the "certificate_pem" field.
+func CertificatePemHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldCertificatePem, v))
+}
+
+// CertificatePemHasSuffix applies the HasSuffix predicate on the "certificate_pem" field.
+func CertificatePemHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldCertificatePem, v))
+}
+
+// CertificatePemEqualFold applies the EqualFold predicate on the "certificate_pem" field.
+func CertificatePemEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldCertificatePem, v))
+}
+
+// CertificatePemContainsFold applies the ContainsFold predicate on the "certificate_pem" field.
+func CertificatePemContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldCertificatePem, v))
+}
+
+// CaCertificatePemEQ applies the EQ predicate on the "ca_certificate_pem" field.
+func CaCertificatePemEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemNEQ applies the NEQ predicate on the "ca_certificate_pem" field.
+func CaCertificatePemNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemIn applies the In predicate on the "ca_certificate_pem" field.
+func CaCertificatePemIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldCaCertificatePem, vs...))
+}
+
+// CaCertificatePemNotIn applies the NotIn predicate on the "ca_certificate_pem" field.
+func CaCertificatePemNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldCaCertificatePem, vs...))
+}
+
+// CaCertificatePemGT applies the GT predicate on the "ca_certificate_pem" field.
+func CaCertificatePemGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemGTE applies the GTE predicate on the "ca_certificate_pem" field.
+func CaCertificatePemGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemLT applies the LT predicate on the "ca_certificate_pem" field.
+func CaCertificatePemLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemLTE applies the LTE predicate on the "ca_certificate_pem" field.
+func CaCertificatePemLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemContains applies the Contains predicate on the "ca_certificate_pem" field.
+func CaCertificatePemContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemHasPrefix applies the HasPrefix predicate on the "ca_certificate_pem" field.
+func CaCertificatePemHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemHasSuffix applies the HasSuffix predicate on the "ca_certificate_pem" field.
+func CaCertificatePemHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemIsNil applies the IsNil predicate on the "ca_certificate_pem" field.
+func CaCertificatePemIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldCaCertificatePem))
+}
+
+// CaCertificatePemNotNil applies the NotNil predicate on the "ca_certificate_pem" field.
+func CaCertificatePemNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldCaCertificatePem))
+}
+
+// CaCertificatePemEqualFold applies the EqualFold predicate on the "ca_certificate_pem" field.
+func CaCertificatePemEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldCaCertificatePem, v))
+}
+
+// CaCertificatePemContainsFold applies the ContainsFold predicate on the "ca_certificate_pem" field.
+func CaCertificatePemContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldCaCertificatePem, v))
+}
+
+// PrivateKeyRefEQ applies the EQ predicate on the "private_key_ref" field.
+func PrivateKeyRefEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefNEQ applies the NEQ predicate on the "private_key_ref" field.
+func PrivateKeyRefNEQ(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefIn applies the In predicate on the "private_key_ref" field.
+func PrivateKeyRefIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldPrivateKeyRef, vs...))
+}
+
+// PrivateKeyRefNotIn applies the NotIn predicate on the "private_key_ref" field.
+func PrivateKeyRefNotIn(vs ...string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldPrivateKeyRef, vs...))
+}
+
+// PrivateKeyRefGT applies the GT predicate on the "private_key_ref" field.
+func PrivateKeyRefGT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefGTE applies the GTE predicate on the "private_key_ref" field.
+func PrivateKeyRefGTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefLT applies the LT predicate on the "private_key_ref" field.
+func PrivateKeyRefLT(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefLTE applies the LTE predicate on the "private_key_ref" field.
+func PrivateKeyRefLTE(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefContains applies the Contains predicate on the "private_key_ref" field.
+func PrivateKeyRefContains(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContains(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefHasPrefix applies the HasPrefix predicate on the "private_key_ref" field.
+func PrivateKeyRefHasPrefix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasPrefix(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefHasSuffix applies the HasSuffix predicate on the "private_key_ref" field.
+func PrivateKeyRefHasSuffix(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldHasSuffix(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefIsNil applies the IsNil predicate on the "private_key_ref" field.
+func PrivateKeyRefIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldPrivateKeyRef))
+}
+
+// PrivateKeyRefNotNil applies the NotNil predicate on the "private_key_ref" field.
+func PrivateKeyRefNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldPrivateKeyRef))
+}
+
+// PrivateKeyRefEqualFold applies the EqualFold predicate on the "private_key_ref" field.
+func PrivateKeyRefEqualFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEqualFold(FieldPrivateKeyRef, v))
+}
+
+// PrivateKeyRefContainsFold applies the ContainsFold predicate on the "private_key_ref" field.
+func PrivateKeyRefContainsFold(v string) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldContainsFold(FieldPrivateKeyRef, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExternalCertificate) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExternalCertificate) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExternalCertificate) predicate.ExternalCertificate {
+	return predicate.ExternalCertificate(sql.NotPredicates(p))
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
 )
 
+// The AuditChainHeadFunc type is an adapter to allow the use of ordinary
+// function as AuditChainHead mutator.
+type AuditChainHeadFunc func(context.Context, *ent.AuditChainHeadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditChainHeadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditChainHeadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditChainHeadMutation", m)
+}
+
 // The AuditLogFunc type is an adapter to allow the use of ordinary
 // function as AuditLog mutator.
 type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
@@ -21,6 +33,54 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The CertificateRevocationFunc type is an adapter to allow the use of ordinary
+// function as CertificateRevocation mutator.
+type CertificateRevocationFunc func(context.Context, *ent.CertificateRevocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertificateRevocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CertificateRevocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertificateRevocationMutation", m)
+}
+
+// The ChangeRecordFunc type is an adapter to allow the use of ordinary
+// function as ChangeRecord mutator.
+type ChangeRecordFunc func(context.Context, *ent.ChangeRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChangeRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChangeRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChangeRecordMutation", m)
+}
+
+// The ConfigurationRevisionFunc type is an adapter to allow the use of ordinary
+// function as ConfigurationRevision mutator.
+type ConfigurationRevisionFunc func(context.Context, *ent.ConfigurationRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigurationRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConfigurationRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConfigurationRevisionMutation", m)
+}
+
+// The DeployLockFunc type is an adapter to allow the use of ordinary
+// function as DeployLock mutator.
+type DeployLockFunc func(context.Context, *ent.DeployLockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeployLockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeployLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeployLockMutation", m)
+}
+
 // The DeploymentHistoryFunc type is an adapter to allow the use of ordinary
 // function as DeploymentHistory mutator.
 type DeploymentHistoryFunc func(context.Context, *ent.DeploymentHistoryMutation) (ent.Value, error)
@@ -57,6 +117,78 @@ func (f DeploymentTargetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeploymentTargetMutation", m)
 }
 
+// The DriftEventFunc type is an adapter to allow the use of ordinary
+// function as DriftEvent mutator.
+type DriftEventFunc func(context.Context, *ent.DriftEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DriftEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DriftEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DriftEventMutation", m)
+}
+
+// The ExternalCertificateFunc type is an adapter to allow the use of ordinary
+// function as ExternalCertificate mutator.
+type ExternalCertificateFunc func(context.Context, *ent.ExternalCertificateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExternalCertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExternalCertificateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExternalCertificateMutation", m)
+}
+
+// The InventorySnapshotFunc type is an adapter to allow the use of ordinary
+// function as InventorySnapshot mutator.
+type InventorySnapshotFunc func(context.Context, *ent.InventorySnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventorySnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InventorySnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventorySnapshotMutation", m)
+}
+
+// The NotificationRuleFunc type is an adapter to allow the use of ordinary
+// function as NotificationRule mutator.
+type NotificationRuleFunc func(context.Context, *ent.NotificationRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationRuleMutation", m)
+}
+
+// The OutboxEventFunc type is an adapter to allow the use of ordinary
+// function as OutboxEvent mutator.
+type OutboxEventFunc func(context.Context, *ent.OutboxEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutboxEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxEventMutation", m)
+}
+
+// The RoleBindingFunc type is an adapter to allow the use of ordinary
+// function as RoleBinding mutator.
+type RoleBindingFunc func(context.Context, *ent.RoleBindingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleBindingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleBindingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleBindingMutation", m)
+}
+
 // The TargetConfigurationFunc type is an adapter to allow the use of ordinary
 // function as TargetConfiguration mutator.
 type TargetConfigurationFunc func(context.Context, *ent.TargetConfigurationMutation) (ent.Value, error)
@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorysnapshot
+
+import (
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TargetConfigurationID applies equality check predicate on the "target_configuration_id" field. It's identical to TargetConfigurationIDEQ.
+func TargetConfigurationID(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldTargetConfigurationID, v))
+}
+
+// ProviderType applies equality check predicate on the "provider_type" field. It's identical to ProviderTypeEQ.
+func ProviderType(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldProviderType, v))
+}
+
+// UnmanagedCount applies equality check predicate on the "unmanaged_count" field. It's identical to UnmanagedCountEQ.
+func UnmanagedCount(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldUnmanagedCount, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldError, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotNull(FieldTenantID))
+}
+
+// TargetConfigurationIDEQ applies the EQ predicate on the "target_configuration_id" field.
+func TargetConfigurationIDEQ(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDNEQ applies the NEQ predicate on the "target_configuration_id" field.
+func TargetConfigurationIDNEQ(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDIn applies the In predicate on the "target_configuration_id" field.
+func TargetConfigurationIDIn(vs ...string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldTargetConfigurationID, vs...))
+}
+
+// TargetConfigurationIDNotIn applies the NotIn predicate on the "target_configuration_id" field.
+func TargetConfigurationIDNotIn(vs ...string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldTargetConfigurationID, vs...))
+}
+
+// TargetConfigurationIDGT applies the GT predicate on the "target_configuration_id" field.
+func TargetConfigurationIDGT(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDGTE applies the GTE predicate on the "target_configuration_id" field.
+func TargetConfigurationIDGTE(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDLT applies the LT predicate on the "target_configuration_id" field.
+func TargetConfigurationIDLT(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDLTE applies the LTE predicate on the "target_configuration_id" field.
+func TargetConfigurationIDLTE(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDContains applies the Contains predicate on the "target_configuration_id" field.
+func TargetConfigurationIDContains(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldContains(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDHasPrefix applies the HasPrefix predicate on the "target_configuration_id" field.
+func TargetConfigurationIDHasPrefix(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldHasPrefix(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDHasSuffix applies the HasSuffix predicate on the "target_configuration_id" field.
+func TargetConfigurationIDHasSuffix(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldHasSuffix(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDEqualFold applies the EqualFold predicate on the "target_configuration_id" field.
+func TargetConfigurationIDEqualFold(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEqualFold(FieldTargetConfigurationID, v))
+}
+
+// TargetConfigurationIDContainsFold applies the ContainsFold predicate on the "target_configuration_id" field.
+func TargetConfigurationIDContainsFold(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldContainsFold(FieldTargetConfigurationID, v))
+}
+
+// ProviderTypeEQ applies the EQ predicate on the "provider_type" field.
+func ProviderTypeEQ(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldProviderType, v))
+}
+
+// ProviderTypeNEQ applies the NEQ predicate on the "provider_type" field.
+func ProviderTypeNEQ(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldProviderType, v))
+}
+
+// ProviderTypeIn applies the In predicate on the "provider_type" field.
+func ProviderTypeIn(vs ...string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldProviderType, vs...))
+}
+
+// ProviderTypeNotIn applies the NotIn predicate on the "provider_type" field.
+func ProviderTypeNotIn(vs ...string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldProviderType, vs...))
+}
+
+// ProviderTypeGT applies the GT predicate on the "provider_type" field.
+func ProviderTypeGT(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldProviderType, v))
+}
+
+// ProviderTypeGTE applies the GTE predicate on the "provider_type" field.
+func ProviderTypeGTE(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldProviderType, v))
+}
+
+// ProviderTypeLT applies the LT predicate on the "provider_type" field.
+func ProviderTypeLT(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldProviderType, v))
+}
+
+// ProviderTypeLTE applies the LTE predicate on the "provider_type" field.
+func ProviderTypeLTE(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldProviderType, v))
+}
+
+// ProviderTypeContains applies the Contains predicate on the "provider_type" field.
+func ProviderTypeContains(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldContains(FieldProviderType, v))
+}
+
+// ProviderTypeHasPrefix applies the HasPrefix predicate on the "provider_type" field.
+func ProviderTypeHasPrefix(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldHasPrefix(FieldProviderType, v))
+}
+
+// ProviderTypeHasSuffix applies the HasSuffix predicate on the "provider_type" field.
+func ProviderTypeHasSuffix(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldHasSuffix(FieldProviderType, v))
+}
+
+// ProviderTypeEqualFold applies the EqualFold predicate on the "provider_type" field.
+func ProviderTypeEqualFold(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEqualFold(FieldProviderType, v))
+}
+
+// ProviderTypeContainsFold applies the ContainsFold predicate on the "provider_type" field.
+func ProviderTypeContainsFold(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldContainsFold(FieldProviderType, v))
+}
+
+// CertificatesIsNil applies the IsNil predicate on the "certificates" field.
+func CertificatesIsNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIsNull(FieldCertificates))
+}
+
+// CertificatesNotNil applies the NotNil predicate on the "certificates" field.
+func CertificatesNotNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotNull(FieldCertificates))
+}
+
+// UnmanagedCountEQ applies the EQ predicate on the "unmanaged_count" field.
+func UnmanagedCountEQ(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldUnmanagedCount, v))
+}
+
+// UnmanagedCountNEQ applies the NEQ predicate on the "unmanaged_count" field.
+func UnmanagedCountNEQ(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldUnmanagedCount, v))
+}
+
+// UnmanagedCountIn applies the In predicate on the "unmanaged_count" field.
+func UnmanagedCountIn(vs ...int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldUnmanagedCount, vs...))
+}
+
+// UnmanagedCountNotIn applies the NotIn predicate on the "unmanaged_count" field.
+func UnmanagedCountNotIn(vs ...int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldUnmanagedCount, vs...))
+}
+
+// UnmanagedCountGT applies the GT predicate on the "unmanaged_count" field.
+func UnmanagedCountGT(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldUnmanagedCount, v))
+}
+
+// UnmanagedCountGTE applies the GTE predicate on the "unmanaged_count" field.
+func UnmanagedCountGTE(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldUnmanagedCount, v))
+}
+
+// UnmanagedCountLT applies the LT predicate on the "unmanaged_count" field.
+func UnmanagedCountLT(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldUnmanagedCount, v))
+}
+
+// UnmanagedCountLTE applies the LTE predicate on the "unmanaged_count" field.
+func UnmanagedCountLTE(v int) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldUnmanagedCount, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.FieldContainsFold(FieldError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventorySnapshot) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventorySnapshot) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventorySnapshot) predicate.InventorySnapshot {
+	return predicate.InventorySnapshot(sql.NotPredicates(p))
+}
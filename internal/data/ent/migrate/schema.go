@@ -9,6 +9,18 @@ import (
 )
 
 var (
+	// DeployerAuditChainHeadColumns holds the columns for the "deployer_audit_chain_head" table.
+	DeployerAuditChainHeadColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, Comment: "Always 1: there is a single chain"},
+		{Name: "last_id", Type: field.TypeUint32, Nullable: true, Comment: "ID of the latest audit log row"},
+		{Name: "last_hash", Type: field.TypeString, Nullable: true, Comment: "Hash of the latest audit log row"},
+	}
+	// DeployerAuditChainHeadTable holds the schema information for the "deployer_audit_chain_head" table.
+	DeployerAuditChainHeadTable = &schema.Table{
+		Name:       "deployer_audit_chain_head",
+		Columns:    DeployerAuditChainHeadColumns,
+		PrimaryKey: []*schema.Column{DeployerAuditChainHeadColumns[0]},
+	}
 	// DeployerAuditLogsColumns holds the columns for the "deployer_audit_logs" table.
 	DeployerAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -33,6 +45,8 @@ var (
 		{Name: "geo_location", Type: field.TypeJSON, Nullable: true, Comment: "Geographic location info"},
 		{Name: "log_hash", Type: field.TypeString, Nullable: true, Comment: "SHA-256 hash of the log content"},
 		{Name: "signature", Type: field.TypeBytes, Nullable: true, Comment: "ECDSA signature for integrity verification"},
+		{Name: "prev_id", Type: field.TypeUint32, Nullable: true, Comment: "ID of the row this row is chained to"},
+		{Name: "prev_hash", Type: field.TypeString, Nullable: true, Comment: "log_hash of the row this row is chained to, covered by log_hash"},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "Additional metadata"},
 	}
 	// DeployerAuditLogsTable holds the schema information for the "deployer_audit_logs" table.
@@ -81,6 +95,147 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{DeployerAuditLogsColumns[18]},
 			},
+			{
+				Name:    "deployer_auditlog_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerAuditLogsColumns[1]},
+			},
+		},
+	}
+	// DeployerCertificateRevocationsColumns holds the columns for the "deployer_certificate_revocations" table.
+	DeployerCertificateRevocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "certificate_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Revoked certificate ID"},
+		{Name: "serial_number", Type: field.TypeString, Nullable: true, Comment: "Revoked serial number, empty when every serial of the certificate is gone"},
+	}
+	// DeployerCertificateRevocationsTable holds the schema information for the "deployer_certificate_revocations" table.
+	DeployerCertificateRevocationsTable = &schema.Table{
+		Name:       "deployer_certificate_revocations",
+		Columns:    DeployerCertificateRevocationsColumns,
+		PrimaryKey: []*schema.Column{DeployerCertificateRevocationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certificaterevocation_tenant_id_certificate_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerCertificateRevocationsColumns[4], DeployerCertificateRevocationsColumns[5]},
+			},
+			{
+				Name:    "certificaterevocation_tenant_id_serial_number",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerCertificateRevocationsColumns[4], DeployerCertificateRevocationsColumns[6]},
+			},
+		},
+	}
+	// DeployerChangeRecordsColumns holds the columns for the "deployer_change_records" table.
+	DeployerChangeRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of the changed resource", Enums: []string{"CHANGE_RESOURCE_TYPE_DEPLOYMENT_TARGET", "CHANGE_RESOURCE_TYPE_TARGET_CONFIGURATION"}},
+		{Name: "resource_id", Type: field.TypeString, Comment: "ID of the changed resource"},
+		{Name: "resource_name", Type: field.TypeString, Nullable: true, Comment: "Name of the resource at the time of the change"},
+		{Name: "action", Type: field.TypeEnum, Comment: "Kind of change", Enums: []string{"CHANGE_ACTION_CREATE", "CHANGE_ACTION_UPDATE", "CHANGE_ACTION_DELETE", "CHANGE_ACTION_ADD_CONFIGURATIONS", "CHANGE_ACTION_REMOVE_CONFIGURATIONS"}},
+		{Name: "actor_id", Type: field.TypeUint32, Nullable: true, Comment: "User ID of the actor, if known"},
+		{Name: "actor_name", Type: field.TypeString, Nullable: true, Comment: "Username or client certificate CN of the actor"},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true, Comment: "Field-level before/after values"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Reason given for the change"},
+	}
+	// DeployerChangeRecordsTable holds the schema information for the "deployer_change_records" table.
+	DeployerChangeRecordsTable = &schema.Table{
+		Name:       "deployer_change_records",
+		Columns:    DeployerChangeRecordsColumns,
+		PrimaryKey: []*schema.Column{DeployerChangeRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_change_resource",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerChangeRecordsColumns[5], DeployerChangeRecordsColumns[6]},
+			},
+			{
+				Name:    "deployer_change_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerChangeRecordsColumns[4]},
+			},
+			{
+				Name:    "deployer_change_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerChangeRecordsColumns[9]},
+			},
+			{
+				Name:    "deployer_change_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerChangeRecordsColumns[1]},
+			},
+		},
+	}
+	// DeployerConfigRevisionsColumns holds the columns for the "deployer_config_revisions" table.
+	DeployerConfigRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "target_configuration_id", Type: field.TypeString, Comment: "Target configuration this revision belongs to"},
+		{Name: "revision", Type: field.TypeUint32, Comment: "Revision number, starting at 1 for each configuration"},
+		{Name: "name", Type: field.TypeString, Comment: "Configuration name at this revision"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "Configuration description at this revision"},
+		{Name: "provider_type", Type: field.TypeString, Comment: "Provider type at this revision"},
+		{Name: "credentials_encrypted", Type: field.TypeBytes, Comment: "Encrypted provider credentials (JSON) at this revision"},
+		{Name: "config", Type: field.TypeJSON, Nullable: true, Comment: "Provider-specific configuration at this revision"},
+		{Name: "reverted_from", Type: field.TypeUint32, Nullable: true, Comment: "Revision number this revision was restored from"},
+		{Name: "actor_id", Type: field.TypeUint32, Nullable: true, Comment: "User ID of the actor, if known"},
+		{Name: "actor_name", Type: field.TypeString, Nullable: true, Comment: "Username or client certificate CN of the actor"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Reason given for the change"},
+	}
+	// DeployerConfigRevisionsTable holds the schema information for the "deployer_config_revisions" table.
+	DeployerConfigRevisionsTable = &schema.Table{
+		Name:       "deployer_config_revisions",
+		Columns:    DeployerConfigRevisionsColumns,
+		PrimaryKey: []*schema.Column{DeployerConfigRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_config_revision_number",
+				Unique:  true,
+				Columns: []*schema.Column{DeployerConfigRevisionsColumns[5], DeployerConfigRevisionsColumns[6]},
+			},
+			{
+				Name:    "deployer_config_revision_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerConfigRevisionsColumns[4]},
+			},
+		},
+	}
+	// DeployerLocksColumns holds the columns for the "deployer_locks" table.
+	DeployerLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Lock key (e.g. configuration:<id>, host:<host>, provider:<type>/<slot>)"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "holder", Type: field.TypeString, Comment: "ID of the job or replica holding the lock"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "Lease expiry; expired locks may be taken over"},
+	}
+	// DeployerLocksTable holds the schema information for the "deployer_locks" table.
+	DeployerLocksTable = &schema.Table{
+		Name:       "deployer_locks",
+		Columns:    DeployerLocksColumns,
+		PrimaryKey: []*schema.Column{DeployerLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deploylock_holder",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerLocksColumns[4]},
+			},
+			{
+				Name:    "deploylock_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerLocksColumns[5]},
+			},
 		},
 	}
 	// DeployerHistoryColumns holds the columns for the "deployer_history" table.
@@ -89,7 +244,7 @@ var (
 		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
 		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
-		{Name: "action", Type: field.TypeEnum, Comment: "Action type", Enums: []string{"ACTION_DEPLOY", "ACTION_VERIFY", "ACTION_ROLLBACK"}},
+		{Name: "action", Type: field.TypeEnum, Comment: "Action type", Enums: []string{"ACTION_DEPLOY", "ACTION_VERIFY", "ACTION_ROLLBACK", "ACTION_REMOVE"}},
 		{Name: "result", Type: field.TypeEnum, Comment: "Action result", Enums: []string{"RESULT_SUCCESS", "RESULT_FAILURE", "RESULT_PARTIAL"}},
 		{Name: "message", Type: field.TypeString, Nullable: true, Comment: "Result message"},
 		{Name: "duration_ms", Type: field.TypeInt64, Comment: "Action duration in milliseconds", Default: 0},
@@ -142,16 +297,24 @@ var (
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "certificate_id", Type: field.TypeString, Comment: "LCM certificate ID"},
 		{Name: "certificate_serial", Type: field.TypeString, Nullable: true, Comment: "Certificate serial number"},
+		{Name: "certificate_common_name", Type: field.TypeString, Nullable: true, Comment: "Certificate common name, kept to remove certificates LCM no longer knows (child/direct jobs)"},
+		{Name: "certificate_lineage", Type: field.TypeString, Nullable: true, Comment: "ID of the first certificate of the renewal chain (child/direct jobs)"},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Client key that coalesces repeated create requests (parent/direct jobs)"},
+		{Name: "superseded_by_job_id", Type: field.TypeString, Nullable: true, Comment: "Job that replaced this queued job (child/direct jobs)"},
 		{Name: "status", Type: field.TypeEnum, Comment: "Job status", Enums: []string{"JOB_STATUS_UNSPECIFIED", "JOB_STATUS_PENDING", "JOB_STATUS_PROCESSING", "JOB_STATUS_COMPLETED", "JOB_STATUS_FAILED", "JOB_STATUS_CANCELLED", "JOB_STATUS_RETRYING", "JOB_STATUS_PARTIAL"}, Default: "JOB_STATUS_PENDING"},
 		{Name: "status_message", Type: field.TypeString, Nullable: true, Comment: "Status message"},
 		{Name: "progress", Type: field.TypeInt32, Comment: "Progress percentage (0-100)", Default: 0},
 		{Name: "retry_count", Type: field.TypeInt32, Comment: "Number of retry attempts", Default: 0},
 		{Name: "max_retries", Type: field.TypeInt32, Comment: "Maximum retry attempts", Default: 3},
-		{Name: "triggered_by", Type: field.TypeEnum, Comment: "How the job was triggered", Enums: []string{"TRIGGER_TYPE_UNSPECIFIED", "TRIGGER_TYPE_MANUAL", "TRIGGER_TYPE_EVENT", "TRIGGER_TYPE_AUTO_RENEWAL"}, Default: "TRIGGER_TYPE_MANUAL"},
+		{Name: "retry_policy", Type: field.TypeJSON, Nullable: true, Comment: "Effective retry and timeout policy the job was created with"},
+		{Name: "triggered_by", Type: field.TypeEnum, Comment: "How the job was triggered", Enums: []string{"TRIGGER_TYPE_UNSPECIFIED", "TRIGGER_TYPE_MANUAL", "TRIGGER_TYPE_EVENT", "TRIGGER_TYPE_AUTO_RENEWAL", "TRIGGER_TYPE_DRIFT_REMEDIATION", "TRIGGER_TYPE_REVOCATION"}, Default: "TRIGGER_TYPE_MANUAL"},
+		{Name: "action", Type: field.TypeEnum, Comment: "Whether the job deploys or removes the certificate (child/direct jobs)", Enums: []string{"JOB_ACTION_DEPLOY", "JOB_ACTION_REMOVE"}, Default: "JOB_ACTION_DEPLOY"},
 		{Name: "result", Type: field.TypeJSON, Nullable: true, Comment: "Deployment result details"},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "Job start time"},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true, Comment: "Job completion time"},
 		{Name: "next_retry_at", Type: field.TypeTime, Nullable: true, Comment: "Next retry time"},
+		{Name: "configuration_revision_id", Type: field.TypeUint32, Nullable: true, Comment: "Configuration revision the job executed with (child/direct jobs)"},
+		{Name: "error_category", Type: field.TypeEnum, Nullable: true, Comment: "Category of the last failure (child/direct jobs)", Enums: []string{"ERROR_CATEGORY_UNKNOWN", "ERROR_CATEGORY_AUTH", "ERROR_CATEGORY_PERMISSION", "ERROR_CATEGORY_NOT_FOUND", "ERROR_CATEGORY_VALIDATION", "ERROR_CATEGORY_RATE_LIMITED", "ERROR_CATEGORY_TRANSIENT", "ERROR_CATEGORY_CONFLICT"}},
 		{Name: "parent_job_id", Type: field.TypeString, Nullable: true, Comment: "FK to parent job (for child jobs)"},
 		{Name: "deployment_target_id", Type: field.TypeString, Nullable: true, Comment: "FK to deployment target group (for parent jobs)"},
 		{Name: "target_configuration_id", Type: field.TypeString, Nullable: true, Comment: "FK to target configuration (for child/direct jobs)"},
@@ -164,19 +327,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deployer_jobs_deployer_jobs_child_jobs",
-				Columns:    []*schema.Column{DeployerJobsColumns[18]},
+				Columns:    []*schema.Column{DeployerJobsColumns[26]},
 				RefColumns: []*schema.Column{DeployerJobsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "deployer_jobs_deployer_targets_jobs",
-				Columns:    []*schema.Column{DeployerJobsColumns[19]},
+				Columns:    []*schema.Column{DeployerJobsColumns[27]},
 				RefColumns: []*schema.Column{DeployerTargetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "deployer_jobs_deployer_target_configs_jobs",
-				Columns:    []*schema.Column{DeployerJobsColumns[20]},
+				Columns:    []*schema.Column{DeployerJobsColumns[28]},
 				RefColumns: []*schema.Column{DeployerTargetConfigsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -190,32 +353,47 @@ var (
 			{
 				Name:    "deploymentjob_deployment_target_id",
 				Unique:  false,
-				Columns: []*schema.Column{DeployerJobsColumns[19]},
+				Columns: []*schema.Column{DeployerJobsColumns[27]},
 			},
 			{
 				Name:    "deploymentjob_target_configuration_id",
 				Unique:  false,
-				Columns: []*schema.Column{DeployerJobsColumns[20]},
+				Columns: []*schema.Column{DeployerJobsColumns[28]},
 			},
 			{
 				Name:    "deploymentjob_parent_job_id",
 				Unique:  false,
-				Columns: []*schema.Column{DeployerJobsColumns[18]},
+				Columns: []*schema.Column{DeployerJobsColumns[26]},
 			},
 			{
 				Name:    "deploymentjob_certificate_id",
 				Unique:  false,
 				Columns: []*schema.Column{DeployerJobsColumns[6]},
 			},
+			{
+				Name:    "deploymentjob_target_configuration_id_certificate_lineage",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerJobsColumns[28], DeployerJobsColumns[9]},
+			},
+			{
+				Name:    "deploymentjob_tenant_id_idempotency_key",
+				Unique:  true,
+				Columns: []*schema.Column{DeployerJobsColumns[5], DeployerJobsColumns[10]},
+			},
 			{
 				Name:    "deploymentjob_status",
 				Unique:  false,
-				Columns: []*schema.Column{DeployerJobsColumns[8]},
+				Columns: []*schema.Column{DeployerJobsColumns[12]},
+			},
+			{
+				Name:    "deploymentjob_error_category",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerJobsColumns[25]},
 			},
 			{
 				Name:    "deploymentjob_triggered_by",
 				Unique:  false,
-				Columns: []*schema.Column{DeployerJobsColumns[13]},
+				Columns: []*schema.Column{DeployerJobsColumns[18]},
 			},
 			{
 				Name:    "deploymentjob_create_time",
@@ -237,6 +415,8 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "Target group description"},
 		{Name: "auto_deploy_on_renewal", Type: field.TypeBool, Comment: "Auto-deploy certificates on renewal/issuance", Default: false},
 		{Name: "certificate_filters", Type: field.TypeJSON, Nullable: true, Comment: "Filters for auto-deployment"},
+		{Name: "retry_policy", Type: field.TypeJSON, Nullable: true, Comment: "Retry and timeout overrides for jobs deploying to this target"},
+		{Name: "revocation_policy", Type: field.TypeEnum, Comment: "Whether revoked or deleted certificates are replaced or removed", Enums: []string{"REVOCATION_POLICY_REDEPLOY", "REVOCATION_POLICY_REMOVE"}, Default: "REVOCATION_POLICY_REDEPLOY"},
 	}
 	// DeployerTargetsTable holds the schema information for the "deployer_targets" table.
 	DeployerTargetsTable = &schema.Table{
@@ -261,6 +441,206 @@ var (
 			},
 		},
 	}
+	// DeployerDriftEventsColumns holds the columns for the "deployer_drift_events" table.
+	DeployerDriftEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "target_configuration_id", Type: field.TypeString, Comment: "Configuration whose device drifted"},
+		{Name: "job_id", Type: field.TypeString, Comment: "Last successful deployment the device no longer matches"},
+		{Name: "certificate_id", Type: field.TypeString, Comment: "Certificate the device is expected to serve"},
+		{Name: "certificate_serial", Type: field.TypeString, Nullable: true, Comment: "Serial of the expected certificate"},
+		{Name: "source", Type: field.TypeEnum, Comment: "Check that found the drift", Enums: []string{"DRIFT_SOURCE_VERIFY", "DRIFT_SOURCE_TLS_PROBE"}},
+		{Name: "message", Type: field.TypeString, Nullable: true, Comment: "What the check found"},
+		{Name: "observed_serial", Type: field.TypeString, Nullable: true, Comment: "Serial of the certificate the device served instead (TLS probes)"},
+		{Name: "details", Type: field.TypeJSON, Nullable: true, Comment: "Provider verification details"},
+		{Name: "remediation_job_id", Type: field.TypeString, Nullable: true, Comment: "Job created to redeploy the expected certificate"},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true, Comment: "When a later check found the expected certificate again"},
+	}
+	// DeployerDriftEventsTable holds the schema information for the "deployer_drift_events" table.
+	DeployerDriftEventsTable = &schema.Table{
+		Name:       "deployer_drift_events",
+		Columns:    DeployerDriftEventsColumns,
+		PrimaryKey: []*schema.Column{DeployerDriftEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_drift_config",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerDriftEventsColumns[5]},
+			},
+			{
+				Name:    "deployer_drift_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerDriftEventsColumns[4]},
+			},
+			{
+				Name:    "deployer_drift_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerDriftEventsColumns[1]},
+			},
+		},
+	}
+	// DeployerExternalCertificatesColumns holds the columns for the "deployer_external_certificates" table.
+	DeployerExternalCertificatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "certificate_id", Type: field.TypeString, Size: 255, Comment: "Certificate ID used by the jobs of the certificate"},
+		{Name: "source", Type: field.TypeString, Comment: "Ingestion source that announced the certificate"},
+		{Name: "serial_number", Type: field.TypeString, Nullable: true, Comment: "Certificate serial number"},
+		{Name: "common_name", Type: field.TypeString, Nullable: true, Comment: "Certificate common name"},
+		{Name: "sans", Type: field.TypeJSON, Nullable: true, Comment: "Subject alternative names"},
+		{Name: "certificate_pem", Type: field.TypeString, Size: 2147483647, Comment: "PEM-encoded certificate"},
+		{Name: "ca_certificate_pem", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "PEM-encoded issuer chain"},
+		{Name: "private_key_ref", Type: field.TypeString, Nullable: true, Comment: "env:/file:/vault: secret reference of the PEM-encoded private key"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "Certificate expiry"},
+	}
+	// DeployerExternalCertificatesTable holds the schema information for the "deployer_external_certificates" table.
+	DeployerExternalCertificatesTable = &schema.Table{
+		Name:       "deployer_external_certificates",
+		Columns:    DeployerExternalCertificatesColumns,
+		PrimaryKey: []*schema.Column{DeployerExternalCertificatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_external_certificate_id",
+				Unique:  true,
+				Columns: []*schema.Column{DeployerExternalCertificatesColumns[4], DeployerExternalCertificatesColumns[5]},
+			},
+		},
+	}
+	// DeployerInventorySnapshotsColumns holds the columns for the "deployer_inventory_snapshots" table.
+	DeployerInventorySnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "target_configuration_id", Type: field.TypeString, Comment: "Configuration whose device was inspected"},
+		{Name: "provider_type", Type: field.TypeString, Comment: "Provider that listed the certificates"},
+		{Name: "certificates", Type: field.TypeJSON, Nullable: true, Comment: "Certificates found on the device"},
+		{Name: "unmanaged_count", Type: field.TypeInt, Comment: "Number of certificates not deployed by the deployer", Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Comment: "Why the device could not be inspected"},
+	}
+	// DeployerInventorySnapshotsTable holds the schema information for the "deployer_inventory_snapshots" table.
+	DeployerInventorySnapshotsTable = &schema.Table{
+		Name:       "deployer_inventory_snapshots",
+		Columns:    DeployerInventorySnapshotsColumns,
+		PrimaryKey: []*schema.Column{DeployerInventorySnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_inventory_config",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerInventorySnapshotsColumns[5]},
+			},
+			{
+				Name:    "deployer_inventory_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerInventorySnapshotsColumns[4]},
+			},
+			{
+				Name:    "deployer_inventory_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerInventorySnapshotsColumns[1]},
+			},
+		},
+	}
+	// DeployerNotificationRulesColumns holds the columns for the "deployer_notification_rules" table.
+	DeployerNotificationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Rule name"},
+		{Name: "channel", Type: field.TypeEnum, Comment: "Channel notifications are delivered over", Enums: []string{"NOTIFICATION_CHANNEL_EMAIL", "NOTIFICATION_CHANNEL_WEBHOOK", "NOTIFICATION_CHANNEL_HTTP"}},
+		{Name: "recipients", Type: field.TypeJSON, Nullable: true, Comment: "Email recipients"},
+		{Name: "url", Type: field.TypeString, Nullable: true, Comment: "Webhook or HTTP endpoint URL, or a secret reference to it"},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true, Comment: "HTTP headers sent to the endpoint; values may be secret references"},
+		{Name: "statuses", Type: field.TypeJSON, Nullable: true, Comment: "Job statuses notified about, empty for failed and partial"},
+		{Name: "deployment_target_id", Type: field.TypeString, Comment: "Deployment target the rule is limited to, empty for tenant-wide rules", Default: ""},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether the rule sends notifications", Default: true},
+	}
+	// DeployerNotificationRulesTable holds the schema information for the "deployer_notification_rules" table.
+	DeployerNotificationRulesTable = &schema.Table{
+		Name:       "deployer_notification_rules",
+		Columns:    DeployerNotificationRulesColumns,
+		PrimaryKey: []*schema.Column{DeployerNotificationRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_notification_rule_name",
+				Unique:  true,
+				Columns: []*schema.Column{DeployerNotificationRulesColumns[5], DeployerNotificationRulesColumns[6]},
+			},
+			{
+				Name:    "deployer_notification_rule_target",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerNotificationRulesColumns[5], DeployerNotificationRulesColumns[12]},
+			},
+		},
+	}
+	// DeployerEventOutboxColumns holds the columns for the "deployer_event_outbox" table.
+	DeployerEventOutboxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "event_id", Type: field.TypeString, Unique: true, Comment: "ID of the event envelope, for consumers to deduplicate"},
+		{Name: "channel", Type: field.TypeString, Comment: "Channel the event is published on"},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Comment: "JSON event envelope"},
+		{Name: "attempts", Type: field.TypeInt32, Comment: "Failed publish attempts", Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Comment: "Error of the last failed attempt"},
+		{Name: "next_attempt_at", Type: field.TypeTime, Comment: "Earliest time of the next publish attempt"},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true, Comment: "When the event was published"},
+	}
+	// DeployerEventOutboxTable holds the schema information for the "deployer_event_outbox" table.
+	DeployerEventOutboxTable = &schema.Table{
+		Name:       "deployer_event_outbox",
+		Columns:    DeployerEventOutboxColumns,
+		PrimaryKey: []*schema.Column{DeployerEventOutboxColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_outbox_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerEventOutboxColumns[10]},
+			},
+		},
+	}
+	// DeployerRoleBindingsColumns holds the columns for the "deployer_role_bindings" table.
+	DeployerRoleBindingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "subject_type", Type: field.TypeEnum, Comment: "Kind of caller identity the binding matches", Enums: []string{"SUBJECT_TYPE_USER", "SUBJECT_TYPE_CLIENT", "SUBJECT_TYPE_CLAIM_ROLE"}},
+		{Name: "subject", Type: field.TypeString, Size: 255, Comment: "User ID, client certificate CN or JWT role claim"},
+		{Name: "role", Type: field.TypeEnum, Comment: "Granted role", Enums: []string{"ROLE_VIEWER", "ROLE_OPERATOR", "ROLE_ADMIN"}},
+		{Name: "deployment_target_id", Type: field.TypeString, Comment: "Deployment target the binding is limited to, empty for tenant-wide bindings", Default: ""},
+	}
+	// DeployerRoleBindingsTable holds the schema information for the "deployer_role_bindings" table.
+	DeployerRoleBindingsTable = &schema.Table{
+		Name:       "deployer_role_bindings",
+		Columns:    DeployerRoleBindingsColumns,
+		PrimaryKey: []*schema.Column{DeployerRoleBindingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deployer_role_binding_unique",
+				Unique:  true,
+				Columns: []*schema.Column{DeployerRoleBindingsColumns[5], DeployerRoleBindingsColumns[6], DeployerRoleBindingsColumns[7], DeployerRoleBindingsColumns[8], DeployerRoleBindingsColumns[9]},
+			},
+			{
+				Name:    "deployer_role_binding_target",
+				Unique:  false,
+				Columns: []*schema.Column{DeployerRoleBindingsColumns[9]},
+			},
+		},
+	}
 	// DeployerTargetConfigsColumns holds the columns for the "deployer_target_configs" table.
 	DeployerTargetConfigsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
@@ -274,10 +654,23 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "Configuration description"},
 		{Name: "provider_type", Type: field.TypeString, Comment: "Provider type (e.g., cloudflare, aws_acm)"},
 		{Name: "credentials_encrypted", Type: field.TypeBytes, Comment: "Encrypted provider credentials (JSON)"},
+		{Name: "credential_rotated_at", Type: field.TypeJSON, Nullable: true, Comment: "Time each credential field was last set, keyed by field name"},
 		{Name: "config", Type: field.TypeJSON, Nullable: true, Comment: "Provider-specific configuration"},
 		{Name: "status", Type: field.TypeEnum, Comment: "Configuration status", Enums: []string{"CONFIG_STATUS_UNSPECIFIED", "CONFIG_STATUS_ACTIVE", "CONFIG_STATUS_INACTIVE", "CONFIG_STATUS_ERROR"}, Default: "CONFIG_STATUS_ACTIVE"},
 		{Name: "status_message", Type: field.TypeString, Nullable: true, Comment: "Status message (e.g., error details)"},
+		{Name: "consecutive_failures", Type: field.TypeInt32, Comment: "Deployment attempts failed in a row; opens the circuit breaker at the threshold", Default: 0},
+		{Name: "next_probe_at", Type: field.TypeTime, Nullable: true, Comment: "When the open circuit is next probed by validating the credentials"},
+		{Name: "last_validated_at", Type: field.TypeTime, Nullable: true, Comment: "When the credentials were last validated against the device"},
+		{Name: "last_validation_error", Type: field.TypeString, Nullable: true, Comment: "Error of the last credential validation; empty when it passed"},
+		{Name: "next_validation_at", Type: field.TypeTime, Nullable: true, Comment: "When the scheduled credential health check is next due"},
+		{Name: "tls_probe_address", Type: field.TypeString, Nullable: true, Size: 255, Comment: "host:port whose served certificate the drift detector compares with the deployed one"},
+		{Name: "last_drift_check_at", Type: field.TypeTime, Nullable: true, Comment: "When the drift detector last checked the device"},
+		{Name: "next_drift_check_at", Type: field.TypeTime, Nullable: true, Comment: "When the drift detector next checks the device"},
 		{Name: "last_deployment_at", Type: field.TypeTime, Nullable: true, Comment: "Last deployment timestamp"},
+		{Name: "deployed_certificate_id", Type: field.TypeString, Nullable: true, Comment: "Certificate of the last successful deployment"},
+		{Name: "deployed_certificate_expires_at", Type: field.TypeTime, Nullable: true, Comment: "Expiry of the deployed certificate; unset until known"},
+		{Name: "revision", Type: field.TypeUint32, Comment: "Current revision number (see ConfigurationRevision)", Default: 0},
+		{Name: "retry_policy", Type: field.TypeJSON, Nullable: true, Comment: "Retry and timeout overrides for jobs deploying to this configuration"},
 	}
 	// DeployerTargetConfigsTable holds the schema information for the "deployer_target_configs" table.
 	DeployerTargetConfigsTable = &schema.Table{
@@ -303,7 +696,7 @@ var (
 			{
 				Name:    "targetconfiguration_status",
 				Unique:  false,
-				Columns: []*schema.Column{DeployerTargetConfigsColumns[12]},
+				Columns: []*schema.Column{DeployerTargetConfigsColumns[13]},
 			},
 		},
 	}
@@ -334,19 +727,45 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DeployerAuditChainHeadTable,
 		DeployerAuditLogsTable,
+		DeployerCertificateRevocationsTable,
+		DeployerChangeRecordsTable,
+		DeployerConfigRevisionsTable,
+		DeployerLocksTable,
 		DeployerHistoryTable,
 		DeployerJobsTable,
 		DeployerTargetsTable,
+		DeployerDriftEventsTable,
+		DeployerExternalCertificatesTable,
+		DeployerInventorySnapshotsTable,
+		DeployerNotificationRulesTable,
+		DeployerEventOutboxTable,
+		DeployerRoleBindingsTable,
 		DeployerTargetConfigsTable,
 		DeploymentTargetConfigurationsTable,
 	}
 )
 
 func init() {
+	DeployerAuditChainHeadTable.Annotation = &entsql.Annotation{
+		Table: "deployer_audit_chain_head",
+	}
 	DeployerAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "deployer_audit_logs",
 	}
+	DeployerCertificateRevocationsTable.Annotation = &entsql.Annotation{
+		Table: "deployer_certificate_revocations",
+	}
+	DeployerChangeRecordsTable.Annotation = &entsql.Annotation{
+		Table: "deployer_change_records",
+	}
+	DeployerConfigRevisionsTable.Annotation = &entsql.Annotation{
+		Table: "deployer_config_revisions",
+	}
+	DeployerLocksTable.Annotation = &entsql.Annotation{
+		Table: "deployer_locks",
+	}
 	DeployerHistoryTable.ForeignKeys[0].RefTable = DeployerJobsTable
 	DeployerHistoryTable.Annotation = &entsql.Annotation{
 		Table: "deployer_history",
@@ -360,6 +779,24 @@ func init() {
 	DeployerTargetsTable.Annotation = &entsql.Annotation{
 		Table: "deployer_targets",
 	}
+	DeployerDriftEventsTable.Annotation = &entsql.Annotation{
+		Table: "deployer_drift_events",
+	}
+	DeployerExternalCertificatesTable.Annotation = &entsql.Annotation{
+		Table: "deployer_external_certificates",
+	}
+	DeployerInventorySnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "deployer_inventory_snapshots",
+	}
+	DeployerNotificationRulesTable.Annotation = &entsql.Annotation{
+		Table: "deployer_notification_rules",
+	}
+	DeployerEventOutboxTable.Annotation = &entsql.Annotation{
+		Table: "deployer_event_outbox",
+	}
+	DeployerRoleBindingsTable.Annotation = &entsql.Annotation{
+		Table: "deployer_role_bindings",
+	}
 	DeployerTargetConfigsTable.Annotation = &entsql.Annotation{
 		Table: "deployer_target_configs",
 	}
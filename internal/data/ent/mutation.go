@@ -9,13 +9,25 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditchainhead"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/certificaterevocation"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploylock"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenthistory"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/externalcertificate"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/inventorysnapshot"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/outboxevent"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/rolebinding"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -30,61 +42,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuditLog            = "AuditLog"
-	TypeDeploymentHistory   = "DeploymentHistory"
-	TypeDeploymentJob       = "DeploymentJob"
-	TypeDeploymentTarget    = "DeploymentTarget"
-	TypeTargetConfiguration = "TargetConfiguration"
+	TypeAuditChainHead        = "AuditChainHead"
+	TypeAuditLog              = "AuditLog"
+	TypeCertificateRevocation = "CertificateRevocation"
+	TypeChangeRecord          = "ChangeRecord"
+	TypeConfigurationRevision = "ConfigurationRevision"
+	TypeDeployLock            = "DeployLock"
+	TypeDeploymentHistory     = "DeploymentHistory"
+	TypeDeploymentJob         = "DeploymentJob"
+	TypeDeploymentTarget      = "DeploymentTarget"
+	TypeDriftEvent            = "DriftEvent"
+	TypeExternalCertificate   = "ExternalCertificate"
+	TypeInventorySnapshot     = "InventorySnapshot"
+	TypeNotificationRule      = "NotificationRule"
+	TypeOutboxEvent           = "OutboxEvent"
+	TypeRoleBinding           = "RoleBinding"
+	TypeTargetConfiguration   = "TargetConfiguration"
 )
 
-// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
-type AuditLogMutation struct {
+// AuditChainHeadMutation represents an operation that mutates the AuditChainHead nodes in the graph.
+type AuditChainHeadMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uint32
-	create_time          *time.Time
-	update_time          *time.Time
-	delete_time          *time.Time
-	tenant_id            *uint32
-	addtenant_id         *int32
-	audit_id             *string
-	request_id           *string
-	operation            *string
-	service_name         *string
-	client_id            *string
-	client_common_name   *string
-	client_organization  *string
-	client_serial_number *string
-	is_authenticated     *bool
-	success              *bool
-	error_code           *int32
-	adderror_code        *int32
-	error_message        *string
-	latency_ms           *int64
-	addlatency_ms        *int64
-	peer_address         *string
-	geo_location         *map[string]string
-	log_hash             *string
-	signature            *[]byte
-	metadata             *map[string]string
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*AuditLog, error)
-	predicates           []predicate.AuditLog
-}
-
-var _ ent.Mutation = (*AuditLogMutation)(nil)
-
-// auditlogOption allows management of the mutation configuration using functional options.
-type auditlogOption func(*AuditLogMutation)
-
-// newAuditLogMutation creates new mutation for the AuditLog entity.
-func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
-	m := &AuditLogMutation{
+	op            Op
+	typ           string
+	id            *int
+	last_id       *uint32
+	addlast_id    *int32
+	last_hash     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditChainHead, error)
+	predicates    []predicate.AuditChainHead
+}
+
+var _ ent.Mutation = (*AuditChainHeadMutation)(nil)
+
+// auditchainheadOption allows management of the mutation configuration using functional options.
+type auditchainheadOption func(*AuditChainHeadMutation)
+
+// newAuditChainHeadMutation creates new mutation for the AuditChainHead entity.
+func newAuditChainHeadMutation(c config, op Op, opts ...auditchainheadOption) *AuditChainHeadMutation {
+	m := &AuditChainHeadMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuditLog,
+		typ:           TypeAuditChainHead,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -93,20 +94,20 @@ func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutat
 	return m
 }
 
-// withAuditLogID sets the ID field of the mutation.
-func withAuditLogID(id uint32) auditlogOption {
-	return func(m *AuditLogMutation) {
+// withAuditChainHeadID sets the ID field of the mutation.
+func withAuditChainHeadID(id int) auditchainheadOption {
+	return func(m *AuditChainHeadMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuditLog
+			value *AuditChainHead
 		)
-		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+		m.oldValue = func(ctx context.Context) (*AuditChainHead, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuditLog.Get(ctx, id)
+					value, err = m.Client().AuditChainHead.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -115,10 +116,10 @@ func withAuditLogID(id uint32) auditlogOption {
 	}
 }
 
-// withAuditLog sets the old AuditLog of the mutation.
-func withAuditLog(node *AuditLog) auditlogOption {
-	return func(m *AuditLogMutation) {
-		m.oldValue = func(context.Context) (*AuditLog, error) {
+// withAuditChainHead sets the old AuditChainHead of the mutation.
+func withAuditChainHead(node *AuditChainHead) auditchainheadOption {
+	return func(m *AuditChainHeadMutation) {
+		m.oldValue = func(context.Context) (*AuditChainHead, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -127,7 +128,7 @@ func withAuditLog(node *AuditLog) auditlogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuditLogMutation) Client() *Client {
+func (m AuditChainHeadMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -135,7 +136,7 @@ func (m AuditLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuditLogMutation) Tx() (*Tx, error) {
+func (m AuditChainHeadMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -145,14 +146,14 @@ func (m AuditLogMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuditLog entities.
-func (m *AuditLogMutation) SetID(id uint32) {
+// operation is only accepted on creation of AuditChainHead entities.
+func (m *AuditChainHeadMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuditLogMutation) ID() (id uint32, exists bool) {
+func (m *AuditChainHeadMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -163,1236 +164,13592 @@ func (m *AuditLogMutation) ID() (id uint32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuditLogMutation) IDs(ctx context.Context) ([]uint32, error) {
+func (m *AuditChainHeadMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uint32{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditChainHead.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *AuditLogMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetLastID sets the "last_id" field.
+func (m *AuditChainHeadMutation) SetLastID(u uint32) {
+	m.last_id = &u
+	m.addlast_id = nil
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *AuditLogMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// LastID returns the value of the "last_id" field in the mutation.
+func (m *AuditChainHeadMutation) LastID() (r uint32, exists bool) {
+	v := m.last_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldLastID returns the old "last_id" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *AuditChainHeadMutation) OldLastID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldLastID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastID: %w", err)
 	}
-	return oldValue.CreateTime, nil
-}
-
-// ClearCreateTime clears the value of the "create_time" field.
-func (m *AuditLogMutation) ClearCreateTime() {
-	m.create_time = nil
-	m.clearedFields[auditlog.FieldCreateTime] = struct{}{}
-}
-
-// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *AuditLogMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldCreateTime]
-	return ok
+	return oldValue.LastID, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *AuditLogMutation) ResetCreateTime() {
-	m.create_time = nil
-	delete(m.clearedFields, auditlog.FieldCreateTime)
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (m *AuditLogMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// AddLastID adds u to the "last_id" field.
+func (m *AuditChainHeadMutation) AddLastID(u int32) {
+	if m.addlast_id != nil {
+		*m.addlast_id += u
+	} else {
+		m.addlast_id = &u
+	}
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *AuditLogMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// AddedLastID returns the value that was added to the "last_id" field in this mutation.
+func (m *AuditChainHeadMutation) AddedLastID() (r int32, exists bool) {
+	v := m.addlast_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
-	}
-	return oldValue.UpdateTime, nil
-}
-
-// ClearUpdateTime clears the value of the "update_time" field.
-func (m *AuditLogMutation) ClearUpdateTime() {
-	m.update_time = nil
-	m.clearedFields[auditlog.FieldUpdateTime] = struct{}{}
+// ClearLastID clears the value of the "last_id" field.
+func (m *AuditChainHeadMutation) ClearLastID() {
+	m.last_id = nil
+	m.addlast_id = nil
+	m.clearedFields[auditchainhead.FieldLastID] = struct{}{}
 }
 
-// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *AuditLogMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldUpdateTime]
+// LastIDCleared returns if the "last_id" field was cleared in this mutation.
+func (m *AuditChainHeadMutation) LastIDCleared() bool {
+	_, ok := m.clearedFields[auditchainhead.FieldLastID]
 	return ok
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *AuditLogMutation) ResetUpdateTime() {
-	m.update_time = nil
-	delete(m.clearedFields, auditlog.FieldUpdateTime)
+// ResetLastID resets all changes to the "last_id" field.
+func (m *AuditChainHeadMutation) ResetLastID() {
+	m.last_id = nil
+	m.addlast_id = nil
+	delete(m.clearedFields, auditchainhead.FieldLastID)
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (m *AuditLogMutation) SetDeleteTime(t time.Time) {
-	m.delete_time = &t
+// SetLastHash sets the "last_hash" field.
+func (m *AuditChainHeadMutation) SetLastHash(s string) {
+	m.last_hash = &s
 }
 
-// DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *AuditLogMutation) DeleteTime() (r time.Time, exists bool) {
-	v := m.delete_time
+// LastHash returns the value of the "last_hash" field in the mutation.
+func (m *AuditChainHeadMutation) LastHash() (r string, exists bool) {
+	v := m.last_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldLastHash returns the old "last_hash" field's value of the AuditChainHead entity.
+// If the AuditChainHead object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *AuditChainHeadMutation) OldLastHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+		return v, errors.New("OldLastHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastHash: %w", err)
 	}
-	return oldValue.DeleteTime, nil
+	return oldValue.LastHash, nil
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (m *AuditLogMutation) ClearDeleteTime() {
-	m.delete_time = nil
-	m.clearedFields[auditlog.FieldDeleteTime] = struct{}{}
+// ClearLastHash clears the value of the "last_hash" field.
+func (m *AuditChainHeadMutation) ClearLastHash() {
+	m.last_hash = nil
+	m.clearedFields[auditchainhead.FieldLastHash] = struct{}{}
 }
 
-// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *AuditLogMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldDeleteTime]
+// LastHashCleared returns if the "last_hash" field was cleared in this mutation.
+func (m *AuditChainHeadMutation) LastHashCleared() bool {
+	_, ok := m.clearedFields[auditchainhead.FieldLastHash]
 	return ok
 }
 
-// ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *AuditLogMutation) ResetDeleteTime() {
-	m.delete_time = nil
-	delete(m.clearedFields, auditlog.FieldDeleteTime)
+// ResetLastHash resets all changes to the "last_hash" field.
+func (m *AuditChainHeadMutation) ResetLastHash() {
+	m.last_hash = nil
+	delete(m.clearedFields, auditchainhead.FieldLastHash)
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *AuditLogMutation) SetTenantID(u uint32) {
-	m.tenant_id = &u
-	m.addtenant_id = nil
+// Where appends a list predicates to the AuditChainHeadMutation builder.
+func (m *AuditChainHeadMutation) Where(ps ...predicate.AuditChainHead) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *AuditLogMutation) TenantID() (r uint32, exists bool) {
-	v := m.tenant_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuditChainHeadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditChainHeadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditChainHead, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *AuditChainHeadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditChainHeadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditChainHead).
+func (m *AuditChainHeadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditChainHeadMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.last_id != nil {
+		fields = append(fields, auditchainhead.FieldLastID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	if m.last_hash != nil {
+		fields = append(fields, auditchainhead.FieldLastHash)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditChainHeadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditchainhead.FieldLastID:
+		return m.LastID()
+	case auditchainhead.FieldLastHash:
+		return m.LastHash()
 	}
-	return oldValue.TenantID, nil
+	return nil, false
 }
 
-// AddTenantID adds u to the "tenant_id" field.
-func (m *AuditLogMutation) AddTenantID(u int32) {
-	if m.addtenant_id != nil {
-		*m.addtenant_id += u
-	} else {
-		m.addtenant_id = &u
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditChainHeadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditchainhead.FieldLastID:
+		return m.OldLastID(ctx)
+	case auditchainhead.FieldLastHash:
+		return m.OldLastHash(ctx)
 	}
+	return nil, fmt.Errorf("unknown AuditChainHead field %s", name)
 }
 
-// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *AuditLogMutation) AddedTenantID() (r int32, exists bool) {
-	v := m.addtenant_id
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditChainHeadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditchainhead.FieldLastID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastID(v)
+		return nil
+	case auditchainhead.FieldLastHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHash(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditChainHead field %s", name)
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *AuditLogMutation) ClearTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	m.clearedFields[auditlog.FieldTenantID] = struct{}{}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditChainHeadMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_id != nil {
+		fields = append(fields, auditchainhead.FieldLastID)
+	}
+	return fields
 }
 
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *AuditLogMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldTenantID]
-	return ok
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditChainHeadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditchainhead.FieldLastID:
+		return m.AddedLastID()
+	}
+	return nil, false
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *AuditLogMutation) ResetTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	delete(m.clearedFields, auditlog.FieldTenantID)
-}
-
-// SetAuditID sets the "audit_id" field.
-func (m *AuditLogMutation) SetAuditID(s string) {
-	m.audit_id = &s
-}
-
-// AuditID returns the value of the "audit_id" field in the mutation.
-func (m *AuditLogMutation) AuditID() (r string, exists bool) {
-	v := m.audit_id
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditChainHeadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditchainhead.FieldLastID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditChainHead numeric field %s", name)
 }
 
-// OldAuditID returns the old "audit_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldAuditID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuditID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuditID requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditChainHeadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditchainhead.FieldLastID) {
+		fields = append(fields, auditchainhead.FieldLastID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuditID: %w", err)
+	if m.FieldCleared(auditchainhead.FieldLastHash) {
+		fields = append(fields, auditchainhead.FieldLastHash)
 	}
-	return oldValue.AuditID, nil
-}
-
-// ResetAuditID resets all changes to the "audit_id" field.
-func (m *AuditLogMutation) ResetAuditID() {
-	m.audit_id = nil
+	return fields
 }
 
-// SetRequestID sets the "request_id" field.
-func (m *AuditLogMutation) SetRequestID(s string) {
-	m.request_id = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditChainHeadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// RequestID returns the value of the "request_id" field in the mutation.
-func (m *AuditLogMutation) RequestID() (r string, exists bool) {
-	v := m.request_id
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditChainHeadMutation) ClearField(name string) error {
+	switch name {
+	case auditchainhead.FieldLastID:
+		m.ClearLastID()
+		return nil
+	case auditchainhead.FieldLastHash:
+		m.ClearLastHash()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditChainHead nullable field %s", name)
 }
 
-// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditChainHeadMutation) ResetField(name string) error {
+	switch name {
+	case auditchainhead.FieldLastID:
+		m.ResetLastID()
+		return nil
+	case auditchainhead.FieldLastHash:
+		m.ResetLastHash()
+		return nil
 	}
-	return oldValue.RequestID, nil
+	return fmt.Errorf("unknown AuditChainHead field %s", name)
 }
 
-// ClearRequestID clears the value of the "request_id" field.
-func (m *AuditLogMutation) ClearRequestID() {
-	m.request_id = nil
-	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditChainHeadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
-func (m *AuditLogMutation) RequestIDCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldRequestID]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditChainHeadMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetRequestID resets all changes to the "request_id" field.
-func (m *AuditLogMutation) ResetRequestID() {
-	m.request_id = nil
-	delete(m.clearedFields, auditlog.FieldRequestID)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditChainHeadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetOperation sets the "operation" field.
-func (m *AuditLogMutation) SetOperation(s string) {
-	m.operation = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditChainHeadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Operation returns the value of the "operation" field in the mutation.
-func (m *AuditLogMutation) Operation() (r string, exists bool) {
-	v := m.operation
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditChainHeadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldOperation returns the old "operation" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperation requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
-	}
-	return oldValue.Operation, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditChainHeadMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetOperation resets all changes to the "operation" field.
-func (m *AuditLogMutation) ResetOperation() {
-	m.operation = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditChainHeadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditChainHead unique edge %s", name)
 }
 
-// SetServiceName sets the "service_name" field.
-func (m *AuditLogMutation) SetServiceName(s string) {
-	m.service_name = &s
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditChainHeadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditChainHead edge %s", name)
 }
 
-// ServiceName returns the value of the "service_name" field in the mutation.
-func (m *AuditLogMutation) ServiceName() (r string, exists bool) {
-	v := m.service_name
-	if v == nil {
-		return
-	}
-	return *v, true
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint32
+	create_time          *time.Time
+	update_time          *time.Time
+	delete_time          *time.Time
+	tenant_id            *uint32
+	addtenant_id         *int32
+	audit_id             *string
+	request_id           *string
+	operation            *string
+	service_name         *string
+	client_id            *string
+	client_common_name   *string
+	client_organization  *string
+	client_serial_number *string
+	is_authenticated     *bool
+	success              *bool
+	error_code           *int32
+	adderror_code        *int32
+	error_message        *string
+	latency_ms           *int64
+	addlatency_ms        *int64
+	peer_address         *string
+	geo_location         *map[string]string
+	log_hash             *string
+	signature            *[]byte
+	prev_id              *uint32
+	addprev_id           *int32
+	prev_hash            *string
+	metadata             *map[string]string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*AuditLog, error)
+	predicates           []predicate.AuditLog
 }
 
-// OldServiceName returns the old "service_name" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldServiceName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServiceName requires an ID field in the mutation")
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLog,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.ServiceName, nil
+	return m
 }
 
-// ResetServiceName resets all changes to the "service_name" field.
-func (m *AuditLogMutation) ResetServiceName() {
-	m.service_name = nil
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id uint32) auditlogOption {
+	return func(m *AuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *AuditLogMutation) SetClientID(s string) {
-	m.client_id = &s
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *AuditLogMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldClientID returns the old "client_id" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.ClientID, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearClientID clears the value of the "client_id" field.
-func (m *AuditLogMutation) ClearClientID() {
-	m.client_id = nil
-	m.clearedFields[auditlog.FieldClientID] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id uint32) {
+	m.id = &id
 }
 
-// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientIDCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientID]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditLogMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *AuditLogMutation) ResetClientID() {
-	m.client_id = nil
-	delete(m.clearedFields, auditlog.FieldClientID)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetClientCommonName sets the "client_common_name" field.
-func (m *AuditLogMutation) SetClientCommonName(s string) {
-	m.client_common_name = &s
+// SetCreateTime sets the "create_time" field.
+func (m *AuditLogMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// ClientCommonName returns the value of the "client_common_name" field in the mutation.
-func (m *AuditLogMutation) ClientCommonName() (r string, exists bool) {
-	v := m.client_common_name
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *AuditLogMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientCommonName returns the old "client_common_name" field's value of the AuditLog entity.
+// OldCreateTime returns the old "create_time" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientCommonName(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientCommonName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientCommonName requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientCommonName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.ClientCommonName, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearClientCommonName clears the value of the "client_common_name" field.
-func (m *AuditLogMutation) ClearClientCommonName() {
-	m.client_common_name = nil
-	m.clearedFields[auditlog.FieldClientCommonName] = struct{}{}
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *AuditLogMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[auditlog.FieldCreateTime] = struct{}{}
 }
 
-// ClientCommonNameCleared returns if the "client_common_name" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientCommonNameCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientCommonName]
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *AuditLogMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldCreateTime]
 	return ok
 }
 
-// ResetClientCommonName resets all changes to the "client_common_name" field.
-func (m *AuditLogMutation) ResetClientCommonName() {
-	m.client_common_name = nil
-	delete(m.clearedFields, auditlog.FieldClientCommonName)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *AuditLogMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, auditlog.FieldCreateTime)
 }
 
-// SetClientOrganization sets the "client_organization" field.
-func (m *AuditLogMutation) SetClientOrganization(s string) {
-	m.client_organization = &s
+// SetUpdateTime sets the "update_time" field.
+func (m *AuditLogMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// ClientOrganization returns the value of the "client_organization" field in the mutation.
-func (m *AuditLogMutation) ClientOrganization() (r string, exists bool) {
-	v := m.client_organization
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *AuditLogMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientOrganization returns the old "client_organization" field's value of the AuditLog entity.
+// OldUpdateTime returns the old "update_time" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientOrganization(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientOrganization is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientOrganization requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientOrganization: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.ClientOrganization, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ClearClientOrganization clears the value of the "client_organization" field.
-func (m *AuditLogMutation) ClearClientOrganization() {
-	m.client_organization = nil
-	m.clearedFields[auditlog.FieldClientOrganization] = struct{}{}
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *AuditLogMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[auditlog.FieldUpdateTime] = struct{}{}
 }
 
-// ClientOrganizationCleared returns if the "client_organization" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientOrganizationCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientOrganization]
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *AuditLogMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldUpdateTime]
 	return ok
 }
 
-// ResetClientOrganization resets all changes to the "client_organization" field.
-func (m *AuditLogMutation) ResetClientOrganization() {
-	m.client_organization = nil
-	delete(m.clearedFields, auditlog.FieldClientOrganization)
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *AuditLogMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, auditlog.FieldUpdateTime)
 }
 
-// SetClientSerialNumber sets the "client_serial_number" field.
-func (m *AuditLogMutation) SetClientSerialNumber(s string) {
-	m.client_serial_number = &s
+// SetDeleteTime sets the "delete_time" field.
+func (m *AuditLogMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
 }
 
-// ClientSerialNumber returns the value of the "client_serial_number" field in the mutation.
-func (m *AuditLogMutation) ClientSerialNumber() (r string, exists bool) {
-	v := m.client_serial_number
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *AuditLogMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSerialNumber returns the old "client_serial_number" field's value of the AuditLog entity.
+// OldDeleteTime returns the old "delete_time" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldClientSerialNumber(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSerialNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSerialNumber requires an ID field in the mutation")
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSerialNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
 	}
-	return oldValue.ClientSerialNumber, nil
+	return oldValue.DeleteTime, nil
 }
 
-// ClearClientSerialNumber clears the value of the "client_serial_number" field.
-func (m *AuditLogMutation) ClearClientSerialNumber() {
-	m.client_serial_number = nil
-	m.clearedFields[auditlog.FieldClientSerialNumber] = struct{}{}
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *AuditLogMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[auditlog.FieldDeleteTime] = struct{}{}
 }
 
-// ClientSerialNumberCleared returns if the "client_serial_number" field was cleared in this mutation.
-func (m *AuditLogMutation) ClientSerialNumberCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldClientSerialNumber]
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *AuditLogMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldDeleteTime]
 	return ok
 }
 
-// ResetClientSerialNumber resets all changes to the "client_serial_number" field.
-func (m *AuditLogMutation) ResetClientSerialNumber() {
-	m.client_serial_number = nil
-	delete(m.clearedFields, auditlog.FieldClientSerialNumber)
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *AuditLogMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, auditlog.FieldDeleteTime)
 }
 
-// SetIsAuthenticated sets the "is_authenticated" field.
-func (m *AuditLogMutation) SetIsAuthenticated(b bool) {
-	m.is_authenticated = &b
+// SetTenantID sets the "tenant_id" field.
+func (m *AuditLogMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// IsAuthenticated returns the value of the "is_authenticated" field in the mutation.
-func (m *AuditLogMutation) IsAuthenticated() (r bool, exists bool) {
-	v := m.is_authenticated
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AuditLogMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsAuthenticated returns the old "is_authenticated" field's value of the AuditLog entity.
+// OldTenantID returns the old "tenant_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldIsAuthenticated(ctx context.Context) (v bool, err error) {
+func (m *AuditLogMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAuthenticated is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAuthenticated requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAuthenticated: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.IsAuthenticated, nil
-}
-
-// ResetIsAuthenticated resets all changes to the "is_authenticated" field.
-func (m *AuditLogMutation) ResetIsAuthenticated() {
-	m.is_authenticated = nil
+	return oldValue.TenantID, nil
 }
 
-// SetSuccess sets the "success" field.
-func (m *AuditLogMutation) SetSuccess(b bool) {
-	m.success = &b
+// AddTenantID adds u to the "tenant_id" field.
+func (m *AuditLogMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// Success returns the value of the "success" field in the mutation.
-func (m *AuditLogMutation) Success() (r bool, exists bool) {
-	v := m.success
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *AuditLogMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccess returns the old "success" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldSuccess(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccess requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
-	}
-	return oldValue.Success, nil
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *AuditLogMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[auditlog.FieldTenantID] = struct{}{}
 }
 
-// ResetSuccess resets all changes to the "success" field.
-func (m *AuditLogMutation) ResetSuccess() {
-	m.success = nil
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTenantID]
+	return ok
 }
 
-// SetErrorCode sets the "error_code" field.
-func (m *AuditLogMutation) SetErrorCode(i int32) {
-	m.error_code = &i
-	m.adderror_code = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AuditLogMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, auditlog.FieldTenantID)
 }
 
-// ErrorCode returns the value of the "error_code" field in the mutation.
-func (m *AuditLogMutation) ErrorCode() (r int32, exists bool) {
-	v := m.error_code
+// SetAuditID sets the "audit_id" field.
+func (m *AuditLogMutation) SetAuditID(s string) {
+	m.audit_id = &s
+}
+
+// AuditID returns the value of the "audit_id" field in the mutation.
+func (m *AuditLogMutation) AuditID() (r string, exists bool) {
+	v := m.audit_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorCode returns the old "error_code" field's value of the AuditLog entity.
+// OldAuditID returns the old "audit_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldErrorCode(ctx context.Context) (v *int32, err error) {
+func (m *AuditLogMutation) OldAuditID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuditID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+		return v, errors.New("OldAuditID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
-	}
-	return oldValue.ErrorCode, nil
-}
-
-// AddErrorCode adds i to the "error_code" field.
-func (m *AuditLogMutation) AddErrorCode(i int32) {
-	if m.adderror_code != nil {
-		*m.adderror_code += i
-	} else {
-		m.adderror_code = &i
-	}
-}
-
-// AddedErrorCode returns the value that was added to the "error_code" field in this mutation.
-func (m *AuditLogMutation) AddedErrorCode() (r int32, exists bool) {
-	v := m.adderror_code
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAuditID: %w", err)
 	}
-	return *v, true
-}
-
-// ClearErrorCode clears the value of the "error_code" field.
-func (m *AuditLogMutation) ClearErrorCode() {
-	m.error_code = nil
-	m.adderror_code = nil
-	m.clearedFields[auditlog.FieldErrorCode] = struct{}{}
-}
-
-// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
-func (m *AuditLogMutation) ErrorCodeCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldErrorCode]
-	return ok
+	return oldValue.AuditID, nil
 }
 
-// ResetErrorCode resets all changes to the "error_code" field.
-func (m *AuditLogMutation) ResetErrorCode() {
-	m.error_code = nil
-	m.adderror_code = nil
-	delete(m.clearedFields, auditlog.FieldErrorCode)
+// ResetAuditID resets all changes to the "audit_id" field.
+func (m *AuditLogMutation) ResetAuditID() {
+	m.audit_id = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *AuditLogMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetRequestID sets the "request_id" field.
+func (m *AuditLogMutation) SetRequestID(s string) {
+	m.request_id = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *AuditLogMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the AuditLog entity.
+// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.RequestID, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *AuditLogMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[auditlog.FieldErrorMessage] = struct{}{}
+// ClearRequestID clears the value of the "request_id" field.
+func (m *AuditLogMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *AuditLogMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldErrorMessage]
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *AuditLogMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRequestID]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *AuditLogMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, auditlog.FieldErrorMessage)
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditLogMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditlog.FieldRequestID)
 }
 
-// SetLatencyMs sets the "latency_ms" field.
-func (m *AuditLogMutation) SetLatencyMs(i int64) {
-	m.latency_ms = &i
-	m.addlatency_ms = nil
+// SetOperation sets the "operation" field.
+func (m *AuditLogMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// LatencyMs returns the value of the "latency_ms" field in the mutation.
-func (m *AuditLogMutation) LatencyMs() (r int64, exists bool) {
-	v := m.latency_ms
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditLogMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLatencyMs returns the old "latency_ms" field's value of the AuditLog entity.
+// OldOperation returns the old "operation" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
-	}
-	return oldValue.LatencyMs, nil
-}
-
-// AddLatencyMs adds i to the "latency_ms" field.
-func (m *AuditLogMutation) AddLatencyMs(i int64) {
-	if m.addlatency_ms != nil {
-		*m.addlatency_ms += i
-	} else {
-		m.addlatency_ms = &i
-	}
-}
-
-// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
-func (m *AuditLogMutation) AddedLatencyMs() (r int64, exists bool) {
-	v := m.addlatency_ms
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return *v, true
+	return oldValue.Operation, nil
 }
 
-// ResetLatencyMs resets all changes to the "latency_ms" field.
-func (m *AuditLogMutation) ResetLatencyMs() {
-	m.latency_ms = nil
-	m.addlatency_ms = nil
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditLogMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// SetPeerAddress sets the "peer_address" field.
-func (m *AuditLogMutation) SetPeerAddress(s string) {
-	m.peer_address = &s
+// SetServiceName sets the "service_name" field.
+func (m *AuditLogMutation) SetServiceName(s string) {
+	m.service_name = &s
 }
 
-// PeerAddress returns the value of the "peer_address" field in the mutation.
-func (m *AuditLogMutation) PeerAddress() (r string, exists bool) {
-	v := m.peer_address
+// ServiceName returns the value of the "service_name" field in the mutation.
+func (m *AuditLogMutation) ServiceName() (r string, exists bool) {
+	v := m.service_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeerAddress returns the old "peer_address" field's value of the AuditLog entity.
+// OldServiceName returns the old "service_name" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldPeerAddress(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldServiceName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeerAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeerAddress requires an ID field in the mutation")
+		return v, errors.New("OldServiceName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeerAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
 	}
-	return oldValue.PeerAddress, nil
-}
-
-// ClearPeerAddress clears the value of the "peer_address" field.
-func (m *AuditLogMutation) ClearPeerAddress() {
-	m.peer_address = nil
-	m.clearedFields[auditlog.FieldPeerAddress] = struct{}{}
-}
-
-// PeerAddressCleared returns if the "peer_address" field was cleared in this mutation.
-func (m *AuditLogMutation) PeerAddressCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldPeerAddress]
-	return ok
+	return oldValue.ServiceName, nil
 }
 
-// ResetPeerAddress resets all changes to the "peer_address" field.
-func (m *AuditLogMutation) ResetPeerAddress() {
-	m.peer_address = nil
-	delete(m.clearedFields, auditlog.FieldPeerAddress)
+// ResetServiceName resets all changes to the "service_name" field.
+func (m *AuditLogMutation) ResetServiceName() {
+	m.service_name = nil
 }
 
-// SetGeoLocation sets the "geo_location" field.
-func (m *AuditLogMutation) SetGeoLocation(value map[string]string) {
-	m.geo_location = &value
+// SetClientID sets the "client_id" field.
+func (m *AuditLogMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// GeoLocation returns the value of the "geo_location" field in the mutation.
-func (m *AuditLogMutation) GeoLocation() (r map[string]string, exists bool) {
-	v := m.geo_location
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *AuditLogMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGeoLocation returns the old "geo_location" field's value of the AuditLog entity.
+// OldClientID returns the old "client_id" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldGeoLocation(ctx context.Context) (v map[string]string, err error) {
+func (m *AuditLogMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGeoLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGeoLocation requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGeoLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.GeoLocation, nil
+	return oldValue.ClientID, nil
 }
 
-// ClearGeoLocation clears the value of the "geo_location" field.
-func (m *AuditLogMutation) ClearGeoLocation() {
-	m.geo_location = nil
-	m.clearedFields[auditlog.FieldGeoLocation] = struct{}{}
+// ClearClientID clears the value of the "client_id" field.
+func (m *AuditLogMutation) ClearClientID() {
+	m.client_id = nil
+	m.clearedFields[auditlog.FieldClientID] = struct{}{}
 }
 
-// GeoLocationCleared returns if the "geo_location" field was cleared in this mutation.
-func (m *AuditLogMutation) GeoLocationCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldGeoLocation]
+// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientID]
 	return ok
 }
 
-// ResetGeoLocation resets all changes to the "geo_location" field.
-func (m *AuditLogMutation) ResetGeoLocation() {
-	m.geo_location = nil
-	delete(m.clearedFields, auditlog.FieldGeoLocation)
+// ResetClientID resets all changes to the "client_id" field.
+func (m *AuditLogMutation) ResetClientID() {
+	m.client_id = nil
+	delete(m.clearedFields, auditlog.FieldClientID)
 }
 
-// SetLogHash sets the "log_hash" field.
-func (m *AuditLogMutation) SetLogHash(s string) {
-	m.log_hash = &s
+// SetClientCommonName sets the "client_common_name" field.
+func (m *AuditLogMutation) SetClientCommonName(s string) {
+	m.client_common_name = &s
 }
 
-// LogHash returns the value of the "log_hash" field in the mutation.
-func (m *AuditLogMutation) LogHash() (r string, exists bool) {
-	v := m.log_hash
+// ClientCommonName returns the value of the "client_common_name" field in the mutation.
+func (m *AuditLogMutation) ClientCommonName() (r string, exists bool) {
+	v := m.client_common_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogHash returns the old "log_hash" field's value of the AuditLog entity.
+// OldClientCommonName returns the old "client_common_name" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldLogHash(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldClientCommonName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientCommonName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogHash requires an ID field in the mutation")
+		return v, errors.New("OldClientCommonName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientCommonName: %w", err)
 	}
-	return oldValue.LogHash, nil
+	return oldValue.ClientCommonName, nil
 }
 
-// ClearLogHash clears the value of the "log_hash" field.
-func (m *AuditLogMutation) ClearLogHash() {
-	m.log_hash = nil
-	m.clearedFields[auditlog.FieldLogHash] = struct{}{}
+// ClearClientCommonName clears the value of the "client_common_name" field.
+func (m *AuditLogMutation) ClearClientCommonName() {
+	m.client_common_name = nil
+	m.clearedFields[auditlog.FieldClientCommonName] = struct{}{}
 }
 
-// LogHashCleared returns if the "log_hash" field was cleared in this mutation.
-func (m *AuditLogMutation) LogHashCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldLogHash]
+// ClientCommonNameCleared returns if the "client_common_name" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientCommonNameCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientCommonName]
 	return ok
 }
 
-// ResetLogHash resets all changes to the "log_hash" field.
-func (m *AuditLogMutation) ResetLogHash() {
-	m.log_hash = nil
-	delete(m.clearedFields, auditlog.FieldLogHash)
+// ResetClientCommonName resets all changes to the "client_common_name" field.
+func (m *AuditLogMutation) ResetClientCommonName() {
+	m.client_common_name = nil
+	delete(m.clearedFields, auditlog.FieldClientCommonName)
 }
 
-// SetSignature sets the "signature" field.
-func (m *AuditLogMutation) SetSignature(b []byte) {
-	m.signature = &b
+// SetClientOrganization sets the "client_organization" field.
+func (m *AuditLogMutation) SetClientOrganization(s string) {
+	m.client_organization = &s
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *AuditLogMutation) Signature() (r []byte, exists bool) {
-	v := m.signature
+// ClientOrganization returns the value of the "client_organization" field in the mutation.
+func (m *AuditLogMutation) ClientOrganization() (r string, exists bool) {
+	v := m.client_organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSignature returns the old "signature" field's value of the AuditLog entity.
+// OldClientOrganization returns the old "client_organization" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+func (m *AuditLogMutation) OldClientOrganization(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientOrganization is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignature requires an ID field in the mutation")
+		return v, errors.New("OldClientOrganization requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientOrganization: %w", err)
 	}
-	return oldValue.Signature, nil
+	return oldValue.ClientOrganization, nil
 }
 
-// ClearSignature clears the value of the "signature" field.
-func (m *AuditLogMutation) ClearSignature() {
-	m.signature = nil
-	m.clearedFields[auditlog.FieldSignature] = struct{}{}
+// ClearClientOrganization clears the value of the "client_organization" field.
+func (m *AuditLogMutation) ClearClientOrganization() {
+	m.client_organization = nil
+	m.clearedFields[auditlog.FieldClientOrganization] = struct{}{}
 }
 
-// SignatureCleared returns if the "signature" field was cleared in this mutation.
-func (m *AuditLogMutation) SignatureCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldSignature]
+// ClientOrganizationCleared returns if the "client_organization" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientOrganizationCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientOrganization]
 	return ok
 }
 
-// ResetSignature resets all changes to the "signature" field.
-func (m *AuditLogMutation) ResetSignature() {
-	m.signature = nil
-	delete(m.clearedFields, auditlog.FieldSignature)
+// ResetClientOrganization resets all changes to the "client_organization" field.
+func (m *AuditLogMutation) ResetClientOrganization() {
+	m.client_organization = nil
+	delete(m.clearedFields, auditlog.FieldClientOrganization)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *AuditLogMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetClientSerialNumber sets the "client_serial_number" field.
+func (m *AuditLogMutation) SetClientSerialNumber(s string) {
+	m.client_serial_number = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *AuditLogMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// ClientSerialNumber returns the value of the "client_serial_number" field in the mutation.
+func (m *AuditLogMutation) ClientSerialNumber() (r string, exists bool) {
+	v := m.client_serial_number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the AuditLog entity.
+// OldClientSerialNumber returns the old "client_serial_number" field's value of the AuditLog entity.
 // If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *AuditLogMutation) OldClientSerialNumber(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientSerialNumber is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldClientSerialNumber requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientSerialNumber: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.ClientSerialNumber, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *AuditLogMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
+// ClearClientSerialNumber clears the value of the "client_serial_number" field.
+func (m *AuditLogMutation) ClearClientSerialNumber() {
+	m.client_serial_number = nil
+	m.clearedFields[auditlog.FieldClientSerialNumber] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *AuditLogMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldMetadata]
+// ClientSerialNumberCleared returns if the "client_serial_number" field was cleared in this mutation.
+func (m *AuditLogMutation) ClientSerialNumberCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldClientSerialNumber]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *AuditLogMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, auditlog.FieldMetadata)
+// ResetClientSerialNumber resets all changes to the "client_serial_number" field.
+func (m *AuditLogMutation) ResetClientSerialNumber() {
+	m.client_serial_number = nil
+	delete(m.clearedFields, auditlog.FieldClientSerialNumber)
 }
 
-// Where appends a list predicates to the AuditLogMutation builder.
-func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
-	m.predicates = append(m.predicates, ps...)
+// SetIsAuthenticated sets the "is_authenticated" field.
+func (m *AuditLogMutation) SetIsAuthenticated(b bool) {
+	m.is_authenticated = &b
 }
 
-// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuditLog, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// IsAuthenticated returns the value of the "is_authenticated" field in the mutation.
+func (m *AuditLogMutation) IsAuthenticated() (r bool, exists bool) {
+	v := m.is_authenticated
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *AuditLogMutation) Op() Op {
-	return m.op
+// OldIsAuthenticated returns the old "is_authenticated" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldIsAuthenticated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAuthenticated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAuthenticated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAuthenticated: %w", err)
+	}
+	return oldValue.IsAuthenticated, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AuditLogMutation) SetOp(op Op) {
-	m.op = op
+// ResetIsAuthenticated resets all changes to the "is_authenticated" field.
+func (m *AuditLogMutation) ResetIsAuthenticated() {
+	m.is_authenticated = nil
 }
 
-// Type returns the node type of this mutation (AuditLog).
-func (m *AuditLogMutation) Type() string {
-	return m.typ
+// SetSuccess sets the "success" field.
+func (m *AuditLogMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AuditLogMutation) Fields() []string {
-	fields := make([]string, 0, 22)
-	if m.create_time != nil {
-		fields = append(fields, auditlog.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, auditlog.FieldUpdateTime)
-	}
-	if m.delete_time != nil {
-		fields = append(fields, auditlog.FieldDeleteTime)
-	}
-	if m.tenant_id != nil {
-		fields = append(fields, auditlog.FieldTenantID)
-	}
-	if m.audit_id != nil {
-		fields = append(fields, auditlog.FieldAuditID)
+// Success returns the value of the "success" field in the mutation.
+func (m *AuditLogMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
 	}
-	if m.request_id != nil {
-		fields = append(fields, auditlog.FieldRequestID)
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
-	if m.operation != nil {
-		fields = append(fields, auditlog.FieldOperation)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
 	}
-	if m.service_name != nil {
-		fields = append(fields, auditlog.FieldServiceName)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *AuditLogMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *AuditLogMutation) SetErrorCode(i int32) {
+	m.error_code = &i
+	m.adderror_code = nil
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *AuditLogMutation) ErrorCode() (r int32, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldErrorCode(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// AddErrorCode adds i to the "error_code" field.
+func (m *AuditLogMutation) AddErrorCode(i int32) {
+	if m.adderror_code != nil {
+		*m.adderror_code += i
+	} else {
+		m.adderror_code = &i
+	}
+}
+
+// AddedErrorCode returns the value that was added to the "error_code" field in this mutation.
+func (m *AuditLogMutation) AddedErrorCode() (r int32, exists bool) {
+	v := m.adderror_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *AuditLogMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.adderror_code = nil
+	m.clearedFields[auditlog.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *AuditLogMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *AuditLogMutation) ResetErrorCode() {
+	m.error_code = nil
+	m.adderror_code = nil
+	delete(m.clearedFields, auditlog.FieldErrorCode)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *AuditLogMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *AuditLogMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *AuditLogMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[auditlog.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *AuditLogMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *AuditLogMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, auditlog.FieldErrorMessage)
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *AuditLogMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *AuditLogMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *AuditLogMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *AuditLogMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *AuditLogMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetPeerAddress sets the "peer_address" field.
+func (m *AuditLogMutation) SetPeerAddress(s string) {
+	m.peer_address = &s
+}
+
+// PeerAddress returns the value of the "peer_address" field in the mutation.
+func (m *AuditLogMutation) PeerAddress() (r string, exists bool) {
+	v := m.peer_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerAddress returns the old "peer_address" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPeerAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerAddress: %w", err)
+	}
+	return oldValue.PeerAddress, nil
+}
+
+// ClearPeerAddress clears the value of the "peer_address" field.
+func (m *AuditLogMutation) ClearPeerAddress() {
+	m.peer_address = nil
+	m.clearedFields[auditlog.FieldPeerAddress] = struct{}{}
+}
+
+// PeerAddressCleared returns if the "peer_address" field was cleared in this mutation.
+func (m *AuditLogMutation) PeerAddressCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldPeerAddress]
+	return ok
+}
+
+// ResetPeerAddress resets all changes to the "peer_address" field.
+func (m *AuditLogMutation) ResetPeerAddress() {
+	m.peer_address = nil
+	delete(m.clearedFields, auditlog.FieldPeerAddress)
+}
+
+// SetGeoLocation sets the "geo_location" field.
+func (m *AuditLogMutation) SetGeoLocation(value map[string]string) {
+	m.geo_location = &value
+}
+
+// GeoLocation returns the value of the "geo_location" field in the mutation.
+func (m *AuditLogMutation) GeoLocation() (r map[string]string, exists bool) {
+	v := m.geo_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeoLocation returns the old "geo_location" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldGeoLocation(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeoLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeoLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeoLocation: %w", err)
+	}
+	return oldValue.GeoLocation, nil
+}
+
+// ClearGeoLocation clears the value of the "geo_location" field.
+func (m *AuditLogMutation) ClearGeoLocation() {
+	m.geo_location = nil
+	m.clearedFields[auditlog.FieldGeoLocation] = struct{}{}
+}
+
+// GeoLocationCleared returns if the "geo_location" field was cleared in this mutation.
+func (m *AuditLogMutation) GeoLocationCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldGeoLocation]
+	return ok
+}
+
+// ResetGeoLocation resets all changes to the "geo_location" field.
+func (m *AuditLogMutation) ResetGeoLocation() {
+	m.geo_location = nil
+	delete(m.clearedFields, auditlog.FieldGeoLocation)
+}
+
+// SetLogHash sets the "log_hash" field.
+func (m *AuditLogMutation) SetLogHash(s string) {
+	m.log_hash = &s
+}
+
+// LogHash returns the value of the "log_hash" field in the mutation.
+func (m *AuditLogMutation) LogHash() (r string, exists bool) {
+	v := m.log_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogHash returns the old "log_hash" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldLogHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogHash: %w", err)
+	}
+	return oldValue.LogHash, nil
+}
+
+// ClearLogHash clears the value of the "log_hash" field.
+func (m *AuditLogMutation) ClearLogHash() {
+	m.log_hash = nil
+	m.clearedFields[auditlog.FieldLogHash] = struct{}{}
+}
+
+// LogHashCleared returns if the "log_hash" field was cleared in this mutation.
+func (m *AuditLogMutation) LogHashCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldLogHash]
+	return ok
+}
+
+// ResetLogHash resets all changes to the "log_hash" field.
+func (m *AuditLogMutation) ResetLogHash() {
+	m.log_hash = nil
+	delete(m.clearedFields, auditlog.FieldLogHash)
+}
+
+// SetSignature sets the "signature" field.
+func (m *AuditLogMutation) SetSignature(b []byte) {
+	m.signature = &b
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *AuditLogMutation) Signature() (r []byte, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (m *AuditLogMutation) ClearSignature() {
+	m.signature = nil
+	m.clearedFields[auditlog.FieldSignature] = struct{}{}
+}
+
+// SignatureCleared returns if the "signature" field was cleared in this mutation.
+func (m *AuditLogMutation) SignatureCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldSignature]
+	return ok
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *AuditLogMutation) ResetSignature() {
+	m.signature = nil
+	delete(m.clearedFields, auditlog.FieldSignature)
+}
+
+// SetPrevID sets the "prev_id" field.
+func (m *AuditLogMutation) SetPrevID(u uint32) {
+	m.prev_id = &u
+	m.addprev_id = nil
+}
+
+// PrevID returns the value of the "prev_id" field in the mutation.
+func (m *AuditLogMutation) PrevID() (r uint32, exists bool) {
+	v := m.prev_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrevID returns the old "prev_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPrevID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrevID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrevID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrevID: %w", err)
+	}
+	return oldValue.PrevID, nil
+}
+
+// AddPrevID adds u to the "prev_id" field.
+func (m *AuditLogMutation) AddPrevID(u int32) {
+	if m.addprev_id != nil {
+		*m.addprev_id += u
+	} else {
+		m.addprev_id = &u
+	}
+}
+
+// AddedPrevID returns the value that was added to the "prev_id" field in this mutation.
+func (m *AuditLogMutation) AddedPrevID() (r int32, exists bool) {
+	v := m.addprev_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPrevID clears the value of the "prev_id" field.
+func (m *AuditLogMutation) ClearPrevID() {
+	m.prev_id = nil
+	m.addprev_id = nil
+	m.clearedFields[auditlog.FieldPrevID] = struct{}{}
+}
+
+// PrevIDCleared returns if the "prev_id" field was cleared in this mutation.
+func (m *AuditLogMutation) PrevIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldPrevID]
+	return ok
+}
+
+// ResetPrevID resets all changes to the "prev_id" field.
+func (m *AuditLogMutation) ResetPrevID() {
+	m.prev_id = nil
+	m.addprev_id = nil
+	delete(m.clearedFields, auditlog.FieldPrevID)
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (m *AuditLogMutation) SetPrevHash(s string) {
+	m.prev_hash = &s
+}
+
+// PrevHash returns the value of the "prev_hash" field in the mutation.
+func (m *AuditLogMutation) PrevHash() (r string, exists bool) {
+	v := m.prev_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrevHash returns the old "prev_hash" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPrevHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrevHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrevHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrevHash: %w", err)
+	}
+	return oldValue.PrevHash, nil
+}
+
+// ClearPrevHash clears the value of the "prev_hash" field.
+func (m *AuditLogMutation) ClearPrevHash() {
+	m.prev_hash = nil
+	m.clearedFields[auditlog.FieldPrevHash] = struct{}{}
+}
+
+// PrevHashCleared returns if the "prev_hash" field was cleared in this mutation.
+func (m *AuditLogMutation) PrevHashCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldPrevHash]
+	return ok
+}
+
+// ResetPrevHash resets all changes to the "prev_hash" field.
+func (m *AuditLogMutation) ResetPrevHash() {
+	m.prev_hash = nil
+	delete(m.clearedFields, auditlog.FieldPrevHash)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AuditLogMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AuditLogMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AuditLogMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AuditLogMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AuditLogMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, auditlog.FieldMetadata)
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.create_time != nil {
+		fields = append(fields, auditlog.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, auditlog.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, auditlog.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.audit_id != nil {
+		fields = append(fields, auditlog.FieldAuditID)
+	}
+	if m.request_id != nil {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.operation != nil {
+		fields = append(fields, auditlog.FieldOperation)
+	}
+	if m.service_name != nil {
+		fields = append(fields, auditlog.FieldServiceName)
 	}
 	if m.client_id != nil {
 		fields = append(fields, auditlog.FieldClientID)
 	}
-	if m.client_common_name != nil {
-		fields = append(fields, auditlog.FieldClientCommonName)
+	if m.client_common_name != nil {
+		fields = append(fields, auditlog.FieldClientCommonName)
+	}
+	if m.client_organization != nil {
+		fields = append(fields, auditlog.FieldClientOrganization)
+	}
+	if m.client_serial_number != nil {
+		fields = append(fields, auditlog.FieldClientSerialNumber)
+	}
+	if m.is_authenticated != nil {
+		fields = append(fields, auditlog.FieldIsAuthenticated)
+	}
+	if m.success != nil {
+		fields = append(fields, auditlog.FieldSuccess)
+	}
+	if m.error_code != nil {
+		fields = append(fields, auditlog.FieldErrorCode)
+	}
+	if m.error_message != nil {
+		fields = append(fields, auditlog.FieldErrorMessage)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, auditlog.FieldLatencyMs)
+	}
+	if m.peer_address != nil {
+		fields = append(fields, auditlog.FieldPeerAddress)
+	}
+	if m.geo_location != nil {
+		fields = append(fields, auditlog.FieldGeoLocation)
+	}
+	if m.log_hash != nil {
+		fields = append(fields, auditlog.FieldLogHash)
+	}
+	if m.signature != nil {
+		fields = append(fields, auditlog.FieldSignature)
+	}
+	if m.prev_id != nil {
+		fields = append(fields, auditlog.FieldPrevID)
+	}
+	if m.prev_hash != nil {
+		fields = append(fields, auditlog.FieldPrevHash)
+	}
+	if m.metadata != nil {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldCreateTime:
+		return m.CreateTime()
+	case auditlog.FieldUpdateTime:
+		return m.UpdateTime()
+	case auditlog.FieldDeleteTime:
+		return m.DeleteTime()
+	case auditlog.FieldTenantID:
+		return m.TenantID()
+	case auditlog.FieldAuditID:
+		return m.AuditID()
+	case auditlog.FieldRequestID:
+		return m.RequestID()
+	case auditlog.FieldOperation:
+		return m.Operation()
+	case auditlog.FieldServiceName:
+		return m.ServiceName()
+	case auditlog.FieldClientID:
+		return m.ClientID()
+	case auditlog.FieldClientCommonName:
+		return m.ClientCommonName()
+	case auditlog.FieldClientOrganization:
+		return m.ClientOrganization()
+	case auditlog.FieldClientSerialNumber:
+		return m.ClientSerialNumber()
+	case auditlog.FieldIsAuthenticated:
+		return m.IsAuthenticated()
+	case auditlog.FieldSuccess:
+		return m.Success()
+	case auditlog.FieldErrorCode:
+		return m.ErrorCode()
+	case auditlog.FieldErrorMessage:
+		return m.ErrorMessage()
+	case auditlog.FieldLatencyMs:
+		return m.LatencyMs()
+	case auditlog.FieldPeerAddress:
+		return m.PeerAddress()
+	case auditlog.FieldGeoLocation:
+		return m.GeoLocation()
+	case auditlog.FieldLogHash:
+		return m.LogHash()
+	case auditlog.FieldSignature:
+		return m.Signature()
+	case auditlog.FieldPrevID:
+		return m.PrevID()
+	case auditlog.FieldPrevHash:
+		return m.PrevHash()
+	case auditlog.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case auditlog.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case auditlog.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case auditlog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case auditlog.FieldAuditID:
+		return m.OldAuditID(ctx)
+	case auditlog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditlog.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditlog.FieldServiceName:
+		return m.OldServiceName(ctx)
+	case auditlog.FieldClientID:
+		return m.OldClientID(ctx)
+	case auditlog.FieldClientCommonName:
+		return m.OldClientCommonName(ctx)
+	case auditlog.FieldClientOrganization:
+		return m.OldClientOrganization(ctx)
+	case auditlog.FieldClientSerialNumber:
+		return m.OldClientSerialNumber(ctx)
+	case auditlog.FieldIsAuthenticated:
+		return m.OldIsAuthenticated(ctx)
+	case auditlog.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case auditlog.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case auditlog.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case auditlog.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case auditlog.FieldPeerAddress:
+		return m.OldPeerAddress(ctx)
+	case auditlog.FieldGeoLocation:
+		return m.OldGeoLocation(ctx)
+	case auditlog.FieldLogHash:
+		return m.OldLogHash(ctx)
+	case auditlog.FieldSignature:
+		return m.OldSignature(ctx)
+	case auditlog.FieldPrevID:
+		return m.OldPrevID(ctx)
+	case auditlog.FieldPrevHash:
+		return m.OldPrevHash(ctx)
+	case auditlog.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case auditlog.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case auditlog.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case auditlog.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case auditlog.FieldAuditID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuditID(v)
+		return nil
+	case auditlog.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditlog.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case auditlog.FieldServiceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceName(v)
+		return nil
+	case auditlog.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case auditlog.FieldClientCommonName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientCommonName(v)
+		return nil
+	case auditlog.FieldClientOrganization:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrganization(v)
+		return nil
+	case auditlog.FieldClientSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSerialNumber(v)
+		return nil
+	case auditlog.FieldIsAuthenticated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAuthenticated(v)
+		return nil
+	case auditlog.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case auditlog.FieldErrorCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case auditlog.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case auditlog.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case auditlog.FieldPeerAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerAddress(v)
+		return nil
+	case auditlog.FieldGeoLocation:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeoLocation(v)
+		return nil
+	case auditlog.FieldLogHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogHash(v)
+		return nil
+	case auditlog.FieldSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case auditlog.FieldPrevID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrevID(v)
+		return nil
+	case auditlog.FieldPrevHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrevHash(v)
+		return nil
+	case auditlog.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.adderror_code != nil {
+		fields = append(fields, auditlog.FieldErrorCode)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, auditlog.FieldLatencyMs)
+	}
+	if m.addprev_id != nil {
+		fields = append(fields, auditlog.FieldPrevID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldTenantID:
+		return m.AddedTenantID()
+	case auditlog.FieldErrorCode:
+		return m.AddedErrorCode()
+	case auditlog.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case auditlog.FieldPrevID:
+		return m.AddedPrevID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case auditlog.FieldErrorCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrorCode(v)
+		return nil
+	case auditlog.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	case auditlog.FieldPrevID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrevID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldCreateTime) {
+		fields = append(fields, auditlog.FieldCreateTime)
+	}
+	if m.FieldCleared(auditlog.FieldUpdateTime) {
+		fields = append(fields, auditlog.FieldUpdateTime)
+	}
+	if m.FieldCleared(auditlog.FieldDeleteTime) {
+		fields = append(fields, auditlog.FieldDeleteTime)
+	}
+	if m.FieldCleared(auditlog.FieldTenantID) {
+		fields = append(fields, auditlog.FieldTenantID)
+	}
+	if m.FieldCleared(auditlog.FieldRequestID) {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.FieldCleared(auditlog.FieldClientID) {
+		fields = append(fields, auditlog.FieldClientID)
+	}
+	if m.FieldCleared(auditlog.FieldClientCommonName) {
+		fields = append(fields, auditlog.FieldClientCommonName)
+	}
+	if m.FieldCleared(auditlog.FieldClientOrganization) {
+		fields = append(fields, auditlog.FieldClientOrganization)
+	}
+	if m.FieldCleared(auditlog.FieldClientSerialNumber) {
+		fields = append(fields, auditlog.FieldClientSerialNumber)
+	}
+	if m.FieldCleared(auditlog.FieldErrorCode) {
+		fields = append(fields, auditlog.FieldErrorCode)
+	}
+	if m.FieldCleared(auditlog.FieldErrorMessage) {
+		fields = append(fields, auditlog.FieldErrorMessage)
+	}
+	if m.FieldCleared(auditlog.FieldPeerAddress) {
+		fields = append(fields, auditlog.FieldPeerAddress)
+	}
+	if m.FieldCleared(auditlog.FieldGeoLocation) {
+		fields = append(fields, auditlog.FieldGeoLocation)
+	}
+	if m.FieldCleared(auditlog.FieldLogHash) {
+		fields = append(fields, auditlog.FieldLogHash)
+	}
+	if m.FieldCleared(auditlog.FieldSignature) {
+		fields = append(fields, auditlog.FieldSignature)
+	}
+	if m.FieldCleared(auditlog.FieldPrevID) {
+		fields = append(fields, auditlog.FieldPrevID)
+	}
+	if m.FieldCleared(auditlog.FieldPrevHash) {
+		fields = append(fields, auditlog.FieldPrevHash)
+	}
+	if m.FieldCleared(auditlog.FieldMetadata) {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case auditlog.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case auditlog.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case auditlog.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case auditlog.FieldClientID:
+		m.ClearClientID()
+		return nil
+	case auditlog.FieldClientCommonName:
+		m.ClearClientCommonName()
+		return nil
+	case auditlog.FieldClientOrganization:
+		m.ClearClientOrganization()
+		return nil
+	case auditlog.FieldClientSerialNumber:
+		m.ClearClientSerialNumber()
+		return nil
+	case auditlog.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case auditlog.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case auditlog.FieldPeerAddress:
+		m.ClearPeerAddress()
+		return nil
+	case auditlog.FieldGeoLocation:
+		m.ClearGeoLocation()
+		return nil
+	case auditlog.FieldLogHash:
+		m.ClearLogHash()
+		return nil
+	case auditlog.FieldSignature:
+		m.ClearSignature()
+		return nil
+	case auditlog.FieldPrevID:
+		m.ClearPrevID()
+		return nil
+	case auditlog.FieldPrevHash:
+		m.ClearPrevHash()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case auditlog.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case auditlog.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case auditlog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case auditlog.FieldAuditID:
+		m.ResetAuditID()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditlog.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case auditlog.FieldServiceName:
+		m.ResetServiceName()
+		return nil
+	case auditlog.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case auditlog.FieldClientCommonName:
+		m.ResetClientCommonName()
+		return nil
+	case auditlog.FieldClientOrganization:
+		m.ResetClientOrganization()
+		return nil
+	case auditlog.FieldClientSerialNumber:
+		m.ResetClientSerialNumber()
+		return nil
+	case auditlog.FieldIsAuthenticated:
+		m.ResetIsAuthenticated()
+		return nil
+	case auditlog.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case auditlog.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case auditlog.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case auditlog.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case auditlog.FieldPeerAddress:
+		m.ResetPeerAddress()
+		return nil
+	case auditlog.FieldGeoLocation:
+		m.ResetGeoLocation()
+		return nil
+	case auditlog.FieldLogHash:
+		m.ResetLogHash()
+		return nil
+	case auditlog.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case auditlog.FieldPrevID:
+		m.ResetPrevID()
+		return nil
+	case auditlog.FieldPrevHash:
+		m.ResetPrevHash()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// CertificateRevocationMutation represents an operation that mutates the CertificateRevocation nodes in the graph.
+type CertificateRevocationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint32
+	create_time    *time.Time
+	update_time    *time.Time
+	delete_time    *time.Time
+	tenant_id      *uint32
+	addtenant_id   *int32
+	certificate_id *string
+	serial_number  *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*CertificateRevocation, error)
+	predicates     []predicate.CertificateRevocation
+}
+
+var _ ent.Mutation = (*CertificateRevocationMutation)(nil)
+
+// certificaterevocationOption allows management of the mutation configuration using functional options.
+type certificaterevocationOption func(*CertificateRevocationMutation)
+
+// newCertificateRevocationMutation creates new mutation for the CertificateRevocation entity.
+func newCertificateRevocationMutation(c config, op Op, opts ...certificaterevocationOption) *CertificateRevocationMutation {
+	m := &CertificateRevocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertificateRevocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCertificateRevocationID sets the ID field of the mutation.
+func withCertificateRevocationID(id uint32) certificaterevocationOption {
+	return func(m *CertificateRevocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CertificateRevocation
+		)
+		m.oldValue = func(ctx context.Context) (*CertificateRevocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CertificateRevocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCertificateRevocation sets the old CertificateRevocation of the mutation.
+func withCertificateRevocation(node *CertificateRevocation) certificaterevocationOption {
+	return func(m *CertificateRevocationMutation) {
+		m.oldValue = func(context.Context) (*CertificateRevocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertificateRevocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertificateRevocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CertificateRevocation entities.
+func (m *CertificateRevocationMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertificateRevocationMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertificateRevocationMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CertificateRevocation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *CertificateRevocationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *CertificateRevocationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the CertificateRevocation entity.
+// If the CertificateRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateRevocationMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *CertificateRevocationMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[certificaterevocation.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *CertificateRevocationMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[certificaterevocation.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *CertificateRevocationMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, certificaterevocation.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *CertificateRevocationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *CertificateRevocationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the CertificateRevocation entity.
+// If the CertificateRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateRevocationMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *CertificateRevocationMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[certificaterevocation.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *CertificateRevocationMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[certificaterevocation.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *CertificateRevocationMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, certificaterevocation.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *CertificateRevocationMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *CertificateRevocationMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the CertificateRevocation entity.
+// If the CertificateRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateRevocationMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *CertificateRevocationMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[certificaterevocation.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *CertificateRevocationMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[certificaterevocation.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *CertificateRevocationMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, certificaterevocation.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CertificateRevocationMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CertificateRevocationMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the CertificateRevocation entity.
+// If the CertificateRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateRevocationMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *CertificateRevocationMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *CertificateRevocationMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *CertificateRevocationMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[certificaterevocation.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *CertificateRevocationMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[certificaterevocation.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CertificateRevocationMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, certificaterevocation.FieldTenantID)
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *CertificateRevocationMutation) SetCertificateID(s string) {
+	m.certificate_id = &s
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *CertificateRevocationMutation) CertificateID() (r string, exists bool) {
+	v := m.certificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the CertificateRevocation entity.
+// If the CertificateRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateRevocationMutation) OldCertificateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// ClearCertificateID clears the value of the "certificate_id" field.
+func (m *CertificateRevocationMutation) ClearCertificateID() {
+	m.certificate_id = nil
+	m.clearedFields[certificaterevocation.FieldCertificateID] = struct{}{}
+}
+
+// CertificateIDCleared returns if the "certificate_id" field was cleared in this mutation.
+func (m *CertificateRevocationMutation) CertificateIDCleared() bool {
+	_, ok := m.clearedFields[certificaterevocation.FieldCertificateID]
+	return ok
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *CertificateRevocationMutation) ResetCertificateID() {
+	m.certificate_id = nil
+	delete(m.clearedFields, certificaterevocation.FieldCertificateID)
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (m *CertificateRevocationMutation) SetSerialNumber(s string) {
+	m.serial_number = &s
+}
+
+// SerialNumber returns the value of the "serial_number" field in the mutation.
+func (m *CertificateRevocationMutation) SerialNumber() (r string, exists bool) {
+	v := m.serial_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSerialNumber returns the old "serial_number" field's value of the CertificateRevocation entity.
+// If the CertificateRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateRevocationMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+	}
+	return oldValue.SerialNumber, nil
+}
+
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (m *CertificateRevocationMutation) ClearSerialNumber() {
+	m.serial_number = nil
+	m.clearedFields[certificaterevocation.FieldSerialNumber] = struct{}{}
+}
+
+// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
+func (m *CertificateRevocationMutation) SerialNumberCleared() bool {
+	_, ok := m.clearedFields[certificaterevocation.FieldSerialNumber]
+	return ok
+}
+
+// ResetSerialNumber resets all changes to the "serial_number" field.
+func (m *CertificateRevocationMutation) ResetSerialNumber() {
+	m.serial_number = nil
+	delete(m.clearedFields, certificaterevocation.FieldSerialNumber)
+}
+
+// Where appends a list predicates to the CertificateRevocationMutation builder.
+func (m *CertificateRevocationMutation) Where(ps ...predicate.CertificateRevocation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertificateRevocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertificateRevocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertificateRevocation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertificateRevocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertificateRevocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CertificateRevocation).
+func (m *CertificateRevocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertificateRevocationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, certificaterevocation.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, certificaterevocation.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, certificaterevocation.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, certificaterevocation.FieldTenantID)
+	}
+	if m.certificate_id != nil {
+		fields = append(fields, certificaterevocation.FieldCertificateID)
+	}
+	if m.serial_number != nil {
+		fields = append(fields, certificaterevocation.FieldSerialNumber)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertificateRevocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certificaterevocation.FieldCreateTime:
+		return m.CreateTime()
+	case certificaterevocation.FieldUpdateTime:
+		return m.UpdateTime()
+	case certificaterevocation.FieldDeleteTime:
+		return m.DeleteTime()
+	case certificaterevocation.FieldTenantID:
+		return m.TenantID()
+	case certificaterevocation.FieldCertificateID:
+		return m.CertificateID()
+	case certificaterevocation.FieldSerialNumber:
+		return m.SerialNumber()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertificateRevocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certificaterevocation.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case certificaterevocation.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case certificaterevocation.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case certificaterevocation.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case certificaterevocation.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case certificaterevocation.FieldSerialNumber:
+		return m.OldSerialNumber(ctx)
+	}
+	return nil, fmt.Errorf("unknown CertificateRevocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateRevocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certificaterevocation.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case certificaterevocation.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case certificaterevocation.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case certificaterevocation.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case certificaterevocation.FieldCertificateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case certificaterevocation.FieldSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerialNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertificateRevocation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertificateRevocationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, certificaterevocation.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertificateRevocationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case certificaterevocation.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateRevocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case certificaterevocation.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertificateRevocation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertificateRevocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certificaterevocation.FieldCreateTime) {
+		fields = append(fields, certificaterevocation.FieldCreateTime)
+	}
+	if m.FieldCleared(certificaterevocation.FieldUpdateTime) {
+		fields = append(fields, certificaterevocation.FieldUpdateTime)
+	}
+	if m.FieldCleared(certificaterevocation.FieldDeleteTime) {
+		fields = append(fields, certificaterevocation.FieldDeleteTime)
+	}
+	if m.FieldCleared(certificaterevocation.FieldTenantID) {
+		fields = append(fields, certificaterevocation.FieldTenantID)
+	}
+	if m.FieldCleared(certificaterevocation.FieldCertificateID) {
+		fields = append(fields, certificaterevocation.FieldCertificateID)
+	}
+	if m.FieldCleared(certificaterevocation.FieldSerialNumber) {
+		fields = append(fields, certificaterevocation.FieldSerialNumber)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertificateRevocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertificateRevocationMutation) ClearField(name string) error {
+	switch name {
+	case certificaterevocation.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case certificaterevocation.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case certificaterevocation.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case certificaterevocation.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case certificaterevocation.FieldCertificateID:
+		m.ClearCertificateID()
+		return nil
+	case certificaterevocation.FieldSerialNumber:
+		m.ClearSerialNumber()
+		return nil
+	}
+	return fmt.Errorf("unknown CertificateRevocation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertificateRevocationMutation) ResetField(name string) error {
+	switch name {
+	case certificaterevocation.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case certificaterevocation.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case certificaterevocation.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case certificaterevocation.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case certificaterevocation.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case certificaterevocation.FieldSerialNumber:
+		m.ResetSerialNumber()
+		return nil
+	}
+	return fmt.Errorf("unknown CertificateRevocation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertificateRevocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertificateRevocationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertificateRevocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertificateRevocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertificateRevocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertificateRevocationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertificateRevocationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CertificateRevocation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertificateRevocationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CertificateRevocation edge %s", name)
+}
+
+// ChangeRecordMutation represents an operation that mutates the ChangeRecord nodes in the graph.
+type ChangeRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	tenant_id     *uint32
+	addtenant_id  *int32
+	resource_type *changerecord.ResourceType
+	resource_id   *string
+	resource_name *string
+	action        *changerecord.Action
+	actor_id      *uint32
+	addactor_id   *int32
+	actor_name    *string
+	changes       *[]schema.FieldChange
+	appendchanges []schema.FieldChange
+	reason        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ChangeRecord, error)
+	predicates    []predicate.ChangeRecord
+}
+
+var _ ent.Mutation = (*ChangeRecordMutation)(nil)
+
+// changerecordOption allows management of the mutation configuration using functional options.
+type changerecordOption func(*ChangeRecordMutation)
+
+// newChangeRecordMutation creates new mutation for the ChangeRecord entity.
+func newChangeRecordMutation(c config, op Op, opts ...changerecordOption) *ChangeRecordMutation {
+	m := &ChangeRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChangeRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChangeRecordID sets the ID field of the mutation.
+func withChangeRecordID(id uint32) changerecordOption {
+	return func(m *ChangeRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChangeRecord
+		)
+		m.oldValue = func(ctx context.Context) (*ChangeRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChangeRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChangeRecord sets the old ChangeRecord of the mutation.
+func withChangeRecord(node *ChangeRecord) changerecordOption {
+	return func(m *ChangeRecordMutation) {
+		m.oldValue = func(context.Context) (*ChangeRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChangeRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChangeRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChangeRecord entities.
+func (m *ChangeRecordMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChangeRecordMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChangeRecordMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChangeRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ChangeRecordMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ChangeRecordMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *ChangeRecordMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[changerecord.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *ChangeRecordMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ChangeRecordMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, changerecord.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ChangeRecordMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ChangeRecordMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *ChangeRecordMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[changerecord.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *ChangeRecordMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ChangeRecordMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, changerecord.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *ChangeRecordMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *ChangeRecordMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *ChangeRecordMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[changerecord.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *ChangeRecordMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *ChangeRecordMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, changerecord.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ChangeRecordMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ChangeRecordMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *ChangeRecordMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ChangeRecordMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ChangeRecordMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[changerecord.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ChangeRecordMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ChangeRecordMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, changerecord.FieldTenantID)
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *ChangeRecordMutation) SetResourceType(ct changerecord.ResourceType) {
+	m.resource_type = &ct
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *ChangeRecordMutation) ResourceType() (r changerecord.ResourceType, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldResourceType(ctx context.Context) (v changerecord.ResourceType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *ChangeRecordMutation) ResetResourceType() {
+	m.resource_type = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *ChangeRecordMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *ChangeRecordMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *ChangeRecordMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetResourceName sets the "resource_name" field.
+func (m *ChangeRecordMutation) SetResourceName(s string) {
+	m.resource_name = &s
+}
+
+// ResourceName returns the value of the "resource_name" field in the mutation.
+func (m *ChangeRecordMutation) ResourceName() (r string, exists bool) {
+	v := m.resource_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceName returns the old "resource_name" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldResourceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceName: %w", err)
+	}
+	return oldValue.ResourceName, nil
+}
+
+// ClearResourceName clears the value of the "resource_name" field.
+func (m *ChangeRecordMutation) ClearResourceName() {
+	m.resource_name = nil
+	m.clearedFields[changerecord.FieldResourceName] = struct{}{}
+}
+
+// ResourceNameCleared returns if the "resource_name" field was cleared in this mutation.
+func (m *ChangeRecordMutation) ResourceNameCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldResourceName]
+	return ok
+}
+
+// ResetResourceName resets all changes to the "resource_name" field.
+func (m *ChangeRecordMutation) ResetResourceName() {
+	m.resource_name = nil
+	delete(m.clearedFields, changerecord.FieldResourceName)
+}
+
+// SetAction sets the "action" field.
+func (m *ChangeRecordMutation) SetAction(c changerecord.Action) {
+	m.action = &c
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ChangeRecordMutation) Action() (r changerecord.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldAction(ctx context.Context) (v changerecord.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ChangeRecordMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *ChangeRecordMutation) SetActorID(u uint32) {
+	m.actor_id = &u
+	m.addactor_id = nil
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *ChangeRecordMutation) ActorID() (r uint32, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldActorID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// AddActorID adds u to the "actor_id" field.
+func (m *ChangeRecordMutation) AddActorID(u int32) {
+	if m.addactor_id != nil {
+		*m.addactor_id += u
+	} else {
+		m.addactor_id = &u
+	}
+}
+
+// AddedActorID returns the value that was added to the "actor_id" field in this mutation.
+func (m *ChangeRecordMutation) AddedActorID() (r int32, exists bool) {
+	v := m.addactor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *ChangeRecordMutation) ClearActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+	m.clearedFields[changerecord.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *ChangeRecordMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *ChangeRecordMutation) ResetActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+	delete(m.clearedFields, changerecord.FieldActorID)
+}
+
+// SetActorName sets the "actor_name" field.
+func (m *ChangeRecordMutation) SetActorName(s string) {
+	m.actor_name = &s
+}
+
+// ActorName returns the value of the "actor_name" field in the mutation.
+func (m *ChangeRecordMutation) ActorName() (r string, exists bool) {
+	v := m.actor_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorName returns the old "actor_name" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldActorName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorName: %w", err)
+	}
+	return oldValue.ActorName, nil
+}
+
+// ClearActorName clears the value of the "actor_name" field.
+func (m *ChangeRecordMutation) ClearActorName() {
+	m.actor_name = nil
+	m.clearedFields[changerecord.FieldActorName] = struct{}{}
+}
+
+// ActorNameCleared returns if the "actor_name" field was cleared in this mutation.
+func (m *ChangeRecordMutation) ActorNameCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldActorName]
+	return ok
+}
+
+// ResetActorName resets all changes to the "actor_name" field.
+func (m *ChangeRecordMutation) ResetActorName() {
+	m.actor_name = nil
+	delete(m.clearedFields, changerecord.FieldActorName)
+}
+
+// SetChanges sets the "changes" field.
+func (m *ChangeRecordMutation) SetChanges(sc []schema.FieldChange) {
+	m.changes = &sc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *ChangeRecordMutation) Changes() (r []schema.FieldChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldChanges(ctx context.Context) (v []schema.FieldChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds sc to the "changes" field.
+func (m *ChangeRecordMutation) AppendChanges(sc []schema.FieldChange) {
+	m.appendchanges = append(m.appendchanges, sc...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *ChangeRecordMutation) AppendedChanges() ([]schema.FieldChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *ChangeRecordMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[changerecord.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *ChangeRecordMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *ChangeRecordMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, changerecord.FieldChanges)
+}
+
+// SetReason sets the "reason" field.
+func (m *ChangeRecordMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ChangeRecordMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ChangeRecord entity.
+// If the ChangeRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChangeRecordMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ChangeRecordMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[changerecord.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ChangeRecordMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[changerecord.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ChangeRecordMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, changerecord.FieldReason)
+}
+
+// Where appends a list predicates to the ChangeRecordMutation builder.
+func (m *ChangeRecordMutation) Where(ps ...predicate.ChangeRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChangeRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChangeRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChangeRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChangeRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChangeRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChangeRecord).
+func (m *ChangeRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChangeRecordMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, changerecord.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, changerecord.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, changerecord.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, changerecord.FieldTenantID)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, changerecord.FieldResourceType)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, changerecord.FieldResourceID)
+	}
+	if m.resource_name != nil {
+		fields = append(fields, changerecord.FieldResourceName)
+	}
+	if m.action != nil {
+		fields = append(fields, changerecord.FieldAction)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, changerecord.FieldActorID)
+	}
+	if m.actor_name != nil {
+		fields = append(fields, changerecord.FieldActorName)
+	}
+	if m.changes != nil {
+		fields = append(fields, changerecord.FieldChanges)
+	}
+	if m.reason != nil {
+		fields = append(fields, changerecord.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChangeRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case changerecord.FieldCreateTime:
+		return m.CreateTime()
+	case changerecord.FieldUpdateTime:
+		return m.UpdateTime()
+	case changerecord.FieldDeleteTime:
+		return m.DeleteTime()
+	case changerecord.FieldTenantID:
+		return m.TenantID()
+	case changerecord.FieldResourceType:
+		return m.ResourceType()
+	case changerecord.FieldResourceID:
+		return m.ResourceID()
+	case changerecord.FieldResourceName:
+		return m.ResourceName()
+	case changerecord.FieldAction:
+		return m.Action()
+	case changerecord.FieldActorID:
+		return m.ActorID()
+	case changerecord.FieldActorName:
+		return m.ActorName()
+	case changerecord.FieldChanges:
+		return m.Changes()
+	case changerecord.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChangeRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case changerecord.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case changerecord.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case changerecord.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case changerecord.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case changerecord.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case changerecord.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case changerecord.FieldResourceName:
+		return m.OldResourceName(ctx)
+	case changerecord.FieldAction:
+		return m.OldAction(ctx)
+	case changerecord.FieldActorID:
+		return m.OldActorID(ctx)
+	case changerecord.FieldActorName:
+		return m.OldActorName(ctx)
+	case changerecord.FieldChanges:
+		return m.OldChanges(ctx)
+	case changerecord.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChangeRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChangeRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case changerecord.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case changerecord.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case changerecord.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case changerecord.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case changerecord.FieldResourceType:
+		v, ok := value.(changerecord.ResourceType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case changerecord.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case changerecord.FieldResourceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceName(v)
+		return nil
+	case changerecord.FieldAction:
+		v, ok := value.(changerecord.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case changerecord.FieldActorID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case changerecord.FieldActorName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorName(v)
+		return nil
+	case changerecord.FieldChanges:
+		v, ok := value.([]schema.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case changerecord.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChangeRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChangeRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, changerecord.FieldTenantID)
+	}
+	if m.addactor_id != nil {
+		fields = append(fields, changerecord.FieldActorID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChangeRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case changerecord.FieldTenantID:
+		return m.AddedTenantID()
+	case changerecord.FieldActorID:
+		return m.AddedActorID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChangeRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case changerecord.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case changerecord.FieldActorID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChangeRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChangeRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(changerecord.FieldCreateTime) {
+		fields = append(fields, changerecord.FieldCreateTime)
+	}
+	if m.FieldCleared(changerecord.FieldUpdateTime) {
+		fields = append(fields, changerecord.FieldUpdateTime)
+	}
+	if m.FieldCleared(changerecord.FieldDeleteTime) {
+		fields = append(fields, changerecord.FieldDeleteTime)
+	}
+	if m.FieldCleared(changerecord.FieldTenantID) {
+		fields = append(fields, changerecord.FieldTenantID)
+	}
+	if m.FieldCleared(changerecord.FieldResourceName) {
+		fields = append(fields, changerecord.FieldResourceName)
+	}
+	if m.FieldCleared(changerecord.FieldActorID) {
+		fields = append(fields, changerecord.FieldActorID)
+	}
+	if m.FieldCleared(changerecord.FieldActorName) {
+		fields = append(fields, changerecord.FieldActorName)
+	}
+	if m.FieldCleared(changerecord.FieldChanges) {
+		fields = append(fields, changerecord.FieldChanges)
+	}
+	if m.FieldCleared(changerecord.FieldReason) {
+		fields = append(fields, changerecord.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChangeRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChangeRecordMutation) ClearField(name string) error {
+	switch name {
+	case changerecord.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case changerecord.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case changerecord.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case changerecord.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case changerecord.FieldResourceName:
+		m.ClearResourceName()
+		return nil
+	case changerecord.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case changerecord.FieldActorName:
+		m.ClearActorName()
+		return nil
+	case changerecord.FieldChanges:
+		m.ClearChanges()
+		return nil
+	case changerecord.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ChangeRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChangeRecordMutation) ResetField(name string) error {
+	switch name {
+	case changerecord.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case changerecord.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case changerecord.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case changerecord.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case changerecord.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case changerecord.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case changerecord.FieldResourceName:
+		m.ResetResourceName()
+		return nil
+	case changerecord.FieldAction:
+		m.ResetAction()
+		return nil
+	case changerecord.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case changerecord.FieldActorName:
+		m.ResetActorName()
+		return nil
+	case changerecord.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case changerecord.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ChangeRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChangeRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChangeRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChangeRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChangeRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChangeRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChangeRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChangeRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChangeRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChangeRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChangeRecord edge %s", name)
+}
+
+// ConfigurationRevisionMutation represents an operation that mutates the ConfigurationRevision nodes in the graph.
+type ConfigurationRevisionMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint32
+	create_time             *time.Time
+	update_time             *time.Time
+	delete_time             *time.Time
+	tenant_id               *uint32
+	addtenant_id            *int32
+	target_configuration_id *string
+	revision                *uint32
+	addrevision             *int32
+	name                    *string
+	description             *string
+	provider_type           *string
+	credentials_encrypted   *[]byte
+	_config                 *map[string]interface{}
+	reverted_from           *uint32
+	addreverted_from        *int32
+	actor_id                *uint32
+	addactor_id             *int32
+	actor_name              *string
+	reason                  *string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*ConfigurationRevision, error)
+	predicates              []predicate.ConfigurationRevision
+}
+
+var _ ent.Mutation = (*ConfigurationRevisionMutation)(nil)
+
+// configurationrevisionOption allows management of the mutation configuration using functional options.
+type configurationrevisionOption func(*ConfigurationRevisionMutation)
+
+// newConfigurationRevisionMutation creates new mutation for the ConfigurationRevision entity.
+func newConfigurationRevisionMutation(c config, op Op, opts ...configurationrevisionOption) *ConfigurationRevisionMutation {
+	m := &ConfigurationRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigurationRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigurationRevisionID sets the ID field of the mutation.
+func withConfigurationRevisionID(id uint32) configurationrevisionOption {
+	return func(m *ConfigurationRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigurationRevision
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigurationRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigurationRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigurationRevision sets the old ConfigurationRevision of the mutation.
+func withConfigurationRevision(node *ConfigurationRevision) configurationrevisionOption {
+	return func(m *ConfigurationRevisionMutation) {
+		m.oldValue = func(context.Context) (*ConfigurationRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigurationRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigurationRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConfigurationRevision entities.
+func (m *ConfigurationRevisionMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigurationRevisionMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigurationRevisionMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigurationRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ConfigurationRevisionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ConfigurationRevisionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *ConfigurationRevisionMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[configurationrevision.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ConfigurationRevisionMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, configurationrevision.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ConfigurationRevisionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ConfigurationRevisionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *ConfigurationRevisionMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[configurationrevision.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ConfigurationRevisionMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, configurationrevision.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *ConfigurationRevisionMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *ConfigurationRevisionMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *ConfigurationRevisionMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[configurationrevision.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *ConfigurationRevisionMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, configurationrevision.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ConfigurationRevisionMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ConfigurationRevisionMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *ConfigurationRevisionMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ConfigurationRevisionMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ConfigurationRevisionMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[configurationrevision.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ConfigurationRevisionMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, configurationrevision.FieldTenantID)
+}
+
+// SetTargetConfigurationID sets the "target_configuration_id" field.
+func (m *ConfigurationRevisionMutation) SetTargetConfigurationID(s string) {
+	m.target_configuration_id = &s
+}
+
+// TargetConfigurationID returns the value of the "target_configuration_id" field in the mutation.
+func (m *ConfigurationRevisionMutation) TargetConfigurationID() (r string, exists bool) {
+	v := m.target_configuration_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetConfigurationID returns the old "target_configuration_id" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldTargetConfigurationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetConfigurationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetConfigurationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetConfigurationID: %w", err)
+	}
+	return oldValue.TargetConfigurationID, nil
+}
+
+// ResetTargetConfigurationID resets all changes to the "target_configuration_id" field.
+func (m *ConfigurationRevisionMutation) ResetTargetConfigurationID() {
+	m.target_configuration_id = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *ConfigurationRevisionMutation) SetRevision(u uint32) {
+	m.revision = &u
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *ConfigurationRevisionMutation) Revision() (r uint32, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldRevision(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds u to the "revision" field.
+func (m *ConfigurationRevisionMutation) AddRevision(u int32) {
+	if m.addrevision != nil {
+		*m.addrevision += u
+	} else {
+		m.addrevision = &u
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *ConfigurationRevisionMutation) AddedRevision() (r int32, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *ConfigurationRevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetName sets the "name" field.
+func (m *ConfigurationRevisionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ConfigurationRevisionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ConfigurationRevisionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ConfigurationRevisionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ConfigurationRevisionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ConfigurationRevisionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[configurationrevision.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ConfigurationRevisionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, configurationrevision.FieldDescription)
+}
+
+// SetProviderType sets the "provider_type" field.
+func (m *ConfigurationRevisionMutation) SetProviderType(s string) {
+	m.provider_type = &s
+}
+
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *ConfigurationRevisionMutation) ProviderType() (r string, exists bool) {
+	v := m.provider_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderType returns the old "provider_type" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldProviderType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+	}
+	return oldValue.ProviderType, nil
+}
+
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *ConfigurationRevisionMutation) ResetProviderType() {
+	m.provider_type = nil
+}
+
+// SetCredentialsEncrypted sets the "credentials_encrypted" field.
+func (m *ConfigurationRevisionMutation) SetCredentialsEncrypted(b []byte) {
+	m.credentials_encrypted = &b
+}
+
+// CredentialsEncrypted returns the value of the "credentials_encrypted" field in the mutation.
+func (m *ConfigurationRevisionMutation) CredentialsEncrypted() (r []byte, exists bool) {
+	v := m.credentials_encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialsEncrypted returns the old "credentials_encrypted" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldCredentialsEncrypted(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialsEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialsEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialsEncrypted: %w", err)
+	}
+	return oldValue.CredentialsEncrypted, nil
+}
+
+// ResetCredentialsEncrypted resets all changes to the "credentials_encrypted" field.
+func (m *ConfigurationRevisionMutation) ResetCredentialsEncrypted() {
+	m.credentials_encrypted = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *ConfigurationRevisionMutation) SetConfig(value map[string]interface{}) {
+	m._config = &value
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *ConfigurationRevisionMutation) Config() (r map[string]interface{}, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ClearConfig clears the value of the "config" field.
+func (m *ConfigurationRevisionMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[configurationrevision.FieldConfig] = struct{}{}
+}
+
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldConfig]
+	return ok
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *ConfigurationRevisionMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, configurationrevision.FieldConfig)
+}
+
+// SetRevertedFrom sets the "reverted_from" field.
+func (m *ConfigurationRevisionMutation) SetRevertedFrom(u uint32) {
+	m.reverted_from = &u
+	m.addreverted_from = nil
+}
+
+// RevertedFrom returns the value of the "reverted_from" field in the mutation.
+func (m *ConfigurationRevisionMutation) RevertedFrom() (r uint32, exists bool) {
+	v := m.reverted_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevertedFrom returns the old "reverted_from" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldRevertedFrom(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevertedFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevertedFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevertedFrom: %w", err)
+	}
+	return oldValue.RevertedFrom, nil
+}
+
+// AddRevertedFrom adds u to the "reverted_from" field.
+func (m *ConfigurationRevisionMutation) AddRevertedFrom(u int32) {
+	if m.addreverted_from != nil {
+		*m.addreverted_from += u
+	} else {
+		m.addreverted_from = &u
+	}
+}
+
+// AddedRevertedFrom returns the value that was added to the "reverted_from" field in this mutation.
+func (m *ConfigurationRevisionMutation) AddedRevertedFrom() (r int32, exists bool) {
+	v := m.addreverted_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRevertedFrom clears the value of the "reverted_from" field.
+func (m *ConfigurationRevisionMutation) ClearRevertedFrom() {
+	m.reverted_from = nil
+	m.addreverted_from = nil
+	m.clearedFields[configurationrevision.FieldRevertedFrom] = struct{}{}
+}
+
+// RevertedFromCleared returns if the "reverted_from" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) RevertedFromCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldRevertedFrom]
+	return ok
+}
+
+// ResetRevertedFrom resets all changes to the "reverted_from" field.
+func (m *ConfigurationRevisionMutation) ResetRevertedFrom() {
+	m.reverted_from = nil
+	m.addreverted_from = nil
+	delete(m.clearedFields, configurationrevision.FieldRevertedFrom)
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *ConfigurationRevisionMutation) SetActorID(u uint32) {
+	m.actor_id = &u
+	m.addactor_id = nil
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *ConfigurationRevisionMutation) ActorID() (r uint32, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldActorID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// AddActorID adds u to the "actor_id" field.
+func (m *ConfigurationRevisionMutation) AddActorID(u int32) {
+	if m.addactor_id != nil {
+		*m.addactor_id += u
+	} else {
+		m.addactor_id = &u
+	}
+}
+
+// AddedActorID returns the value that was added to the "actor_id" field in this mutation.
+func (m *ConfigurationRevisionMutation) AddedActorID() (r int32, exists bool) {
+	v := m.addactor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *ConfigurationRevisionMutation) ClearActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+	m.clearedFields[configurationrevision.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *ConfigurationRevisionMutation) ResetActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+	delete(m.clearedFields, configurationrevision.FieldActorID)
+}
+
+// SetActorName sets the "actor_name" field.
+func (m *ConfigurationRevisionMutation) SetActorName(s string) {
+	m.actor_name = &s
+}
+
+// ActorName returns the value of the "actor_name" field in the mutation.
+func (m *ConfigurationRevisionMutation) ActorName() (r string, exists bool) {
+	v := m.actor_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorName returns the old "actor_name" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldActorName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorName: %w", err)
+	}
+	return oldValue.ActorName, nil
+}
+
+// ClearActorName clears the value of the "actor_name" field.
+func (m *ConfigurationRevisionMutation) ClearActorName() {
+	m.actor_name = nil
+	m.clearedFields[configurationrevision.FieldActorName] = struct{}{}
+}
+
+// ActorNameCleared returns if the "actor_name" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) ActorNameCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldActorName]
+	return ok
+}
+
+// ResetActorName resets all changes to the "actor_name" field.
+func (m *ConfigurationRevisionMutation) ResetActorName() {
+	m.actor_name = nil
+	delete(m.clearedFields, configurationrevision.FieldActorName)
+}
+
+// SetReason sets the "reason" field.
+func (m *ConfigurationRevisionMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ConfigurationRevisionMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ConfigurationRevision entity.
+// If the ConfigurationRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigurationRevisionMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ConfigurationRevisionMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[configurationrevision.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[configurationrevision.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ConfigurationRevisionMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, configurationrevision.FieldReason)
+}
+
+// Where appends a list predicates to the ConfigurationRevisionMutation builder.
+func (m *ConfigurationRevisionMutation) Where(ps ...predicate.ConfigurationRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigurationRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigurationRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigurationRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigurationRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigurationRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigurationRevision).
+func (m *ConfigurationRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigurationRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, configurationrevision.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, configurationrevision.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, configurationrevision.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, configurationrevision.FieldTenantID)
+	}
+	if m.target_configuration_id != nil {
+		fields = append(fields, configurationrevision.FieldTargetConfigurationID)
+	}
+	if m.revision != nil {
+		fields = append(fields, configurationrevision.FieldRevision)
+	}
+	if m.name != nil {
+		fields = append(fields, configurationrevision.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, configurationrevision.FieldDescription)
+	}
+	if m.provider_type != nil {
+		fields = append(fields, configurationrevision.FieldProviderType)
+	}
+	if m.credentials_encrypted != nil {
+		fields = append(fields, configurationrevision.FieldCredentialsEncrypted)
+	}
+	if m._config != nil {
+		fields = append(fields, configurationrevision.FieldConfig)
+	}
+	if m.reverted_from != nil {
+		fields = append(fields, configurationrevision.FieldRevertedFrom)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, configurationrevision.FieldActorID)
+	}
+	if m.actor_name != nil {
+		fields = append(fields, configurationrevision.FieldActorName)
+	}
+	if m.reason != nil {
+		fields = append(fields, configurationrevision.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigurationRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configurationrevision.FieldCreateTime:
+		return m.CreateTime()
+	case configurationrevision.FieldUpdateTime:
+		return m.UpdateTime()
+	case configurationrevision.FieldDeleteTime:
+		return m.DeleteTime()
+	case configurationrevision.FieldTenantID:
+		return m.TenantID()
+	case configurationrevision.FieldTargetConfigurationID:
+		return m.TargetConfigurationID()
+	case configurationrevision.FieldRevision:
+		return m.Revision()
+	case configurationrevision.FieldName:
+		return m.Name()
+	case configurationrevision.FieldDescription:
+		return m.Description()
+	case configurationrevision.FieldProviderType:
+		return m.ProviderType()
+	case configurationrevision.FieldCredentialsEncrypted:
+		return m.CredentialsEncrypted()
+	case configurationrevision.FieldConfig:
+		return m.Config()
+	case configurationrevision.FieldRevertedFrom:
+		return m.RevertedFrom()
+	case configurationrevision.FieldActorID:
+		return m.ActorID()
+	case configurationrevision.FieldActorName:
+		return m.ActorName()
+	case configurationrevision.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigurationRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configurationrevision.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case configurationrevision.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case configurationrevision.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case configurationrevision.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case configurationrevision.FieldTargetConfigurationID:
+		return m.OldTargetConfigurationID(ctx)
+	case configurationrevision.FieldRevision:
+		return m.OldRevision(ctx)
+	case configurationrevision.FieldName:
+		return m.OldName(ctx)
+	case configurationrevision.FieldDescription:
+		return m.OldDescription(ctx)
+	case configurationrevision.FieldProviderType:
+		return m.OldProviderType(ctx)
+	case configurationrevision.FieldCredentialsEncrypted:
+		return m.OldCredentialsEncrypted(ctx)
+	case configurationrevision.FieldConfig:
+		return m.OldConfig(ctx)
+	case configurationrevision.FieldRevertedFrom:
+		return m.OldRevertedFrom(ctx)
+	case configurationrevision.FieldActorID:
+		return m.OldActorID(ctx)
+	case configurationrevision.FieldActorName:
+		return m.OldActorName(ctx)
+	case configurationrevision.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigurationRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigurationRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configurationrevision.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case configurationrevision.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case configurationrevision.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case configurationrevision.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case configurationrevision.FieldTargetConfigurationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetConfigurationID(v)
+		return nil
+	case configurationrevision.FieldRevision:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case configurationrevision.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case configurationrevision.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case configurationrevision.FieldProviderType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderType(v)
+		return nil
+	case configurationrevision.FieldCredentialsEncrypted:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialsEncrypted(v)
+		return nil
+	case configurationrevision.FieldConfig:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case configurationrevision.FieldRevertedFrom:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevertedFrom(v)
+		return nil
+	case configurationrevision.FieldActorID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case configurationrevision.FieldActorName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorName(v)
+		return nil
+	case configurationrevision.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigurationRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigurationRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, configurationrevision.FieldTenantID)
+	}
+	if m.addrevision != nil {
+		fields = append(fields, configurationrevision.FieldRevision)
+	}
+	if m.addreverted_from != nil {
+		fields = append(fields, configurationrevision.FieldRevertedFrom)
+	}
+	if m.addactor_id != nil {
+		fields = append(fields, configurationrevision.FieldActorID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigurationRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configurationrevision.FieldTenantID:
+		return m.AddedTenantID()
+	case configurationrevision.FieldRevision:
+		return m.AddedRevision()
+	case configurationrevision.FieldRevertedFrom:
+		return m.AddedRevertedFrom()
+	case configurationrevision.FieldActorID:
+		return m.AddedActorID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigurationRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case configurationrevision.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case configurationrevision.FieldRevision:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case configurationrevision.FieldRevertedFrom:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevertedFrom(v)
+		return nil
+	case configurationrevision.FieldActorID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigurationRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigurationRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(configurationrevision.FieldCreateTime) {
+		fields = append(fields, configurationrevision.FieldCreateTime)
+	}
+	if m.FieldCleared(configurationrevision.FieldUpdateTime) {
+		fields = append(fields, configurationrevision.FieldUpdateTime)
+	}
+	if m.FieldCleared(configurationrevision.FieldDeleteTime) {
+		fields = append(fields, configurationrevision.FieldDeleteTime)
+	}
+	if m.FieldCleared(configurationrevision.FieldTenantID) {
+		fields = append(fields, configurationrevision.FieldTenantID)
+	}
+	if m.FieldCleared(configurationrevision.FieldDescription) {
+		fields = append(fields, configurationrevision.FieldDescription)
+	}
+	if m.FieldCleared(configurationrevision.FieldConfig) {
+		fields = append(fields, configurationrevision.FieldConfig)
+	}
+	if m.FieldCleared(configurationrevision.FieldRevertedFrom) {
+		fields = append(fields, configurationrevision.FieldRevertedFrom)
+	}
+	if m.FieldCleared(configurationrevision.FieldActorID) {
+		fields = append(fields, configurationrevision.FieldActorID)
+	}
+	if m.FieldCleared(configurationrevision.FieldActorName) {
+		fields = append(fields, configurationrevision.FieldActorName)
+	}
+	if m.FieldCleared(configurationrevision.FieldReason) {
+		fields = append(fields, configurationrevision.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigurationRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigurationRevisionMutation) ClearField(name string) error {
+	switch name {
+	case configurationrevision.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case configurationrevision.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case configurationrevision.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case configurationrevision.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case configurationrevision.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case configurationrevision.FieldConfig:
+		m.ClearConfig()
+		return nil
+	case configurationrevision.FieldRevertedFrom:
+		m.ClearRevertedFrom()
+		return nil
+	case configurationrevision.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case configurationrevision.FieldActorName:
+		m.ClearActorName()
+		return nil
+	case configurationrevision.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigurationRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigurationRevisionMutation) ResetField(name string) error {
+	switch name {
+	case configurationrevision.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case configurationrevision.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case configurationrevision.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case configurationrevision.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case configurationrevision.FieldTargetConfigurationID:
+		m.ResetTargetConfigurationID()
+		return nil
+	case configurationrevision.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case configurationrevision.FieldName:
+		m.ResetName()
+		return nil
+	case configurationrevision.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case configurationrevision.FieldProviderType:
+		m.ResetProviderType()
+		return nil
+	case configurationrevision.FieldCredentialsEncrypted:
+		m.ResetCredentialsEncrypted()
+		return nil
+	case configurationrevision.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case configurationrevision.FieldRevertedFrom:
+		m.ResetRevertedFrom()
+		return nil
+	case configurationrevision.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case configurationrevision.FieldActorName:
+		m.ResetActorName()
+		return nil
+	case configurationrevision.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigurationRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigurationRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigurationRevisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigurationRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigurationRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigurationRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigurationRevisionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigurationRevisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigurationRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigurationRevisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigurationRevision edge %s", name)
+}
+
+// DeployLockMutation represents an operation that mutates the DeployLock nodes in the graph.
+type DeployLockMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_time   *time.Time
+	update_time   *time.Time
+	delete_time   *time.Time
+	holder        *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeployLock, error)
+	predicates    []predicate.DeployLock
+}
+
+var _ ent.Mutation = (*DeployLockMutation)(nil)
+
+// deploylockOption allows management of the mutation configuration using functional options.
+type deploylockOption func(*DeployLockMutation)
+
+// newDeployLockMutation creates new mutation for the DeployLock entity.
+func newDeployLockMutation(c config, op Op, opts ...deploylockOption) *DeployLockMutation {
+	m := &DeployLockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeployLock,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeployLockID sets the ID field of the mutation.
+func withDeployLockID(id string) deploylockOption {
+	return func(m *DeployLockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeployLock
+		)
+		m.oldValue = func(ctx context.Context) (*DeployLock, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeployLock.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeployLock sets the old DeployLock of the mutation.
+func withDeployLock(node *DeployLock) deploylockOption {
+	return func(m *DeployLockMutation) {
+		m.oldValue = func(context.Context) (*DeployLock, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeployLockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeployLockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeployLock entities.
+func (m *DeployLockMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeployLockMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeployLockMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeployLock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeployLockMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeployLockMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeployLock entity.
+// If the DeployLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeployLockMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *DeployLockMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[deploylock.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *DeployLockMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[deploylock.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeployLockMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, deploylock.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DeployLockMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeployLockMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DeployLock entity.
+// If the DeployLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeployLockMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *DeployLockMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[deploylock.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *DeployLockMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[deploylock.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeployLockMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, deploylock.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DeployLockMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DeployLockMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the DeployLock entity.
+// If the DeployLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeployLockMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DeployLockMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[deploylock.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DeployLockMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[deploylock.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DeployLockMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, deploylock.FieldDeleteTime)
+}
+
+// SetHolder sets the "holder" field.
+func (m *DeployLockMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *DeployLockMutation) Holder() (r string, exists bool) {
+	v := m.holder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolder returns the old "holder" field's value of the DeployLock entity.
+// If the DeployLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeployLockMutation) OldHolder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
+	}
+	return oldValue.Holder, nil
+}
+
+// ResetHolder resets all changes to the "holder" field.
+func (m *DeployLockMutation) ResetHolder() {
+	m.holder = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *DeployLockMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *DeployLockMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the DeployLock entity.
+// If the DeployLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeployLockMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *DeployLockMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the DeployLockMutation builder.
+func (m *DeployLockMutation) Where(ps ...predicate.DeployLock) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeployLockMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeployLockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeployLock, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeployLockMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeployLockMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeployLock).
+func (m *DeployLockMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeployLockMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, deploylock.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deploylock.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, deploylock.FieldDeleteTime)
+	}
+	if m.holder != nil {
+		fields = append(fields, deploylock.FieldHolder)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, deploylock.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeployLockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploylock.FieldCreateTime:
+		return m.CreateTime()
+	case deploylock.FieldUpdateTime:
+		return m.UpdateTime()
+	case deploylock.FieldDeleteTime:
+		return m.DeleteTime()
+	case deploylock.FieldHolder:
+		return m.Holder()
+	case deploylock.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeployLockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploylock.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deploylock.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case deploylock.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case deploylock.FieldHolder:
+		return m.OldHolder(ctx)
+	case deploylock.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeployLock field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeployLockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploylock.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deploylock.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case deploylock.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case deploylock.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case deploylock.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeployLock field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeployLockMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeployLockMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeployLockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeployLock numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeployLockMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploylock.FieldCreateTime) {
+		fields = append(fields, deploylock.FieldCreateTime)
+	}
+	if m.FieldCleared(deploylock.FieldUpdateTime) {
+		fields = append(fields, deploylock.FieldUpdateTime)
+	}
+	if m.FieldCleared(deploylock.FieldDeleteTime) {
+		fields = append(fields, deploylock.FieldDeleteTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeployLockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeployLockMutation) ClearField(name string) error {
+	switch name {
+	case deploylock.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case deploylock.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case deploylock.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DeployLock nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeployLockMutation) ResetField(name string) error {
+	switch name {
+	case deploylock.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deploylock.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case deploylock.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case deploylock.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case deploylock.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeployLock field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeployLockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeployLockMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeployLockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeployLockMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeployLockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeployLockMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeployLockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeployLock unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeployLockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeployLock edge %s", name)
+}
+
+// DeploymentHistoryMutation represents an operation that mutates the DeploymentHistory nodes in the graph.
+type DeploymentHistoryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint32
+	create_time    *time.Time
+	update_time    *time.Time
+	delete_time    *time.Time
+	action         *deploymenthistory.Action
+	result         *deploymenthistory.Result
+	message        *string
+	duration_ms    *int64
+	addduration_ms *int64
+	details        *map[string]interface{}
+	clearedFields  map[string]struct{}
+	job            *string
+	clearedjob     bool
+	done           bool
+	oldValue       func(context.Context) (*DeploymentHistory, error)
+	predicates     []predicate.DeploymentHistory
+}
+
+var _ ent.Mutation = (*DeploymentHistoryMutation)(nil)
+
+// deploymenthistoryOption allows management of the mutation configuration using functional options.
+type deploymenthistoryOption func(*DeploymentHistoryMutation)
+
+// newDeploymentHistoryMutation creates new mutation for the DeploymentHistory entity.
+func newDeploymentHistoryMutation(c config, op Op, opts ...deploymenthistoryOption) *DeploymentHistoryMutation {
+	m := &DeploymentHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentHistoryID sets the ID field of the mutation.
+func withDeploymentHistoryID(id uint32) deploymenthistoryOption {
+	return func(m *DeploymentHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentHistory
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentHistory sets the old DeploymentHistory of the mutation.
+func withDeploymentHistory(node *DeploymentHistory) deploymenthistoryOption {
+	return func(m *DeploymentHistoryMutation) {
+		m.oldValue = func(context.Context) (*DeploymentHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentHistory entities.
+func (m *DeploymentHistoryMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentHistoryMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentHistoryMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeploymentHistoryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeploymentHistoryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *DeploymentHistoryMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[deploymenthistory.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *DeploymentHistoryMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymenthistory.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeploymentHistoryMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, deploymenthistory.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DeploymentHistoryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeploymentHistoryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *DeploymentHistoryMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[deploymenthistory.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *DeploymentHistoryMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymenthistory.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeploymentHistoryMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, deploymenthistory.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DeploymentHistoryMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DeploymentHistoryMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DeploymentHistoryMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[deploymenthistory.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DeploymentHistoryMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[deploymenthistory.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DeploymentHistoryMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, deploymenthistory.FieldDeleteTime)
+}
+
+// SetJobID sets the "job_id" field.
+func (m *DeploymentHistoryMutation) SetJobID(s string) {
+	m.job = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *DeploymentHistoryMutation) JobID() (r string, exists bool) {
+	v := m.job
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *DeploymentHistoryMutation) ResetJobID() {
+	m.job = nil
+}
+
+// SetAction sets the "action" field.
+func (m *DeploymentHistoryMutation) SetAction(d deploymenthistory.Action) {
+	m.action = &d
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *DeploymentHistoryMutation) Action() (r deploymenthistory.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldAction(ctx context.Context) (v deploymenthistory.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *DeploymentHistoryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetResult sets the "result" field.
+func (m *DeploymentHistoryMutation) SetResult(d deploymenthistory.Result) {
+	m.result = &d
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *DeploymentHistoryMutation) Result() (r deploymenthistory.Result, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldResult(ctx context.Context) (v deploymenthistory.Result, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *DeploymentHistoryMutation) ResetResult() {
+	m.result = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *DeploymentHistoryMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *DeploymentHistoryMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *DeploymentHistoryMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[deploymenthistory.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *DeploymentHistoryMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[deploymenthistory.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *DeploymentHistoryMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, deploymenthistory.FieldMessage)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *DeploymentHistoryMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *DeploymentHistoryMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *DeploymentHistoryMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *DeploymentHistoryMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *DeploymentHistoryMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetDetails sets the "details" field.
+func (m *DeploymentHistoryMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *DeploymentHistoryMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the DeploymentHistory entity.
+// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentHistoryMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *DeploymentHistoryMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[deploymenthistory.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *DeploymentHistoryMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[deploymenthistory.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *DeploymentHistoryMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, deploymenthistory.FieldDetails)
+}
+
+// ClearJob clears the "job" edge to the DeploymentJob entity.
+func (m *DeploymentHistoryMutation) ClearJob() {
+	m.clearedjob = true
+	m.clearedFields[deploymenthistory.FieldJobID] = struct{}{}
+}
+
+// JobCleared reports if the "job" edge to the DeploymentJob entity was cleared.
+func (m *DeploymentHistoryMutation) JobCleared() bool {
+	return m.clearedjob
+}
+
+// JobIDs returns the "job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JobID instead. It exists only for internal usage by the builders.
+func (m *DeploymentHistoryMutation) JobIDs() (ids []string) {
+	if id := m.job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJob resets all changes to the "job" edge.
+func (m *DeploymentHistoryMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
+}
+
+// Where appends a list predicates to the DeploymentHistoryMutation builder.
+func (m *DeploymentHistoryMutation) Where(ps ...predicate.DeploymentHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentHistory).
+func (m *DeploymentHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, deploymenthistory.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deploymenthistory.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, deploymenthistory.FieldDeleteTime)
+	}
+	if m.job != nil {
+		fields = append(fields, deploymenthistory.FieldJobID)
+	}
+	if m.action != nil {
+		fields = append(fields, deploymenthistory.FieldAction)
+	}
+	if m.result != nil {
+		fields = append(fields, deploymenthistory.FieldResult)
+	}
+	if m.message != nil {
+		fields = append(fields, deploymenthistory.FieldMessage)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, deploymenthistory.FieldDurationMs)
+	}
+	if m.details != nil {
+		fields = append(fields, deploymenthistory.FieldDetails)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymenthistory.FieldCreateTime:
+		return m.CreateTime()
+	case deploymenthistory.FieldUpdateTime:
+		return m.UpdateTime()
+	case deploymenthistory.FieldDeleteTime:
+		return m.DeleteTime()
+	case deploymenthistory.FieldJobID:
+		return m.JobID()
+	case deploymenthistory.FieldAction:
+		return m.Action()
+	case deploymenthistory.FieldResult:
+		return m.Result()
+	case deploymenthistory.FieldMessage:
+		return m.Message()
+	case deploymenthistory.FieldDurationMs:
+		return m.DurationMs()
+	case deploymenthistory.FieldDetails:
+		return m.Details()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymenthistory.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deploymenthistory.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case deploymenthistory.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case deploymenthistory.FieldJobID:
+		return m.OldJobID(ctx)
+	case deploymenthistory.FieldAction:
+		return m.OldAction(ctx)
+	case deploymenthistory.FieldResult:
+		return m.OldResult(ctx)
+	case deploymenthistory.FieldMessage:
+		return m.OldMessage(ctx)
+	case deploymenthistory.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case deploymenthistory.FieldDetails:
+		return m.OldDetails(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymenthistory.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deploymenthistory.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case deploymenthistory.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case deploymenthistory.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case deploymenthistory.FieldAction:
+		v, ok := value.(deploymenthistory.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case deploymenthistory.FieldResult:
+		v, ok := value.(deploymenthistory.Result)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case deploymenthistory.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case deploymenthistory.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case deploymenthistory.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, deploymenthistory.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymenthistory.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymenthistory.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymenthistory.FieldCreateTime) {
+		fields = append(fields, deploymenthistory.FieldCreateTime)
+	}
+	if m.FieldCleared(deploymenthistory.FieldUpdateTime) {
+		fields = append(fields, deploymenthistory.FieldUpdateTime)
+	}
+	if m.FieldCleared(deploymenthistory.FieldDeleteTime) {
+		fields = append(fields, deploymenthistory.FieldDeleteTime)
+	}
+	if m.FieldCleared(deploymenthistory.FieldMessage) {
+		fields = append(fields, deploymenthistory.FieldMessage)
+	}
+	if m.FieldCleared(deploymenthistory.FieldDetails) {
+		fields = append(fields, deploymenthistory.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentHistoryMutation) ClearField(name string) error {
+	switch name {
+	case deploymenthistory.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case deploymenthistory.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case deploymenthistory.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case deploymenthistory.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case deploymenthistory.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentHistoryMutation) ResetField(name string) error {
+	switch name {
+	case deploymenthistory.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deploymenthistory.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case deploymenthistory.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case deploymenthistory.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case deploymenthistory.FieldAction:
+		m.ResetAction()
+		return nil
+	case deploymenthistory.FieldResult:
+		m.ResetResult()
+		return nil
+	case deploymenthistory.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case deploymenthistory.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case deploymenthistory.FieldDetails:
+		m.ResetDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job != nil {
+		edges = append(edges, deploymenthistory.EdgeJob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymenthistory.EdgeJob:
+		if id := m.job; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob {
+		edges = append(edges, deploymenthistory.EdgeJob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymenthistory.EdgeJob:
+		return m.clearedjob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymenthistory.EdgeJob:
+		m.ClearJob()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymenthistory.EdgeJob:
+		m.ResetJob()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentHistory edge %s", name)
+}
+
+// DeploymentJobMutation represents an operation that mutates the DeploymentJob nodes in the graph.
+type DeploymentJobMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *string
+	create_by                    *uint32
+	addcreate_by                 *int32
+	create_time                  *time.Time
+	update_time                  *time.Time
+	delete_time                  *time.Time
+	tenant_id                    *uint32
+	addtenant_id                 *int32
+	certificate_id               *string
+	certificate_serial           *string
+	certificate_common_name      *string
+	certificate_lineage          *string
+	idempotency_key              *string
+	superseded_by_job_id         *string
+	status                       *deploymentjob.Status
+	status_message               *string
+	progress                     *int32
+	addprogress                  *int32
+	retry_count                  *int32
+	addretry_count               *int32
+	max_retries                  *int32
+	addmax_retries               *int32
+	retry_policy                 **registry.RetryPolicy
+	triggered_by                 *deploymentjob.TriggeredBy
+	action                       *deploymentjob.Action
+	result                       *map[string]interface{}
+	started_at                   *time.Time
+	completed_at                 *time.Time
+	next_retry_at                *time.Time
+	configuration_revision_id    *uint32
+	addconfiguration_revision_id *int32
+	error_category               *deploymentjob.ErrorCategory
+	clearedFields                map[string]struct{}
+	deployment_target            *string
+	cleareddeployment_target     bool
+	target_configuration         *string
+	clearedtarget_configuration  bool
+	parent_job                   *string
+	clearedparent_job            bool
+	child_jobs                   map[string]struct{}
+	removedchild_jobs            map[string]struct{}
+	clearedchild_jobs            bool
+	history                      map[uint32]struct{}
+	removedhistory               map[uint32]struct{}
+	clearedhistory               bool
+	done                         bool
+	oldValue                     func(context.Context) (*DeploymentJob, error)
+	predicates                   []predicate.DeploymentJob
+}
+
+var _ ent.Mutation = (*DeploymentJobMutation)(nil)
+
+// deploymentjobOption allows management of the mutation configuration using functional options.
+type deploymentjobOption func(*DeploymentJobMutation)
+
+// newDeploymentJobMutation creates new mutation for the DeploymentJob entity.
+func newDeploymentJobMutation(c config, op Op, opts ...deploymentjobOption) *DeploymentJobMutation {
+	m := &DeploymentJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentJobID sets the ID field of the mutation.
+func withDeploymentJobID(id string) deploymentjobOption {
+	return func(m *DeploymentJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentJob
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentJob sets the old DeploymentJob of the mutation.
+func withDeploymentJob(node *DeploymentJob) deploymentjobOption {
+	return func(m *DeploymentJobMutation) {
+		m.oldValue = func(context.Context) (*DeploymentJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentJob entities.
+func (m *DeploymentJobMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentJobMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentJobMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *DeploymentJobMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *DeploymentJobMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *DeploymentJobMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *DeploymentJobMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *DeploymentJobMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[deploymentjob.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *DeploymentJobMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *DeploymentJobMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, deploymentjob.FieldCreateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeploymentJobMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeploymentJobMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *DeploymentJobMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[deploymentjob.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *DeploymentJobMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeploymentJobMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, deploymentjob.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DeploymentJobMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeploymentJobMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *DeploymentJobMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[deploymentjob.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *DeploymentJobMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeploymentJobMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, deploymentjob.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DeploymentJobMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DeploymentJobMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DeploymentJobMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[deploymentjob.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DeploymentJobMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DeploymentJobMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, deploymentjob.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DeploymentJobMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DeploymentJobMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *DeploymentJobMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DeploymentJobMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *DeploymentJobMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[deploymentjob.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *DeploymentJobMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DeploymentJobMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, deploymentjob.FieldTenantID)
+}
+
+// SetDeploymentTargetID sets the "deployment_target_id" field.
+func (m *DeploymentJobMutation) SetDeploymentTargetID(s string) {
+	m.deployment_target = &s
+}
+
+// DeploymentTargetID returns the value of the "deployment_target_id" field in the mutation.
+func (m *DeploymentJobMutation) DeploymentTargetID() (r string, exists bool) {
+	v := m.deployment_target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeploymentTargetID returns the old "deployment_target_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldDeploymentTargetID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeploymentTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeploymentTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeploymentTargetID: %w", err)
+	}
+	return oldValue.DeploymentTargetID, nil
+}
+
+// ClearDeploymentTargetID clears the value of the "deployment_target_id" field.
+func (m *DeploymentJobMutation) ClearDeploymentTargetID() {
+	m.deployment_target = nil
+	m.clearedFields[deploymentjob.FieldDeploymentTargetID] = struct{}{}
+}
+
+// DeploymentTargetIDCleared returns if the "deployment_target_id" field was cleared in this mutation.
+func (m *DeploymentJobMutation) DeploymentTargetIDCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldDeploymentTargetID]
+	return ok
+}
+
+// ResetDeploymentTargetID resets all changes to the "deployment_target_id" field.
+func (m *DeploymentJobMutation) ResetDeploymentTargetID() {
+	m.deployment_target = nil
+	delete(m.clearedFields, deploymentjob.FieldDeploymentTargetID)
+}
+
+// SetTargetConfigurationID sets the "target_configuration_id" field.
+func (m *DeploymentJobMutation) SetTargetConfigurationID(s string) {
+	m.target_configuration = &s
+}
+
+// TargetConfigurationID returns the value of the "target_configuration_id" field in the mutation.
+func (m *DeploymentJobMutation) TargetConfigurationID() (r string, exists bool) {
+	v := m.target_configuration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetConfigurationID returns the old "target_configuration_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldTargetConfigurationID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetConfigurationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetConfigurationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetConfigurationID: %w", err)
+	}
+	return oldValue.TargetConfigurationID, nil
+}
+
+// ClearTargetConfigurationID clears the value of the "target_configuration_id" field.
+func (m *DeploymentJobMutation) ClearTargetConfigurationID() {
+	m.target_configuration = nil
+	m.clearedFields[deploymentjob.FieldTargetConfigurationID] = struct{}{}
+}
+
+// TargetConfigurationIDCleared returns if the "target_configuration_id" field was cleared in this mutation.
+func (m *DeploymentJobMutation) TargetConfigurationIDCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldTargetConfigurationID]
+	return ok
+}
+
+// ResetTargetConfigurationID resets all changes to the "target_configuration_id" field.
+func (m *DeploymentJobMutation) ResetTargetConfigurationID() {
+	m.target_configuration = nil
+	delete(m.clearedFields, deploymentjob.FieldTargetConfigurationID)
+}
+
+// SetParentJobID sets the "parent_job_id" field.
+func (m *DeploymentJobMutation) SetParentJobID(s string) {
+	m.parent_job = &s
+}
+
+// ParentJobID returns the value of the "parent_job_id" field in the mutation.
+func (m *DeploymentJobMutation) ParentJobID() (r string, exists bool) {
+	v := m.parent_job
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentJobID returns the old "parent_job_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldParentJobID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentJobID: %w", err)
+	}
+	return oldValue.ParentJobID, nil
+}
+
+// ClearParentJobID clears the value of the "parent_job_id" field.
+func (m *DeploymentJobMutation) ClearParentJobID() {
+	m.parent_job = nil
+	m.clearedFields[deploymentjob.FieldParentJobID] = struct{}{}
+}
+
+// ParentJobIDCleared returns if the "parent_job_id" field was cleared in this mutation.
+func (m *DeploymentJobMutation) ParentJobIDCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldParentJobID]
+	return ok
+}
+
+// ResetParentJobID resets all changes to the "parent_job_id" field.
+func (m *DeploymentJobMutation) ResetParentJobID() {
+	m.parent_job = nil
+	delete(m.clearedFields, deploymentjob.FieldParentJobID)
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *DeploymentJobMutation) SetCertificateID(s string) {
+	m.certificate_id = &s
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *DeploymentJobMutation) CertificateID() (r string, exists bool) {
+	v := m.certificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCertificateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *DeploymentJobMutation) ResetCertificateID() {
+	m.certificate_id = nil
+}
+
+// SetCertificateSerial sets the "certificate_serial" field.
+func (m *DeploymentJobMutation) SetCertificateSerial(s string) {
+	m.certificate_serial = &s
+}
+
+// CertificateSerial returns the value of the "certificate_serial" field in the mutation.
+func (m *DeploymentJobMutation) CertificateSerial() (r string, exists bool) {
+	v := m.certificate_serial
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateSerial returns the old "certificate_serial" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCertificateSerial(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateSerial is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateSerial requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateSerial: %w", err)
+	}
+	return oldValue.CertificateSerial, nil
+}
+
+// ClearCertificateSerial clears the value of the "certificate_serial" field.
+func (m *DeploymentJobMutation) ClearCertificateSerial() {
+	m.certificate_serial = nil
+	m.clearedFields[deploymentjob.FieldCertificateSerial] = struct{}{}
+}
+
+// CertificateSerialCleared returns if the "certificate_serial" field was cleared in this mutation.
+func (m *DeploymentJobMutation) CertificateSerialCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldCertificateSerial]
+	return ok
+}
+
+// ResetCertificateSerial resets all changes to the "certificate_serial" field.
+func (m *DeploymentJobMutation) ResetCertificateSerial() {
+	m.certificate_serial = nil
+	delete(m.clearedFields, deploymentjob.FieldCertificateSerial)
+}
+
+// SetCertificateCommonName sets the "certificate_common_name" field.
+func (m *DeploymentJobMutation) SetCertificateCommonName(s string) {
+	m.certificate_common_name = &s
+}
+
+// CertificateCommonName returns the value of the "certificate_common_name" field in the mutation.
+func (m *DeploymentJobMutation) CertificateCommonName() (r string, exists bool) {
+	v := m.certificate_common_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateCommonName returns the old "certificate_common_name" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCertificateCommonName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateCommonName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateCommonName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateCommonName: %w", err)
+	}
+	return oldValue.CertificateCommonName, nil
+}
+
+// ClearCertificateCommonName clears the value of the "certificate_common_name" field.
+func (m *DeploymentJobMutation) ClearCertificateCommonName() {
+	m.certificate_common_name = nil
+	m.clearedFields[deploymentjob.FieldCertificateCommonName] = struct{}{}
+}
+
+// CertificateCommonNameCleared returns if the "certificate_common_name" field was cleared in this mutation.
+func (m *DeploymentJobMutation) CertificateCommonNameCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldCertificateCommonName]
+	return ok
+}
+
+// ResetCertificateCommonName resets all changes to the "certificate_common_name" field.
+func (m *DeploymentJobMutation) ResetCertificateCommonName() {
+	m.certificate_common_name = nil
+	delete(m.clearedFields, deploymentjob.FieldCertificateCommonName)
+}
+
+// SetCertificateLineage sets the "certificate_lineage" field.
+func (m *DeploymentJobMutation) SetCertificateLineage(s string) {
+	m.certificate_lineage = &s
+}
+
+// CertificateLineage returns the value of the "certificate_lineage" field in the mutation.
+func (m *DeploymentJobMutation) CertificateLineage() (r string, exists bool) {
+	v := m.certificate_lineage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateLineage returns the old "certificate_lineage" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCertificateLineage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateLineage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateLineage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateLineage: %w", err)
+	}
+	return oldValue.CertificateLineage, nil
+}
+
+// ClearCertificateLineage clears the value of the "certificate_lineage" field.
+func (m *DeploymentJobMutation) ClearCertificateLineage() {
+	m.certificate_lineage = nil
+	m.clearedFields[deploymentjob.FieldCertificateLineage] = struct{}{}
+}
+
+// CertificateLineageCleared returns if the "certificate_lineage" field was cleared in this mutation.
+func (m *DeploymentJobMutation) CertificateLineageCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldCertificateLineage]
+	return ok
+}
+
+// ResetCertificateLineage resets all changes to the "certificate_lineage" field.
+func (m *DeploymentJobMutation) ResetCertificateLineage() {
+	m.certificate_lineage = nil
+	delete(m.clearedFields, deploymentjob.FieldCertificateLineage)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *DeploymentJobMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *DeploymentJobMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *DeploymentJobMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[deploymentjob.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *DeploymentJobMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *DeploymentJobMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, deploymentjob.FieldIdempotencyKey)
+}
+
+// SetSupersededByJobID sets the "superseded_by_job_id" field.
+func (m *DeploymentJobMutation) SetSupersededByJobID(s string) {
+	m.superseded_by_job_id = &s
+}
+
+// SupersededByJobID returns the value of the "superseded_by_job_id" field in the mutation.
+func (m *DeploymentJobMutation) SupersededByJobID() (r string, exists bool) {
+	v := m.superseded_by_job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupersededByJobID returns the old "superseded_by_job_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldSupersededByJobID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupersededByJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupersededByJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupersededByJobID: %w", err)
+	}
+	return oldValue.SupersededByJobID, nil
+}
+
+// ClearSupersededByJobID clears the value of the "superseded_by_job_id" field.
+func (m *DeploymentJobMutation) ClearSupersededByJobID() {
+	m.superseded_by_job_id = nil
+	m.clearedFields[deploymentjob.FieldSupersededByJobID] = struct{}{}
+}
+
+// SupersededByJobIDCleared returns if the "superseded_by_job_id" field was cleared in this mutation.
+func (m *DeploymentJobMutation) SupersededByJobIDCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldSupersededByJobID]
+	return ok
+}
+
+// ResetSupersededByJobID resets all changes to the "superseded_by_job_id" field.
+func (m *DeploymentJobMutation) ResetSupersededByJobID() {
+	m.superseded_by_job_id = nil
+	delete(m.clearedFields, deploymentjob.FieldSupersededByJobID)
+}
+
+// SetStatus sets the "status" field.
+func (m *DeploymentJobMutation) SetStatus(d deploymentjob.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DeploymentJobMutation) Status() (r deploymentjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldStatus(ctx context.Context) (v deploymentjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DeploymentJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (m *DeploymentJobMutation) SetStatusMessage(s string) {
+	m.status_message = &s
+}
+
+// StatusMessage returns the value of the "status_message" field in the mutation.
+func (m *DeploymentJobMutation) StatusMessage() (r string, exists bool) {
+	v := m.status_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusMessage returns the old "status_message" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	}
+	return oldValue.StatusMessage, nil
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (m *DeploymentJobMutation) ClearStatusMessage() {
+	m.status_message = nil
+	m.clearedFields[deploymentjob.FieldStatusMessage] = struct{}{}
+}
+
+// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
+func (m *DeploymentJobMutation) StatusMessageCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldStatusMessage]
+	return ok
+}
+
+// ResetStatusMessage resets all changes to the "status_message" field.
+func (m *DeploymentJobMutation) ResetStatusMessage() {
+	m.status_message = nil
+	delete(m.clearedFields, deploymentjob.FieldStatusMessage)
+}
+
+// SetProgress sets the "progress" field.
+func (m *DeploymentJobMutation) SetProgress(i int32) {
+	m.progress = &i
+	m.addprogress = nil
+}
+
+// Progress returns the value of the "progress" field in the mutation.
+func (m *DeploymentJobMutation) Progress() (r int32, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgress returns the old "progress" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldProgress(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
+}
+
+// AddProgress adds i to the "progress" field.
+func (m *DeploymentJobMutation) AddProgress(i int32) {
+	if m.addprogress != nil {
+		*m.addprogress += i
+	} else {
+		m.addprogress = &i
+	}
+}
+
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *DeploymentJobMutation) AddedProgress() (r int32, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgress resets all changes to the "progress" field.
+func (m *DeploymentJobMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (m *DeploymentJobMutation) SetRetryCount(i int32) {
+	m.retry_count = &i
+	m.addretry_count = nil
+}
+
+// RetryCount returns the value of the "retry_count" field in the mutation.
+func (m *DeploymentJobMutation) RetryCount() (r int32, exists bool) {
+	v := m.retry_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryCount returns the old "retry_count" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldRetryCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryCount: %w", err)
+	}
+	return oldValue.RetryCount, nil
+}
+
+// AddRetryCount adds i to the "retry_count" field.
+func (m *DeploymentJobMutation) AddRetryCount(i int32) {
+	if m.addretry_count != nil {
+		*m.addretry_count += i
+	} else {
+		m.addretry_count = &i
+	}
+}
+
+// AddedRetryCount returns the value that was added to the "retry_count" field in this mutation.
+func (m *DeploymentJobMutation) AddedRetryCount() (r int32, exists bool) {
+	v := m.addretry_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetryCount resets all changes to the "retry_count" field.
+func (m *DeploymentJobMutation) ResetRetryCount() {
+	m.retry_count = nil
+	m.addretry_count = nil
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (m *DeploymentJobMutation) SetMaxRetries(i int32) {
+	m.max_retries = &i
+	m.addmax_retries = nil
+}
+
+// MaxRetries returns the value of the "max_retries" field in the mutation.
+func (m *DeploymentJobMutation) MaxRetries() (r int32, exists bool) {
+	v := m.max_retries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRetries returns the old "max_retries" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldMaxRetries(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRetries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRetries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRetries: %w", err)
+	}
+	return oldValue.MaxRetries, nil
+}
+
+// AddMaxRetries adds i to the "max_retries" field.
+func (m *DeploymentJobMutation) AddMaxRetries(i int32) {
+	if m.addmax_retries != nil {
+		*m.addmax_retries += i
+	} else {
+		m.addmax_retries = &i
+	}
+}
+
+// AddedMaxRetries returns the value that was added to the "max_retries" field in this mutation.
+func (m *DeploymentJobMutation) AddedMaxRetries() (r int32, exists bool) {
+	v := m.addmax_retries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxRetries resets all changes to the "max_retries" field.
+func (m *DeploymentJobMutation) ResetMaxRetries() {
+	m.max_retries = nil
+	m.addmax_retries = nil
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (m *DeploymentJobMutation) SetRetryPolicy(rp *registry.RetryPolicy) {
+	m.retry_policy = &rp
+}
+
+// RetryPolicy returns the value of the "retry_policy" field in the mutation.
+func (m *DeploymentJobMutation) RetryPolicy() (r *registry.RetryPolicy, exists bool) {
+	v := m.retry_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryPolicy returns the old "retry_policy" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldRetryPolicy(ctx context.Context) (v *registry.RetryPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryPolicy: %w", err)
+	}
+	return oldValue.RetryPolicy, nil
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (m *DeploymentJobMutation) ClearRetryPolicy() {
+	m.retry_policy = nil
+	m.clearedFields[deploymentjob.FieldRetryPolicy] = struct{}{}
+}
+
+// RetryPolicyCleared returns if the "retry_policy" field was cleared in this mutation.
+func (m *DeploymentJobMutation) RetryPolicyCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldRetryPolicy]
+	return ok
+}
+
+// ResetRetryPolicy resets all changes to the "retry_policy" field.
+func (m *DeploymentJobMutation) ResetRetryPolicy() {
+	m.retry_policy = nil
+	delete(m.clearedFields, deploymentjob.FieldRetryPolicy)
+}
+
+// SetTriggeredBy sets the "triggered_by" field.
+func (m *DeploymentJobMutation) SetTriggeredBy(db deploymentjob.TriggeredBy) {
+	m.triggered_by = &db
+}
+
+// TriggeredBy returns the value of the "triggered_by" field in the mutation.
+func (m *DeploymentJobMutation) TriggeredBy() (r deploymentjob.TriggeredBy, exists bool) {
+	v := m.triggered_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggeredBy returns the old "triggered_by" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldTriggeredBy(ctx context.Context) (v deploymentjob.TriggeredBy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggeredBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggeredBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggeredBy: %w", err)
+	}
+	return oldValue.TriggeredBy, nil
+}
+
+// ResetTriggeredBy resets all changes to the "triggered_by" field.
+func (m *DeploymentJobMutation) ResetTriggeredBy() {
+	m.triggered_by = nil
+}
+
+// SetAction sets the "action" field.
+func (m *DeploymentJobMutation) SetAction(d deploymentjob.Action) {
+	m.action = &d
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *DeploymentJobMutation) Action() (r deploymentjob.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldAction(ctx context.Context) (v deploymentjob.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *DeploymentJobMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetResult sets the "result" field.
+func (m *DeploymentJobMutation) SetResult(value map[string]interface{}) {
+	m.result = &value
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *DeploymentJobMutation) Result() (r map[string]interface{}, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldResult(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ClearResult clears the value of the "result" field.
+func (m *DeploymentJobMutation) ClearResult() {
+	m.result = nil
+	m.clearedFields[deploymentjob.FieldResult] = struct{}{}
+}
+
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *DeploymentJobMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldResult]
+	return ok
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *DeploymentJobMutation) ResetResult() {
+	m.result = nil
+	delete(m.clearedFields, deploymentjob.FieldResult)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *DeploymentJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DeploymentJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *DeploymentJobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[deploymentjob.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *DeploymentJobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DeploymentJobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, deploymentjob.FieldStartedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *DeploymentJobMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *DeploymentJobMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *DeploymentJobMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[deploymentjob.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *DeploymentJobMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *DeploymentJobMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, deploymentjob.FieldCompletedAt)
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (m *DeploymentJobMutation) SetNextRetryAt(t time.Time) {
+	m.next_retry_at = &t
+}
+
+// NextRetryAt returns the value of the "next_retry_at" field in the mutation.
+func (m *DeploymentJobMutation) NextRetryAt() (r time.Time, exists bool) {
+	v := m.next_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRetryAt returns the old "next_retry_at" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldNextRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRetryAt: %w", err)
+	}
+	return oldValue.NextRetryAt, nil
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (m *DeploymentJobMutation) ClearNextRetryAt() {
+	m.next_retry_at = nil
+	m.clearedFields[deploymentjob.FieldNextRetryAt] = struct{}{}
+}
+
+// NextRetryAtCleared returns if the "next_retry_at" field was cleared in this mutation.
+func (m *DeploymentJobMutation) NextRetryAtCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldNextRetryAt]
+	return ok
+}
+
+// ResetNextRetryAt resets all changes to the "next_retry_at" field.
+func (m *DeploymentJobMutation) ResetNextRetryAt() {
+	m.next_retry_at = nil
+	delete(m.clearedFields, deploymentjob.FieldNextRetryAt)
+}
+
+// SetConfigurationRevisionID sets the "configuration_revision_id" field.
+func (m *DeploymentJobMutation) SetConfigurationRevisionID(u uint32) {
+	m.configuration_revision_id = &u
+	m.addconfiguration_revision_id = nil
+}
+
+// ConfigurationRevisionID returns the value of the "configuration_revision_id" field in the mutation.
+func (m *DeploymentJobMutation) ConfigurationRevisionID() (r uint32, exists bool) {
+	v := m.configuration_revision_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfigurationRevisionID returns the old "configuration_revision_id" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldConfigurationRevisionID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfigurationRevisionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfigurationRevisionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfigurationRevisionID: %w", err)
+	}
+	return oldValue.ConfigurationRevisionID, nil
+}
+
+// AddConfigurationRevisionID adds u to the "configuration_revision_id" field.
+func (m *DeploymentJobMutation) AddConfigurationRevisionID(u int32) {
+	if m.addconfiguration_revision_id != nil {
+		*m.addconfiguration_revision_id += u
+	} else {
+		m.addconfiguration_revision_id = &u
+	}
+}
+
+// AddedConfigurationRevisionID returns the value that was added to the "configuration_revision_id" field in this mutation.
+func (m *DeploymentJobMutation) AddedConfigurationRevisionID() (r int32, exists bool) {
+	v := m.addconfiguration_revision_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearConfigurationRevisionID clears the value of the "configuration_revision_id" field.
+func (m *DeploymentJobMutation) ClearConfigurationRevisionID() {
+	m.configuration_revision_id = nil
+	m.addconfiguration_revision_id = nil
+	m.clearedFields[deploymentjob.FieldConfigurationRevisionID] = struct{}{}
+}
+
+// ConfigurationRevisionIDCleared returns if the "configuration_revision_id" field was cleared in this mutation.
+func (m *DeploymentJobMutation) ConfigurationRevisionIDCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldConfigurationRevisionID]
+	return ok
+}
+
+// ResetConfigurationRevisionID resets all changes to the "configuration_revision_id" field.
+func (m *DeploymentJobMutation) ResetConfigurationRevisionID() {
+	m.configuration_revision_id = nil
+	m.addconfiguration_revision_id = nil
+	delete(m.clearedFields, deploymentjob.FieldConfigurationRevisionID)
+}
+
+// SetErrorCategory sets the "error_category" field.
+func (m *DeploymentJobMutation) SetErrorCategory(dc deploymentjob.ErrorCategory) {
+	m.error_category = &dc
+}
+
+// ErrorCategory returns the value of the "error_category" field in the mutation.
+func (m *DeploymentJobMutation) ErrorCategory() (r deploymentjob.ErrorCategory, exists bool) {
+	v := m.error_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCategory returns the old "error_category" field's value of the DeploymentJob entity.
+// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentJobMutation) OldErrorCategory(ctx context.Context) (v *deploymentjob.ErrorCategory, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCategory: %w", err)
+	}
+	return oldValue.ErrorCategory, nil
+}
+
+// ClearErrorCategory clears the value of the "error_category" field.
+func (m *DeploymentJobMutation) ClearErrorCategory() {
+	m.error_category = nil
+	m.clearedFields[deploymentjob.FieldErrorCategory] = struct{}{}
+}
+
+// ErrorCategoryCleared returns if the "error_category" field was cleared in this mutation.
+func (m *DeploymentJobMutation) ErrorCategoryCleared() bool {
+	_, ok := m.clearedFields[deploymentjob.FieldErrorCategory]
+	return ok
+}
+
+// ResetErrorCategory resets all changes to the "error_category" field.
+func (m *DeploymentJobMutation) ResetErrorCategory() {
+	m.error_category = nil
+	delete(m.clearedFields, deploymentjob.FieldErrorCategory)
+}
+
+// ClearDeploymentTarget clears the "deployment_target" edge to the DeploymentTarget entity.
+func (m *DeploymentJobMutation) ClearDeploymentTarget() {
+	m.cleareddeployment_target = true
+	m.clearedFields[deploymentjob.FieldDeploymentTargetID] = struct{}{}
+}
+
+// DeploymentTargetCleared reports if the "deployment_target" edge to the DeploymentTarget entity was cleared.
+func (m *DeploymentJobMutation) DeploymentTargetCleared() bool {
+	return m.DeploymentTargetIDCleared() || m.cleareddeployment_target
+}
+
+// DeploymentTargetIDs returns the "deployment_target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentTargetID instead. It exists only for internal usage by the builders.
+func (m *DeploymentJobMutation) DeploymentTargetIDs() (ids []string) {
+	if id := m.deployment_target; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeploymentTarget resets all changes to the "deployment_target" edge.
+func (m *DeploymentJobMutation) ResetDeploymentTarget() {
+	m.deployment_target = nil
+	m.cleareddeployment_target = false
+}
+
+// ClearTargetConfiguration clears the "target_configuration" edge to the TargetConfiguration entity.
+func (m *DeploymentJobMutation) ClearTargetConfiguration() {
+	m.clearedtarget_configuration = true
+	m.clearedFields[deploymentjob.FieldTargetConfigurationID] = struct{}{}
+}
+
+// TargetConfigurationCleared reports if the "target_configuration" edge to the TargetConfiguration entity was cleared.
+func (m *DeploymentJobMutation) TargetConfigurationCleared() bool {
+	return m.TargetConfigurationIDCleared() || m.clearedtarget_configuration
+}
+
+// TargetConfigurationIDs returns the "target_configuration" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetConfigurationID instead. It exists only for internal usage by the builders.
+func (m *DeploymentJobMutation) TargetConfigurationIDs() (ids []string) {
+	if id := m.target_configuration; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargetConfiguration resets all changes to the "target_configuration" edge.
+func (m *DeploymentJobMutation) ResetTargetConfiguration() {
+	m.target_configuration = nil
+	m.clearedtarget_configuration = false
+}
+
+// ClearParentJob clears the "parent_job" edge to the DeploymentJob entity.
+func (m *DeploymentJobMutation) ClearParentJob() {
+	m.clearedparent_job = true
+	m.clearedFields[deploymentjob.FieldParentJobID] = struct{}{}
+}
+
+// ParentJobCleared reports if the "parent_job" edge to the DeploymentJob entity was cleared.
+func (m *DeploymentJobMutation) ParentJobCleared() bool {
+	return m.ParentJobIDCleared() || m.clearedparent_job
+}
+
+// ParentJobIDs returns the "parent_job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentJobID instead. It exists only for internal usage by the builders.
+func (m *DeploymentJobMutation) ParentJobIDs() (ids []string) {
+	if id := m.parent_job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParentJob resets all changes to the "parent_job" edge.
+func (m *DeploymentJobMutation) ResetParentJob() {
+	m.parent_job = nil
+	m.clearedparent_job = false
+}
+
+// AddChildJobIDs adds the "child_jobs" edge to the DeploymentJob entity by ids.
+func (m *DeploymentJobMutation) AddChildJobIDs(ids ...string) {
+	if m.child_jobs == nil {
+		m.child_jobs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.child_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildJobs clears the "child_jobs" edge to the DeploymentJob entity.
+func (m *DeploymentJobMutation) ClearChildJobs() {
+	m.clearedchild_jobs = true
+}
+
+// ChildJobsCleared reports if the "child_jobs" edge to the DeploymentJob entity was cleared.
+func (m *DeploymentJobMutation) ChildJobsCleared() bool {
+	return m.clearedchild_jobs
+}
+
+// RemoveChildJobIDs removes the "child_jobs" edge to the DeploymentJob entity by IDs.
+func (m *DeploymentJobMutation) RemoveChildJobIDs(ids ...string) {
+	if m.removedchild_jobs == nil {
+		m.removedchild_jobs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.child_jobs, ids[i])
+		m.removedchild_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildJobs returns the removed IDs of the "child_jobs" edge to the DeploymentJob entity.
+func (m *DeploymentJobMutation) RemovedChildJobsIDs() (ids []string) {
+	for id := range m.removedchild_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildJobsIDs returns the "child_jobs" edge IDs in the mutation.
+func (m *DeploymentJobMutation) ChildJobsIDs() (ids []string) {
+	for id := range m.child_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildJobs resets all changes to the "child_jobs" edge.
+func (m *DeploymentJobMutation) ResetChildJobs() {
+	m.child_jobs = nil
+	m.clearedchild_jobs = false
+	m.removedchild_jobs = nil
+}
+
+// AddHistoryIDs adds the "history" edge to the DeploymentHistory entity by ids.
+func (m *DeploymentJobMutation) AddHistoryIDs(ids ...uint32) {
+	if m.history == nil {
+		m.history = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistory clears the "history" edge to the DeploymentHistory entity.
+func (m *DeploymentJobMutation) ClearHistory() {
+	m.clearedhistory = true
+}
+
+// HistoryCleared reports if the "history" edge to the DeploymentHistory entity was cleared.
+func (m *DeploymentJobMutation) HistoryCleared() bool {
+	return m.clearedhistory
+}
+
+// RemoveHistoryIDs removes the "history" edge to the DeploymentHistory entity by IDs.
+func (m *DeploymentJobMutation) RemoveHistoryIDs(ids ...uint32) {
+	if m.removedhistory == nil {
+		m.removedhistory = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.history, ids[i])
+		m.removedhistory[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistory returns the removed IDs of the "history" edge to the DeploymentHistory entity.
+func (m *DeploymentJobMutation) RemovedHistoryIDs() (ids []uint32) {
+	for id := range m.removedhistory {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoryIDs returns the "history" edge IDs in the mutation.
+func (m *DeploymentJobMutation) HistoryIDs() (ids []uint32) {
+	for id := range m.history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistory resets all changes to the "history" edge.
+func (m *DeploymentJobMutation) ResetHistory() {
+	m.history = nil
+	m.clearedhistory = false
+	m.removedhistory = nil
+}
+
+// Where appends a list predicates to the DeploymentJobMutation builder.
+func (m *DeploymentJobMutation) Where(ps ...predicate.DeploymentJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentJob).
+func (m *DeploymentJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentJobMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.create_by != nil {
+		fields = append(fields, deploymentjob.FieldCreateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, deploymentjob.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deploymentjob.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, deploymentjob.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, deploymentjob.FieldTenantID)
+	}
+	if m.deployment_target != nil {
+		fields = append(fields, deploymentjob.FieldDeploymentTargetID)
+	}
+	if m.target_configuration != nil {
+		fields = append(fields, deploymentjob.FieldTargetConfigurationID)
+	}
+	if m.parent_job != nil {
+		fields = append(fields, deploymentjob.FieldParentJobID)
+	}
+	if m.certificate_id != nil {
+		fields = append(fields, deploymentjob.FieldCertificateID)
+	}
+	if m.certificate_serial != nil {
+		fields = append(fields, deploymentjob.FieldCertificateSerial)
+	}
+	if m.certificate_common_name != nil {
+		fields = append(fields, deploymentjob.FieldCertificateCommonName)
+	}
+	if m.certificate_lineage != nil {
+		fields = append(fields, deploymentjob.FieldCertificateLineage)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, deploymentjob.FieldIdempotencyKey)
+	}
+	if m.superseded_by_job_id != nil {
+		fields = append(fields, deploymentjob.FieldSupersededByJobID)
+	}
+	if m.status != nil {
+		fields = append(fields, deploymentjob.FieldStatus)
+	}
+	if m.status_message != nil {
+		fields = append(fields, deploymentjob.FieldStatusMessage)
+	}
+	if m.progress != nil {
+		fields = append(fields, deploymentjob.FieldProgress)
+	}
+	if m.retry_count != nil {
+		fields = append(fields, deploymentjob.FieldRetryCount)
+	}
+	if m.max_retries != nil {
+		fields = append(fields, deploymentjob.FieldMaxRetries)
+	}
+	if m.retry_policy != nil {
+		fields = append(fields, deploymentjob.FieldRetryPolicy)
+	}
+	if m.triggered_by != nil {
+		fields = append(fields, deploymentjob.FieldTriggeredBy)
+	}
+	if m.action != nil {
+		fields = append(fields, deploymentjob.FieldAction)
+	}
+	if m.result != nil {
+		fields = append(fields, deploymentjob.FieldResult)
+	}
+	if m.started_at != nil {
+		fields = append(fields, deploymentjob.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, deploymentjob.FieldCompletedAt)
+	}
+	if m.next_retry_at != nil {
+		fields = append(fields, deploymentjob.FieldNextRetryAt)
+	}
+	if m.configuration_revision_id != nil {
+		fields = append(fields, deploymentjob.FieldConfigurationRevisionID)
+	}
+	if m.error_category != nil {
+		fields = append(fields, deploymentjob.FieldErrorCategory)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		return m.CreateBy()
+	case deploymentjob.FieldCreateTime:
+		return m.CreateTime()
+	case deploymentjob.FieldUpdateTime:
+		return m.UpdateTime()
+	case deploymentjob.FieldDeleteTime:
+		return m.DeleteTime()
+	case deploymentjob.FieldTenantID:
+		return m.TenantID()
+	case deploymentjob.FieldDeploymentTargetID:
+		return m.DeploymentTargetID()
+	case deploymentjob.FieldTargetConfigurationID:
+		return m.TargetConfigurationID()
+	case deploymentjob.FieldParentJobID:
+		return m.ParentJobID()
+	case deploymentjob.FieldCertificateID:
+		return m.CertificateID()
+	case deploymentjob.FieldCertificateSerial:
+		return m.CertificateSerial()
+	case deploymentjob.FieldCertificateCommonName:
+		return m.CertificateCommonName()
+	case deploymentjob.FieldCertificateLineage:
+		return m.CertificateLineage()
+	case deploymentjob.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case deploymentjob.FieldSupersededByJobID:
+		return m.SupersededByJobID()
+	case deploymentjob.FieldStatus:
+		return m.Status()
+	case deploymentjob.FieldStatusMessage:
+		return m.StatusMessage()
+	case deploymentjob.FieldProgress:
+		return m.Progress()
+	case deploymentjob.FieldRetryCount:
+		return m.RetryCount()
+	case deploymentjob.FieldMaxRetries:
+		return m.MaxRetries()
+	case deploymentjob.FieldRetryPolicy:
+		return m.RetryPolicy()
+	case deploymentjob.FieldTriggeredBy:
+		return m.TriggeredBy()
+	case deploymentjob.FieldAction:
+		return m.Action()
+	case deploymentjob.FieldResult:
+		return m.Result()
+	case deploymentjob.FieldStartedAt:
+		return m.StartedAt()
+	case deploymentjob.FieldCompletedAt:
+		return m.CompletedAt()
+	case deploymentjob.FieldNextRetryAt:
+		return m.NextRetryAt()
+	case deploymentjob.FieldConfigurationRevisionID:
+		return m.ConfigurationRevisionID()
+	case deploymentjob.FieldErrorCategory:
+		return m.ErrorCategory()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case deploymentjob.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deploymentjob.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case deploymentjob.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case deploymentjob.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case deploymentjob.FieldDeploymentTargetID:
+		return m.OldDeploymentTargetID(ctx)
+	case deploymentjob.FieldTargetConfigurationID:
+		return m.OldTargetConfigurationID(ctx)
+	case deploymentjob.FieldParentJobID:
+		return m.OldParentJobID(ctx)
+	case deploymentjob.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case deploymentjob.FieldCertificateSerial:
+		return m.OldCertificateSerial(ctx)
+	case deploymentjob.FieldCertificateCommonName:
+		return m.OldCertificateCommonName(ctx)
+	case deploymentjob.FieldCertificateLineage:
+		return m.OldCertificateLineage(ctx)
+	case deploymentjob.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case deploymentjob.FieldSupersededByJobID:
+		return m.OldSupersededByJobID(ctx)
+	case deploymentjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case deploymentjob.FieldStatusMessage:
+		return m.OldStatusMessage(ctx)
+	case deploymentjob.FieldProgress:
+		return m.OldProgress(ctx)
+	case deploymentjob.FieldRetryCount:
+		return m.OldRetryCount(ctx)
+	case deploymentjob.FieldMaxRetries:
+		return m.OldMaxRetries(ctx)
+	case deploymentjob.FieldRetryPolicy:
+		return m.OldRetryPolicy(ctx)
+	case deploymentjob.FieldTriggeredBy:
+		return m.OldTriggeredBy(ctx)
+	case deploymentjob.FieldAction:
+		return m.OldAction(ctx)
+	case deploymentjob.FieldResult:
+		return m.OldResult(ctx)
+	case deploymentjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case deploymentjob.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case deploymentjob.FieldNextRetryAt:
+		return m.OldNextRetryAt(ctx)
+	case deploymentjob.FieldConfigurationRevisionID:
+		return m.OldConfigurationRevisionID(ctx)
+	case deploymentjob.FieldErrorCategory:
+		return m.OldErrorCategory(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case deploymentjob.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deploymentjob.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case deploymentjob.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case deploymentjob.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case deploymentjob.FieldDeploymentTargetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentTargetID(v)
+		return nil
+	case deploymentjob.FieldTargetConfigurationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetConfigurationID(v)
+		return nil
+	case deploymentjob.FieldParentJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentJobID(v)
+		return nil
+	case deploymentjob.FieldCertificateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case deploymentjob.FieldCertificateSerial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateSerial(v)
+		return nil
+	case deploymentjob.FieldCertificateCommonName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateCommonName(v)
+		return nil
+	case deploymentjob.FieldCertificateLineage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateLineage(v)
+		return nil
+	case deploymentjob.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case deploymentjob.FieldSupersededByJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSupersededByJobID(v)
+		return nil
+	case deploymentjob.FieldStatus:
+		v, ok := value.(deploymentjob.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case deploymentjob.FieldStatusMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusMessage(v)
+		return nil
+	case deploymentjob.FieldProgress:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case deploymentjob.FieldRetryCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryCount(v)
+		return nil
+	case deploymentjob.FieldMaxRetries:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRetries(v)
+		return nil
+	case deploymentjob.FieldRetryPolicy:
+		v, ok := value.(*registry.RetryPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryPolicy(v)
+		return nil
+	case deploymentjob.FieldTriggeredBy:
+		v, ok := value.(deploymentjob.TriggeredBy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggeredBy(v)
+		return nil
+	case deploymentjob.FieldAction:
+		v, ok := value.(deploymentjob.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case deploymentjob.FieldResult:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case deploymentjob.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case deploymentjob.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case deploymentjob.FieldNextRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRetryAt(v)
+		return nil
+	case deploymentjob.FieldConfigurationRevisionID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfigurationRevisionID(v)
+		return nil
+	case deploymentjob.FieldErrorCategory:
+		v, ok := value.(deploymentjob.ErrorCategory)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCategory(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, deploymentjob.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, deploymentjob.FieldTenantID)
+	}
+	if m.addprogress != nil {
+		fields = append(fields, deploymentjob.FieldProgress)
+	}
+	if m.addretry_count != nil {
+		fields = append(fields, deploymentjob.FieldRetryCount)
+	}
+	if m.addmax_retries != nil {
+		fields = append(fields, deploymentjob.FieldMaxRetries)
+	}
+	if m.addconfiguration_revision_id != nil {
+		fields = append(fields, deploymentjob.FieldConfigurationRevisionID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		return m.AddedCreateBy()
+	case deploymentjob.FieldTenantID:
+		return m.AddedTenantID()
+	case deploymentjob.FieldProgress:
+		return m.AddedProgress()
+	case deploymentjob.FieldRetryCount:
+		return m.AddedRetryCount()
+	case deploymentjob.FieldMaxRetries:
+		return m.AddedMaxRetries()
+	case deploymentjob.FieldConfigurationRevisionID:
+		return m.AddedConfigurationRevisionID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case deploymentjob.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case deploymentjob.FieldProgress:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case deploymentjob.FieldRetryCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryCount(v)
+		return nil
+	case deploymentjob.FieldMaxRetries:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRetries(v)
+		return nil
+	case deploymentjob.FieldConfigurationRevisionID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfigurationRevisionID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentjob.FieldCreateBy) {
+		fields = append(fields, deploymentjob.FieldCreateBy)
+	}
+	if m.FieldCleared(deploymentjob.FieldCreateTime) {
+		fields = append(fields, deploymentjob.FieldCreateTime)
+	}
+	if m.FieldCleared(deploymentjob.FieldUpdateTime) {
+		fields = append(fields, deploymentjob.FieldUpdateTime)
+	}
+	if m.FieldCleared(deploymentjob.FieldDeleteTime) {
+		fields = append(fields, deploymentjob.FieldDeleteTime)
+	}
+	if m.FieldCleared(deploymentjob.FieldTenantID) {
+		fields = append(fields, deploymentjob.FieldTenantID)
+	}
+	if m.FieldCleared(deploymentjob.FieldDeploymentTargetID) {
+		fields = append(fields, deploymentjob.FieldDeploymentTargetID)
+	}
+	if m.FieldCleared(deploymentjob.FieldTargetConfigurationID) {
+		fields = append(fields, deploymentjob.FieldTargetConfigurationID)
+	}
+	if m.FieldCleared(deploymentjob.FieldParentJobID) {
+		fields = append(fields, deploymentjob.FieldParentJobID)
+	}
+	if m.FieldCleared(deploymentjob.FieldCertificateSerial) {
+		fields = append(fields, deploymentjob.FieldCertificateSerial)
+	}
+	if m.FieldCleared(deploymentjob.FieldCertificateCommonName) {
+		fields = append(fields, deploymentjob.FieldCertificateCommonName)
+	}
+	if m.FieldCleared(deploymentjob.FieldCertificateLineage) {
+		fields = append(fields, deploymentjob.FieldCertificateLineage)
+	}
+	if m.FieldCleared(deploymentjob.FieldIdempotencyKey) {
+		fields = append(fields, deploymentjob.FieldIdempotencyKey)
+	}
+	if m.FieldCleared(deploymentjob.FieldSupersededByJobID) {
+		fields = append(fields, deploymentjob.FieldSupersededByJobID)
+	}
+	if m.FieldCleared(deploymentjob.FieldStatusMessage) {
+		fields = append(fields, deploymentjob.FieldStatusMessage)
+	}
+	if m.FieldCleared(deploymentjob.FieldRetryPolicy) {
+		fields = append(fields, deploymentjob.FieldRetryPolicy)
+	}
+	if m.FieldCleared(deploymentjob.FieldResult) {
+		fields = append(fields, deploymentjob.FieldResult)
+	}
+	if m.FieldCleared(deploymentjob.FieldStartedAt) {
+		fields = append(fields, deploymentjob.FieldStartedAt)
+	}
+	if m.FieldCleared(deploymentjob.FieldCompletedAt) {
+		fields = append(fields, deploymentjob.FieldCompletedAt)
+	}
+	if m.FieldCleared(deploymentjob.FieldNextRetryAt) {
+		fields = append(fields, deploymentjob.FieldNextRetryAt)
+	}
+	if m.FieldCleared(deploymentjob.FieldConfigurationRevisionID) {
+		fields = append(fields, deploymentjob.FieldConfigurationRevisionID)
+	}
+	if m.FieldCleared(deploymentjob.FieldErrorCategory) {
+		fields = append(fields, deploymentjob.FieldErrorCategory)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentJobMutation) ClearField(name string) error {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case deploymentjob.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case deploymentjob.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case deploymentjob.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case deploymentjob.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case deploymentjob.FieldDeploymentTargetID:
+		m.ClearDeploymentTargetID()
+		return nil
+	case deploymentjob.FieldTargetConfigurationID:
+		m.ClearTargetConfigurationID()
+		return nil
+	case deploymentjob.FieldParentJobID:
+		m.ClearParentJobID()
+		return nil
+	case deploymentjob.FieldCertificateSerial:
+		m.ClearCertificateSerial()
+		return nil
+	case deploymentjob.FieldCertificateCommonName:
+		m.ClearCertificateCommonName()
+		return nil
+	case deploymentjob.FieldCertificateLineage:
+		m.ClearCertificateLineage()
+		return nil
+	case deploymentjob.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	case deploymentjob.FieldSupersededByJobID:
+		m.ClearSupersededByJobID()
+		return nil
+	case deploymentjob.FieldStatusMessage:
+		m.ClearStatusMessage()
+		return nil
+	case deploymentjob.FieldRetryPolicy:
+		m.ClearRetryPolicy()
+		return nil
+	case deploymentjob.FieldResult:
+		m.ClearResult()
+		return nil
+	case deploymentjob.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case deploymentjob.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case deploymentjob.FieldNextRetryAt:
+		m.ClearNextRetryAt()
+		return nil
+	case deploymentjob.FieldConfigurationRevisionID:
+		m.ClearConfigurationRevisionID()
+		return nil
+	case deploymentjob.FieldErrorCategory:
+		m.ClearErrorCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentJobMutation) ResetField(name string) error {
+	switch name {
+	case deploymentjob.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case deploymentjob.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deploymentjob.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case deploymentjob.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case deploymentjob.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case deploymentjob.FieldDeploymentTargetID:
+		m.ResetDeploymentTargetID()
+		return nil
+	case deploymentjob.FieldTargetConfigurationID:
+		m.ResetTargetConfigurationID()
+		return nil
+	case deploymentjob.FieldParentJobID:
+		m.ResetParentJobID()
+		return nil
+	case deploymentjob.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case deploymentjob.FieldCertificateSerial:
+		m.ResetCertificateSerial()
+		return nil
+	case deploymentjob.FieldCertificateCommonName:
+		m.ResetCertificateCommonName()
+		return nil
+	case deploymentjob.FieldCertificateLineage:
+		m.ResetCertificateLineage()
+		return nil
+	case deploymentjob.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case deploymentjob.FieldSupersededByJobID:
+		m.ResetSupersededByJobID()
+		return nil
+	case deploymentjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case deploymentjob.FieldStatusMessage:
+		m.ResetStatusMessage()
+		return nil
+	case deploymentjob.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case deploymentjob.FieldRetryCount:
+		m.ResetRetryCount()
+		return nil
+	case deploymentjob.FieldMaxRetries:
+		m.ResetMaxRetries()
+		return nil
+	case deploymentjob.FieldRetryPolicy:
+		m.ResetRetryPolicy()
+		return nil
+	case deploymentjob.FieldTriggeredBy:
+		m.ResetTriggeredBy()
+		return nil
+	case deploymentjob.FieldAction:
+		m.ResetAction()
+		return nil
+	case deploymentjob.FieldResult:
+		m.ResetResult()
+		return nil
+	case deploymentjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case deploymentjob.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case deploymentjob.FieldNextRetryAt:
+		m.ResetNextRetryAt()
+		return nil
+	case deploymentjob.FieldConfigurationRevisionID:
+		m.ResetConfigurationRevisionID()
+		return nil
+	case deploymentjob.FieldErrorCategory:
+		m.ResetErrorCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.deployment_target != nil {
+		edges = append(edges, deploymentjob.EdgeDeploymentTarget)
+	}
+	if m.target_configuration != nil {
+		edges = append(edges, deploymentjob.EdgeTargetConfiguration)
+	}
+	if m.parent_job != nil {
+		edges = append(edges, deploymentjob.EdgeParentJob)
+	}
+	if m.child_jobs != nil {
+		edges = append(edges, deploymentjob.EdgeChildJobs)
+	}
+	if m.history != nil {
+		edges = append(edges, deploymentjob.EdgeHistory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentjob.EdgeDeploymentTarget:
+		if id := m.deployment_target; id != nil {
+			return []ent.Value{*id}
+		}
+	case deploymentjob.EdgeTargetConfiguration:
+		if id := m.target_configuration; id != nil {
+			return []ent.Value{*id}
+		}
+	case deploymentjob.EdgeParentJob:
+		if id := m.parent_job; id != nil {
+			return []ent.Value{*id}
+		}
+	case deploymentjob.EdgeChildJobs:
+		ids := make([]ent.Value, 0, len(m.child_jobs))
+		for id := range m.child_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentjob.EdgeHistory:
+		ids := make([]ent.Value, 0, len(m.history))
+		for id := range m.history {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedchild_jobs != nil {
+		edges = append(edges, deploymentjob.EdgeChildJobs)
+	}
+	if m.removedhistory != nil {
+		edges = append(edges, deploymentjob.EdgeHistory)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentJobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentjob.EdgeChildJobs:
+		ids := make([]ent.Value, 0, len(m.removedchild_jobs))
+		for id := range m.removedchild_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentjob.EdgeHistory:
+		ids := make([]ent.Value, 0, len(m.removedhistory))
+		for id := range m.removedhistory {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleareddeployment_target {
+		edges = append(edges, deploymentjob.EdgeDeploymentTarget)
+	}
+	if m.clearedtarget_configuration {
+		edges = append(edges, deploymentjob.EdgeTargetConfiguration)
+	}
+	if m.clearedparent_job {
+		edges = append(edges, deploymentjob.EdgeParentJob)
+	}
+	if m.clearedchild_jobs {
+		edges = append(edges, deploymentjob.EdgeChildJobs)
+	}
+	if m.clearedhistory {
+		edges = append(edges, deploymentjob.EdgeHistory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentjob.EdgeDeploymentTarget:
+		return m.cleareddeployment_target
+	case deploymentjob.EdgeTargetConfiguration:
+		return m.clearedtarget_configuration
+	case deploymentjob.EdgeParentJob:
+		return m.clearedparent_job
+	case deploymentjob.EdgeChildJobs:
+		return m.clearedchild_jobs
+	case deploymentjob.EdgeHistory:
+		return m.clearedhistory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentJobMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentjob.EdgeDeploymentTarget:
+		m.ClearDeploymentTarget()
+		return nil
+	case deploymentjob.EdgeTargetConfiguration:
+		m.ClearTargetConfiguration()
+		return nil
+	case deploymentjob.EdgeParentJob:
+		m.ClearParentJob()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentJobMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentjob.EdgeDeploymentTarget:
+		m.ResetDeploymentTarget()
+		return nil
+	case deploymentjob.EdgeTargetConfiguration:
+		m.ResetTargetConfiguration()
+		return nil
+	case deploymentjob.EdgeParentJob:
+		m.ResetParentJob()
+		return nil
+	case deploymentjob.EdgeChildJobs:
+		m.ResetChildJobs()
+		return nil
+	case deploymentjob.EdgeHistory:
+		m.ResetHistory()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentJob edge %s", name)
+}
+
+// DeploymentTargetMutation represents an operation that mutates the DeploymentTarget nodes in the graph.
+type DeploymentTargetMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	create_by                 *uint32
+	addcreate_by              *int32
+	update_by                 *uint32
+	addupdate_by              *int32
+	create_time               *time.Time
+	update_time               *time.Time
+	delete_time               *time.Time
+	tenant_id                 *uint32
+	addtenant_id              *int32
+	name                      *string
+	description               *string
+	auto_deploy_on_renewal    *bool
+	certificate_filters       *[]schema.CertificateFilter
+	appendcertificate_filters []schema.CertificateFilter
+	retry_policy              **registry.RetryPolicy
+	revocation_policy         *deploymenttarget.RevocationPolicy
+	clearedFields             map[string]struct{}
+	configurations            map[string]struct{}
+	removedconfigurations     map[string]struct{}
+	clearedconfigurations     bool
+	jobs                      map[string]struct{}
+	removedjobs               map[string]struct{}
+	clearedjobs               bool
+	done                      bool
+	oldValue                  func(context.Context) (*DeploymentTarget, error)
+	predicates                []predicate.DeploymentTarget
+}
+
+var _ ent.Mutation = (*DeploymentTargetMutation)(nil)
+
+// deploymenttargetOption allows management of the mutation configuration using functional options.
+type deploymenttargetOption func(*DeploymentTargetMutation)
+
+// newDeploymentTargetMutation creates new mutation for the DeploymentTarget entity.
+func newDeploymentTargetMutation(c config, op Op, opts ...deploymenttargetOption) *DeploymentTargetMutation {
+	m := &DeploymentTargetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentTarget,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentTargetID sets the ID field of the mutation.
+func withDeploymentTargetID(id string) deploymenttargetOption {
+	return func(m *DeploymentTargetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentTarget
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentTarget, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentTarget.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentTarget sets the old DeploymentTarget of the mutation.
+func withDeploymentTarget(node *DeploymentTarget) deploymenttargetOption {
+	return func(m *DeploymentTargetMutation) {
+		m.oldValue = func(context.Context) (*DeploymentTarget, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentTargetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentTargetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentTarget entities.
+func (m *DeploymentTargetMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentTargetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentTargetMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentTarget.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *DeploymentTargetMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *DeploymentTargetMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *DeploymentTargetMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
+	}
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *DeploymentTargetMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *DeploymentTargetMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[deploymenttarget.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *DeploymentTargetMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, deploymenttarget.FieldCreateBy)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *DeploymentTargetMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *DeploymentTargetMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// AddUpdateBy adds u to the "update_by" field.
+func (m *DeploymentTargetMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
+	}
+}
+
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *DeploymentTargetMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *DeploymentTargetMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[deploymenttarget.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *DeploymentTargetMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, deploymenttarget.FieldUpdateBy)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeploymentTargetMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeploymentTargetMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *DeploymentTargetMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[deploymenttarget.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeploymentTargetMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, deploymenttarget.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DeploymentTargetMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeploymentTargetMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *DeploymentTargetMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[deploymenttarget.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeploymentTargetMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, deploymenttarget.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DeploymentTargetMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DeploymentTargetMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DeploymentTargetMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[deploymenttarget.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DeploymentTargetMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, deploymenttarget.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DeploymentTargetMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DeploymentTargetMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *DeploymentTargetMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DeploymentTargetMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *DeploymentTargetMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[deploymenttarget.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DeploymentTargetMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, deploymenttarget.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *DeploymentTargetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DeploymentTargetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DeploymentTargetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *DeploymentTargetMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DeploymentTargetMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DeploymentTargetMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[deploymenttarget.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DeploymentTargetMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, deploymenttarget.FieldDescription)
+}
+
+// SetAutoDeployOnRenewal sets the "auto_deploy_on_renewal" field.
+func (m *DeploymentTargetMutation) SetAutoDeployOnRenewal(b bool) {
+	m.auto_deploy_on_renewal = &b
+}
+
+// AutoDeployOnRenewal returns the value of the "auto_deploy_on_renewal" field in the mutation.
+func (m *DeploymentTargetMutation) AutoDeployOnRenewal() (r bool, exists bool) {
+	v := m.auto_deploy_on_renewal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoDeployOnRenewal returns the old "auto_deploy_on_renewal" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldAutoDeployOnRenewal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoDeployOnRenewal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoDeployOnRenewal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoDeployOnRenewal: %w", err)
+	}
+	return oldValue.AutoDeployOnRenewal, nil
+}
+
+// ResetAutoDeployOnRenewal resets all changes to the "auto_deploy_on_renewal" field.
+func (m *DeploymentTargetMutation) ResetAutoDeployOnRenewal() {
+	m.auto_deploy_on_renewal = nil
+}
+
+// SetCertificateFilters sets the "certificate_filters" field.
+func (m *DeploymentTargetMutation) SetCertificateFilters(sf []schema.CertificateFilter) {
+	m.certificate_filters = &sf
+	m.appendcertificate_filters = nil
+}
+
+// CertificateFilters returns the value of the "certificate_filters" field in the mutation.
+func (m *DeploymentTargetMutation) CertificateFilters() (r []schema.CertificateFilter, exists bool) {
+	v := m.certificate_filters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateFilters returns the old "certificate_filters" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldCertificateFilters(ctx context.Context) (v []schema.CertificateFilter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateFilters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateFilters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateFilters: %w", err)
+	}
+	return oldValue.CertificateFilters, nil
+}
+
+// AppendCertificateFilters adds sf to the "certificate_filters" field.
+func (m *DeploymentTargetMutation) AppendCertificateFilters(sf []schema.CertificateFilter) {
+	m.appendcertificate_filters = append(m.appendcertificate_filters, sf...)
+}
+
+// AppendedCertificateFilters returns the list of values that were appended to the "certificate_filters" field in this mutation.
+func (m *DeploymentTargetMutation) AppendedCertificateFilters() ([]schema.CertificateFilter, bool) {
+	if len(m.appendcertificate_filters) == 0 {
+		return nil, false
+	}
+	return m.appendcertificate_filters, true
+}
+
+// ClearCertificateFilters clears the value of the "certificate_filters" field.
+func (m *DeploymentTargetMutation) ClearCertificateFilters() {
+	m.certificate_filters = nil
+	m.appendcertificate_filters = nil
+	m.clearedFields[deploymenttarget.FieldCertificateFilters] = struct{}{}
+}
+
+// CertificateFiltersCleared returns if the "certificate_filters" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) CertificateFiltersCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldCertificateFilters]
+	return ok
+}
+
+// ResetCertificateFilters resets all changes to the "certificate_filters" field.
+func (m *DeploymentTargetMutation) ResetCertificateFilters() {
+	m.certificate_filters = nil
+	m.appendcertificate_filters = nil
+	delete(m.clearedFields, deploymenttarget.FieldCertificateFilters)
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (m *DeploymentTargetMutation) SetRetryPolicy(rp *registry.RetryPolicy) {
+	m.retry_policy = &rp
+}
+
+// RetryPolicy returns the value of the "retry_policy" field in the mutation.
+func (m *DeploymentTargetMutation) RetryPolicy() (r *registry.RetryPolicy, exists bool) {
+	v := m.retry_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryPolicy returns the old "retry_policy" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldRetryPolicy(ctx context.Context) (v *registry.RetryPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryPolicy: %w", err)
+	}
+	return oldValue.RetryPolicy, nil
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (m *DeploymentTargetMutation) ClearRetryPolicy() {
+	m.retry_policy = nil
+	m.clearedFields[deploymenttarget.FieldRetryPolicy] = struct{}{}
+}
+
+// RetryPolicyCleared returns if the "retry_policy" field was cleared in this mutation.
+func (m *DeploymentTargetMutation) RetryPolicyCleared() bool {
+	_, ok := m.clearedFields[deploymenttarget.FieldRetryPolicy]
+	return ok
+}
+
+// ResetRetryPolicy resets all changes to the "retry_policy" field.
+func (m *DeploymentTargetMutation) ResetRetryPolicy() {
+	m.retry_policy = nil
+	delete(m.clearedFields, deploymenttarget.FieldRetryPolicy)
+}
+
+// SetRevocationPolicy sets the "revocation_policy" field.
+func (m *DeploymentTargetMutation) SetRevocationPolicy(dp deploymenttarget.RevocationPolicy) {
+	m.revocation_policy = &dp
+}
+
+// RevocationPolicy returns the value of the "revocation_policy" field in the mutation.
+func (m *DeploymentTargetMutation) RevocationPolicy() (r deploymenttarget.RevocationPolicy, exists bool) {
+	v := m.revocation_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevocationPolicy returns the old "revocation_policy" field's value of the DeploymentTarget entity.
+// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentTargetMutation) OldRevocationPolicy(ctx context.Context) (v deploymenttarget.RevocationPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevocationPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevocationPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevocationPolicy: %w", err)
+	}
+	return oldValue.RevocationPolicy, nil
+}
+
+// ResetRevocationPolicy resets all changes to the "revocation_policy" field.
+func (m *DeploymentTargetMutation) ResetRevocationPolicy() {
+	m.revocation_policy = nil
+}
+
+// AddConfigurationIDs adds the "configurations" edge to the TargetConfiguration entity by ids.
+func (m *DeploymentTargetMutation) AddConfigurationIDs(ids ...string) {
+	if m.configurations == nil {
+		m.configurations = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.configurations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConfigurations clears the "configurations" edge to the TargetConfiguration entity.
+func (m *DeploymentTargetMutation) ClearConfigurations() {
+	m.clearedconfigurations = true
+}
+
+// ConfigurationsCleared reports if the "configurations" edge to the TargetConfiguration entity was cleared.
+func (m *DeploymentTargetMutation) ConfigurationsCleared() bool {
+	return m.clearedconfigurations
+}
+
+// RemoveConfigurationIDs removes the "configurations" edge to the TargetConfiguration entity by IDs.
+func (m *DeploymentTargetMutation) RemoveConfigurationIDs(ids ...string) {
+	if m.removedconfigurations == nil {
+		m.removedconfigurations = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.configurations, ids[i])
+		m.removedconfigurations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConfigurations returns the removed IDs of the "configurations" edge to the TargetConfiguration entity.
+func (m *DeploymentTargetMutation) RemovedConfigurationsIDs() (ids []string) {
+	for id := range m.removedconfigurations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConfigurationsIDs returns the "configurations" edge IDs in the mutation.
+func (m *DeploymentTargetMutation) ConfigurationsIDs() (ids []string) {
+	for id := range m.configurations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConfigurations resets all changes to the "configurations" edge.
+func (m *DeploymentTargetMutation) ResetConfigurations() {
+	m.configurations = nil
+	m.clearedconfigurations = false
+	m.removedconfigurations = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the DeploymentJob entity by ids.
+func (m *DeploymentTargetMutation) AddJobIDs(ids ...string) {
+	if m.jobs == nil {
+		m.jobs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the DeploymentJob entity.
+func (m *DeploymentTargetMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the DeploymentJob entity was cleared.
+func (m *DeploymentTargetMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the DeploymentJob entity by IDs.
+func (m *DeploymentTargetMutation) RemoveJobIDs(ids ...string) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the DeploymentJob entity.
+func (m *DeploymentTargetMutation) RemovedJobsIDs() (ids []string) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *DeploymentTargetMutation) JobsIDs() (ids []string) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *DeploymentTargetMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// Where appends a list predicates to the DeploymentTargetMutation builder.
+func (m *DeploymentTargetMutation) Where(ps ...predicate.DeploymentTarget) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentTargetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentTargetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentTarget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentTargetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentTargetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentTarget).
+func (m *DeploymentTargetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentTargetMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_by != nil {
+		fields = append(fields, deploymenttarget.FieldCreateBy)
+	}
+	if m.update_by != nil {
+		fields = append(fields, deploymenttarget.FieldUpdateBy)
+	}
+	if m.create_time != nil {
+		fields = append(fields, deploymenttarget.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deploymenttarget.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, deploymenttarget.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, deploymenttarget.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, deploymenttarget.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, deploymenttarget.FieldDescription)
+	}
+	if m.auto_deploy_on_renewal != nil {
+		fields = append(fields, deploymenttarget.FieldAutoDeployOnRenewal)
+	}
+	if m.certificate_filters != nil {
+		fields = append(fields, deploymenttarget.FieldCertificateFilters)
+	}
+	if m.retry_policy != nil {
+		fields = append(fields, deploymenttarget.FieldRetryPolicy)
+	}
+	if m.revocation_policy != nil {
+		fields = append(fields, deploymenttarget.FieldRevocationPolicy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentTargetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		return m.CreateBy()
+	case deploymenttarget.FieldUpdateBy:
+		return m.UpdateBy()
+	case deploymenttarget.FieldCreateTime:
+		return m.CreateTime()
+	case deploymenttarget.FieldUpdateTime:
+		return m.UpdateTime()
+	case deploymenttarget.FieldDeleteTime:
+		return m.DeleteTime()
+	case deploymenttarget.FieldTenantID:
+		return m.TenantID()
+	case deploymenttarget.FieldName:
+		return m.Name()
+	case deploymenttarget.FieldDescription:
+		return m.Description()
+	case deploymenttarget.FieldAutoDeployOnRenewal:
+		return m.AutoDeployOnRenewal()
+	case deploymenttarget.FieldCertificateFilters:
+		return m.CertificateFilters()
+	case deploymenttarget.FieldRetryPolicy:
+		return m.RetryPolicy()
+	case deploymenttarget.FieldRevocationPolicy:
+		return m.RevocationPolicy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentTargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case deploymenttarget.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case deploymenttarget.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deploymenttarget.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case deploymenttarget.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case deploymenttarget.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case deploymenttarget.FieldName:
+		return m.OldName(ctx)
+	case deploymenttarget.FieldDescription:
+		return m.OldDescription(ctx)
+	case deploymenttarget.FieldAutoDeployOnRenewal:
+		return m.OldAutoDeployOnRenewal(ctx)
+	case deploymenttarget.FieldCertificateFilters:
+		return m.OldCertificateFilters(ctx)
+	case deploymenttarget.FieldRetryPolicy:
+		return m.OldRetryPolicy(ctx)
+	case deploymenttarget.FieldRevocationPolicy:
+		return m.OldRevocationPolicy(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentTarget field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentTargetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case deploymenttarget.FieldUpdateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case deploymenttarget.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deploymenttarget.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case deploymenttarget.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case deploymenttarget.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case deploymenttarget.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case deploymenttarget.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case deploymenttarget.FieldAutoDeployOnRenewal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoDeployOnRenewal(v)
+		return nil
+	case deploymenttarget.FieldCertificateFilters:
+		v, ok := value.([]schema.CertificateFilter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateFilters(v)
+		return nil
+	case deploymenttarget.FieldRetryPolicy:
+		v, ok := value.(*registry.RetryPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryPolicy(v)
+		return nil
+	case deploymenttarget.FieldRevocationPolicy:
+		v, ok := value.(deploymenttarget.RevocationPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevocationPolicy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentTarget field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentTargetMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, deploymenttarget.FieldCreateBy)
+	}
+	if m.addupdate_by != nil {
+		fields = append(fields, deploymenttarget.FieldUpdateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, deploymenttarget.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentTargetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		return m.AddedCreateBy()
+	case deploymenttarget.FieldUpdateBy:
+		return m.AddedUpdateBy()
+	case deploymenttarget.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentTargetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case deploymenttarget.FieldUpdateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdateBy(v)
+		return nil
+	case deploymenttarget.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentTarget numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentTargetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymenttarget.FieldCreateBy) {
+		fields = append(fields, deploymenttarget.FieldCreateBy)
+	}
+	if m.FieldCleared(deploymenttarget.FieldUpdateBy) {
+		fields = append(fields, deploymenttarget.FieldUpdateBy)
+	}
+	if m.FieldCleared(deploymenttarget.FieldCreateTime) {
+		fields = append(fields, deploymenttarget.FieldCreateTime)
+	}
+	if m.FieldCleared(deploymenttarget.FieldUpdateTime) {
+		fields = append(fields, deploymenttarget.FieldUpdateTime)
+	}
+	if m.FieldCleared(deploymenttarget.FieldDeleteTime) {
+		fields = append(fields, deploymenttarget.FieldDeleteTime)
+	}
+	if m.FieldCleared(deploymenttarget.FieldTenantID) {
+		fields = append(fields, deploymenttarget.FieldTenantID)
+	}
+	if m.FieldCleared(deploymenttarget.FieldDescription) {
+		fields = append(fields, deploymenttarget.FieldDescription)
+	}
+	if m.FieldCleared(deploymenttarget.FieldCertificateFilters) {
+		fields = append(fields, deploymenttarget.FieldCertificateFilters)
+	}
+	if m.FieldCleared(deploymenttarget.FieldRetryPolicy) {
+		fields = append(fields, deploymenttarget.FieldRetryPolicy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentTargetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentTargetMutation) ClearField(name string) error {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case deploymenttarget.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case deploymenttarget.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case deploymenttarget.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case deploymenttarget.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case deploymenttarget.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case deploymenttarget.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case deploymenttarget.FieldCertificateFilters:
+		m.ClearCertificateFilters()
+		return nil
+	case deploymenttarget.FieldRetryPolicy:
+		m.ClearRetryPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentTarget nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentTargetMutation) ResetField(name string) error {
+	switch name {
+	case deploymenttarget.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case deploymenttarget.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case deploymenttarget.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deploymenttarget.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case deploymenttarget.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case deploymenttarget.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case deploymenttarget.FieldName:
+		m.ResetName()
+		return nil
+	case deploymenttarget.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case deploymenttarget.FieldAutoDeployOnRenewal:
+		m.ResetAutoDeployOnRenewal()
+		return nil
+	case deploymenttarget.FieldCertificateFilters:
+		m.ResetCertificateFilters()
+		return nil
+	case deploymenttarget.FieldRetryPolicy:
+		m.ResetRetryPolicy()
+		return nil
+	case deploymenttarget.FieldRevocationPolicy:
+		m.ResetRevocationPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentTarget field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentTargetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.configurations != nil {
+		edges = append(edges, deploymenttarget.EdgeConfigurations)
+	}
+	if m.jobs != nil {
+		edges = append(edges, deploymenttarget.EdgeJobs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentTargetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymenttarget.EdgeConfigurations:
+		ids := make([]ent.Value, 0, len(m.configurations))
+		for id := range m.configurations {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymenttarget.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentTargetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedconfigurations != nil {
+		edges = append(edges, deploymenttarget.EdgeConfigurations)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, deploymenttarget.EdgeJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentTargetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deploymenttarget.EdgeConfigurations:
+		ids := make([]ent.Value, 0, len(m.removedconfigurations))
+		for id := range m.removedconfigurations {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymenttarget.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentTargetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedconfigurations {
+		edges = append(edges, deploymenttarget.EdgeConfigurations)
+	}
+	if m.clearedjobs {
+		edges = append(edges, deploymenttarget.EdgeJobs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentTargetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymenttarget.EdgeConfigurations:
+		return m.clearedconfigurations
+	case deploymenttarget.EdgeJobs:
+		return m.clearedjobs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentTargetMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeploymentTarget unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentTargetMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymenttarget.EdgeConfigurations:
+		m.ResetConfigurations()
+		return nil
+	case deploymenttarget.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentTarget edge %s", name)
+}
+
+// DriftEventMutation represents an operation that mutates the DriftEvent nodes in the graph.
+type DriftEventMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint32
+	create_time             *time.Time
+	update_time             *time.Time
+	delete_time             *time.Time
+	tenant_id               *uint32
+	addtenant_id            *int32
+	target_configuration_id *string
+	job_id                  *string
+	certificate_id          *string
+	certificate_serial      *string
+	source                  *driftevent.Source
+	message                 *string
+	observed_serial         *string
+	details                 *map[string]interface{}
+	remediation_job_id      *string
+	resolved_at             *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*DriftEvent, error)
+	predicates              []predicate.DriftEvent
+}
+
+var _ ent.Mutation = (*DriftEventMutation)(nil)
+
+// drifteventOption allows management of the mutation configuration using functional options.
+type drifteventOption func(*DriftEventMutation)
+
+// newDriftEventMutation creates new mutation for the DriftEvent entity.
+func newDriftEventMutation(c config, op Op, opts ...drifteventOption) *DriftEventMutation {
+	m := &DriftEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDriftEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDriftEventID sets the ID field of the mutation.
+func withDriftEventID(id uint32) drifteventOption {
+	return func(m *DriftEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DriftEvent
+		)
+		m.oldValue = func(ctx context.Context) (*DriftEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DriftEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDriftEvent sets the old DriftEvent of the mutation.
+func withDriftEvent(node *DriftEvent) drifteventOption {
+	return func(m *DriftEventMutation) {
+		m.oldValue = func(context.Context) (*DriftEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DriftEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DriftEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DriftEvent entities.
+func (m *DriftEventMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DriftEventMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DriftEventMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DriftEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DriftEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DriftEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *DriftEventMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[driftevent.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *DriftEventMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DriftEventMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, driftevent.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DriftEventMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DriftEventMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *DriftEventMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[driftevent.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *DriftEventMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DriftEventMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, driftevent.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DriftEventMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DriftEventMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DriftEventMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[driftevent.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DriftEventMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DriftEventMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, driftevent.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DriftEventMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DriftEventMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *DriftEventMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DriftEventMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *DriftEventMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[driftevent.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *DriftEventMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DriftEventMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, driftevent.FieldTenantID)
+}
+
+// SetTargetConfigurationID sets the "target_configuration_id" field.
+func (m *DriftEventMutation) SetTargetConfigurationID(s string) {
+	m.target_configuration_id = &s
+}
+
+// TargetConfigurationID returns the value of the "target_configuration_id" field in the mutation.
+func (m *DriftEventMutation) TargetConfigurationID() (r string, exists bool) {
+	v := m.target_configuration_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetConfigurationID returns the old "target_configuration_id" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldTargetConfigurationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetConfigurationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetConfigurationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetConfigurationID: %w", err)
+	}
+	return oldValue.TargetConfigurationID, nil
+}
+
+// ResetTargetConfigurationID resets all changes to the "target_configuration_id" field.
+func (m *DriftEventMutation) ResetTargetConfigurationID() {
+	m.target_configuration_id = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *DriftEventMutation) SetJobID(s string) {
+	m.job_id = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *DriftEventMutation) JobID() (r string, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *DriftEventMutation) ResetJobID() {
+	m.job_id = nil
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *DriftEventMutation) SetCertificateID(s string) {
+	m.certificate_id = &s
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *DriftEventMutation) CertificateID() (r string, exists bool) {
+	v := m.certificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldCertificateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *DriftEventMutation) ResetCertificateID() {
+	m.certificate_id = nil
+}
+
+// SetCertificateSerial sets the "certificate_serial" field.
+func (m *DriftEventMutation) SetCertificateSerial(s string) {
+	m.certificate_serial = &s
+}
+
+// CertificateSerial returns the value of the "certificate_serial" field in the mutation.
+func (m *DriftEventMutation) CertificateSerial() (r string, exists bool) {
+	v := m.certificate_serial
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateSerial returns the old "certificate_serial" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldCertificateSerial(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateSerial is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateSerial requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateSerial: %w", err)
+	}
+	return oldValue.CertificateSerial, nil
+}
+
+// ClearCertificateSerial clears the value of the "certificate_serial" field.
+func (m *DriftEventMutation) ClearCertificateSerial() {
+	m.certificate_serial = nil
+	m.clearedFields[driftevent.FieldCertificateSerial] = struct{}{}
+}
+
+// CertificateSerialCleared returns if the "certificate_serial" field was cleared in this mutation.
+func (m *DriftEventMutation) CertificateSerialCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldCertificateSerial]
+	return ok
+}
+
+// ResetCertificateSerial resets all changes to the "certificate_serial" field.
+func (m *DriftEventMutation) ResetCertificateSerial() {
+	m.certificate_serial = nil
+	delete(m.clearedFields, driftevent.FieldCertificateSerial)
+}
+
+// SetSource sets the "source" field.
+func (m *DriftEventMutation) SetSource(d driftevent.Source) {
+	m.source = &d
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *DriftEventMutation) Source() (r driftevent.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldSource(ctx context.Context) (v driftevent.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *DriftEventMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *DriftEventMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *DriftEventMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *DriftEventMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[driftevent.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *DriftEventMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *DriftEventMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, driftevent.FieldMessage)
+}
+
+// SetObservedSerial sets the "observed_serial" field.
+func (m *DriftEventMutation) SetObservedSerial(s string) {
+	m.observed_serial = &s
+}
+
+// ObservedSerial returns the value of the "observed_serial" field in the mutation.
+func (m *DriftEventMutation) ObservedSerial() (r string, exists bool) {
+	v := m.observed_serial
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObservedSerial returns the old "observed_serial" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldObservedSerial(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObservedSerial is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObservedSerial requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObservedSerial: %w", err)
+	}
+	return oldValue.ObservedSerial, nil
+}
+
+// ClearObservedSerial clears the value of the "observed_serial" field.
+func (m *DriftEventMutation) ClearObservedSerial() {
+	m.observed_serial = nil
+	m.clearedFields[driftevent.FieldObservedSerial] = struct{}{}
+}
+
+// ObservedSerialCleared returns if the "observed_serial" field was cleared in this mutation.
+func (m *DriftEventMutation) ObservedSerialCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldObservedSerial]
+	return ok
+}
+
+// ResetObservedSerial resets all changes to the "observed_serial" field.
+func (m *DriftEventMutation) ResetObservedSerial() {
+	m.observed_serial = nil
+	delete(m.clearedFields, driftevent.FieldObservedSerial)
+}
+
+// SetDetails sets the "details" field.
+func (m *DriftEventMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *DriftEventMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *DriftEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[driftevent.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *DriftEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *DriftEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, driftevent.FieldDetails)
+}
+
+// SetRemediationJobID sets the "remediation_job_id" field.
+func (m *DriftEventMutation) SetRemediationJobID(s string) {
+	m.remediation_job_id = &s
+}
+
+// RemediationJobID returns the value of the "remediation_job_id" field in the mutation.
+func (m *DriftEventMutation) RemediationJobID() (r string, exists bool) {
+	v := m.remediation_job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemediationJobID returns the old "remediation_job_id" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldRemediationJobID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemediationJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemediationJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemediationJobID: %w", err)
+	}
+	return oldValue.RemediationJobID, nil
+}
+
+// ClearRemediationJobID clears the value of the "remediation_job_id" field.
+func (m *DriftEventMutation) ClearRemediationJobID() {
+	m.remediation_job_id = nil
+	m.clearedFields[driftevent.FieldRemediationJobID] = struct{}{}
+}
+
+// RemediationJobIDCleared returns if the "remediation_job_id" field was cleared in this mutation.
+func (m *DriftEventMutation) RemediationJobIDCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldRemediationJobID]
+	return ok
+}
+
+// ResetRemediationJobID resets all changes to the "remediation_job_id" field.
+func (m *DriftEventMutation) ResetRemediationJobID() {
+	m.remediation_job_id = nil
+	delete(m.clearedFields, driftevent.FieldRemediationJobID)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *DriftEventMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *DriftEventMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the DriftEvent entity.
+// If the DriftEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriftEventMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *DriftEventMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[driftevent.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *DriftEventMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[driftevent.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *DriftEventMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, driftevent.FieldResolvedAt)
+}
+
+// Where appends a list predicates to the DriftEventMutation builder.
+func (m *DriftEventMutation) Where(ps ...predicate.DriftEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DriftEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DriftEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DriftEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DriftEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DriftEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DriftEvent).
+func (m *DriftEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DriftEventMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, driftevent.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, driftevent.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, driftevent.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, driftevent.FieldTenantID)
+	}
+	if m.target_configuration_id != nil {
+		fields = append(fields, driftevent.FieldTargetConfigurationID)
+	}
+	if m.job_id != nil {
+		fields = append(fields, driftevent.FieldJobID)
+	}
+	if m.certificate_id != nil {
+		fields = append(fields, driftevent.FieldCertificateID)
+	}
+	if m.certificate_serial != nil {
+		fields = append(fields, driftevent.FieldCertificateSerial)
+	}
+	if m.source != nil {
+		fields = append(fields, driftevent.FieldSource)
+	}
+	if m.message != nil {
+		fields = append(fields, driftevent.FieldMessage)
+	}
+	if m.observed_serial != nil {
+		fields = append(fields, driftevent.FieldObservedSerial)
+	}
+	if m.details != nil {
+		fields = append(fields, driftevent.FieldDetails)
+	}
+	if m.remediation_job_id != nil {
+		fields = append(fields, driftevent.FieldRemediationJobID)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, driftevent.FieldResolvedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DriftEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case driftevent.FieldCreateTime:
+		return m.CreateTime()
+	case driftevent.FieldUpdateTime:
+		return m.UpdateTime()
+	case driftevent.FieldDeleteTime:
+		return m.DeleteTime()
+	case driftevent.FieldTenantID:
+		return m.TenantID()
+	case driftevent.FieldTargetConfigurationID:
+		return m.TargetConfigurationID()
+	case driftevent.FieldJobID:
+		return m.JobID()
+	case driftevent.FieldCertificateID:
+		return m.CertificateID()
+	case driftevent.FieldCertificateSerial:
+		return m.CertificateSerial()
+	case driftevent.FieldSource:
+		return m.Source()
+	case driftevent.FieldMessage:
+		return m.Message()
+	case driftevent.FieldObservedSerial:
+		return m.ObservedSerial()
+	case driftevent.FieldDetails:
+		return m.Details()
+	case driftevent.FieldRemediationJobID:
+		return m.RemediationJobID()
+	case driftevent.FieldResolvedAt:
+		return m.ResolvedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DriftEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case driftevent.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case driftevent.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case driftevent.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case driftevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case driftevent.FieldTargetConfigurationID:
+		return m.OldTargetConfigurationID(ctx)
+	case driftevent.FieldJobID:
+		return m.OldJobID(ctx)
+	case driftevent.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case driftevent.FieldCertificateSerial:
+		return m.OldCertificateSerial(ctx)
+	case driftevent.FieldSource:
+		return m.OldSource(ctx)
+	case driftevent.FieldMessage:
+		return m.OldMessage(ctx)
+	case driftevent.FieldObservedSerial:
+		return m.OldObservedSerial(ctx)
+	case driftevent.FieldDetails:
+		return m.OldDetails(ctx)
+	case driftevent.FieldRemediationJobID:
+		return m.OldRemediationJobID(ctx)
+	case driftevent.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DriftEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriftEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case driftevent.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case driftevent.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case driftevent.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case driftevent.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case driftevent.FieldTargetConfigurationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetConfigurationID(v)
+		return nil
+	case driftevent.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case driftevent.FieldCertificateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case driftevent.FieldCertificateSerial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateSerial(v)
+		return nil
+	case driftevent.FieldSource:
+		v, ok := value.(driftevent.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case driftevent.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case driftevent.FieldObservedSerial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObservedSerial(v)
+		return nil
+	case driftevent.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case driftevent.FieldRemediationJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemediationJobID(v)
+		return nil
+	case driftevent.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DriftEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DriftEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, driftevent.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DriftEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case driftevent.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriftEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case driftevent.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DriftEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DriftEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(driftevent.FieldCreateTime) {
+		fields = append(fields, driftevent.FieldCreateTime)
+	}
+	if m.FieldCleared(driftevent.FieldUpdateTime) {
+		fields = append(fields, driftevent.FieldUpdateTime)
+	}
+	if m.FieldCleared(driftevent.FieldDeleteTime) {
+		fields = append(fields, driftevent.FieldDeleteTime)
+	}
+	if m.FieldCleared(driftevent.FieldTenantID) {
+		fields = append(fields, driftevent.FieldTenantID)
+	}
+	if m.FieldCleared(driftevent.FieldCertificateSerial) {
+		fields = append(fields, driftevent.FieldCertificateSerial)
+	}
+	if m.FieldCleared(driftevent.FieldMessage) {
+		fields = append(fields, driftevent.FieldMessage)
+	}
+	if m.FieldCleared(driftevent.FieldObservedSerial) {
+		fields = append(fields, driftevent.FieldObservedSerial)
+	}
+	if m.FieldCleared(driftevent.FieldDetails) {
+		fields = append(fields, driftevent.FieldDetails)
+	}
+	if m.FieldCleared(driftevent.FieldRemediationJobID) {
+		fields = append(fields, driftevent.FieldRemediationJobID)
+	}
+	if m.FieldCleared(driftevent.FieldResolvedAt) {
+		fields = append(fields, driftevent.FieldResolvedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DriftEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DriftEventMutation) ClearField(name string) error {
+	switch name {
+	case driftevent.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case driftevent.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case driftevent.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case driftevent.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case driftevent.FieldCertificateSerial:
+		m.ClearCertificateSerial()
+		return nil
+	case driftevent.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case driftevent.FieldObservedSerial:
+		m.ClearObservedSerial()
+		return nil
+	case driftevent.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case driftevent.FieldRemediationJobID:
+		m.ClearRemediationJobID()
+		return nil
+	case driftevent.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DriftEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DriftEventMutation) ResetField(name string) error {
+	switch name {
+	case driftevent.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case driftevent.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case driftevent.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case driftevent.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case driftevent.FieldTargetConfigurationID:
+		m.ResetTargetConfigurationID()
+		return nil
+	case driftevent.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case driftevent.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case driftevent.FieldCertificateSerial:
+		m.ResetCertificateSerial()
+		return nil
+	case driftevent.FieldSource:
+		m.ResetSource()
+		return nil
+	case driftevent.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case driftevent.FieldObservedSerial:
+		m.ResetObservedSerial()
+		return nil
+	case driftevent.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case driftevent.FieldRemediationJobID:
+		m.ResetRemediationJobID()
+		return nil
+	case driftevent.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DriftEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DriftEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DriftEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DriftEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DriftEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DriftEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DriftEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DriftEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DriftEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DriftEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DriftEvent edge %s", name)
+}
+
+// ExternalCertificateMutation represents an operation that mutates the ExternalCertificate nodes in the graph.
+type ExternalCertificateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint32
+	create_time        *time.Time
+	update_time        *time.Time
+	delete_time        *time.Time
+	tenant_id          *uint32
+	addtenant_id       *int32
+	certificate_id     *string
+	source             *string
+	serial_number      *string
+	common_name        *string
+	sans               *[]string
+	appendsans         []string
+	certificate_pem    *string
+	ca_certificate_pem *string
+	private_key_ref    *string
+	expires_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ExternalCertificate, error)
+	predicates         []predicate.ExternalCertificate
+}
+
+var _ ent.Mutation = (*ExternalCertificateMutation)(nil)
+
+// externalcertificateOption allows management of the mutation configuration using functional options.
+type externalcertificateOption func(*ExternalCertificateMutation)
+
+// newExternalCertificateMutation creates new mutation for the ExternalCertificate entity.
+func newExternalCertificateMutation(c config, op Op, opts ...externalcertificateOption) *ExternalCertificateMutation {
+	m := &ExternalCertificateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExternalCertificate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExternalCertificateID sets the ID field of the mutation.
+func withExternalCertificateID(id uint32) externalcertificateOption {
+	return func(m *ExternalCertificateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExternalCertificate
+		)
+		m.oldValue = func(ctx context.Context) (*ExternalCertificate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExternalCertificate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExternalCertificate sets the old ExternalCertificate of the mutation.
+func withExternalCertificate(node *ExternalCertificate) externalcertificateOption {
+	return func(m *ExternalCertificateMutation) {
+		m.oldValue = func(context.Context) (*ExternalCertificate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExternalCertificateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExternalCertificateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExternalCertificate entities.
+func (m *ExternalCertificateMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExternalCertificateMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExternalCertificateMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExternalCertificate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ExternalCertificateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ExternalCertificateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *ExternalCertificateMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[externalcertificate.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ExternalCertificateMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, externalcertificate.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ExternalCertificateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ExternalCertificateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *ExternalCertificateMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[externalcertificate.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ExternalCertificateMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, externalcertificate.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *ExternalCertificateMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *ExternalCertificateMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *ExternalCertificateMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[externalcertificate.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *ExternalCertificateMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, externalcertificate.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ExternalCertificateMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ExternalCertificateMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *ExternalCertificateMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *ExternalCertificateMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ExternalCertificateMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[externalcertificate.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ExternalCertificateMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, externalcertificate.FieldTenantID)
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *ExternalCertificateMutation) SetCertificateID(s string) {
+	m.certificate_id = &s
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *ExternalCertificateMutation) CertificateID() (r string, exists bool) {
+	v := m.certificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldCertificateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *ExternalCertificateMutation) ResetCertificateID() {
+	m.certificate_id = nil
+}
+
+// SetSource sets the "source" field.
+func (m *ExternalCertificateMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ExternalCertificateMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ExternalCertificateMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (m *ExternalCertificateMutation) SetSerialNumber(s string) {
+	m.serial_number = &s
+}
+
+// SerialNumber returns the value of the "serial_number" field in the mutation.
+func (m *ExternalCertificateMutation) SerialNumber() (r string, exists bool) {
+	v := m.serial_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSerialNumber returns the old "serial_number" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
 	}
-	if m.client_organization != nil {
-		fields = append(fields, auditlog.FieldClientOrganization)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
 	}
-	if m.client_serial_number != nil {
-		fields = append(fields, auditlog.FieldClientSerialNumber)
+	return oldValue.SerialNumber, nil
+}
+
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (m *ExternalCertificateMutation) ClearSerialNumber() {
+	m.serial_number = nil
+	m.clearedFields[externalcertificate.FieldSerialNumber] = struct{}{}
+}
+
+// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) SerialNumberCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldSerialNumber]
+	return ok
+}
+
+// ResetSerialNumber resets all changes to the "serial_number" field.
+func (m *ExternalCertificateMutation) ResetSerialNumber() {
+	m.serial_number = nil
+	delete(m.clearedFields, externalcertificate.FieldSerialNumber)
+}
+
+// SetCommonName sets the "common_name" field.
+func (m *ExternalCertificateMutation) SetCommonName(s string) {
+	m.common_name = &s
+}
+
+// CommonName returns the value of the "common_name" field in the mutation.
+func (m *ExternalCertificateMutation) CommonName() (r string, exists bool) {
+	v := m.common_name
+	if v == nil {
+		return
 	}
-	if m.is_authenticated != nil {
-		fields = append(fields, auditlog.FieldIsAuthenticated)
+	return *v, true
+}
+
+// OldCommonName returns the old "common_name" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldCommonName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommonName is only allowed on UpdateOne operations")
 	}
-	if m.success != nil {
-		fields = append(fields, auditlog.FieldSuccess)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommonName requires an ID field in the mutation")
 	}
-	if m.error_code != nil {
-		fields = append(fields, auditlog.FieldErrorCode)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommonName: %w", err)
 	}
-	if m.error_message != nil {
-		fields = append(fields, auditlog.FieldErrorMessage)
+	return oldValue.CommonName, nil
+}
+
+// ClearCommonName clears the value of the "common_name" field.
+func (m *ExternalCertificateMutation) ClearCommonName() {
+	m.common_name = nil
+	m.clearedFields[externalcertificate.FieldCommonName] = struct{}{}
+}
+
+// CommonNameCleared returns if the "common_name" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) CommonNameCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldCommonName]
+	return ok
+}
+
+// ResetCommonName resets all changes to the "common_name" field.
+func (m *ExternalCertificateMutation) ResetCommonName() {
+	m.common_name = nil
+	delete(m.clearedFields, externalcertificate.FieldCommonName)
+}
+
+// SetSans sets the "sans" field.
+func (m *ExternalCertificateMutation) SetSans(s []string) {
+	m.sans = &s
+	m.appendsans = nil
+}
+
+// Sans returns the value of the "sans" field in the mutation.
+func (m *ExternalCertificateMutation) Sans() (r []string, exists bool) {
+	v := m.sans
+	if v == nil {
+		return
 	}
-	if m.latency_ms != nil {
-		fields = append(fields, auditlog.FieldLatencyMs)
+	return *v, true
+}
+
+// OldSans returns the old "sans" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldSans(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSans is only allowed on UpdateOne operations")
 	}
-	if m.peer_address != nil {
-		fields = append(fields, auditlog.FieldPeerAddress)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSans requires an ID field in the mutation")
 	}
-	if m.geo_location != nil {
-		fields = append(fields, auditlog.FieldGeoLocation)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSans: %w", err)
 	}
-	if m.log_hash != nil {
-		fields = append(fields, auditlog.FieldLogHash)
+	return oldValue.Sans, nil
+}
+
+// AppendSans adds s to the "sans" field.
+func (m *ExternalCertificateMutation) AppendSans(s []string) {
+	m.appendsans = append(m.appendsans, s...)
+}
+
+// AppendedSans returns the list of values that were appended to the "sans" field in this mutation.
+func (m *ExternalCertificateMutation) AppendedSans() ([]string, bool) {
+	if len(m.appendsans) == 0 {
+		return nil, false
 	}
-	if m.signature != nil {
-		fields = append(fields, auditlog.FieldSignature)
+	return m.appendsans, true
+}
+
+// ClearSans clears the value of the "sans" field.
+func (m *ExternalCertificateMutation) ClearSans() {
+	m.sans = nil
+	m.appendsans = nil
+	m.clearedFields[externalcertificate.FieldSans] = struct{}{}
+}
+
+// SansCleared returns if the "sans" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) SansCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldSans]
+	return ok
+}
+
+// ResetSans resets all changes to the "sans" field.
+func (m *ExternalCertificateMutation) ResetSans() {
+	m.sans = nil
+	m.appendsans = nil
+	delete(m.clearedFields, externalcertificate.FieldSans)
+}
+
+// SetCertificatePem sets the "certificate_pem" field.
+func (m *ExternalCertificateMutation) SetCertificatePem(s string) {
+	m.certificate_pem = &s
+}
+
+// CertificatePem returns the value of the "certificate_pem" field in the mutation.
+func (m *ExternalCertificateMutation) CertificatePem() (r string, exists bool) {
+	v := m.certificate_pem
+	if v == nil {
+		return
 	}
-	if m.metadata != nil {
-		fields = append(fields, auditlog.FieldMetadata)
+	return *v, true
+}
+
+// OldCertificatePem returns the old "certificate_pem" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldCertificatePem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificatePem is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificatePem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificatePem: %w", err)
+	}
+	return oldValue.CertificatePem, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case auditlog.FieldCreateTime:
-		return m.CreateTime()
-	case auditlog.FieldUpdateTime:
-		return m.UpdateTime()
-	case auditlog.FieldDeleteTime:
-		return m.DeleteTime()
-	case auditlog.FieldTenantID:
-		return m.TenantID()
-	case auditlog.FieldAuditID:
-		return m.AuditID()
-	case auditlog.FieldRequestID:
-		return m.RequestID()
-	case auditlog.FieldOperation:
-		return m.Operation()
-	case auditlog.FieldServiceName:
-		return m.ServiceName()
-	case auditlog.FieldClientID:
-		return m.ClientID()
-	case auditlog.FieldClientCommonName:
-		return m.ClientCommonName()
-	case auditlog.FieldClientOrganization:
-		return m.ClientOrganization()
-	case auditlog.FieldClientSerialNumber:
-		return m.ClientSerialNumber()
-	case auditlog.FieldIsAuthenticated:
-		return m.IsAuthenticated()
-	case auditlog.FieldSuccess:
-		return m.Success()
-	case auditlog.FieldErrorCode:
-		return m.ErrorCode()
-	case auditlog.FieldErrorMessage:
-		return m.ErrorMessage()
-	case auditlog.FieldLatencyMs:
-		return m.LatencyMs()
-	case auditlog.FieldPeerAddress:
-		return m.PeerAddress()
-	case auditlog.FieldGeoLocation:
-		return m.GeoLocation()
-	case auditlog.FieldLogHash:
-		return m.LogHash()
-	case auditlog.FieldSignature:
-		return m.Signature()
-	case auditlog.FieldMetadata:
-		return m.Metadata()
+// ResetCertificatePem resets all changes to the "certificate_pem" field.
+func (m *ExternalCertificateMutation) ResetCertificatePem() {
+	m.certificate_pem = nil
+}
+
+// SetCaCertificatePem sets the "ca_certificate_pem" field.
+func (m *ExternalCertificateMutation) SetCaCertificatePem(s string) {
+	m.ca_certificate_pem = &s
+}
+
+// CaCertificatePem returns the value of the "ca_certificate_pem" field in the mutation.
+func (m *ExternalCertificateMutation) CaCertificatePem() (r string, exists bool) {
+	v := m.ca_certificate_pem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaCertificatePem returns the old "ca_certificate_pem" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldCaCertificatePem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaCertificatePem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaCertificatePem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaCertificatePem: %w", err)
+	}
+	return oldValue.CaCertificatePem, nil
+}
+
+// ClearCaCertificatePem clears the value of the "ca_certificate_pem" field.
+func (m *ExternalCertificateMutation) ClearCaCertificatePem() {
+	m.ca_certificate_pem = nil
+	m.clearedFields[externalcertificate.FieldCaCertificatePem] = struct{}{}
+}
+
+// CaCertificatePemCleared returns if the "ca_certificate_pem" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) CaCertificatePemCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldCaCertificatePem]
+	return ok
+}
+
+// ResetCaCertificatePem resets all changes to the "ca_certificate_pem" field.
+func (m *ExternalCertificateMutation) ResetCaCertificatePem() {
+	m.ca_certificate_pem = nil
+	delete(m.clearedFields, externalcertificate.FieldCaCertificatePem)
+}
+
+// SetPrivateKeyRef sets the "private_key_ref" field.
+func (m *ExternalCertificateMutation) SetPrivateKeyRef(s string) {
+	m.private_key_ref = &s
+}
+
+// PrivateKeyRef returns the value of the "private_key_ref" field in the mutation.
+func (m *ExternalCertificateMutation) PrivateKeyRef() (r string, exists bool) {
+	v := m.private_key_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKeyRef returns the old "private_key_ref" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldPrivateKeyRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKeyRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKeyRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKeyRef: %w", err)
+	}
+	return oldValue.PrivateKeyRef, nil
+}
+
+// ClearPrivateKeyRef clears the value of the "private_key_ref" field.
+func (m *ExternalCertificateMutation) ClearPrivateKeyRef() {
+	m.private_key_ref = nil
+	m.clearedFields[externalcertificate.FieldPrivateKeyRef] = struct{}{}
+}
+
+// PrivateKeyRefCleared returns if the "private_key_ref" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) PrivateKeyRefCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldPrivateKeyRef]
+	return ok
+}
+
+// ResetPrivateKeyRef resets all changes to the "private_key_ref" field.
+func (m *ExternalCertificateMutation) ResetPrivateKeyRef() {
+	m.private_key_ref = nil
+	delete(m.clearedFields, externalcertificate.FieldPrivateKeyRef)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ExternalCertificateMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ExternalCertificateMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ExternalCertificate entity.
+// If the ExternalCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalCertificateMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ExternalCertificateMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[externalcertificate.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ExternalCertificateMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[externalcertificate.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ExternalCertificateMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, externalcertificate.FieldExpiresAt)
+}
+
+// Where appends a list predicates to the ExternalCertificateMutation builder.
+func (m *ExternalCertificateMutation) Where(ps ...predicate.ExternalCertificate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExternalCertificateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExternalCertificateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExternalCertificate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExternalCertificateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExternalCertificateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExternalCertificate).
+func (m *ExternalCertificateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExternalCertificateMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, externalcertificate.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, externalcertificate.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, externalcertificate.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, externalcertificate.FieldTenantID)
+	}
+	if m.certificate_id != nil {
+		fields = append(fields, externalcertificate.FieldCertificateID)
+	}
+	if m.source != nil {
+		fields = append(fields, externalcertificate.FieldSource)
+	}
+	if m.serial_number != nil {
+		fields = append(fields, externalcertificate.FieldSerialNumber)
+	}
+	if m.common_name != nil {
+		fields = append(fields, externalcertificate.FieldCommonName)
+	}
+	if m.sans != nil {
+		fields = append(fields, externalcertificate.FieldSans)
+	}
+	if m.certificate_pem != nil {
+		fields = append(fields, externalcertificate.FieldCertificatePem)
+	}
+	if m.ca_certificate_pem != nil {
+		fields = append(fields, externalcertificate.FieldCaCertificatePem)
+	}
+	if m.private_key_ref != nil {
+		fields = append(fields, externalcertificate.FieldPrivateKeyRef)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, externalcertificate.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExternalCertificateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case externalcertificate.FieldCreateTime:
+		return m.CreateTime()
+	case externalcertificate.FieldUpdateTime:
+		return m.UpdateTime()
+	case externalcertificate.FieldDeleteTime:
+		return m.DeleteTime()
+	case externalcertificate.FieldTenantID:
+		return m.TenantID()
+	case externalcertificate.FieldCertificateID:
+		return m.CertificateID()
+	case externalcertificate.FieldSource:
+		return m.Source()
+	case externalcertificate.FieldSerialNumber:
+		return m.SerialNumber()
+	case externalcertificate.FieldCommonName:
+		return m.CommonName()
+	case externalcertificate.FieldSans:
+		return m.Sans()
+	case externalcertificate.FieldCertificatePem:
+		return m.CertificatePem()
+	case externalcertificate.FieldCaCertificatePem:
+		return m.CaCertificatePem()
+	case externalcertificate.FieldPrivateKeyRef:
+		return m.PrivateKeyRef()
+	case externalcertificate.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -1400,231 +13757,144 @@ func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExternalCertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case externalcertificate.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case auditlog.FieldUpdateTime:
+	case externalcertificate.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case auditlog.FieldDeleteTime:
+	case externalcertificate.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case auditlog.FieldTenantID:
+	case externalcertificate.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case auditlog.FieldAuditID:
-		return m.OldAuditID(ctx)
-	case auditlog.FieldRequestID:
-		return m.OldRequestID(ctx)
-	case auditlog.FieldOperation:
-		return m.OldOperation(ctx)
-	case auditlog.FieldServiceName:
-		return m.OldServiceName(ctx)
-	case auditlog.FieldClientID:
-		return m.OldClientID(ctx)
-	case auditlog.FieldClientCommonName:
-		return m.OldClientCommonName(ctx)
-	case auditlog.FieldClientOrganization:
-		return m.OldClientOrganization(ctx)
-	case auditlog.FieldClientSerialNumber:
-		return m.OldClientSerialNumber(ctx)
-	case auditlog.FieldIsAuthenticated:
-		return m.OldIsAuthenticated(ctx)
-	case auditlog.FieldSuccess:
-		return m.OldSuccess(ctx)
-	case auditlog.FieldErrorCode:
-		return m.OldErrorCode(ctx)
-	case auditlog.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case auditlog.FieldLatencyMs:
-		return m.OldLatencyMs(ctx)
-	case auditlog.FieldPeerAddress:
-		return m.OldPeerAddress(ctx)
-	case auditlog.FieldGeoLocation:
-		return m.OldGeoLocation(ctx)
-	case auditlog.FieldLogHash:
-		return m.OldLogHash(ctx)
-	case auditlog.FieldSignature:
-		return m.OldSignature(ctx)
-	case auditlog.FieldMetadata:
-		return m.OldMetadata(ctx)
-	}
-	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+	case externalcertificate.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case externalcertificate.FieldSource:
+		return m.OldSource(ctx)
+	case externalcertificate.FieldSerialNumber:
+		return m.OldSerialNumber(ctx)
+	case externalcertificate.FieldCommonName:
+		return m.OldCommonName(ctx)
+	case externalcertificate.FieldSans:
+		return m.OldSans(ctx)
+	case externalcertificate.FieldCertificatePem:
+		return m.OldCertificatePem(ctx)
+	case externalcertificate.FieldCaCertificatePem:
+		return m.OldCaCertificatePem(ctx)
+	case externalcertificate.FieldPrivateKeyRef:
+		return m.OldPrivateKeyRef(ctx)
+	case externalcertificate.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExternalCertificate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+func (m *ExternalCertificateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case externalcertificate.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case auditlog.FieldUpdateTime:
+	case externalcertificate.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case auditlog.FieldDeleteTime:
+	case externalcertificate.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case auditlog.FieldTenantID:
+	case externalcertificate.FieldTenantID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case auditlog.FieldAuditID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuditID(v)
-		return nil
-	case auditlog.FieldRequestID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequestID(v)
-		return nil
-	case auditlog.FieldOperation:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOperation(v)
-		return nil
-	case auditlog.FieldServiceName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServiceName(v)
-		return nil
-	case auditlog.FieldClientID:
+	case externalcertificate.FieldCertificateID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetCertificateID(v)
 		return nil
-	case auditlog.FieldClientCommonName:
+	case externalcertificate.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientCommonName(v)
+		m.SetSource(v)
 		return nil
-	case auditlog.FieldClientOrganization:
+	case externalcertificate.FieldSerialNumber:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientOrganization(v)
+		m.SetSerialNumber(v)
 		return nil
-	case auditlog.FieldClientSerialNumber:
+	case externalcertificate.FieldCommonName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSerialNumber(v)
-		return nil
-	case auditlog.FieldIsAuthenticated:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsAuthenticated(v)
+		m.SetCommonName(v)
 		return nil
-	case auditlog.FieldSuccess:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuccess(v)
-		return nil
-	case auditlog.FieldErrorCode:
-		v, ok := value.(int32)
+	case externalcertificate.FieldSans:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorCode(v)
+		m.SetSans(v)
 		return nil
-	case auditlog.FieldErrorMessage:
+	case externalcertificate.FieldCertificatePem:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
-		return nil
-	case auditlog.FieldLatencyMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLatencyMs(v)
+		m.SetCertificatePem(v)
 		return nil
-	case auditlog.FieldPeerAddress:
+	case externalcertificate.FieldCaCertificatePem:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPeerAddress(v)
-		return nil
-	case auditlog.FieldGeoLocation:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGeoLocation(v)
+		m.SetCaCertificatePem(v)
 		return nil
-	case auditlog.FieldLogHash:
+	case externalcertificate.FieldPrivateKeyRef:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLogHash(v)
-		return nil
-	case auditlog.FieldSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSignature(v)
+		m.SetPrivateKeyRef(v)
 		return nil
-	case auditlog.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case externalcertificate.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog field %s", name)
+	return fmt.Errorf("unknown ExternalCertificate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuditLogMutation) AddedFields() []string {
+func (m *ExternalCertificateMutation) AddedFields() []string {
 	var fields []string
 	if m.addtenant_id != nil {
-		fields = append(fields, auditlog.FieldTenantID)
-	}
-	if m.adderror_code != nil {
-		fields = append(fields, auditlog.FieldErrorCode)
-	}
-	if m.addlatency_ms != nil {
-		fields = append(fields, auditlog.FieldLatencyMs)
+		fields = append(fields, externalcertificate.FieldTenantID)
 	}
 	return fields
 }
@@ -1632,14 +13902,10 @@ func (m *AuditLogMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExternalCertificateMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case auditlog.FieldTenantID:
+	case externalcertificate.FieldTenantID:
 		return m.AddedTenantID()
-	case auditlog.FieldErrorCode:
-		return m.AddedErrorCode()
-	case auditlog.FieldLatencyMs:
-		return m.AddedLatencyMs()
 	}
 	return nil, false
 }
@@ -1647,307 +13913,231 @@ func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+func (m *ExternalCertificateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case auditlog.FieldTenantID:
+	case externalcertificate.FieldTenantID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddTenantID(v)
 		return nil
-	case auditlog.FieldErrorCode:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddErrorCode(v)
-		return nil
-	case auditlog.FieldLatencyMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLatencyMs(v)
-		return nil
 	}
-	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+	return fmt.Errorf("unknown ExternalCertificate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuditLogMutation) ClearedFields() []string {
+func (m *ExternalCertificateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(auditlog.FieldCreateTime) {
-		fields = append(fields, auditlog.FieldCreateTime)
-	}
-	if m.FieldCleared(auditlog.FieldUpdateTime) {
-		fields = append(fields, auditlog.FieldUpdateTime)
-	}
-	if m.FieldCleared(auditlog.FieldDeleteTime) {
-		fields = append(fields, auditlog.FieldDeleteTime)
-	}
-	if m.FieldCleared(auditlog.FieldTenantID) {
-		fields = append(fields, auditlog.FieldTenantID)
-	}
-	if m.FieldCleared(auditlog.FieldRequestID) {
-		fields = append(fields, auditlog.FieldRequestID)
+	if m.FieldCleared(externalcertificate.FieldCreateTime) {
+		fields = append(fields, externalcertificate.FieldCreateTime)
 	}
-	if m.FieldCleared(auditlog.FieldClientID) {
-		fields = append(fields, auditlog.FieldClientID)
-	}
-	if m.FieldCleared(auditlog.FieldClientCommonName) {
-		fields = append(fields, auditlog.FieldClientCommonName)
-	}
-	if m.FieldCleared(auditlog.FieldClientOrganization) {
-		fields = append(fields, auditlog.FieldClientOrganization)
+	if m.FieldCleared(externalcertificate.FieldUpdateTime) {
+		fields = append(fields, externalcertificate.FieldUpdateTime)
 	}
-	if m.FieldCleared(auditlog.FieldClientSerialNumber) {
-		fields = append(fields, auditlog.FieldClientSerialNumber)
+	if m.FieldCleared(externalcertificate.FieldDeleteTime) {
+		fields = append(fields, externalcertificate.FieldDeleteTime)
 	}
-	if m.FieldCleared(auditlog.FieldErrorCode) {
-		fields = append(fields, auditlog.FieldErrorCode)
+	if m.FieldCleared(externalcertificate.FieldTenantID) {
+		fields = append(fields, externalcertificate.FieldTenantID)
 	}
-	if m.FieldCleared(auditlog.FieldErrorMessage) {
-		fields = append(fields, auditlog.FieldErrorMessage)
+	if m.FieldCleared(externalcertificate.FieldSerialNumber) {
+		fields = append(fields, externalcertificate.FieldSerialNumber)
 	}
-	if m.FieldCleared(auditlog.FieldPeerAddress) {
-		fields = append(fields, auditlog.FieldPeerAddress)
+	if m.FieldCleared(externalcertificate.FieldCommonName) {
+		fields = append(fields, externalcertificate.FieldCommonName)
 	}
-	if m.FieldCleared(auditlog.FieldGeoLocation) {
-		fields = append(fields, auditlog.FieldGeoLocation)
+	if m.FieldCleared(externalcertificate.FieldSans) {
+		fields = append(fields, externalcertificate.FieldSans)
 	}
-	if m.FieldCleared(auditlog.FieldLogHash) {
-		fields = append(fields, auditlog.FieldLogHash)
+	if m.FieldCleared(externalcertificate.FieldCaCertificatePem) {
+		fields = append(fields, externalcertificate.FieldCaCertificatePem)
 	}
-	if m.FieldCleared(auditlog.FieldSignature) {
-		fields = append(fields, auditlog.FieldSignature)
+	if m.FieldCleared(externalcertificate.FieldPrivateKeyRef) {
+		fields = append(fields, externalcertificate.FieldPrivateKeyRef)
 	}
-	if m.FieldCleared(auditlog.FieldMetadata) {
-		fields = append(fields, auditlog.FieldMetadata)
+	if m.FieldCleared(externalcertificate.FieldExpiresAt) {
+		fields = append(fields, externalcertificate.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuditLogMutation) FieldCleared(name string) bool {
+func (m *ExternalCertificateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuditLogMutation) ClearField(name string) error {
+func (m *ExternalCertificateMutation) ClearField(name string) error {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case externalcertificate.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case auditlog.FieldUpdateTime:
+	case externalcertificate.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case auditlog.FieldDeleteTime:
-		m.ClearDeleteTime()
-		return nil
-	case auditlog.FieldTenantID:
-		m.ClearTenantID()
-		return nil
-	case auditlog.FieldRequestID:
-		m.ClearRequestID()
-		return nil
-	case auditlog.FieldClientID:
-		m.ClearClientID()
-		return nil
-	case auditlog.FieldClientCommonName:
-		m.ClearClientCommonName()
-		return nil
-	case auditlog.FieldClientOrganization:
-		m.ClearClientOrganization()
-		return nil
-	case auditlog.FieldClientSerialNumber:
-		m.ClearClientSerialNumber()
+	case externalcertificate.FieldDeleteTime:
+		m.ClearDeleteTime()
 		return nil
-	case auditlog.FieldErrorCode:
-		m.ClearErrorCode()
+	case externalcertificate.FieldTenantID:
+		m.ClearTenantID()
 		return nil
-	case auditlog.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case externalcertificate.FieldSerialNumber:
+		m.ClearSerialNumber()
 		return nil
-	case auditlog.FieldPeerAddress:
-		m.ClearPeerAddress()
+	case externalcertificate.FieldCommonName:
+		m.ClearCommonName()
 		return nil
-	case auditlog.FieldGeoLocation:
-		m.ClearGeoLocation()
+	case externalcertificate.FieldSans:
+		m.ClearSans()
 		return nil
-	case auditlog.FieldLogHash:
-		m.ClearLogHash()
+	case externalcertificate.FieldCaCertificatePem:
+		m.ClearCaCertificatePem()
 		return nil
-	case auditlog.FieldSignature:
-		m.ClearSignature()
+	case externalcertificate.FieldPrivateKeyRef:
+		m.ClearPrivateKeyRef()
 		return nil
-	case auditlog.FieldMetadata:
-		m.ClearMetadata()
+	case externalcertificate.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+	return fmt.Errorf("unknown ExternalCertificate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuditLogMutation) ResetField(name string) error {
+func (m *ExternalCertificateMutation) ResetField(name string) error {
 	switch name {
-	case auditlog.FieldCreateTime:
+	case externalcertificate.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case auditlog.FieldUpdateTime:
+	case externalcertificate.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case auditlog.FieldDeleteTime:
+	case externalcertificate.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case auditlog.FieldTenantID:
+	case externalcertificate.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case auditlog.FieldAuditID:
-		m.ResetAuditID()
-		return nil
-	case auditlog.FieldRequestID:
-		m.ResetRequestID()
-		return nil
-	case auditlog.FieldOperation:
-		m.ResetOperation()
-		return nil
-	case auditlog.FieldServiceName:
-		m.ResetServiceName()
-		return nil
-	case auditlog.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case auditlog.FieldClientCommonName:
-		m.ResetClientCommonName()
-		return nil
-	case auditlog.FieldClientOrganization:
-		m.ResetClientOrganization()
-		return nil
-	case auditlog.FieldClientSerialNumber:
-		m.ResetClientSerialNumber()
-		return nil
-	case auditlog.FieldIsAuthenticated:
-		m.ResetIsAuthenticated()
-		return nil
-	case auditlog.FieldSuccess:
-		m.ResetSuccess()
+	case externalcertificate.FieldCertificateID:
+		m.ResetCertificateID()
 		return nil
-	case auditlog.FieldErrorCode:
-		m.ResetErrorCode()
+	case externalcertificate.FieldSource:
+		m.ResetSource()
 		return nil
-	case auditlog.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case externalcertificate.FieldSerialNumber:
+		m.ResetSerialNumber()
 		return nil
-	case auditlog.FieldLatencyMs:
-		m.ResetLatencyMs()
+	case externalcertificate.FieldCommonName:
+		m.ResetCommonName()
 		return nil
-	case auditlog.FieldPeerAddress:
-		m.ResetPeerAddress()
+	case externalcertificate.FieldSans:
+		m.ResetSans()
 		return nil
-	case auditlog.FieldGeoLocation:
-		m.ResetGeoLocation()
+	case externalcertificate.FieldCertificatePem:
+		m.ResetCertificatePem()
 		return nil
-	case auditlog.FieldLogHash:
-		m.ResetLogHash()
+	case externalcertificate.FieldCaCertificatePem:
+		m.ResetCaCertificatePem()
 		return nil
-	case auditlog.FieldSignature:
-		m.ResetSignature()
+	case externalcertificate.FieldPrivateKeyRef:
+		m.ResetPrivateKeyRef()
 		return nil
-	case auditlog.FieldMetadata:
-		m.ResetMetadata()
+	case externalcertificate.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog field %s", name)
+	return fmt.Errorf("unknown ExternalCertificate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuditLogMutation) AddedEdges() []string {
+func (m *ExternalCertificateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+func (m *ExternalCertificateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuditLogMutation) RemovedEdges() []string {
+func (m *ExternalCertificateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *ExternalCertificateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuditLogMutation) ClearedEdges() []string {
+func (m *ExternalCertificateMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuditLogMutation) EdgeCleared(name string) bool {
+func (m *ExternalCertificateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuditLogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+func (m *ExternalCertificateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExternalCertificate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuditLogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuditLog edge %s", name)
+func (m *ExternalCertificateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExternalCertificate edge %s", name)
 }
 
-// DeploymentHistoryMutation represents an operation that mutates the DeploymentHistory nodes in the graph.
-type DeploymentHistoryMutation struct {
+// InventorySnapshotMutation represents an operation that mutates the InventorySnapshot nodes in the graph.
+type InventorySnapshotMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uint32
-	create_time    *time.Time
-	update_time    *time.Time
-	delete_time    *time.Time
-	action         *deploymenthistory.Action
-	result         *deploymenthistory.Result
-	message        *string
-	duration_ms    *int64
-	addduration_ms *int64
-	details        *map[string]interface{}
-	clearedFields  map[string]struct{}
-	job            *string
-	clearedjob     bool
-	done           bool
-	oldValue       func(context.Context) (*DeploymentHistory, error)
-	predicates     []predicate.DeploymentHistory
-}
-
-var _ ent.Mutation = (*DeploymentHistoryMutation)(nil)
-
-// deploymenthistoryOption allows management of the mutation configuration using functional options.
-type deploymenthistoryOption func(*DeploymentHistoryMutation)
-
-// newDeploymentHistoryMutation creates new mutation for the DeploymentHistory entity.
-func newDeploymentHistoryMutation(c config, op Op, opts ...deploymenthistoryOption) *DeploymentHistoryMutation {
-	m := &DeploymentHistoryMutation{
+	op                      Op
+	typ                     string
+	id                      *uint32
+	create_time             *time.Time
+	update_time             *time.Time
+	delete_time             *time.Time
+	tenant_id               *uint32
+	addtenant_id            *int32
+	target_configuration_id *string
+	provider_type           *string
+	certificates            *[]schema.InventoryCertificate
+	appendcertificates      []schema.InventoryCertificate
+	unmanaged_count         *int
+	addunmanaged_count      *int
+	error                   *string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*InventorySnapshot, error)
+	predicates              []predicate.InventorySnapshot
+}
+
+var _ ent.Mutation = (*InventorySnapshotMutation)(nil)
+
+// inventorysnapshotOption allows management of the mutation configuration using functional options.
+type inventorysnapshotOption func(*InventorySnapshotMutation)
+
+// newInventorySnapshotMutation creates new mutation for the InventorySnapshot entity.
+func newInventorySnapshotMutation(c config, op Op, opts ...inventorysnapshotOption) *InventorySnapshotMutation {
+	m := &InventorySnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentHistory,
+		typ:           TypeInventorySnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1956,20 +14146,20 @@ func newDeploymentHistoryMutation(c config, op Op, opts ...deploymenthistoryOpti
 	return m
 }
 
-// withDeploymentHistoryID sets the ID field of the mutation.
-func withDeploymentHistoryID(id uint32) deploymenthistoryOption {
-	return func(m *DeploymentHistoryMutation) {
+// withInventorySnapshotID sets the ID field of the mutation.
+func withInventorySnapshotID(id uint32) inventorysnapshotOption {
+	return func(m *InventorySnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentHistory
+			value *InventorySnapshot
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentHistory, error) {
+		m.oldValue = func(ctx context.Context) (*InventorySnapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentHistory.Get(ctx, id)
+					value, err = m.Client().InventorySnapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1978,10 +14168,10 @@ func withDeploymentHistoryID(id uint32) deploymenthistoryOption {
 	}
 }
 
-// withDeploymentHistory sets the old DeploymentHistory of the mutation.
-func withDeploymentHistory(node *DeploymentHistory) deploymenthistoryOption {
-	return func(m *DeploymentHistoryMutation) {
-		m.oldValue = func(context.Context) (*DeploymentHistory, error) {
+// withInventorySnapshot sets the old InventorySnapshot of the mutation.
+func withInventorySnapshot(node *InventorySnapshot) inventorysnapshotOption {
+	return func(m *InventorySnapshotMutation) {
+		m.oldValue = func(context.Context) (*InventorySnapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1990,7 +14180,7 @@ func withDeploymentHistory(node *DeploymentHistory) deploymenthistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentHistoryMutation) Client() *Client {
+func (m InventorySnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1998,7 +14188,7 @@ func (m DeploymentHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentHistoryMutation) Tx() (*Tx, error) {
+func (m InventorySnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2008,14 +14198,14 @@ func (m DeploymentHistoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeploymentHistory entities.
-func (m *DeploymentHistoryMutation) SetID(id uint32) {
+// operation is only accepted on creation of InventorySnapshot entities.
+func (m *InventorySnapshotMutation) SetID(id uint32) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentHistoryMutation) ID() (id uint32, exists bool) {
+func (m *InventorySnapshotMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2026,7 +14216,7 @@ func (m *DeploymentHistoryMutation) ID() (id uint32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentHistoryMutation) IDs(ctx context.Context) ([]uint32, error) {
+func (m *InventorySnapshotMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2035,19 +14225,19 @@ func (m *DeploymentHistoryMutation) IDs(ctx context.Context) ([]uint32, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().InventorySnapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DeploymentHistoryMutation) SetCreateTime(t time.Time) {
+func (m *InventorySnapshotMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DeploymentHistoryMutation) CreateTime() (r time.Time, exists bool) {
+func (m *InventorySnapshotMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2055,10 +14245,10 @@ func (m *DeploymentHistoryMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *InventorySnapshotMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2073,30 +14263,30 @@ func (m *DeploymentHistoryMutation) OldCreateTime(ctx context.Context) (v *time.
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *DeploymentHistoryMutation) ClearCreateTime() {
+func (m *InventorySnapshotMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[deploymenthistory.FieldCreateTime] = struct{}{}
+	m.clearedFields[inventorysnapshot.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *DeploymentHistoryMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[deploymenthistory.FieldCreateTime]
+func (m *InventorySnapshotMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[inventorysnapshot.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DeploymentHistoryMutation) ResetCreateTime() {
+func (m *InventorySnapshotMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, deploymenthistory.FieldCreateTime)
+	delete(m.clearedFields, inventorysnapshot.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DeploymentHistoryMutation) SetUpdateTime(t time.Time) {
+func (m *InventorySnapshotMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DeploymentHistoryMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *InventorySnapshotMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2104,10 +14294,10 @@ func (m *DeploymentHistoryMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *InventorySnapshotMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2122,30 +14312,30 @@ func (m *DeploymentHistoryMutation) OldUpdateTime(ctx context.Context) (v *time.
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *DeploymentHistoryMutation) ClearUpdateTime() {
+func (m *InventorySnapshotMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[deploymenthistory.FieldUpdateTime] = struct{}{}
+	m.clearedFields[inventorysnapshot.FieldUpdateTime] = struct{}{}
 }
 
 // UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *DeploymentHistoryMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[deploymenthistory.FieldUpdateTime]
+func (m *InventorySnapshotMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[inventorysnapshot.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DeploymentHistoryMutation) ResetUpdateTime() {
+func (m *InventorySnapshotMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, deploymenthistory.FieldUpdateTime)
+	delete(m.clearedFields, inventorysnapshot.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *DeploymentHistoryMutation) SetDeleteTime(t time.Time) {
+func (m *InventorySnapshotMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *DeploymentHistoryMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *InventorySnapshotMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -2153,10 +14343,10 @@ func (m *DeploymentHistoryMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *InventorySnapshotMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -2171,321 +14361,344 @@ func (m *DeploymentHistoryMutation) OldDeleteTime(ctx context.Context) (v *time.
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *DeploymentHistoryMutation) ClearDeleteTime() {
+func (m *InventorySnapshotMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[deploymenthistory.FieldDeleteTime] = struct{}{}
+	m.clearedFields[inventorysnapshot.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *DeploymentHistoryMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[deploymenthistory.FieldDeleteTime]
+func (m *InventorySnapshotMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[inventorysnapshot.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *DeploymentHistoryMutation) ResetDeleteTime() {
+func (m *InventorySnapshotMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, deploymenthistory.FieldDeleteTime)
+	delete(m.clearedFields, inventorysnapshot.FieldDeleteTime)
 }
 
-// SetJobID sets the "job_id" field.
-func (m *DeploymentHistoryMutation) SetJobID(s string) {
-	m.job = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *InventorySnapshotMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// JobID returns the value of the "job_id" field in the mutation.
-func (m *DeploymentHistoryMutation) JobID() (r string, exists bool) {
-	v := m.job
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *InventorySnapshotMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobID returns the old "job_id" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldJobID(ctx context.Context) (v string, err error) {
+func (m *InventorySnapshotMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobID requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.JobID, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetJobID resets all changes to the "job_id" field.
-func (m *DeploymentHistoryMutation) ResetJobID() {
-	m.job = nil
+// AddTenantID adds u to the "tenant_id" field.
+func (m *InventorySnapshotMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// SetAction sets the "action" field.
-func (m *DeploymentHistoryMutation) SetAction(d deploymenthistory.Action) {
-	m.action = &d
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *InventorySnapshotMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *DeploymentHistoryMutation) Action() (r deploymenthistory.Action, exists bool) {
-	v := m.action
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *InventorySnapshotMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[inventorysnapshot.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *InventorySnapshotMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[inventorysnapshot.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *InventorySnapshotMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, inventorysnapshot.FieldTenantID)
+}
+
+// SetTargetConfigurationID sets the "target_configuration_id" field.
+func (m *InventorySnapshotMutation) SetTargetConfigurationID(s string) {
+	m.target_configuration_id = &s
+}
+
+// TargetConfigurationID returns the value of the "target_configuration_id" field in the mutation.
+func (m *InventorySnapshotMutation) TargetConfigurationID() (r string, exists bool) {
+	v := m.target_configuration_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetConfigurationID returns the old "target_configuration_id" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldAction(ctx context.Context) (v deploymenthistory.Action, err error) {
+func (m *InventorySnapshotMutation) OldTargetConfigurationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetConfigurationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldTargetConfigurationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetConfigurationID: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.TargetConfigurationID, nil
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *DeploymentHistoryMutation) ResetAction() {
-	m.action = nil
+// ResetTargetConfigurationID resets all changes to the "target_configuration_id" field.
+func (m *InventorySnapshotMutation) ResetTargetConfigurationID() {
+	m.target_configuration_id = nil
 }
 
-// SetResult sets the "result" field.
-func (m *DeploymentHistoryMutation) SetResult(d deploymenthistory.Result) {
-	m.result = &d
+// SetProviderType sets the "provider_type" field.
+func (m *InventorySnapshotMutation) SetProviderType(s string) {
+	m.provider_type = &s
 }
 
-// Result returns the value of the "result" field in the mutation.
-func (m *DeploymentHistoryMutation) Result() (r deploymenthistory.Result, exists bool) {
-	v := m.result
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *InventorySnapshotMutation) ProviderType() (r string, exists bool) {
+	v := m.provider_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResult returns the old "result" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderType returns the old "provider_type" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldResult(ctx context.Context) (v deploymenthistory.Result, err error) {
+func (m *InventorySnapshotMutation) OldProviderType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResult requires an ID field in the mutation")
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
 	}
-	return oldValue.Result, nil
+	return oldValue.ProviderType, nil
 }
 
-// ResetResult resets all changes to the "result" field.
-func (m *DeploymentHistoryMutation) ResetResult() {
-	m.result = nil
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *InventorySnapshotMutation) ResetProviderType() {
+	m.provider_type = nil
 }
 
-// SetMessage sets the "message" field.
-func (m *DeploymentHistoryMutation) SetMessage(s string) {
-	m.message = &s
+// SetCertificates sets the "certificates" field.
+func (m *InventorySnapshotMutation) SetCertificates(sc []schema.InventoryCertificate) {
+	m.certificates = &sc
+	m.appendcertificates = nil
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *DeploymentHistoryMutation) Message() (r string, exists bool) {
-	v := m.message
+// Certificates returns the value of the "certificates" field in the mutation.
+func (m *InventorySnapshotMutation) Certificates() (r []schema.InventoryCertificate, exists bool) {
+	v := m.certificates
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCertificates returns the old "certificates" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *InventorySnapshotMutation) OldCertificates(ctx context.Context) (v []schema.InventoryCertificate, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldCertificates is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldCertificates requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldCertificates: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.Certificates, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *DeploymentHistoryMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[deploymenthistory.FieldMessage] = struct{}{}
+// AppendCertificates adds sc to the "certificates" field.
+func (m *InventorySnapshotMutation) AppendCertificates(sc []schema.InventoryCertificate) {
+	m.appendcertificates = append(m.appendcertificates, sc...)
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *DeploymentHistoryMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[deploymenthistory.FieldMessage]
+// AppendedCertificates returns the list of values that were appended to the "certificates" field in this mutation.
+func (m *InventorySnapshotMutation) AppendedCertificates() ([]schema.InventoryCertificate, bool) {
+	if len(m.appendcertificates) == 0 {
+		return nil, false
+	}
+	return m.appendcertificates, true
+}
+
+// ClearCertificates clears the value of the "certificates" field.
+func (m *InventorySnapshotMutation) ClearCertificates() {
+	m.certificates = nil
+	m.appendcertificates = nil
+	m.clearedFields[inventorysnapshot.FieldCertificates] = struct{}{}
+}
+
+// CertificatesCleared returns if the "certificates" field was cleared in this mutation.
+func (m *InventorySnapshotMutation) CertificatesCleared() bool {
+	_, ok := m.clearedFields[inventorysnapshot.FieldCertificates]
 	return ok
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *DeploymentHistoryMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, deploymenthistory.FieldMessage)
+// ResetCertificates resets all changes to the "certificates" field.
+func (m *InventorySnapshotMutation) ResetCertificates() {
+	m.certificates = nil
+	m.appendcertificates = nil
+	delete(m.clearedFields, inventorysnapshot.FieldCertificates)
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (m *DeploymentHistoryMutation) SetDurationMs(i int64) {
-	m.duration_ms = &i
-	m.addduration_ms = nil
+// SetUnmanagedCount sets the "unmanaged_count" field.
+func (m *InventorySnapshotMutation) SetUnmanagedCount(i int) {
+	m.unmanaged_count = &i
+	m.addunmanaged_count = nil
 }
 
-// DurationMs returns the value of the "duration_ms" field in the mutation.
-func (m *DeploymentHistoryMutation) DurationMs() (r int64, exists bool) {
-	v := m.duration_ms
+// UnmanagedCount returns the value of the "unmanaged_count" field in the mutation.
+func (m *InventorySnapshotMutation) UnmanagedCount() (r int, exists bool) {
+	v := m.unmanaged_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDurationMs returns the old "duration_ms" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldUnmanagedCount returns the old "unmanaged_count" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+func (m *InventorySnapshotMutation) OldUnmanagedCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnmanagedCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+		return v, errors.New("OldUnmanagedCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnmanagedCount: %w", err)
 	}
-	return oldValue.DurationMs, nil
+	return oldValue.UnmanagedCount, nil
 }
 
-// AddDurationMs adds i to the "duration_ms" field.
-func (m *DeploymentHistoryMutation) AddDurationMs(i int64) {
-	if m.addduration_ms != nil {
-		*m.addduration_ms += i
+// AddUnmanagedCount adds i to the "unmanaged_count" field.
+func (m *InventorySnapshotMutation) AddUnmanagedCount(i int) {
+	if m.addunmanaged_count != nil {
+		*m.addunmanaged_count += i
 	} else {
-		m.addduration_ms = &i
+		m.addunmanaged_count = &i
 	}
 }
 
-// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
-func (m *DeploymentHistoryMutation) AddedDurationMs() (r int64, exists bool) {
-	v := m.addduration_ms
+// AddedUnmanagedCount returns the value that was added to the "unmanaged_count" field in this mutation.
+func (m *InventorySnapshotMutation) AddedUnmanagedCount() (r int, exists bool) {
+	v := m.addunmanaged_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDurationMs resets all changes to the "duration_ms" field.
-func (m *DeploymentHistoryMutation) ResetDurationMs() {
-	m.duration_ms = nil
-	m.addduration_ms = nil
+// ResetUnmanagedCount resets all changes to the "unmanaged_count" field.
+func (m *InventorySnapshotMutation) ResetUnmanagedCount() {
+	m.unmanaged_count = nil
+	m.addunmanaged_count = nil
 }
 
-// SetDetails sets the "details" field.
-func (m *DeploymentHistoryMutation) SetDetails(value map[string]interface{}) {
-	m.details = &value
+// SetError sets the "error" field.
+func (m *InventorySnapshotMutation) SetError(s string) {
+	m.error = &s
 }
 
-// Details returns the value of the "details" field in the mutation.
-func (m *DeploymentHistoryMutation) Details() (r map[string]interface{}, exists bool) {
-	v := m.details
+// Error returns the value of the "error" field in the mutation.
+func (m *InventorySnapshotMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDetails returns the old "details" field's value of the DeploymentHistory entity.
-// If the DeploymentHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the InventorySnapshot entity.
+// If the InventorySnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentHistoryMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *InventorySnapshotMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDetails requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.Details, nil
+	return oldValue.Error, nil
 }
 
-// ClearDetails clears the value of the "details" field.
-func (m *DeploymentHistoryMutation) ClearDetails() {
-	m.details = nil
-	m.clearedFields[deploymenthistory.FieldDetails] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *InventorySnapshotMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[inventorysnapshot.FieldError] = struct{}{}
 }
 
-// DetailsCleared returns if the "details" field was cleared in this mutation.
-func (m *DeploymentHistoryMutation) DetailsCleared() bool {
-	_, ok := m.clearedFields[deploymenthistory.FieldDetails]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *InventorySnapshotMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[inventorysnapshot.FieldError]
 	return ok
 }
 
-// ResetDetails resets all changes to the "details" field.
-func (m *DeploymentHistoryMutation) ResetDetails() {
-	m.details = nil
-	delete(m.clearedFields, deploymenthistory.FieldDetails)
-}
-
-// ClearJob clears the "job" edge to the DeploymentJob entity.
-func (m *DeploymentHistoryMutation) ClearJob() {
-	m.clearedjob = true
-	m.clearedFields[deploymenthistory.FieldJobID] = struct{}{}
-}
-
-// JobCleared reports if the "job" edge to the DeploymentJob entity was cleared.
-func (m *DeploymentHistoryMutation) JobCleared() bool {
-	return m.clearedjob
-}
-
-// JobIDs returns the "job" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// JobID instead. It exists only for internal usage by the builders.
-func (m *DeploymentHistoryMutation) JobIDs() (ids []string) {
-	if id := m.job; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetJob resets all changes to the "job" edge.
-func (m *DeploymentHistoryMutation) ResetJob() {
-	m.job = nil
-	m.clearedjob = false
+// ResetError resets all changes to the "error" field.
+func (m *InventorySnapshotMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, inventorysnapshot.FieldError)
 }
 
-// Where appends a list predicates to the DeploymentHistoryMutation builder.
-func (m *DeploymentHistoryMutation) Where(ps ...predicate.DeploymentHistory) {
+// Where appends a list predicates to the InventorySnapshotMutation builder.
+func (m *InventorySnapshotMutation) Where(ps ...predicate.InventorySnapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentHistoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InventorySnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentHistory, len(ps))
+func (m *InventorySnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InventorySnapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2493,51 +14706,51 @@ func (m *DeploymentHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentHistoryMutation) Op() Op {
+func (m *InventorySnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentHistoryMutation) SetOp(op Op) {
+func (m *InventorySnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentHistory).
-func (m *DeploymentHistoryMutation) Type() string {
+// Type returns the node type of this mutation (InventorySnapshot).
+func (m *InventorySnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentHistoryMutation) Fields() []string {
+func (m *InventorySnapshotMutation) Fields() []string {
 	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, deploymenthistory.FieldCreateTime)
+		fields = append(fields, inventorysnapshot.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, deploymenthistory.FieldUpdateTime)
+		fields = append(fields, inventorysnapshot.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, deploymenthistory.FieldDeleteTime)
+		fields = append(fields, inventorysnapshot.FieldDeleteTime)
 	}
-	if m.job != nil {
-		fields = append(fields, deploymenthistory.FieldJobID)
+	if m.tenant_id != nil {
+		fields = append(fields, inventorysnapshot.FieldTenantID)
 	}
-	if m.action != nil {
-		fields = append(fields, deploymenthistory.FieldAction)
+	if m.target_configuration_id != nil {
+		fields = append(fields, inventorysnapshot.FieldTargetConfigurationID)
 	}
-	if m.result != nil {
-		fields = append(fields, deploymenthistory.FieldResult)
+	if m.provider_type != nil {
+		fields = append(fields, inventorysnapshot.FieldProviderType)
 	}
-	if m.message != nil {
-		fields = append(fields, deploymenthistory.FieldMessage)
+	if m.certificates != nil {
+		fields = append(fields, inventorysnapshot.FieldCertificates)
 	}
-	if m.duration_ms != nil {
-		fields = append(fields, deploymenthistory.FieldDurationMs)
+	if m.unmanaged_count != nil {
+		fields = append(fields, inventorysnapshot.FieldUnmanagedCount)
 	}
-	if m.details != nil {
-		fields = append(fields, deploymenthistory.FieldDetails)
+	if m.error != nil {
+		fields = append(fields, inventorysnapshot.FieldError)
 	}
 	return fields
 }
@@ -2545,26 +14758,26 @@ func (m *DeploymentHistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *InventorySnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymenthistory.FieldCreateTime:
+	case inventorysnapshot.FieldCreateTime:
 		return m.CreateTime()
-	case deploymenthistory.FieldUpdateTime:
+	case inventorysnapshot.FieldUpdateTime:
 		return m.UpdateTime()
-	case deploymenthistory.FieldDeleteTime:
+	case inventorysnapshot.FieldDeleteTime:
 		return m.DeleteTime()
-	case deploymenthistory.FieldJobID:
-		return m.JobID()
-	case deploymenthistory.FieldAction:
-		return m.Action()
-	case deploymenthistory.FieldResult:
-		return m.Result()
-	case deploymenthistory.FieldMessage:
-		return m.Message()
-	case deploymenthistory.FieldDurationMs:
-		return m.DurationMs()
-	case deploymenthistory.FieldDetails:
-		return m.Details()
+	case inventorysnapshot.FieldTenantID:
+		return m.TenantID()
+	case inventorysnapshot.FieldTargetConfigurationID:
+		return m.TargetConfigurationID()
+	case inventorysnapshot.FieldProviderType:
+		return m.ProviderType()
+	case inventorysnapshot.FieldCertificates:
+		return m.Certificates()
+	case inventorysnapshot.FieldUnmanagedCount:
+		return m.UnmanagedCount()
+	case inventorysnapshot.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -2572,108 +14785,111 @@ func (m *DeploymentHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InventorySnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymenthistory.FieldCreateTime:
+	case inventorysnapshot.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case deploymenthistory.FieldUpdateTime:
+	case inventorysnapshot.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case deploymenthistory.FieldDeleteTime:
+	case inventorysnapshot.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case deploymenthistory.FieldJobID:
-		return m.OldJobID(ctx)
-	case deploymenthistory.FieldAction:
-		return m.OldAction(ctx)
-	case deploymenthistory.FieldResult:
-		return m.OldResult(ctx)
-	case deploymenthistory.FieldMessage:
-		return m.OldMessage(ctx)
-	case deploymenthistory.FieldDurationMs:
-		return m.OldDurationMs(ctx)
-	case deploymenthistory.FieldDetails:
-		return m.OldDetails(ctx)
+	case inventorysnapshot.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case inventorysnapshot.FieldTargetConfigurationID:
+		return m.OldTargetConfigurationID(ctx)
+	case inventorysnapshot.FieldProviderType:
+		return m.OldProviderType(ctx)
+	case inventorysnapshot.FieldCertificates:
+		return m.OldCertificates(ctx)
+	case inventorysnapshot.FieldUnmanagedCount:
+		return m.OldUnmanagedCount(ctx)
+	case inventorysnapshot.FieldError:
+		return m.OldError(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeploymentHistory field %s", name)
+	return nil, fmt.Errorf("unknown InventorySnapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *InventorySnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymenthistory.FieldCreateTime:
+	case inventorysnapshot.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case deploymenthistory.FieldUpdateTime:
+	case inventorysnapshot.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case deploymenthistory.FieldDeleteTime:
+	case inventorysnapshot.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case deploymenthistory.FieldJobID:
-		v, ok := value.(string)
+	case inventorysnapshot.FieldTenantID:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJobID(v)
+		m.SetTenantID(v)
 		return nil
-	case deploymenthistory.FieldAction:
-		v, ok := value.(deploymenthistory.Action)
+	case inventorysnapshot.FieldTargetConfigurationID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAction(v)
+		m.SetTargetConfigurationID(v)
 		return nil
-	case deploymenthistory.FieldResult:
-		v, ok := value.(deploymenthistory.Result)
+	case inventorysnapshot.FieldProviderType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResult(v)
+		m.SetProviderType(v)
 		return nil
-	case deploymenthistory.FieldMessage:
-		v, ok := value.(string)
+	case inventorysnapshot.FieldCertificates:
+		v, ok := value.([]schema.InventoryCertificate)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetCertificates(v)
 		return nil
-	case deploymenthistory.FieldDurationMs:
-		v, ok := value.(int64)
+	case inventorysnapshot.FieldUnmanagedCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDurationMs(v)
+		m.SetUnmanagedCount(v)
 		return nil
-	case deploymenthistory.FieldDetails:
-		v, ok := value.(map[string]interface{})
+	case inventorysnapshot.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDetails(v)
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentHistory field %s", name)
+	return fmt.Errorf("unknown InventorySnapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentHistoryMutation) AddedFields() []string {
+func (m *InventorySnapshotMutation) AddedFields() []string {
 	var fields []string
-	if m.addduration_ms != nil {
-		fields = append(fields, deploymenthistory.FieldDurationMs)
+	if m.addtenant_id != nil {
+		fields = append(fields, inventorysnapshot.FieldTenantID)
+	}
+	if m.addunmanaged_count != nil {
+		fields = append(fields, inventorysnapshot.FieldUnmanagedCount)
 	}
 	return fields
 }
@@ -2681,10 +14897,12 @@ func (m *DeploymentHistoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentHistoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InventorySnapshotMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case deploymenthistory.FieldDurationMs:
-		return m.AddedDurationMs()
+	case inventorysnapshot.FieldTenantID:
+		return m.AddedTenantID()
+	case inventorysnapshot.FieldUnmanagedCount:
+		return m.AddedUnmanagedCount()
 	}
 	return nil, false
 }
@@ -2692,237 +14910,207 @@ func (m *DeploymentHistoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *InventorySnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case deploymenthistory.FieldDurationMs:
-		v, ok := value.(int64)
+	case inventorysnapshot.FieldTenantID:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDurationMs(v)
+		m.AddTenantID(v)
+		return nil
+	case inventorysnapshot.FieldUnmanagedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnmanagedCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentHistory numeric field %s", name)
+	return fmt.Errorf("unknown InventorySnapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentHistoryMutation) ClearedFields() []string {
+func (m *InventorySnapshotMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deploymenthistory.FieldCreateTime) {
-		fields = append(fields, deploymenthistory.FieldCreateTime)
+	if m.FieldCleared(inventorysnapshot.FieldCreateTime) {
+		fields = append(fields, inventorysnapshot.FieldCreateTime)
 	}
-	if m.FieldCleared(deploymenthistory.FieldUpdateTime) {
-		fields = append(fields, deploymenthistory.FieldUpdateTime)
+	if m.FieldCleared(inventorysnapshot.FieldUpdateTime) {
+		fields = append(fields, inventorysnapshot.FieldUpdateTime)
 	}
-	if m.FieldCleared(deploymenthistory.FieldDeleteTime) {
-		fields = append(fields, deploymenthistory.FieldDeleteTime)
+	if m.FieldCleared(inventorysnapshot.FieldDeleteTime) {
+		fields = append(fields, inventorysnapshot.FieldDeleteTime)
 	}
-	if m.FieldCleared(deploymenthistory.FieldMessage) {
-		fields = append(fields, deploymenthistory.FieldMessage)
+	if m.FieldCleared(inventorysnapshot.FieldTenantID) {
+		fields = append(fields, inventorysnapshot.FieldTenantID)
 	}
-	if m.FieldCleared(deploymenthistory.FieldDetails) {
-		fields = append(fields, deploymenthistory.FieldDetails)
+	if m.FieldCleared(inventorysnapshot.FieldCertificates) {
+		fields = append(fields, inventorysnapshot.FieldCertificates)
+	}
+	if m.FieldCleared(inventorysnapshot.FieldError) {
+		fields = append(fields, inventorysnapshot.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeploymentHistoryMutation) FieldCleared(name string) bool {
+func (m *InventorySnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeploymentHistoryMutation) ClearField(name string) error {
+func (m *InventorySnapshotMutation) ClearField(name string) error {
 	switch name {
-	case deploymenthistory.FieldCreateTime:
+	case inventorysnapshot.FieldCreateTime:
 		m.ClearCreateTime()
 		return nil
-	case deploymenthistory.FieldUpdateTime:
+	case inventorysnapshot.FieldUpdateTime:
 		m.ClearUpdateTime()
 		return nil
-	case deploymenthistory.FieldDeleteTime:
+	case inventorysnapshot.FieldDeleteTime:
 		m.ClearDeleteTime()
 		return nil
-	case deploymenthistory.FieldMessage:
-		m.ClearMessage()
+	case inventorysnapshot.FieldTenantID:
+		m.ClearTenantID()
 		return nil
-	case deploymenthistory.FieldDetails:
-		m.ClearDetails()
+	case inventorysnapshot.FieldCertificates:
+		m.ClearCertificates()
+		return nil
+	case inventorysnapshot.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentHistory nullable field %s", name)
+	return fmt.Errorf("unknown InventorySnapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentHistoryMutation) ResetField(name string) error {
+func (m *InventorySnapshotMutation) ResetField(name string) error {
 	switch name {
-	case deploymenthistory.FieldCreateTime:
+	case inventorysnapshot.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case deploymenthistory.FieldUpdateTime:
+	case inventorysnapshot.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case deploymenthistory.FieldDeleteTime:
+	case inventorysnapshot.FieldDeleteTime:
 		m.ResetDeleteTime()
 		return nil
-	case deploymenthistory.FieldJobID:
-		m.ResetJobID()
+	case inventorysnapshot.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case deploymenthistory.FieldAction:
-		m.ResetAction()
+	case inventorysnapshot.FieldTargetConfigurationID:
+		m.ResetTargetConfigurationID()
 		return nil
-	case deploymenthistory.FieldResult:
-		m.ResetResult()
+	case inventorysnapshot.FieldProviderType:
+		m.ResetProviderType()
 		return nil
-	case deploymenthistory.FieldMessage:
-		m.ResetMessage()
+	case inventorysnapshot.FieldCertificates:
+		m.ResetCertificates()
 		return nil
-	case deploymenthistory.FieldDurationMs:
-		m.ResetDurationMs()
+	case inventorysnapshot.FieldUnmanagedCount:
+		m.ResetUnmanagedCount()
 		return nil
-	case deploymenthistory.FieldDetails:
-		m.ResetDetails()
+	case inventorysnapshot.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentHistory field %s", name)
+	return fmt.Errorf("unknown InventorySnapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentHistoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.job != nil {
-		edges = append(edges, deploymenthistory.EdgeJob)
-	}
+func (m *InventorySnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentHistoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case deploymenthistory.EdgeJob:
-		if id := m.job; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *InventorySnapshotMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentHistoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *InventorySnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *InventorySnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentHistoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedjob {
-		edges = append(edges, deploymenthistory.EdgeJob)
-	}
+func (m *InventorySnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentHistoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case deploymenthistory.EdgeJob:
-		return m.clearedjob
-	}
+func (m *InventorySnapshotMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentHistoryMutation) ClearEdge(name string) error {
-	switch name {
-	case deploymenthistory.EdgeJob:
-		m.ClearJob()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentHistory unique edge %s", name)
+func (m *InventorySnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InventorySnapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentHistoryMutation) ResetEdge(name string) error {
-	switch name {
-	case deploymenthistory.EdgeJob:
-		m.ResetJob()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentHistory edge %s", name)
+func (m *InventorySnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InventorySnapshot edge %s", name)
 }
 
-// DeploymentJobMutation represents an operation that mutates the DeploymentJob nodes in the graph.
-type DeploymentJobMutation struct {
+// NotificationRuleMutation represents an operation that mutates the NotificationRule nodes in the graph.
+type NotificationRuleMutation struct {
 	config
-	op                          Op
-	typ                         string
-	id                          *string
-	create_by                   *uint32
-	addcreate_by                *int32
-	create_time                 *time.Time
-	update_time                 *time.Time
-	delete_time                 *time.Time
-	tenant_id                   *uint32
-	addtenant_id                *int32
-	certificate_id              *string
-	certificate_serial          *string
-	status                      *deploymentjob.Status
-	status_message              *string
-	progress                    *int32
-	addprogress                 *int32
-	retry_count                 *int32
-	addretry_count              *int32
-	max_retries                 *int32
-	addmax_retries              *int32
-	triggered_by                *deploymentjob.TriggeredBy
-	result                      *map[string]interface{}
-	started_at                  *time.Time
-	completed_at                *time.Time
-	next_retry_at               *time.Time
-	clearedFields               map[string]struct{}
-	deployment_target           *string
-	cleareddeployment_target    bool
-	target_configuration        *string
-	clearedtarget_configuration bool
-	parent_job                  *string
-	clearedparent_job           bool
-	child_jobs                  map[string]struct{}
-	removedchild_jobs           map[string]struct{}
-	clearedchild_jobs           bool
-	history                     map[uint32]struct{}
-	removedhistory              map[uint32]struct{}
-	clearedhistory              bool
-	done                        bool
-	oldValue                    func(context.Context) (*DeploymentJob, error)
-	predicates                  []predicate.DeploymentJob
+	op                   Op
+	typ                  string
+	id                   *uint32
+	create_by            *uint32
+	addcreate_by         *int32
+	create_time          *time.Time
+	update_time          *time.Time
+	delete_time          *time.Time
+	tenant_id            *uint32
+	addtenant_id         *int32
+	name                 *string
+	channel              *notificationrule.Channel
+	recipients           *[]string
+	appendrecipients     []string
+	url                  *string
+	headers              *map[string]string
+	statuses             *[]string
+	appendstatuses       []string
+	deployment_target_id *string
+	enabled              *bool
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*NotificationRule, error)
+	predicates           []predicate.NotificationRule
 }
 
-var _ ent.Mutation = (*DeploymentJobMutation)(nil)
+var _ ent.Mutation = (*NotificationRuleMutation)(nil)
 
-// deploymentjobOption allows management of the mutation configuration using functional options.
-type deploymentjobOption func(*DeploymentJobMutation)
+// notificationruleOption allows management of the mutation configuration using functional options.
+type notificationruleOption func(*NotificationRuleMutation)
 
-// newDeploymentJobMutation creates new mutation for the DeploymentJob entity.
-func newDeploymentJobMutation(c config, op Op, opts ...deploymentjobOption) *DeploymentJobMutation {
-	m := &DeploymentJobMutation{
+// newNotificationRuleMutation creates new mutation for the NotificationRule entity.
+func newNotificationRuleMutation(c config, op Op, opts ...notificationruleOption) *NotificationRuleMutation {
+	m := &NotificationRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentJob,
+		typ:           TypeNotificationRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2931,20 +15119,20 @@ func newDeploymentJobMutation(c config, op Op, opts ...deploymentjobOption) *Dep
 	return m
 }
 
-// withDeploymentJobID sets the ID field of the mutation.
-func withDeploymentJobID(id string) deploymentjobOption {
-	return func(m *DeploymentJobMutation) {
+// withNotificationRuleID sets the ID field of the mutation.
+func withNotificationRuleID(id uint32) notificationruleOption {
+	return func(m *NotificationRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentJob
+			value *NotificationRule
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentJob, error) {
+		m.oldValue = func(ctx context.Context) (*NotificationRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentJob.Get(ctx, id)
+					value, err = m.Client().NotificationRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2953,10 +15141,10 @@ func withDeploymentJobID(id string) deploymentjobOption {
 	}
 }
 
-// withDeploymentJob sets the old DeploymentJob of the mutation.
-func withDeploymentJob(node *DeploymentJob) deploymentjobOption {
-	return func(m *DeploymentJobMutation) {
-		m.oldValue = func(context.Context) (*DeploymentJob, error) {
+// withNotificationRule sets the old NotificationRule of the mutation.
+func withNotificationRule(node *NotificationRule) notificationruleOption {
+	return func(m *NotificationRuleMutation) {
+		m.oldValue = func(context.Context) (*NotificationRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2965,7 +15153,7 @@ func withDeploymentJob(node *DeploymentJob) deploymentjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentJobMutation) Client() *Client {
+func (m NotificationRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2973,7 +15161,7 @@ func (m DeploymentJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentJobMutation) Tx() (*Tx, error) {
+func (m NotificationRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2983,14 +15171,14 @@ func (m DeploymentJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeploymentJob entities.
-func (m *DeploymentJobMutation) SetID(id string) {
+// operation is only accepted on creation of NotificationRule entities.
+func (m *NotificationRuleMutation) SetID(id uint32) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentJobMutation) ID() (id string, exists bool) {
+func (m *NotificationRuleMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3001,29 +15189,29 @@ func (m *DeploymentJobMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentJobMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *NotificationRuleMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uint32{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NotificationRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateBy sets the "create_by" field.
-func (m *DeploymentJobMutation) SetCreateBy(u uint32) {
+func (m *NotificationRuleMutation) SetCreateBy(u uint32) {
 	m.create_by = &u
 	m.addcreate_by = nil
 }
 
 // CreateBy returns the value of the "create_by" field in the mutation.
-func (m *DeploymentJobMutation) CreateBy() (r uint32, exists bool) {
+func (m *NotificationRuleMutation) CreateBy() (r uint32, exists bool) {
 	v := m.create_by
 	if v == nil {
 		return
@@ -3031,10 +15219,10 @@ func (m *DeploymentJobMutation) CreateBy() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateBy returns the old "create_by" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+func (m *NotificationRuleMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
 	}
@@ -3049,7 +15237,7 @@ func (m *DeploymentJobMutation) OldCreateBy(ctx context.Context) (v *uint32, err
 }
 
 // AddCreateBy adds u to the "create_by" field.
-func (m *DeploymentJobMutation) AddCreateBy(u int32) {
+func (m *NotificationRuleMutation) AddCreateBy(u int32) {
 	if m.addcreate_by != nil {
 		*m.addcreate_by += u
 	} else {
@@ -3058,7 +15246,7 @@ func (m *DeploymentJobMutation) AddCreateBy(u int32) {
 }
 
 // AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *DeploymentJobMutation) AddedCreateBy() (r int32, exists bool) {
+func (m *NotificationRuleMutation) AddedCreateBy() (r int32, exists bool) {
 	v := m.addcreate_by
 	if v == nil {
 		return
@@ -3067,32 +15255,32 @@ func (m *DeploymentJobMutation) AddedCreateBy() (r int32, exists bool) {
 }
 
 // ClearCreateBy clears the value of the "create_by" field.
-func (m *DeploymentJobMutation) ClearCreateBy() {
+func (m *NotificationRuleMutation) ClearCreateBy() {
 	m.create_by = nil
 	m.addcreate_by = nil
-	m.clearedFields[deploymentjob.FieldCreateBy] = struct{}{}
+	m.clearedFields[notificationrule.FieldCreateBy] = struct{}{}
 }
 
 // CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *DeploymentJobMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldCreateBy]
+func (m *NotificationRuleMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldCreateBy]
 	return ok
 }
 
 // ResetCreateBy resets all changes to the "create_by" field.
-func (m *DeploymentJobMutation) ResetCreateBy() {
+func (m *NotificationRuleMutation) ResetCreateBy() {
 	m.create_by = nil
 	m.addcreate_by = nil
-	delete(m.clearedFields, deploymentjob.FieldCreateBy)
+	delete(m.clearedFields, notificationrule.FieldCreateBy)
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DeploymentJobMutation) SetCreateTime(t time.Time) {
+func (m *NotificationRuleMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DeploymentJobMutation) CreateTime() (r time.Time, exists bool) {
+func (m *NotificationRuleMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3100,10 +15288,10 @@ func (m *DeploymentJobMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationRuleMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3118,30 +15306,30 @@ func (m *DeploymentJobMutation) OldCreateTime(ctx context.Context) (v *time.Time
 }
 
 // ClearCreateTime clears the value of the "create_time" field.
-func (m *DeploymentJobMutation) ClearCreateTime() {
+func (m *NotificationRuleMutation) ClearCreateTime() {
 	m.create_time = nil
-	m.clearedFields[deploymentjob.FieldCreateTime] = struct{}{}
+	m.clearedFields[notificationrule.FieldCreateTime] = struct{}{}
 }
 
 // CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *DeploymentJobMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldCreateTime]
+func (m *NotificationRuleMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldCreateTime]
 	return ok
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DeploymentJobMutation) ResetCreateTime() {
+func (m *NotificationRuleMutation) ResetCreateTime() {
 	m.create_time = nil
-	delete(m.clearedFields, deploymentjob.FieldCreateTime)
+	delete(m.clearedFields, notificationrule.FieldCreateTime)
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DeploymentJobMutation) SetUpdateTime(t time.Time) {
+func (m *NotificationRuleMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DeploymentJobMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *NotificationRuleMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3149,10 +15337,10 @@ func (m *DeploymentJobMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationRuleMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3167,30 +15355,30 @@ func (m *DeploymentJobMutation) OldUpdateTime(ctx context.Context) (v *time.Time
 }
 
 // ClearUpdateTime clears the value of the "update_time" field.
-func (m *DeploymentJobMutation) ClearUpdateTime() {
+func (m *NotificationRuleMutation) ClearUpdateTime() {
 	m.update_time = nil
-	m.clearedFields[deploymentjob.FieldUpdateTime] = struct{}{}
+	m.clearedFields[notificationrule.FieldUpdateTime] = struct{}{}
 }
 
 // UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *DeploymentJobMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldUpdateTime]
+func (m *NotificationRuleMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldUpdateTime]
 	return ok
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DeploymentJobMutation) ResetUpdateTime() {
+func (m *NotificationRuleMutation) ResetUpdateTime() {
 	m.update_time = nil
-	delete(m.clearedFields, deploymentjob.FieldUpdateTime)
+	delete(m.clearedFields, notificationrule.FieldUpdateTime)
 }
 
 // SetDeleteTime sets the "delete_time" field.
-func (m *DeploymentJobMutation) SetDeleteTime(t time.Time) {
+func (m *NotificationRuleMutation) SetDeleteTime(t time.Time) {
 	m.delete_time = &t
 }
 
 // DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *DeploymentJobMutation) DeleteTime() (r time.Time, exists bool) {
+func (m *NotificationRuleMutation) DeleteTime() (r time.Time, exists bool) {
 	v := m.delete_time
 	if v == nil {
 		return
@@ -3198,10 +15386,10 @@ func (m *DeploymentJobMutation) DeleteTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationRuleMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
@@ -3216,31 +15404,31 @@ func (m *DeploymentJobMutation) OldDeleteTime(ctx context.Context) (v *time.Time
 }
 
 // ClearDeleteTime clears the value of the "delete_time" field.
-func (m *DeploymentJobMutation) ClearDeleteTime() {
+func (m *NotificationRuleMutation) ClearDeleteTime() {
 	m.delete_time = nil
-	m.clearedFields[deploymentjob.FieldDeleteTime] = struct{}{}
+	m.clearedFields[notificationrule.FieldDeleteTime] = struct{}{}
 }
 
 // DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *DeploymentJobMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldDeleteTime]
+func (m *NotificationRuleMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldDeleteTime]
 	return ok
 }
 
 // ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *DeploymentJobMutation) ResetDeleteTime() {
+func (m *NotificationRuleMutation) ResetDeleteTime() {
 	m.delete_time = nil
-	delete(m.clearedFields, deploymentjob.FieldDeleteTime)
+	delete(m.clearedFields, notificationrule.FieldDeleteTime)
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *DeploymentJobMutation) SetTenantID(u uint32) {
+func (m *NotificationRuleMutation) SetTenantID(u uint32) {
 	m.tenant_id = &u
 	m.addtenant_id = nil
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *DeploymentJobMutation) TenantID() (r uint32, exists bool) {
+func (m *NotificationRuleMutation) TenantID() (r uint32, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -3248,10 +15436,10 @@ func (m *DeploymentJobMutation) TenantID() (r uint32, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *NotificationRuleMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -3266,7 +15454,7 @@ func (m *DeploymentJobMutation) OldTenantID(ctx context.Context) (v *uint32, err
 }
 
 // AddTenantID adds u to the "tenant_id" field.
-func (m *DeploymentJobMutation) AddTenantID(u int32) {
+func (m *NotificationRuleMutation) AddTenantID(u int32) {
 	if m.addtenant_id != nil {
 		*m.addtenant_id += u
 	} else {
@@ -3275,7 +15463,7 @@ func (m *DeploymentJobMutation) AddTenantID(u int32) {
 }
 
 // AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *DeploymentJobMutation) AddedTenantID() (r int32, exists bool) {
+func (m *NotificationRuleMutation) AddedTenantID() (r int32, exists bool) {
 	v := m.addtenant_id
 	if v == nil {
 		return
@@ -3284,940 +15472,1445 @@ func (m *DeploymentJobMutation) AddedTenantID() (r int32, exists bool) {
 }
 
 // ClearTenantID clears the value of the "tenant_id" field.
-func (m *DeploymentJobMutation) ClearTenantID() {
+func (m *NotificationRuleMutation) ClearTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	m.clearedFields[deploymentjob.FieldTenantID] = struct{}{}
+	m.clearedFields[notificationrule.FieldTenantID] = struct{}{}
 }
 
 // TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *DeploymentJobMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldTenantID]
+func (m *NotificationRuleMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldTenantID]
 	return ok
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *DeploymentJobMutation) ResetTenantID() {
+func (m *NotificationRuleMutation) ResetTenantID() {
 	m.tenant_id = nil
 	m.addtenant_id = nil
-	delete(m.clearedFields, deploymentjob.FieldTenantID)
+	delete(m.clearedFields, notificationrule.FieldTenantID)
 }
 
-// SetDeploymentTargetID sets the "deployment_target_id" field.
-func (m *DeploymentJobMutation) SetDeploymentTargetID(s string) {
-	m.deployment_target = &s
+// SetName sets the "name" field.
+func (m *NotificationRuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DeploymentTargetID returns the value of the "deployment_target_id" field in the mutation.
-func (m *DeploymentJobMutation) DeploymentTargetID() (r string, exists bool) {
-	v := m.deployment_target
+// Name returns the value of the "name" field in the mutation.
+func (m *NotificationRuleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeploymentTargetID returns the old "deployment_target_id" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldDeploymentTargetID(ctx context.Context) (v *string, err error) {
+func (m *NotificationRuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeploymentTargetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeploymentTargetID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeploymentTargetID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DeploymentTargetID, nil
+	return oldValue.Name, nil
 }
 
-// ClearDeploymentTargetID clears the value of the "deployment_target_id" field.
-func (m *DeploymentJobMutation) ClearDeploymentTargetID() {
-	m.deployment_target = nil
-	m.clearedFields[deploymentjob.FieldDeploymentTargetID] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *NotificationRuleMutation) ResetName() {
+	m.name = nil
 }
 
-// DeploymentTargetIDCleared returns if the "deployment_target_id" field was cleared in this mutation.
-func (m *DeploymentJobMutation) DeploymentTargetIDCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldDeploymentTargetID]
+// SetChannel sets the "channel" field.
+func (m *NotificationRuleMutation) SetChannel(n notificationrule.Channel) {
+	m.channel = &n
+}
+
+// Channel returns the value of the "channel" field in the mutation.
+func (m *NotificationRuleMutation) Channel() (r notificationrule.Channel, exists bool) {
+	v := m.channel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannel returns the old "channel" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldChannel(ctx context.Context) (v notificationrule.Channel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
+	}
+	return oldValue.Channel, nil
+}
+
+// ResetChannel resets all changes to the "channel" field.
+func (m *NotificationRuleMutation) ResetChannel() {
+	m.channel = nil
+}
+
+// SetRecipients sets the "recipients" field.
+func (m *NotificationRuleMutation) SetRecipients(s []string) {
+	m.recipients = &s
+	m.appendrecipients = nil
+}
+
+// Recipients returns the value of the "recipients" field in the mutation.
+func (m *NotificationRuleMutation) Recipients() (r []string, exists bool) {
+	v := m.recipients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipients returns the old "recipients" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldRecipients(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipients: %w", err)
+	}
+	return oldValue.Recipients, nil
+}
+
+// AppendRecipients adds s to the "recipients" field.
+func (m *NotificationRuleMutation) AppendRecipients(s []string) {
+	m.appendrecipients = append(m.appendrecipients, s...)
+}
+
+// AppendedRecipients returns the list of values that were appended to the "recipients" field in this mutation.
+func (m *NotificationRuleMutation) AppendedRecipients() ([]string, bool) {
+	if len(m.appendrecipients) == 0 {
+		return nil, false
+	}
+	return m.appendrecipients, true
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (m *NotificationRuleMutation) ClearRecipients() {
+	m.recipients = nil
+	m.appendrecipients = nil
+	m.clearedFields[notificationrule.FieldRecipients] = struct{}{}
+}
+
+// RecipientsCleared returns if the "recipients" field was cleared in this mutation.
+func (m *NotificationRuleMutation) RecipientsCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldRecipients]
+	return ok
+}
+
+// ResetRecipients resets all changes to the "recipients" field.
+func (m *NotificationRuleMutation) ResetRecipients() {
+	m.recipients = nil
+	m.appendrecipients = nil
+	delete(m.clearedFields, notificationrule.FieldRecipients)
+}
+
+// SetURL sets the "url" field.
+func (m *NotificationRuleMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *NotificationRuleMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *NotificationRuleMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[notificationrule.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *NotificationRuleMutation) URLCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldURL]
 	return ok
 }
 
-// ResetDeploymentTargetID resets all changes to the "deployment_target_id" field.
-func (m *DeploymentJobMutation) ResetDeploymentTargetID() {
-	m.deployment_target = nil
-	delete(m.clearedFields, deploymentjob.FieldDeploymentTargetID)
+// ResetURL resets all changes to the "url" field.
+func (m *NotificationRuleMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, notificationrule.FieldURL)
 }
 
-// SetTargetConfigurationID sets the "target_configuration_id" field.
-func (m *DeploymentJobMutation) SetTargetConfigurationID(s string) {
-	m.target_configuration = &s
+// SetHeaders sets the "headers" field.
+func (m *NotificationRuleMutation) SetHeaders(value map[string]string) {
+	m.headers = &value
 }
 
-// TargetConfigurationID returns the value of the "target_configuration_id" field in the mutation.
-func (m *DeploymentJobMutation) TargetConfigurationID() (r string, exists bool) {
-	v := m.target_configuration
+// Headers returns the value of the "headers" field in the mutation.
+func (m *NotificationRuleMutation) Headers() (r map[string]string, exists bool) {
+	v := m.headers
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetConfigurationID returns the old "target_configuration_id" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldHeaders returns the old "headers" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldTargetConfigurationID(ctx context.Context) (v *string, err error) {
+func (m *NotificationRuleMutation) OldHeaders(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetConfigurationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHeaders is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetConfigurationID requires an ID field in the mutation")
+		return v, errors.New("OldHeaders requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetConfigurationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHeaders: %w", err)
 	}
-	return oldValue.TargetConfigurationID, nil
+	return oldValue.Headers, nil
 }
 
-// ClearTargetConfigurationID clears the value of the "target_configuration_id" field.
-func (m *DeploymentJobMutation) ClearTargetConfigurationID() {
-	m.target_configuration = nil
-	m.clearedFields[deploymentjob.FieldTargetConfigurationID] = struct{}{}
+// ClearHeaders clears the value of the "headers" field.
+func (m *NotificationRuleMutation) ClearHeaders() {
+	m.headers = nil
+	m.clearedFields[notificationrule.FieldHeaders] = struct{}{}
 }
 
-// TargetConfigurationIDCleared returns if the "target_configuration_id" field was cleared in this mutation.
-func (m *DeploymentJobMutation) TargetConfigurationIDCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldTargetConfigurationID]
+// HeadersCleared returns if the "headers" field was cleared in this mutation.
+func (m *NotificationRuleMutation) HeadersCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldHeaders]
 	return ok
 }
 
-// ResetTargetConfigurationID resets all changes to the "target_configuration_id" field.
-func (m *DeploymentJobMutation) ResetTargetConfigurationID() {
-	m.target_configuration = nil
-	delete(m.clearedFields, deploymentjob.FieldTargetConfigurationID)
+// ResetHeaders resets all changes to the "headers" field.
+func (m *NotificationRuleMutation) ResetHeaders() {
+	m.headers = nil
+	delete(m.clearedFields, notificationrule.FieldHeaders)
 }
 
-// SetParentJobID sets the "parent_job_id" field.
-func (m *DeploymentJobMutation) SetParentJobID(s string) {
-	m.parent_job = &s
+// SetStatuses sets the "statuses" field.
+func (m *NotificationRuleMutation) SetStatuses(s []string) {
+	m.statuses = &s
+	m.appendstatuses = nil
 }
 
-// ParentJobID returns the value of the "parent_job_id" field in the mutation.
-func (m *DeploymentJobMutation) ParentJobID() (r string, exists bool) {
-	v := m.parent_job
+// Statuses returns the value of the "statuses" field in the mutation.
+func (m *NotificationRuleMutation) Statuses() (r []string, exists bool) {
+	v := m.statuses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentJobID returns the old "parent_job_id" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldStatuses returns the old "statuses" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldParentJobID(ctx context.Context) (v *string, err error) {
+func (m *NotificationRuleMutation) OldStatuses(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentJobID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatuses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentJobID requires an ID field in the mutation")
+		return v, errors.New("OldStatuses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentJobID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatuses: %w", err)
 	}
-	return oldValue.ParentJobID, nil
+	return oldValue.Statuses, nil
 }
 
-// ClearParentJobID clears the value of the "parent_job_id" field.
-func (m *DeploymentJobMutation) ClearParentJobID() {
-	m.parent_job = nil
-	m.clearedFields[deploymentjob.FieldParentJobID] = struct{}{}
+// AppendStatuses adds s to the "statuses" field.
+func (m *NotificationRuleMutation) AppendStatuses(s []string) {
+	m.appendstatuses = append(m.appendstatuses, s...)
 }
 
-// ParentJobIDCleared returns if the "parent_job_id" field was cleared in this mutation.
-func (m *DeploymentJobMutation) ParentJobIDCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldParentJobID]
+// AppendedStatuses returns the list of values that were appended to the "statuses" field in this mutation.
+func (m *NotificationRuleMutation) AppendedStatuses() ([]string, bool) {
+	if len(m.appendstatuses) == 0 {
+		return nil, false
+	}
+	return m.appendstatuses, true
+}
+
+// ClearStatuses clears the value of the "statuses" field.
+func (m *NotificationRuleMutation) ClearStatuses() {
+	m.statuses = nil
+	m.appendstatuses = nil
+	m.clearedFields[notificationrule.FieldStatuses] = struct{}{}
+}
+
+// StatusesCleared returns if the "statuses" field was cleared in this mutation.
+func (m *NotificationRuleMutation) StatusesCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldStatuses]
 	return ok
 }
 
-// ResetParentJobID resets all changes to the "parent_job_id" field.
-func (m *DeploymentJobMutation) ResetParentJobID() {
-	m.parent_job = nil
-	delete(m.clearedFields, deploymentjob.FieldParentJobID)
+// ResetStatuses resets all changes to the "statuses" field.
+func (m *NotificationRuleMutation) ResetStatuses() {
+	m.statuses = nil
+	m.appendstatuses = nil
+	delete(m.clearedFields, notificationrule.FieldStatuses)
 }
 
-// SetCertificateID sets the "certificate_id" field.
-func (m *DeploymentJobMutation) SetCertificateID(s string) {
-	m.certificate_id = &s
+// SetDeploymentTargetID sets the "deployment_target_id" field.
+func (m *NotificationRuleMutation) SetDeploymentTargetID(s string) {
+	m.deployment_target_id = &s
 }
 
-// CertificateID returns the value of the "certificate_id" field in the mutation.
-func (m *DeploymentJobMutation) CertificateID() (r string, exists bool) {
-	v := m.certificate_id
+// DeploymentTargetID returns the value of the "deployment_target_id" field in the mutation.
+func (m *NotificationRuleMutation) DeploymentTargetID() (r string, exists bool) {
+	v := m.deployment_target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCertificateID returns the old "certificate_id" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldDeploymentTargetID returns the old "deployment_target_id" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldCertificateID(ctx context.Context) (v string, err error) {
+func (m *NotificationRuleMutation) OldDeploymentTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeploymentTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+		return v, errors.New("OldDeploymentTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeploymentTargetID: %w", err)
 	}
-	return oldValue.CertificateID, nil
+	return oldValue.DeploymentTargetID, nil
 }
 
-// ResetCertificateID resets all changes to the "certificate_id" field.
-func (m *DeploymentJobMutation) ResetCertificateID() {
-	m.certificate_id = nil
+// ResetDeploymentTargetID resets all changes to the "deployment_target_id" field.
+func (m *NotificationRuleMutation) ResetDeploymentTargetID() {
+	m.deployment_target_id = nil
 }
 
-// SetCertificateSerial sets the "certificate_serial" field.
-func (m *DeploymentJobMutation) SetCertificateSerial(s string) {
-	m.certificate_serial = &s
+// SetEnabled sets the "enabled" field.
+func (m *NotificationRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// CertificateSerial returns the value of the "certificate_serial" field in the mutation.
-func (m *DeploymentJobMutation) CertificateSerial() (r string, exists bool) {
-	v := m.certificate_serial
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *NotificationRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCertificateSerial returns the old "certificate_serial" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldCertificateSerial(ctx context.Context) (v string, err error) {
+func (m *NotificationRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCertificateSerial is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCertificateSerial requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCertificateSerial: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.CertificateSerial, nil
+	return oldValue.Enabled, nil
 }
 
-// ClearCertificateSerial clears the value of the "certificate_serial" field.
-func (m *DeploymentJobMutation) ClearCertificateSerial() {
-	m.certificate_serial = nil
-	m.clearedFields[deploymentjob.FieldCertificateSerial] = struct{}{}
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *NotificationRuleMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// CertificateSerialCleared returns if the "certificate_serial" field was cleared in this mutation.
-func (m *DeploymentJobMutation) CertificateSerialCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldCertificateSerial]
-	return ok
+// Where appends a list predicates to the NotificationRuleMutation builder.
+func (m *NotificationRuleMutation) Where(ps ...predicate.NotificationRule) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetCertificateSerial resets all changes to the "certificate_serial" field.
-func (m *DeploymentJobMutation) ResetCertificateSerial() {
-	m.certificate_serial = nil
-	delete(m.clearedFields, deploymentjob.FieldCertificateSerial)
+// WhereP appends storage-level predicates to the NotificationRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetStatus sets the "status" field.
-func (m *DeploymentJobMutation) SetStatus(d deploymentjob.Status) {
-	m.status = &d
+// Op returns the operation name.
+func (m *NotificationRuleMutation) Op() Op {
+	return m.op
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DeploymentJobMutation) Status() (r deploymentjob.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *NotificationRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationRule).
+func (m *NotificationRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationRuleMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_by != nil {
+		fields = append(fields, notificationrule.FieldCreateBy)
 	}
-	return *v, true
+	if m.create_time != nil {
+		fields = append(fields, notificationrule.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, notificationrule.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, notificationrule.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, notificationrule.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, notificationrule.FieldName)
+	}
+	if m.channel != nil {
+		fields = append(fields, notificationrule.FieldChannel)
+	}
+	if m.recipients != nil {
+		fields = append(fields, notificationrule.FieldRecipients)
+	}
+	if m.url != nil {
+		fields = append(fields, notificationrule.FieldURL)
+	}
+	if m.headers != nil {
+		fields = append(fields, notificationrule.FieldHeaders)
+	}
+	if m.statuses != nil {
+		fields = append(fields, notificationrule.FieldStatuses)
+	}
+	if m.deployment_target_id != nil {
+		fields = append(fields, notificationrule.FieldDeploymentTargetID)
+	}
+	if m.enabled != nil {
+		fields = append(fields, notificationrule.FieldEnabled)
+	}
+	return fields
 }
 
-// OldStatus returns the old "status" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldStatus(ctx context.Context) (v deploymentjob.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		return m.CreateBy()
+	case notificationrule.FieldCreateTime:
+		return m.CreateTime()
+	case notificationrule.FieldUpdateTime:
+		return m.UpdateTime()
+	case notificationrule.FieldDeleteTime:
+		return m.DeleteTime()
+	case notificationrule.FieldTenantID:
+		return m.TenantID()
+	case notificationrule.FieldName:
+		return m.Name()
+	case notificationrule.FieldChannel:
+		return m.Channel()
+	case notificationrule.FieldRecipients:
+		return m.Recipients()
+	case notificationrule.FieldURL:
+		return m.URL()
+	case notificationrule.FieldHeaders:
+		return m.Headers()
+	case notificationrule.FieldStatuses:
+		return m.Statuses()
+	case notificationrule.FieldDeploymentTargetID:
+		return m.DeploymentTargetID()
+	case notificationrule.FieldEnabled:
+		return m.Enabled()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case notificationrule.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case notificationrule.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case notificationrule.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case notificationrule.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case notificationrule.FieldName:
+		return m.OldName(ctx)
+	case notificationrule.FieldChannel:
+		return m.OldChannel(ctx)
+	case notificationrule.FieldRecipients:
+		return m.OldRecipients(ctx)
+	case notificationrule.FieldURL:
+		return m.OldURL(ctx)
+	case notificationrule.FieldHeaders:
+		return m.OldHeaders(ctx)
+	case notificationrule.FieldStatuses:
+		return m.OldStatuses(ctx)
+	case notificationrule.FieldDeploymentTargetID:
+		return m.OldDeploymentTargetID(ctx)
+	case notificationrule.FieldEnabled:
+		return m.OldEnabled(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	return nil, fmt.Errorf("unknown NotificationRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case notificationrule.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case notificationrule.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case notificationrule.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case notificationrule.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case notificationrule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case notificationrule.FieldChannel:
+		v, ok := value.(notificationrule.Channel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	case notificationrule.FieldRecipients:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipients(v)
+		return nil
+	case notificationrule.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case notificationrule.FieldHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeaders(v)
+		return nil
+	case notificationrule.FieldStatuses:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatuses(v)
+		return nil
+	case notificationrule.FieldDeploymentTargetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentTargetID(v)
+		return nil
+	case notificationrule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
 	}
-	return oldValue.Status, nil
+	return fmt.Errorf("unknown NotificationRule field %s", name)
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DeploymentJobMutation) ResetStatus() {
-	m.status = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, notificationrule.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, notificationrule.FieldTenantID)
+	}
+	return fields
 }
 
-// SetStatusMessage sets the "status_message" field.
-func (m *DeploymentJobMutation) SetStatusMessage(s string) {
-	m.status_message = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		return m.AddedCreateBy()
+	case notificationrule.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
 }
 
-// StatusMessage returns the value of the "status_message" field in the mutation.
-func (m *DeploymentJobMutation) StatusMessage() (r string, exists bool) {
-	v := m.status_message
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case notificationrule.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown NotificationRule numeric field %s", name)
 }
 
-// OldStatusMessage returns the old "status_message" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationrule.FieldCreateBy) {
+		fields = append(fields, notificationrule.FieldCreateBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	if m.FieldCleared(notificationrule.FieldCreateTime) {
+		fields = append(fields, notificationrule.FieldCreateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	if m.FieldCleared(notificationrule.FieldUpdateTime) {
+		fields = append(fields, notificationrule.FieldUpdateTime)
 	}
-	return oldValue.StatusMessage, nil
-}
-
-// ClearStatusMessage clears the value of the "status_message" field.
-func (m *DeploymentJobMutation) ClearStatusMessage() {
-	m.status_message = nil
-	m.clearedFields[deploymentjob.FieldStatusMessage] = struct{}{}
-}
-
-// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
-func (m *DeploymentJobMutation) StatusMessageCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldStatusMessage]
-	return ok
-}
-
-// ResetStatusMessage resets all changes to the "status_message" field.
-func (m *DeploymentJobMutation) ResetStatusMessage() {
-	m.status_message = nil
-	delete(m.clearedFields, deploymentjob.FieldStatusMessage)
-}
-
-// SetProgress sets the "progress" field.
-func (m *DeploymentJobMutation) SetProgress(i int32) {
-	m.progress = &i
-	m.addprogress = nil
-}
-
-// Progress returns the value of the "progress" field in the mutation.
-func (m *DeploymentJobMutation) Progress() (r int32, exists bool) {
-	v := m.progress
-	if v == nil {
-		return
+	if m.FieldCleared(notificationrule.FieldDeleteTime) {
+		fields = append(fields, notificationrule.FieldDeleteTime)
 	}
-	return *v, true
-}
-
-// OldProgress returns the old "progress" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldProgress(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+	if m.FieldCleared(notificationrule.FieldTenantID) {
+		fields = append(fields, notificationrule.FieldTenantID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProgress requires an ID field in the mutation")
+	if m.FieldCleared(notificationrule.FieldRecipients) {
+		fields = append(fields, notificationrule.FieldRecipients)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	if m.FieldCleared(notificationrule.FieldURL) {
+		fields = append(fields, notificationrule.FieldURL)
 	}
-	return oldValue.Progress, nil
+	if m.FieldCleared(notificationrule.FieldHeaders) {
+		fields = append(fields, notificationrule.FieldHeaders)
+	}
+	if m.FieldCleared(notificationrule.FieldStatuses) {
+		fields = append(fields, notificationrule.FieldStatuses)
+	}
+	return fields
 }
 
-// AddProgress adds i to the "progress" field.
-func (m *DeploymentJobMutation) AddProgress(i int32) {
-	if m.addprogress != nil {
-		*m.addprogress += i
-	} else {
-		m.addprogress = &i
-	}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddedProgress returns the value that was added to the "progress" field in this mutation.
-func (m *DeploymentJobMutation) AddedProgress() (r int32, exists bool) {
-	v := m.addprogress
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationRuleMutation) ClearField(name string) error {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case notificationrule.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case notificationrule.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case notificationrule.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case notificationrule.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case notificationrule.FieldRecipients:
+		m.ClearRecipients()
+		return nil
+	case notificationrule.FieldURL:
+		m.ClearURL()
+		return nil
+	case notificationrule.FieldHeaders:
+		m.ClearHeaders()
+		return nil
+	case notificationrule.FieldStatuses:
+		m.ClearStatuses()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown NotificationRule nullable field %s", name)
 }
 
-// ResetProgress resets all changes to the "progress" field.
-func (m *DeploymentJobMutation) ResetProgress() {
-	m.progress = nil
-	m.addprogress = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationRuleMutation) ResetField(name string) error {
+	switch name {
+	case notificationrule.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case notificationrule.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case notificationrule.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case notificationrule.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case notificationrule.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case notificationrule.FieldName:
+		m.ResetName()
+		return nil
+	case notificationrule.FieldChannel:
+		m.ResetChannel()
+		return nil
+	case notificationrule.FieldRecipients:
+		m.ResetRecipients()
+		return nil
+	case notificationrule.FieldURL:
+		m.ResetURL()
+		return nil
+	case notificationrule.FieldHeaders:
+		m.ResetHeaders()
+		return nil
+	case notificationrule.FieldStatuses:
+		m.ResetStatuses()
+		return nil
+	case notificationrule.FieldDeploymentTargetID:
+		m.ResetDeploymentTargetID()
+		return nil
+	case notificationrule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationRule field %s", name)
 }
 
-// SetRetryCount sets the "retry_count" field.
-func (m *DeploymentJobMutation) SetRetryCount(i int32) {
-	m.retry_count = &i
-	m.addretry_count = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RetryCount returns the value of the "retry_count" field in the mutation.
-func (m *DeploymentJobMutation) RetryCount() (r int32, exists bool) {
-	v := m.retry_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldRetryCount returns the old "retry_count" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldRetryCount(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRetryCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRetryCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRetryCount: %w", err)
-	}
-	return oldValue.RetryCount, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddRetryCount adds i to the "retry_count" field.
-func (m *DeploymentJobMutation) AddRetryCount(i int32) {
-	if m.addretry_count != nil {
-		*m.addretry_count += i
-	} else {
-		m.addretry_count = &i
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddedRetryCount returns the value that was added to the "retry_count" field in this mutation.
-func (m *DeploymentJobMutation) AddedRetryCount() (r int32, exists bool) {
-	v := m.addretry_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetRetryCount resets all changes to the "retry_count" field.
-func (m *DeploymentJobMutation) ResetRetryCount() {
-	m.retry_count = nil
-	m.addretry_count = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationRuleMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetMaxRetries sets the "max_retries" field.
-func (m *DeploymentJobMutation) SetMaxRetries(i int32) {
-	m.max_retries = &i
-	m.addmax_retries = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationRule unique edge %s", name)
 }
 
-// MaxRetries returns the value of the "max_retries" field in the mutation.
-func (m *DeploymentJobMutation) MaxRetries() (r int32, exists bool) {
-	v := m.max_retries
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationRule edge %s", name)
 }
 
-// OldMaxRetries returns the old "max_retries" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldMaxRetries(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxRetries is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxRetries requires an ID field in the mutation")
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uint32
+	create_time     *time.Time
+	update_time     *time.Time
+	delete_time     *time.Time
+	event_id        *string
+	channel         *string
+	payload         *string
+	attempts        *int32
+	addattempts     *int32
+	last_error      *string
+	next_attempt_at *time.Time
+	published_at    *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OutboxEvent, error)
+	predicates      []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxRetries: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.MaxRetries, nil
+	return m
 }
 
-// AddMaxRetries adds i to the "max_retries" field.
-func (m *DeploymentJobMutation) AddMaxRetries(i int32) {
-	if m.addmax_retries != nil {
-		*m.addmax_retries += i
-	} else {
-		m.addmax_retries = &i
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id uint32) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedMaxRetries returns the value that was added to the "max_retries" field in this mutation.
-func (m *DeploymentJobMutation) AddedMaxRetries() (r int32, exists bool) {
-	v := m.addmax_retries
-	if v == nil {
-		return
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetMaxRetries resets all changes to the "max_retries" field.
-func (m *DeploymentJobMutation) ResetMaxRetries() {
-	m.max_retries = nil
-	m.addmax_retries = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetTriggeredBy sets the "triggered_by" field.
-func (m *DeploymentJobMutation) SetTriggeredBy(db deploymentjob.TriggeredBy) {
-	m.triggered_by = &db
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// TriggeredBy returns the value of the "triggered_by" field in the mutation.
-func (m *DeploymentJobMutation) TriggeredBy() (r deploymentjob.TriggeredBy, exists bool) {
-	v := m.triggered_by
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OutboxEvent entities.
+func (m *OutboxEventMutation) SetID(id uint32) {
+	m.id = &id
 }
 
-// OldTriggeredBy returns the old "triggered_by" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldTriggeredBy(ctx context.Context) (v deploymentjob.TriggeredBy, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggeredBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggeredBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggeredBy: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.TriggeredBy, nil
+	return *m.id, true
 }
 
-// ResetTriggeredBy resets all changes to the "triggered_by" field.
-func (m *DeploymentJobMutation) ResetTriggeredBy() {
-	m.triggered_by = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetResult sets the "result" field.
-func (m *DeploymentJobMutation) SetResult(value map[string]interface{}) {
-	m.result = &value
+// SetCreateTime sets the "create_time" field.
+func (m *OutboxEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// Result returns the value of the "result" field in the mutation.
-func (m *DeploymentJobMutation) Result() (r map[string]interface{}, exists bool) {
-	v := m.result
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OutboxEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResult returns the old "result" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldResult(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *OutboxEventMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResult requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.Result, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearResult clears the value of the "result" field.
-func (m *DeploymentJobMutation) ClearResult() {
-	m.result = nil
-	m.clearedFields[deploymentjob.FieldResult] = struct{}{}
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *OutboxEventMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[outboxevent.FieldCreateTime] = struct{}{}
 }
 
-// ResultCleared returns if the "result" field was cleared in this mutation.
-func (m *DeploymentJobMutation) ResultCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldResult]
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *OutboxEventMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldCreateTime]
 	return ok
 }
 
-// ResetResult resets all changes to the "result" field.
-func (m *DeploymentJobMutation) ResetResult() {
-	m.result = nil
-	delete(m.clearedFields, deploymentjob.FieldResult)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OutboxEventMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, outboxevent.FieldCreateTime)
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *DeploymentJobMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetUpdateTime sets the "update_time" field.
+func (m *OutboxEventMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *DeploymentJobMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OutboxEventMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *OutboxEventMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *DeploymentJobMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[deploymentjob.FieldStartedAt] = struct{}{}
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *OutboxEventMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[outboxevent.FieldUpdateTime] = struct{}{}
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *DeploymentJobMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldStartedAt]
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *OutboxEventMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldUpdateTime]
 	return ok
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *DeploymentJobMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, deploymentjob.FieldStartedAt)
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OutboxEventMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, outboxevent.FieldUpdateTime)
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *DeploymentJobMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// SetDeleteTime sets the "delete_time" field.
+func (m *OutboxEventMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *DeploymentJobMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *OutboxEventMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteTime returns the old "delete_time" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *OutboxEventMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.DeleteTime, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *DeploymentJobMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[deploymentjob.FieldCompletedAt] = struct{}{}
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *OutboxEventMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[outboxevent.FieldDeleteTime] = struct{}{}
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *DeploymentJobMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldCompletedAt]
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *OutboxEventMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldDeleteTime]
 	return ok
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *DeploymentJobMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, deploymentjob.FieldCompletedAt)
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *OutboxEventMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, outboxevent.FieldDeleteTime)
 }
 
-// SetNextRetryAt sets the "next_retry_at" field.
-func (m *DeploymentJobMutation) SetNextRetryAt(t time.Time) {
-	m.next_retry_at = &t
+// SetEventID sets the "event_id" field.
+func (m *OutboxEventMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// NextRetryAt returns the value of the "next_retry_at" field in the mutation.
-func (m *DeploymentJobMutation) NextRetryAt() (r time.Time, exists bool) {
-	v := m.next_retry_at
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *OutboxEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNextRetryAt returns the old "next_retry_at" field's value of the DeploymentJob entity.
-// If the DeploymentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentJobMutation) OldNextRetryAt(ctx context.Context) (v *time.Time, err error) {
+func (m *OutboxEventMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRetryAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRetryAt requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRetryAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.NextRetryAt, nil
-}
-
-// ClearNextRetryAt clears the value of the "next_retry_at" field.
-func (m *DeploymentJobMutation) ClearNextRetryAt() {
-	m.next_retry_at = nil
-	m.clearedFields[deploymentjob.FieldNextRetryAt] = struct{}{}
-}
-
-// NextRetryAtCleared returns if the "next_retry_at" field was cleared in this mutation.
-func (m *DeploymentJobMutation) NextRetryAtCleared() bool {
-	_, ok := m.clearedFields[deploymentjob.FieldNextRetryAt]
-	return ok
-}
-
-// ResetNextRetryAt resets all changes to the "next_retry_at" field.
-func (m *DeploymentJobMutation) ResetNextRetryAt() {
-	m.next_retry_at = nil
-	delete(m.clearedFields, deploymentjob.FieldNextRetryAt)
+	return oldValue.EventID, nil
 }
 
-// ClearDeploymentTarget clears the "deployment_target" edge to the DeploymentTarget entity.
-func (m *DeploymentJobMutation) ClearDeploymentTarget() {
-	m.cleareddeployment_target = true
-	m.clearedFields[deploymentjob.FieldDeploymentTargetID] = struct{}{}
+// ResetEventID resets all changes to the "event_id" field.
+func (m *OutboxEventMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// DeploymentTargetCleared reports if the "deployment_target" edge to the DeploymentTarget entity was cleared.
-func (m *DeploymentJobMutation) DeploymentTargetCleared() bool {
-	return m.DeploymentTargetIDCleared() || m.cleareddeployment_target
+// SetChannel sets the "channel" field.
+func (m *OutboxEventMutation) SetChannel(s string) {
+	m.channel = &s
 }
 
-// DeploymentTargetIDs returns the "deployment_target" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentTargetID instead. It exists only for internal usage by the builders.
-func (m *DeploymentJobMutation) DeploymentTargetIDs() (ids []string) {
-	if id := m.deployment_target; id != nil {
-		ids = append(ids, *id)
+// Channel returns the value of the "channel" field in the mutation.
+func (m *OutboxEventMutation) Channel() (r string, exists bool) {
+	v := m.channel
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetDeploymentTarget resets all changes to the "deployment_target" edge.
-func (m *DeploymentJobMutation) ResetDeploymentTarget() {
-	m.deployment_target = nil
-	m.cleareddeployment_target = false
+// OldChannel returns the old "channel" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldChannel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
+	}
+	return oldValue.Channel, nil
 }
 
-// ClearTargetConfiguration clears the "target_configuration" edge to the TargetConfiguration entity.
-func (m *DeploymentJobMutation) ClearTargetConfiguration() {
-	m.clearedtarget_configuration = true
-	m.clearedFields[deploymentjob.FieldTargetConfigurationID] = struct{}{}
+// ResetChannel resets all changes to the "channel" field.
+func (m *OutboxEventMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// TargetConfigurationCleared reports if the "target_configuration" edge to the TargetConfiguration entity was cleared.
-func (m *DeploymentJobMutation) TargetConfigurationCleared() bool {
-	return m.TargetConfigurationIDCleared() || m.clearedtarget_configuration
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// TargetConfigurationIDs returns the "target_configuration" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetConfigurationID instead. It exists only for internal usage by the builders.
-func (m *DeploymentJobMutation) TargetConfigurationIDs() (ids []string) {
-	if id := m.target_configuration; id != nil {
-		ids = append(ids, *id)
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTargetConfiguration resets all changes to the "target_configuration" edge.
-func (m *DeploymentJobMutation) ResetTargetConfiguration() {
-	m.target_configuration = nil
-	m.clearedtarget_configuration = false
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// ClearParentJob clears the "parent_job" edge to the DeploymentJob entity.
-func (m *DeploymentJobMutation) ClearParentJob() {
-	m.clearedparent_job = true
-	m.clearedFields[deploymentjob.FieldParentJobID] = struct{}{}
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// ParentJobCleared reports if the "parent_job" edge to the DeploymentJob entity was cleared.
-func (m *DeploymentJobMutation) ParentJobCleared() bool {
-	return m.ParentJobIDCleared() || m.clearedparent_job
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// ParentJobIDs returns the "parent_job" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentJobID instead. It exists only for internal usage by the builders.
-func (m *DeploymentJobMutation) ParentJobIDs() (ids []string) {
-	if id := m.parent_job; id != nil {
-		ids = append(ids, *id)
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetParentJob resets all changes to the "parent_job" edge.
-func (m *DeploymentJobMutation) ResetParentJob() {
-	m.parent_job = nil
-	m.clearedparent_job = false
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// AddChildJobIDs adds the "child_jobs" edge to the DeploymentJob entity by ids.
-func (m *DeploymentJobMutation) AddChildJobIDs(ids ...string) {
-	if m.child_jobs == nil {
-		m.child_jobs = make(map[string]struct{})
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
-	for i := range ids {
-		m.child_jobs[ids[i]] = struct{}{}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildJobs clears the "child_jobs" edge to the DeploymentJob entity.
-func (m *DeploymentJobMutation) ClearChildJobs() {
-	m.clearedchild_jobs = true
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// ChildJobsCleared reports if the "child_jobs" edge to the DeploymentJob entity was cleared.
-func (m *DeploymentJobMutation) ChildJobsCleared() bool {
-	return m.clearedchild_jobs
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// RemoveChildJobIDs removes the "child_jobs" edge to the DeploymentJob entity by IDs.
-func (m *DeploymentJobMutation) RemoveChildJobIDs(ids ...string) {
-	if m.removedchild_jobs == nil {
-		m.removedchild_jobs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.child_jobs, ids[i])
-		m.removedchild_jobs[ids[i]] = struct{}{}
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChildJobs returns the removed IDs of the "child_jobs" edge to the DeploymentJob entity.
-func (m *DeploymentJobMutation) RemovedChildJobsIDs() (ids []string) {
-	for id := range m.removedchild_jobs {
-		ids = append(ids, id)
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// ChildJobsIDs returns the "child_jobs" edge IDs in the mutation.
-func (m *DeploymentJobMutation) ChildJobsIDs() (ids []string) {
-	for id := range m.child_jobs {
-		ids = append(ids, id)
-	}
-	return
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
 }
 
-// ResetChildJobs resets all changes to the "child_jobs" edge.
-func (m *DeploymentJobMutation) ResetChildJobs() {
-	m.child_jobs = nil
-	m.clearedchild_jobs = false
-	m.removedchild_jobs = nil
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
 }
 
-// AddHistoryIDs adds the "history" edge to the DeploymentHistory entity by ids.
-func (m *DeploymentJobMutation) AddHistoryIDs(ids ...uint32) {
-	if m.history == nil {
-		m.history = make(map[uint32]struct{})
-	}
-	for i := range ids {
-		m.history[ids[i]] = struct{}{}
-	}
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
 }
 
-// ClearHistory clears the "history" edge to the DeploymentHistory entity.
-func (m *DeploymentJobMutation) ClearHistory() {
-	m.clearedhistory = true
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEventMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// HistoryCleared reports if the "history" edge to the DeploymentHistory entity was cleared.
-func (m *DeploymentJobMutation) HistoryCleared() bool {
-	return m.clearedhistory
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEventMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveHistoryIDs removes the "history" edge to the DeploymentHistory entity by IDs.
-func (m *DeploymentJobMutation) RemoveHistoryIDs(ids ...uint32) {
-	if m.removedhistory == nil {
-		m.removedhistory = make(map[uint32]struct{})
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.history, ids[i])
-		m.removedhistory[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
 }
 
-// RemovedHistory returns the removed IDs of the "history" edge to the DeploymentHistory entity.
-func (m *DeploymentJobMutation) RemovedHistoryIDs() (ids []uint32) {
-	for id := range m.removedhistory {
-		ids = append(ids, id)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEventMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *OutboxEventMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *OutboxEventMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// HistoryIDs returns the "history" edge IDs in the mutation.
-func (m *DeploymentJobMutation) HistoryIDs() (ids []uint32) {
-	for id := range m.history {
-		ids = append(ids, id)
+// OldPublishedAt returns the old "published_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
 }
 
-// ResetHistory resets all changes to the "history" edge.
-func (m *DeploymentJobMutation) ResetHistory() {
-	m.history = nil
-	m.clearedhistory = false
-	m.removedhistory = nil
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *OutboxEventMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[outboxevent.FieldPublishedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the DeploymentJobMutation builder.
-func (m *DeploymentJobMutation) Where(ps ...predicate.DeploymentJob) {
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *OutboxEventMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, outboxevent.FieldPublishedAt)
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentJobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentJob, len(ps))
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4225,84 +16918,54 @@ func (m *DeploymentJobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentJobMutation) Op() Op {
+func (m *OutboxEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentJobMutation) SetOp(op Op) {
+func (m *OutboxEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentJob).
-func (m *DeploymentJobMutation) Type() string {
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentJobMutation) Fields() []string {
-	fields := make([]string, 0, 20)
-	if m.create_by != nil {
-		fields = append(fields, deploymentjob.FieldCreateBy)
-	}
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
-		fields = append(fields, deploymentjob.FieldCreateTime)
+		fields = append(fields, outboxevent.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, deploymentjob.FieldUpdateTime)
+		fields = append(fields, outboxevent.FieldUpdateTime)
 	}
 	if m.delete_time != nil {
-		fields = append(fields, deploymentjob.FieldDeleteTime)
-	}
-	if m.tenant_id != nil {
-		fields = append(fields, deploymentjob.FieldTenantID)
-	}
-	if m.deployment_target != nil {
-		fields = append(fields, deploymentjob.FieldDeploymentTargetID)
-	}
-	if m.target_configuration != nil {
-		fields = append(fields, deploymentjob.FieldTargetConfigurationID)
-	}
-	if m.parent_job != nil {
-		fields = append(fields, deploymentjob.FieldParentJobID)
-	}
-	if m.certificate_id != nil {
-		fields = append(fields, deploymentjob.FieldCertificateID)
-	}
-	if m.certificate_serial != nil {
-		fields = append(fields, deploymentjob.FieldCertificateSerial)
+		fields = append(fields, outboxevent.FieldDeleteTime)
 	}
-	if m.status != nil {
-		fields = append(fields, deploymentjob.FieldStatus)
-	}
-	if m.status_message != nil {
-		fields = append(fields, deploymentjob.FieldStatusMessage)
-	}
-	if m.progress != nil {
-		fields = append(fields, deploymentjob.FieldProgress)
+	if m.event_id != nil {
+		fields = append(fields, outboxevent.FieldEventID)
 	}
-	if m.retry_count != nil {
-		fields = append(fields, deploymentjob.FieldRetryCount)
-	}
-	if m.max_retries != nil {
-		fields = append(fields, deploymentjob.FieldMaxRetries)
-	}
-	if m.triggered_by != nil {
-		fields = append(fields, deploymentjob.FieldTriggeredBy)
+	if m.channel != nil {
+		fields = append(fields, outboxevent.FieldChannel)
 	}
-	if m.result != nil {
-		fields = append(fields, deploymentjob.FieldResult)
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
 	}
-	if m.started_at != nil {
-		fields = append(fields, deploymentjob.FieldStartedAt)
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, deploymentjob.FieldCompletedAt)
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
 	}
-	if m.next_retry_at != nil {
-		fields = append(fields, deploymentjob.FieldNextRetryAt)
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxevent.FieldNextAttemptAt)
+	}
+	if m.published_at != nil {
+		fields = append(fields, outboxevent.FieldPublishedAt)
 	}
 	return fields
 }
@@ -4310,48 +16973,28 @@ func (m *DeploymentJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentJobMutation) Field(name string) (ent.Value, bool) {
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentjob.FieldCreateBy:
-		return m.CreateBy()
-	case deploymentjob.FieldCreateTime:
+	case outboxevent.FieldCreateTime:
 		return m.CreateTime()
-	case deploymentjob.FieldUpdateTime:
+	case outboxevent.FieldUpdateTime:
 		return m.UpdateTime()
-	case deploymentjob.FieldDeleteTime:
+	case outboxevent.FieldDeleteTime:
 		return m.DeleteTime()
-	case deploymentjob.FieldTenantID:
-		return m.TenantID()
-	case deploymentjob.FieldDeploymentTargetID:
-		return m.DeploymentTargetID()
-	case deploymentjob.FieldTargetConfigurationID:
-		return m.TargetConfigurationID()
-	case deploymentjob.FieldParentJobID:
-		return m.ParentJobID()
-	case deploymentjob.FieldCertificateID:
-		return m.CertificateID()
-	case deploymentjob.FieldCertificateSerial:
-		return m.CertificateSerial()
-	case deploymentjob.FieldStatus:
-		return m.Status()
-	case deploymentjob.FieldStatusMessage:
-		return m.StatusMessage()
-	case deploymentjob.FieldProgress:
-		return m.Progress()
-	case deploymentjob.FieldRetryCount:
-		return m.RetryCount()
-	case deploymentjob.FieldMaxRetries:
-		return m.MaxRetries()
-	case deploymentjob.FieldTriggeredBy:
-		return m.TriggeredBy()
-	case deploymentjob.FieldResult:
-		return m.Result()
-	case deploymentjob.FieldStartedAt:
-		return m.StartedAt()
-	case deploymentjob.FieldCompletedAt:
-		return m.CompletedAt()
-	case deploymentjob.FieldNextRetryAt:
-		return m.NextRetryAt()
+	case outboxevent.FieldEventID:
+		return m.EventID()
+	case outboxevent.FieldChannel:
+		return m.Channel()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxevent.FieldPublishedAt:
+		return m.PublishedAt()
 	}
 	return nil, false
 }
@@ -4359,2729 +17002,2719 @@ func (m *DeploymentJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymentjob.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case deploymentjob.FieldCreateTime:
+	case outboxevent.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case deploymentjob.FieldUpdateTime:
+	case outboxevent.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case deploymentjob.FieldDeleteTime:
+	case outboxevent.FieldDeleteTime:
 		return m.OldDeleteTime(ctx)
-	case deploymentjob.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case deploymentjob.FieldDeploymentTargetID:
-		return m.OldDeploymentTargetID(ctx)
-	case deploymentjob.FieldTargetConfigurationID:
-		return m.OldTargetConfigurationID(ctx)
-	case deploymentjob.FieldParentJobID:
-		return m.OldParentJobID(ctx)
-	case deploymentjob.FieldCertificateID:
-		return m.OldCertificateID(ctx)
-	case deploymentjob.FieldCertificateSerial:
-		return m.OldCertificateSerial(ctx)
-	case deploymentjob.FieldStatus:
-		return m.OldStatus(ctx)
-	case deploymentjob.FieldStatusMessage:
-		return m.OldStatusMessage(ctx)
-	case deploymentjob.FieldProgress:
-		return m.OldProgress(ctx)
-	case deploymentjob.FieldRetryCount:
-		return m.OldRetryCount(ctx)
-	case deploymentjob.FieldMaxRetries:
-		return m.OldMaxRetries(ctx)
-	case deploymentjob.FieldTriggeredBy:
-		return m.OldTriggeredBy(ctx)
-	case deploymentjob.FieldResult:
-		return m.OldResult(ctx)
-	case deploymentjob.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case deploymentjob.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case deploymentjob.FieldNextRetryAt:
-		return m.OldNextRetryAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown DeploymentJob field %s", name)
+	case outboxevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case outboxevent.FieldChannel:
+		return m.OldChannel(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxevent.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentJobMutation) SetField(name string, value ent.Value) error {
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymentjob.FieldCreateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case deploymentjob.FieldCreateTime:
+	case outboxevent.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case deploymentjob.FieldUpdateTime:
+	case outboxevent.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case deploymentjob.FieldDeleteTime:
+	case outboxevent.FieldDeleteTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeleteTime(v)
 		return nil
-	case deploymentjob.FieldTenantID:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case deploymentjob.FieldDeploymentTargetID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeploymentTargetID(v)
-		return nil
-	case deploymentjob.FieldTargetConfigurationID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTargetConfigurationID(v)
-		return nil
-	case deploymentjob.FieldParentJobID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentJobID(v)
-		return nil
-	case deploymentjob.FieldCertificateID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCertificateID(v)
-		return nil
-	case deploymentjob.FieldCertificateSerial:
+	case outboxevent.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCertificateSerial(v)
-		return nil
-	case deploymentjob.FieldStatus:
-		v, ok := value.(deploymentjob.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetEventID(v)
 		return nil
-	case deploymentjob.FieldStatusMessage:
+	case outboxevent.FieldChannel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatusMessage(v)
-		return nil
-	case deploymentjob.FieldProgress:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProgress(v)
-		return nil
-	case deploymentjob.FieldRetryCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRetryCount(v)
-		return nil
-	case deploymentjob.FieldMaxRetries:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxRetries(v)
-		return nil
-	case deploymentjob.FieldTriggeredBy:
-		v, ok := value.(deploymentjob.TriggeredBy)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTriggeredBy(v)
-		return nil
-	case deploymentjob.FieldResult:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResult(v)
-		return nil
-	case deploymentjob.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case deploymentjob.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
-	case deploymentjob.FieldNextRetryAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNextRetryAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentJob field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DeploymentJobMutation) AddedFields() []string {
-	var fields []string
-	if m.addcreate_by != nil {
-		fields = append(fields, deploymentjob.FieldCreateBy)
-	}
-	if m.addtenant_id != nil {
-		fields = append(fields, deploymentjob.FieldTenantID)
-	}
-	if m.addprogress != nil {
-		fields = append(fields, deploymentjob.FieldProgress)
-	}
-	if m.addretry_count != nil {
-		fields = append(fields, deploymentjob.FieldRetryCount)
-	}
-	if m.addmax_retries != nil {
-		fields = append(fields, deploymentjob.FieldMaxRetries)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DeploymentJobMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case deploymentjob.FieldCreateBy:
-		return m.AddedCreateBy()
-	case deploymentjob.FieldTenantID:
-		return m.AddedTenantID()
-	case deploymentjob.FieldProgress:
-		return m.AddedProgress()
-	case deploymentjob.FieldRetryCount:
-		return m.AddedRetryCount()
-	case deploymentjob.FieldMaxRetries:
-		return m.AddedMaxRetries()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DeploymentJobMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case deploymentjob.FieldCreateBy:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreateBy(v)
+		m.SetChannel(v)
 		return nil
-	case deploymentjob.FieldTenantID:
-		v, ok := value.(int32)
+	case outboxevent.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTenantID(v)
+		m.SetPayload(v)
 		return nil
-	case deploymentjob.FieldProgress:
+	case outboxevent.FieldAttempts:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddProgress(v)
+		m.SetAttempts(v)
 		return nil
-	case deploymentjob.FieldRetryCount:
-		v, ok := value.(int32)
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRetryCount(v)
+		m.SetLastError(v)
 		return nil
-	case deploymentjob.FieldMaxRetries:
+	case outboxevent.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxevent.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxRetries(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentJob numeric field %s", name)
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentJobMutation) ClearedFields() []string {
+func (m *OutboxEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deploymentjob.FieldCreateBy) {
-		fields = append(fields, deploymentjob.FieldCreateBy)
+	if m.FieldCleared(outboxevent.FieldCreateTime) {
+		fields = append(fields, outboxevent.FieldCreateTime)
 	}
-	if m.FieldCleared(deploymentjob.FieldCreateTime) {
-		fields = append(fields, deploymentjob.FieldCreateTime)
+	if m.FieldCleared(outboxevent.FieldUpdateTime) {
+		fields = append(fields, outboxevent.FieldUpdateTime)
 	}
-	if m.FieldCleared(deploymentjob.FieldUpdateTime) {
-		fields = append(fields, deploymentjob.FieldUpdateTime)
+	if m.FieldCleared(outboxevent.FieldDeleteTime) {
+		fields = append(fields, outboxevent.FieldDeleteTime)
 	}
-	if m.FieldCleared(deploymentjob.FieldDeleteTime) {
-		fields = append(fields, deploymentjob.FieldDeleteTime)
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
 	}
-	if m.FieldCleared(deploymentjob.FieldTenantID) {
-		fields = append(fields, deploymentjob.FieldTenantID)
+	if m.FieldCleared(outboxevent.FieldPublishedAt) {
+		fields = append(fields, outboxevent.FieldPublishedAt)
 	}
-	if m.FieldCleared(deploymentjob.FieldDeploymentTargetID) {
-		fields = append(fields, deploymentjob.FieldDeploymentTargetID)
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case outboxevent.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case outboxevent.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
 	}
-	if m.FieldCleared(deploymentjob.FieldTargetConfigurationID) {
-		fields = append(fields, deploymentjob.FieldTargetConfigurationID)
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case outboxevent.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case outboxevent.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case outboxevent.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case outboxevent.FieldChannel:
+		m.ResetChannel()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
 	}
-	if m.FieldCleared(deploymentjob.FieldParentJobID) {
-		fields = append(fields, deploymentjob.FieldParentJobID)
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// RoleBindingMutation represents an operation that mutates the RoleBinding nodes in the graph.
+type RoleBindingMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint32
+	create_by            *uint32
+	addcreate_by         *int32
+	create_time          *time.Time
+	update_time          *time.Time
+	delete_time          *time.Time
+	tenant_id            *uint32
+	addtenant_id         *int32
+	subject_type         *rolebinding.SubjectType
+	subject              *string
+	role                 *rolebinding.Role
+	deployment_target_id *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*RoleBinding, error)
+	predicates           []predicate.RoleBinding
+}
+
+var _ ent.Mutation = (*RoleBindingMutation)(nil)
+
+// rolebindingOption allows management of the mutation configuration using functional options.
+type rolebindingOption func(*RoleBindingMutation)
+
+// newRoleBindingMutation creates new mutation for the RoleBinding entity.
+func newRoleBindingMutation(c config, op Op, opts ...rolebindingOption) *RoleBindingMutation {
+	m := &RoleBindingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleBinding,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.FieldCleared(deploymentjob.FieldCertificateSerial) {
-		fields = append(fields, deploymentjob.FieldCertificateSerial)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.FieldCleared(deploymentjob.FieldStatusMessage) {
-		fields = append(fields, deploymentjob.FieldStatusMessage)
+	return m
+}
+
+// withRoleBindingID sets the ID field of the mutation.
+func withRoleBindingID(id uint32) rolebindingOption {
+	return func(m *RoleBindingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleBinding
+		)
+		m.oldValue = func(ctx context.Context) (*RoleBinding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleBinding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.FieldCleared(deploymentjob.FieldResult) {
-		fields = append(fields, deploymentjob.FieldResult)
+}
+
+// withRoleBinding sets the old RoleBinding of the mutation.
+func withRoleBinding(node *RoleBinding) rolebindingOption {
+	return func(m *RoleBindingMutation) {
+		m.oldValue = func(context.Context) (*RoleBinding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleBindingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleBindingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleBinding entities.
+func (m *RoleBindingMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleBindingMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleBindingMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleBinding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *RoleBindingMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *RoleBindingMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
 	}
-	if m.FieldCleared(deploymentjob.FieldStartedAt) {
-		fields = append(fields, deploymentjob.FieldStartedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
 	}
-	if m.FieldCleared(deploymentjob.FieldCompletedAt) {
-		fields = append(fields, deploymentjob.FieldCompletedAt)
+	return oldValue.CreateBy, nil
+}
+
+// AddCreateBy adds u to the "create_by" field.
+func (m *RoleBindingMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
+	} else {
+		m.addcreate_by = &u
 	}
-	if m.FieldCleared(deploymentjob.FieldNextRetryAt) {
-		fields = append(fields, deploymentjob.FieldNextRetryAt)
+}
+
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *RoleBindingMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DeploymentJobMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *RoleBindingMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[rolebinding.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *RoleBindingMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[rolebinding.FieldCreateBy]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DeploymentJobMutation) ClearField(name string) error {
-	switch name {
-	case deploymentjob.FieldCreateBy:
-		m.ClearCreateBy()
-		return nil
-	case deploymentjob.FieldCreateTime:
-		m.ClearCreateTime()
-		return nil
-	case deploymentjob.FieldUpdateTime:
-		m.ClearUpdateTime()
-		return nil
-	case deploymentjob.FieldDeleteTime:
-		m.ClearDeleteTime()
-		return nil
-	case deploymentjob.FieldTenantID:
-		m.ClearTenantID()
-		return nil
-	case deploymentjob.FieldDeploymentTargetID:
-		m.ClearDeploymentTargetID()
-		return nil
-	case deploymentjob.FieldTargetConfigurationID:
-		m.ClearTargetConfigurationID()
-		return nil
-	case deploymentjob.FieldParentJobID:
-		m.ClearParentJobID()
-		return nil
-	case deploymentjob.FieldCertificateSerial:
-		m.ClearCertificateSerial()
-		return nil
-	case deploymentjob.FieldStatusMessage:
-		m.ClearStatusMessage()
-		return nil
-	case deploymentjob.FieldResult:
-		m.ClearResult()
-		return nil
-	case deploymentjob.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case deploymentjob.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case deploymentjob.FieldNextRetryAt:
-		m.ClearNextRetryAt()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentJob nullable field %s", name)
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *RoleBindingMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, rolebinding.FieldCreateBy)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DeploymentJobMutation) ResetField(name string) error {
-	switch name {
-	case deploymentjob.FieldCreateBy:
-		m.ResetCreateBy()
-		return nil
-	case deploymentjob.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case deploymentjob.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case deploymentjob.FieldDeleteTime:
-		m.ResetDeleteTime()
-		return nil
-	case deploymentjob.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case deploymentjob.FieldDeploymentTargetID:
-		m.ResetDeploymentTargetID()
-		return nil
-	case deploymentjob.FieldTargetConfigurationID:
-		m.ResetTargetConfigurationID()
-		return nil
-	case deploymentjob.FieldParentJobID:
-		m.ResetParentJobID()
-		return nil
-	case deploymentjob.FieldCertificateID:
-		m.ResetCertificateID()
-		return nil
-	case deploymentjob.FieldCertificateSerial:
-		m.ResetCertificateSerial()
-		return nil
-	case deploymentjob.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case deploymentjob.FieldStatusMessage:
-		m.ResetStatusMessage()
-		return nil
-	case deploymentjob.FieldProgress:
-		m.ResetProgress()
-		return nil
-	case deploymentjob.FieldRetryCount:
-		m.ResetRetryCount()
-		return nil
-	case deploymentjob.FieldMaxRetries:
-		m.ResetMaxRetries()
-		return nil
-	case deploymentjob.FieldTriggeredBy:
-		m.ResetTriggeredBy()
-		return nil
-	case deploymentjob.FieldResult:
-		m.ResetResult()
-		return nil
-	case deploymentjob.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case deploymentjob.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case deploymentjob.FieldNextRetryAt:
-		m.ResetNextRetryAt()
-		return nil
+// SetCreateTime sets the "create_time" field.
+func (m *RoleBindingMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RoleBindingMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DeploymentJob field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.deployment_target != nil {
-		edges = append(edges, deploymentjob.EdgeDeploymentTarget)
+// OldCreateTime returns the old "create_time" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	if m.target_configuration != nil {
-		edges = append(edges, deploymentjob.EdgeTargetConfiguration)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
-	if m.parent_job != nil {
-		edges = append(edges, deploymentjob.EdgeParentJob)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	if m.child_jobs != nil {
-		edges = append(edges, deploymentjob.EdgeChildJobs)
+	return oldValue.CreateTime, nil
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *RoleBindingMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[rolebinding.FieldCreateTime] = struct{}{}
+}
+
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *RoleBindingMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[rolebinding.FieldCreateTime]
+	return ok
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RoleBindingMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, rolebinding.FieldCreateTime)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RoleBindingMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RoleBindingMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	if m.history != nil {
-		edges = append(edges, deploymentjob.EdgeHistory)
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *DeploymentJobMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case deploymentjob.EdgeDeploymentTarget:
-		if id := m.deployment_target; id != nil {
-			return []ent.Value{*id}
-		}
-	case deploymentjob.EdgeTargetConfiguration:
-		if id := m.target_configuration; id != nil {
-			return []ent.Value{*id}
-		}
-	case deploymentjob.EdgeParentJob:
-		if id := m.parent_job; id != nil {
-			return []ent.Value{*id}
-		}
-	case deploymentjob.EdgeChildJobs:
-		ids := make([]ent.Value, 0, len(m.child_jobs))
-		for id := range m.child_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentjob.EdgeHistory:
-		ids := make([]ent.Value, 0, len(m.history))
-		for id := range m.history {
-			ids = append(ids, id)
-		}
-		return ids
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *RoleBindingMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[rolebinding.FieldUpdateTime] = struct{}{}
+}
+
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *RoleBindingMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[rolebinding.FieldUpdateTime]
+	return ok
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RoleBindingMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, rolebinding.FieldUpdateTime)
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *RoleBindingMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *RoleBindingMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedchild_jobs != nil {
-		edges = append(edges, deploymentjob.EdgeChildJobs)
+// OldDeleteTime returns the old "delete_time" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
-	if m.removedhistory != nil {
-		edges = append(edges, deploymentjob.EdgeHistory)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DeploymentJobMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case deploymentjob.EdgeChildJobs:
-		ids := make([]ent.Value, 0, len(m.removedchild_jobs))
-		for id := range m.removedchild_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentjob.EdgeHistory:
-		ids := make([]ent.Value, 0, len(m.removedhistory))
-		for id := range m.removedhistory {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *RoleBindingMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[rolebinding.FieldDeleteTime] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.cleareddeployment_target {
-		edges = append(edges, deploymentjob.EdgeDeploymentTarget)
-	}
-	if m.clearedtarget_configuration {
-		edges = append(edges, deploymentjob.EdgeTargetConfiguration)
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *RoleBindingMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[rolebinding.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *RoleBindingMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, rolebinding.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RoleBindingMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RoleBindingMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
 	}
-	if m.clearedparent_job {
-		edges = append(edges, deploymentjob.EdgeParentJob)
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
-	if m.clearedchild_jobs {
-		edges = append(edges, deploymentjob.EdgeChildJobs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
-	if m.clearedhistory {
-		edges = append(edges, deploymentjob.EdgeHistory)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return edges
+	return oldValue.TenantID, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DeploymentJobMutation) EdgeCleared(name string) bool {
-	switch name {
-	case deploymentjob.EdgeDeploymentTarget:
-		return m.cleareddeployment_target
-	case deploymentjob.EdgeTargetConfiguration:
-		return m.clearedtarget_configuration
-	case deploymentjob.EdgeParentJob:
-		return m.clearedparent_job
-	case deploymentjob.EdgeChildJobs:
-		return m.clearedchild_jobs
-	case deploymentjob.EdgeHistory:
-		return m.clearedhistory
+// AddTenantID adds u to the "tenant_id" field.
+func (m *RoleBindingMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DeploymentJobMutation) ClearEdge(name string) error {
-	switch name {
-	case deploymentjob.EdgeDeploymentTarget:
-		m.ClearDeploymentTarget()
-		return nil
-	case deploymentjob.EdgeTargetConfiguration:
-		m.ClearTargetConfiguration()
-		return nil
-	case deploymentjob.EdgeParentJob:
-		m.ClearParentJob()
-		return nil
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *RoleBindingMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DeploymentJob unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DeploymentJobMutation) ResetEdge(name string) error {
-	switch name {
-	case deploymentjob.EdgeDeploymentTarget:
-		m.ResetDeploymentTarget()
-		return nil
-	case deploymentjob.EdgeTargetConfiguration:
-		m.ResetTargetConfiguration()
-		return nil
-	case deploymentjob.EdgeParentJob:
-		m.ResetParentJob()
-		return nil
-	case deploymentjob.EdgeChildJobs:
-		m.ResetChildJobs()
-		return nil
-	case deploymentjob.EdgeHistory:
-		m.ResetHistory()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentJob edge %s", name)
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *RoleBindingMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[rolebinding.FieldTenantID] = struct{}{}
 }
 
-// DeploymentTargetMutation represents an operation that mutates the DeploymentTarget nodes in the graph.
-type DeploymentTargetMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *string
-	create_by                 *uint32
-	addcreate_by              *int32
-	update_by                 *uint32
-	addupdate_by              *int32
-	create_time               *time.Time
-	update_time               *time.Time
-	delete_time               *time.Time
-	tenant_id                 *uint32
-	addtenant_id              *int32
-	name                      *string
-	description               *string
-	auto_deploy_on_renewal    *bool
-	certificate_filters       *[]schema.CertificateFilter
-	appendcertificate_filters []schema.CertificateFilter
-	clearedFields             map[string]struct{}
-	configurations            map[string]struct{}
-	removedconfigurations     map[string]struct{}
-	clearedconfigurations     bool
-	jobs                      map[string]struct{}
-	removedjobs               map[string]struct{}
-	clearedjobs               bool
-	done                      bool
-	oldValue                  func(context.Context) (*DeploymentTarget, error)
-	predicates                []predicate.DeploymentTarget
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *RoleBindingMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[rolebinding.FieldTenantID]
+	return ok
 }
 
-var _ ent.Mutation = (*DeploymentTargetMutation)(nil)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RoleBindingMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, rolebinding.FieldTenantID)
+}
 
-// deploymenttargetOption allows management of the mutation configuration using functional options.
-type deploymenttargetOption func(*DeploymentTargetMutation)
+// SetSubjectType sets the "subject_type" field.
+func (m *RoleBindingMutation) SetSubjectType(rt rolebinding.SubjectType) {
+	m.subject_type = &rt
+}
 
-// newDeploymentTargetMutation creates new mutation for the DeploymentTarget entity.
-func newDeploymentTargetMutation(c config, op Op, opts ...deploymenttargetOption) *DeploymentTargetMutation {
-	m := &DeploymentTargetMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDeploymentTarget,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// SubjectType returns the value of the "subject_type" field in the mutation.
+func (m *RoleBindingMutation) SubjectType() (r rolebinding.SubjectType, exists bool) {
+	v := m.subject_type
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withDeploymentTargetID sets the ID field of the mutation.
-func withDeploymentTargetID(id string) deploymenttargetOption {
-	return func(m *DeploymentTargetMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DeploymentTarget
-		)
-		m.oldValue = func(ctx context.Context) (*DeploymentTarget, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DeploymentTarget.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldSubjectType returns the old "subject_type" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldSubjectType(ctx context.Context) (v rolebinding.SubjectType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
 	}
+	return oldValue.SubjectType, nil
 }
 
-// withDeploymentTarget sets the old DeploymentTarget of the mutation.
-func withDeploymentTarget(node *DeploymentTarget) deploymenttargetOption {
-	return func(m *DeploymentTargetMutation) {
-		m.oldValue = func(context.Context) (*DeploymentTarget, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetSubjectType resets all changes to the "subject_type" field.
+func (m *RoleBindingMutation) ResetSubjectType() {
+	m.subject_type = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentTargetMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetSubject sets the "subject" field.
+func (m *RoleBindingMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *RoleBindingMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DeploymentTargetMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldSubject returns the old "subject" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeploymentTarget entities.
-func (m *DeploymentTargetMutation) SetID(id string) {
-	m.id = &id
+// ResetSubject resets all changes to the "subject" field.
+func (m *RoleBindingMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentTargetMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// SetRole sets the "role" field.
+func (m *RoleBindingMutation) SetRole(r rolebinding.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RoleBindingMutation) Role() (r rolebinding.Role, exists bool) {
+	v := m.role
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DeploymentTargetMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentTarget.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldRole returns the old "role" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleBindingMutation) OldRole(ctx context.Context) (v rolebinding.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
 }
 
-// SetCreateBy sets the "create_by" field.
-func (m *DeploymentTargetMutation) SetCreateBy(u uint32) {
-	m.create_by = &u
-	m.addcreate_by = nil
+// ResetRole resets all changes to the "role" field.
+func (m *RoleBindingMutation) ResetRole() {
+	m.role = nil
 }
 
-// CreateBy returns the value of the "create_by" field in the mutation.
-func (m *DeploymentTargetMutation) CreateBy() (r uint32, exists bool) {
-	v := m.create_by
+// SetDeploymentTargetID sets the "deployment_target_id" field.
+func (m *RoleBindingMutation) SetDeploymentTargetID(s string) {
+	m.deployment_target_id = &s
+}
+
+// DeploymentTargetID returns the value of the "deployment_target_id" field in the mutation.
+func (m *RoleBindingMutation) DeploymentTargetID() (r string, exists bool) {
+	v := m.deployment_target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldDeploymentTargetID returns the old "deployment_target_id" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+func (m *RoleBindingMutation) OldDeploymentTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeploymentTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+		return v, errors.New("OldDeploymentTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeploymentTargetID: %w", err)
 	}
-	return oldValue.CreateBy, nil
+	return oldValue.DeploymentTargetID, nil
 }
 
-// AddCreateBy adds u to the "create_by" field.
-func (m *DeploymentTargetMutation) AddCreateBy(u int32) {
-	if m.addcreate_by != nil {
-		*m.addcreate_by += u
-	} else {
-		m.addcreate_by = &u
+// ResetDeploymentTargetID resets all changes to the "deployment_target_id" field.
+func (m *RoleBindingMutation) ResetDeploymentTargetID() {
+	m.deployment_target_id = nil
+}
+
+// Where appends a list predicates to the RoleBindingMutation builder.
+func (m *RoleBindingMutation) Where(ps ...predicate.RoleBinding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleBindingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleBindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleBinding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *DeploymentTargetMutation) AddedCreateBy() (r int32, exists bool) {
-	v := m.addcreate_by
-	if v == nil {
-		return
+// Op returns the operation name.
+func (m *RoleBindingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleBindingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleBinding).
+func (m *RoleBindingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleBindingMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_by != nil {
+		fields = append(fields, rolebinding.FieldCreateBy)
 	}
-	return *v, true
+	if m.create_time != nil {
+		fields = append(fields, rolebinding.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, rolebinding.FieldUpdateTime)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, rolebinding.FieldDeleteTime)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, rolebinding.FieldTenantID)
+	}
+	if m.subject_type != nil {
+		fields = append(fields, rolebinding.FieldSubjectType)
+	}
+	if m.subject != nil {
+		fields = append(fields, rolebinding.FieldSubject)
+	}
+	if m.role != nil {
+		fields = append(fields, rolebinding.FieldRole)
+	}
+	if m.deployment_target_id != nil {
+		fields = append(fields, rolebinding.FieldDeploymentTargetID)
+	}
+	return fields
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (m *DeploymentTargetMutation) ClearCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	m.clearedFields[deploymenttarget.FieldCreateBy] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleBindingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		return m.CreateBy()
+	case rolebinding.FieldCreateTime:
+		return m.CreateTime()
+	case rolebinding.FieldUpdateTime:
+		return m.UpdateTime()
+	case rolebinding.FieldDeleteTime:
+		return m.DeleteTime()
+	case rolebinding.FieldTenantID:
+		return m.TenantID()
+	case rolebinding.FieldSubjectType:
+		return m.SubjectType()
+	case rolebinding.FieldSubject:
+		return m.Subject()
+	case rolebinding.FieldRole:
+		return m.Role()
+	case rolebinding.FieldDeploymentTargetID:
+		return m.DeploymentTargetID()
+	}
+	return nil, false
 }
 
-// CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldCreateBy]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleBindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case rolebinding.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case rolebinding.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case rolebinding.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
+	case rolebinding.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case rolebinding.FieldSubjectType:
+		return m.OldSubjectType(ctx)
+	case rolebinding.FieldSubject:
+		return m.OldSubject(ctx)
+	case rolebinding.FieldRole:
+		return m.OldRole(ctx)
+	case rolebinding.FieldDeploymentTargetID:
+		return m.OldDeploymentTargetID(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleBinding field %s", name)
 }
 
-// ResetCreateBy resets all changes to the "create_by" field.
-func (m *DeploymentTargetMutation) ResetCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	delete(m.clearedFields, deploymenttarget.FieldCreateBy)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleBindingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case rolebinding.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case rolebinding.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case rolebinding.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
+	case rolebinding.FieldTenantID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case rolebinding.FieldSubjectType:
+		v, ok := value.(rolebinding.SubjectType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectType(v)
+		return nil
+	case rolebinding.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case rolebinding.FieldRole:
+		v, ok := value.(rolebinding.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case rolebinding.FieldDeploymentTargetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentTargetID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleBinding field %s", name)
 }
 
-// SetUpdateBy sets the "update_by" field.
-func (m *DeploymentTargetMutation) SetUpdateBy(u uint32) {
-	m.update_by = &u
-	m.addupdate_by = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleBindingMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreate_by != nil {
+		fields = append(fields, rolebinding.FieldCreateBy)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, rolebinding.FieldTenantID)
+	}
+	return fields
 }
 
-// UpdateBy returns the value of the "update_by" field in the mutation.
-func (m *DeploymentTargetMutation) UpdateBy() (r uint32, exists bool) {
-	v := m.update_by
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleBindingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		return m.AddedCreateBy()
+	case rolebinding.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleBindingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreateBy(v)
+		return nil
+	case rolebinding.FieldTenantID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RoleBinding numeric field %s", name)
 }
 
-// OldUpdateBy returns the old "update_by" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleBindingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolebinding.FieldCreateBy) {
+		fields = append(fields, rolebinding.FieldCreateBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	if m.FieldCleared(rolebinding.FieldCreateTime) {
+		fields = append(fields, rolebinding.FieldCreateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	if m.FieldCleared(rolebinding.FieldUpdateTime) {
+		fields = append(fields, rolebinding.FieldUpdateTime)
 	}
-	return oldValue.UpdateBy, nil
-}
-
-// AddUpdateBy adds u to the "update_by" field.
-func (m *DeploymentTargetMutation) AddUpdateBy(u int32) {
-	if m.addupdate_by != nil {
-		*m.addupdate_by += u
-	} else {
-		m.addupdate_by = &u
+	if m.FieldCleared(rolebinding.FieldDeleteTime) {
+		fields = append(fields, rolebinding.FieldDeleteTime)
 	}
-}
-
-// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
-func (m *DeploymentTargetMutation) AddedUpdateBy() (r int32, exists bool) {
-	v := m.addupdate_by
-	if v == nil {
-		return
+	if m.FieldCleared(rolebinding.FieldTenantID) {
+		fields = append(fields, rolebinding.FieldTenantID)
 	}
-	return *v, true
-}
-
-// ClearUpdateBy clears the value of the "update_by" field.
-func (m *DeploymentTargetMutation) ClearUpdateBy() {
-	m.update_by = nil
-	m.addupdate_by = nil
-	m.clearedFields[deploymenttarget.FieldUpdateBy] = struct{}{}
+	return fields
 }
 
-// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) UpdateByCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldUpdateBy]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleBindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetUpdateBy resets all changes to the "update_by" field.
-func (m *DeploymentTargetMutation) ResetUpdateBy() {
-	m.update_by = nil
-	m.addupdate_by = nil
-	delete(m.clearedFields, deploymenttarget.FieldUpdateBy)
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *DeploymentTargetMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DeploymentTargetMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleBindingMutation) ClearField(name string) error {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case rolebinding.FieldCreateTime:
+		m.ClearCreateTime()
+		return nil
+	case rolebinding.FieldUpdateTime:
+		m.ClearUpdateTime()
+		return nil
+	case rolebinding.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
+	case rolebinding.FieldTenantID:
+		m.ClearTenantID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RoleBinding nullable field %s", name)
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleBindingMutation) ResetField(name string) error {
+	switch name {
+	case rolebinding.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case rolebinding.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case rolebinding.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case rolebinding.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
+	case rolebinding.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case rolebinding.FieldSubjectType:
+		m.ResetSubjectType()
+		return nil
+	case rolebinding.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case rolebinding.FieldRole:
+		m.ResetRole()
+		return nil
+	case rolebinding.FieldDeploymentTargetID:
+		m.ResetDeploymentTargetID()
+		return nil
 	}
-	return oldValue.CreateTime, nil
+	return fmt.Errorf("unknown RoleBinding field %s", name)
 }
 
-// ClearCreateTime clears the value of the "create_time" field.
-func (m *DeploymentTargetMutation) ClearCreateTime() {
-	m.create_time = nil
-	m.clearedFields[deploymenttarget.FieldCreateTime] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleBindingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldCreateTime]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleBindingMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *DeploymentTargetMutation) ResetCreateTime() {
-	m.create_time = nil
-	delete(m.clearedFields, deploymenttarget.FieldCreateTime)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleBindingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *DeploymentTargetMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleBindingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DeploymentTargetMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleBindingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
-	}
-	return oldValue.UpdateTime, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleBindingMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (m *DeploymentTargetMutation) ClearUpdateTime() {
-	m.update_time = nil
-	m.clearedFields[deploymenttarget.FieldUpdateTime] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleBindingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RoleBinding unique edge %s", name)
 }
 
-// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldUpdateTime]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleBindingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RoleBinding edge %s", name)
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DeploymentTargetMutation) ResetUpdateTime() {
-	m.update_time = nil
-	delete(m.clearedFields, deploymenttarget.FieldUpdateTime)
+// TargetConfigurationMutation represents an operation that mutates the TargetConfiguration nodes in the graph.
+type TargetConfigurationMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *string
+	create_by                       *uint32
+	addcreate_by                    *int32
+	update_by                       *uint32
+	addupdate_by                    *int32
+	create_time                     *time.Time
+	update_time                     *time.Time
+	delete_time                     *time.Time
+	tenant_id                       *uint32
+	addtenant_id                    *int32
+	name                            *string
+	description                     *string
+	provider_type                   *string
+	credentials_encrypted           *[]byte
+	credential_rotated_at           *map[string]time.Time
+	_config                         *map[string]interface{}
+	status                          *targetconfiguration.Status
+	status_message                  *string
+	consecutive_failures            *int32
+	addconsecutive_failures         *int32
+	next_probe_at                   *time.Time
+	last_validated_at               *time.Time
+	last_validation_error           *string
+	next_validation_at              *time.Time
+	tls_probe_address               *string
+	last_drift_check_at             *time.Time
+	next_drift_check_at             *time.Time
+	last_deployment_at              *time.Time
+	deployed_certificate_id         *string
+	deployed_certificate_expires_at *time.Time
+	revision                        *uint32
+	addrevision                     *int32
+	retry_policy                    **registry.RetryPolicy
+	clearedFields                   map[string]struct{}
+	jobs                            map[string]struct{}
+	removedjobs                     map[string]struct{}
+	clearedjobs                     bool
+	deployment_targets              map[string]struct{}
+	removeddeployment_targets       map[string]struct{}
+	cleareddeployment_targets       bool
+	done                            bool
+	oldValue                        func(context.Context) (*TargetConfiguration, error)
+	predicates                      []predicate.TargetConfiguration
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (m *DeploymentTargetMutation) SetDeleteTime(t time.Time) {
-	m.delete_time = &t
-}
+var _ ent.Mutation = (*TargetConfigurationMutation)(nil)
 
-// DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *DeploymentTargetMutation) DeleteTime() (r time.Time, exists bool) {
-	v := m.delete_time
-	if v == nil {
-		return
+// targetconfigurationOption allows management of the mutation configuration using functional options.
+type targetconfigurationOption func(*TargetConfigurationMutation)
+
+// newTargetConfigurationMutation creates new mutation for the TargetConfiguration entity.
+func newTargetConfigurationMutation(c config, op Op, opts ...targetconfigurationOption) *TargetConfigurationMutation {
+	m := &TargetConfigurationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTargetConfiguration,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+// withTargetConfigurationID sets the ID field of the mutation.
+func withTargetConfigurationID(id string) targetconfigurationOption {
+	return func(m *TargetConfigurationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TargetConfiguration
+		)
+		m.oldValue = func(ctx context.Context) (*TargetConfiguration, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TargetConfiguration.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+}
+
+// withTargetConfiguration sets the old TargetConfiguration of the mutation.
+func withTargetConfiguration(node *TargetConfiguration) targetconfigurationOption {
+	return func(m *TargetConfigurationMutation) {
+		m.oldValue = func(context.Context) (*TargetConfiguration, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TargetConfigurationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TargetConfigurationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.DeleteTime, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (m *DeploymentTargetMutation) ClearDeleteTime() {
-	m.delete_time = nil
-	m.clearedFields[deploymenttarget.FieldDeleteTime] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TargetConfiguration entities.
+func (m *TargetConfigurationMutation) SetID(id string) {
+	m.id = &id
 }
 
-// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldDeleteTime]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TargetConfigurationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *DeploymentTargetMutation) ResetDeleteTime() {
-	m.delete_time = nil
-	delete(m.clearedFields, deploymenttarget.FieldDeleteTime)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TargetConfigurationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TargetConfiguration.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *DeploymentTargetMutation) SetTenantID(u uint32) {
-	m.tenant_id = &u
-	m.addtenant_id = nil
+// SetCreateBy sets the "create_by" field.
+func (m *TargetConfigurationMutation) SetCreateBy(u uint32) {
+	m.create_by = &u
+	m.addcreate_by = nil
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *DeploymentTargetMutation) TenantID() (r uint32, exists bool) {
-	v := m.tenant_id
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *TargetConfigurationMutation) CreateBy() (r uint32, exists bool) {
+	v := m.create_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateBy returns the old "create_by" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *TargetConfigurationMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.CreateBy, nil
 }
 
-// AddTenantID adds u to the "tenant_id" field.
-func (m *DeploymentTargetMutation) AddTenantID(u int32) {
-	if m.addtenant_id != nil {
-		*m.addtenant_id += u
+// AddCreateBy adds u to the "create_by" field.
+func (m *TargetConfigurationMutation) AddCreateBy(u int32) {
+	if m.addcreate_by != nil {
+		*m.addcreate_by += u
 	} else {
-		m.addtenant_id = &u
+		m.addcreate_by = &u
 	}
 }
 
-// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *DeploymentTargetMutation) AddedTenantID() (r int32, exists bool) {
-	v := m.addtenant_id
+// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
+func (m *TargetConfigurationMutation) AddedCreateBy() (r int32, exists bool) {
+	v := m.addcreate_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *DeploymentTargetMutation) ClearTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	m.clearedFields[deploymenttarget.FieldTenantID] = struct{}{}
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *TargetConfigurationMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	m.clearedFields[targetconfiguration.FieldCreateBy] = struct{}{}
 }
 
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldTenantID]
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldCreateBy]
 	return ok
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *DeploymentTargetMutation) ResetTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	delete(m.clearedFields, deploymenttarget.FieldTenantID)
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *TargetConfigurationMutation) ResetCreateBy() {
+	m.create_by = nil
+	m.addcreate_by = nil
+	delete(m.clearedFields, targetconfiguration.FieldCreateBy)
 }
 
-// SetName sets the "name" field.
-func (m *DeploymentTargetMutation) SetName(s string) {
-	m.name = &s
+// SetUpdateBy sets the "update_by" field.
+func (m *TargetConfigurationMutation) SetUpdateBy(u uint32) {
+	m.update_by = &u
+	m.addupdate_by = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DeploymentTargetMutation) Name() (r string, exists bool) {
-	v := m.name
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *TargetConfigurationMutation) UpdateBy() (r uint32, exists bool) {
+	v := m.update_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateBy returns the old "update_by" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TargetConfigurationMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *DeploymentTargetMutation) ResetName() {
-	m.name = nil
+	return oldValue.UpdateBy, nil
 }
 
-// SetDescription sets the "description" field.
-func (m *DeploymentTargetMutation) SetDescription(s string) {
-	m.description = &s
+// AddUpdateBy adds u to the "update_by" field.
+func (m *TargetConfigurationMutation) AddUpdateBy(u int32) {
+	if m.addupdate_by != nil {
+		*m.addupdate_by += u
+	} else {
+		m.addupdate_by = &u
+	}
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DeploymentTargetMutation) Description() (r string, exists bool) {
-	v := m.description
+// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
+func (m *TargetConfigurationMutation) AddedUpdateBy() (r int32, exists bool) {
+	v := m.addupdate_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *DeploymentTargetMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[deploymenttarget.FieldDescription] = struct{}{}
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *TargetConfigurationMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	m.clearedFields[targetconfiguration.FieldUpdateBy] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldDescription]
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldUpdateBy]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DeploymentTargetMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, deploymenttarget.FieldDescription)
-}
-
-// SetAutoDeployOnRenewal sets the "auto_deploy_on_renewal" field.
-func (m *DeploymentTargetMutation) SetAutoDeployOnRenewal(b bool) {
-	m.auto_deploy_on_renewal = &b
-}
-
-// AutoDeployOnRenewal returns the value of the "auto_deploy_on_renewal" field in the mutation.
-func (m *DeploymentTargetMutation) AutoDeployOnRenewal() (r bool, exists bool) {
-	v := m.auto_deploy_on_renewal
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAutoDeployOnRenewal returns the old "auto_deploy_on_renewal" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldAutoDeployOnRenewal(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAutoDeployOnRenewal is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAutoDeployOnRenewal requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAutoDeployOnRenewal: %w", err)
-	}
-	return oldValue.AutoDeployOnRenewal, nil
-}
-
-// ResetAutoDeployOnRenewal resets all changes to the "auto_deploy_on_renewal" field.
-func (m *DeploymentTargetMutation) ResetAutoDeployOnRenewal() {
-	m.auto_deploy_on_renewal = nil
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *TargetConfigurationMutation) ResetUpdateBy() {
+	m.update_by = nil
+	m.addupdate_by = nil
+	delete(m.clearedFields, targetconfiguration.FieldUpdateBy)
 }
 
-// SetCertificateFilters sets the "certificate_filters" field.
-func (m *DeploymentTargetMutation) SetCertificateFilters(sf []schema.CertificateFilter) {
-	m.certificate_filters = &sf
-	m.appendcertificate_filters = nil
+// SetCreateTime sets the "create_time" field.
+func (m *TargetConfigurationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// CertificateFilters returns the value of the "certificate_filters" field in the mutation.
-func (m *DeploymentTargetMutation) CertificateFilters() (r []schema.CertificateFilter, exists bool) {
-	v := m.certificate_filters
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TargetConfigurationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCertificateFilters returns the old "certificate_filters" field's value of the DeploymentTarget entity.
-// If the DeploymentTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentTargetMutation) OldCertificateFilters(ctx context.Context) (v []schema.CertificateFilter, err error) {
+func (m *TargetConfigurationMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCertificateFilters is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCertificateFilters requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCertificateFilters: %w", err)
-	}
-	return oldValue.CertificateFilters, nil
-}
-
-// AppendCertificateFilters adds sf to the "certificate_filters" field.
-func (m *DeploymentTargetMutation) AppendCertificateFilters(sf []schema.CertificateFilter) {
-	m.appendcertificate_filters = append(m.appendcertificate_filters, sf...)
-}
-
-// AppendedCertificateFilters returns the list of values that were appended to the "certificate_filters" field in this mutation.
-func (m *DeploymentTargetMutation) AppendedCertificateFilters() ([]schema.CertificateFilter, bool) {
-	if len(m.appendcertificate_filters) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return m.appendcertificate_filters, true
+	return oldValue.CreateTime, nil
 }
 
-// ClearCertificateFilters clears the value of the "certificate_filters" field.
-func (m *DeploymentTargetMutation) ClearCertificateFilters() {
-	m.certificate_filters = nil
-	m.appendcertificate_filters = nil
-	m.clearedFields[deploymenttarget.FieldCertificateFilters] = struct{}{}
+// ClearCreateTime clears the value of the "create_time" field.
+func (m *TargetConfigurationMutation) ClearCreateTime() {
+	m.create_time = nil
+	m.clearedFields[targetconfiguration.FieldCreateTime] = struct{}{}
 }
 
-// CertificateFiltersCleared returns if the "certificate_filters" field was cleared in this mutation.
-func (m *DeploymentTargetMutation) CertificateFiltersCleared() bool {
-	_, ok := m.clearedFields[deploymenttarget.FieldCertificateFilters]
+// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) CreateTimeCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldCreateTime]
 	return ok
 }
 
-// ResetCertificateFilters resets all changes to the "certificate_filters" field.
-func (m *DeploymentTargetMutation) ResetCertificateFilters() {
-	m.certificate_filters = nil
-	m.appendcertificate_filters = nil
-	delete(m.clearedFields, deploymenttarget.FieldCertificateFilters)
-}
-
-// AddConfigurationIDs adds the "configurations" edge to the TargetConfiguration entity by ids.
-func (m *DeploymentTargetMutation) AddConfigurationIDs(ids ...string) {
-	if m.configurations == nil {
-		m.configurations = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.configurations[ids[i]] = struct{}{}
-	}
-}
-
-// ClearConfigurations clears the "configurations" edge to the TargetConfiguration entity.
-func (m *DeploymentTargetMutation) ClearConfigurations() {
-	m.clearedconfigurations = true
-}
-
-// ConfigurationsCleared reports if the "configurations" edge to the TargetConfiguration entity was cleared.
-func (m *DeploymentTargetMutation) ConfigurationsCleared() bool {
-	return m.clearedconfigurations
-}
-
-// RemoveConfigurationIDs removes the "configurations" edge to the TargetConfiguration entity by IDs.
-func (m *DeploymentTargetMutation) RemoveConfigurationIDs(ids ...string) {
-	if m.removedconfigurations == nil {
-		m.removedconfigurations = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.configurations, ids[i])
-		m.removedconfigurations[ids[i]] = struct{}{}
-	}
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TargetConfigurationMutation) ResetCreateTime() {
+	m.create_time = nil
+	delete(m.clearedFields, targetconfiguration.FieldCreateTime)
 }
 
-// RemovedConfigurations returns the removed IDs of the "configurations" edge to the TargetConfiguration entity.
-func (m *DeploymentTargetMutation) RemovedConfigurationsIDs() (ids []string) {
-	for id := range m.removedconfigurations {
-		ids = append(ids, id)
-	}
-	return
+// SetUpdateTime sets the "update_time" field.
+func (m *TargetConfigurationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// ConfigurationsIDs returns the "configurations" edge IDs in the mutation.
-func (m *DeploymentTargetMutation) ConfigurationsIDs() (ids []string) {
-	for id := range m.configurations {
-		ids = append(ids, id)
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TargetConfigurationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetConfigurations resets all changes to the "configurations" edge.
-func (m *DeploymentTargetMutation) ResetConfigurations() {
-	m.configurations = nil
-	m.clearedconfigurations = false
-	m.removedconfigurations = nil
+	return *v, true
 }
 
-// AddJobIDs adds the "jobs" edge to the DeploymentJob entity by ids.
-func (m *DeploymentTargetMutation) AddJobIDs(ids ...string) {
-	if m.jobs == nil {
-		m.jobs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.jobs[ids[i]] = struct{}{}
+// OldUpdateTime returns the old "update_time" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearJobs clears the "jobs" edge to the DeploymentJob entity.
-func (m *DeploymentTargetMutation) ClearJobs() {
-	m.clearedjobs = true
-}
-
-// JobsCleared reports if the "jobs" edge to the DeploymentJob entity was cleared.
-func (m *DeploymentTargetMutation) JobsCleared() bool {
-	return m.clearedjobs
-}
-
-// RemoveJobIDs removes the "jobs" edge to the DeploymentJob entity by IDs.
-func (m *DeploymentTargetMutation) RemoveJobIDs(ids ...string) {
-	if m.removedjobs == nil {
-		m.removedjobs = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.jobs, ids[i])
-		m.removedjobs[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
+	return oldValue.UpdateTime, nil
 }
 
-// RemovedJobs returns the removed IDs of the "jobs" edge to the DeploymentJob entity.
-func (m *DeploymentTargetMutation) RemovedJobsIDs() (ids []string) {
-	for id := range m.removedjobs {
-		ids = append(ids, id)
-	}
-	return
+// ClearUpdateTime clears the value of the "update_time" field.
+func (m *TargetConfigurationMutation) ClearUpdateTime() {
+	m.update_time = nil
+	m.clearedFields[targetconfiguration.FieldUpdateTime] = struct{}{}
 }
 
-// JobsIDs returns the "jobs" edge IDs in the mutation.
-func (m *DeploymentTargetMutation) JobsIDs() (ids []string) {
-	for id := range m.jobs {
-		ids = append(ids, id)
-	}
-	return
+// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) UpdateTimeCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldUpdateTime]
+	return ok
 }
 
-// ResetJobs resets all changes to the "jobs" edge.
-func (m *DeploymentTargetMutation) ResetJobs() {
-	m.jobs = nil
-	m.clearedjobs = false
-	m.removedjobs = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TargetConfigurationMutation) ResetUpdateTime() {
+	m.update_time = nil
+	delete(m.clearedFields, targetconfiguration.FieldUpdateTime)
 }
 
-// Where appends a list predicates to the DeploymentTargetMutation builder.
-func (m *DeploymentTargetMutation) Where(ps ...predicate.DeploymentTarget) {
-	m.predicates = append(m.predicates, ps...)
+// SetDeleteTime sets the "delete_time" field.
+func (m *TargetConfigurationMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
 }
 
-// WhereP appends storage-level predicates to the DeploymentTargetMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentTargetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentTarget, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *TargetConfigurationMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *DeploymentTargetMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *DeploymentTargetMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (DeploymentTarget).
-func (m *DeploymentTargetMutation) Type() string {
-	return m.typ
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DeploymentTargetMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.create_by != nil {
-		fields = append(fields, deploymenttarget.FieldCreateBy)
-	}
-	if m.update_by != nil {
-		fields = append(fields, deploymenttarget.FieldUpdateBy)
-	}
-	if m.create_time != nil {
-		fields = append(fields, deploymenttarget.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, deploymenttarget.FieldUpdateTime)
-	}
-	if m.delete_time != nil {
-		fields = append(fields, deploymenttarget.FieldDeleteTime)
-	}
-	if m.tenant_id != nil {
-		fields = append(fields, deploymenttarget.FieldTenantID)
-	}
-	if m.name != nil {
-		fields = append(fields, deploymenttarget.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, deploymenttarget.FieldDescription)
+// OldDeleteTime returns the old "delete_time" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
-	if m.auto_deploy_on_renewal != nil {
-		fields = append(fields, deploymenttarget.FieldAutoDeployOnRenewal)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
-	if m.certificate_filters != nil {
-		fields = append(fields, deploymenttarget.FieldCertificateFilters)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
 	}
-	return fields
+	return oldValue.DeleteTime, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DeploymentTargetMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		return m.CreateBy()
-	case deploymenttarget.FieldUpdateBy:
-		return m.UpdateBy()
-	case deploymenttarget.FieldCreateTime:
-		return m.CreateTime()
-	case deploymenttarget.FieldUpdateTime:
-		return m.UpdateTime()
-	case deploymenttarget.FieldDeleteTime:
-		return m.DeleteTime()
-	case deploymenttarget.FieldTenantID:
-		return m.TenantID()
-	case deploymenttarget.FieldName:
-		return m.Name()
-	case deploymenttarget.FieldDescription:
-		return m.Description()
-	case deploymenttarget.FieldAutoDeployOnRenewal:
-		return m.AutoDeployOnRenewal()
-	case deploymenttarget.FieldCertificateFilters:
-		return m.CertificateFilters()
-	}
-	return nil, false
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *TargetConfigurationMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[targetconfiguration.FieldDeleteTime] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *DeploymentTargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case deploymenttarget.FieldUpdateBy:
-		return m.OldUpdateBy(ctx)
-	case deploymenttarget.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case deploymenttarget.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case deploymenttarget.FieldDeleteTime:
-		return m.OldDeleteTime(ctx)
-	case deploymenttarget.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case deploymenttarget.FieldName:
-		return m.OldName(ctx)
-	case deploymenttarget.FieldDescription:
-		return m.OldDescription(ctx)
-	case deploymenttarget.FieldAutoDeployOnRenewal:
-		return m.OldAutoDeployOnRenewal(ctx)
-	case deploymenttarget.FieldCertificateFilters:
-		return m.OldCertificateFilters(ctx)
-	}
-	return nil, fmt.Errorf("unknown DeploymentTarget field %s", name)
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldDeleteTime]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DeploymentTargetMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case deploymenttarget.FieldUpdateBy:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateBy(v)
-		return nil
-	case deploymenttarget.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case deploymenttarget.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case deploymenttarget.FieldDeleteTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeleteTime(v)
-		return nil
-	case deploymenttarget.FieldTenantID:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case deploymenttarget.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case deploymenttarget.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case deploymenttarget.FieldAutoDeployOnRenewal:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAutoDeployOnRenewal(v)
-		return nil
-	case deploymenttarget.FieldCertificateFilters:
-		v, ok := value.([]schema.CertificateFilter)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCertificateFilters(v)
-		return nil
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *TargetConfigurationMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, targetconfiguration.FieldDeleteTime)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TargetConfigurationMutation) SetTenantID(u uint32) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TargetConfigurationMutation) TenantID() (r uint32, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DeploymentTarget field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DeploymentTargetMutation) AddedFields() []string {
-	var fields []string
-	if m.addcreate_by != nil {
-		fields = append(fields, deploymenttarget.FieldCreateBy)
+// OldTenantID returns the old "tenant_id" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
-	if m.addupdate_by != nil {
-		fields = append(fields, deploymenttarget.FieldUpdateBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
-	if m.addtenant_id != nil {
-		fields = append(fields, deploymenttarget.FieldTenantID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return fields
+	return oldValue.TenantID, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DeploymentTargetMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		return m.AddedCreateBy()
-	case deploymenttarget.FieldUpdateBy:
-		return m.AddedUpdateBy()
-	case deploymenttarget.FieldTenantID:
-		return m.AddedTenantID()
+// AddTenantID adds u to the "tenant_id" field.
+func (m *TargetConfigurationMutation) AddTenantID(u int32) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DeploymentTargetMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreateBy(v)
-		return nil
-	case deploymenttarget.FieldUpdateBy:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUpdateBy(v)
-		return nil
-	case deploymenttarget.FieldTenantID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTenantID(v)
-		return nil
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *TargetConfigurationMutation) AddedTenantID() (r int32, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DeploymentTarget numeric field %s", name)
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *TargetConfigurationMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[targetconfiguration.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TargetConfigurationMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, targetconfiguration.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *TargetConfigurationMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *DeploymentTargetMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(deploymenttarget.FieldCreateBy) {
-		fields = append(fields, deploymenttarget.FieldCreateBy)
-	}
-	if m.FieldCleared(deploymenttarget.FieldUpdateBy) {
-		fields = append(fields, deploymenttarget.FieldUpdateBy)
-	}
-	if m.FieldCleared(deploymenttarget.FieldCreateTime) {
-		fields = append(fields, deploymenttarget.FieldCreateTime)
-	}
-	if m.FieldCleared(deploymenttarget.FieldUpdateTime) {
-		fields = append(fields, deploymenttarget.FieldUpdateTime)
-	}
-	if m.FieldCleared(deploymenttarget.FieldDeleteTime) {
-		fields = append(fields, deploymenttarget.FieldDeleteTime)
+// Name returns the value of the "name" field in the mutation.
+func (m *TargetConfigurationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(deploymenttarget.FieldTenantID) {
-		fields = append(fields, deploymenttarget.FieldTenantID)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(deploymenttarget.FieldDescription) {
-		fields = append(fields, deploymenttarget.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.FieldCleared(deploymenttarget.FieldCertificateFilters) {
-		fields = append(fields, deploymenttarget.FieldCertificateFilters)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return fields
+	return oldValue.Name, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DeploymentTargetMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetName resets all changes to the "name" field.
+func (m *TargetConfigurationMutation) ResetName() {
+	m.name = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DeploymentTargetMutation) ClearField(name string) error {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		m.ClearCreateBy()
-		return nil
-	case deploymenttarget.FieldUpdateBy:
-		m.ClearUpdateBy()
-		return nil
-	case deploymenttarget.FieldCreateTime:
-		m.ClearCreateTime()
-		return nil
-	case deploymenttarget.FieldUpdateTime:
-		m.ClearUpdateTime()
-		return nil
-	case deploymenttarget.FieldDeleteTime:
-		m.ClearDeleteTime()
-		return nil
-	case deploymenttarget.FieldTenantID:
-		m.ClearTenantID()
-		return nil
-	case deploymenttarget.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case deploymenttarget.FieldCertificateFilters:
-		m.ClearCertificateFilters()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentTarget nullable field %s", name)
+// SetDescription sets the "description" field.
+func (m *TargetConfigurationMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DeploymentTargetMutation) ResetField(name string) error {
-	switch name {
-	case deploymenttarget.FieldCreateBy:
-		m.ResetCreateBy()
-		return nil
-	case deploymenttarget.FieldUpdateBy:
-		m.ResetUpdateBy()
-		return nil
-	case deploymenttarget.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case deploymenttarget.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case deploymenttarget.FieldDeleteTime:
-		m.ResetDeleteTime()
-		return nil
-	case deploymenttarget.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case deploymenttarget.FieldName:
-		m.ResetName()
-		return nil
-	case deploymenttarget.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case deploymenttarget.FieldAutoDeployOnRenewal:
-		m.ResetAutoDeployOnRenewal()
-		return nil
-	case deploymenttarget.FieldCertificateFilters:
-		m.ResetCertificateFilters()
-		return nil
+// Description returns the value of the "description" field in the mutation.
+func (m *TargetConfigurationMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DeploymentTarget field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentTargetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.configurations != nil {
-		edges = append(edges, deploymenttarget.EdgeConfigurations)
+// OldDescription returns the old "description" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	if m.jobs != nil {
-		edges = append(edges, deploymenttarget.EdgeJobs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *DeploymentTargetMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case deploymenttarget.EdgeConfigurations:
-		ids := make([]ent.Value, 0, len(m.configurations))
-		for id := range m.configurations {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymenttarget.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.jobs))
-		for id := range m.jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearDescription clears the value of the "description" field.
+func (m *TargetConfigurationMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[targetconfiguration.FieldDescription] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentTargetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedconfigurations != nil {
-		edges = append(edges, deploymenttarget.EdgeConfigurations)
-	}
-	if m.removedjobs != nil {
-		edges = append(edges, deploymenttarget.EdgeJobs)
-	}
-	return edges
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldDescription]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DeploymentTargetMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case deploymenttarget.EdgeConfigurations:
-		ids := make([]ent.Value, 0, len(m.removedconfigurations))
-		for id := range m.removedconfigurations {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymenttarget.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.removedjobs))
-		for id := range m.removedjobs {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetDescription resets all changes to the "description" field.
+func (m *TargetConfigurationMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, targetconfiguration.FieldDescription)
+}
+
+// SetProviderType sets the "provider_type" field.
+func (m *TargetConfigurationMutation) SetProviderType(s string) {
+	m.provider_type = &s
+}
+
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *TargetConfigurationMutation) ProviderType() (r string, exists bool) {
+	v := m.provider_type
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentTargetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedconfigurations {
-		edges = append(edges, deploymenttarget.EdgeConfigurations)
+// OldProviderType returns the old "provider_type" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldProviderType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
 	}
-	if m.clearedjobs {
-		edges = append(edges, deploymenttarget.EdgeJobs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+	}
+	return oldValue.ProviderType, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DeploymentTargetMutation) EdgeCleared(name string) bool {
-	switch name {
-	case deploymenttarget.EdgeConfigurations:
-		return m.clearedconfigurations
-	case deploymenttarget.EdgeJobs:
-		return m.clearedjobs
-	}
-	return false
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *TargetConfigurationMutation) ResetProviderType() {
+	m.provider_type = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DeploymentTargetMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown DeploymentTarget unique edge %s", name)
+// SetCredentialsEncrypted sets the "credentials_encrypted" field.
+func (m *TargetConfigurationMutation) SetCredentialsEncrypted(b []byte) {
+	m.credentials_encrypted = &b
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DeploymentTargetMutation) ResetEdge(name string) error {
-	switch name {
-	case deploymenttarget.EdgeConfigurations:
-		m.ResetConfigurations()
-		return nil
-	case deploymenttarget.EdgeJobs:
-		m.ResetJobs()
-		return nil
+// CredentialsEncrypted returns the value of the "credentials_encrypted" field in the mutation.
+func (m *TargetConfigurationMutation) CredentialsEncrypted() (r []byte, exists bool) {
+	v := m.credentials_encrypted
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown DeploymentTarget edge %s", name)
+	return *v, true
 }
 
-// TargetConfigurationMutation represents an operation that mutates the TargetConfiguration nodes in the graph.
-type TargetConfigurationMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *string
-	create_by                 *uint32
-	addcreate_by              *int32
-	update_by                 *uint32
-	addupdate_by              *int32
-	create_time               *time.Time
-	update_time               *time.Time
-	delete_time               *time.Time
-	tenant_id                 *uint32
-	addtenant_id              *int32
-	name                      *string
-	description               *string
-	provider_type             *string
-	credentials_encrypted     *[]byte
-	_config                   *map[string]interface{}
-	status                    *targetconfiguration.Status
-	status_message            *string
-	last_deployment_at        *time.Time
-	clearedFields             map[string]struct{}
-	jobs                      map[string]struct{}
-	removedjobs               map[string]struct{}
-	clearedjobs               bool
-	deployment_targets        map[string]struct{}
-	removeddeployment_targets map[string]struct{}
-	cleareddeployment_targets bool
-	done                      bool
-	oldValue                  func(context.Context) (*TargetConfiguration, error)
-	predicates                []predicate.TargetConfiguration
+// OldCredentialsEncrypted returns the old "credentials_encrypted" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldCredentialsEncrypted(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialsEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialsEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialsEncrypted: %w", err)
+	}
+	return oldValue.CredentialsEncrypted, nil
 }
 
-var _ ent.Mutation = (*TargetConfigurationMutation)(nil)
+// ResetCredentialsEncrypted resets all changes to the "credentials_encrypted" field.
+func (m *TargetConfigurationMutation) ResetCredentialsEncrypted() {
+	m.credentials_encrypted = nil
+}
 
-// targetconfigurationOption allows management of the mutation configuration using functional options.
-type targetconfigurationOption func(*TargetConfigurationMutation)
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (m *TargetConfigurationMutation) SetCredentialRotatedAt(value map[string]time.Time) {
+	m.credential_rotated_at = &value
+}
 
-// newTargetConfigurationMutation creates new mutation for the TargetConfiguration entity.
-func newTargetConfigurationMutation(c config, op Op, opts ...targetconfigurationOption) *TargetConfigurationMutation {
-	m := &TargetConfigurationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTargetConfiguration,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// CredentialRotatedAt returns the value of the "credential_rotated_at" field in the mutation.
+func (m *TargetConfigurationMutation) CredentialRotatedAt() (r map[string]time.Time, exists bool) {
+	v := m.credential_rotated_at
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withTargetConfigurationID sets the ID field of the mutation.
-func withTargetConfigurationID(id string) targetconfigurationOption {
-	return func(m *TargetConfigurationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *TargetConfiguration
-		)
-		m.oldValue = func(ctx context.Context) (*TargetConfiguration, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().TargetConfiguration.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldCredentialRotatedAt returns the old "credential_rotated_at" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldCredentialRotatedAt(ctx context.Context) (v map[string]time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialRotatedAt: %w", err)
 	}
+	return oldValue.CredentialRotatedAt, nil
 }
 
-// withTargetConfiguration sets the old TargetConfiguration of the mutation.
-func withTargetConfiguration(node *TargetConfiguration) targetconfigurationOption {
-	return func(m *TargetConfigurationMutation) {
-		m.oldValue = func(context.Context) (*TargetConfiguration, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (m *TargetConfigurationMutation) ClearCredentialRotatedAt() {
+	m.credential_rotated_at = nil
+	m.clearedFields[targetconfiguration.FieldCredentialRotatedAt] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TargetConfigurationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// CredentialRotatedAtCleared returns if the "credential_rotated_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) CredentialRotatedAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldCredentialRotatedAt]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TargetConfigurationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetCredentialRotatedAt resets all changes to the "credential_rotated_at" field.
+func (m *TargetConfigurationMutation) ResetCredentialRotatedAt() {
+	m.credential_rotated_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldCredentialRotatedAt)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TargetConfiguration entities.
-func (m *TargetConfigurationMutation) SetID(id string) {
-	m.id = &id
+// SetConfig sets the "config" field.
+func (m *TargetConfigurationMutation) SetConfig(value map[string]interface{}) {
+	m._config = &value
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TargetConfigurationMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// Config returns the value of the "config" field in the mutation.
+func (m *TargetConfigurationMutation) Config() (r map[string]interface{}, exists bool) {
+	v := m._config
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TargetConfigurationMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TargetConfiguration.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldConfig returns the old "config" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
 	}
+	return oldValue.Config, nil
 }
 
-// SetCreateBy sets the "create_by" field.
-func (m *TargetConfigurationMutation) SetCreateBy(u uint32) {
-	m.create_by = &u
-	m.addcreate_by = nil
+// ClearConfig clears the value of the "config" field.
+func (m *TargetConfigurationMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[targetconfiguration.FieldConfig] = struct{}{}
 }
 
-// CreateBy returns the value of the "create_by" field in the mutation.
-func (m *TargetConfigurationMutation) CreateBy() (r uint32, exists bool) {
-	v := m.create_by
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldConfig]
+	return ok
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *TargetConfigurationMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, targetconfiguration.FieldConfig)
+}
+
+// SetStatus sets the "status" field.
+func (m *TargetConfigurationMutation) SetStatus(t targetconfiguration.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TargetConfigurationMutation) Status() (r targetconfiguration.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the TargetConfiguration entity.
+// OldStatus returns the old "status" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldCreateBy(ctx context.Context) (v *uint32, err error) {
+func (m *TargetConfigurationMutation) OldStatus(ctx context.Context) (v targetconfiguration.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CreateBy, nil
+	return oldValue.Status, nil
 }
 
-// AddCreateBy adds u to the "create_by" field.
-func (m *TargetConfigurationMutation) AddCreateBy(u int32) {
-	if m.addcreate_by != nil {
-		*m.addcreate_by += u
-	} else {
-		m.addcreate_by = &u
-	}
+// ResetStatus resets all changes to the "status" field.
+func (m *TargetConfigurationMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedCreateBy returns the value that was added to the "create_by" field in this mutation.
-func (m *TargetConfigurationMutation) AddedCreateBy() (r int32, exists bool) {
-	v := m.addcreate_by
+// SetStatusMessage sets the "status_message" field.
+func (m *TargetConfigurationMutation) SetStatusMessage(s string) {
+	m.status_message = &s
+}
+
+// StatusMessage returns the value of the "status_message" field in the mutation.
+func (m *TargetConfigurationMutation) StatusMessage() (r string, exists bool) {
+	v := m.status_message
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldStatusMessage returns the old "status_message" field's value of the TargetConfiguration entity.
+// If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetConfigurationMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	}
+	return oldValue.StatusMessage, nil
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (m *TargetConfigurationMutation) ClearCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	m.clearedFields[targetconfiguration.FieldCreateBy] = struct{}{}
+// ClearStatusMessage clears the value of the "status_message" field.
+func (m *TargetConfigurationMutation) ClearStatusMessage() {
+	m.status_message = nil
+	m.clearedFields[targetconfiguration.FieldStatusMessage] = struct{}{}
 }
 
-// CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldCreateBy]
+// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) StatusMessageCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldStatusMessage]
 	return ok
 }
 
-// ResetCreateBy resets all changes to the "create_by" field.
-func (m *TargetConfigurationMutation) ResetCreateBy() {
-	m.create_by = nil
-	m.addcreate_by = nil
-	delete(m.clearedFields, targetconfiguration.FieldCreateBy)
+// ResetStatusMessage resets all changes to the "status_message" field.
+func (m *TargetConfigurationMutation) ResetStatusMessage() {
+	m.status_message = nil
+	delete(m.clearedFields, targetconfiguration.FieldStatusMessage)
 }
 
-// SetUpdateBy sets the "update_by" field.
-func (m *TargetConfigurationMutation) SetUpdateBy(u uint32) {
-	m.update_by = &u
-	m.addupdate_by = nil
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *TargetConfigurationMutation) SetConsecutiveFailures(i int32) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
 }
 
-// UpdateBy returns the value of the "update_by" field in the mutation.
-func (m *TargetConfigurationMutation) UpdateBy() (r uint32, exists bool) {
-	v := m.update_by
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *TargetConfigurationMutation) ConsecutiveFailures() (r int32, exists bool) {
+	v := m.consecutive_failures
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateBy returns the old "update_by" field's value of the TargetConfiguration entity.
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldUpdateBy(ctx context.Context) (v *uint32, err error) {
+func (m *TargetConfigurationMutation) OldConsecutiveFailures(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
 	}
-	return oldValue.UpdateBy, nil
+	return oldValue.ConsecutiveFailures, nil
 }
 
-// AddUpdateBy adds u to the "update_by" field.
-func (m *TargetConfigurationMutation) AddUpdateBy(u int32) {
-	if m.addupdate_by != nil {
-		*m.addupdate_by += u
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *TargetConfigurationMutation) AddConsecutiveFailures(i int32) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
 	} else {
-		m.addupdate_by = &u
+		m.addconsecutive_failures = &i
 	}
 }
 
-// AddedUpdateBy returns the value that was added to the "update_by" field in this mutation.
-func (m *TargetConfigurationMutation) AddedUpdateBy() (r int32, exists bool) {
-	v := m.addupdate_by
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *TargetConfigurationMutation) AddedConsecutiveFailures() (r int32, exists bool) {
+	v := m.addconsecutive_failures
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearUpdateBy clears the value of the "update_by" field.
-func (m *TargetConfigurationMutation) ClearUpdateBy() {
-	m.update_by = nil
-	m.addupdate_by = nil
-	m.clearedFields[targetconfiguration.FieldUpdateBy] = struct{}{}
-}
-
-// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) UpdateByCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldUpdateBy]
-	return ok
-}
-
-// ResetUpdateBy resets all changes to the "update_by" field.
-func (m *TargetConfigurationMutation) ResetUpdateBy() {
-	m.update_by = nil
-	m.addupdate_by = nil
-	delete(m.clearedFields, targetconfiguration.FieldUpdateBy)
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *TargetConfigurationMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *TargetConfigurationMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetNextProbeAt sets the "next_probe_at" field.
+func (m *TargetConfigurationMutation) SetNextProbeAt(t time.Time) {
+	m.next_probe_at = &t
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TargetConfigurationMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// NextProbeAt returns the value of the "next_probe_at" field in the mutation.
+func (m *TargetConfigurationMutation) NextProbeAt() (r time.Time, exists bool) {
+	v := m.next_probe_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TargetConfiguration entity.
+// OldNextProbeAt returns the old "next_probe_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldCreateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TargetConfigurationMutation) OldNextProbeAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextProbeAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldNextProbeAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextProbeAt: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.NextProbeAt, nil
 }
 
-// ClearCreateTime clears the value of the "create_time" field.
-func (m *TargetConfigurationMutation) ClearCreateTime() {
-	m.create_time = nil
-	m.clearedFields[targetconfiguration.FieldCreateTime] = struct{}{}
+// ClearNextProbeAt clears the value of the "next_probe_at" field.
+func (m *TargetConfigurationMutation) ClearNextProbeAt() {
+	m.next_probe_at = nil
+	m.clearedFields[targetconfiguration.FieldNextProbeAt] = struct{}{}
 }
 
-// CreateTimeCleared returns if the "create_time" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) CreateTimeCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldCreateTime]
+// NextProbeAtCleared returns if the "next_probe_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) NextProbeAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldNextProbeAt]
 	return ok
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TargetConfigurationMutation) ResetCreateTime() {
-	m.create_time = nil
-	delete(m.clearedFields, targetconfiguration.FieldCreateTime)
+// ResetNextProbeAt resets all changes to the "next_probe_at" field.
+func (m *TargetConfigurationMutation) ResetNextProbeAt() {
+	m.next_probe_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldNextProbeAt)
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *TargetConfigurationMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (m *TargetConfigurationMutation) SetLastValidatedAt(t time.Time) {
+	m.last_validated_at = &t
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TargetConfigurationMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// LastValidatedAt returns the value of the "last_validated_at" field in the mutation.
+func (m *TargetConfigurationMutation) LastValidatedAt() (r time.Time, exists bool) {
+	v := m.last_validated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TargetConfiguration entity.
+// OldLastValidatedAt returns the old "last_validated_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldUpdateTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TargetConfigurationMutation) OldLastValidatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastValidatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldLastValidatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastValidatedAt: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.LastValidatedAt, nil
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (m *TargetConfigurationMutation) ClearUpdateTime() {
-	m.update_time = nil
-	m.clearedFields[targetconfiguration.FieldUpdateTime] = struct{}{}
+// ClearLastValidatedAt clears the value of the "last_validated_at" field.
+func (m *TargetConfigurationMutation) ClearLastValidatedAt() {
+	m.last_validated_at = nil
+	m.clearedFields[targetconfiguration.FieldLastValidatedAt] = struct{}{}
 }
 
-// UpdateTimeCleared returns if the "update_time" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) UpdateTimeCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldUpdateTime]
+// LastValidatedAtCleared returns if the "last_validated_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) LastValidatedAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldLastValidatedAt]
 	return ok
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TargetConfigurationMutation) ResetUpdateTime() {
-	m.update_time = nil
-	delete(m.clearedFields, targetconfiguration.FieldUpdateTime)
+// ResetLastValidatedAt resets all changes to the "last_validated_at" field.
+func (m *TargetConfigurationMutation) ResetLastValidatedAt() {
+	m.last_validated_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldLastValidatedAt)
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (m *TargetConfigurationMutation) SetDeleteTime(t time.Time) {
-	m.delete_time = &t
+// SetLastValidationError sets the "last_validation_error" field.
+func (m *TargetConfigurationMutation) SetLastValidationError(s string) {
+	m.last_validation_error = &s
 }
 
-// DeleteTime returns the value of the "delete_time" field in the mutation.
-func (m *TargetConfigurationMutation) DeleteTime() (r time.Time, exists bool) {
-	v := m.delete_time
+// LastValidationError returns the value of the "last_validation_error" field in the mutation.
+func (m *TargetConfigurationMutation) LastValidationError() (r string, exists bool) {
+	v := m.last_validation_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeleteTime returns the old "delete_time" field's value of the TargetConfiguration entity.
+// OldLastValidationError returns the old "last_validation_error" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TargetConfigurationMutation) OldLastValidationError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastValidationError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+		return v, errors.New("OldLastValidationError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastValidationError: %w", err)
 	}
-	return oldValue.DeleteTime, nil
+	return oldValue.LastValidationError, nil
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (m *TargetConfigurationMutation) ClearDeleteTime() {
-	m.delete_time = nil
-	m.clearedFields[targetconfiguration.FieldDeleteTime] = struct{}{}
+// ClearLastValidationError clears the value of the "last_validation_error" field.
+func (m *TargetConfigurationMutation) ClearLastValidationError() {
+	m.last_validation_error = nil
+	m.clearedFields[targetconfiguration.FieldLastValidationError] = struct{}{}
 }
 
-// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) DeleteTimeCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldDeleteTime]
+// LastValidationErrorCleared returns if the "last_validation_error" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) LastValidationErrorCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldLastValidationError]
 	return ok
 }
 
-// ResetDeleteTime resets all changes to the "delete_time" field.
-func (m *TargetConfigurationMutation) ResetDeleteTime() {
-	m.delete_time = nil
-	delete(m.clearedFields, targetconfiguration.FieldDeleteTime)
+// ResetLastValidationError resets all changes to the "last_validation_error" field.
+func (m *TargetConfigurationMutation) ResetLastValidationError() {
+	m.last_validation_error = nil
+	delete(m.clearedFields, targetconfiguration.FieldLastValidationError)
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *TargetConfigurationMutation) SetTenantID(u uint32) {
-	m.tenant_id = &u
-	m.addtenant_id = nil
+// SetNextValidationAt sets the "next_validation_at" field.
+func (m *TargetConfigurationMutation) SetNextValidationAt(t time.Time) {
+	m.next_validation_at = &t
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *TargetConfigurationMutation) TenantID() (r uint32, exists bool) {
-	v := m.tenant_id
+// NextValidationAt returns the value of the "next_validation_at" field in the mutation.
+func (m *TargetConfigurationMutation) NextValidationAt() (r time.Time, exists bool) {
+	v := m.next_validation_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the TargetConfiguration entity.
+// OldNextValidationAt returns the old "next_validation_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldTenantID(ctx context.Context) (v *uint32, err error) {
+func (m *TargetConfigurationMutation) OldNextValidationAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextValidationAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldNextValidationAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
-	}
-	return oldValue.TenantID, nil
-}
-
-// AddTenantID adds u to the "tenant_id" field.
-func (m *TargetConfigurationMutation) AddTenantID(u int32) {
-	if m.addtenant_id != nil {
-		*m.addtenant_id += u
-	} else {
-		m.addtenant_id = &u
-	}
-}
-
-// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *TargetConfigurationMutation) AddedTenantID() (r int32, exists bool) {
-	v := m.addtenant_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNextValidationAt: %w", err)
 	}
-	return *v, true
+	return oldValue.NextValidationAt, nil
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *TargetConfigurationMutation) ClearTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	m.clearedFields[targetconfiguration.FieldTenantID] = struct{}{}
+// ClearNextValidationAt clears the value of the "next_validation_at" field.
+func (m *TargetConfigurationMutation) ClearNextValidationAt() {
+	m.next_validation_at = nil
+	m.clearedFields[targetconfiguration.FieldNextValidationAt] = struct{}{}
 }
 
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldTenantID]
+// NextValidationAtCleared returns if the "next_validation_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) NextValidationAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldNextValidationAt]
 	return ok
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *TargetConfigurationMutation) ResetTenantID() {
-	m.tenant_id = nil
-	m.addtenant_id = nil
-	delete(m.clearedFields, targetconfiguration.FieldTenantID)
+// ResetNextValidationAt resets all changes to the "next_validation_at" field.
+func (m *TargetConfigurationMutation) ResetNextValidationAt() {
+	m.next_validation_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldNextValidationAt)
 }
 
-// SetName sets the "name" field.
-func (m *TargetConfigurationMutation) SetName(s string) {
-	m.name = &s
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (m *TargetConfigurationMutation) SetTLSProbeAddress(s string) {
+	m.tls_probe_address = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TargetConfigurationMutation) Name() (r string, exists bool) {
-	v := m.name
+// TLSProbeAddress returns the value of the "tls_probe_address" field in the mutation.
+func (m *TargetConfigurationMutation) TLSProbeAddress() (r string, exists bool) {
+	v := m.tls_probe_address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the TargetConfiguration entity.
+// OldTLSProbeAddress returns the old "tls_probe_address" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TargetConfigurationMutation) OldTLSProbeAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTLSProbeAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTLSProbeAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTLSProbeAddress: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TLSProbeAddress, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TargetConfigurationMutation) ResetName() {
-	m.name = nil
+// ClearTLSProbeAddress clears the value of the "tls_probe_address" field.
+func (m *TargetConfigurationMutation) ClearTLSProbeAddress() {
+	m.tls_probe_address = nil
+	m.clearedFields[targetconfiguration.FieldTLSProbeAddress] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *TargetConfigurationMutation) SetDescription(s string) {
-	m.description = &s
+// TLSProbeAddressCleared returns if the "tls_probe_address" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) TLSProbeAddressCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldTLSProbeAddress]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TargetConfigurationMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetTLSProbeAddress resets all changes to the "tls_probe_address" field.
+func (m *TargetConfigurationMutation) ResetTLSProbeAddress() {
+	m.tls_probe_address = nil
+	delete(m.clearedFields, targetconfiguration.FieldTLSProbeAddress)
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (m *TargetConfigurationMutation) SetLastDriftCheckAt(t time.Time) {
+	m.last_drift_check_at = &t
+}
+
+// LastDriftCheckAt returns the value of the "last_drift_check_at" field in the mutation.
+func (m *TargetConfigurationMutation) LastDriftCheckAt() (r time.Time, exists bool) {
+	v := m.last_drift_check_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the TargetConfiguration entity.
+// OldLastDriftCheckAt returns the old "last_drift_check_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TargetConfigurationMutation) OldLastDriftCheckAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastDriftCheckAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLastDriftCheckAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastDriftCheckAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.LastDriftCheckAt, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *TargetConfigurationMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[targetconfiguration.FieldDescription] = struct{}{}
+// ClearLastDriftCheckAt clears the value of the "last_drift_check_at" field.
+func (m *TargetConfigurationMutation) ClearLastDriftCheckAt() {
+	m.last_drift_check_at = nil
+	m.clearedFields[targetconfiguration.FieldLastDriftCheckAt] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldDescription]
+// LastDriftCheckAtCleared returns if the "last_drift_check_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) LastDriftCheckAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldLastDriftCheckAt]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TargetConfigurationMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, targetconfiguration.FieldDescription)
+// ResetLastDriftCheckAt resets all changes to the "last_drift_check_at" field.
+func (m *TargetConfigurationMutation) ResetLastDriftCheckAt() {
+	m.last_drift_check_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldLastDriftCheckAt)
 }
 
-// SetProviderType sets the "provider_type" field.
-func (m *TargetConfigurationMutation) SetProviderType(s string) {
-	m.provider_type = &s
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (m *TargetConfigurationMutation) SetNextDriftCheckAt(t time.Time) {
+	m.next_drift_check_at = &t
 }
 
-// ProviderType returns the value of the "provider_type" field in the mutation.
-func (m *TargetConfigurationMutation) ProviderType() (r string, exists bool) {
-	v := m.provider_type
+// NextDriftCheckAt returns the value of the "next_drift_check_at" field in the mutation.
+func (m *TargetConfigurationMutation) NextDriftCheckAt() (r time.Time, exists bool) {
+	v := m.next_drift_check_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderType returns the old "provider_type" field's value of the TargetConfiguration entity.
+// OldNextDriftCheckAt returns the old "next_drift_check_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldProviderType(ctx context.Context) (v string, err error) {
+func (m *TargetConfigurationMutation) OldNextDriftCheckAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextDriftCheckAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderType requires an ID field in the mutation")
+		return v, errors.New("OldNextDriftCheckAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextDriftCheckAt: %w", err)
 	}
-	return oldValue.ProviderType, nil
+	return oldValue.NextDriftCheckAt, nil
 }
 
-// ResetProviderType resets all changes to the "provider_type" field.
-func (m *TargetConfigurationMutation) ResetProviderType() {
-	m.provider_type = nil
+// ClearNextDriftCheckAt clears the value of the "next_drift_check_at" field.
+func (m *TargetConfigurationMutation) ClearNextDriftCheckAt() {
+	m.next_drift_check_at = nil
+	m.clearedFields[targetconfiguration.FieldNextDriftCheckAt] = struct{}{}
 }
 
-// SetCredentialsEncrypted sets the "credentials_encrypted" field.
-func (m *TargetConfigurationMutation) SetCredentialsEncrypted(b []byte) {
-	m.credentials_encrypted = &b
+// NextDriftCheckAtCleared returns if the "next_drift_check_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) NextDriftCheckAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldNextDriftCheckAt]
+	return ok
 }
 
-// CredentialsEncrypted returns the value of the "credentials_encrypted" field in the mutation.
-func (m *TargetConfigurationMutation) CredentialsEncrypted() (r []byte, exists bool) {
-	v := m.credentials_encrypted
+// ResetNextDriftCheckAt resets all changes to the "next_drift_check_at" field.
+func (m *TargetConfigurationMutation) ResetNextDriftCheckAt() {
+	m.next_drift_check_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldNextDriftCheckAt)
+}
+
+// SetLastDeploymentAt sets the "last_deployment_at" field.
+func (m *TargetConfigurationMutation) SetLastDeploymentAt(t time.Time) {
+	m.last_deployment_at = &t
+}
+
+// LastDeploymentAt returns the value of the "last_deployment_at" field in the mutation.
+func (m *TargetConfigurationMutation) LastDeploymentAt() (r time.Time, exists bool) {
+	v := m.last_deployment_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCredentialsEncrypted returns the old "credentials_encrypted" field's value of the TargetConfiguration entity.
+// OldLastDeploymentAt returns the old "last_deployment_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldCredentialsEncrypted(ctx context.Context) (v []byte, err error) {
+func (m *TargetConfigurationMutation) OldLastDeploymentAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCredentialsEncrypted is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastDeploymentAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCredentialsEncrypted requires an ID field in the mutation")
+		return v, errors.New("OldLastDeploymentAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCredentialsEncrypted: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastDeploymentAt: %w", err)
 	}
-	return oldValue.CredentialsEncrypted, nil
+	return oldValue.LastDeploymentAt, nil
 }
 
-// ResetCredentialsEncrypted resets all changes to the "credentials_encrypted" field.
-func (m *TargetConfigurationMutation) ResetCredentialsEncrypted() {
-	m.credentials_encrypted = nil
+// ClearLastDeploymentAt clears the value of the "last_deployment_at" field.
+func (m *TargetConfigurationMutation) ClearLastDeploymentAt() {
+	m.last_deployment_at = nil
+	m.clearedFields[targetconfiguration.FieldLastDeploymentAt] = struct{}{}
 }
 
-// SetConfig sets the "config" field.
-func (m *TargetConfigurationMutation) SetConfig(value map[string]interface{}) {
-	m._config = &value
+// LastDeploymentAtCleared returns if the "last_deployment_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) LastDeploymentAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldLastDeploymentAt]
+	return ok
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *TargetConfigurationMutation) Config() (r map[string]interface{}, exists bool) {
-	v := m._config
+// ResetLastDeploymentAt resets all changes to the "last_deployment_at" field.
+func (m *TargetConfigurationMutation) ResetLastDeploymentAt() {
+	m.last_deployment_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldLastDeploymentAt)
+}
+
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (m *TargetConfigurationMutation) SetDeployedCertificateID(s string) {
+	m.deployed_certificate_id = &s
+}
+
+// DeployedCertificateID returns the value of the "deployed_certificate_id" field in the mutation.
+func (m *TargetConfigurationMutation) DeployedCertificateID() (r string, exists bool) {
+	v := m.deployed_certificate_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the TargetConfiguration entity.
+// OldDeployedCertificateID returns the old "deployed_certificate_id" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *TargetConfigurationMutation) OldDeployedCertificateID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeployedCertificateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldDeployedCertificateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeployedCertificateID: %w", err)
 	}
-	return oldValue.Config, nil
+	return oldValue.DeployedCertificateID, nil
 }
 
-// ClearConfig clears the value of the "config" field.
-func (m *TargetConfigurationMutation) ClearConfig() {
-	m._config = nil
-	m.clearedFields[targetconfiguration.FieldConfig] = struct{}{}
+// ClearDeployedCertificateID clears the value of the "deployed_certificate_id" field.
+func (m *TargetConfigurationMutation) ClearDeployedCertificateID() {
+	m.deployed_certificate_id = nil
+	m.clearedFields[targetconfiguration.FieldDeployedCertificateID] = struct{}{}
 }
 
-// ConfigCleared returns if the "config" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) ConfigCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldConfig]
+// DeployedCertificateIDCleared returns if the "deployed_certificate_id" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) DeployedCertificateIDCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldDeployedCertificateID]
 	return ok
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *TargetConfigurationMutation) ResetConfig() {
-	m._config = nil
-	delete(m.clearedFields, targetconfiguration.FieldConfig)
+// ResetDeployedCertificateID resets all changes to the "deployed_certificate_id" field.
+func (m *TargetConfigurationMutation) ResetDeployedCertificateID() {
+	m.deployed_certificate_id = nil
+	delete(m.clearedFields, targetconfiguration.FieldDeployedCertificateID)
 }
 
-// SetStatus sets the "status" field.
-func (m *TargetConfigurationMutation) SetStatus(t targetconfiguration.Status) {
-	m.status = &t
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (m *TargetConfigurationMutation) SetDeployedCertificateExpiresAt(t time.Time) {
+	m.deployed_certificate_expires_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TargetConfigurationMutation) Status() (r targetconfiguration.Status, exists bool) {
-	v := m.status
+// DeployedCertificateExpiresAt returns the value of the "deployed_certificate_expires_at" field in the mutation.
+func (m *TargetConfigurationMutation) DeployedCertificateExpiresAt() (r time.Time, exists bool) {
+	v := m.deployed_certificate_expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TargetConfiguration entity.
+// OldDeployedCertificateExpiresAt returns the old "deployed_certificate_expires_at" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldStatus(ctx context.Context) (v targetconfiguration.Status, err error) {
+func (m *TargetConfigurationMutation) OldDeployedCertificateExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeployedCertificateExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldDeployedCertificateExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeployedCertificateExpiresAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.DeployedCertificateExpiresAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TargetConfigurationMutation) ResetStatus() {
-	m.status = nil
+// ClearDeployedCertificateExpiresAt clears the value of the "deployed_certificate_expires_at" field.
+func (m *TargetConfigurationMutation) ClearDeployedCertificateExpiresAt() {
+	m.deployed_certificate_expires_at = nil
+	m.clearedFields[targetconfiguration.FieldDeployedCertificateExpiresAt] = struct{}{}
 }
 
-// SetStatusMessage sets the "status_message" field.
-func (m *TargetConfigurationMutation) SetStatusMessage(s string) {
-	m.status_message = &s
+// DeployedCertificateExpiresAtCleared returns if the "deployed_certificate_expires_at" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) DeployedCertificateExpiresAtCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldDeployedCertificateExpiresAt]
+	return ok
 }
 
-// StatusMessage returns the value of the "status_message" field in the mutation.
-func (m *TargetConfigurationMutation) StatusMessage() (r string, exists bool) {
-	v := m.status_message
+// ResetDeployedCertificateExpiresAt resets all changes to the "deployed_certificate_expires_at" field.
+func (m *TargetConfigurationMutation) ResetDeployedCertificateExpiresAt() {
+	m.deployed_certificate_expires_at = nil
+	delete(m.clearedFields, targetconfiguration.FieldDeployedCertificateExpiresAt)
+}
+
+// SetRevision sets the "revision" field.
+func (m *TargetConfigurationMutation) SetRevision(u uint32) {
+	m.revision = &u
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *TargetConfigurationMutation) Revision() (r uint32, exists bool) {
+	v := m.revision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatusMessage returns the old "status_message" field's value of the TargetConfiguration entity.
+// OldRevision returns the old "revision" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+func (m *TargetConfigurationMutation) OldRevision(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+		return v, errors.New("OldRevision requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
 	}
-	return oldValue.StatusMessage, nil
+	return oldValue.Revision, nil
 }
 
-// ClearStatusMessage clears the value of the "status_message" field.
-func (m *TargetConfigurationMutation) ClearStatusMessage() {
-	m.status_message = nil
-	m.clearedFields[targetconfiguration.FieldStatusMessage] = struct{}{}
+// AddRevision adds u to the "revision" field.
+func (m *TargetConfigurationMutation) AddRevision(u int32) {
+	if m.addrevision != nil {
+		*m.addrevision += u
+	} else {
+		m.addrevision = &u
+	}
 }
 
-// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) StatusMessageCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldStatusMessage]
-	return ok
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *TargetConfigurationMutation) AddedRevision() (r int32, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetStatusMessage resets all changes to the "status_message" field.
-func (m *TargetConfigurationMutation) ResetStatusMessage() {
-	m.status_message = nil
-	delete(m.clearedFields, targetconfiguration.FieldStatusMessage)
+// ResetRevision resets all changes to the "revision" field.
+func (m *TargetConfigurationMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
 }
 
-// SetLastDeploymentAt sets the "last_deployment_at" field.
-func (m *TargetConfigurationMutation) SetLastDeploymentAt(t time.Time) {
-	m.last_deployment_at = &t
+// SetRetryPolicy sets the "retry_policy" field.
+func (m *TargetConfigurationMutation) SetRetryPolicy(rp *registry.RetryPolicy) {
+	m.retry_policy = &rp
 }
 
-// LastDeploymentAt returns the value of the "last_deployment_at" field in the mutation.
-func (m *TargetConfigurationMutation) LastDeploymentAt() (r time.Time, exists bool) {
-	v := m.last_deployment_at
+// RetryPolicy returns the value of the "retry_policy" field in the mutation.
+func (m *TargetConfigurationMutation) RetryPolicy() (r *registry.RetryPolicy, exists bool) {
+	v := m.retry_policy
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastDeploymentAt returns the old "last_deployment_at" field's value of the TargetConfiguration entity.
+// OldRetryPolicy returns the old "retry_policy" field's value of the TargetConfiguration entity.
 // If the TargetConfiguration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetConfigurationMutation) OldLastDeploymentAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TargetConfigurationMutation) OldRetryPolicy(ctx context.Context) (v *registry.RetryPolicy, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastDeploymentAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRetryPolicy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastDeploymentAt requires an ID field in the mutation")
+		return v, errors.New("OldRetryPolicy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastDeploymentAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetryPolicy: %w", err)
 	}
-	return oldValue.LastDeploymentAt, nil
+	return oldValue.RetryPolicy, nil
 }
 
-// ClearLastDeploymentAt clears the value of the "last_deployment_at" field.
-func (m *TargetConfigurationMutation) ClearLastDeploymentAt() {
-	m.last_deployment_at = nil
-	m.clearedFields[targetconfiguration.FieldLastDeploymentAt] = struct{}{}
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (m *TargetConfigurationMutation) ClearRetryPolicy() {
+	m.retry_policy = nil
+	m.clearedFields[targetconfiguration.FieldRetryPolicy] = struct{}{}
 }
 
-// LastDeploymentAtCleared returns if the "last_deployment_at" field was cleared in this mutation.
-func (m *TargetConfigurationMutation) LastDeploymentAtCleared() bool {
-	_, ok := m.clearedFields[targetconfiguration.FieldLastDeploymentAt]
+// RetryPolicyCleared returns if the "retry_policy" field was cleared in this mutation.
+func (m *TargetConfigurationMutation) RetryPolicyCleared() bool {
+	_, ok := m.clearedFields[targetconfiguration.FieldRetryPolicy]
 	return ok
 }
 
-// ResetLastDeploymentAt resets all changes to the "last_deployment_at" field.
-func (m *TargetConfigurationMutation) ResetLastDeploymentAt() {
-	m.last_deployment_at = nil
-	delete(m.clearedFields, targetconfiguration.FieldLastDeploymentAt)
+// ResetRetryPolicy resets all changes to the "retry_policy" field.
+func (m *TargetConfigurationMutation) ResetRetryPolicy() {
+	m.retry_policy = nil
+	delete(m.clearedFields, targetconfiguration.FieldRetryPolicy)
 }
 
 // AddJobIDs adds the "jobs" edge to the DeploymentJob entity by ids.
@@ -7226,7 +19859,7 @@ func (m *TargetConfigurationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TargetConfigurationMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 27)
 	if m.create_by != nil {
 		fields = append(fields, targetconfiguration.FieldCreateBy)
 	}
@@ -7257,6 +19890,9 @@ func (m *TargetConfigurationMutation) Fields() []string {
 	if m.credentials_encrypted != nil {
 		fields = append(fields, targetconfiguration.FieldCredentialsEncrypted)
 	}
+	if m.credential_rotated_at != nil {
+		fields = append(fields, targetconfiguration.FieldCredentialRotatedAt)
+	}
 	if m._config != nil {
 		fields = append(fields, targetconfiguration.FieldConfig)
 	}
@@ -7266,9 +19902,45 @@ func (m *TargetConfigurationMutation) Fields() []string {
 	if m.status_message != nil {
 		fields = append(fields, targetconfiguration.FieldStatusMessage)
 	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, targetconfiguration.FieldConsecutiveFailures)
+	}
+	if m.next_probe_at != nil {
+		fields = append(fields, targetconfiguration.FieldNextProbeAt)
+	}
+	if m.last_validated_at != nil {
+		fields = append(fields, targetconfiguration.FieldLastValidatedAt)
+	}
+	if m.last_validation_error != nil {
+		fields = append(fields, targetconfiguration.FieldLastValidationError)
+	}
+	if m.next_validation_at != nil {
+		fields = append(fields, targetconfiguration.FieldNextValidationAt)
+	}
+	if m.tls_probe_address != nil {
+		fields = append(fields, targetconfiguration.FieldTLSProbeAddress)
+	}
+	if m.last_drift_check_at != nil {
+		fields = append(fields, targetconfiguration.FieldLastDriftCheckAt)
+	}
+	if m.next_drift_check_at != nil {
+		fields = append(fields, targetconfiguration.FieldNextDriftCheckAt)
+	}
 	if m.last_deployment_at != nil {
 		fields = append(fields, targetconfiguration.FieldLastDeploymentAt)
 	}
+	if m.deployed_certificate_id != nil {
+		fields = append(fields, targetconfiguration.FieldDeployedCertificateID)
+	}
+	if m.deployed_certificate_expires_at != nil {
+		fields = append(fields, targetconfiguration.FieldDeployedCertificateExpiresAt)
+	}
+	if m.revision != nil {
+		fields = append(fields, targetconfiguration.FieldRevision)
+	}
+	if m.retry_policy != nil {
+		fields = append(fields, targetconfiguration.FieldRetryPolicy)
+	}
 	return fields
 }
 
@@ -7297,14 +19969,40 @@ func (m *TargetConfigurationMutation) Field(name string) (ent.Value, bool) {
 		return m.ProviderType()
 	case targetconfiguration.FieldCredentialsEncrypted:
 		return m.CredentialsEncrypted()
+	case targetconfiguration.FieldCredentialRotatedAt:
+		return m.CredentialRotatedAt()
 	case targetconfiguration.FieldConfig:
 		return m.Config()
 	case targetconfiguration.FieldStatus:
 		return m.Status()
 	case targetconfiguration.FieldStatusMessage:
 		return m.StatusMessage()
+	case targetconfiguration.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case targetconfiguration.FieldNextProbeAt:
+		return m.NextProbeAt()
+	case targetconfiguration.FieldLastValidatedAt:
+		return m.LastValidatedAt()
+	case targetconfiguration.FieldLastValidationError:
+		return m.LastValidationError()
+	case targetconfiguration.FieldNextValidationAt:
+		return m.NextValidationAt()
+	case targetconfiguration.FieldTLSProbeAddress:
+		return m.TLSProbeAddress()
+	case targetconfiguration.FieldLastDriftCheckAt:
+		return m.LastDriftCheckAt()
+	case targetconfiguration.FieldNextDriftCheckAt:
+		return m.NextDriftCheckAt()
 	case targetconfiguration.FieldLastDeploymentAt:
 		return m.LastDeploymentAt()
+	case targetconfiguration.FieldDeployedCertificateID:
+		return m.DeployedCertificateID()
+	case targetconfiguration.FieldDeployedCertificateExpiresAt:
+		return m.DeployedCertificateExpiresAt()
+	case targetconfiguration.FieldRevision:
+		return m.Revision()
+	case targetconfiguration.FieldRetryPolicy:
+		return m.RetryPolicy()
 	}
 	return nil, false
 }
@@ -7334,14 +20032,40 @@ func (m *TargetConfigurationMutation) OldField(ctx context.Context, name string)
 		return m.OldProviderType(ctx)
 	case targetconfiguration.FieldCredentialsEncrypted:
 		return m.OldCredentialsEncrypted(ctx)
+	case targetconfiguration.FieldCredentialRotatedAt:
+		return m.OldCredentialRotatedAt(ctx)
 	case targetconfiguration.FieldConfig:
 		return m.OldConfig(ctx)
 	case targetconfiguration.FieldStatus:
 		return m.OldStatus(ctx)
 	case targetconfiguration.FieldStatusMessage:
 		return m.OldStatusMessage(ctx)
+	case targetconfiguration.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case targetconfiguration.FieldNextProbeAt:
+		return m.OldNextProbeAt(ctx)
+	case targetconfiguration.FieldLastValidatedAt:
+		return m.OldLastValidatedAt(ctx)
+	case targetconfiguration.FieldLastValidationError:
+		return m.OldLastValidationError(ctx)
+	case targetconfiguration.FieldNextValidationAt:
+		return m.OldNextValidationAt(ctx)
+	case targetconfiguration.FieldTLSProbeAddress:
+		return m.OldTLSProbeAddress(ctx)
+	case targetconfiguration.FieldLastDriftCheckAt:
+		return m.OldLastDriftCheckAt(ctx)
+	case targetconfiguration.FieldNextDriftCheckAt:
+		return m.OldNextDriftCheckAt(ctx)
 	case targetconfiguration.FieldLastDeploymentAt:
 		return m.OldLastDeploymentAt(ctx)
+	case targetconfiguration.FieldDeployedCertificateID:
+		return m.OldDeployedCertificateID(ctx)
+	case targetconfiguration.FieldDeployedCertificateExpiresAt:
+		return m.OldDeployedCertificateExpiresAt(ctx)
+	case targetconfiguration.FieldRevision:
+		return m.OldRevision(ctx)
+	case targetconfiguration.FieldRetryPolicy:
+		return m.OldRetryPolicy(ctx)
 	}
 	return nil, fmt.Errorf("unknown TargetConfiguration field %s", name)
 }
@@ -7421,6 +20145,13 @@ func (m *TargetConfigurationMutation) SetField(name string, value ent.Value) err
 		}
 		m.SetCredentialsEncrypted(v)
 		return nil
+	case targetconfiguration.FieldCredentialRotatedAt:
+		v, ok := value.(map[string]time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialRotatedAt(v)
+		return nil
 	case targetconfiguration.FieldConfig:
 		v, ok := value.(map[string]interface{})
 		if !ok {
@@ -7442,6 +20173,62 @@ func (m *TargetConfigurationMutation) SetField(name string, value ent.Value) err
 		}
 		m.SetStatusMessage(v)
 		return nil
+	case targetconfiguration.FieldConsecutiveFailures:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case targetconfiguration.FieldNextProbeAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextProbeAt(v)
+		return nil
+	case targetconfiguration.FieldLastValidatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastValidatedAt(v)
+		return nil
+	case targetconfiguration.FieldLastValidationError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastValidationError(v)
+		return nil
+	case targetconfiguration.FieldNextValidationAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextValidationAt(v)
+		return nil
+	case targetconfiguration.FieldTLSProbeAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSProbeAddress(v)
+		return nil
+	case targetconfiguration.FieldLastDriftCheckAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDriftCheckAt(v)
+		return nil
+	case targetconfiguration.FieldNextDriftCheckAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextDriftCheckAt(v)
+		return nil
 	case targetconfiguration.FieldLastDeploymentAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -7449,6 +20236,34 @@ func (m *TargetConfigurationMutation) SetField(name string, value ent.Value) err
 		}
 		m.SetLastDeploymentAt(v)
 		return nil
+	case targetconfiguration.FieldDeployedCertificateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeployedCertificateID(v)
+		return nil
+	case targetconfiguration.FieldDeployedCertificateExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeployedCertificateExpiresAt(v)
+		return nil
+	case targetconfiguration.FieldRevision:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case targetconfiguration.FieldRetryPolicy:
+		v, ok := value.(*registry.RetryPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryPolicy(v)
+		return nil
 	}
 	return fmt.Errorf("unknown TargetConfiguration field %s", name)
 }
@@ -7466,6 +20281,12 @@ func (m *TargetConfigurationMutation) AddedFields() []string {
 	if m.addtenant_id != nil {
 		fields = append(fields, targetconfiguration.FieldTenantID)
 	}
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, targetconfiguration.FieldConsecutiveFailures)
+	}
+	if m.addrevision != nil {
+		fields = append(fields, targetconfiguration.FieldRevision)
+	}
 	return fields
 }
 
@@ -7480,6 +20301,10 @@ func (m *TargetConfigurationMutation) AddedField(name string) (ent.Value, bool)
 		return m.AddedUpdateBy()
 	case targetconfiguration.FieldTenantID:
 		return m.AddedTenantID()
+	case targetconfiguration.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	case targetconfiguration.FieldRevision:
+		return m.AddedRevision()
 	}
 	return nil, false
 }
@@ -7510,6 +20335,20 @@ func (m *TargetConfigurationMutation) AddField(name string, value ent.Value) err
 		}
 		m.AddTenantID(v)
 		return nil
+	case targetconfiguration.FieldConsecutiveFailures:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	case targetconfiguration.FieldRevision:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
 	}
 	return fmt.Errorf("unknown TargetConfiguration numeric field %s", name)
 }
@@ -7539,15 +20378,48 @@ func (m *TargetConfigurationMutation) ClearedFields() []string {
 	if m.FieldCleared(targetconfiguration.FieldDescription) {
 		fields = append(fields, targetconfiguration.FieldDescription)
 	}
+	if m.FieldCleared(targetconfiguration.FieldCredentialRotatedAt) {
+		fields = append(fields, targetconfiguration.FieldCredentialRotatedAt)
+	}
 	if m.FieldCleared(targetconfiguration.FieldConfig) {
 		fields = append(fields, targetconfiguration.FieldConfig)
 	}
 	if m.FieldCleared(targetconfiguration.FieldStatusMessage) {
 		fields = append(fields, targetconfiguration.FieldStatusMessage)
 	}
+	if m.FieldCleared(targetconfiguration.FieldNextProbeAt) {
+		fields = append(fields, targetconfiguration.FieldNextProbeAt)
+	}
+	if m.FieldCleared(targetconfiguration.FieldLastValidatedAt) {
+		fields = append(fields, targetconfiguration.FieldLastValidatedAt)
+	}
+	if m.FieldCleared(targetconfiguration.FieldLastValidationError) {
+		fields = append(fields, targetconfiguration.FieldLastValidationError)
+	}
+	if m.FieldCleared(targetconfiguration.FieldNextValidationAt) {
+		fields = append(fields, targetconfiguration.FieldNextValidationAt)
+	}
+	if m.FieldCleared(targetconfiguration.FieldTLSProbeAddress) {
+		fields = append(fields, targetconfiguration.FieldTLSProbeAddress)
+	}
+	if m.FieldCleared(targetconfiguration.FieldLastDriftCheckAt) {
+		fields = append(fields, targetconfiguration.FieldLastDriftCheckAt)
+	}
+	if m.FieldCleared(targetconfiguration.FieldNextDriftCheckAt) {
+		fields = append(fields, targetconfiguration.FieldNextDriftCheckAt)
+	}
 	if m.FieldCleared(targetconfiguration.FieldLastDeploymentAt) {
 		fields = append(fields, targetconfiguration.FieldLastDeploymentAt)
 	}
+	if m.FieldCleared(targetconfiguration.FieldDeployedCertificateID) {
+		fields = append(fields, targetconfiguration.FieldDeployedCertificateID)
+	}
+	if m.FieldCleared(targetconfiguration.FieldDeployedCertificateExpiresAt) {
+		fields = append(fields, targetconfiguration.FieldDeployedCertificateExpiresAt)
+	}
+	if m.FieldCleared(targetconfiguration.FieldRetryPolicy) {
+		fields = append(fields, targetconfiguration.FieldRetryPolicy)
+	}
 	return fields
 }
 
@@ -7583,15 +20455,48 @@ func (m *TargetConfigurationMutation) ClearField(name string) error {
 	case targetconfiguration.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case targetconfiguration.FieldCredentialRotatedAt:
+		m.ClearCredentialRotatedAt()
+		return nil
 	case targetconfiguration.FieldConfig:
 		m.ClearConfig()
 		return nil
 	case targetconfiguration.FieldStatusMessage:
 		m.ClearStatusMessage()
 		return nil
+	case targetconfiguration.FieldNextProbeAt:
+		m.ClearNextProbeAt()
+		return nil
+	case targetconfiguration.FieldLastValidatedAt:
+		m.ClearLastValidatedAt()
+		return nil
+	case targetconfiguration.FieldLastValidationError:
+		m.ClearLastValidationError()
+		return nil
+	case targetconfiguration.FieldNextValidationAt:
+		m.ClearNextValidationAt()
+		return nil
+	case targetconfiguration.FieldTLSProbeAddress:
+		m.ClearTLSProbeAddress()
+		return nil
+	case targetconfiguration.FieldLastDriftCheckAt:
+		m.ClearLastDriftCheckAt()
+		return nil
+	case targetconfiguration.FieldNextDriftCheckAt:
+		m.ClearNextDriftCheckAt()
+		return nil
 	case targetconfiguration.FieldLastDeploymentAt:
 		m.ClearLastDeploymentAt()
 		return nil
+	case targetconfiguration.FieldDeployedCertificateID:
+		m.ClearDeployedCertificateID()
+		return nil
+	case targetconfiguration.FieldDeployedCertificateExpiresAt:
+		m.ClearDeployedCertificateExpiresAt()
+		return nil
+	case targetconfiguration.FieldRetryPolicy:
+		m.ClearRetryPolicy()
+		return nil
 	}
 	return fmt.Errorf("unknown TargetConfiguration nullable field %s", name)
 }
@@ -7630,6 +20535,9 @@ func (m *TargetConfigurationMutation) ResetField(name string) error {
 	case targetconfiguration.FieldCredentialsEncrypted:
 		m.ResetCredentialsEncrypted()
 		return nil
+	case targetconfiguration.FieldCredentialRotatedAt:
+		m.ResetCredentialRotatedAt()
+		return nil
 	case targetconfiguration.FieldConfig:
 		m.ResetConfig()
 		return nil
@@ -7639,9 +20547,45 @@ func (m *TargetConfigurationMutation) ResetField(name string) error {
 	case targetconfiguration.FieldStatusMessage:
 		m.ResetStatusMessage()
 		return nil
+	case targetconfiguration.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case targetconfiguration.FieldNextProbeAt:
+		m.ResetNextProbeAt()
+		return nil
+	case targetconfiguration.FieldLastValidatedAt:
+		m.ResetLastValidatedAt()
+		return nil
+	case targetconfiguration.FieldLastValidationError:
+		m.ResetLastValidationError()
+		return nil
+	case targetconfiguration.FieldNextValidationAt:
+		m.ResetNextValidationAt()
+		return nil
+	case targetconfiguration.FieldTLSProbeAddress:
+		m.ResetTLSProbeAddress()
+		return nil
+	case targetconfiguration.FieldLastDriftCheckAt:
+		m.ResetLastDriftCheckAt()
+		return nil
+	case targetconfiguration.FieldNextDriftCheckAt:
+		m.ResetNextDriftCheckAt()
+		return nil
 	case targetconfiguration.FieldLastDeploymentAt:
 		m.ResetLastDeploymentAt()
 		return nil
+	case targetconfiguration.FieldDeployedCertificateID:
+		m.ResetDeployedCertificateID()
+		return nil
+	case targetconfiguration.FieldDeployedCertificateExpiresAt:
+		m.ResetDeployedCertificateExpiresAt()
+		return nil
+	case targetconfiguration.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case targetconfiguration.FieldRetryPolicy:
+		m.ResetRetryPolicy()
+		return nil
 	}
 	return fmt.Errorf("unknown TargetConfiguration field %s", name)
 }
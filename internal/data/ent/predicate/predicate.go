@@ -6,9 +6,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditChainHead is the predicate function for auditchainhead builders.
+type AuditChainHead func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// CertificateRevocation is the predicate function for certificaterevocation builders.
+type CertificateRevocation func(*sql.Selector)
+
+// ChangeRecord is the predicate function for changerecord builders.
+type ChangeRecord func(*sql.Selector)
+
+// ConfigurationRevision is the predicate function for configurationrevision builders.
+type ConfigurationRevision func(*sql.Selector)
+
+// DeployLock is the predicate function for deploylock builders.
+type DeployLock func(*sql.Selector)
+
 // DeploymentHistory is the predicate function for deploymenthistory builders.
 type DeploymentHistory func(*sql.Selector)
 
@@ -18,5 +33,23 @@ type DeploymentJob func(*sql.Selector)
 // DeploymentTarget is the predicate function for deploymenttarget builders.
 type DeploymentTarget func(*sql.Selector)
 
+// DriftEvent is the predicate function for driftevent builders.
+type DriftEvent func(*sql.Selector)
+
+// ExternalCertificate is the predicate function for externalcertificate builders.
+type ExternalCertificate func(*sql.Selector)
+
+// InventorySnapshot is the predicate function for inventorysnapshot builders.
+type InventorySnapshot func(*sql.Selector)
+
+// NotificationRule is the predicate function for notificationrule builders.
+type NotificationRule func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// RoleBinding is the predicate function for rolebinding builders.
+type RoleBinding func(*sql.Selector)
+
 // TargetConfiguration is the predicate function for targetconfiguration builders.
 type TargetConfiguration func(*sql.Selector)
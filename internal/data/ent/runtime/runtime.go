@@ -6,9 +6,19 @@ import (
 	"context"
 
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/certificaterevocation"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploylock"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenthistory"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/externalcertificate"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/inventorysnapshot"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/rolebinding"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
 
@@ -21,7 +31,7 @@ import (
 // to their package variables.
 func init() {
 	auditlogMixin := schema.AuditLog{}.Mixin()
-	auditlog.Policy = privacy.NewPolicies(auditlogMixin[2], schema.AuditLog{})
+	auditlog.Policy = privacy.NewPolicies(auditlogMixin[2], auditlogMixin[3], schema.AuditLog{})
 	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
@@ -68,6 +78,116 @@ func init() {
 	auditlogDescID := auditlogMixinFields0[0].Descriptor()
 	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	auditlog.IDValidator = auditlogDescID.Validators[0].(func(uint32) error)
+	certificaterevocationMixin := schema.CertificateRevocation{}.Mixin()
+	certificaterevocation.Policy = privacy.NewPolicies(certificaterevocationMixin[2], certificaterevocationMixin[3], schema.CertificateRevocation{})
+	certificaterevocation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := certificaterevocation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	certificaterevocationMixinFields0 := certificaterevocationMixin[0].Fields()
+	_ = certificaterevocationMixinFields0
+	certificaterevocationMixinFields2 := certificaterevocationMixin[2].Fields()
+	_ = certificaterevocationMixinFields2
+	certificaterevocationFields := schema.CertificateRevocation{}.Fields()
+	_ = certificaterevocationFields
+	// certificaterevocationDescTenantID is the schema descriptor for tenant_id field.
+	certificaterevocationDescTenantID := certificaterevocationMixinFields2[0].Descriptor()
+	// certificaterevocation.DefaultTenantID holds the default value on creation for the tenant_id field.
+	certificaterevocation.DefaultTenantID = certificaterevocationDescTenantID.Default.(uint32)
+	// certificaterevocationDescCertificateID is the schema descriptor for certificate_id field.
+	certificaterevocationDescCertificateID := certificaterevocationFields[0].Descriptor()
+	// certificaterevocation.CertificateIDValidator is a validator for the "certificate_id" field. It is called by the builders before save.
+	certificaterevocation.CertificateIDValidator = certificaterevocationDescCertificateID.Validators[0].(func(string) error)
+	// certificaterevocationDescID is the schema descriptor for id field.
+	certificaterevocationDescID := certificaterevocationMixinFields0[0].Descriptor()
+	// certificaterevocation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	certificaterevocation.IDValidator = certificaterevocationDescID.Validators[0].(func(uint32) error)
+	changerecordMixin := schema.ChangeRecord{}.Mixin()
+	changerecord.Policy = privacy.NewPolicies(changerecordMixin[2], changerecordMixin[3], schema.ChangeRecord{})
+	changerecord.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := changerecord.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	changerecordMixinFields0 := changerecordMixin[0].Fields()
+	_ = changerecordMixinFields0
+	changerecordMixinFields2 := changerecordMixin[2].Fields()
+	_ = changerecordMixinFields2
+	changerecordFields := schema.ChangeRecord{}.Fields()
+	_ = changerecordFields
+	// changerecordDescTenantID is the schema descriptor for tenant_id field.
+	changerecordDescTenantID := changerecordMixinFields2[0].Descriptor()
+	// changerecord.DefaultTenantID holds the default value on creation for the tenant_id field.
+	changerecord.DefaultTenantID = changerecordDescTenantID.Default.(uint32)
+	// changerecordDescResourceID is the schema descriptor for resource_id field.
+	changerecordDescResourceID := changerecordFields[1].Descriptor()
+	// changerecord.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	changerecord.ResourceIDValidator = changerecordDescResourceID.Validators[0].(func(string) error)
+	// changerecordDescReason is the schema descriptor for reason field.
+	changerecordDescReason := changerecordFields[7].Descriptor()
+	// changerecord.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	changerecord.ReasonValidator = changerecordDescReason.Validators[0].(func(string) error)
+	// changerecordDescID is the schema descriptor for id field.
+	changerecordDescID := changerecordMixinFields0[0].Descriptor()
+	// changerecord.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	changerecord.IDValidator = changerecordDescID.Validators[0].(func(uint32) error)
+	configurationrevisionMixin := schema.ConfigurationRevision{}.Mixin()
+	configurationrevision.Policy = privacy.NewPolicies(configurationrevisionMixin[2], configurationrevisionMixin[3], schema.ConfigurationRevision{})
+	configurationrevision.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := configurationrevision.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	configurationrevisionMixinFields0 := configurationrevisionMixin[0].Fields()
+	_ = configurationrevisionMixinFields0
+	configurationrevisionMixinFields2 := configurationrevisionMixin[2].Fields()
+	_ = configurationrevisionMixinFields2
+	configurationrevisionFields := schema.ConfigurationRevision{}.Fields()
+	_ = configurationrevisionFields
+	// configurationrevisionDescTenantID is the schema descriptor for tenant_id field.
+	configurationrevisionDescTenantID := configurationrevisionMixinFields2[0].Descriptor()
+	// configurationrevision.DefaultTenantID holds the default value on creation for the tenant_id field.
+	configurationrevision.DefaultTenantID = configurationrevisionDescTenantID.Default.(uint32)
+	// configurationrevisionDescTargetConfigurationID is the schema descriptor for target_configuration_id field.
+	configurationrevisionDescTargetConfigurationID := configurationrevisionFields[0].Descriptor()
+	// configurationrevision.TargetConfigurationIDValidator is a validator for the "target_configuration_id" field. It is called by the builders before save.
+	configurationrevision.TargetConfigurationIDValidator = configurationrevisionDescTargetConfigurationID.Validators[0].(func(string) error)
+	// configurationrevisionDescName is the schema descriptor for name field.
+	configurationrevisionDescName := configurationrevisionFields[2].Descriptor()
+	// configurationrevision.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	configurationrevision.NameValidator = configurationrevisionDescName.Validators[0].(func(string) error)
+	// configurationrevisionDescProviderType is the schema descriptor for provider_type field.
+	configurationrevisionDescProviderType := configurationrevisionFields[4].Descriptor()
+	// configurationrevision.ProviderTypeValidator is a validator for the "provider_type" field. It is called by the builders before save.
+	configurationrevision.ProviderTypeValidator = configurationrevisionDescProviderType.Validators[0].(func(string) error)
+	// configurationrevisionDescReason is the schema descriptor for reason field.
+	configurationrevisionDescReason := configurationrevisionFields[10].Descriptor()
+	// configurationrevision.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	configurationrevision.ReasonValidator = configurationrevisionDescReason.Validators[0].(func(string) error)
+	// configurationrevisionDescID is the schema descriptor for id field.
+	configurationrevisionDescID := configurationrevisionMixinFields0[0].Descriptor()
+	// configurationrevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	configurationrevision.IDValidator = configurationrevisionDescID.Validators[0].(func(uint32) error)
+	deploylockFields := schema.DeployLock{}.Fields()
+	_ = deploylockFields
+	// deploylockDescHolder is the schema descriptor for holder field.
+	deploylockDescHolder := deploylockFields[1].Descriptor()
+	// deploylock.HolderValidator is a validator for the "holder" field. It is called by the builders before save.
+	deploylock.HolderValidator = deploylockDescHolder.Validators[0].(func(string) error)
+	// deploylockDescID is the schema descriptor for id field.
+	deploylockDescID := deploylockFields[0].Descriptor()
+	// deploylock.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	deploylock.IDValidator = deploylockDescID.Validators[0].(func(string) error)
 	deploymenthistoryMixin := schema.DeploymentHistory{}.Mixin()
 	deploymenthistoryMixinFields0 := deploymenthistoryMixin[0].Fields()
 	_ = deploymenthistoryMixinFields0
@@ -86,7 +206,7 @@ func init() {
 	// deploymenthistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	deploymenthistory.IDValidator = deploymenthistoryDescID.Validators[0].(func(uint32) error)
 	deploymentjobMixin := schema.DeploymentJob{}.Mixin()
-	deploymentjob.Policy = privacy.NewPolicies(deploymentjobMixin[2], schema.DeploymentJob{})
+	deploymentjob.Policy = privacy.NewPolicies(deploymentjobMixin[2], deploymentjobMixin[3], schema.DeploymentJob{})
 	deploymentjob.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := deploymentjob.Policy.EvalMutation(ctx, m); err != nil {
@@ -107,16 +227,20 @@ func init() {
 	deploymentjobDescCertificateID := deploymentjobFields[4].Descriptor()
 	// deploymentjob.CertificateIDValidator is a validator for the "certificate_id" field. It is called by the builders before save.
 	deploymentjob.CertificateIDValidator = deploymentjobDescCertificateID.Validators[0].(func(string) error)
+	// deploymentjobDescIdempotencyKey is the schema descriptor for idempotency_key field.
+	deploymentjobDescIdempotencyKey := deploymentjobFields[8].Descriptor()
+	// deploymentjob.IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	deploymentjob.IdempotencyKeyValidator = deploymentjobDescIdempotencyKey.Validators[0].(func(string) error)
 	// deploymentjobDescProgress is the schema descriptor for progress field.
-	deploymentjobDescProgress := deploymentjobFields[8].Descriptor()
+	deploymentjobDescProgress := deploymentjobFields[12].Descriptor()
 	// deploymentjob.DefaultProgress holds the default value on creation for the progress field.
 	deploymentjob.DefaultProgress = deploymentjobDescProgress.Default.(int32)
 	// deploymentjobDescRetryCount is the schema descriptor for retry_count field.
-	deploymentjobDescRetryCount := deploymentjobFields[9].Descriptor()
+	deploymentjobDescRetryCount := deploymentjobFields[13].Descriptor()
 	// deploymentjob.DefaultRetryCount holds the default value on creation for the retry_count field.
 	deploymentjob.DefaultRetryCount = deploymentjobDescRetryCount.Default.(int32)
 	// deploymentjobDescMaxRetries is the schema descriptor for max_retries field.
-	deploymentjobDescMaxRetries := deploymentjobFields[10].Descriptor()
+	deploymentjobDescMaxRetries := deploymentjobFields[14].Descriptor()
 	// deploymentjob.DefaultMaxRetries holds the default value on creation for the max_retries field.
 	deploymentjob.DefaultMaxRetries = deploymentjobDescMaxRetries.Default.(int32)
 	// deploymentjobDescID is the schema descriptor for id field.
@@ -124,7 +248,7 @@ func init() {
 	// deploymentjob.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	deploymentjob.IDValidator = deploymentjobDescID.Validators[0].(func(string) error)
 	deploymenttargetMixin := schema.DeploymentTarget{}.Mixin()
-	deploymenttarget.Policy = privacy.NewPolicies(deploymenttargetMixin[3], schema.DeploymentTarget{})
+	deploymenttarget.Policy = privacy.NewPolicies(deploymenttargetMixin[3], deploymenttargetMixin[4], schema.DeploymentTarget{})
 	deploymenttarget.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := deploymenttarget.Policy.EvalMutation(ctx, m); err != nil {
@@ -153,8 +277,243 @@ func init() {
 	deploymenttargetDescID := deploymenttargetFields[0].Descriptor()
 	// deploymenttarget.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	deploymenttarget.IDValidator = deploymenttargetDescID.Validators[0].(func(string) error)
+	drifteventMixin := schema.DriftEvent{}.Mixin()
+	driftevent.Policy = privacy.NewPolicies(drifteventMixin[2], drifteventMixin[3], schema.DriftEvent{})
+	driftevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := driftevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	drifteventMixinFields0 := drifteventMixin[0].Fields()
+	_ = drifteventMixinFields0
+	drifteventMixinFields2 := drifteventMixin[2].Fields()
+	_ = drifteventMixinFields2
+	drifteventFields := schema.DriftEvent{}.Fields()
+	_ = drifteventFields
+	// drifteventDescTenantID is the schema descriptor for tenant_id field.
+	drifteventDescTenantID := drifteventMixinFields2[0].Descriptor()
+	// driftevent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	driftevent.DefaultTenantID = drifteventDescTenantID.Default.(uint32)
+	// drifteventDescTargetConfigurationID is the schema descriptor for target_configuration_id field.
+	drifteventDescTargetConfigurationID := drifteventFields[0].Descriptor()
+	// driftevent.TargetConfigurationIDValidator is a validator for the "target_configuration_id" field. It is called by the builders before save.
+	driftevent.TargetConfigurationIDValidator = drifteventDescTargetConfigurationID.Validators[0].(func(string) error)
+	// drifteventDescJobID is the schema descriptor for job_id field.
+	drifteventDescJobID := drifteventFields[1].Descriptor()
+	// driftevent.JobIDValidator is a validator for the "job_id" field. It is called by the builders before save.
+	driftevent.JobIDValidator = drifteventDescJobID.Validators[0].(func(string) error)
+	// drifteventDescCertificateID is the schema descriptor for certificate_id field.
+	drifteventDescCertificateID := drifteventFields[2].Descriptor()
+	// driftevent.CertificateIDValidator is a validator for the "certificate_id" field. It is called by the builders before save.
+	driftevent.CertificateIDValidator = drifteventDescCertificateID.Validators[0].(func(string) error)
+	// drifteventDescID is the schema descriptor for id field.
+	drifteventDescID := drifteventMixinFields0[0].Descriptor()
+	// driftevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	driftevent.IDValidator = drifteventDescID.Validators[0].(func(uint32) error)
+	externalcertificateMixin := schema.ExternalCertificate{}.Mixin()
+	externalcertificate.Policy = privacy.NewPolicies(externalcertificateMixin[2], externalcertificateMixin[3], schema.ExternalCertificate{})
+	externalcertificate.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := externalcertificate.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	externalcertificateMixinFields0 := externalcertificateMixin[0].Fields()
+	_ = externalcertificateMixinFields0
+	externalcertificateMixinFields2 := externalcertificateMixin[2].Fields()
+	_ = externalcertificateMixinFields2
+	externalcertificateFields := schema.ExternalCertificate{}.Fields()
+	_ = externalcertificateFields
+	// externalcertificateDescTenantID is the schema descriptor for tenant_id field.
+	externalcertificateDescTenantID := externalcertificateMixinFields2[0].Descriptor()
+	// externalcertificate.DefaultTenantID holds the default value on creation for the tenant_id field.
+	externalcertificate.DefaultTenantID = externalcertificateDescTenantID.Default.(uint32)
+	// externalcertificateDescCertificateID is the schema descriptor for certificate_id field.
+	externalcertificateDescCertificateID := externalcertificateFields[0].Descriptor()
+	// externalcertificate.CertificateIDValidator is a validator for the "certificate_id" field. It is called by the builders before save.
+	externalcertificate.CertificateIDValidator = func() func(string) error {
+		validators := externalcertificateDescCertificateID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(certificate_id string) error {
+			for _, fn := range fns {
+				if err := fn(certificate_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// externalcertificateDescSource is the schema descriptor for source field.
+	externalcertificateDescSource := externalcertificateFields[1].Descriptor()
+	// externalcertificate.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	externalcertificate.SourceValidator = externalcertificateDescSource.Validators[0].(func(string) error)
+	// externalcertificateDescID is the schema descriptor for id field.
+	externalcertificateDescID := externalcertificateMixinFields0[0].Descriptor()
+	// externalcertificate.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	externalcertificate.IDValidator = externalcertificateDescID.Validators[0].(func(uint32) error)
+	inventorysnapshotMixin := schema.InventorySnapshot{}.Mixin()
+	inventorysnapshot.Policy = privacy.NewPolicies(inventorysnapshotMixin[2], inventorysnapshotMixin[3], schema.InventorySnapshot{})
+	inventorysnapshot.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := inventorysnapshot.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	inventorysnapshotMixinFields0 := inventorysnapshotMixin[0].Fields()
+	_ = inventorysnapshotMixinFields0
+	inventorysnapshotMixinFields2 := inventorysnapshotMixin[2].Fields()
+	_ = inventorysnapshotMixinFields2
+	inventorysnapshotFields := schema.InventorySnapshot{}.Fields()
+	_ = inventorysnapshotFields
+	// inventorysnapshotDescTenantID is the schema descriptor for tenant_id field.
+	inventorysnapshotDescTenantID := inventorysnapshotMixinFields2[0].Descriptor()
+	// inventorysnapshot.DefaultTenantID holds the default value on creation for the tenant_id field.
+	inventorysnapshot.DefaultTenantID = inventorysnapshotDescTenantID.Default.(uint32)
+	// inventorysnapshotDescTargetConfigurationID is the schema descriptor for target_configuration_id field.
+	inventorysnapshotDescTargetConfigurationID := inventorysnapshotFields[0].Descriptor()
+	// inventorysnapshot.TargetConfigurationIDValidator is a validator for the "target_configuration_id" field. It is called by the builders before save.
+	inventorysnapshot.TargetConfigurationIDValidator = inventorysnapshotDescTargetConfigurationID.Validators[0].(func(string) error)
+	// inventorysnapshotDescProviderType is the schema descriptor for provider_type field.
+	inventorysnapshotDescProviderType := inventorysnapshotFields[1].Descriptor()
+	// inventorysnapshot.ProviderTypeValidator is a validator for the "provider_type" field. It is called by the builders before save.
+	inventorysnapshot.ProviderTypeValidator = inventorysnapshotDescProviderType.Validators[0].(func(string) error)
+	// inventorysnapshotDescUnmanagedCount is the schema descriptor for unmanaged_count field.
+	inventorysnapshotDescUnmanagedCount := inventorysnapshotFields[3].Descriptor()
+	// inventorysnapshot.DefaultUnmanagedCount holds the default value on creation for the unmanaged_count field.
+	inventorysnapshot.DefaultUnmanagedCount = inventorysnapshotDescUnmanagedCount.Default.(int)
+	// inventorysnapshotDescID is the schema descriptor for id field.
+	inventorysnapshotDescID := inventorysnapshotMixinFields0[0].Descriptor()
+	// inventorysnapshot.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	inventorysnapshot.IDValidator = inventorysnapshotDescID.Validators[0].(func(uint32) error)
+	notificationruleMixin := schema.NotificationRule{}.Mixin()
+	notificationrule.Policy = privacy.NewPolicies(notificationruleMixin[3], notificationruleMixin[4], schema.NotificationRule{})
+	notificationrule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := notificationrule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	notificationruleMixinFields0 := notificationruleMixin[0].Fields()
+	_ = notificationruleMixinFields0
+	notificationruleMixinFields3 := notificationruleMixin[3].Fields()
+	_ = notificationruleMixinFields3
+	notificationruleFields := schema.NotificationRule{}.Fields()
+	_ = notificationruleFields
+	// notificationruleDescTenantID is the schema descriptor for tenant_id field.
+	notificationruleDescTenantID := notificationruleMixinFields3[0].Descriptor()
+	// notificationrule.DefaultTenantID holds the default value on creation for the tenant_id field.
+	notificationrule.DefaultTenantID = notificationruleDescTenantID.Default.(uint32)
+	// notificationruleDescName is the schema descriptor for name field.
+	notificationruleDescName := notificationruleFields[0].Descriptor()
+	// notificationrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	notificationrule.NameValidator = func() func(string) error {
+		validators := notificationruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationruleDescDeploymentTargetID is the schema descriptor for deployment_target_id field.
+	notificationruleDescDeploymentTargetID := notificationruleFields[6].Descriptor()
+	// notificationrule.DefaultDeploymentTargetID holds the default value on creation for the deployment_target_id field.
+	notificationrule.DefaultDeploymentTargetID = notificationruleDescDeploymentTargetID.Default.(string)
+	// notificationruleDescEnabled is the schema descriptor for enabled field.
+	notificationruleDescEnabled := notificationruleFields[7].Descriptor()
+	// notificationrule.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationrule.DefaultEnabled = notificationruleDescEnabled.Default.(bool)
+	// notificationruleDescID is the schema descriptor for id field.
+	notificationruleDescID := notificationruleMixinFields0[0].Descriptor()
+	// notificationrule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationrule.IDValidator = notificationruleDescID.Validators[0].(func(uint32) error)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescEventID is the schema descriptor for event_id field.
+	outboxeventDescEventID := outboxeventFields[0].Descriptor()
+	// outboxevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	outboxevent.EventIDValidator = outboxeventDescEventID.Validators[0].(func(string) error)
+	// outboxeventDescChannel is the schema descriptor for channel field.
+	outboxeventDescChannel := outboxeventFields[1].Descriptor()
+	// outboxevent.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	outboxevent.ChannelValidator = outboxeventDescChannel.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[3].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int32)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	outboxevent.IDValidator = outboxeventDescID.Validators[0].(func(uint32) error)
+	rolebindingMixin := schema.RoleBinding{}.Mixin()
+	rolebinding.Policy = privacy.NewPolicies(rolebindingMixin[3], rolebindingMixin[4], schema.RoleBinding{})
+	rolebinding.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := rolebinding.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	rolebindingMixinFields0 := rolebindingMixin[0].Fields()
+	_ = rolebindingMixinFields0
+	rolebindingMixinFields3 := rolebindingMixin[3].Fields()
+	_ = rolebindingMixinFields3
+	rolebindingFields := schema.RoleBinding{}.Fields()
+	_ = rolebindingFields
+	// rolebindingDescTenantID is the schema descriptor for tenant_id field.
+	rolebindingDescTenantID := rolebindingMixinFields3[0].Descriptor()
+	// rolebinding.DefaultTenantID holds the default value on creation for the tenant_id field.
+	rolebinding.DefaultTenantID = rolebindingDescTenantID.Default.(uint32)
+	// rolebindingDescSubject is the schema descriptor for subject field.
+	rolebindingDescSubject := rolebindingFields[1].Descriptor()
+	// rolebinding.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	rolebinding.SubjectValidator = func() func(string) error {
+		validators := rolebindingDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rolebindingDescDeploymentTargetID is the schema descriptor for deployment_target_id field.
+	rolebindingDescDeploymentTargetID := rolebindingFields[3].Descriptor()
+	// rolebinding.DefaultDeploymentTargetID holds the default value on creation for the deployment_target_id field.
+	rolebinding.DefaultDeploymentTargetID = rolebindingDescDeploymentTargetID.Default.(string)
+	// rolebindingDescID is the schema descriptor for id field.
+	rolebindingDescID := rolebindingMixinFields0[0].Descriptor()
+	// rolebinding.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	rolebinding.IDValidator = rolebindingDescID.Validators[0].(func(uint32) error)
 	targetconfigurationMixin := schema.TargetConfiguration{}.Mixin()
-	targetconfiguration.Policy = privacy.NewPolicies(targetconfigurationMixin[3], schema.TargetConfiguration{})
+	targetconfiguration.Policy = privacy.NewPolicies(targetconfigurationMixin[3], targetconfigurationMixin[4], schema.TargetConfiguration{})
 	targetconfiguration.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			if err := targetconfiguration.Policy.EvalMutation(ctx, m); err != nil {
@@ -179,6 +538,18 @@ func init() {
 	targetconfigurationDescProviderType := targetconfigurationFields[3].Descriptor()
 	// targetconfiguration.ProviderTypeValidator is a validator for the "provider_type" field. It is called by the builders before save.
 	targetconfiguration.ProviderTypeValidator = targetconfigurationDescProviderType.Validators[0].(func(string) error)
+	// targetconfigurationDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	targetconfigurationDescConsecutiveFailures := targetconfigurationFields[9].Descriptor()
+	// targetconfiguration.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	targetconfiguration.DefaultConsecutiveFailures = targetconfigurationDescConsecutiveFailures.Default.(int32)
+	// targetconfigurationDescTLSProbeAddress is the schema descriptor for tls_probe_address field.
+	targetconfigurationDescTLSProbeAddress := targetconfigurationFields[14].Descriptor()
+	// targetconfiguration.TLSProbeAddressValidator is a validator for the "tls_probe_address" field. It is called by the builders before save.
+	targetconfiguration.TLSProbeAddressValidator = targetconfigurationDescTLSProbeAddress.Validators[0].(func(string) error)
+	// targetconfigurationDescRevision is the schema descriptor for revision field.
+	targetconfigurationDescRevision := targetconfigurationFields[20].Descriptor()
+	// targetconfiguration.DefaultRevision holds the default value on creation for the revision field.
+	targetconfiguration.DefaultRevision = targetconfigurationDescRevision.Default.(uint32)
 	// targetconfigurationDescID is the schema descriptor for id field.
 	targetconfigurationDescID := targetconfigurationFields[0].Descriptor()
 	// targetconfiguration.IDValidator is a validator for the "id" field. It is called by the builders before save.
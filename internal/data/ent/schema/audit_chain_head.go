@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditChainHead holds the schema definition for the AuditChainHead entity.
+// Its single row points at the latest audit log row. Writers lock it while
+// appending so that all replicas extend one hash chain.
+type AuditChainHead struct {
+	ent.Schema
+}
+
+// Annotations of the AuditChainHead.
+func (AuditChainHead) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_audit_chain_head"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the AuditChainHead.
+func (AuditChainHead) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Immutable().
+			Comment("Always 1: there is a single chain"),
+
+		field.Uint32("last_id").
+			Optional().
+			Nillable().
+			Comment("ID of the latest audit log row"),
+
+		field.String("last_hash").
+			Optional().
+			Comment("Hash of the latest audit log row"),
+	}
+}
@@ -94,6 +94,13 @@ func (AuditLog) Fields() []ent.Field {
 		field.Bytes("signature").
 			Optional().
 			Comment("ECDSA signature for integrity verification"),
+		field.Uint32("prev_id").
+			Optional().
+			Nillable().
+			Comment("ID of the row this row is chained to"),
+		field.String("prev_hash").
+			Optional().
+			Comment("log_hash of the row this row is chained to, covered by log_hash"),
 
 		// Additional metadata
 		field.JSON("metadata", map[string]string{}).
@@ -113,6 +120,7 @@ func (AuditLog) Mixin() []ent.Mixin {
 		mixin.AutoIncrementId{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
@@ -127,5 +135,6 @@ func (AuditLog) Indexes() []ent.Index {
 		index.Fields("client_id").StorageKey("deployer_auditlog_client_id"),
 		index.Fields("success").StorageKey("deployer_auditlog_success"),
 		index.Fields("peer_address").StorageKey("deployer_auditlog_peer_address"),
+		index.Fields("create_time").StorageKey("deployer_auditlog_create_time"),
 	}
 }
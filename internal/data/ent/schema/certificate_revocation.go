@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// CertificateRevocation holds the schema definition for the CertificateRevocation entity.
+// It records a revoked or deleted certificate, so that it is never picked to
+// replace another revoked certificate.
+type CertificateRevocation struct {
+	ent.Schema
+}
+
+// Annotations of the CertificateRevocation.
+func (CertificateRevocation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_certificate_revocations"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the CertificateRevocation.
+func (CertificateRevocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("certificate_id").
+			Optional().
+			MaxLen(255).
+			Comment("Revoked certificate ID"),
+
+		field.String("serial_number").
+			Optional().
+			Comment("Revoked serial number, empty when every serial of the certificate is gone"),
+	}
+}
+
+// Mixin of the CertificateRevocation.
+func (CertificateRevocation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the CertificateRevocation.
+func (CertificateRevocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "certificate_id"),
+		index.Fields("tenant_id", "serial_number"),
+	}
+}
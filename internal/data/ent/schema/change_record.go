@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// FieldChange is a single field-level difference recorded on a change record.
+// Values are rendered as strings; masked values never carry the secret itself.
+type FieldChange struct {
+	// Field is the dotted path of the changed field (e.g. "config.partition")
+	Field string `json:"field"`
+
+	// Before is the previous value, nil when the field was absent
+	Before *string `json:"before,omitempty"`
+
+	// After is the new value, nil when the field was removed
+	After *string `json:"after,omitempty"`
+
+	// Masked is true when the values were replaced to hide secrets
+	Masked bool `json:"masked,omitempty"`
+}
+
+// ChangeRecord holds the schema definition for the ChangeRecord entity.
+// This records business-level mutations of deployment targets and target
+// configurations: who changed what, with field-level before/after values.
+type ChangeRecord struct {
+	ent.Schema
+}
+
+// Annotations of the ChangeRecord.
+func (ChangeRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_change_records"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ChangeRecord.
+func (ChangeRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("resource_type").
+			Values(
+				"CHANGE_RESOURCE_TYPE_DEPLOYMENT_TARGET",
+				"CHANGE_RESOURCE_TYPE_TARGET_CONFIGURATION",
+			).
+			Comment("Type of the changed resource"),
+
+		field.String("resource_id").
+			NotEmpty().
+			Comment("ID of the changed resource"),
+
+		field.String("resource_name").
+			Optional().
+			Comment("Name of the resource at the time of the change"),
+
+		field.Enum("action").
+			Values(
+				"CHANGE_ACTION_CREATE",
+				"CHANGE_ACTION_UPDATE",
+				"CHANGE_ACTION_DELETE",
+				"CHANGE_ACTION_ADD_CONFIGURATIONS",
+				"CHANGE_ACTION_REMOVE_CONFIGURATIONS",
+			).
+			Comment("Kind of change"),
+
+		field.Uint32("actor_id").
+			Optional().
+			Nillable().
+			Comment("User ID of the actor, if known"),
+
+		field.String("actor_name").
+			Optional().
+			Comment("Username or client certificate CN of the actor"),
+
+		field.JSON("changes", []FieldChange{}).
+			Optional().
+			Comment("Field-level before/after values"),
+
+		field.String("reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Reason given for the change"),
+	}
+}
+
+// Edges of the ChangeRecord.
+func (ChangeRecord) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ChangeRecord.
+func (ChangeRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the ChangeRecord.
+func (ChangeRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_type", "resource_id").StorageKey("deployer_change_resource"),
+		index.Fields("tenant_id").StorageKey("deployer_change_tenant_id"),
+		index.Fields("actor_id").StorageKey("deployer_change_actor_id"),
+		index.Fields("create_time").StorageKey("deployer_change_create_time"),
+	}
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ConfigurationRevision holds the schema definition for the ConfigurationRevision entity.
+// This is an immutable snapshot of a target configuration (config plus encrypted
+// credentials) taken every time the configuration is created, edited or reverted.
+type ConfigurationRevision struct {
+	ent.Schema
+}
+
+// Annotations of the ConfigurationRevision.
+func (ConfigurationRevision) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_config_revisions"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ConfigurationRevision.
+func (ConfigurationRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target_configuration_id").
+			NotEmpty().
+			Immutable().
+			Comment("Target configuration this revision belongs to"),
+
+		field.Uint32("revision").
+			Immutable().
+			Comment("Revision number, starting at 1 for each configuration"),
+
+		field.String("name").
+			NotEmpty().
+			Immutable().
+			Comment("Configuration name at this revision"),
+
+		field.String("description").
+			Optional().
+			Immutable().
+			Comment("Configuration description at this revision"),
+
+		field.String("provider_type").
+			NotEmpty().
+			Immutable().
+			Comment("Provider type at this revision"),
+
+		// Not immutable: key rotation re-wraps the data key of every revision
+		field.Bytes("credentials_encrypted").
+			Sensitive().
+			Comment("Encrypted provider credentials (JSON) at this revision"),
+
+		field.JSON("config", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Provider-specific configuration at this revision"),
+
+		field.Uint32("reverted_from").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Revision number this revision was restored from"),
+
+		field.Uint32("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("User ID of the actor, if known"),
+
+		field.String("actor_name").
+			Optional().
+			Immutable().
+			Comment("Username or client certificate CN of the actor"),
+
+		field.String("reason").
+			Optional().
+			Immutable().
+			MaxLen(1024).
+			Comment("Reason given for the change"),
+	}
+}
+
+// Edges of the ConfigurationRevision.
+func (ConfigurationRevision) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ConfigurationRevision.
+func (ConfigurationRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the ConfigurationRevision.
+func (ConfigurationRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_configuration_id", "revision").Unique().StorageKey("deployer_config_revision_number"),
+		index.Fields("tenant_id").StorageKey("deployer_config_revision_tenant_id"),
+	}
+}
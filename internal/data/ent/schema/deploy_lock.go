@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// DeployLock holds the schema definition for the DeployLock entity.
+// A lock is a lease on a deployment resource (a configuration, a device host
+// or a provider concurrency slot) held by the job deploying to it. Leases
+// expire so that a crashed replica cannot block a resource forever.
+type DeployLock struct {
+	ent.Schema
+}
+
+// Annotations of the DeployLock.
+func (DeployLock) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_locks"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the DeployLock.
+func (DeployLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Lock key (e.g. configuration:<id>, host:<host>, provider:<type>/<slot>)"),
+
+		field.String("holder").
+			NotEmpty().
+			Comment("ID of the job or replica holding the lock"),
+
+		field.Time("expires_at").
+			Comment("Lease expiry; expired locks may be taken over"),
+	}
+}
+
+// Mixin of the DeployLock.
+func (DeployLock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Indexes of the DeployLock.
+func (DeployLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("holder"),
+		index.Fields("expires_at"),
+	}
+}
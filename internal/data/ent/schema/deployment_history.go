@@ -32,7 +32,7 @@ func (DeploymentHistory) Fields() []ent.Field {
 			Comment("FK to deployment job"),
 
 		field.Enum("action").
-			Values("ACTION_DEPLOY", "ACTION_VERIFY", "ACTION_ROLLBACK").
+			Values("ACTION_DEPLOY", "ACTION_VERIFY", "ACTION_ROLLBACK", "ACTION_REMOVE").
 			Comment("Action type"),
 
 		field.Enum("result").
@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 )
 
 // DeploymentJob holds the schema definition for the DeploymentJob entity.
@@ -66,6 +68,25 @@ func (DeploymentJob) Fields() []ent.Field {
 			Optional().
 			Comment("Certificate serial number"),
 
+		field.String("certificate_common_name").
+			Optional().
+			Comment("Certificate common name, kept to remove certificates LCM no longer knows (child/direct jobs)"),
+
+		field.String("certificate_lineage").
+			Optional().
+			Comment("ID of the first certificate of the renewal chain (child/direct jobs)"),
+
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			MaxLen(255).
+			Comment("Client key that coalesces repeated create requests (parent/direct jobs)"),
+
+		field.String("superseded_by_job_id").
+			Optional().
+			Nillable().
+			Comment("Job that replaced this queued job (child/direct jobs)"),
+
 		field.Enum("status").
 			Values("JOB_STATUS_UNSPECIFIED", "JOB_STATUS_PENDING", "JOB_STATUS_PROCESSING", "JOB_STATUS_COMPLETED", "JOB_STATUS_FAILED", "JOB_STATUS_CANCELLED", "JOB_STATUS_RETRYING", "JOB_STATUS_PARTIAL").
 			Default("JOB_STATUS_PENDING").
@@ -87,11 +108,20 @@ func (DeploymentJob) Fields() []ent.Field {
 			Default(3).
 			Comment("Maximum retry attempts"),
 
+		field.JSON("retry_policy", &registry.RetryPolicy{}).
+			Optional().
+			Comment("Effective retry and timeout policy the job was created with"),
+
 		field.Enum("triggered_by").
-			Values("TRIGGER_TYPE_UNSPECIFIED", "TRIGGER_TYPE_MANUAL", "TRIGGER_TYPE_EVENT", "TRIGGER_TYPE_AUTO_RENEWAL").
+			Values("TRIGGER_TYPE_UNSPECIFIED", "TRIGGER_TYPE_MANUAL", "TRIGGER_TYPE_EVENT", "TRIGGER_TYPE_AUTO_RENEWAL", "TRIGGER_TYPE_DRIFT_REMEDIATION", "TRIGGER_TYPE_REVOCATION").
 			Default("TRIGGER_TYPE_MANUAL").
 			Comment("How the job was triggered"),
 
+		field.Enum("action").
+			Values("JOB_ACTION_DEPLOY", "JOB_ACTION_REMOVE").
+			Default("JOB_ACTION_DEPLOY").
+			Comment("Whether the job deploys or removes the certificate (child/direct jobs)"),
+
 		field.JSON("result", map[string]interface{}{}).
 			Optional().
 			Comment("Deployment result details"),
@@ -110,6 +140,26 @@ func (DeploymentJob) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Next retry time"),
+
+		field.Uint32("configuration_revision_id").
+			Optional().
+			Nillable().
+			Comment("Configuration revision the job executed with (child/direct jobs)"),
+
+		field.Enum("error_category").
+			Values(
+				"ERROR_CATEGORY_UNKNOWN",
+				"ERROR_CATEGORY_AUTH",
+				"ERROR_CATEGORY_PERMISSION",
+				"ERROR_CATEGORY_NOT_FOUND",
+				"ERROR_CATEGORY_VALIDATION",
+				"ERROR_CATEGORY_RATE_LIMITED",
+				"ERROR_CATEGORY_TRANSIENT",
+				"ERROR_CATEGORY_CONFLICT",
+			).
+			Optional().
+			Nillable().
+			Comment("Category of the last failure (child/direct jobs)"),
 	}
 }
 
@@ -149,6 +199,7 @@ func (DeploymentJob) Mixin() []ent.Mixin {
 		mixin.CreateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
@@ -160,7 +211,10 @@ func (DeploymentJob) Indexes() []ent.Index {
 		index.Fields("target_configuration_id"),
 		index.Fields("parent_job_id"),
 		index.Fields("certificate_id"),
+		index.Fields("target_configuration_id", "certificate_lineage"),
+		index.Fields("tenant_id", "idempotency_key").Unique(),
 		index.Fields("status"),
+		index.Fields("error_category"),
 		index.Fields("triggered_by"),
 		index.Fields("create_time"),
 	}
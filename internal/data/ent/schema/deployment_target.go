@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 )
 
 // CertificateFilter represents filter criteria for auto-deployment
@@ -76,6 +78,15 @@ func (DeploymentTarget) Fields() []ent.Field {
 		field.JSON("certificate_filters", []CertificateFilter{}).
 			Optional().
 			Comment("Filters for auto-deployment"),
+
+		field.JSON("retry_policy", &registry.RetryPolicy{}).
+			Optional().
+			Comment("Retry and timeout overrides for jobs deploying to this target"),
+
+		field.Enum("revocation_policy").
+			Values("REVOCATION_POLICY_REDEPLOY", "REVOCATION_POLICY_REMOVE").
+			Default("REVOCATION_POLICY_REDEPLOY").
+			Comment("Whether revoked or deleted certificates are replaced or removed"),
 	}
 }
 
@@ -99,6 +110,7 @@ func (DeploymentTarget) Mixin() []ent.Mixin {
 		mixin.UpdateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
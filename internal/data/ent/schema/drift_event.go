@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// DriftEvent holds the schema definition for the DriftEvent entity.
+// This records a device that no longer serves the certificate of the last
+// successful deployment to a configuration, e.g. after a manual change or a
+// restored backup. An event stays open until a later check passes again.
+type DriftEvent struct {
+	ent.Schema
+}
+
+// Annotations of the DriftEvent.
+func (DriftEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_drift_events"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the DriftEvent.
+func (DriftEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target_configuration_id").
+			NotEmpty().
+			Comment("Configuration whose device drifted"),
+
+		field.String("job_id").
+			NotEmpty().
+			Comment("Last successful deployment the device no longer matches"),
+
+		field.String("certificate_id").
+			NotEmpty().
+			Comment("Certificate the device is expected to serve"),
+
+		field.String("certificate_serial").
+			Optional().
+			Comment("Serial of the expected certificate"),
+
+		field.Enum("source").
+			Values("DRIFT_SOURCE_VERIFY", "DRIFT_SOURCE_TLS_PROBE").
+			Comment("Check that found the drift"),
+
+		field.String("message").
+			Optional().
+			Comment("What the check found"),
+
+		field.String("observed_serial").
+			Optional().
+			Comment("Serial of the certificate the device served instead (TLS probes)"),
+
+		field.JSON("details", map[string]interface{}{}).
+			Optional().
+			Comment("Provider verification details"),
+
+		field.String("remediation_job_id").
+			Optional().
+			Nillable().
+			Comment("Job created to redeploy the expected certificate"),
+
+		field.Time("resolved_at").
+			Optional().
+			Nillable().
+			Comment("When a later check found the expected certificate again"),
+	}
+}
+
+// Edges of the DriftEvent.
+func (DriftEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the DriftEvent.
+func (DriftEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the DriftEvent.
+func (DriftEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_configuration_id").StorageKey("deployer_drift_config"),
+		index.Fields("tenant_id").StorageKey("deployer_drift_tenant_id"),
+		index.Fields("create_time").StorageKey("deployer_drift_create_time"),
+	}
+}
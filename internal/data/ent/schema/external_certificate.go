@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ExternalCertificate holds the schema definition for the ExternalCertificate entity.
+// It keeps a certificate announced by an ingested event of a source other
+// than LCM, so that jobs of it can be deployed without asking LCM. The
+// private key is never stored, only a secret reference to it.
+type ExternalCertificate struct {
+	ent.Schema
+}
+
+// Annotations of the ExternalCertificate.
+func (ExternalCertificate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_external_certificates"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ExternalCertificate.
+func (ExternalCertificate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("certificate_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Certificate ID used by the jobs of the certificate"),
+
+		field.String("source").
+			NotEmpty().
+			Comment("Ingestion source that announced the certificate"),
+
+		field.String("serial_number").
+			Optional().
+			Comment("Certificate serial number"),
+
+		field.String("common_name").
+			Optional().
+			Comment("Certificate common name"),
+
+		field.Strings("sans").
+			Optional().
+			Comment("Subject alternative names"),
+
+		field.Text("certificate_pem").
+			Comment("PEM-encoded certificate"),
+
+		field.Text("ca_certificate_pem").
+			Optional().
+			Comment("PEM-encoded issuer chain"),
+
+		field.String("private_key_ref").
+			Optional().
+			Comment("env:/file:/vault: secret reference of the PEM-encoded private key"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Certificate expiry"),
+	}
+}
+
+// Mixin of the ExternalCertificate.
+func (ExternalCertificate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the ExternalCertificate.
+func (ExternalCertificate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "certificate_id").
+			Unique().
+			StorageKey("deployer_external_certificate_id"),
+	}
+}
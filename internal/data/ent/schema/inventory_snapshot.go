@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// InventoryCertificate is a certificate object found on a device
+type InventoryCertificate struct {
+	// Name identifies the object on the device
+	Name         string     `json:"name"`
+	CommonName   string     `json:"common_name,omitempty"`
+	SANs         []string   `json:"sans,omitempty"`
+	SerialNumber string     `json:"serial_number,omitempty"`
+	Issuer       string     `json:"issuer,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	// References lists the objects on the device using the certificate
+	References []string `json:"references,omitempty"`
+	// Managed is set when the deployer deployed the certificate to the configuration
+	Managed bool `json:"managed"`
+}
+
+// InventorySnapshot holds the schema definition for the InventorySnapshot entity.
+// This records the certificates found on the device of a configuration by one
+// inventory discovery, so existing infrastructure can be onboarded.
+type InventorySnapshot struct {
+	ent.Schema
+}
+
+// Annotations of the InventorySnapshot.
+func (InventorySnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_inventory_snapshots"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the InventorySnapshot.
+func (InventorySnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target_configuration_id").
+			NotEmpty().
+			Comment("Configuration whose device was inspected"),
+
+		field.String("provider_type").
+			NotEmpty().
+			Comment("Provider that listed the certificates"),
+
+		field.JSON("certificates", []InventoryCertificate{}).
+			Optional().
+			Comment("Certificates found on the device"),
+
+		field.Int("unmanaged_count").
+			Default(0).
+			Comment("Number of certificates not deployed by the deployer"),
+
+		field.String("error").
+			Optional().
+			Comment("Why the device could not be inspected"),
+	}
+}
+
+// Edges of the InventorySnapshot.
+func (InventorySnapshot) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the InventorySnapshot.
+func (InventorySnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the InventorySnapshot.
+func (InventorySnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_configuration_id").StorageKey("deployer_inventory_config"),
+		index.Fields("tenant_id").StorageKey("deployer_inventory_tenant_id"),
+		index.Fields("create_time").StorageKey("deployer_inventory_create_time"),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// NotificationRule holds the schema definition for the NotificationRule entity.
+// A rule sends notifications about jobs reaching some statuses over a
+// channel, either for every job of the tenant or for jobs of one target.
+type NotificationRule struct {
+	ent.Schema
+}
+
+// Annotations of the NotificationRule.
+func (NotificationRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_notification_rules"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the NotificationRule.
+func (NotificationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Rule name"),
+
+		field.Enum("channel").
+			Values(
+				"NOTIFICATION_CHANNEL_EMAIL",
+				"NOTIFICATION_CHANNEL_WEBHOOK",
+				"NOTIFICATION_CHANNEL_HTTP",
+			).
+			Immutable().
+			Comment("Channel notifications are delivered over"),
+
+		field.JSON("recipients", []string{}).
+			Optional().
+			Comment("Email recipients"),
+
+		field.String("url").
+			Optional().
+			Sensitive().
+			Comment("Webhook or HTTP endpoint URL, or a secret reference to it"),
+
+		field.JSON("headers", map[string]string{}).
+			Optional().
+			Sensitive().
+			Comment("HTTP headers sent to the endpoint; values may be secret references"),
+
+		field.JSON("statuses", []string{}).
+			Optional().
+			Comment("Job statuses notified about, empty for failed and partial"),
+
+		field.String("deployment_target_id").
+			Default("").
+			Immutable().
+			Comment("Deployment target the rule is limited to, empty for tenant-wide rules"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the rule sends notifications"),
+	}
+}
+
+// Edges of the NotificationRule.
+func (NotificationRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the NotificationRule.
+func (NotificationRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the NotificationRule.
+func (NotificationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique().
+			StorageKey("deployer_notification_rule_name"),
+		index.Fields("tenant_id", "deployment_target_id").StorageKey("deployer_notification_rule_target"),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// Lifecycle events are written here first and relayed to the event bus
+// afterwards, so that events survive the bus being briefly unavailable.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Annotations of the OutboxEvent.
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_event_outbox"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("ID of the event envelope, for consumers to deduplicate"),
+
+		field.String("channel").
+			NotEmpty().
+			Immutable().
+			Comment("Channel the event is published on"),
+
+		field.Text("payload").
+			Immutable().
+			Comment("JSON event envelope"),
+
+		field.Int32("attempts").
+			Default(0).
+			Comment("Failed publish attempts"),
+
+		field.String("last_error").
+			Optional().
+			Comment("Error of the last failed attempt"),
+
+		field.Time("next_attempt_at").
+			Comment("Earliest time of the next publish attempt"),
+
+		field.Time("published_at").
+			Optional().
+			Nillable().
+			Comment("When the event was published"),
+	}
+}
+
+// Mixin of the OutboxEvent.
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at").StorageKey("deployer_outbox_published_at"),
+	}
+}
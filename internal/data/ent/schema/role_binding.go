@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RoleBinding holds the schema definition for the RoleBinding entity.
+// A binding grants a role to a caller identity (user, mTLS client or JWT role
+// claim), either tenant-wide or on a single deployment target.
+type RoleBinding struct {
+	ent.Schema
+}
+
+// Annotations of the RoleBinding.
+func (RoleBinding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "deployer_role_bindings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the RoleBinding.
+func (RoleBinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("subject_type").
+			Values(
+				"SUBJECT_TYPE_USER",
+				"SUBJECT_TYPE_CLIENT",
+				"SUBJECT_TYPE_CLAIM_ROLE",
+			).
+			Immutable().
+			Comment("Kind of caller identity the binding matches"),
+
+		field.String("subject").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("User ID, client certificate CN or JWT role claim"),
+
+		field.Enum("role").
+			Values("ROLE_VIEWER", "ROLE_OPERATOR", "ROLE_ADMIN").
+			Immutable().
+			Comment("Granted role"),
+
+		field.String("deployment_target_id").
+			Default("").
+			Immutable().
+			Comment("Deployment target the binding is limited to, empty for tenant-wide bindings"),
+	}
+}
+
+// Edges of the RoleBinding.
+func (RoleBinding) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the RoleBinding.
+func (RoleBinding) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+		TenantScope{},
+	}
+}
+
+// Indexes of the RoleBinding.
+func (RoleBinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "subject_type", "subject", "role", "deployment_target_id").
+			Unique().
+			StorageKey("deployer_role_binding_unique"),
+		index.Fields("deployment_target_id").StorageKey("deployer_role_binding_target"),
+	}
+}
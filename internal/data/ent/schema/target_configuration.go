@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -8,6 +10,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 )
 
 // TargetConfiguration holds the schema definition for the TargetConfiguration entity.
@@ -48,6 +52,10 @@ func (TargetConfiguration) Fields() []ent.Field {
 			Sensitive().
 			Comment("Encrypted provider credentials (JSON)"),
 
+		field.JSON("credential_rotated_at", map[string]time.Time{}).
+			Optional().
+			Comment("Time each credential field was last set, keyed by field name"),
+
 		field.JSON("config", map[string]interface{}{}).
 			Optional().
 			Comment("Provider-specific configuration"),
@@ -61,10 +69,65 @@ func (TargetConfiguration) Fields() []ent.Field {
 			Optional().
 			Comment("Status message (e.g., error details)"),
 
+		field.Int32("consecutive_failures").
+			Default(0).
+			Comment("Deployment attempts failed in a row; opens the circuit breaker at the threshold"),
+
+		field.Time("next_probe_at").
+			Optional().
+			Nillable().
+			Comment("When the open circuit is next probed by validating the credentials"),
+
+		field.Time("last_validated_at").
+			Optional().
+			Nillable().
+			Comment("When the credentials were last validated against the device"),
+
+		field.String("last_validation_error").
+			Optional().
+			Comment("Error of the last credential validation; empty when it passed"),
+
+		field.Time("next_validation_at").
+			Optional().
+			Nillable().
+			Comment("When the scheduled credential health check is next due"),
+
+		field.String("tls_probe_address").
+			Optional().
+			MaxLen(255).
+			Comment("host:port whose served certificate the drift detector compares with the deployed one"),
+
+		field.Time("last_drift_check_at").
+			Optional().
+			Nillable().
+			Comment("When the drift detector last checked the device"),
+
+		field.Time("next_drift_check_at").
+			Optional().
+			Nillable().
+			Comment("When the drift detector next checks the device"),
+
 		field.Time("last_deployment_at").
 			Optional().
 			Nillable().
 			Comment("Last deployment timestamp"),
+
+		field.String("deployed_certificate_id").
+			Optional().
+			Comment("Certificate of the last successful deployment"),
+
+		field.Time("deployed_certificate_expires_at").
+			Optional().
+			Nillable().
+			Comment("Expiry of the deployed certificate; unset until known"),
+
+		field.Uint32("revision").
+			Default(0).
+			Comment("Current revision number (see ConfigurationRevision)"),
+
+		field.JSON("retry_policy", &registry.RetryPolicy{}).
+			Optional().
+			Comment("Retry and timeout overrides for jobs deploying to this configuration"),
 	}
 }
 
@@ -89,6 +152,7 @@ func (TargetConfiguration) Mixin() []ent.Mixin {
 		mixin.UpdateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		TenantScope{},
 	}
 }
 
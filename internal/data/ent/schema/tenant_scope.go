@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/privacy"
+	"entgo.io/ent/schema/mixin"
+	"github.com/tx7do/go-crud/viewer"
+)
+
+// TenantScope confines updates and deletes to the viewer's tenant. It
+// complements mixin.TenantID, whose policy filters queries and stamps the
+// tenant on creates but lets updates and deletes by ID through.
+type TenantScope struct {
+	mixin.Schema
+}
+
+// Policy of the TenantScope.
+func (TenantScope) Policy() ent.Policy {
+	return tenantScopePolicy{}
+}
+
+type tenantScopePolicy struct{}
+
+// EvalQuery leaves queries to the TenantID policy.
+func (tenantScopePolicy) EvalQuery(context.Context, ent.Query) error {
+	return privacy.Skip
+}
+
+// EvalMutation adds a tenant predicate to updates and deletes of tenant viewers.
+func (tenantScopePolicy) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne) {
+		return privacy.Skip
+	}
+
+	vc, ok := viewer.FromContext(ctx)
+	if !ok {
+		return privacy.Denyf("security: missing ViewerContext in context")
+	}
+	if vc.IsPlatformContext() || vc.IsSystemContext() {
+		return privacy.Skip
+	}
+
+	scoped, ok := m.(interface {
+		WhereP(...func(*sql.Selector))
+	})
+	if !ok {
+		return privacy.Denyf("security: %s mutation cannot be scoped to a tenant", m.Type())
+	}
+	scoped.WhereP(sql.FieldEQ("tenant_id", uint32(vc.TenantID())))
+
+	return privacy.Skip
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -40,14 +41,40 @@ type TargetConfiguration struct {
 	ProviderType string `json:"provider_type,omitempty"`
 	// Encrypted provider credentials (JSON)
 	CredentialsEncrypted []byte `json:"-"`
+	// Time each credential field was last set, keyed by field name
+	CredentialRotatedAt map[string]time.Time `json:"credential_rotated_at,omitempty"`
 	// Provider-specific configuration
 	Config map[string]interface{} `json:"config,omitempty"`
 	// Configuration status
 	Status targetconfiguration.Status `json:"status,omitempty"`
 	// Status message (e.g., error details)
 	StatusMessage string `json:"status_message,omitempty"`
+	// Deployment attempts failed in a row; opens the circuit breaker at the threshold
+	ConsecutiveFailures int32 `json:"consecutive_failures,omitempty"`
+	// When the open circuit is next probed by validating the credentials
+	NextProbeAt *time.Time `json:"next_probe_at,omitempty"`
+	// When the credentials were last validated against the device
+	LastValidatedAt *time.Time `json:"last_validated_at,omitempty"`
+	// Error of the last credential validation; empty when it passed
+	LastValidationError string `json:"last_validation_error,omitempty"`
+	// When the scheduled credential health check is next due
+	NextValidationAt *time.Time `json:"next_validation_at,omitempty"`
+	// host:port whose served certificate the drift detector compares with the deployed one
+	TLSProbeAddress string `json:"tls_probe_address,omitempty"`
+	// When the drift detector last checked the device
+	LastDriftCheckAt *time.Time `json:"last_drift_check_at,omitempty"`
+	// When the drift detector next checks the device
+	NextDriftCheckAt *time.Time `json:"next_drift_check_at,omitempty"`
 	// Last deployment timestamp
 	LastDeploymentAt *time.Time `json:"last_deployment_at,omitempty"`
+	// Certificate of the last successful deployment
+	DeployedCertificateID string `json:"deployed_certificate_id,omitempty"`
+	// Expiry of the deployed certificate; unset until known
+	DeployedCertificateExpiresAt *time.Time `json:"deployed_certificate_expires_at,omitempty"`
+	// Current revision number (see ConfigurationRevision)
+	Revision uint32 `json:"revision,omitempty"`
+	// Retry and timeout overrides for jobs deploying to this configuration
+	RetryPolicy *registry.RetryPolicy `json:"retry_policy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TargetConfigurationQuery when eager-loading is set.
 	Edges        TargetConfigurationEdges `json:"edges"`
@@ -88,13 +115,13 @@ func (*TargetConfiguration) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case targetconfiguration.FieldCredentialsEncrypted, targetconfiguration.FieldConfig:
+		case targetconfiguration.FieldCredentialsEncrypted, targetconfiguration.FieldCredentialRotatedAt, targetconfiguration.FieldConfig, targetconfiguration.FieldRetryPolicy:
 			values[i] = new([]byte)
-		case targetconfiguration.FieldCreateBy, targetconfiguration.FieldUpdateBy, targetconfiguration.FieldTenantID:
+		case targetconfiguration.FieldCreateBy, targetconfiguration.FieldUpdateBy, targetconfiguration.FieldTenantID, targetconfiguration.FieldConsecutiveFailures, targetconfiguration.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case targetconfiguration.FieldID, targetconfiguration.FieldName, targetconfiguration.FieldDescription, targetconfiguration.FieldProviderType, targetconfiguration.FieldStatus, targetconfiguration.FieldStatusMessage:
+		case targetconfiguration.FieldID, targetconfiguration.FieldName, targetconfiguration.FieldDescription, targetconfiguration.FieldProviderType, targetconfiguration.FieldStatus, targetconfiguration.FieldStatusMessage, targetconfiguration.FieldLastValidationError, targetconfiguration.FieldTLSProbeAddress, targetconfiguration.FieldDeployedCertificateID:
 			values[i] = new(sql.NullString)
-		case targetconfiguration.FieldCreateTime, targetconfiguration.FieldUpdateTime, targetconfiguration.FieldDeleteTime, targetconfiguration.FieldLastDeploymentAt:
+		case targetconfiguration.FieldCreateTime, targetconfiguration.FieldUpdateTime, targetconfiguration.FieldDeleteTime, targetconfiguration.FieldNextProbeAt, targetconfiguration.FieldLastValidatedAt, targetconfiguration.FieldNextValidationAt, targetconfiguration.FieldLastDriftCheckAt, targetconfiguration.FieldNextDriftCheckAt, targetconfiguration.FieldLastDeploymentAt, targetconfiguration.FieldDeployedCertificateExpiresAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -183,6 +210,14 @@ func (_m *TargetConfiguration) assignValues(columns []string, values []any) erro
 			} else if value != nil {
 				_m.CredentialsEncrypted = *value
 			}
+		case targetconfiguration.FieldCredentialRotatedAt:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_rotated_at", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CredentialRotatedAt); err != nil {
+					return fmt.Errorf("unmarshal field credential_rotated_at: %w", err)
+				}
+			}
 		case targetconfiguration.FieldConfig:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field config", values[i])
@@ -203,6 +238,59 @@ func (_m *TargetConfiguration) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				_m.StatusMessage = value.String
 			}
+		case targetconfiguration.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int32(value.Int64)
+			}
+		case targetconfiguration.FieldNextProbeAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_probe_at", values[i])
+			} else if value.Valid {
+				_m.NextProbeAt = new(time.Time)
+				*_m.NextProbeAt = value.Time
+			}
+		case targetconfiguration.FieldLastValidatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_validated_at", values[i])
+			} else if value.Valid {
+				_m.LastValidatedAt = new(time.Time)
+				*_m.LastValidatedAt = value.Time
+			}
+		case targetconfiguration.FieldLastValidationError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_validation_error", values[i])
+			} else if value.Valid {
+				_m.LastValidationError = value.String
+			}
+		case targetconfiguration.FieldNextValidationAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_validation_at", values[i])
+			} else if value.Valid {
+				_m.NextValidationAt = new(time.Time)
+				*_m.NextValidationAt = value.Time
+			}
+		case targetconfiguration.FieldTLSProbeAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_probe_address", values[i])
+			} else if value.Valid {
+				_m.TLSProbeAddress = value.String
+			}
+		case targetconfiguration.FieldLastDriftCheckAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_drift_check_at", values[i])
+			} else if value.Valid {
+				_m.LastDriftCheckAt = new(time.Time)
+				*_m.LastDriftCheckAt = value.Time
+			}
+		case targetconfiguration.FieldNextDriftCheckAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_drift_check_at", values[i])
+			} else if value.Valid {
+				_m.NextDriftCheckAt = new(time.Time)
+				*_m.NextDriftCheckAt = value.Time
+			}
 		case targetconfiguration.FieldLastDeploymentAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_deployment_at", values[i])
@@ -210,6 +298,33 @@ func (_m *TargetConfiguration) assignValues(columns []string, values []any) erro
 				_m.LastDeploymentAt = new(time.Time)
 				*_m.LastDeploymentAt = value.Time
 			}
+		case targetconfiguration.FieldDeployedCertificateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deployed_certificate_id", values[i])
+			} else if value.Valid {
+				_m.DeployedCertificateID = value.String
+			}
+		case targetconfiguration.FieldDeployedCertificateExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deployed_certificate_expires_at", values[i])
+			} else if value.Valid {
+				_m.DeployedCertificateExpiresAt = new(time.Time)
+				*_m.DeployedCertificateExpiresAt = value.Time
+			}
+		case targetconfiguration.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = uint32(value.Int64)
+			}
+		case targetconfiguration.FieldRetryPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetryPolicy); err != nil {
+					return fmt.Errorf("unmarshal field retry_policy: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -297,6 +412,9 @@ func (_m *TargetConfiguration) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("credentials_encrypted=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("credential_rotated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CredentialRotatedAt))
+	builder.WriteString(", ")
 	builder.WriteString("config=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Config))
 	builder.WriteString(", ")
@@ -306,10 +424,58 @@ func (_m *TargetConfiguration) String() string {
 	builder.WriteString("status_message=")
 	builder.WriteString(_m.StatusMessage)
 	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.NextProbeAt; v != nil {
+		builder.WriteString("next_probe_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastValidatedAt; v != nil {
+		builder.WriteString("last_validated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_validation_error=")
+	builder.WriteString(_m.LastValidationError)
+	builder.WriteString(", ")
+	if v := _m.NextValidationAt; v != nil {
+		builder.WriteString("next_validation_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tls_probe_address=")
+	builder.WriteString(_m.TLSProbeAddress)
+	builder.WriteString(", ")
+	if v := _m.LastDriftCheckAt; v != nil {
+		builder.WriteString("last_drift_check_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.NextDriftCheckAt; v != nil {
+		builder.WriteString("next_drift_check_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.LastDeploymentAt; v != nil {
 		builder.WriteString("last_deployment_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("deployed_certificate_id=")
+	builder.WriteString(_m.DeployedCertificateID)
+	builder.WriteString(", ")
+	if v := _m.DeployedCertificateExpiresAt; v != nil {
+		builder.WriteString("deployed_certificate_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("retry_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
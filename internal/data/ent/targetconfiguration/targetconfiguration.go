@@ -35,14 +35,40 @@ const (
 	FieldProviderType = "provider_type"
 	// FieldCredentialsEncrypted holds the string denoting the credentials_encrypted field in the database.
 	FieldCredentialsEncrypted = "credentials_encrypted"
+	// FieldCredentialRotatedAt holds the string denoting the credential_rotated_at field in the database.
+	FieldCredentialRotatedAt = "credential_rotated_at"
 	// FieldConfig holds the string denoting the config field in the database.
 	FieldConfig = "config"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldStatusMessage holds the string denoting the status_message field in the database.
 	FieldStatusMessage = "status_message"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldNextProbeAt holds the string denoting the next_probe_at field in the database.
+	FieldNextProbeAt = "next_probe_at"
+	// FieldLastValidatedAt holds the string denoting the last_validated_at field in the database.
+	FieldLastValidatedAt = "last_validated_at"
+	// FieldLastValidationError holds the string denoting the last_validation_error field in the database.
+	FieldLastValidationError = "last_validation_error"
+	// FieldNextValidationAt holds the string denoting the next_validation_at field in the database.
+	FieldNextValidationAt = "next_validation_at"
+	// FieldTLSProbeAddress holds the string denoting the tls_probe_address field in the database.
+	FieldTLSProbeAddress = "tls_probe_address"
+	// FieldLastDriftCheckAt holds the string denoting the last_drift_check_at field in the database.
+	FieldLastDriftCheckAt = "last_drift_check_at"
+	// FieldNextDriftCheckAt holds the string denoting the next_drift_check_at field in the database.
+	FieldNextDriftCheckAt = "next_drift_check_at"
 	// FieldLastDeploymentAt holds the string denoting the last_deployment_at field in the database.
 	FieldLastDeploymentAt = "last_deployment_at"
+	// FieldDeployedCertificateID holds the string denoting the deployed_certificate_id field in the database.
+	FieldDeployedCertificateID = "deployed_certificate_id"
+	// FieldDeployedCertificateExpiresAt holds the string denoting the deployed_certificate_expires_at field in the database.
+	FieldDeployedCertificateExpiresAt = "deployed_certificate_expires_at"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldRetryPolicy holds the string denoting the retry_policy field in the database.
+	FieldRetryPolicy = "retry_policy"
 	// EdgeJobs holds the string denoting the jobs edge name in mutations.
 	EdgeJobs = "jobs"
 	// EdgeDeploymentTargets holds the string denoting the deployment_targets edge name in mutations.
@@ -76,10 +102,23 @@ var Columns = []string{
 	FieldDescription,
 	FieldProviderType,
 	FieldCredentialsEncrypted,
+	FieldCredentialRotatedAt,
 	FieldConfig,
 	FieldStatus,
 	FieldStatusMessage,
+	FieldConsecutiveFailures,
+	FieldNextProbeAt,
+	FieldLastValidatedAt,
+	FieldLastValidationError,
+	FieldNextValidationAt,
+	FieldTLSProbeAddress,
+	FieldLastDriftCheckAt,
+	FieldNextDriftCheckAt,
 	FieldLastDeploymentAt,
+	FieldDeployedCertificateID,
+	FieldDeployedCertificateExpiresAt,
+	FieldRevision,
+	FieldRetryPolicy,
 }
 
 var (
@@ -112,6 +151,12 @@ var (
 	NameValidator func(string) error
 	// ProviderTypeValidator is a validator for the "provider_type" field. It is called by the builders before save.
 	ProviderTypeValidator func(string) error
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int32
+	// TLSProbeAddressValidator is a validator for the "tls_probe_address" field. It is called by the builders before save.
+	TLSProbeAddressValidator func(string) error
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision uint32
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -207,11 +252,66 @@ func ByStatusMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatusMessage, opts...).ToFunc()
 }
 
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByNextProbeAt orders the results by the next_probe_at field.
+func ByNextProbeAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextProbeAt, opts...).ToFunc()
+}
+
+// ByLastValidatedAt orders the results by the last_validated_at field.
+func ByLastValidatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastValidatedAt, opts...).ToFunc()
+}
+
+// ByLastValidationError orders the results by the last_validation_error field.
+func ByLastValidationError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastValidationError, opts...).ToFunc()
+}
+
+// ByNextValidationAt orders the results by the next_validation_at field.
+func ByNextValidationAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextValidationAt, opts...).ToFunc()
+}
+
+// ByTLSProbeAddress orders the results by the tls_probe_address field.
+func ByTLSProbeAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSProbeAddress, opts...).ToFunc()
+}
+
+// ByLastDriftCheckAt orders the results by the last_drift_check_at field.
+func ByLastDriftCheckAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDriftCheckAt, opts...).ToFunc()
+}
+
+// ByNextDriftCheckAt orders the results by the next_drift_check_at field.
+func ByNextDriftCheckAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextDriftCheckAt, opts...).ToFunc()
+}
+
 // ByLastDeploymentAt orders the results by the last_deployment_at field.
 func ByLastDeploymentAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastDeploymentAt, opts...).ToFunc()
 }
 
+// ByDeployedCertificateID orders the results by the deployed_certificate_id field.
+func ByDeployedCertificateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeployedCertificateID, opts...).ToFunc()
+}
+
+// ByDeployedCertificateExpiresAt orders the results by the deployed_certificate_expires_at field.
+func ByDeployedCertificateExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeployedCertificateExpiresAt, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
 // ByJobsCount orders the results by jobs count.
 func ByJobsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
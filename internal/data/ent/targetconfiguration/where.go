@@ -121,11 +121,66 @@ func StatusMessage(v string) predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldEQ(FieldStatusMessage, v))
 }
 
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// NextProbeAt applies equality check predicate on the "next_probe_at" field. It's identical to NextProbeAtEQ.
+func NextProbeAt(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldNextProbeAt, v))
+}
+
+// LastValidatedAt applies equality check predicate on the "last_validated_at" field. It's identical to LastValidatedAtEQ.
+func LastValidatedAt(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastValidatedAt, v))
+}
+
+// LastValidationError applies equality check predicate on the "last_validation_error" field. It's identical to LastValidationErrorEQ.
+func LastValidationError(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastValidationError, v))
+}
+
+// NextValidationAt applies equality check predicate on the "next_validation_at" field. It's identical to NextValidationAtEQ.
+func NextValidationAt(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldNextValidationAt, v))
+}
+
+// TLSProbeAddress applies equality check predicate on the "tls_probe_address" field. It's identical to TLSProbeAddressEQ.
+func TLSProbeAddress(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldTLSProbeAddress, v))
+}
+
+// LastDriftCheckAt applies equality check predicate on the "last_drift_check_at" field. It's identical to LastDriftCheckAtEQ.
+func LastDriftCheckAt(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastDriftCheckAt, v))
+}
+
+// NextDriftCheckAt applies equality check predicate on the "next_drift_check_at" field. It's identical to NextDriftCheckAtEQ.
+func NextDriftCheckAt(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldNextDriftCheckAt, v))
+}
+
 // LastDeploymentAt applies equality check predicate on the "last_deployment_at" field. It's identical to LastDeploymentAtEQ.
 func LastDeploymentAt(v time.Time) predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastDeploymentAt, v))
 }
 
+// DeployedCertificateID applies equality check predicate on the "deployed_certificate_id" field. It's identical to DeployedCertificateIDEQ.
+func DeployedCertificateID(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateExpiresAt applies equality check predicate on the "deployed_certificate_expires_at" field. It's identical to DeployedCertificateExpiresAtEQ.
+func DeployedCertificateExpiresAt(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldDeployedCertificateExpiresAt, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldRevision, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldEQ(FieldCreateBy, v))
@@ -671,6 +726,16 @@ func CredentialsEncryptedLTE(v []byte) predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldLTE(FieldCredentialsEncrypted, v))
 }
 
+// CredentialRotatedAtIsNil applies the IsNil predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldCredentialRotatedAt))
+}
+
+// CredentialRotatedAtNotNil applies the NotNil predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldCredentialRotatedAt))
+}
+
 // ConfigIsNil applies the IsNil predicate on the "config" field.
 func ConfigIsNil() predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldIsNull(FieldConfig))
@@ -776,6 +841,446 @@ func StatusMessageContainsFold(v string) predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldContainsFold(FieldStatusMessage, v))
 }
 
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// NextProbeAtEQ applies the EQ predicate on the "next_probe_at" field.
+func NextProbeAtEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldNextProbeAt, v))
+}
+
+// NextProbeAtNEQ applies the NEQ predicate on the "next_probe_at" field.
+func NextProbeAtNEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldNextProbeAt, v))
+}
+
+// NextProbeAtIn applies the In predicate on the "next_probe_at" field.
+func NextProbeAtIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldNextProbeAt, vs...))
+}
+
+// NextProbeAtNotIn applies the NotIn predicate on the "next_probe_at" field.
+func NextProbeAtNotIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldNextProbeAt, vs...))
+}
+
+// NextProbeAtGT applies the GT predicate on the "next_probe_at" field.
+func NextProbeAtGT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldNextProbeAt, v))
+}
+
+// NextProbeAtGTE applies the GTE predicate on the "next_probe_at" field.
+func NextProbeAtGTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldNextProbeAt, v))
+}
+
+// NextProbeAtLT applies the LT predicate on the "next_probe_at" field.
+func NextProbeAtLT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldNextProbeAt, v))
+}
+
+// NextProbeAtLTE applies the LTE predicate on the "next_probe_at" field.
+func NextProbeAtLTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldNextProbeAt, v))
+}
+
+// NextProbeAtIsNil applies the IsNil predicate on the "next_probe_at" field.
+func NextProbeAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldNextProbeAt))
+}
+
+// NextProbeAtNotNil applies the NotNil predicate on the "next_probe_at" field.
+func NextProbeAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldNextProbeAt))
+}
+
+// LastValidatedAtEQ applies the EQ predicate on the "last_validated_at" field.
+func LastValidatedAtEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastValidatedAt, v))
+}
+
+// LastValidatedAtNEQ applies the NEQ predicate on the "last_validated_at" field.
+func LastValidatedAtNEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldLastValidatedAt, v))
+}
+
+// LastValidatedAtIn applies the In predicate on the "last_validated_at" field.
+func LastValidatedAtIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldLastValidatedAt, vs...))
+}
+
+// LastValidatedAtNotIn applies the NotIn predicate on the "last_validated_at" field.
+func LastValidatedAtNotIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldLastValidatedAt, vs...))
+}
+
+// LastValidatedAtGT applies the GT predicate on the "last_validated_at" field.
+func LastValidatedAtGT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldLastValidatedAt, v))
+}
+
+// LastValidatedAtGTE applies the GTE predicate on the "last_validated_at" field.
+func LastValidatedAtGTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldLastValidatedAt, v))
+}
+
+// LastValidatedAtLT applies the LT predicate on the "last_validated_at" field.
+func LastValidatedAtLT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldLastValidatedAt, v))
+}
+
+// LastValidatedAtLTE applies the LTE predicate on the "last_validated_at" field.
+func LastValidatedAtLTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldLastValidatedAt, v))
+}
+
+// LastValidatedAtIsNil applies the IsNil predicate on the "last_validated_at" field.
+func LastValidatedAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldLastValidatedAt))
+}
+
+// LastValidatedAtNotNil applies the NotNil predicate on the "last_validated_at" field.
+func LastValidatedAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldLastValidatedAt))
+}
+
+// LastValidationErrorEQ applies the EQ predicate on the "last_validation_error" field.
+func LastValidationErrorEQ(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastValidationError, v))
+}
+
+// LastValidationErrorNEQ applies the NEQ predicate on the "last_validation_error" field.
+func LastValidationErrorNEQ(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldLastValidationError, v))
+}
+
+// LastValidationErrorIn applies the In predicate on the "last_validation_error" field.
+func LastValidationErrorIn(vs ...string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldLastValidationError, vs...))
+}
+
+// LastValidationErrorNotIn applies the NotIn predicate on the "last_validation_error" field.
+func LastValidationErrorNotIn(vs ...string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldLastValidationError, vs...))
+}
+
+// LastValidationErrorGT applies the GT predicate on the "last_validation_error" field.
+func LastValidationErrorGT(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldLastValidationError, v))
+}
+
+// LastValidationErrorGTE applies the GTE predicate on the "last_validation_error" field.
+func LastValidationErrorGTE(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldLastValidationError, v))
+}
+
+// LastValidationErrorLT applies the LT predicate on the "last_validation_error" field.
+func LastValidationErrorLT(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldLastValidationError, v))
+}
+
+// LastValidationErrorLTE applies the LTE predicate on the "last_validation_error" field.
+func LastValidationErrorLTE(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldLastValidationError, v))
+}
+
+// LastValidationErrorContains applies the Contains predicate on the "last_validation_error" field.
+func LastValidationErrorContains(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldContains(FieldLastValidationError, v))
+}
+
+// LastValidationErrorHasPrefix applies the HasPrefix predicate on the "last_validation_error" field.
+func LastValidationErrorHasPrefix(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldHasPrefix(FieldLastValidationError, v))
+}
+
+// LastValidationErrorHasSuffix applies the HasSuffix predicate on the "last_validation_error" field.
+func LastValidationErrorHasSuffix(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldHasSuffix(FieldLastValidationError, v))
+}
+
+// LastValidationErrorIsNil applies the IsNil predicate on the "last_validation_error" field.
+func LastValidationErrorIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldLastValidationError))
+}
+
+// LastValidationErrorNotNil applies the NotNil predicate on the "last_validation_error" field.
+func LastValidationErrorNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldLastValidationError))
+}
+
+// LastValidationErrorEqualFold applies the EqualFold predicate on the "last_validation_error" field.
+func LastValidationErrorEqualFold(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEqualFold(FieldLastValidationError, v))
+}
+
+// LastValidationErrorContainsFold applies the ContainsFold predicate on the "last_validation_error" field.
+func LastValidationErrorContainsFold(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldContainsFold(FieldLastValidationError, v))
+}
+
+// NextValidationAtEQ applies the EQ predicate on the "next_validation_at" field.
+func NextValidationAtEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldNextValidationAt, v))
+}
+
+// NextValidationAtNEQ applies the NEQ predicate on the "next_validation_at" field.
+func NextValidationAtNEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldNextValidationAt, v))
+}
+
+// NextValidationAtIn applies the In predicate on the "next_validation_at" field.
+func NextValidationAtIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldNextValidationAt, vs...))
+}
+
+// NextValidationAtNotIn applies the NotIn predicate on the "next_validation_at" field.
+func NextValidationAtNotIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldNextValidationAt, vs...))
+}
+
+// NextValidationAtGT applies the GT predicate on the "next_validation_at" field.
+func NextValidationAtGT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldNextValidationAt, v))
+}
+
+// NextValidationAtGTE applies the GTE predicate on the "next_validation_at" field.
+func NextValidationAtGTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldNextValidationAt, v))
+}
+
+// NextValidationAtLT applies the LT predicate on the "next_validation_at" field.
+func NextValidationAtLT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldNextValidationAt, v))
+}
+
+// NextValidationAtLTE applies the LTE predicate on the "next_validation_at" field.
+func NextValidationAtLTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldNextValidationAt, v))
+}
+
+// NextValidationAtIsNil applies the IsNil predicate on the "next_validation_at" field.
+func NextValidationAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldNextValidationAt))
+}
+
+// NextValidationAtNotNil applies the NotNil predicate on the "next_validation_at" field.
+func NextValidationAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldNextValidationAt))
+}
+
+// TLSProbeAddressEQ applies the EQ predicate on the "tls_probe_address" field.
+func TLSProbeAddressEQ(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressNEQ applies the NEQ predicate on the "tls_probe_address" field.
+func TLSProbeAddressNEQ(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressIn applies the In predicate on the "tls_probe_address" field.
+func TLSProbeAddressIn(vs ...string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldTLSProbeAddress, vs...))
+}
+
+// TLSProbeAddressNotIn applies the NotIn predicate on the "tls_probe_address" field.
+func TLSProbeAddressNotIn(vs ...string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldTLSProbeAddress, vs...))
+}
+
+// TLSProbeAddressGT applies the GT predicate on the "tls_probe_address" field.
+func TLSProbeAddressGT(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressGTE applies the GTE predicate on the "tls_probe_address" field.
+func TLSProbeAddressGTE(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressLT applies the LT predicate on the "tls_probe_address" field.
+func TLSProbeAddressLT(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressLTE applies the LTE predicate on the "tls_probe_address" field.
+func TLSProbeAddressLTE(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressContains applies the Contains predicate on the "tls_probe_address" field.
+func TLSProbeAddressContains(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldContains(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressHasPrefix applies the HasPrefix predicate on the "tls_probe_address" field.
+func TLSProbeAddressHasPrefix(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldHasPrefix(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressHasSuffix applies the HasSuffix predicate on the "tls_probe_address" field.
+func TLSProbeAddressHasSuffix(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldHasSuffix(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressIsNil applies the IsNil predicate on the "tls_probe_address" field.
+func TLSProbeAddressIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldTLSProbeAddress))
+}
+
+// TLSProbeAddressNotNil applies the NotNil predicate on the "tls_probe_address" field.
+func TLSProbeAddressNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldTLSProbeAddress))
+}
+
+// TLSProbeAddressEqualFold applies the EqualFold predicate on the "tls_probe_address" field.
+func TLSProbeAddressEqualFold(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEqualFold(FieldTLSProbeAddress, v))
+}
+
+// TLSProbeAddressContainsFold applies the ContainsFold predicate on the "tls_probe_address" field.
+func TLSProbeAddressContainsFold(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldContainsFold(FieldTLSProbeAddress, v))
+}
+
+// LastDriftCheckAtEQ applies the EQ predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastDriftCheckAt, v))
+}
+
+// LastDriftCheckAtNEQ applies the NEQ predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtNEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldLastDriftCheckAt, v))
+}
+
+// LastDriftCheckAtIn applies the In predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldLastDriftCheckAt, vs...))
+}
+
+// LastDriftCheckAtNotIn applies the NotIn predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtNotIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldLastDriftCheckAt, vs...))
+}
+
+// LastDriftCheckAtGT applies the GT predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtGT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldLastDriftCheckAt, v))
+}
+
+// LastDriftCheckAtGTE applies the GTE predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtGTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldLastDriftCheckAt, v))
+}
+
+// LastDriftCheckAtLT applies the LT predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtLT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldLastDriftCheckAt, v))
+}
+
+// LastDriftCheckAtLTE applies the LTE predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtLTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldLastDriftCheckAt, v))
+}
+
+// LastDriftCheckAtIsNil applies the IsNil predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldLastDriftCheckAt))
+}
+
+// LastDriftCheckAtNotNil applies the NotNil predicate on the "last_drift_check_at" field.
+func LastDriftCheckAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldLastDriftCheckAt))
+}
+
+// NextDriftCheckAtEQ applies the EQ predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldNextDriftCheckAt, v))
+}
+
+// NextDriftCheckAtNEQ applies the NEQ predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtNEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldNextDriftCheckAt, v))
+}
+
+// NextDriftCheckAtIn applies the In predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldNextDriftCheckAt, vs...))
+}
+
+// NextDriftCheckAtNotIn applies the NotIn predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtNotIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldNextDriftCheckAt, vs...))
+}
+
+// NextDriftCheckAtGT applies the GT predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtGT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldNextDriftCheckAt, v))
+}
+
+// NextDriftCheckAtGTE applies the GTE predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtGTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldNextDriftCheckAt, v))
+}
+
+// NextDriftCheckAtLT applies the LT predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtLT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldNextDriftCheckAt, v))
+}
+
+// NextDriftCheckAtLTE applies the LTE predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtLTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldNextDriftCheckAt, v))
+}
+
+// NextDriftCheckAtIsNil applies the IsNil predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldNextDriftCheckAt))
+}
+
+// NextDriftCheckAtNotNil applies the NotNil predicate on the "next_drift_check_at" field.
+func NextDriftCheckAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldNextDriftCheckAt))
+}
+
 // LastDeploymentAtEQ applies the EQ predicate on the "last_deployment_at" field.
 func LastDeploymentAtEQ(v time.Time) predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldEQ(FieldLastDeploymentAt, v))
@@ -826,6 +1331,181 @@ func LastDeploymentAtNotNil() predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(sql.FieldNotNull(FieldLastDeploymentAt))
 }
 
+// DeployedCertificateIDEQ applies the EQ predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDEQ(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDNEQ applies the NEQ predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDNEQ(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDIn applies the In predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDIn(vs ...string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldDeployedCertificateID, vs...))
+}
+
+// DeployedCertificateIDNotIn applies the NotIn predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDNotIn(vs ...string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldDeployedCertificateID, vs...))
+}
+
+// DeployedCertificateIDGT applies the GT predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDGT(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDGTE applies the GTE predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDGTE(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDLT applies the LT predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDLT(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDLTE applies the LTE predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDLTE(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDContains applies the Contains predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDContains(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldContains(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDHasPrefix applies the HasPrefix predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDHasPrefix(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldHasPrefix(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDHasSuffix applies the HasSuffix predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDHasSuffix(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldHasSuffix(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDIsNil applies the IsNil predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldDeployedCertificateID))
+}
+
+// DeployedCertificateIDNotNil applies the NotNil predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldDeployedCertificateID))
+}
+
+// DeployedCertificateIDEqualFold applies the EqualFold predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDEqualFold(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEqualFold(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateIDContainsFold applies the ContainsFold predicate on the "deployed_certificate_id" field.
+func DeployedCertificateIDContainsFold(v string) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldContainsFold(FieldDeployedCertificateID, v))
+}
+
+// DeployedCertificateExpiresAtEQ applies the EQ predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldDeployedCertificateExpiresAt, v))
+}
+
+// DeployedCertificateExpiresAtNEQ applies the NEQ predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtNEQ(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldDeployedCertificateExpiresAt, v))
+}
+
+// DeployedCertificateExpiresAtIn applies the In predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldDeployedCertificateExpiresAt, vs...))
+}
+
+// DeployedCertificateExpiresAtNotIn applies the NotIn predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtNotIn(vs ...time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldDeployedCertificateExpiresAt, vs...))
+}
+
+// DeployedCertificateExpiresAtGT applies the GT predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtGT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldDeployedCertificateExpiresAt, v))
+}
+
+// DeployedCertificateExpiresAtGTE applies the GTE predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtGTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldDeployedCertificateExpiresAt, v))
+}
+
+// DeployedCertificateExpiresAtLT applies the LT predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtLT(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldDeployedCertificateExpiresAt, v))
+}
+
+// DeployedCertificateExpiresAtLTE applies the LTE predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtLTE(v time.Time) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldDeployedCertificateExpiresAt, v))
+}
+
+// DeployedCertificateExpiresAtIsNil applies the IsNil predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldDeployedCertificateExpiresAt))
+}
+
+// DeployedCertificateExpiresAtNotNil applies the NotNil predicate on the "deployed_certificate_expires_at" field.
+func DeployedCertificateExpiresAtNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldDeployedCertificateExpiresAt))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v uint32) predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldLTE(FieldRevision, v))
+}
+
+// RetryPolicyIsNil applies the IsNil predicate on the "retry_policy" field.
+func RetryPolicyIsNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldIsNull(FieldRetryPolicy))
+}
+
+// RetryPolicyNotNil applies the NotNil predicate on the "retry_policy" field.
+func RetryPolicyNotNil() predicate.TargetConfiguration {
+	return predicate.TargetConfiguration(sql.FieldNotNull(FieldRetryPolicy))
+}
+
 // HasJobs applies the HasEdge predicate on the "jobs" edge.
 func HasJobs() predicate.TargetConfiguration {
 	return predicate.TargetConfiguration(func(s *sql.Selector) {
@@ -11,6 +11,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -142,6 +143,12 @@ func (_c *TargetConfigurationCreate) SetCredentialsEncrypted(v []byte) *TargetCo
 	return _c
 }
 
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (_c *TargetConfigurationCreate) SetCredentialRotatedAt(v map[string]time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetCredentialRotatedAt(v)
+	return _c
+}
+
 // SetConfig sets the "config" field.
 func (_c *TargetConfigurationCreate) SetConfig(v map[string]interface{}) *TargetConfigurationCreate {
 	_c.mutation.SetConfig(v)
@@ -176,6 +183,118 @@ func (_c *TargetConfigurationCreate) SetNillableStatusMessage(v *string) *Target
 	return _c
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *TargetConfigurationCreate) SetConsecutiveFailures(v int32) *TargetConfigurationCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableConsecutiveFailures(v *int32) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetNextProbeAt sets the "next_probe_at" field.
+func (_c *TargetConfigurationCreate) SetNextProbeAt(v time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetNextProbeAt(v)
+	return _c
+}
+
+// SetNillableNextProbeAt sets the "next_probe_at" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableNextProbeAt(v *time.Time) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetNextProbeAt(*v)
+	}
+	return _c
+}
+
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (_c *TargetConfigurationCreate) SetLastValidatedAt(v time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetLastValidatedAt(v)
+	return _c
+}
+
+// SetNillableLastValidatedAt sets the "last_validated_at" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableLastValidatedAt(v *time.Time) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetLastValidatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastValidationError sets the "last_validation_error" field.
+func (_c *TargetConfigurationCreate) SetLastValidationError(v string) *TargetConfigurationCreate {
+	_c.mutation.SetLastValidationError(v)
+	return _c
+}
+
+// SetNillableLastValidationError sets the "last_validation_error" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableLastValidationError(v *string) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetLastValidationError(*v)
+	}
+	return _c
+}
+
+// SetNextValidationAt sets the "next_validation_at" field.
+func (_c *TargetConfigurationCreate) SetNextValidationAt(v time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetNextValidationAt(v)
+	return _c
+}
+
+// SetNillableNextValidationAt sets the "next_validation_at" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableNextValidationAt(v *time.Time) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetNextValidationAt(*v)
+	}
+	return _c
+}
+
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (_c *TargetConfigurationCreate) SetTLSProbeAddress(v string) *TargetConfigurationCreate {
+	_c.mutation.SetTLSProbeAddress(v)
+	return _c
+}
+
+// SetNillableTLSProbeAddress sets the "tls_probe_address" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableTLSProbeAddress(v *string) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetTLSProbeAddress(*v)
+	}
+	return _c
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (_c *TargetConfigurationCreate) SetLastDriftCheckAt(v time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetLastDriftCheckAt(v)
+	return _c
+}
+
+// SetNillableLastDriftCheckAt sets the "last_drift_check_at" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableLastDriftCheckAt(v *time.Time) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetLastDriftCheckAt(*v)
+	}
+	return _c
+}
+
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (_c *TargetConfigurationCreate) SetNextDriftCheckAt(v time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetNextDriftCheckAt(v)
+	return _c
+}
+
+// SetNillableNextDriftCheckAt sets the "next_drift_check_at" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableNextDriftCheckAt(v *time.Time) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetNextDriftCheckAt(*v)
+	}
+	return _c
+}
+
 // SetLastDeploymentAt sets the "last_deployment_at" field.
 func (_c *TargetConfigurationCreate) SetLastDeploymentAt(v time.Time) *TargetConfigurationCreate {
 	_c.mutation.SetLastDeploymentAt(v)
@@ -190,6 +309,54 @@ func (_c *TargetConfigurationCreate) SetNillableLastDeploymentAt(v *time.Time) *
 	return _c
 }
 
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (_c *TargetConfigurationCreate) SetDeployedCertificateID(v string) *TargetConfigurationCreate {
+	_c.mutation.SetDeployedCertificateID(v)
+	return _c
+}
+
+// SetNillableDeployedCertificateID sets the "deployed_certificate_id" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableDeployedCertificateID(v *string) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetDeployedCertificateID(*v)
+	}
+	return _c
+}
+
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (_c *TargetConfigurationCreate) SetDeployedCertificateExpiresAt(v time.Time) *TargetConfigurationCreate {
+	_c.mutation.SetDeployedCertificateExpiresAt(v)
+	return _c
+}
+
+// SetNillableDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableDeployedCertificateExpiresAt(v *time.Time) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetDeployedCertificateExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *TargetConfigurationCreate) SetRevision(v uint32) *TargetConfigurationCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *TargetConfigurationCreate) SetNillableRevision(v *uint32) *TargetConfigurationCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (_c *TargetConfigurationCreate) SetRetryPolicy(v *registry.RetryPolicy) *TargetConfigurationCreate {
+	_c.mutation.SetRetryPolicy(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TargetConfigurationCreate) SetID(v string) *TargetConfigurationCreate {
 	_c.mutation.SetID(v)
@@ -271,6 +438,14 @@ func (_c *TargetConfigurationCreate) defaults() error {
 		v := targetconfiguration.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := targetconfiguration.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := targetconfiguration.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
 	return nil
 }
 
@@ -303,6 +478,17 @@ func (_c *TargetConfigurationCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "TargetConfiguration.consecutive_failures"`)}
+	}
+	if v, ok := _c.mutation.TLSProbeAddress(); ok {
+		if err := targetconfiguration.TLSProbeAddressValidator(v); err != nil {
+			return &ValidationError{Name: "tls_probe_address", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.tls_probe_address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "TargetConfiguration.revision"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := targetconfiguration.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.id": %w`, err)}
@@ -384,6 +570,10 @@ func (_c *TargetConfigurationCreate) createSpec() (*TargetConfiguration, *sqlgra
 		_spec.SetField(targetconfiguration.FieldCredentialsEncrypted, field.TypeBytes, value)
 		_node.CredentialsEncrypted = value
 	}
+	if value, ok := _c.mutation.CredentialRotatedAt(); ok {
+		_spec.SetField(targetconfiguration.FieldCredentialRotatedAt, field.TypeJSON, value)
+		_node.CredentialRotatedAt = value
+	}
 	if value, ok := _c.mutation.Config(); ok {
 		_spec.SetField(targetconfiguration.FieldConfig, field.TypeJSON, value)
 		_node.Config = value
@@ -396,10 +586,58 @@ func (_c *TargetConfigurationCreate) createSpec() (*TargetConfiguration, *sqlgra
 		_spec.SetField(targetconfiguration.FieldStatusMessage, field.TypeString, value)
 		_node.StatusMessage = value
 	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(targetconfiguration.FieldConsecutiveFailures, field.TypeInt32, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.NextProbeAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextProbeAt, field.TypeTime, value)
+		_node.NextProbeAt = &value
+	}
+	if value, ok := _c.mutation.LastValidatedAt(); ok {
+		_spec.SetField(targetconfiguration.FieldLastValidatedAt, field.TypeTime, value)
+		_node.LastValidatedAt = &value
+	}
+	if value, ok := _c.mutation.LastValidationError(); ok {
+		_spec.SetField(targetconfiguration.FieldLastValidationError, field.TypeString, value)
+		_node.LastValidationError = value
+	}
+	if value, ok := _c.mutation.NextValidationAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextValidationAt, field.TypeTime, value)
+		_node.NextValidationAt = &value
+	}
+	if value, ok := _c.mutation.TLSProbeAddress(); ok {
+		_spec.SetField(targetconfiguration.FieldTLSProbeAddress, field.TypeString, value)
+		_node.TLSProbeAddress = value
+	}
+	if value, ok := _c.mutation.LastDriftCheckAt(); ok {
+		_spec.SetField(targetconfiguration.FieldLastDriftCheckAt, field.TypeTime, value)
+		_node.LastDriftCheckAt = &value
+	}
+	if value, ok := _c.mutation.NextDriftCheckAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextDriftCheckAt, field.TypeTime, value)
+		_node.NextDriftCheckAt = &value
+	}
 	if value, ok := _c.mutation.LastDeploymentAt(); ok {
 		_spec.SetField(targetconfiguration.FieldLastDeploymentAt, field.TypeTime, value)
 		_node.LastDeploymentAt = &value
 	}
+	if value, ok := _c.mutation.DeployedCertificateID(); ok {
+		_spec.SetField(targetconfiguration.FieldDeployedCertificateID, field.TypeString, value)
+		_node.DeployedCertificateID = value
+	}
+	if value, ok := _c.mutation.DeployedCertificateExpiresAt(); ok {
+		_spec.SetField(targetconfiguration.FieldDeployedCertificateExpiresAt, field.TypeTime, value)
+		_node.DeployedCertificateExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(targetconfiguration.FieldRevision, field.TypeUint32, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.RetryPolicy(); ok {
+		_spec.SetField(targetconfiguration.FieldRetryPolicy, field.TypeJSON, value)
+		_node.RetryPolicy = value
+	}
 	if nodes := _c.mutation.JobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -622,6 +860,24 @@ func (u *TargetConfigurationUpsert) UpdateCredentialsEncrypted() *TargetConfigur
 	return u
 }
 
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (u *TargetConfigurationUpsert) SetCredentialRotatedAt(v map[string]time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldCredentialRotatedAt, v)
+	return u
+}
+
+// UpdateCredentialRotatedAt sets the "credential_rotated_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateCredentialRotatedAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldCredentialRotatedAt)
+	return u
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (u *TargetConfigurationUpsert) ClearCredentialRotatedAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldCredentialRotatedAt)
+	return u
+}
+
 // SetConfig sets the "config" field.
 func (u *TargetConfigurationUpsert) SetConfig(v map[string]interface{}) *TargetConfigurationUpsert {
 	u.Set(targetconfiguration.FieldConfig, v)
@@ -670,6 +926,150 @@ func (u *TargetConfigurationUpsert) ClearStatusMessage() *TargetConfigurationUps
 	return u
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *TargetConfigurationUpsert) SetConsecutiveFailures(v int32) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldConsecutiveFailures, v)
+	return u
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateConsecutiveFailures() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldConsecutiveFailures)
+	return u
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *TargetConfigurationUpsert) AddConsecutiveFailures(v int32) *TargetConfigurationUpsert {
+	u.Add(targetconfiguration.FieldConsecutiveFailures, v)
+	return u
+}
+
+// SetNextProbeAt sets the "next_probe_at" field.
+func (u *TargetConfigurationUpsert) SetNextProbeAt(v time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldNextProbeAt, v)
+	return u
+}
+
+// UpdateNextProbeAt sets the "next_probe_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateNextProbeAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldNextProbeAt)
+	return u
+}
+
+// ClearNextProbeAt clears the value of the "next_probe_at" field.
+func (u *TargetConfigurationUpsert) ClearNextProbeAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldNextProbeAt)
+	return u
+}
+
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (u *TargetConfigurationUpsert) SetLastValidatedAt(v time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldLastValidatedAt, v)
+	return u
+}
+
+// UpdateLastValidatedAt sets the "last_validated_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateLastValidatedAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldLastValidatedAt)
+	return u
+}
+
+// ClearLastValidatedAt clears the value of the "last_validated_at" field.
+func (u *TargetConfigurationUpsert) ClearLastValidatedAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldLastValidatedAt)
+	return u
+}
+
+// SetLastValidationError sets the "last_validation_error" field.
+func (u *TargetConfigurationUpsert) SetLastValidationError(v string) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldLastValidationError, v)
+	return u
+}
+
+// UpdateLastValidationError sets the "last_validation_error" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateLastValidationError() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldLastValidationError)
+	return u
+}
+
+// ClearLastValidationError clears the value of the "last_validation_error" field.
+func (u *TargetConfigurationUpsert) ClearLastValidationError() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldLastValidationError)
+	return u
+}
+
+// SetNextValidationAt sets the "next_validation_at" field.
+func (u *TargetConfigurationUpsert) SetNextValidationAt(v time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldNextValidationAt, v)
+	return u
+}
+
+// UpdateNextValidationAt sets the "next_validation_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateNextValidationAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldNextValidationAt)
+	return u
+}
+
+// ClearNextValidationAt clears the value of the "next_validation_at" field.
+func (u *TargetConfigurationUpsert) ClearNextValidationAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldNextValidationAt)
+	return u
+}
+
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (u *TargetConfigurationUpsert) SetTLSProbeAddress(v string) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldTLSProbeAddress, v)
+	return u
+}
+
+// UpdateTLSProbeAddress sets the "tls_probe_address" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateTLSProbeAddress() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldTLSProbeAddress)
+	return u
+}
+
+// ClearTLSProbeAddress clears the value of the "tls_probe_address" field.
+func (u *TargetConfigurationUpsert) ClearTLSProbeAddress() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldTLSProbeAddress)
+	return u
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (u *TargetConfigurationUpsert) SetLastDriftCheckAt(v time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldLastDriftCheckAt, v)
+	return u
+}
+
+// UpdateLastDriftCheckAt sets the "last_drift_check_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateLastDriftCheckAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldLastDriftCheckAt)
+	return u
+}
+
+// ClearLastDriftCheckAt clears the value of the "last_drift_check_at" field.
+func (u *TargetConfigurationUpsert) ClearLastDriftCheckAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldLastDriftCheckAt)
+	return u
+}
+
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (u *TargetConfigurationUpsert) SetNextDriftCheckAt(v time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldNextDriftCheckAt, v)
+	return u
+}
+
+// UpdateNextDriftCheckAt sets the "next_drift_check_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateNextDriftCheckAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldNextDriftCheckAt)
+	return u
+}
+
+// ClearNextDriftCheckAt clears the value of the "next_drift_check_at" field.
+func (u *TargetConfigurationUpsert) ClearNextDriftCheckAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldNextDriftCheckAt)
+	return u
+}
+
 // SetLastDeploymentAt sets the "last_deployment_at" field.
 func (u *TargetConfigurationUpsert) SetLastDeploymentAt(v time.Time) *TargetConfigurationUpsert {
 	u.Set(targetconfiguration.FieldLastDeploymentAt, v)
@@ -688,6 +1088,78 @@ func (u *TargetConfigurationUpsert) ClearLastDeploymentAt() *TargetConfiguration
 	return u
 }
 
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (u *TargetConfigurationUpsert) SetDeployedCertificateID(v string) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldDeployedCertificateID, v)
+	return u
+}
+
+// UpdateDeployedCertificateID sets the "deployed_certificate_id" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateDeployedCertificateID() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldDeployedCertificateID)
+	return u
+}
+
+// ClearDeployedCertificateID clears the value of the "deployed_certificate_id" field.
+func (u *TargetConfigurationUpsert) ClearDeployedCertificateID() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldDeployedCertificateID)
+	return u
+}
+
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (u *TargetConfigurationUpsert) SetDeployedCertificateExpiresAt(v time.Time) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldDeployedCertificateExpiresAt, v)
+	return u
+}
+
+// UpdateDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateDeployedCertificateExpiresAt() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldDeployedCertificateExpiresAt)
+	return u
+}
+
+// ClearDeployedCertificateExpiresAt clears the value of the "deployed_certificate_expires_at" field.
+func (u *TargetConfigurationUpsert) ClearDeployedCertificateExpiresAt() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldDeployedCertificateExpiresAt)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *TargetConfigurationUpsert) SetRevision(v uint32) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateRevision() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *TargetConfigurationUpsert) AddRevision(v uint32) *TargetConfigurationUpsert {
+	u.Add(targetconfiguration.FieldRevision, v)
+	return u
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *TargetConfigurationUpsert) SetRetryPolicy(v *registry.RetryPolicy) *TargetConfigurationUpsert {
+	u.Set(targetconfiguration.FieldRetryPolicy, v)
+	return u
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *TargetConfigurationUpsert) UpdateRetryPolicy() *TargetConfigurationUpsert {
+	u.SetExcluded(targetconfiguration.FieldRetryPolicy)
+	return u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *TargetConfigurationUpsert) ClearRetryPolicy() *TargetConfigurationUpsert {
+	u.SetNull(targetconfiguration.FieldRetryPolicy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -903,6 +1375,27 @@ func (u *TargetConfigurationUpsertOne) UpdateCredentialsEncrypted() *TargetConfi
 	})
 }
 
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (u *TargetConfigurationUpsertOne) SetCredentialRotatedAt(v map[string]time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetCredentialRotatedAt(v)
+	})
+}
+
+// UpdateCredentialRotatedAt sets the "credential_rotated_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateCredentialRotatedAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateCredentialRotatedAt()
+	})
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (u *TargetConfigurationUpsertOne) ClearCredentialRotatedAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearCredentialRotatedAt()
+	})
+}
+
 // SetConfig sets the "config" field.
 func (u *TargetConfigurationUpsertOne) SetConfig(v map[string]interface{}) *TargetConfigurationUpsertOne {
 	return u.Update(func(s *TargetConfigurationUpsert) {
@@ -959,32 +1452,284 @@ func (u *TargetConfigurationUpsertOne) ClearStatusMessage() *TargetConfiguration
 	})
 }
 
-// SetLastDeploymentAt sets the "last_deployment_at" field.
-func (u *TargetConfigurationUpsertOne) SetLastDeploymentAt(v time.Time) *TargetConfigurationUpsertOne {
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *TargetConfigurationUpsertOne) SetConsecutiveFailures(v int32) *TargetConfigurationUpsertOne {
 	return u.Update(func(s *TargetConfigurationUpsert) {
-		s.SetLastDeploymentAt(v)
+		s.SetConsecutiveFailures(v)
 	})
 }
 
-// UpdateLastDeploymentAt sets the "last_deployment_at" field to the value that was provided on create.
-func (u *TargetConfigurationUpsertOne) UpdateLastDeploymentAt() *TargetConfigurationUpsertOne {
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *TargetConfigurationUpsertOne) AddConsecutiveFailures(v int32) *TargetConfigurationUpsertOne {
 	return u.Update(func(s *TargetConfigurationUpsert) {
-		s.UpdateLastDeploymentAt()
+		s.AddConsecutiveFailures(v)
 	})
 }
 
-// ClearLastDeploymentAt clears the value of the "last_deployment_at" field.
-func (u *TargetConfigurationUpsertOne) ClearLastDeploymentAt() *TargetConfigurationUpsertOne {
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateConsecutiveFailures() *TargetConfigurationUpsertOne {
 	return u.Update(func(s *TargetConfigurationUpsert) {
-		s.ClearLastDeploymentAt()
+		s.UpdateConsecutiveFailures()
 	})
 }
 
-// Exec executes the query.
-func (u *TargetConfigurationUpsertOne) Exec(ctx context.Context) error {
-	if len(u.create.conflict) == 0 {
-		return errors.New("ent: missing options for TargetConfigurationCreate.OnConflict")
-	}
+// SetNextProbeAt sets the "next_probe_at" field.
+func (u *TargetConfigurationUpsertOne) SetNextProbeAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetNextProbeAt(v)
+	})
+}
+
+// UpdateNextProbeAt sets the "next_probe_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateNextProbeAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateNextProbeAt()
+	})
+}
+
+// ClearNextProbeAt clears the value of the "next_probe_at" field.
+func (u *TargetConfigurationUpsertOne) ClearNextProbeAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearNextProbeAt()
+	})
+}
+
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (u *TargetConfigurationUpsertOne) SetLastValidatedAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastValidatedAt(v)
+	})
+}
+
+// UpdateLastValidatedAt sets the "last_validated_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateLastValidatedAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastValidatedAt()
+	})
+}
+
+// ClearLastValidatedAt clears the value of the "last_validated_at" field.
+func (u *TargetConfigurationUpsertOne) ClearLastValidatedAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastValidatedAt()
+	})
+}
+
+// SetLastValidationError sets the "last_validation_error" field.
+func (u *TargetConfigurationUpsertOne) SetLastValidationError(v string) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastValidationError(v)
+	})
+}
+
+// UpdateLastValidationError sets the "last_validation_error" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateLastValidationError() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastValidationError()
+	})
+}
+
+// ClearLastValidationError clears the value of the "last_validation_error" field.
+func (u *TargetConfigurationUpsertOne) ClearLastValidationError() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastValidationError()
+	})
+}
+
+// SetNextValidationAt sets the "next_validation_at" field.
+func (u *TargetConfigurationUpsertOne) SetNextValidationAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetNextValidationAt(v)
+	})
+}
+
+// UpdateNextValidationAt sets the "next_validation_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateNextValidationAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateNextValidationAt()
+	})
+}
+
+// ClearNextValidationAt clears the value of the "next_validation_at" field.
+func (u *TargetConfigurationUpsertOne) ClearNextValidationAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearNextValidationAt()
+	})
+}
+
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (u *TargetConfigurationUpsertOne) SetTLSProbeAddress(v string) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetTLSProbeAddress(v)
+	})
+}
+
+// UpdateTLSProbeAddress sets the "tls_probe_address" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateTLSProbeAddress() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateTLSProbeAddress()
+	})
+}
+
+// ClearTLSProbeAddress clears the value of the "tls_probe_address" field.
+func (u *TargetConfigurationUpsertOne) ClearTLSProbeAddress() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearTLSProbeAddress()
+	})
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (u *TargetConfigurationUpsertOne) SetLastDriftCheckAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastDriftCheckAt(v)
+	})
+}
+
+// UpdateLastDriftCheckAt sets the "last_drift_check_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateLastDriftCheckAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastDriftCheckAt()
+	})
+}
+
+// ClearLastDriftCheckAt clears the value of the "last_drift_check_at" field.
+func (u *TargetConfigurationUpsertOne) ClearLastDriftCheckAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastDriftCheckAt()
+	})
+}
+
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (u *TargetConfigurationUpsertOne) SetNextDriftCheckAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetNextDriftCheckAt(v)
+	})
+}
+
+// UpdateNextDriftCheckAt sets the "next_drift_check_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateNextDriftCheckAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateNextDriftCheckAt()
+	})
+}
+
+// ClearNextDriftCheckAt clears the value of the "next_drift_check_at" field.
+func (u *TargetConfigurationUpsertOne) ClearNextDriftCheckAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearNextDriftCheckAt()
+	})
+}
+
+// SetLastDeploymentAt sets the "last_deployment_at" field.
+func (u *TargetConfigurationUpsertOne) SetLastDeploymentAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastDeploymentAt(v)
+	})
+}
+
+// UpdateLastDeploymentAt sets the "last_deployment_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateLastDeploymentAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastDeploymentAt()
+	})
+}
+
+// ClearLastDeploymentAt clears the value of the "last_deployment_at" field.
+func (u *TargetConfigurationUpsertOne) ClearLastDeploymentAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastDeploymentAt()
+	})
+}
+
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (u *TargetConfigurationUpsertOne) SetDeployedCertificateID(v string) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetDeployedCertificateID(v)
+	})
+}
+
+// UpdateDeployedCertificateID sets the "deployed_certificate_id" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateDeployedCertificateID() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateDeployedCertificateID()
+	})
+}
+
+// ClearDeployedCertificateID clears the value of the "deployed_certificate_id" field.
+func (u *TargetConfigurationUpsertOne) ClearDeployedCertificateID() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearDeployedCertificateID()
+	})
+}
+
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (u *TargetConfigurationUpsertOne) SetDeployedCertificateExpiresAt(v time.Time) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetDeployedCertificateExpiresAt(v)
+	})
+}
+
+// UpdateDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateDeployedCertificateExpiresAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateDeployedCertificateExpiresAt()
+	})
+}
+
+// ClearDeployedCertificateExpiresAt clears the value of the "deployed_certificate_expires_at" field.
+func (u *TargetConfigurationUpsertOne) ClearDeployedCertificateExpiresAt() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearDeployedCertificateExpiresAt()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *TargetConfigurationUpsertOne) SetRevision(v uint32) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *TargetConfigurationUpsertOne) AddRevision(v uint32) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateRevision() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *TargetConfigurationUpsertOne) SetRetryPolicy(v *registry.RetryPolicy) *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertOne) UpdateRetryPolicy() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *TargetConfigurationUpsertOne) ClearRetryPolicy() *TargetConfigurationUpsertOne {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetConfigurationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetConfigurationCreate.OnConflict")
+	}
 	return u.create.Exec(ctx)
 }
 
@@ -1362,6 +2107,27 @@ func (u *TargetConfigurationUpsertBulk) UpdateCredentialsEncrypted() *TargetConf
 	})
 }
 
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (u *TargetConfigurationUpsertBulk) SetCredentialRotatedAt(v map[string]time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetCredentialRotatedAt(v)
+	})
+}
+
+// UpdateCredentialRotatedAt sets the "credential_rotated_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateCredentialRotatedAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateCredentialRotatedAt()
+	})
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearCredentialRotatedAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearCredentialRotatedAt()
+	})
+}
+
 // SetConfig sets the "config" field.
 func (u *TargetConfigurationUpsertBulk) SetConfig(v map[string]interface{}) *TargetConfigurationUpsertBulk {
 	return u.Update(func(s *TargetConfigurationUpsert) {
@@ -1418,6 +2184,174 @@ func (u *TargetConfigurationUpsertBulk) ClearStatusMessage() *TargetConfiguratio
 	})
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *TargetConfigurationUpsertBulk) SetConsecutiveFailures(v int32) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *TargetConfigurationUpsertBulk) AddConsecutiveFailures(v int32) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateConsecutiveFailures() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetNextProbeAt sets the "next_probe_at" field.
+func (u *TargetConfigurationUpsertBulk) SetNextProbeAt(v time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetNextProbeAt(v)
+	})
+}
+
+// UpdateNextProbeAt sets the "next_probe_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateNextProbeAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateNextProbeAt()
+	})
+}
+
+// ClearNextProbeAt clears the value of the "next_probe_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearNextProbeAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearNextProbeAt()
+	})
+}
+
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (u *TargetConfigurationUpsertBulk) SetLastValidatedAt(v time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastValidatedAt(v)
+	})
+}
+
+// UpdateLastValidatedAt sets the "last_validated_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateLastValidatedAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastValidatedAt()
+	})
+}
+
+// ClearLastValidatedAt clears the value of the "last_validated_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearLastValidatedAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastValidatedAt()
+	})
+}
+
+// SetLastValidationError sets the "last_validation_error" field.
+func (u *TargetConfigurationUpsertBulk) SetLastValidationError(v string) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastValidationError(v)
+	})
+}
+
+// UpdateLastValidationError sets the "last_validation_error" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateLastValidationError() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastValidationError()
+	})
+}
+
+// ClearLastValidationError clears the value of the "last_validation_error" field.
+func (u *TargetConfigurationUpsertBulk) ClearLastValidationError() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastValidationError()
+	})
+}
+
+// SetNextValidationAt sets the "next_validation_at" field.
+func (u *TargetConfigurationUpsertBulk) SetNextValidationAt(v time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetNextValidationAt(v)
+	})
+}
+
+// UpdateNextValidationAt sets the "next_validation_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateNextValidationAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateNextValidationAt()
+	})
+}
+
+// ClearNextValidationAt clears the value of the "next_validation_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearNextValidationAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearNextValidationAt()
+	})
+}
+
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (u *TargetConfigurationUpsertBulk) SetTLSProbeAddress(v string) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetTLSProbeAddress(v)
+	})
+}
+
+// UpdateTLSProbeAddress sets the "tls_probe_address" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateTLSProbeAddress() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateTLSProbeAddress()
+	})
+}
+
+// ClearTLSProbeAddress clears the value of the "tls_probe_address" field.
+func (u *TargetConfigurationUpsertBulk) ClearTLSProbeAddress() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearTLSProbeAddress()
+	})
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (u *TargetConfigurationUpsertBulk) SetLastDriftCheckAt(v time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetLastDriftCheckAt(v)
+	})
+}
+
+// UpdateLastDriftCheckAt sets the "last_drift_check_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateLastDriftCheckAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateLastDriftCheckAt()
+	})
+}
+
+// ClearLastDriftCheckAt clears the value of the "last_drift_check_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearLastDriftCheckAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearLastDriftCheckAt()
+	})
+}
+
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (u *TargetConfigurationUpsertBulk) SetNextDriftCheckAt(v time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetNextDriftCheckAt(v)
+	})
+}
+
+// UpdateNextDriftCheckAt sets the "next_drift_check_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateNextDriftCheckAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateNextDriftCheckAt()
+	})
+}
+
+// ClearNextDriftCheckAt clears the value of the "next_drift_check_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearNextDriftCheckAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearNextDriftCheckAt()
+	})
+}
+
 // SetLastDeploymentAt sets the "last_deployment_at" field.
 func (u *TargetConfigurationUpsertBulk) SetLastDeploymentAt(v time.Time) *TargetConfigurationUpsertBulk {
 	return u.Update(func(s *TargetConfigurationUpsert) {
@@ -1439,6 +2373,90 @@ func (u *TargetConfigurationUpsertBulk) ClearLastDeploymentAt() *TargetConfigura
 	})
 }
 
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (u *TargetConfigurationUpsertBulk) SetDeployedCertificateID(v string) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetDeployedCertificateID(v)
+	})
+}
+
+// UpdateDeployedCertificateID sets the "deployed_certificate_id" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateDeployedCertificateID() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateDeployedCertificateID()
+	})
+}
+
+// ClearDeployedCertificateID clears the value of the "deployed_certificate_id" field.
+func (u *TargetConfigurationUpsertBulk) ClearDeployedCertificateID() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearDeployedCertificateID()
+	})
+}
+
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (u *TargetConfigurationUpsertBulk) SetDeployedCertificateExpiresAt(v time.Time) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetDeployedCertificateExpiresAt(v)
+	})
+}
+
+// UpdateDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateDeployedCertificateExpiresAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateDeployedCertificateExpiresAt()
+	})
+}
+
+// ClearDeployedCertificateExpiresAt clears the value of the "deployed_certificate_expires_at" field.
+func (u *TargetConfigurationUpsertBulk) ClearDeployedCertificateExpiresAt() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearDeployedCertificateExpiresAt()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *TargetConfigurationUpsertBulk) SetRevision(v uint32) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *TargetConfigurationUpsertBulk) AddRevision(v uint32) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateRevision() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *TargetConfigurationUpsertBulk) SetRetryPolicy(v *registry.RetryPolicy) *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *TargetConfigurationUpsertBulk) UpdateRetryPolicy() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *TargetConfigurationUpsertBulk) ClearRetryPolicy() *TargetConfigurationUpsertBulk {
+	return u.Update(func(s *TargetConfigurationUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *TargetConfigurationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -180,6 +181,18 @@ func (_u *TargetConfigurationUpdate) SetCredentialsEncrypted(v []byte) *TargetCo
 	return _u
 }
 
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (_u *TargetConfigurationUpdate) SetCredentialRotatedAt(v map[string]time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetCredentialRotatedAt(v)
+	return _u
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (_u *TargetConfigurationUpdate) ClearCredentialRotatedAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearCredentialRotatedAt()
+	return _u
+}
+
 // SetConfig sets the "config" field.
 func (_u *TargetConfigurationUpdate) SetConfig(v map[string]interface{}) *TargetConfigurationUpdate {
 	_u.mutation.SetConfig(v)
@@ -226,6 +239,167 @@ func (_u *TargetConfigurationUpdate) ClearStatusMessage() *TargetConfigurationUp
 	return _u
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *TargetConfigurationUpdate) SetConsecutiveFailures(v int32) *TargetConfigurationUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableConsecutiveFailures(v *int32) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *TargetConfigurationUpdate) AddConsecutiveFailures(v int32) *TargetConfigurationUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetNextProbeAt sets the "next_probe_at" field.
+func (_u *TargetConfigurationUpdate) SetNextProbeAt(v time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetNextProbeAt(v)
+	return _u
+}
+
+// SetNillableNextProbeAt sets the "next_probe_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableNextProbeAt(v *time.Time) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetNextProbeAt(*v)
+	}
+	return _u
+}
+
+// ClearNextProbeAt clears the value of the "next_probe_at" field.
+func (_u *TargetConfigurationUpdate) ClearNextProbeAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearNextProbeAt()
+	return _u
+}
+
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (_u *TargetConfigurationUpdate) SetLastValidatedAt(v time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetLastValidatedAt(v)
+	return _u
+}
+
+// SetNillableLastValidatedAt sets the "last_validated_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableLastValidatedAt(v *time.Time) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetLastValidatedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastValidatedAt clears the value of the "last_validated_at" field.
+func (_u *TargetConfigurationUpdate) ClearLastValidatedAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearLastValidatedAt()
+	return _u
+}
+
+// SetLastValidationError sets the "last_validation_error" field.
+func (_u *TargetConfigurationUpdate) SetLastValidationError(v string) *TargetConfigurationUpdate {
+	_u.mutation.SetLastValidationError(v)
+	return _u
+}
+
+// SetNillableLastValidationError sets the "last_validation_error" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableLastValidationError(v *string) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetLastValidationError(*v)
+	}
+	return _u
+}
+
+// ClearLastValidationError clears the value of the "last_validation_error" field.
+func (_u *TargetConfigurationUpdate) ClearLastValidationError() *TargetConfigurationUpdate {
+	_u.mutation.ClearLastValidationError()
+	return _u
+}
+
+// SetNextValidationAt sets the "next_validation_at" field.
+func (_u *TargetConfigurationUpdate) SetNextValidationAt(v time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetNextValidationAt(v)
+	return _u
+}
+
+// SetNillableNextValidationAt sets the "next_validation_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableNextValidationAt(v *time.Time) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetNextValidationAt(*v)
+	}
+	return _u
+}
+
+// ClearNextValidationAt clears the value of the "next_validation_at" field.
+func (_u *TargetConfigurationUpdate) ClearNextValidationAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearNextValidationAt()
+	return _u
+}
+
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (_u *TargetConfigurationUpdate) SetTLSProbeAddress(v string) *TargetConfigurationUpdate {
+	_u.mutation.SetTLSProbeAddress(v)
+	return _u
+}
+
+// SetNillableTLSProbeAddress sets the "tls_probe_address" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableTLSProbeAddress(v *string) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetTLSProbeAddress(*v)
+	}
+	return _u
+}
+
+// ClearTLSProbeAddress clears the value of the "tls_probe_address" field.
+func (_u *TargetConfigurationUpdate) ClearTLSProbeAddress() *TargetConfigurationUpdate {
+	_u.mutation.ClearTLSProbeAddress()
+	return _u
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (_u *TargetConfigurationUpdate) SetLastDriftCheckAt(v time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetLastDriftCheckAt(v)
+	return _u
+}
+
+// SetNillableLastDriftCheckAt sets the "last_drift_check_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableLastDriftCheckAt(v *time.Time) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetLastDriftCheckAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDriftCheckAt clears the value of the "last_drift_check_at" field.
+func (_u *TargetConfigurationUpdate) ClearLastDriftCheckAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearLastDriftCheckAt()
+	return _u
+}
+
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (_u *TargetConfigurationUpdate) SetNextDriftCheckAt(v time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetNextDriftCheckAt(v)
+	return _u
+}
+
+// SetNillableNextDriftCheckAt sets the "next_drift_check_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableNextDriftCheckAt(v *time.Time) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetNextDriftCheckAt(*v)
+	}
+	return _u
+}
+
+// ClearNextDriftCheckAt clears the value of the "next_drift_check_at" field.
+func (_u *TargetConfigurationUpdate) ClearNextDriftCheckAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearNextDriftCheckAt()
+	return _u
+}
+
 // SetLastDeploymentAt sets the "last_deployment_at" field.
 func (_u *TargetConfigurationUpdate) SetLastDeploymentAt(v time.Time) *TargetConfigurationUpdate {
 	_u.mutation.SetLastDeploymentAt(v)
@@ -246,6 +420,79 @@ func (_u *TargetConfigurationUpdate) ClearLastDeploymentAt() *TargetConfiguratio
 	return _u
 }
 
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (_u *TargetConfigurationUpdate) SetDeployedCertificateID(v string) *TargetConfigurationUpdate {
+	_u.mutation.SetDeployedCertificateID(v)
+	return _u
+}
+
+// SetNillableDeployedCertificateID sets the "deployed_certificate_id" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableDeployedCertificateID(v *string) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetDeployedCertificateID(*v)
+	}
+	return _u
+}
+
+// ClearDeployedCertificateID clears the value of the "deployed_certificate_id" field.
+func (_u *TargetConfigurationUpdate) ClearDeployedCertificateID() *TargetConfigurationUpdate {
+	_u.mutation.ClearDeployedCertificateID()
+	return _u
+}
+
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (_u *TargetConfigurationUpdate) SetDeployedCertificateExpiresAt(v time.Time) *TargetConfigurationUpdate {
+	_u.mutation.SetDeployedCertificateExpiresAt(v)
+	return _u
+}
+
+// SetNillableDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableDeployedCertificateExpiresAt(v *time.Time) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetDeployedCertificateExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearDeployedCertificateExpiresAt clears the value of the "deployed_certificate_expires_at" field.
+func (_u *TargetConfigurationUpdate) ClearDeployedCertificateExpiresAt() *TargetConfigurationUpdate {
+	_u.mutation.ClearDeployedCertificateExpiresAt()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *TargetConfigurationUpdate) SetRevision(v uint32) *TargetConfigurationUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *TargetConfigurationUpdate) SetNillableRevision(v *uint32) *TargetConfigurationUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *TargetConfigurationUpdate) AddRevision(v int32) *TargetConfigurationUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *TargetConfigurationUpdate) SetRetryPolicy(v *registry.RetryPolicy) *TargetConfigurationUpdate {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *TargetConfigurationUpdate) ClearRetryPolicy() *TargetConfigurationUpdate {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
 // AddJobIDs adds the "jobs" edge to the DeploymentJob entity by IDs.
 func (_u *TargetConfigurationUpdate) AddJobIDs(ids ...string) *TargetConfigurationUpdate {
 	_u.mutation.AddJobIDs(ids...)
@@ -367,6 +614,11 @@ func (_u *TargetConfigurationUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TLSProbeAddress(); ok {
+		if err := targetconfiguration.TLSProbeAddressValidator(v); err != nil {
+			return &ValidationError{Name: "tls_probe_address", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.tls_probe_address": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -439,6 +691,12 @@ func (_u *TargetConfigurationUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.CredentialsEncrypted(); ok {
 		_spec.SetField(targetconfiguration.FieldCredentialsEncrypted, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.CredentialRotatedAt(); ok {
+		_spec.SetField(targetconfiguration.FieldCredentialRotatedAt, field.TypeJSON, value)
+	}
+	if _u.mutation.CredentialRotatedAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldCredentialRotatedAt, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Config(); ok {
 		_spec.SetField(targetconfiguration.FieldConfig, field.TypeJSON, value)
 	}
@@ -454,12 +712,84 @@ func (_u *TargetConfigurationUpdate) sqlSave(ctx context.Context) (_node int, er
 	if _u.mutation.StatusMessageCleared() {
 		_spec.ClearField(targetconfiguration.FieldStatusMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(targetconfiguration.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(targetconfiguration.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.NextProbeAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextProbeAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextProbeAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldNextProbeAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastValidatedAt(); ok {
+		_spec.SetField(targetconfiguration.FieldLastValidatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastValidatedAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldLastValidatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastValidationError(); ok {
+		_spec.SetField(targetconfiguration.FieldLastValidationError, field.TypeString, value)
+	}
+	if _u.mutation.LastValidationErrorCleared() {
+		_spec.ClearField(targetconfiguration.FieldLastValidationError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextValidationAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextValidationAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextValidationAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldNextValidationAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TLSProbeAddress(); ok {
+		_spec.SetField(targetconfiguration.FieldTLSProbeAddress, field.TypeString, value)
+	}
+	if _u.mutation.TLSProbeAddressCleared() {
+		_spec.ClearField(targetconfiguration.FieldTLSProbeAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastDriftCheckAt(); ok {
+		_spec.SetField(targetconfiguration.FieldLastDriftCheckAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDriftCheckAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldLastDriftCheckAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NextDriftCheckAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextDriftCheckAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextDriftCheckAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldNextDriftCheckAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.LastDeploymentAt(); ok {
 		_spec.SetField(targetconfiguration.FieldLastDeploymentAt, field.TypeTime, value)
 	}
 	if _u.mutation.LastDeploymentAtCleared() {
 		_spec.ClearField(targetconfiguration.FieldLastDeploymentAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.DeployedCertificateID(); ok {
+		_spec.SetField(targetconfiguration.FieldDeployedCertificateID, field.TypeString, value)
+	}
+	if _u.mutation.DeployedCertificateIDCleared() {
+		_spec.ClearField(targetconfiguration.FieldDeployedCertificateID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeployedCertificateExpiresAt(); ok {
+		_spec.SetField(targetconfiguration.FieldDeployedCertificateExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeployedCertificateExpiresAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldDeployedCertificateExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(targetconfiguration.FieldRevision, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(targetconfiguration.FieldRevision, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(targetconfiguration.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(targetconfiguration.FieldRetryPolicy, field.TypeJSON)
+	}
 	if _u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -720,6 +1050,18 @@ func (_u *TargetConfigurationUpdateOne) SetCredentialsEncrypted(v []byte) *Targe
 	return _u
 }
 
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (_u *TargetConfigurationUpdateOne) SetCredentialRotatedAt(v map[string]time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetCredentialRotatedAt(v)
+	return _u
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearCredentialRotatedAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearCredentialRotatedAt()
+	return _u
+}
+
 // SetConfig sets the "config" field.
 func (_u *TargetConfigurationUpdateOne) SetConfig(v map[string]interface{}) *TargetConfigurationUpdateOne {
 	_u.mutation.SetConfig(v)
@@ -766,6 +1108,167 @@ func (_u *TargetConfigurationUpdateOne) ClearStatusMessage() *TargetConfiguratio
 	return _u
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *TargetConfigurationUpdateOne) SetConsecutiveFailures(v int32) *TargetConfigurationUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableConsecutiveFailures(v *int32) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *TargetConfigurationUpdateOne) AddConsecutiveFailures(v int32) *TargetConfigurationUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetNextProbeAt sets the "next_probe_at" field.
+func (_u *TargetConfigurationUpdateOne) SetNextProbeAt(v time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetNextProbeAt(v)
+	return _u
+}
+
+// SetNillableNextProbeAt sets the "next_probe_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableNextProbeAt(v *time.Time) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetNextProbeAt(*v)
+	}
+	return _u
+}
+
+// ClearNextProbeAt clears the value of the "next_probe_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearNextProbeAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearNextProbeAt()
+	return _u
+}
+
+// SetLastValidatedAt sets the "last_validated_at" field.
+func (_u *TargetConfigurationUpdateOne) SetLastValidatedAt(v time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetLastValidatedAt(v)
+	return _u
+}
+
+// SetNillableLastValidatedAt sets the "last_validated_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableLastValidatedAt(v *time.Time) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetLastValidatedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastValidatedAt clears the value of the "last_validated_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearLastValidatedAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearLastValidatedAt()
+	return _u
+}
+
+// SetLastValidationError sets the "last_validation_error" field.
+func (_u *TargetConfigurationUpdateOne) SetLastValidationError(v string) *TargetConfigurationUpdateOne {
+	_u.mutation.SetLastValidationError(v)
+	return _u
+}
+
+// SetNillableLastValidationError sets the "last_validation_error" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableLastValidationError(v *string) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetLastValidationError(*v)
+	}
+	return _u
+}
+
+// ClearLastValidationError clears the value of the "last_validation_error" field.
+func (_u *TargetConfigurationUpdateOne) ClearLastValidationError() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearLastValidationError()
+	return _u
+}
+
+// SetNextValidationAt sets the "next_validation_at" field.
+func (_u *TargetConfigurationUpdateOne) SetNextValidationAt(v time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetNextValidationAt(v)
+	return _u
+}
+
+// SetNillableNextValidationAt sets the "next_validation_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableNextValidationAt(v *time.Time) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetNextValidationAt(*v)
+	}
+	return _u
+}
+
+// ClearNextValidationAt clears the value of the "next_validation_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearNextValidationAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearNextValidationAt()
+	return _u
+}
+
+// SetTLSProbeAddress sets the "tls_probe_address" field.
+func (_u *TargetConfigurationUpdateOne) SetTLSProbeAddress(v string) *TargetConfigurationUpdateOne {
+	_u.mutation.SetTLSProbeAddress(v)
+	return _u
+}
+
+// SetNillableTLSProbeAddress sets the "tls_probe_address" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableTLSProbeAddress(v *string) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetTLSProbeAddress(*v)
+	}
+	return _u
+}
+
+// ClearTLSProbeAddress clears the value of the "tls_probe_address" field.
+func (_u *TargetConfigurationUpdateOne) ClearTLSProbeAddress() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearTLSProbeAddress()
+	return _u
+}
+
+// SetLastDriftCheckAt sets the "last_drift_check_at" field.
+func (_u *TargetConfigurationUpdateOne) SetLastDriftCheckAt(v time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetLastDriftCheckAt(v)
+	return _u
+}
+
+// SetNillableLastDriftCheckAt sets the "last_drift_check_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableLastDriftCheckAt(v *time.Time) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetLastDriftCheckAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDriftCheckAt clears the value of the "last_drift_check_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearLastDriftCheckAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearLastDriftCheckAt()
+	return _u
+}
+
+// SetNextDriftCheckAt sets the "next_drift_check_at" field.
+func (_u *TargetConfigurationUpdateOne) SetNextDriftCheckAt(v time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetNextDriftCheckAt(v)
+	return _u
+}
+
+// SetNillableNextDriftCheckAt sets the "next_drift_check_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableNextDriftCheckAt(v *time.Time) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetNextDriftCheckAt(*v)
+	}
+	return _u
+}
+
+// ClearNextDriftCheckAt clears the value of the "next_drift_check_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearNextDriftCheckAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearNextDriftCheckAt()
+	return _u
+}
+
 // SetLastDeploymentAt sets the "last_deployment_at" field.
 func (_u *TargetConfigurationUpdateOne) SetLastDeploymentAt(v time.Time) *TargetConfigurationUpdateOne {
 	_u.mutation.SetLastDeploymentAt(v)
@@ -786,6 +1289,79 @@ func (_u *TargetConfigurationUpdateOne) ClearLastDeploymentAt() *TargetConfigura
 	return _u
 }
 
+// SetDeployedCertificateID sets the "deployed_certificate_id" field.
+func (_u *TargetConfigurationUpdateOne) SetDeployedCertificateID(v string) *TargetConfigurationUpdateOne {
+	_u.mutation.SetDeployedCertificateID(v)
+	return _u
+}
+
+// SetNillableDeployedCertificateID sets the "deployed_certificate_id" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableDeployedCertificateID(v *string) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetDeployedCertificateID(*v)
+	}
+	return _u
+}
+
+// ClearDeployedCertificateID clears the value of the "deployed_certificate_id" field.
+func (_u *TargetConfigurationUpdateOne) ClearDeployedCertificateID() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearDeployedCertificateID()
+	return _u
+}
+
+// SetDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field.
+func (_u *TargetConfigurationUpdateOne) SetDeployedCertificateExpiresAt(v time.Time) *TargetConfigurationUpdateOne {
+	_u.mutation.SetDeployedCertificateExpiresAt(v)
+	return _u
+}
+
+// SetNillableDeployedCertificateExpiresAt sets the "deployed_certificate_expires_at" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableDeployedCertificateExpiresAt(v *time.Time) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetDeployedCertificateExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearDeployedCertificateExpiresAt clears the value of the "deployed_certificate_expires_at" field.
+func (_u *TargetConfigurationUpdateOne) ClearDeployedCertificateExpiresAt() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearDeployedCertificateExpiresAt()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *TargetConfigurationUpdateOne) SetRevision(v uint32) *TargetConfigurationUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *TargetConfigurationUpdateOne) SetNillableRevision(v *uint32) *TargetConfigurationUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *TargetConfigurationUpdateOne) AddRevision(v int32) *TargetConfigurationUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *TargetConfigurationUpdateOne) SetRetryPolicy(v *registry.RetryPolicy) *TargetConfigurationUpdateOne {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *TargetConfigurationUpdateOne) ClearRetryPolicy() *TargetConfigurationUpdateOne {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
 // AddJobIDs adds the "jobs" edge to the DeploymentJob entity by IDs.
 func (_u *TargetConfigurationUpdateOne) AddJobIDs(ids ...string) *TargetConfigurationUpdateOne {
 	_u.mutation.AddJobIDs(ids...)
@@ -920,6 +1496,11 @@ func (_u *TargetConfigurationUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TLSProbeAddress(); ok {
+		if err := targetconfiguration.TLSProbeAddressValidator(v); err != nil {
+			return &ValidationError{Name: "tls_probe_address", err: fmt.Errorf(`ent: validator failed for field "TargetConfiguration.tls_probe_address": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1009,6 +1590,12 @@ func (_u *TargetConfigurationUpdateOne) sqlSave(ctx context.Context) (_node *Tar
 	if value, ok := _u.mutation.CredentialsEncrypted(); ok {
 		_spec.SetField(targetconfiguration.FieldCredentialsEncrypted, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.CredentialRotatedAt(); ok {
+		_spec.SetField(targetconfiguration.FieldCredentialRotatedAt, field.TypeJSON, value)
+	}
+	if _u.mutation.CredentialRotatedAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldCredentialRotatedAt, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Config(); ok {
 		_spec.SetField(targetconfiguration.FieldConfig, field.TypeJSON, value)
 	}
@@ -1024,12 +1611,84 @@ func (_u *TargetConfigurationUpdateOne) sqlSave(ctx context.Context) (_node *Tar
 	if _u.mutation.StatusMessageCleared() {
 		_spec.ClearField(targetconfiguration.FieldStatusMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(targetconfiguration.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(targetconfiguration.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.NextProbeAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextProbeAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextProbeAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldNextProbeAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastValidatedAt(); ok {
+		_spec.SetField(targetconfiguration.FieldLastValidatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastValidatedAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldLastValidatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastValidationError(); ok {
+		_spec.SetField(targetconfiguration.FieldLastValidationError, field.TypeString, value)
+	}
+	if _u.mutation.LastValidationErrorCleared() {
+		_spec.ClearField(targetconfiguration.FieldLastValidationError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextValidationAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextValidationAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextValidationAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldNextValidationAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TLSProbeAddress(); ok {
+		_spec.SetField(targetconfiguration.FieldTLSProbeAddress, field.TypeString, value)
+	}
+	if _u.mutation.TLSProbeAddressCleared() {
+		_spec.ClearField(targetconfiguration.FieldTLSProbeAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastDriftCheckAt(); ok {
+		_spec.SetField(targetconfiguration.FieldLastDriftCheckAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDriftCheckAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldLastDriftCheckAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NextDriftCheckAt(); ok {
+		_spec.SetField(targetconfiguration.FieldNextDriftCheckAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextDriftCheckAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldNextDriftCheckAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.LastDeploymentAt(); ok {
 		_spec.SetField(targetconfiguration.FieldLastDeploymentAt, field.TypeTime, value)
 	}
 	if _u.mutation.LastDeploymentAtCleared() {
 		_spec.ClearField(targetconfiguration.FieldLastDeploymentAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.DeployedCertificateID(); ok {
+		_spec.SetField(targetconfiguration.FieldDeployedCertificateID, field.TypeString, value)
+	}
+	if _u.mutation.DeployedCertificateIDCleared() {
+		_spec.ClearField(targetconfiguration.FieldDeployedCertificateID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeployedCertificateExpiresAt(); ok {
+		_spec.SetField(targetconfiguration.FieldDeployedCertificateExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeployedCertificateExpiresAtCleared() {
+		_spec.ClearField(targetconfiguration.FieldDeployedCertificateExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(targetconfiguration.FieldRevision, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(targetconfiguration.FieldRevision, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(targetconfiguration.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(targetconfiguration.FieldRetryPolicy, field.TypeJSON)
+	}
 	if _u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -12,14 +12,36 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditChainHead is the client for interacting with the AuditChainHead builders.
+	AuditChainHead *AuditChainHeadClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// CertificateRevocation is the client for interacting with the CertificateRevocation builders.
+	CertificateRevocation *CertificateRevocationClient
+	// ChangeRecord is the client for interacting with the ChangeRecord builders.
+	ChangeRecord *ChangeRecordClient
+	// ConfigurationRevision is the client for interacting with the ConfigurationRevision builders.
+	ConfigurationRevision *ConfigurationRevisionClient
+	// DeployLock is the client for interacting with the DeployLock builders.
+	DeployLock *DeployLockClient
 	// DeploymentHistory is the client for interacting with the DeploymentHistory builders.
 	DeploymentHistory *DeploymentHistoryClient
 	// DeploymentJob is the client for interacting with the DeploymentJob builders.
 	DeploymentJob *DeploymentJobClient
 	// DeploymentTarget is the client for interacting with the DeploymentTarget builders.
 	DeploymentTarget *DeploymentTargetClient
+	// DriftEvent is the client for interacting with the DriftEvent builders.
+	DriftEvent *DriftEventClient
+	// ExternalCertificate is the client for interacting with the ExternalCertificate builders.
+	ExternalCertificate *ExternalCertificateClient
+	// InventorySnapshot is the client for interacting with the InventorySnapshot builders.
+	InventorySnapshot *InventorySnapshotClient
+	// NotificationRule is the client for interacting with the NotificationRule builders.
+	NotificationRule *NotificationRuleClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// RoleBinding is the client for interacting with the RoleBinding builders.
+	RoleBinding *RoleBindingClient
 	// TargetConfiguration is the client for interacting with the TargetConfiguration builders.
 	TargetConfiguration *TargetConfigurationClient
 
@@ -153,10 +175,21 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditChainHead = NewAuditChainHeadClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.CertificateRevocation = NewCertificateRevocationClient(tx.config)
+	tx.ChangeRecord = NewChangeRecordClient(tx.config)
+	tx.ConfigurationRevision = NewConfigurationRevisionClient(tx.config)
+	tx.DeployLock = NewDeployLockClient(tx.config)
 	tx.DeploymentHistory = NewDeploymentHistoryClient(tx.config)
 	tx.DeploymentJob = NewDeploymentJobClient(tx.config)
 	tx.DeploymentTarget = NewDeploymentTargetClient(tx.config)
+	tx.DriftEvent = NewDriftEventClient(tx.config)
+	tx.ExternalCertificate = NewExternalCertificateClient(tx.config)
+	tx.InventorySnapshot = NewInventorySnapshotClient(tx.config)
+	tx.NotificationRule = NewNotificationRuleClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.RoleBinding = NewRoleBindingClient(tx.config)
 	tx.TargetConfiguration = NewTargetConfigurationClient(tx.config)
 }
 
@@ -167,7 +200,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
+// applies a query, for example: AuditChainHead.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,126 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/externalcertificate"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// ExternalCertificateRepo stores certificates announced by ingestion sources
+type ExternalCertificateRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewExternalCertificateRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ExternalCertificateRepo {
+	return &ExternalCertificateRepo{
+		log:       ctx.NewLoggerHelper("external_certificate/repo"),
+		entClient: entClient,
+	}
+}
+
+// ExternalCertificateInput holds the fields of an announced certificate
+type ExternalCertificateInput struct {
+	TenantID         uint32
+	CertificateID    string
+	Source           string
+	SerialNumber     string
+	CommonName       string
+	SANs             []string
+	CertificatePEM   string
+	CACertificatePEM string
+	PrivateKeyRef    string
+	ExpiresAt        *time.Time
+}
+
+// Save stores a certificate, replacing the one of the tenant with the same ID
+func (r *ExternalCertificateRepo) Save(ctx context.Context, in *ExternalCertificateInput) (*ent.ExternalCertificate, error) {
+	existing, err := r.Get(ctx, in.TenantID, in.CertificateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		builder := r.entClient.Client().ExternalCertificate.UpdateOneID(existing.ID).
+			SetSource(in.Source).
+			SetSerialNumber(in.SerialNumber).
+			SetCommonName(in.CommonName).
+			SetSans(in.SANs).
+			SetCertificatePem(in.CertificatePEM).
+			SetCaCertificatePem(in.CACertificatePEM).
+			SetPrivateKeyRef(in.PrivateKeyRef).
+			SetUpdateTime(time.Now())
+		if in.ExpiresAt != nil {
+			builder.SetExpiresAt(*in.ExpiresAt)
+		} else {
+			builder.ClearExpiresAt()
+		}
+		entity, err := builder.Save(ctx)
+		if err != nil {
+			r.log.Errorf("update external certificate failed: %s", err.Error())
+			return nil, deployerV1.ErrorInternalServerError("update external certificate failed")
+		}
+		return entity, nil
+	}
+
+	entity, err := r.entClient.Client().ExternalCertificate.Create().
+		SetTenantID(in.TenantID).
+		SetCertificateID(in.CertificateID).
+		SetSource(in.Source).
+		SetSerialNumber(in.SerialNumber).
+		SetCommonName(in.CommonName).
+		SetSans(in.SANs).
+		SetCertificatePem(in.CertificatePEM).
+		SetCaCertificatePem(in.CACertificatePEM).
+		SetPrivateKeyRef(in.PrivateKeyRef).
+		SetNillableExpiresAt(in.ExpiresAt).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("create external certificate failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create external certificate failed")
+	}
+	return entity, nil
+}
+
+// Get returns the certificate of a tenant by certificate ID, or nil
+func (r *ExternalCertificateRepo) Get(ctx context.Context, tenantID uint32, certificateID string) (*ent.ExternalCertificate, error) {
+	entity, err := r.entClient.Client().ExternalCertificate.Query().
+		Where(
+			externalcertificate.TenantIDEQ(tenantID),
+			externalcertificate.CertificateIDEQ(certificateID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get external certificate failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get external certificate failed")
+	}
+	return entity, nil
+}
+
+// ListByCommonName lists the certificates of a tenant with a common name
+func (r *ExternalCertificateRepo) ListByCommonName(ctx context.Context, tenantID uint32, commonName string) ([]*ent.ExternalCertificate, error) {
+	entities, err := r.entClient.Client().ExternalCertificate.Query().
+		Where(
+			externalcertificate.TenantIDEQ(tenantID),
+			externalcertificate.CommonNameEqualFold(commonName),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list external certificates failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list external certificates failed")
+	}
+	return entities, nil
+}
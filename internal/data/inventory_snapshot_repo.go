@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/inventorysnapshot"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+type InventorySnapshotRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewInventorySnapshotRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *InventorySnapshotRepo {
+	return &InventorySnapshotRepo{
+		log:       ctx.NewLoggerHelper("inventory_snapshot/repo"),
+		entClient: entClient,
+	}
+}
+
+// InventorySnapshotInput describes the certificates found on a device
+type InventorySnapshotInput struct {
+	TenantID              uint32
+	TargetConfigurationID string
+	ProviderType          string
+	Certificates          []schema.InventoryCertificate
+	UnmanagedCount        int
+	Error                 string
+}
+
+// Create stores an inventory snapshot
+func (r *InventorySnapshotRepo) Create(ctx context.Context, in *InventorySnapshotInput) (*ent.InventorySnapshot, error) {
+	builder := r.entClient.Client().InventorySnapshot.Create().
+		SetTenantID(in.TenantID).
+		SetTargetConfigurationID(in.TargetConfigurationID).
+		SetProviderType(in.ProviderType).
+		SetUnmanagedCount(in.UnmanagedCount).
+		SetCreateTime(time.Now())
+
+	if len(in.Certificates) > 0 {
+		builder.SetCertificates(in.Certificates)
+	}
+	if in.Error != "" {
+		builder.SetError(in.Error)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create inventory snapshot failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create inventory snapshot failed")
+	}
+	return entity, nil
+}
+
+// InventorySnapshotListOptions contains options for listing inventory snapshots
+type InventorySnapshotListOptions struct {
+	TenantID              *uint32
+	TargetConfigurationID *string
+	Page                  uint32
+	PageSize              uint32
+}
+
+// List lists inventory snapshots, newest first
+func (r *InventorySnapshotRepo) List(ctx context.Context, opts *InventorySnapshotListOptions) ([]*ent.InventorySnapshot, int, error) {
+	query := r.entClient.Client().InventorySnapshot.Query()
+
+	if opts.TenantID != nil {
+		query = query.Where(inventorysnapshot.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.TargetConfigurationID != nil {
+		query = query.Where(inventorysnapshot.TargetConfigurationIDEQ(*opts.TargetConfigurationID))
+	}
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count inventory snapshots failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count inventory snapshots failed")
+	}
+
+	// Apply pagination
+	if opts.Page > 0 && opts.PageSize > 0 {
+		offset := int((opts.Page - 1) * opts.PageSize)
+		query = query.Offset(offset).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(inventorysnapshot.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list inventory snapshots failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list inventory snapshots failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.InventorySnapshot to deployerV1.InventorySnapshot
+func (r *InventorySnapshotRepo) ToProto(entity *ent.InventorySnapshot) *deployerV1.InventorySnapshot {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &deployerV1.InventorySnapshot{
+		Id:                    entity.ID,
+		TenantId:              entity.TenantID,
+		TargetConfigurationId: entity.TargetConfigurationID,
+		ProviderType:          entity.ProviderType,
+		UnmanagedCount:        uint32(entity.UnmanagedCount),
+	}
+
+	for _, cert := range entity.Certificates {
+		item := &deployerV1.InventoryCertificate{
+			Name:       cert.Name,
+			Sans:       cert.SANs,
+			References: cert.References,
+			Managed:    cert.Managed,
+		}
+		if cert.CommonName != "" {
+			item.CommonName = &cert.CommonName
+		}
+		if cert.SerialNumber != "" {
+			item.SerialNumber = &cert.SerialNumber
+		}
+		if cert.Issuer != "" {
+			item.Issuer = &cert.Issuer
+		}
+		if cert.ExpiresAt != nil {
+			item.ExpiresAt = timestamppb.New(*cert.ExpiresAt)
+		}
+		proto.Certificates = append(proto.Certificates, item)
+	}
+
+	if entity.Error != "" {
+		proto.Error = &entity.Error
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
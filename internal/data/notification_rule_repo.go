@@ -0,0 +1,187 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+type NotificationRuleRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewNotificationRuleRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *NotificationRuleRepo {
+	return &NotificationRuleRepo{
+		log:       ctx.NewLoggerHelper("notification_rule/repo"),
+		entClient: entClient,
+	}
+}
+
+// NotificationRuleInput holds the fields of a new notification rule
+type NotificationRuleInput struct {
+	TenantID           uint32
+	Name               string
+	Channel            notificationrule.Channel
+	Recipients         []string
+	URL                string
+	Headers            map[string]string
+	Statuses           []string
+	DeploymentTargetID string
+	CreateBy           *uint32
+}
+
+// Create creates a notification rule, tenant-wide when DeploymentTargetID is empty
+func (r *NotificationRuleRepo) Create(ctx context.Context, in *NotificationRuleInput) (*ent.NotificationRule, error) {
+	entity, err := r.entClient.Client().NotificationRule.Create().
+		SetTenantID(in.TenantID).
+		SetName(in.Name).
+		SetChannel(in.Channel).
+		SetRecipients(in.Recipients).
+		SetURL(in.URL).
+		SetHeaders(in.Headers).
+		SetStatuses(in.Statuses).
+		SetDeploymentTargetID(in.DeploymentTargetID).
+		SetNillableCreateBy(in.CreateBy).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, deployerV1.ErrorConflict("notification rule with this name already exists")
+		}
+		r.log.Errorf("create notification rule failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create notification rule failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a notification rule by ID
+func (r *NotificationRuleRepo) GetByID(ctx context.Context, id uint32) (*ent.NotificationRule, error) {
+	entity, err := r.entClient.Client().NotificationRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get notification rule failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get notification rule failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a notification rule
+func (r *NotificationRuleRepo) Delete(ctx context.Context, id uint32) error {
+	err := r.entClient.Client().NotificationRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return deployerV1.ErrorNotFound("notification rule not found")
+		}
+		r.log.Errorf("delete notification rule failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("delete notification rule failed")
+	}
+	return nil
+}
+
+// NotificationRuleListOptions contains options for listing notification rules
+type NotificationRuleListOptions struct {
+	TenantID           *uint32
+	DeploymentTargetID *string
+	Page               uint32
+	PageSize           uint32
+}
+
+// List lists notification rules
+func (r *NotificationRuleRepo) List(ctx context.Context, opts *NotificationRuleListOptions) ([]*ent.NotificationRule, int, error) {
+	query := r.entClient.Client().NotificationRule.Query()
+
+	if opts.TenantID != nil {
+		query = query.Where(notificationrule.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.DeploymentTargetID != nil {
+		query = query.Where(notificationrule.DeploymentTargetIDEQ(*opts.DeploymentTargetID))
+	}
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count notification rules failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count notification rules failed")
+	}
+
+	// Apply pagination
+	if opts.Page > 0 && opts.PageSize > 0 {
+		offset := int((opts.Page - 1) * opts.PageSize)
+		query = query.Offset(offset).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Asc(notificationrule.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list notification rules failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list notification rules failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListEnabled returns the enabled rules of tenantID that apply to jobs of
+// targetID: the tenant-wide ones and those limited to the target
+func (r *NotificationRuleRepo) ListEnabled(ctx context.Context, tenantID uint32, targetID string) ([]*ent.NotificationRule, error) {
+	entities, err := r.entClient.Client().NotificationRule.Query().
+		Where(
+			notificationrule.TenantIDEQ(tenantID),
+			notificationrule.EnabledEQ(true),
+			notificationrule.DeploymentTargetIDIn("", targetID),
+		).
+		Order(ent.Asc(notificationrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list enabled notification rules failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list enabled notification rules failed")
+	}
+	return entities, nil
+}
+
+// ToProto converts an ent.NotificationRule to deployerV1.NotificationRule,
+// leaving out the URL and header values
+func (r *NotificationRuleRepo) ToProto(entity *ent.NotificationRule) *deployerV1.NotificationRule {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &deployerV1.NotificationRule{
+		Id:         entity.ID,
+		TenantId:   entity.TenantID,
+		Name:       entity.Name,
+		Channel:    deployerV1.NotificationChannel(deployerV1.NotificationChannel_value[string(entity.Channel)]),
+		Recipients: entity.Recipients,
+		HasUrl:     entity.URL != "",
+		Enabled:    entity.Enabled,
+		CreatedBy:  entity.CreateBy,
+	}
+
+	for name := range entity.Headers {
+		proto.HeaderNames = append(proto.HeaderNames, name)
+	}
+	sort.Strings(proto.HeaderNames)
+	for _, status := range entity.Statuses {
+		proto.Statuses = append(proto.Statuses, deployerV1.JobStatus(deployerV1.JobStatus_value[status]))
+	}
+	if entity.DeploymentTargetID != "" {
+		proto.DeploymentTargetId = &entity.DeploymentTargetID
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
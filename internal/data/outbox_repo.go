@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/outboxevent"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// OutboxRepo stores events until they are published on the event bus
+type OutboxRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewOutboxRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *OutboxRepo {
+	return &OutboxRepo{
+		log:       ctx.NewLoggerHelper("outbox/repo"),
+		entClient: entClient,
+	}
+}
+
+// Enqueue stores an event to be published on channel right away
+func (r *OutboxRepo) Enqueue(ctx context.Context, eventID, channel string, payload []byte) error {
+	now := time.Now()
+	err := r.entClient.Client().OutboxEvent.Create().
+		SetEventID(eventID).
+		SetChannel(channel).
+		SetPayload(string(payload)).
+		SetNextAttemptAt(now).
+		SetCreateTime(now).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("enqueue outbox event failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("enqueue outbox event failed")
+	}
+	return nil
+}
+
+// ListPending returns up to limit unpublished events, oldest first
+func (r *OutboxRepo) ListPending(ctx context.Context, limit int) ([]*ent.OutboxEvent, error) {
+	entities, err := r.entClient.Client().OutboxEvent.Query().
+		Where(outboxevent.PublishedAtIsNil()).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list pending outbox events failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list pending outbox events failed")
+	}
+	return entities, nil
+}
+
+// MarkPublished records that an event was published
+func (r *OutboxRepo) MarkPublished(ctx context.Context, id uint32) error {
+	err := r.entClient.Client().OutboxEvent.UpdateOneID(id).
+		SetPublishedAt(time.Now()).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("mark outbox event published failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("mark outbox event published failed")
+	}
+	return nil
+}
+
+// MarkFailed records a failed publish attempt and when to try again
+func (r *OutboxRepo) MarkFailed(ctx context.Context, id uint32, cause string, nextAttemptAt time.Time) error {
+	err := r.entClient.Client().OutboxEvent.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(cause).
+		SetNextAttemptAt(nextAttemptAt).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("mark outbox event failed failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("mark outbox event failed failed")
+	}
+	return nil
+}
+
+// DeletePublishedBefore removes events published before cutoff and returns how many
+func (r *OutboxRepo) DeletePublishedBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	n, err := r.entClient.Client().OutboxEvent.Delete().
+		Where(outboxevent.PublishedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete published outbox events failed: %s", err.Error())
+		return 0, deployerV1.ErrorInternalServerError("delete published outbox events failed")
+	}
+	return n, nil
+}
@@ -20,11 +20,25 @@ var ProviderSet = wire.NewSet(
 	data.NewRegistrationClient,
 	data.NewModuleDialer,
 	data.NewTargetConfigurationRepo,
+	data.NewConfigurationRevisionRepo,
 	data.NewDeploymentTargetRepo,
 	data.NewDeploymentJobRepo,
 	data.NewDeploymentHistoryRepo,
 	data.NewLcmClient,
 	data.NewStatisticsRepo,
 	data.NewAuditLogRepo,
+	data.NewCredentialCipher,
+	data.NewSecretManager,
+	data.NewChangeRecordRepo,
+	data.NewRoleBindingRepo,
+	data.NewAuthorizer,
 	data.NewTangraClientPusher,
+	data.NewRetryPolicies,
+	data.NewDeployLockRepo,
+	data.NewDriftEventRepo,
+	data.NewNotificationRuleRepo,
+	data.NewOutboxRepo,
+	data.NewExternalCertificateRepo,
+	data.NewCertificateRevocationRepo,
+	data.NewInventorySnapshotRepo,
 )
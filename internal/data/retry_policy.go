@@ -0,0 +1,134 @@
+package data
+
+import (
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// RetryPolicies resolves the effective retry and timeout policy of new jobs
+type RetryPolicies struct {
+	defaults  *registry.RetryPolicy
+	providers map[string]*registry.RetryPolicy
+}
+
+// NewRetryPolicies builds the policy defaults from the jobs configuration
+func NewRetryPolicies(ctx *bootstrap.Context) *RetryPolicies {
+	var cfg *conf.JobConfig
+	if c, ok := ctx.GetCustomConfig("deployer"); ok && c != nil {
+		if deployerCfg, ok := c.(*conf.Deployer); ok {
+			cfg = deployerCfg.Jobs
+		}
+	}
+
+	global := &registry.RetryPolicy{}
+	if v := cfg.GetMaxRetries(); v > 0 {
+		global.MaxRetries = &v
+	}
+	if v := cfg.GetRetryDelaySeconds(); v > 0 {
+		global.InitialDelaySeconds = &v
+	}
+	if v := float64(cfg.GetRetryBackoffMultiplier()); v > 0 {
+		global.BackoffMultiplier = &v
+	}
+	if v := float64(cfg.GetRetryJitter()); v > 0 {
+		global.Jitter = &v
+	}
+	if v := cfg.GetMaxRetryDelaySeconds(); v > 0 {
+		global.MaxDelaySeconds = &v
+	}
+	if v := cfg.GetJobTimeoutSeconds(); v > 0 {
+		global.AttemptTimeoutSeconds = &v
+	}
+
+	providers := make(map[string]*registry.RetryPolicy, len(cfg.GetProviderPolicies()))
+	for providerType, p := range cfg.GetProviderPolicies() {
+		providers[providerType] = retryPolicyFromConf(p)
+	}
+
+	return &RetryPolicies{
+		defaults:  registry.DefaultRetryPolicy().Merge(global),
+		providers: providers,
+	}
+}
+
+// Resolve layers the global policy, the provider type policy, the target
+// override and the configuration override, most specific last. config and
+// target may be nil.
+func (p *RetryPolicies) Resolve(config *ent.TargetConfiguration, target *ent.DeploymentTarget) *registry.RetryPolicy {
+	layers := make([]*registry.RetryPolicy, 0, 3)
+	if config != nil {
+		layers = append(layers, p.providers[config.ProviderType])
+	}
+	if target != nil {
+		layers = append(layers, target.RetryPolicy)
+	}
+	if config != nil {
+		layers = append(layers, config.RetryPolicy)
+	}
+	return p.defaults.Merge(layers...)
+}
+
+// ForJob returns the policy a job was created with, or the resolved policy of
+// its configuration for jobs created before policies were recorded
+func (p *RetryPolicies) ForJob(job *ent.DeploymentJob, config *ent.TargetConfiguration) *registry.RetryPolicy {
+	if job.RetryPolicy != nil {
+		return p.defaults.Merge(job.RetryPolicy)
+	}
+	return p.Resolve(config, nil)
+}
+
+func retryPolicyFromConf(p *conf.RetryPolicy) *registry.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	policy := &registry.RetryPolicy{
+		MaxRetries:            p.MaxRetries,
+		InitialDelaySeconds:   p.InitialDelaySeconds,
+		MaxDelaySeconds:       p.MaxDelaySeconds,
+		AttemptTimeoutSeconds: p.AttemptTimeoutSeconds,
+	}
+	if p.BackoffMultiplier != nil {
+		v := float64(*p.BackoffMultiplier)
+		policy.BackoffMultiplier = &v
+	}
+	if p.Jitter != nil {
+		v := float64(*p.Jitter)
+		policy.Jitter = &v
+	}
+	return policy
+}
+
+// RetryPolicyFromProto converts an API retry policy
+func RetryPolicyFromProto(p *deployerV1.RetryPolicy) *registry.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &registry.RetryPolicy{
+		MaxRetries:            p.MaxRetries,
+		InitialDelaySeconds:   p.InitialDelaySeconds,
+		BackoffMultiplier:     p.BackoffMultiplier,
+		Jitter:                p.Jitter,
+		MaxDelaySeconds:       p.MaxDelaySeconds,
+		AttemptTimeoutSeconds: p.AttemptTimeoutSeconds,
+	}
+}
+
+// RetryPolicyToProto converts a stored retry policy
+func RetryPolicyToProto(p *registry.RetryPolicy) *deployerV1.RetryPolicy {
+	if p.IsZero() {
+		return nil
+	}
+	return &deployerV1.RetryPolicy{
+		MaxRetries:            p.MaxRetries,
+		InitialDelaySeconds:   p.InitialDelaySeconds,
+		BackoffMultiplier:     p.BackoffMultiplier,
+		Jitter:                p.Jitter,
+		MaxDelaySeconds:       p.MaxDelaySeconds,
+		AttemptTimeoutSeconds: p.AttemptTimeoutSeconds,
+	}
+}
@@ -0,0 +1,199 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/rolebinding"
+	"github.com/go-tangra/go-tangra-deployer/internal/rbac"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+type RoleBindingRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewRoleBindingRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *RoleBindingRepo {
+	return &RoleBindingRepo{
+		log:       ctx.NewLoggerHelper("role_binding/repo"),
+		entClient: entClient,
+	}
+}
+
+// Create grants role to a subject, tenant-wide when deploymentTargetID is empty
+func (r *RoleBindingRepo) Create(ctx context.Context, tenantID uint32, subjectType rolebinding.SubjectType, subject string,
+	role rolebinding.Role, deploymentTargetID string, createBy *uint32) (*ent.RoleBinding, error) {
+	entity, err := r.entClient.Client().RoleBinding.Create().
+		SetTenantID(tenantID).
+		SetSubjectType(subjectType).
+		SetSubject(subject).
+		SetRole(role).
+		SetDeploymentTargetID(deploymentTargetID).
+		SetNillableCreateBy(createBy).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, deployerV1.ErrorConflict("role binding already exists")
+		}
+		r.log.Errorf("create role binding failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create role binding failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a role binding by ID
+func (r *RoleBindingRepo) GetByID(ctx context.Context, id uint32) (*ent.RoleBinding, error) {
+	entity, err := r.entClient.Client().RoleBinding.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get role binding failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get role binding failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a role binding
+func (r *RoleBindingRepo) Delete(ctx context.Context, id uint32) error {
+	err := r.entClient.Client().RoleBinding.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return deployerV1.ErrorNotFound("role binding not found")
+		}
+		r.log.Errorf("delete role binding failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("delete role binding failed")
+	}
+	return nil
+}
+
+// RoleBindingListOptions contains options for listing role bindings
+type RoleBindingListOptions struct {
+	TenantID           *uint32
+	SubjectType        *rolebinding.SubjectType
+	Subject            *string
+	DeploymentTargetID *string
+	Page               uint32
+	PageSize           uint32
+}
+
+// List lists role bindings
+func (r *RoleBindingRepo) List(ctx context.Context, opts *RoleBindingListOptions) ([]*ent.RoleBinding, int, error) {
+	query := r.entClient.Client().RoleBinding.Query()
+
+	if opts.TenantID != nil {
+		query = query.Where(rolebinding.TenantIDEQ(*opts.TenantID))
+	}
+	if opts.SubjectType != nil {
+		query = query.Where(rolebinding.SubjectTypeEQ(*opts.SubjectType))
+	}
+	if opts.Subject != nil {
+		query = query.Where(rolebinding.SubjectEQ(*opts.Subject))
+	}
+	if opts.DeploymentTargetID != nil {
+		query = query.Where(rolebinding.DeploymentTargetIDEQ(*opts.DeploymentTargetID))
+	}
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count role bindings failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count role bindings failed")
+	}
+
+	// Apply pagination
+	if opts.Page > 0 && opts.PageSize > 0 {
+		offset := int((opts.Page - 1) * opts.PageSize)
+		query = query.Offset(offset).Limit(int(opts.PageSize))
+	}
+
+	entities, err := query.Order(ent.Asc(rolebinding.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list role bindings failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list role bindings failed")
+	}
+
+	return entities, total, nil
+}
+
+// MatchBindings returns the bindings in tenantID granted to any of subjects
+func (r *RoleBindingRepo) MatchBindings(ctx context.Context, tenantID uint32, subjects []rbac.Subject) ([]rbac.Binding, error) {
+	if len(subjects) == 0 {
+		return nil, nil
+	}
+
+	bySubject := make([]predicate.RoleBinding, 0, len(subjects))
+	for _, s := range subjects {
+		bySubject = append(bySubject, rolebinding.And(
+			rolebinding.SubjectTypeEQ(rolebinding.SubjectType(s.Type)),
+			rolebinding.SubjectEQ(s.Value),
+		))
+	}
+
+	entities, err := r.entClient.Client().RoleBinding.Query().
+		Where(
+			rolebinding.TenantIDEQ(tenantID),
+			rolebinding.Or(bySubject...),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("match role bindings failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("match role bindings failed")
+	}
+
+	bindings := make([]rbac.Binding, 0, len(entities))
+	for _, entity := range entities {
+		bindings = append(bindings, rbac.Binding{
+			Role:     RBACRole(entity.Role),
+			TargetID: entity.DeploymentTargetID,
+		})
+	}
+	return bindings, nil
+}
+
+// ToProto converts an ent.RoleBinding to deployerV1.RoleBinding
+func (r *RoleBindingRepo) ToProto(entity *ent.RoleBinding) *deployerV1.RoleBinding {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &deployerV1.RoleBinding{
+		Id:          entity.ID,
+		TenantId:    entity.TenantID,
+		SubjectType: deployerV1.SubjectType(deployerV1.SubjectType_value[string(entity.SubjectType)]),
+		Subject:     entity.Subject,
+		Role:        deployerV1.Role(deployerV1.Role_value[string(entity.Role)]),
+		CreatedBy:   entity.CreateBy,
+	}
+
+	if entity.DeploymentTargetID != "" {
+		proto.DeploymentTargetId = &entity.DeploymentTargetID
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
+
+// RBACRole converts a stored role (ROLE_VIEWER, ...) to its rbac.Role
+func RBACRole(role rolebinding.Role) rbac.Role {
+	return rbac.Role(strings.ToLower(strings.TrimPrefix(string(role), "ROLE_")))
+}
+
+// ProtoRole converts an rbac.Role to its deployerV1.Role
+func ProtoRole(role rbac.Role) deployerV1.Role {
+	return deployerV1.Role(deployerV1.Role_value["ROLE_"+strings.ToUpper(string(role))])
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+)
+
+const (
+	defaultSecretCacheTTL  = 300 * time.Second
+	defaultSecretEnvPrefix = "DEPLOYER_SECRET_"
+	defaultSecretFileDir   = "/run/secrets"
+)
+
+// NewSecretManager creates the resolver for external secret references in
+// credentials. env: and file: references are always available; vault:
+// references only when a Vault address is configured.
+func NewSecretManager(ctx *bootstrap.Context) (*secrets.Manager, error) {
+	var cfg *conf.SecretsConfig
+	if c, ok := ctx.GetCustomConfig("deployer"); ok && c != nil {
+		if deployerCfg, ok := c.(*conf.Deployer); ok {
+			cfg = deployerCfg.Secrets
+		}
+	}
+
+	ttl := defaultSecretCacheTTL
+	if s := cfg.GetCacheTtlSeconds(); s > 0 {
+		ttl = time.Duration(s) * time.Second
+	} else if s < 0 {
+		ttl = 0
+	}
+
+	envPrefix := cfg.GetEnvPrefix()
+	if envPrefix == "" {
+		envPrefix = defaultSecretEnvPrefix
+	}
+	fileDir := cfg.GetFileDir()
+	if fileDir == "" {
+		fileDir = defaultSecretFileDir
+	}
+
+	m := secrets.NewManager(ttl)
+	m.Register(secrets.SchemeEnv, secrets.NewEnvResolver(envPrefix))
+	m.Register(secrets.SchemeFile, secrets.NewFileResolver(fileDir))
+
+	l := ctx.NewLoggerHelper("secret_manager/data")
+
+	if vaultCfg := cfg.GetVault(); vaultCfg.GetAddress() != "" {
+		vault, err := secrets.NewVaultResolver(secrets.VaultOptions{
+			Address:   vaultCfg.GetAddress(),
+			Token:     vaultCfg.GetToken(),
+			TokenFile: vaultCfg.GetTokenFile(),
+			Namespace: vaultCfg.GetNamespace(),
+			KVVersion: int(vaultCfg.GetKvVersion()),
+			Timeout:   time.Duration(vaultCfg.GetTimeoutSeconds()) * time.Second,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("vault secret backend: %w", err)
+		}
+		m.Register(secrets.SchemeVault, vault)
+		l.Infof("Vault secret backend enabled at %s", vaultCfg.GetAddress())
+	}
+
+	return m, nil
+}
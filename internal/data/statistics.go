@@ -40,6 +40,8 @@ type JobStats struct {
 	PartialCount    int64
 	ByStatus        map[string]int64
 	ByTriggerType   map[string]int64
+	// FailuresByCategory counts failed jobs by error category (e.g. "auth")
+	FailuresByCategory map[string]int64
 }
 
 // JobTimeStats holds job statistics for a time period
@@ -76,13 +78,15 @@ type RecentErrorInfo struct {
 	ErrorMessage      string
 	TenantID          uint32
 	ProviderType      string
+	ErrorCategory     string
 }
 
 // GetJobStats returns job statistics
 func (r *StatisticsRepo) GetJobStats(ctx context.Context, tenantID *uint32) (*JobStats, error) {
 	stats := &JobStats{
-		ByStatus:      make(map[string]int64),
-		ByTriggerType: make(map[string]int64),
+		ByStatus:           make(map[string]int64),
+		ByTriggerType:      make(map[string]int64),
+		FailuresByCategory: make(map[string]int64),
 	}
 
 	client := r.entClient.Client()
@@ -166,9 +170,35 @@ func (r *StatisticsRepo) GetJobStats(ctx context.Context, tenantID *uint32) (*Jo
 	autoRenewal, _ := baseQuery().Where(deploymentjob.TriggeredByEQ(deploymentjob.TriggeredByTRIGGER_TYPE_AUTO_RENEWAL)).Count(ctx)
 	stats.ByTriggerType["auto_renewal"] = int64(autoRenewal)
 
+	driftRemediation, _ := baseQuery().Where(deploymentjob.TriggeredByEQ(deploymentjob.TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION)).Count(ctx)
+	stats.ByTriggerType["drift_remediation"] = int64(driftRemediation)
+
+	revocation, _ := baseQuery().Where(deploymentjob.TriggeredByEQ(deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION)).Count(ctx)
+	stats.ByTriggerType["revocation"] = int64(revocation)
+
 	triggerUnspecified, _ := baseQuery().Where(deploymentjob.TriggeredByEQ(deploymentjob.TriggeredByTRIGGER_TYPE_UNSPECIFIED)).Count(ctx)
 	stats.ByTriggerType["unspecified"] = int64(triggerUnspecified)
 
+	// Failure category counts
+	var byCategory []struct {
+		ErrorCategory deploymentjob.ErrorCategory `json:"error_category"`
+		Count         int                         `json:"count"`
+	}
+	err = baseQuery().
+		Where(
+			deploymentjob.StatusEQ(deploymentjob.StatusJOB_STATUS_FAILED),
+			deploymentjob.ErrorCategoryNotNil(),
+		).
+		GroupBy(deploymentjob.FieldErrorCategory).
+		Aggregate(ent.Count()).
+		Scan(ctx, &byCategory)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range byCategory {
+		stats.FailuresByCategory[ErrorCategoryName(c.ErrorCategory)] = int64(c.Count)
+	}
+
 	return stats, nil
 }
 
@@ -359,6 +389,10 @@ func (r *StatisticsRepo) GetRecentErrors(ctx context.Context, tenantID *uint32,
 			errorInfo.ConfigurationID = *job.TargetConfigurationID
 		}
 
+		if job.ErrorCategory != nil {
+			errorInfo.ErrorCategory = ErrorCategoryName(*job.ErrorCategory)
+		}
+
 		errors = append(errors, errorInfo)
 	}
 
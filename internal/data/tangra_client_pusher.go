@@ -121,17 +121,41 @@ func (p *TangraClientPusher) PushCertificate(ctx context.Context, clientIDs []st
 		return nil, fmt.Errorf("certificate data is nil")
 	}
 
-	topic := p.topicPrefix + ".certificate.issued"
+	return p.publishPerClient(ctx, "certificate.issued", clientIDs, func(clientID string) any {
+		return buildClientPayload(clientID, certName, cert)
+	}), nil
+}
+
+// RemoveCertificate publishes one certificate.removed event per client_id,
+// naming the certificate the agent uninstalls.
+func (p *TangraClientPusher) RemoveCertificate(ctx context.Context, clientIDs []string, certName string, cert *registry.CertificateData) ([]tangra_client.PushResult, error) {
+	if p.redisClient == nil {
+		return nil, fmt.Errorf("redis client not available")
+	}
+
+	return p.publishPerClient(ctx, "certificate.removed", clientIDs, func(clientID string) any {
+		payload := &clientCertPayload{ClientID: clientID, Name: certName}
+		if cert != nil {
+			payload.CommonName = cert.CommonName
+			payload.SerialNumber = cert.SerialNumber
+		}
+		return payload
+	}), nil
+}
+
+// publishPerClient publishes one event of eventType per client_id on the
+// matching topic and reports the outcome per client.
+func (p *TangraClientPusher) publishPerClient(ctx context.Context, eventType string, clientIDs []string, payload func(clientID string) any) []tangra_client.PushResult {
+	topic := p.topicPrefix + "." + eventType
 	results := make([]tangra_client.PushResult, 0, len(clientIDs))
 
 	for _, clientID := range clientIDs {
-		payload := buildClientPayload(clientID, certName, cert)
 		evt := pushEvent{
 			ID:        uuid.New().String(),
-			Type:      "certificate.issued",
+			Type:      eventType,
 			Source:    "deployer-service",
 			Timestamp: time.Now().UTC(),
-			Data:      payload,
+			Data:      payload(clientID),
 		}
 
 		body, err := json.Marshal(evt)
@@ -145,7 +169,7 @@ func (p *TangraClientPusher) PushCertificate(ctx context.Context, clientIDs []st
 		}
 
 		if err := p.redisClient.Publish(ctx, topic, body).Err(); err != nil {
-			p.log.Errorf("Failed to publish certificate event for client %s: %v", clientID, err)
+			p.log.Errorf("Failed to publish %s event for client %s: %v", eventType, clientID, err)
 			results = append(results, tangra_client.PushResult{
 				ClientID: clientID,
 				Success:  false,
@@ -154,7 +178,7 @@ func (p *TangraClientPusher) PushCertificate(ctx context.Context, clientIDs []st
 			continue
 		}
 
-		p.log.Infof("Published certificate event for client %s on %s (cert=%s)", clientID, topic, certName)
+		p.log.Infof("Published %s event for client %s on %s", eventType, clientID, topic)
 		results = append(results, tangra_client.PushResult{
 			ClientID: clientID,
 			Success:  true,
@@ -162,7 +186,7 @@ func (p *TangraClientPusher) PushCertificate(ctx context.Context, clientIDs []st
 		})
 	}
 
-	return results, nil
+	return results
 }
 
 // VerifyInstalled queries LCM for agent-reported install state of certName
@@ -13,7 +13,9 @@ import (
 	entCrud "github.com/tx7do/go-crud/entgo"
 
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
@@ -30,19 +32,37 @@ func NewTargetConfigurationRepo(ctx *bootstrap.Context, entClient *entCrud.EntCl
 	}
 }
 
-// Create creates a new target configuration
+// RevisionMeta describes who created a configuration revision and why
+type RevisionMeta struct {
+	ActorID   *uint32
+	ActorName string
+	Reason    string
+	// RevertedFrom is set when the revision restores an older revision
+	RevertedFrom *uint32
+}
+
+// Create creates a new target configuration together with its first revision
 func (r *TargetConfigurationRepo) Create(ctx context.Context, tenantID uint32, name, description, providerType string,
-	credentialsEncrypted []byte, config map[string]any) (*ent.TargetConfiguration, error) {
+	credentialsEncrypted []byte, credentialRotatedAt map[string]time.Time, config map[string]any, retryPolicy *registry.RetryPolicy,
+	tlsProbeAddress string, meta *RevisionMeta) (*ent.TargetConfiguration, error) {
 
 	id := uuid.New().String()
 
-	builder := r.entClient.Client().TargetConfiguration.Create().
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create target configuration failed")
+	}
+
+	builder := tx.TargetConfiguration.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetName(name).
 		SetProviderType(providerType).
 		SetCredentialsEncrypted(credentialsEncrypted).
+		SetCredentialRotatedAt(credentialRotatedAt).
 		SetStatus(targetconfiguration.StatusCONFIG_STATUS_ACTIVE).
+		SetRevision(1).
 		SetCreateTime(time.Now())
 
 	if description != "" {
@@ -51,13 +71,30 @@ func (r *TargetConfigurationRepo) Create(ctx context.Context, tenantID uint32, n
 	if config != nil {
 		builder.SetConfig(config)
 	}
+	if !retryPolicy.IsZero() {
+		builder.SetRetryPolicy(retryPolicy)
+	}
+	if tlsProbeAddress != "" {
+		builder.SetTLSProbeAddress(tlsProbeAddress)
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		r.log.Errorf("create target configuration failed: %s", err.Error())
 		return nil, deployerV1.ErrorInternalServerError("create target configuration failed")
 	}
 
+	if err := r.createRevision(ctx, tx.Client(), entity, meta); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit target configuration failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create target configuration failed")
+	}
+
 	return entity, nil
 }
 
@@ -74,6 +111,20 @@ func (r *TargetConfigurationRepo) GetByID(ctx context.Context, id string) (*ent.
 	return entity, nil
 }
 
+// ConfigurationTargetIDs returns the IDs of the deployment targets a
+// configuration belongs to, none when it does not exist or belongs to no target
+func (r *TargetConfigurationRepo) ConfigurationTargetIDs(ctx context.Context, id string) ([]string, error) {
+	ids, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(targetconfiguration.IDEQ(id)).
+		QueryDeploymentTargets().
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("get configuration targets failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("get configuration targets failed")
+	}
+	return ids, nil
+}
+
 // GetByTenantAndName retrieves a target configuration by tenant ID and name
 func (r *TargetConfigurationRepo) GetByTenantAndName(ctx context.Context, tenantID uint32, name string) (*ent.TargetConfiguration, error) {
 	entity, err := r.entClient.Client().TargetConfiguration.Query().
@@ -142,11 +193,22 @@ func (r *TargetConfigurationRepo) ListByIDs(ctx context.Context, ids []string) (
 	return entities, nil
 }
 
-// Update updates a target configuration
+// Update updates a target configuration. Credentials are replaced together
+// with their rotation times; an empty retry policy or TLS probe address removes
+// the setting. When
+// meta is given, the updated state is captured as a new revision in the same
+// transaction.
 func (r *TargetConfigurationRepo) Update(ctx context.Context, id string, name, description *string,
-	credentialsEncrypted []byte, config map[string]any, status *targetconfiguration.Status) (*ent.TargetConfiguration, error) {
+	credentialsEncrypted []byte, credentialRotatedAt map[string]time.Time, config map[string]any, status *targetconfiguration.Status,
+	retryPolicy *registry.RetryPolicy, tlsProbeAddress *string, meta *RevisionMeta) (*ent.TargetConfiguration, error) {
 
-	builder := r.entClient.Client().TargetConfiguration.UpdateOneID(id).
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("update target configuration failed")
+	}
+
+	builder := tx.TargetConfiguration.UpdateOneID(id).
 		SetUpdateTime(time.Now())
 
 	if name != nil {
@@ -156,17 +218,39 @@ func (r *TargetConfigurationRepo) Update(ctx context.Context, id string, name, d
 		builder.SetDescription(*description)
 	}
 	if credentialsEncrypted != nil {
-		builder.SetCredentialsEncrypted(credentialsEncrypted)
+		builder.SetCredentialsEncrypted(credentialsEncrypted).
+			SetCredentialRotatedAt(credentialRotatedAt)
 	}
 	if config != nil {
 		builder.SetConfig(config)
 	}
 	if status != nil {
-		builder.SetStatus(*status)
+		// An explicit status overrides the circuit breaker
+		builder.SetStatus(*status).
+			SetConsecutiveFailures(0).
+			ClearNextProbeAt()
+	}
+	if retryPolicy != nil {
+		if retryPolicy.IsZero() {
+			builder.ClearRetryPolicy()
+		} else {
+			builder.SetRetryPolicy(retryPolicy)
+		}
+	}
+	if tlsProbeAddress != nil {
+		if *tlsProbeAddress == "" {
+			builder.ClearTLSProbeAddress()
+		} else {
+			builder.SetTLSProbeAddress(*tlsProbeAddress)
+		}
+	}
+	if meta != nil {
+		builder.AddRevision(1)
 	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		if ent.IsNotFound(err) {
 			return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
 		}
@@ -174,9 +258,142 @@ func (r *TargetConfigurationRepo) Update(ctx context.Context, id string, name, d
 		return nil, deployerV1.ErrorInternalServerError("update target configuration failed")
 	}
 
+	if meta != nil {
+		if err := r.createRevision(ctx, tx.Client(), entity, meta); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit target configuration failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("update target configuration failed")
+	}
+
 	return entity, nil
 }
 
+// Revert restores the name, description, config and credentials of an earlier
+// revision. The restored state is captured as a new revision.
+func (r *TargetConfigurationRepo) Revert(ctx context.Context, id string, revision *ent.ConfigurationRevision,
+	credentialRotatedAt map[string]time.Time, meta *RevisionMeta) (*ent.TargetConfiguration, error) {
+	reverted := *meta
+	reverted.RevertedFrom = &revision.Revision
+
+	description := revision.Description
+	config := revision.Config
+	if config == nil {
+		config = map[string]any{}
+	}
+	return r.Update(ctx, id, &revision.Name, &description, revision.CredentialsEncrypted, credentialRotatedAt, config, nil, nil, nil, &reverted)
+}
+
+// EnsureRevision returns the current revision of a configuration. Configurations
+// created before revisions existed get their current state captured as revision 1.
+func (r *TargetConfigurationRepo) EnsureRevision(ctx context.Context, entity *ent.TargetConfiguration) (*ent.ConfigurationRevision, error) {
+	if entity.Revision > 0 {
+		revision, err := r.entClient.Client().ConfigurationRevision.Query().
+			Where(
+				configurationrevision.TargetConfigurationIDEQ(entity.ID),
+				configurationrevision.RevisionEQ(entity.Revision),
+			).
+			Only(ctx)
+		if err == nil {
+			return revision, nil
+		}
+		if !ent.IsNotFound(err) {
+			r.log.Errorf("get current configuration revision failed: %s", err.Error())
+			return nil, deployerV1.ErrorInternalServerError("get configuration revision failed")
+		}
+		r.log.Warnf("configuration %s has no row for revision %d, capturing current state", entity.ID, entity.Revision)
+	}
+
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create configuration revision failed")
+	}
+
+	// Guard on the revision we saw so concurrent callers capture it only once
+	affected, err := tx.TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(entity.ID),
+			targetconfiguration.RevisionEQ(entity.Revision),
+		).
+		AddRevision(1).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("bump configuration revision failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create configuration revision failed")
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		current, err := r.GetByID(ctx, entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+		}
+		return r.EnsureRevision(ctx, current)
+	}
+
+	captured := *entity
+	captured.Revision = entity.Revision + 1
+	if err := r.createRevision(ctx, tx.Client(), &captured, &RevisionMeta{ActorName: "system"}); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit configuration revision failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("create configuration revision failed")
+	}
+
+	return r.EnsureRevision(ctx, &captured)
+}
+
+// createRevision stores the given configuration state as an immutable revision
+func (r *TargetConfigurationRepo) createRevision(ctx context.Context, client *ent.Client, entity *ent.TargetConfiguration, meta *RevisionMeta) error {
+	if meta == nil {
+		meta = &RevisionMeta{}
+	}
+
+	builder := client.ConfigurationRevision.Create().
+		SetTargetConfigurationID(entity.ID).
+		SetRevision(entity.Revision).
+		SetName(entity.Name).
+		SetProviderType(entity.ProviderType).
+		SetCredentialsEncrypted(entity.CredentialsEncrypted).
+		SetNillableTenantID(entity.TenantID).
+		SetNillableRevertedFrom(meta.RevertedFrom).
+		SetNillableActorID(meta.ActorID).
+		SetCreateTime(time.Now())
+
+	if entity.Description != "" {
+		builder.SetDescription(entity.Description)
+	}
+	if entity.Config != nil {
+		builder.SetConfig(entity.Config)
+	}
+	if meta.ActorName != "" {
+		builder.SetActorName(meta.ActorName)
+	}
+	if meta.Reason != "" {
+		builder.SetReason(meta.Reason)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return deployerV1.ErrorConflict("configuration was modified concurrently, please retry")
+		}
+		r.log.Errorf("create configuration revision failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("create configuration revision failed")
+	}
+	return nil
+}
+
 // UpdateStatus updates the status of a target configuration
 func (r *TargetConfigurationRepo) UpdateStatus(ctx context.Context, id string, status targetconfiguration.Status, message string) (*ent.TargetConfiguration, error) {
 	builder := r.entClient.Client().TargetConfiguration.UpdateOneID(id).
@@ -195,13 +412,262 @@ func (r *TargetConfigurationRepo) UpdateStatus(ctx context.Context, id string, s
 	return entity, nil
 }
 
-// UpdateLastDeployment updates the last deployment timestamp
-func (r *TargetConfigurationRepo) UpdateLastDeployment(ctx context.Context, id string) error {
+// RecordDeploymentFailure counts a failed deployment attempt. Once threshold
+// attempts failed in a row (threshold > 0), it opens the circuit: the
+// configuration moves to ERROR with message and is probed at probeAt. It
+// returns the status the configuration left, or "" when it did not change.
+func (r *TargetConfigurationRepo) RecordDeploymentFailure(ctx context.Context, id string, threshold int32, message string, probeAt time.Time) (targetconfiguration.Status, error) {
+	client := r.entClient.Client()
+	entity, err := client.TargetConfiguration.UpdateOneID(id).
+		AddConsecutiveFailures(1).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("record deployment failure failed: %s", err.Error())
+		return "", deployerV1.ErrorInternalServerError("record deployment failure failed")
+	}
+	if threshold <= 0 || entity.ConsecutiveFailures < threshold || entity.Status == targetconfiguration.StatusCONFIG_STATUS_ERROR {
+		return "", nil
+	}
+
+	// Only the update that still sees the old status opens the circuit
+	opened, err := r.OpenCircuit(ctx, id, entity.Status, message, probeAt)
+	if err != nil || !opened {
+		return "", err
+	}
+	return entity.Status, nil
+}
+
+// OpenCircuit moves a configuration from status from to ERROR with message,
+// to be probed at probeAt. It returns false when the configuration left from
+// in the meantime.
+func (r *TargetConfigurationRepo) OpenCircuit(ctx context.Context, id string, from targetconfiguration.Status, message string, probeAt time.Time) (bool, error) {
+	affected, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.StatusEQ(from),
+		).
+		SetStatus(targetconfiguration.StatusCONFIG_STATUS_ERROR).
+		SetStatusMessage(message).
+		SetNextProbeAt(probeAt).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("open configuration circuit failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("open configuration circuit failed")
+	}
+	return affected > 0, nil
+}
+
+// RecordDeploymentSuccess resets the consecutive failures of a configuration
+func (r *TargetConfigurationRepo) RecordDeploymentSuccess(ctx context.Context, id string) error {
+	if _, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.ConsecutiveFailuresGT(0),
+		).
+		SetConsecutiveFailures(0).
+		Save(ctx); err != nil {
+		r.log.Errorf("record deployment success failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("record deployment success failed")
+	}
+	return nil
+}
+
+// ListDueProbes lists configurations whose open circuit is due for a probe
+func (r *TargetConfigurationRepo) ListDueProbes(ctx context.Context, limit int) ([]*ent.TargetConfiguration, error) {
+	entities, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(
+			targetconfiguration.StatusEQ(targetconfiguration.StatusCONFIG_STATUS_ERROR),
+			targetconfiguration.NextProbeAtLTE(time.Now()),
+		).
+		Order(ent.Asc(targetconfiguration.FieldNextProbeAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due probes failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list due probes failed")
+	}
+	return entities, nil
+}
+
+// ListIDsByStatus lists the IDs of the configurations in a status
+func (r *TargetConfigurationRepo) ListIDsByStatus(ctx context.Context, status targetconfiguration.Status) ([]string, error) {
+	ids, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(targetconfiguration.StatusEQ(status)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("list configuration ids failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list configurations failed")
+	}
+	return ids, nil
+}
+
+// CloseCircuit moves a configuration whose circuit is open back to ACTIVE.
+// It returns false when the configuration left ERROR in the meantime.
+func (r *TargetConfigurationRepo) CloseCircuit(ctx context.Context, id, message string) (bool, error) {
+	affected, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.StatusEQ(targetconfiguration.StatusCONFIG_STATUS_ERROR),
+			targetconfiguration.NextProbeAtNotNil(),
+		).
+		SetStatus(targetconfiguration.StatusCONFIG_STATUS_ACTIVE).
+		SetStatusMessage(message).
+		SetConsecutiveFailures(0).
+		ClearNextProbeAt().
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("close configuration circuit failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("close configuration circuit failed")
+	}
+	return affected > 0, nil
+}
+
+// ClaimProbe postpones a due probe to nextProbeAt so that only one replica
+// runs it. It returns false when another replica claimed it first.
+func (r *TargetConfigurationRepo) ClaimProbe(ctx context.Context, id string, dueAt, nextProbeAt time.Time) (bool, error) {
+	affected, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.StatusEQ(targetconfiguration.StatusCONFIG_STATUS_ERROR),
+			targetconfiguration.NextProbeAtEQ(dueAt),
+		).
+		SetNextProbeAt(nextProbeAt).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim configuration probe failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("claim configuration probe failed")
+	}
+	return affected > 0, nil
+}
+
+// ScheduleProbe postpones the next probe of an open circuit
+func (r *TargetConfigurationRepo) ScheduleProbe(ctx context.Context, id string, probeAt time.Time, message string) error {
+	if _, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.StatusEQ(targetconfiguration.StatusCONFIG_STATUS_ERROR),
+			targetconfiguration.NextProbeAtNotNil(),
+		).
+		SetStatusMessage(message).
+		SetNextProbeAt(probeAt).
+		Save(ctx); err != nil {
+		r.log.Errorf("schedule configuration probe failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("schedule configuration probe failed")
+	}
+	return nil
+}
+
+// ListDueValidations lists active configurations whose credential health
+// check is due, never checked ones first
+func (r *TargetConfigurationRepo) ListDueValidations(ctx context.Context, limit int) ([]*ent.TargetConfiguration, error) {
+	entities, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(
+			targetconfiguration.StatusEQ(targetconfiguration.StatusCONFIG_STATUS_ACTIVE),
+			targetconfiguration.Or(
+				targetconfiguration.NextValidationAtIsNil(),
+				targetconfiguration.NextValidationAtLTE(time.Now()),
+			),
+		).
+		Order(ent.Asc(targetconfiguration.FieldNextValidationAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due validations failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list due validations failed")
+	}
+	return entities, nil
+}
+
+// ClaimValidation postpones a due health check (dueAt is nil for a
+// configuration never checked) to nextValidationAt so that only one replica
+// runs it. It returns false when another replica claimed it first.
+func (r *TargetConfigurationRepo) ClaimValidation(ctx context.Context, id string, dueAt *time.Time, nextValidationAt time.Time) (bool, error) {
+	due := targetconfiguration.NextValidationAtIsNil()
+	if dueAt != nil {
+		due = targetconfiguration.NextValidationAtEQ(*dueAt)
+	}
+	affected, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(targetconfiguration.IDEQ(id), due).
+		SetNextValidationAt(nextValidationAt).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim configuration validation failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("claim configuration validation failed")
+	}
+	return affected > 0, nil
+}
+
+// RecordValidation records the outcome of validating the credentials of a
+// configuration; validationErr is empty when they passed
+func (r *TargetConfigurationRepo) RecordValidation(ctx context.Context, id, validationErr string) error {
+	if err := r.entClient.Client().TargetConfiguration.UpdateOneID(id).
+		SetLastValidatedAt(time.Now()).
+		SetLastValidationError(validationErr).
+		Exec(ctx); err != nil {
+		r.log.Errorf("record configuration validation failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("record configuration validation failed")
+	}
+	return nil
+}
+
+// ListDueDriftChecks lists active configurations with a successful deployment
+// whose drift check is due, never checked ones first
+func (r *TargetConfigurationRepo) ListDueDriftChecks(ctx context.Context, limit int) ([]*ent.TargetConfiguration, error) {
+	entities, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(
+			targetconfiguration.StatusEQ(targetconfiguration.StatusCONFIG_STATUS_ACTIVE),
+			targetconfiguration.LastDeploymentAtNotNil(),
+			targetconfiguration.Or(
+				targetconfiguration.NextDriftCheckAtIsNil(),
+				targetconfiguration.NextDriftCheckAtLTE(time.Now()),
+			),
+		).
+		Order(ent.Asc(targetconfiguration.FieldNextDriftCheckAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due drift checks failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list due drift checks failed")
+	}
+	return entities, nil
+}
+
+// ClaimDriftCheck postpones a due drift check (dueAt is nil for a
+// configuration never checked) to nextCheckAt and records the check as run, so
+// that only one replica runs it. It returns false when another replica
+// claimed it first.
+func (r *TargetConfigurationRepo) ClaimDriftCheck(ctx context.Context, id string, dueAt *time.Time, nextCheckAt time.Time) (bool, error) {
+	due := targetconfiguration.NextDriftCheckAtIsNil()
+	if dueAt != nil {
+		due = targetconfiguration.NextDriftCheckAtEQ(*dueAt)
+	}
+	affected, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(targetconfiguration.IDEQ(id), due).
+		SetNextDriftCheckAt(nextCheckAt).
+		SetLastDriftCheckAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim configuration drift check failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("claim configuration drift check failed")
+	}
+	return affected > 0, nil
+}
+
+// UpdateLastDeployment updates the last deployment timestamp and records the
+// deployed certificate; a nil expiresAt leaves the expiry to be looked up later
+func (r *TargetConfigurationRepo) UpdateLastDeployment(ctx context.Context, id, certificateID string, expiresAt *time.Time) error {
 	now := time.Now()
-	err := r.entClient.Client().TargetConfiguration.UpdateOneID(id).
+	builder := r.entClient.Client().TargetConfiguration.UpdateOneID(id).
 		SetLastDeploymentAt(now).
-		SetUpdateTime(now).
-		Exec(ctx)
+		SetDeployedCertificateID(certificateID).
+		SetNillableDeployedCertificateExpiresAt(expiresAt).
+		SetUpdateTime(now)
+	if expiresAt == nil {
+		builder.ClearDeployedCertificateExpiresAt()
+	}
+	err := builder.Exec(ctx)
 	if err != nil {
 		r.log.Errorf("update last deployment failed: %s", err.Error())
 		return deployerV1.ErrorInternalServerError("update last deployment failed")
@@ -209,33 +675,188 @@ func (r *TargetConfigurationRepo) UpdateLastDeployment(ctx context.Context, id s
 	return nil
 }
 
-// Delete deletes a target configuration
+// ClearDeployedCertificate records that the certificate deployed to a
+// configuration was removed from the device
+func (r *TargetConfigurationRepo) ClearDeployedCertificate(ctx context.Context, id string) error {
+	err := r.entClient.Client().TargetConfiguration.UpdateOneID(id).
+		ClearDeployedCertificateID().
+		ClearDeployedCertificateExpiresAt().
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("clear deployed certificate failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("update target configuration failed")
+	}
+	return nil
+}
+
+// ListMissingExpiry lists deployed configurations whose certificate expiry is
+// not known yet
+func (r *TargetConfigurationRepo) ListMissingExpiry(ctx context.Context, limit int) ([]*ent.TargetConfiguration, error) {
+	entities, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(
+			targetconfiguration.LastDeploymentAtNotNil(),
+			targetconfiguration.DeployedCertificateExpiresAtIsNil(),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list configurations missing certificate expiry failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list target configurations failed")
+	}
+	return entities, nil
+}
+
+// SetDeployedCertificateExpiry records the expiry of the certificate deployed
+// to a configuration, unless another certificate was deployed meanwhile
+func (r *TargetConfigurationRepo) SetDeployedCertificateExpiry(ctx context.Context, id, certificateID string, expiresAt time.Time) error {
+	_, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.Or(
+				targetconfiguration.DeployedCertificateIDEQ(certificateID),
+				targetconfiguration.DeployedCertificateIDIsNil(),
+				targetconfiguration.DeployedCertificateIDEQ(""),
+			),
+			targetconfiguration.DeployedCertificateExpiresAtIsNil(),
+		).
+		SetDeployedCertificateID(certificateID).
+		SetDeployedCertificateExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("set deployed certificate expiry failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("update target configuration failed")
+	}
+	return nil
+}
+
+// ListExpiring lists configurations whose deployed certificate expires before
+// the given time, soonest first, with their deployment targets. A nil before
+// lists every configuration with a known expiry.
+func (r *TargetConfigurationRepo) ListExpiring(ctx context.Context, tenantID *uint32, before *time.Time,
+	page, pageSize uint32) ([]*ent.TargetConfiguration, int, error) {
+
+	query := r.entClient.Client().TargetConfiguration.Query().
+		Where(targetconfiguration.DeployedCertificateExpiresAtNotNil())
+
+	if tenantID != nil {
+		query = query.Where(targetconfiguration.TenantIDEQ(*tenantID))
+	}
+	if before != nil {
+		query = query.Where(targetconfiguration.DeployedCertificateExpiresAtLT(*before))
+	}
+
+	// Count total
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count expiring configurations failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("count target configurations failed")
+	}
+
+	// Apply pagination
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		WithDeploymentTargets().
+		Order(ent.Asc(targetconfiguration.FieldDeployedCertificateExpiresAt), ent.Asc(targetconfiguration.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list expiring configurations failed: %s", err.Error())
+		return nil, 0, deployerV1.ErrorInternalServerError("list target configurations failed")
+	}
+
+	return entities, total, nil
+}
+
+// Delete deletes a target configuration and its revisions
 func (r *TargetConfigurationRepo) Delete(ctx context.Context, id string) error {
-	err := r.entClient.Client().TargetConfiguration.DeleteOneID(id).Exec(ctx)
+	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("delete target configuration failed")
+	}
+
+	// Revisions hold encrypted credentials and must not outlive the configuration
+	if _, err := tx.ConfigurationRevision.Delete().
+		Where(configurationrevision.TargetConfigurationIDEQ(id)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("delete configuration revisions failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("delete target configuration failed")
+	}
+
+	if err := tx.TargetConfiguration.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
 		if ent.IsNotFound(err) {
 			return deployerV1.ErrorConfigurationNotFound("target configuration not found")
 		}
 		r.log.Errorf("delete target configuration failed: %s", err.Error())
 		return deployerV1.ErrorInternalServerError("delete target configuration failed")
 	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit target configuration delete failed: %s", err.Error())
+		return deployerV1.ErrorInternalServerError("delete target configuration failed")
+	}
 	return nil
 }
 
-// GetCredentialsEncrypted retrieves the encrypted credentials for a configuration
-func (r *TargetConfigurationRepo) GetCredentialsEncrypted(ctx context.Context, id string) ([]byte, error) {
+// GetCredentialsEncrypted retrieves the encrypted credentials for a
+// configuration and the tenant owning them
+func (r *TargetConfigurationRepo) GetCredentialsEncrypted(ctx context.Context, id string) ([]byte, uint32, error) {
 	entity, err := r.entClient.Client().TargetConfiguration.Query().
 		Where(targetconfiguration.IDEQ(id)).
-		Select(targetconfiguration.FieldCredentialsEncrypted).
+		Select(targetconfiguration.FieldCredentialsEncrypted, targetconfiguration.FieldTenantID).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+			return nil, 0, deployerV1.ErrorConfigurationNotFound("target configuration not found")
 		}
 		r.log.Errorf("get credentials failed: %s", err.Error())
-		return nil, deployerV1.ErrorInternalServerError("get credentials failed")
+		return nil, 0, deployerV1.ErrorInternalServerError("get credentials failed")
+	}
+	var tenantID uint32
+	if entity.TenantID != nil {
+		tenantID = *entity.TenantID
 	}
-	return entity.CredentialsEncrypted, nil
+	return entity.CredentialsEncrypted, tenantID, nil
+}
+
+// ListCredentialsAfter lists configurations ordered by ID, loading only the
+// encrypted credentials. Used to walk all records during key rotation.
+func (r *TargetConfigurationRepo) ListCredentialsAfter(ctx context.Context, afterID string, limit int) ([]*ent.TargetConfiguration, error) {
+	entities, err := r.entClient.Client().TargetConfiguration.Query().
+		Where(targetconfiguration.IDGT(afterID)).
+		Order(ent.Asc(targetconfiguration.FieldID)).
+		Limit(limit).
+		Select(targetconfiguration.FieldID, targetconfiguration.FieldCredentialsEncrypted).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list target configuration credentials failed: %s", err.Error())
+		return nil, deployerV1.ErrorInternalServerError("list target configuration credentials failed")
+	}
+	return entities, nil
+}
+
+// ReplaceCredentialsEncrypted swaps the stored ciphertext for an equivalent one
+// (same plaintext). It only applies when the row still holds old, so concurrent
+// edits win, and returns whether the row was updated.
+func (r *TargetConfigurationRepo) ReplaceCredentialsEncrypted(ctx context.Context, id string, old, replacement []byte) (bool, error) {
+	affected, err := r.entClient.Client().TargetConfiguration.Update().
+		Where(
+			targetconfiguration.IDEQ(id),
+			targetconfiguration.CredentialsEncryptedEQ(old),
+		).
+		SetCredentialsEncrypted(replacement).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("replace target configuration credentials failed: %s", err.Error())
+		return false, deployerV1.ErrorInternalServerError("replace target configuration credentials failed")
+	}
+	return affected > 0, nil
 }
 
 // ToProto converts an ent.TargetConfiguration to deployerV1.TargetConfiguration
@@ -257,6 +878,15 @@ func (r *TargetConfigurationRepo) ToProto(entity *ent.TargetConfiguration) *depl
 	if entity.StatusMessage != "" {
 		proto.StatusMessage = &entity.StatusMessage
 	}
+	if entity.LastValidationError != "" {
+		proto.LastValidationError = &entity.LastValidationError
+	}
+	if entity.TLSProbeAddress != "" {
+		proto.TlsProbeAddress = &entity.TLSProbeAddress
+	}
+	if entity.Revision > 0 {
+		proto.Revision = &entity.Revision
+	}
 
 	// Map status
 	switch entity.Status {
@@ -281,11 +911,34 @@ func (r *TargetConfigurationRepo) ToProto(entity *ent.TargetConfiguration) *depl
 			proto.Config = configStruct
 		}
 	}
+	proto.RetryPolicy = RetryPolicyToProto(entity.RetryPolicy)
+	proto.ConsecutiveFailures = &entity.ConsecutiveFailures
 
 	// Convert timestamps
 	if entity.LastDeploymentAt != nil {
 		proto.LastDeploymentAt = timestamppb.New(*entity.LastDeploymentAt)
 	}
+	if entity.NextProbeAt != nil {
+		proto.NextProbeAt = timestamppb.New(*entity.NextProbeAt)
+	}
+	if entity.LastValidatedAt != nil {
+		proto.LastValidatedAt = timestamppb.New(*entity.LastValidatedAt)
+	}
+	if entity.NextValidationAt != nil {
+		proto.NextValidationAt = timestamppb.New(*entity.NextValidationAt)
+	}
+	if entity.LastDriftCheckAt != nil {
+		proto.LastDriftCheckAt = timestamppb.New(*entity.LastDriftCheckAt)
+	}
+	if entity.NextDriftCheckAt != nil {
+		proto.NextDriftCheckAt = timestamppb.New(*entity.NextDriftCheckAt)
+	}
+	if entity.DeployedCertificateID != "" {
+		proto.DeployedCertificateId = &entity.DeployedCertificateID
+	}
+	if entity.DeployedCertificateExpiresAt != nil {
+		proto.DeployedCertificateExpiresAt = timestamppb.New(*entity.DeployedCertificateExpiresAt)
+	}
 	if entity.CreateBy != nil {
 		proto.CreatedBy = entity.CreateBy
 	}
@@ -299,5 +952,36 @@ func (r *TargetConfigurationRepo) ToProto(entity *ent.TargetConfiguration) *depl
 		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
 	}
 
+	// Credentials are write-only: only report which fields are set
+	if entity.CredentialRotatedAt != nil {
+		proto.Credentials = CredentialStatus(entity.ProviderType, entity.CredentialRotatedAt)
+	}
+
 	return proto
 }
+
+// CredentialStatus returns the write-only view of a configuration's credentials:
+// every set field with the time it was last rotated (zero when unknown), plus
+// the provider's required fields that are not set
+func CredentialStatus(providerType string, rotatedAt map[string]time.Time) map[string]*deployerV1.CredentialFieldStatus {
+	status := make(map[string]*deployerV1.CredentialFieldStatus, len(rotatedAt))
+	for name, at := range rotatedAt {
+		field := &deployerV1.CredentialFieldStatus{Set: true}
+		if !at.IsZero() {
+			field.LastRotatedAt = timestamppb.New(at)
+		}
+		status[name] = field
+	}
+
+	if info, err := registry.GetInfo(providerType); err == nil && info.Caps != nil {
+		for _, name := range info.Caps.RequiredCredFields {
+			if field, ok := status[name]; ok {
+				field.Required = true
+			} else {
+				status[name] = &deployerV1.CredentialFieldStatus{Required: true}
+			}
+		}
+	}
+
+	return status
+}
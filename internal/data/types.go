@@ -15,3 +15,9 @@ type TargetConfiguration = ent.TargetConfiguration
 
 // DeploymentHistory is a type alias for the ENT DeploymentHistory entity
 type DeploymentHistory = ent.DeploymentHistory
+
+// ChangeRecord is a type alias for the ENT ChangeRecord entity
+type ChangeRecord = ent.ChangeRecord
+
+// ConfigurationRevision is a type alias for the ENT ConfigurationRevision entity
+type ConfigurationRevision = ent.ConfigurationRevision
@@ -0,0 +1,144 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// CloudEventsSpecVersion is the CloudEvents version understood and produced
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of structured mode CloudEvents
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// FormatLCM is the LCMEvent envelope
+	FormatLCM = "lcm"
+	// FormatCloudEvents is the structured JSON mode of CloudEvents 1.0
+	FormatCloudEvents = "cloudevents"
+)
+
+// CloudEvent is a CloudEvents 1.0 event in structured JSON mode. The tenant
+// travels in the tenantid extension attribute.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            *time.Time      `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	DataSchema      string          `json:"dataschema,omitempty"`
+	TenantID        *TenantIDAttr   `json:"tenantid,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      string          `json:"data_base64,omitempty"`
+}
+
+// TenantIDAttr is the tenantid extension attribute. CloudEvents extensions
+// are often serialized as strings, so both strings and numbers are accepted.
+type TenantIDAttr uint32
+
+// UnmarshalJSON accepts the tenant ID as a JSON number or string
+func (t *TenantIDAttr) UnmarshalJSON(b []byte) error {
+	raw := string(bytes.Trim(b, `"`))
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid tenantid %s", b)
+	}
+	*t = TenantIDAttr(id)
+	return nil
+}
+
+// IsCloudEvent reports whether payload is a structured mode CloudEvent
+func IsCloudEvent(payload []byte) bool {
+	var probe struct {
+		SpecVersion string `json:"specversion"`
+	}
+	return json.Unmarshal(payload, &probe) == nil && probe.SpecVersion != ""
+}
+
+// ParseCloudEvent decodes and validates a structured mode CloudEvent with JSON data
+func ParseCloudEvent(payload []byte) (*CloudEvent, error) {
+	var ce CloudEvent
+	if err := json.Unmarshal(payload, &ce); err != nil {
+		return nil, fmt.Errorf("invalid CloudEvent: %w", err)
+	}
+
+	switch {
+	case ce.SpecVersion != CloudEventsSpecVersion:
+		return nil, fmt.Errorf("unsupported CloudEvents specversion %q", ce.SpecVersion)
+	case ce.ID == "":
+		return nil, fmt.Errorf("CloudEvent has no id")
+	case ce.Source == "":
+		return nil, fmt.Errorf("CloudEvent has no source")
+	case ce.Type == "":
+		return nil, fmt.Errorf("CloudEvent has no type")
+	case ce.DataBase64 != "":
+		return nil, fmt.Errorf("CloudEvent data must be JSON, not data_base64")
+	}
+
+	if ce.DataContentType != "" {
+		mediaType, _, err := mime.ParseMediaType(ce.DataContentType)
+		if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+			return nil, fmt.Errorf("unsupported CloudEvent datacontenttype %q", ce.DataContentType)
+		}
+	}
+
+	return &ce, nil
+}
+
+// ToLCMEvent returns the event in the LCMEvent envelope
+func (ce *CloudEvent) ToLCMEvent() *LCMEvent {
+	evt := &LCMEvent{
+		ID:     ce.ID,
+		Type:   ce.Type,
+		Source: ce.Source,
+		Data:   ce.Data,
+	}
+	if ce.Time != nil {
+		evt.Timestamp = *ce.Time
+	}
+	if ce.TenantID != nil {
+		evt.TenantID = uint32(*ce.TenantID)
+	}
+	return evt
+}
+
+// NewCloudEvent returns evt as a CloudEvent of type eventType
+func NewCloudEvent(evt *LCMEvent, eventType, subject string) *CloudEvent {
+	timestamp := evt.Timestamp
+	tenantID := TenantIDAttr(evt.TenantID)
+	return &CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              evt.ID,
+		Source:          evt.Source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            &timestamp,
+		DataContentType: "application/json",
+		TenantID:        &tenantID,
+		Data:            evt.Data,
+	}
+}
+
+// DecodeEnvelope decodes an LCMEvent or a structured mode CloudEvent into
+// the LCMEvent envelope
+func DecodeEnvelope(payload []byte) (*LCMEvent, error) {
+	if IsCloudEvent(payload) {
+		ce, err := ParseCloudEvent(payload)
+		if err != nil {
+			return nil, err
+		}
+		return ce.ToLCMEvent(), nil
+	}
+
+	var evt LCMEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return nil, fmt.Errorf("invalid LCM event: %w", err)
+	}
+	return &evt, nil
+}
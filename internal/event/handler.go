@@ -12,21 +12,32 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
 
 // Handler handles certificate events and creates deployment jobs
 type Handler struct {
-	log        *log.Helper
-	targetRepo *data.DeploymentTargetRepo
-	jobRepo    *data.DeploymentJobRepo
+	log         *log.Helper
+	targetRepo  *data.DeploymentTargetRepo
+	jobRepo     *data.DeploymentJobRepo
+	policies    *data.RetryPolicies
+	lcmClient   *data.LcmClient
+	certRepo    *data.ExternalCertificateRepo
+	revocations *data.CertificateRevocationRepo
 }
 
 // NewHandler creates a new event handler
-func NewHandler(ctx *bootstrap.Context, targetRepo *data.DeploymentTargetRepo, jobRepo *data.DeploymentJobRepo) *Handler {
+func NewHandler(ctx *bootstrap.Context, targetRepo *data.DeploymentTargetRepo, jobRepo *data.DeploymentJobRepo, policies *data.RetryPolicies,
+	lcmClient *data.LcmClient, certRepo *data.ExternalCertificateRepo, revocations *data.CertificateRevocationRepo) *Handler {
 	return &Handler{
-		log:        ctx.NewLoggerHelper("deployer/event/handler"),
-		targetRepo: targetRepo,
-		jobRepo:    jobRepo,
+		log:         ctx.NewLoggerHelper("deployer/event/handler"),
+		targetRepo:  targetRepo,
+		jobRepo:     jobRepo,
+		policies:    policies,
+		lcmClient:   lcmClient,
+		certRepo:    certRepo,
+		revocations: revocations,
 	}
 }
 
@@ -40,6 +51,8 @@ func (h *Handler) HandleCertificateEvent(ctx context.Context, event *Certificate
 		return h.handleCertificateIssued(ctx, event)
 	case "renewal.completed":
 		return h.handleRenewalCompleted(ctx, event)
+	case "certificate.revoked", "certificate.deleted":
+		return h.handleCertificateRevoked(ctx, event)
 	default:
 		h.log.Infof("Ignoring unknown event type: %s", event.EventType)
 		return nil
@@ -74,9 +87,17 @@ func (h *Handler) handleCertificateIssued(ctx context.Context, event *Certificat
 		}
 
 		// Create parent job for the target group
+		var idempotencyKey string
+		if event.IdempotencyKey != "" {
+			idempotencyKey = event.IdempotencyKey + ":" + target.ID
+		}
 		parentJob, err := h.jobRepo.CreateParentJob(ctx, event.TenantID, target.ID, event.CertificateID,
-			event.SerialNumber, triggerType, 3)
+			event.SerialNumber, idempotencyKey, triggerType, h.policies.Resolve(nil, target))
 		if err != nil {
+			if deployerV1.IsConflict(err) {
+				h.log.Infof("Event already created a job for target group %s, skipping", target.ID)
+				continue
+			}
 			h.log.Errorf("Failed to create parent job for target group %s: %v", target.ID, err)
 			continue
 		}
@@ -85,12 +106,21 @@ func (h *Handler) handleCertificateIssued(ctx context.Context, event *Certificat
 		// Create child jobs for each configuration
 		for _, config := range configs {
 			childJob, err := h.jobRepo.CreateChildJob(ctx, event.TenantID, parentJob.ID, config.ID,
-				event.CertificateID, event.SerialNumber, triggerType, 3)
+				event.CertificateID, event.SerialNumber, triggerType, h.policies.Resolve(config, target))
 			if err != nil {
 				h.log.Errorf("Failed to create child job for configuration %s: %v", config.ID, err)
 				continue
 			}
 			h.log.Infof("Created child deployment job %s for configuration %s (parent: %s)", childJob.ID, config.ID, parentJob.ID)
+
+			// Cancel queued deployments of older certificates of the lineage
+			changes, err := h.jobRepo.SupersedeQueued(ctx, childJob, event.PreviousCertID)
+			if err != nil {
+				h.log.Warnf("Failed to supersede queued jobs for configuration %s: %v", config.ID, err)
+			}
+			for _, c := range changes {
+				h.log.Infof("Job %s: %s -> %s (superseded by job %s)", c.JobID, c.From, c.To, childJob.ID)
+			}
 		}
 	}
 
@@ -0,0 +1,406 @@
+package event
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+// Headers of HMAC-signed ingestion requests. The signature is the hex
+// HMAC-SHA256 of "<timestamp>.<body>" under the secret of the source.
+const (
+	HeaderIngestSource    = "X-Deployer-Source"
+	HeaderIngestTimestamp = "X-Deployer-Timestamp"
+	HeaderIngestSignature = "X-Deployer-Signature"
+)
+
+// IngestPath is where the HTTP server accepts events
+const IngestPath = "/v1/events"
+
+// Event types of ingested CloudEvents, matched as suffix of the CloudEvent type
+const (
+	ingestTypeIssued  = "certificate.issued"
+	ingestTypeRenewed = "certificate.renewed"
+)
+
+// IngestedCertificateData represents the data field of ingested certificate
+// events. With certificate_pem the certificate is kept by the deployer and
+// deployed without LCM; its key must then be given as a secret reference.
+// Without it, certificate_id must be known to LCM.
+type IngestedCertificateData struct {
+	CertificateID         string     `json:"certificate_id"`
+	PreviousCertificateID string     `json:"previous_certificate_id,omitempty"`
+	SerialNumber          string     `json:"serial_number,omitempty"`
+	CommonName            string     `json:"common_name,omitempty"`
+	DNSNames              []string   `json:"dns_names,omitempty"`
+	IssuerName            string     `json:"issuer_name,omitempty"`
+	NotBefore             *time.Time `json:"not_before,omitempty"`
+	ExpiresAt             *time.Time `json:"expires_at,omitempty"`
+	SubjectOrganization   string     `json:"subject_organization,omitempty"`
+	SubjectOrgUnit        string     `json:"subject_org_unit,omitempty"`
+	SubjectCountry        string     `json:"subject_country,omitempty"`
+	CertificatePEM        string     `json:"certificate_pem,omitempty"`
+	CACertificatePEM      string     `json:"ca_certificate_pem,omitempty"`
+	PrivateKeyRef         string     `json:"private_key_ref,omitempty"`
+}
+
+// ingestSource is a configured sender with its resolved HMAC secret
+type ingestSource struct {
+	name     string
+	tenantID uint32
+	secret   []byte
+	clientCN string
+}
+
+// Ingester accepts CloudEvents from certificate sources other than LCM over
+// HTTP and hands them to the handler, so that they trigger the deployments
+// of matching auto-deploy targets like LCM events do. Every source is bound
+// to one tenant and authenticated by an HMAC signature or an mTLS client
+// certificate.
+type Ingester struct {
+	log      *log.Helper
+	handler  *Handler
+	certRepo *data.ExternalCertificateRepo
+	secrets  *secrets.Manager
+	config   *conf.IngestConfig
+	sources  []*ingestSource
+	now      func() time.Time
+}
+
+// NewIngester creates the ingester of the HTTP server
+func NewIngester(ctx *bootstrap.Context, handler *Handler, certRepo *data.ExternalCertificateRepo, secretManager *secrets.Manager) (*Ingester, error) {
+	var ingestCfg *conf.IngestConfig
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			ingestCfg = deployerCfg.Ingest
+		}
+	}
+
+	// Default config
+	if ingestCfg == nil {
+		ingestCfg = &conf.IngestConfig{}
+	}
+	if ingestCfg.MaxBodyBytes <= 0 {
+		ingestCfg.MaxBodyBytes = 1 << 20
+	}
+	if ingestCfg.MaxClockSkewSeconds <= 0 {
+		ingestCfg.MaxClockSkewSeconds = 300
+	}
+
+	i := &Ingester{
+		log:      ctx.NewLoggerHelper("deployer/event/ingest"),
+		handler:  handler,
+		certRepo: certRepo,
+		secrets:  secretManager,
+		config:   ingestCfg,
+		now:      time.Now,
+	}
+	if !ingestCfg.Enabled {
+		return i, nil
+	}
+
+	for _, s := range ingestCfg.Sources {
+		if s.GetName() == "" || s.GetTenantId() == 0 {
+			return nil, fmt.Errorf("ingest: sources need a name and a tenant_id")
+		}
+		if s.GetHmacSecret() == "" && s.GetClientCommonName() == "" {
+			return nil, fmt.Errorf("ingest: source %s needs an hmac_secret or a client_common_name", s.GetName())
+		}
+
+		source := &ingestSource{name: s.GetName(), tenantID: s.GetTenantId(), clientCN: s.GetClientCommonName()}
+		secret := s.GetHmacSecret()
+		if scheme, ref, ok := secrets.ParseReference(secret); ok {
+			resolveCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			resolved, err := secretManager.Resolve(resolveCtx, scheme, ref)
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("ingest: resolve secret of source %s: %w", s.GetName(), err)
+			}
+			secret = resolved
+		}
+		if secret != "" {
+			source.secret = []byte(secret)
+		}
+		i.sources = append(i.sources, source)
+	}
+
+	return i, nil
+}
+
+// Enabled reports whether events are accepted
+func (i *Ingester) Enabled() bool {
+	return i.config.Enabled
+}
+
+// MTLS reports whether the HTTP server should accept client certificates
+func (i *Ingester) MTLS() bool {
+	return i.config.Enabled && i.config.Mtls
+}
+
+// ServeHTTP accepts one structured mode CloudEvent
+func (i *Ingester) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeIngestError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != CloudEventsContentType && mediaType != "application/json" {
+		writeIngestError(w, http.StatusUnsupportedMediaType, "only structured mode CloudEvents ("+CloudEventsContentType+") are accepted")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, i.config.MaxBodyBytes))
+	if err != nil {
+		writeIngestError(w, http.StatusRequestEntityTooLarge, "request body too large")
+		return
+	}
+
+	source, err := i.authenticate(r, body)
+	if err != nil {
+		i.log.Warnf("Rejected ingested event from %s: %v", r.RemoteAddr, err)
+		writeIngestError(w, http.StatusUnauthorized, "authentication failed")
+		return
+	}
+
+	ce, err := ParseCloudEvent(body)
+	if err != nil {
+		writeIngestError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if ce.TenantID != nil && uint32(*ce.TenantID) != source.tenantID {
+		writeIngestError(w, http.StatusForbidden, "tenantid does not match the source")
+		return
+	}
+
+	// Use system viewer context like the event subscriber; the tenant is the source's
+	ctx := appViewer.NewSystemViewerContext(r.Context())
+	if err := i.ingest(ctx, source, ce); err != nil {
+		var bad *badEventError
+		if errors.As(err, &bad) {
+			writeIngestError(w, http.StatusBadRequest, bad.Error())
+			return
+		}
+		i.log.Errorf("Failed to handle ingested event %s from %s: %v", ce.ID, source.name, err)
+		writeIngestError(w, http.StatusInternalServerError, "failed to handle event")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(map[string]string{"id": ce.ID, "status": "accepted"})
+}
+
+// badEventError is an event the ingester cannot act on
+type badEventError struct{ msg string }
+
+func (e *badEventError) Error() string { return e.msg }
+
+func badEvent(format string, args ...any) error {
+	return &badEventError{msg: fmt.Sprintf(format, args...)}
+}
+
+// authenticate returns the source of a request, by its verified client
+// certificate or its HMAC signature
+func (i *Ingester) authenticate(r *http.Request, body []byte) (*ingestSource, error) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		cn := r.TLS.VerifiedChains[0][0].Subject.CommonName
+		for _, s := range i.sources {
+			if s.clientCN != "" && s.clientCN == cn {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("no source for client certificate %q", cn)
+	}
+
+	name := r.Header.Get(HeaderIngestSource)
+	var source *ingestSource
+	for _, s := range i.sources {
+		if s.name == name && s.secret != nil {
+			source = s
+			break
+		}
+	}
+	if source == nil {
+		return nil, fmt.Errorf("unknown source %q", name)
+	}
+
+	timestamp := r.Header.Get(HeaderIngestTimestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+	skew := i.now().Sub(time.Unix(unix, 0))
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > time.Duration(i.config.MaxClockSkewSeconds)*time.Second {
+		return nil, fmt.Errorf("timestamp %s outside the allowed clock skew", timestamp)
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(r.Header.Get(HeaderIngestSignature), "sha256="))
+	if err != nil || !hmac.Equal(signature, SignIngestRequest(source.secret, timestamp, body)) {
+		return nil, fmt.Errorf("invalid signature of source %s", name)
+	}
+	return source, nil
+}
+
+// SignIngestRequest returns the HMAC signature of an ingestion request
+func SignIngestRequest(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// ingest stores the certificate of an event and triggers its deployments
+func (i *Ingester) ingest(ctx context.Context, source *ingestSource, ce *CloudEvent) error {
+	var eventType string
+	switch {
+	case ce.Type == ingestTypeIssued || strings.HasSuffix(ce.Type, "."+ingestTypeIssued):
+		eventType = "certificate.issued"
+	case ce.Type == ingestTypeRenewed || strings.HasSuffix(ce.Type, "."+ingestTypeRenewed):
+		eventType = "renewal.completed"
+	default:
+		return badEvent("unsupported event type %q", ce.Type)
+	}
+
+	var certData IngestedCertificateData
+	if len(ce.Data) == 0 {
+		return badEvent("event has no data")
+	}
+	if err := json.Unmarshal(ce.Data, &certData); err != nil {
+		return badEvent("invalid event data: %v", err)
+	}
+	if certData.CertificateID == "" {
+		return badEvent("certificate_id is required")
+	}
+
+	if certData.CertificatePEM != "" {
+		if err := i.saveCertificate(ctx, source, &certData); err != nil {
+			return err
+		}
+	}
+
+	// Redelivery of the event must not deploy again
+	key := sha256.Sum256([]byte(source.name + "\x00" + ce.ID))
+
+	event := &CertificateEvent{
+		EventType:           eventType,
+		TenantID:            source.tenantID,
+		CertificateID:       certData.CertificateID,
+		SerialNumber:        certData.SerialNumber,
+		CommonName:          certData.CommonName,
+		SANs:                certData.DNSNames,
+		IssuerName:          certData.IssuerName,
+		IsRenewal:           eventType == "renewal.completed",
+		PreviousCertID:      certData.PreviousCertificateID,
+		SubjectOrganization: certData.SubjectOrganization,
+		SubjectOrgUnit:      certData.SubjectOrgUnit,
+		SubjectCountry:      certData.SubjectCountry,
+		IdempotencyKey:      "event:" + hex.EncodeToString(key[:]),
+	}
+	if certData.NotBefore != nil {
+		event.NotBefore = certData.NotBefore.Unix()
+	}
+	if certData.ExpiresAt != nil {
+		event.NotAfter = certData.ExpiresAt.Unix()
+	}
+
+	i.log.Infof("Ingested %s event %s from source %s for tenant %d (cert=%s)",
+		ce.Type, ce.ID, source.name, source.tenantID, certData.CertificateID)
+	return i.handler.HandleCertificateEvent(ctx, event)
+}
+
+// saveCertificate keeps the certificate of an event, completing the data
+// from the certificate itself
+func (i *Ingester) saveCertificate(ctx context.Context, source *ingestSource, certData *IngestedCertificateData) error {
+	block, _ := pem.Decode([]byte(certData.CertificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return badEvent("certificate_pem is not a PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return badEvent("invalid certificate_pem: %v", err)
+	}
+	scheme, ref, ok := secrets.ParseReference(certData.PrivateKeyRef)
+	if !ok {
+		return badEvent("private_key_ref must be an env:, file: or vault: secret reference")
+	}
+	// The key is resolved for the source's tenant, so it must be one of its secrets
+	if err := i.secrets.CheckTenantReference(source.tenantID, scheme, ref); err != nil {
+		return badEvent("private_key_ref: %v", err)
+	}
+
+	if certData.SerialNumber == "" {
+		certData.SerialNumber = cert.SerialNumber.Text(16)
+	}
+	if certData.CommonName == "" {
+		certData.CommonName = cert.Subject.CommonName
+	}
+	if len(certData.DNSNames) == 0 {
+		certData.DNSNames = cert.DNSNames
+	}
+	if certData.IssuerName == "" {
+		certData.IssuerName = cert.Issuer.CommonName
+	}
+	if certData.NotBefore == nil {
+		certData.NotBefore = &cert.NotBefore
+	}
+	if certData.ExpiresAt == nil {
+		certData.ExpiresAt = &cert.NotAfter
+	}
+	if certData.SubjectOrganization == "" && len(cert.Subject.Organization) > 0 {
+		certData.SubjectOrganization = cert.Subject.Organization[0]
+	}
+	if certData.SubjectOrgUnit == "" && len(cert.Subject.OrganizationalUnit) > 0 {
+		certData.SubjectOrgUnit = cert.Subject.OrganizationalUnit[0]
+	}
+	if certData.SubjectCountry == "" && len(cert.Subject.Country) > 0 {
+		certData.SubjectCountry = cert.Subject.Country[0]
+	}
+
+	_, err = i.certRepo.Save(ctx, &data.ExternalCertificateInput{
+		TenantID:         source.tenantID,
+		CertificateID:    certData.CertificateID,
+		Source:           source.name,
+		SerialNumber:     certData.SerialNumber,
+		CommonName:       certData.CommonName,
+		SANs:             certData.DNSNames,
+		CertificatePEM:   certData.CertificatePEM,
+		CACertificatePEM: certData.CACertificatePEM,
+		PrivateKeyRef:    certData.PrivateKeyRef,
+		ExpiresAt:        certData.ExpiresAt,
+	})
+	return err
+}
+
+// writeIngestError writes an error response of the ingestion endpoint
+func writeIngestError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
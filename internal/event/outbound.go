@@ -0,0 +1,49 @@
+package event
+
+import "time"
+
+// SourceDeployer is the source of events published by the deployer
+const SourceDeployer = "deployer-service"
+
+// Types of events published by the deployer, below its topic prefix
+const (
+	EventJobStarted    = "job.started"
+	EventJobCompleted  = "job.completed"
+	EventJobFailed     = "job.failed"
+	EventJobPartial    = "job.partial"
+	EventDriftDetected = "drift.detected"
+)
+
+// JobEventData represents the data field for job.* events
+type JobEventData struct {
+	JobID                 string    `json:"job_id"`
+	ParentJobID           string    `json:"parent_job_id,omitempty"`
+	TenantID              uint32    `json:"tenant_id"`
+	Status                string    `json:"status"`
+	PreviousStatus        string    `json:"previous_status"`
+	TriggeredBy           string    `json:"triggered_by"`
+	RetryCount            int32     `json:"retry_count"`
+	DeploymentTargetID    string    `json:"deployment_target_id,omitempty"`
+	TargetConfigurationID string    `json:"target_configuration_id,omitempty"`
+	CertificateID         string    `json:"certificate_id"`
+	SerialNumber          string    `json:"serial_number,omitempty"`
+	CommonName            string    `json:"common_name,omitempty"`
+	Message               string    `json:"message,omitempty"`
+	ErrorCategory         string    `json:"error_category,omitempty"`
+	OccurredAt            time.Time `json:"occurred_at"`
+}
+
+// DriftDetectedData represents the data field for drift.detected events
+type DriftDetectedData struct {
+	DriftEventID          uint32    `json:"drift_event_id"`
+	TenantID              uint32    `json:"tenant_id"`
+	TargetConfigurationID string    `json:"target_configuration_id"`
+	JobID                 string    `json:"job_id"`
+	CertificateID         string    `json:"certificate_id"`
+	SerialNumber          string    `json:"serial_number,omitempty"`
+	Source                string    `json:"source"`
+	Message               string    `json:"message,omitempty"`
+	ObservedSerial        string    `json:"observed_serial,omitempty"`
+	RemediationJobID      string    `json:"remediation_job_id,omitempty"`
+	DetectedAt            time.Time `json:"detected_at"`
+}
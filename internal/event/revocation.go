@@ -0,0 +1,215 @@
+package event
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+const (
+	// replacementCandidates bounds the recently deployed certificates looked
+	// up in LCM when searching a replacement for a revoked one
+	replacementCandidates = 50
+	// certificateLookupTimeout bounds a single LCM lookup
+	certificateLookupTimeout = 10 * time.Second
+)
+
+// replacementCertificate is a valid certificate that can take the place of a
+// revoked one
+type replacementCertificate struct {
+	id        string
+	serial    string
+	expiresAt time.Time
+}
+
+// handleCertificateRevoked handles a revoked or deleted certificate: every
+// configuration still serving it gets a job that either deploys the newest
+// valid certificate with the same common name or removes the certificate, as
+// the revocation policy of its deployment targets asks. A configuration
+// removes the certificate when any of its targets asks so.
+func (h *Handler) handleCertificateRevoked(ctx context.Context, event *CertificateEvent) error {
+	// A deleted certificate is gone with all its serials
+	deleted := event.EventType == "certificate.deleted"
+	serial := event.SerialNumber
+	if deleted {
+		serial = ""
+	}
+	if event.CertificateID == "" && serial == "" {
+		h.log.Warnf("Ignoring %s event without certificate ID or serial", event.EventType)
+		return nil
+	}
+
+	// Remembered so that it never replaces a later revoked certificate
+	if err := h.revocations.Record(ctx, event.TenantID, event.CertificateID, serial); err != nil {
+		return err
+	}
+
+	serving, err := h.jobRepo.ListServing(ctx, event.TenantID, event.CertificateID, serial)
+	if err != nil {
+		return err
+	}
+	if len(serving) == 0 {
+		h.log.Infof("Certificate %s (serial %s) is not deployed anywhere", event.CertificateID, event.SerialNumber)
+		return nil
+	}
+
+	// Redeployments of all configurations share one replacement
+	var replacement *replacementCertificate
+	searched := false
+
+	for _, last := range serving {
+		config := last.Edges.TargetConfiguration
+		if config == nil {
+			continue
+		}
+		target, remove := revocationTarget(config)
+		policy := h.policies.Resolve(config, target)
+
+		commonName := event.CommonName
+		if commonName == "" {
+			commonName = last.CertificateCommonName
+		}
+
+		// One job per configuration and revoked certificate
+		key := "revocation:" + last.CertificateID + ":" + last.CertificateSerial + ":" + config.ID
+
+		var job *ent.DeploymentJob
+		if remove {
+			job, err = h.jobRepo.CreateRemovalJob(ctx, event.TenantID, config.ID, last.CertificateID,
+				last.CertificateSerial, commonName, key, deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION, policy)
+		} else {
+			if !searched {
+				replacement = h.findReplacement(ctx, event.TenantID, commonName, last.CertificateID, last.CertificateSerial, deleted)
+				searched = true
+			}
+			if replacement == nil {
+				h.log.Warnf("No valid certificate named %q replaces revoked certificate %s on configuration %s, leaving it in place",
+					commonName, last.CertificateID, config.ID)
+				continue
+			}
+			job, err = h.jobRepo.CreateDirectJob(ctx, event.TenantID, config.ID, replacement.id,
+				replacement.serial, key, deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION, policy)
+		}
+		if err != nil {
+			if deployerV1.IsConflict(err) {
+				h.log.Infof("Revocation of certificate %s already handled for configuration %s", last.CertificateID, config.ID)
+				continue
+			}
+			h.log.Errorf("Failed to create revocation job for configuration %s: %v", config.ID, err)
+			continue
+		}
+
+		if remove {
+			h.log.Infof("Created job %s removing revoked certificate %s from configuration %s", job.ID, last.CertificateID, config.ID)
+			continue
+		}
+		h.log.Infof("Created job %s replacing revoked certificate %s with %s on configuration %s",
+			job.ID, last.CertificateID, replacement.id, config.ID)
+		if _, err := h.jobRepo.SupersedeQueued(ctx, job, ""); err != nil {
+			h.log.Warnf("Failed to supersede queued jobs for configuration %s: %v", config.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// revocationTarget returns the deployment target whose revocation policy
+// applies to config, and whether it removes revoked certificates. Removal
+// wins over redeployment; configurations of no target redeploy.
+func revocationTarget(config *ent.TargetConfiguration) (*ent.DeploymentTarget, bool) {
+	var target *ent.DeploymentTarget
+	for _, t := range config.Edges.DeploymentTargets {
+		if t.RevocationPolicy == deploymenttarget.RevocationPolicyREVOCATION_POLICY_REMOVE {
+			return t, true
+		}
+		if target == nil {
+			target = t
+		}
+	}
+	return target, false
+}
+
+// findReplacement returns the valid certificate of the tenant named
+// commonName that expires last, other than the revoked one and those revoked
+// before, or nil. It considers ingested certificates and the certificates the
+// tenant deployed recently.
+func (h *Handler) findReplacement(ctx context.Context, tenantID uint32, commonName, revokedID, revokedSerial string, deleted bool) *replacementCertificate {
+	if commonName == "" {
+		return nil
+	}
+
+	now := time.Now()
+	var best *replacementCertificate
+	consider := func(id, serial, name string, expiresAt time.Time) {
+		switch {
+		case !strings.EqualFold(name, commonName), !expiresAt.After(now):
+			return
+		case id == revokedID && (deleted || serial == "" || sameSerial(serial, revokedSerial)):
+			// Without a known serial, the certificate may be the revoked one
+			return
+		case revokedSerial != "" && sameSerial(serial, revokedSerial):
+			return
+		case best != nil && !expiresAt.After(best.expiresAt):
+			return
+		}
+		revoked, err := h.revocations.IsRevoked(ctx, tenantID, id, serial)
+		if err != nil {
+			h.log.Warnf("Skipping certificate %s whose revocation status is unknown: %v", id, err)
+			return
+		}
+		if revoked {
+			return
+		}
+		best = &replacementCertificate{id: id, serial: serial, expiresAt: expiresAt}
+	}
+
+	external := make(map[string]struct{})
+	if h.certRepo != nil {
+		certs, err := h.certRepo.ListByCommonName(ctx, tenantID, commonName)
+		if err != nil {
+			h.log.Warnf("Failed to list ingested certificates named %q: %v", commonName, err)
+		}
+		for _, cert := range certs {
+			external[cert.CertificateID] = struct{}{}
+			if cert.ExpiresAt != nil {
+				consider(cert.CertificateID, cert.SerialNumber, cert.CommonName, *cert.ExpiresAt)
+			}
+		}
+	}
+
+	if h.lcmClient == nil {
+		return best
+	}
+	ids, err := h.jobRepo.RecentCertificateIDs(ctx, tenantID, replacementCandidates)
+	if err != nil {
+		h.log.Warnf("Failed to list recently deployed certificates: %v", err)
+		return best
+	}
+	for _, id := range ids {
+		if _, ok := external[id]; ok || (deleted && id == revokedID) {
+			continue
+		}
+		lookupCtx, cancel := context.WithTimeout(ctx, certificateLookupTimeout)
+		cert, err := h.lcmClient.GetCertificateByJobID(lookupCtx, id, false)
+		cancel()
+		if err != nil || cert.ExpiresAt <= 0 {
+			continue
+		}
+		consider(id, cert.SerialNumber, cert.CommonName, time.Unix(cert.ExpiresAt, 0))
+	}
+	return best
+}
+
+// sameSerial compares certificate serials regardless of case and separators
+func sameSerial(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.NewReplacer(":", "", "-", "", " ", "").Replace(s))
+	}
+	return normalize(a) == normalize(b)
+}
@@ -0,0 +1,161 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entSql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
+)
+
+func TestHandler_RevokedCertificateIsReplacedOrRemovedPerTargetPolicy(t *testing.T) {
+	drv, err := entSql.Open("sqlite3", "file:revocation?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	entClient := entCrud.NewEntClient(client, drv)
+	ctx := caller.NewContext(context.Background(), caller.Identity{PlatformAdmin: true, UserID: 1})
+
+	bctx := bootstrap.NewContextWithParam(context.Background(), nil, nil, log.DefaultLogger)
+	jobRepo := data.NewDeploymentJobRepo(bctx, entClient)
+	certRepo := data.NewExternalCertificateRepo(bctx, entClient)
+	handler := NewHandler(bctx, data.NewDeploymentTargetRepo(bctx, entClient), jobRepo, data.NewRetryPolicies(bctx), nil, certRepo, data.NewCertificateRevocationRepo(bctx, entClient))
+
+	// config-remove and config-redeploy serve the revoked serial, config-other another one
+	deployed := map[string]string{"config-remove": "AA:01", "config-redeploy": "AA:01", "config-other": "BB:02"}
+	for configID, serial := range deployed {
+		client.TargetConfiguration.Create().SetID(configID).SetTenantID(ingestTenant).SetName(configID).
+			SetProviderType("dummy").SetCredentialsEncrypted([]byte("{}")).ExecX(ctx)
+		client.DeploymentJob.Create().SetID("job-" + configID).SetTenantID(ingestTenant).
+			SetTargetConfigurationID(configID).SetCertificateID("lcm-1").SetCertificateSerial(serial).
+			SetCertificateCommonName("www.example.com").
+			SetStatus(deploymentjob.StatusJOB_STATUS_COMPLETED).SetCompletedAt(time.Now()).
+			ExecX(ctx)
+	}
+	client.DeploymentTarget.Create().SetID("target-strict").SetTenantID(ingestTenant).SetName("strict").
+		SetRevocationPolicy(deploymenttarget.RevocationPolicyREVOCATION_POLICY_REMOVE).
+		AddConfigurationIDs("config-remove").ExecX(ctx)
+	client.DeploymentTarget.Create().SetID("target-lenient").SetTenantID(ingestTenant).SetName("lenient").
+		AddConfigurationIDs("config-redeploy").ExecX(ctx)
+
+	// An expired and a valid certificate of the same name
+	for id, expiresAt := range map[string]time.Time{"ci-old": time.Now().Add(-time.Hour), "ci-new": time.Now().Add(24 * time.Hour)} {
+		if _, err := certRepo.Save(ctx, &data.ExternalCertificateInput{
+			TenantID: ingestTenant, CertificateID: id, Source: "ci", SerialNumber: id,
+			CommonName: "WWW.example.com", CertificatePEM: "pem", ExpiresAt: &expiresAt,
+		}); err != nil {
+			t.Fatalf("save certificate: %v", err)
+		}
+	}
+
+	revoked := &CertificateEvent{EventType: "certificate.revoked", TenantID: ingestTenant, CertificateID: "lcm-1", SerialNumber: "AA:01"}
+	for range 2 {
+		if err := handler.HandleCertificateEvent(ctx, revoked); err != nil {
+			t.Fatalf("HandleCertificateEvent: %v", err)
+		}
+	}
+
+	jobs := client.DeploymentJob.Query().
+		Where(deploymentjob.TriggeredByEQ(deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION)).
+		AllX(ctx)
+	if len(jobs) != 2 {
+		t.Fatalf("created %d revocation jobs, want 2", len(jobs))
+	}
+	var removal *ent.DeploymentJob
+	for _, job := range jobs {
+		switch *job.TargetConfigurationID {
+		case "config-remove":
+			removal = job
+			if job.Action != deploymentjob.ActionJOB_ACTION_REMOVE || job.CertificateSerial != "AA:01" || job.CertificateCommonName != "www.example.com" {
+				t.Fatalf("removal job %+v", job)
+			}
+		case "config-redeploy":
+			if job.Action != deploymentjob.ActionJOB_ACTION_DEPLOY || job.CertificateID != "ci-new" {
+				t.Fatalf("redeploy job %+v, want deployment of ci-new", job)
+			}
+		default:
+			t.Fatalf("job for configuration %s not serving the revoked serial", *job.TargetConfigurationID)
+		}
+	}
+
+	// Once removed, the certificate no longer counts as deployed
+	client.DeploymentJob.UpdateOneID(removal.ID).
+		SetStatus(deploymentjob.StatusJOB_STATUS_COMPLETED).SetCompletedAt(time.Now()).ExecX(ctx)
+	if last, err := jobRepo.LastCompleted(ctx, "config-remove"); err != nil || last != nil {
+		t.Fatalf("last completed job after removal: %v, %v", last, err)
+	}
+	serving, err := jobRepo.ListServing(ctx, ingestTenant, "lcm-1", "AA:01")
+	if err != nil || len(serving) != 1 || *serving[0].TargetConfigurationID != "config-redeploy" {
+		t.Fatalf("configurations serving the revoked serial: %v, %v", serving, err)
+	}
+}
+
+func TestHandler_ReplacementSkipsCertificatesRevokedBefore(t *testing.T) {
+	drv, err := entSql.Open("sqlite3", "file:revocation-history?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	entClient := entCrud.NewEntClient(client, drv)
+	ctx := caller.NewContext(context.Background(), caller.Identity{PlatformAdmin: true, UserID: 1})
+
+	bctx := bootstrap.NewContextWithParam(context.Background(), nil, nil, log.DefaultLogger)
+	certRepo := data.NewExternalCertificateRepo(bctx, entClient)
+	handler := NewHandler(bctx, data.NewDeploymentTargetRepo(bctx, entClient), data.NewDeploymentJobRepo(bctx, entClient),
+		data.NewRetryPolicies(bctx), nil, certRepo, data.NewCertificateRevocationRepo(bctx, entClient))
+
+	client.TargetConfiguration.Create().SetID("config-1").SetTenantID(ingestTenant).SetName("config-1").
+		SetProviderType("dummy").SetCredentialsEncrypted([]byte("{}")).ExecX(ctx)
+	client.DeploymentJob.Create().SetID("job-1").SetTenantID(ingestTenant).
+		SetTargetConfigurationID("config-1").SetCertificateID("lcm-1").SetCertificateSerial("AA:01").
+		SetCertificateCommonName("www.example.com").
+		SetStatus(deploymentjob.StatusJOB_STATUS_COMPLETED).SetCompletedAt(time.Now()).
+		ExecX(ctx)
+
+	// ci-revoked expires last but was revoked before, while deployed nowhere
+	for id, expiresAt := range map[string]time.Time{"ci-valid": time.Now().Add(24 * time.Hour), "ci-revoked": time.Now().Add(48 * time.Hour)} {
+		if _, err := certRepo.Save(ctx, &data.ExternalCertificateInput{
+			TenantID: ingestTenant, CertificateID: id, Source: "ci", SerialNumber: "CC:" + id,
+			CommonName: "www.example.com", CertificatePEM: "pem", ExpiresAt: &expiresAt,
+		}); err != nil {
+			t.Fatalf("save certificate: %v", err)
+		}
+	}
+	if err := handler.HandleCertificateEvent(ctx, &CertificateEvent{
+		EventType: "certificate.revoked", TenantID: ingestTenant, CertificateID: "ci-revoked", SerialNumber: "CC:ci-revoked",
+	}); err != nil {
+		t.Fatalf("HandleCertificateEvent: %v", err)
+	}
+
+	if err := handler.HandleCertificateEvent(ctx, &CertificateEvent{
+		EventType: "certificate.revoked", TenantID: ingestTenant, CertificateID: "lcm-1", SerialNumber: "AA:01",
+	}); err != nil {
+		t.Fatalf("HandleCertificateEvent: %v", err)
+	}
+
+	jobs := client.DeploymentJob.Query().
+		Where(deploymentjob.TriggeredByEQ(deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION)).
+		AllX(ctx)
+	if len(jobs) != 1 || jobs[0].CertificateID != "ci-valid" {
+		t.Fatalf("revocation jobs %v, want one deploying ci-valid", jobs)
+	}
+}
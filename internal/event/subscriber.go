@@ -64,6 +64,17 @@ type RenewalCompletedData struct {
 	IssuerType      string    `json:"issuer_type,omitempty"`
 }
 
+// CertificateRevokedData represents the data field for certificate.revoked
+// and certificate.deleted events
+type CertificateRevokedData struct {
+	CertificateID string   `json:"certificate_id"`
+	TenantID      uint32   `json:"tenant_id"`
+	SerialNumber  string   `json:"serial_number,omitempty"`
+	CommonName    string   `json:"common_name,omitempty"`
+	DNSNames      []string `json:"dns_names,omitempty"`
+	Reason        string   `json:"reason,omitempty"`
+}
+
 // CertificateEvent represents the normalized certificate event for handler
 type CertificateEvent struct {
 	EventType      string   `json:"event_type"`
@@ -77,11 +88,15 @@ type CertificateEvent struct {
 	NotAfter       int64    `json:"not_after,omitempty"`
 	IsRenewal      bool     `json:"is_renewal,omitempty"`
 	PreviousCertID string   `json:"previous_certificate_id,omitempty"`
+	Reason         string   `json:"reason,omitempty"`
 
 	// Subject fields
 	SubjectOrganization string `json:"subject_organization,omitempty"`
 	SubjectOrgUnit      string `json:"subject_org_unit,omitempty"`
 	SubjectCountry      string `json:"subject_country,omitempty"`
+
+	// Key of the event; jobs of an event with a key are created only once
+	IdempotencyKey string `json:"-"`
 }
 
 // Subscriber handles Redis pub/sub event subscriptions
@@ -115,6 +130,8 @@ func NewSubscriber(ctx *bootstrap.Context, rdb *redis.Client, handler *Handler)
 			SubscribeEvents: []string{
 				"certificate.issued",
 				"renewal.completed",
+				"certificate.revoked",
+				"certificate.deleted",
 			},
 		}
 	}
@@ -216,10 +233,10 @@ func (s *Subscriber) listen(pubsub *redis.PubSub) {
 func (s *Subscriber) handleMessage(msg *redis.Message) {
 	s.log.Infof("Received event on channel %s: %s", msg.Channel, msg.Payload)
 
-	// Parse the LCM event wrapper
-	var lcmEvent LCMEvent
-	if err := json.Unmarshal([]byte(msg.Payload), &lcmEvent); err != nil {
-		s.log.Errorf("Failed to unmarshal LCM event: %v", err)
+	// Parse the LCM event wrapper, or a CloudEvent carrying the same data
+	lcmEvent, err := DecodeEnvelope([]byte(msg.Payload))
+	if err != nil {
+		s.log.Errorf("Failed to unmarshal event: %v", err)
 		return
 	}
 
@@ -230,7 +247,7 @@ func (s *Subscriber) handleMessage(msg *redis.Message) {
 	// timestamps as Unix seconds, etc.) so convertToCertificateEvent below
 	// throws "Time.UnmarshalJSON: input is not a JSON string". The events
 	// are not meant for this subscriber — bail out silently.
-	if lcmEvent.Source == "deployer-service" {
+	if lcmEvent.Source == SourceDeployer {
 		return
 	}
 
@@ -245,7 +262,7 @@ func (s *Subscriber) handleMessage(msg *redis.Message) {
 	}
 
 	// Convert LCM event to CertificateEvent based on event type
-	certEvent, err := s.convertToCertificateEvent(eventType, &lcmEvent)
+	certEvent, err := s.convertToCertificateEvent(eventType, lcmEvent)
 	if err != nil {
 		s.log.Errorf("Failed to convert event: %v", err)
 		return
@@ -305,6 +322,25 @@ func (s *Subscriber) convertToCertificateEvent(eventType string, lcmEvent *LCMEv
 			PreviousCertID:      data.CertificateID,
 		}, nil
 
+	case "certificate.revoked", "certificate.deleted":
+		var data CertificateRevokedData
+		if err := json.Unmarshal(lcmEvent.Data, &data); err != nil {
+			return nil, fmt.Errorf("failed to parse %s data: %w", eventType, err)
+		}
+		tenantID := data.TenantID
+		if tenantID == 0 {
+			tenantID = lcmEvent.TenantID
+		}
+		return &CertificateEvent{
+			EventType:     eventType,
+			TenantID:      tenantID,
+			CertificateID: data.CertificateID,
+			SerialNumber:  data.SerialNumber,
+			CommonName:    data.CommonName,
+			SANs:          data.DNSNames,
+			Reason:        data.Reason,
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown event type: %s", eventType)
 	}
@@ -3,6 +3,8 @@ package metrics
 import (
 	"context"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -10,11 +12,18 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	commonMetrics "github.com/go-tangra/go-tangra-common/metrics"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
 )
 
 const namespace = "tangra"
 const subsystem = "deployer"
 
+// expiryLabels label the deployed certificate expiry gauges. A configuration
+// in several deployment targets has a series per target; one in none has an
+// empty target.
+var expiryLabels = []string{"tenant_id", "target", "configuration", "provider_type"}
+
 // Collector holds all Prometheus metrics for the deployer module.
 type Collector struct {
 	log    *log.Helper
@@ -24,12 +33,25 @@ type Collector struct {
 	JobsByStatus  *prometheus.GaugeVec
 	JobsByTrigger *prometheus.GaugeVec
 
+	// Deployment failures by provider and error category
+	DeploymentFailures *prometheus.CounterVec
+
 	// Target metrics
 	TargetsTotal             prometheus.Gauge
 	TargetsAutoDeployEnabled prometheus.Gauge
 
 	// Configuration metrics
 	ConfigurationsByStatus *prometheus.GaugeVec
+	CredentialChecks       *prometheus.CounterVec
+	DriftDetected          *prometheus.CounterVec
+
+	// Deployed certificate expiry metrics
+	DeployedCertificateExpiryDays      *prometheus.GaugeVec
+	DeployedCertificateExpiryTimestamp *prometheus.GaugeVec
+
+	// Audit log metrics
+	AuditIntegrityFindings *prometheus.GaugeVec
+	AuditEntriesArchived   prometheus.Counter
 
 	// gRPC request metrics
 	RequestDuration *prometheus.HistogramVec
@@ -55,6 +77,13 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 			Help:      "Number of deployment jobs by trigger type.",
 		}, []string{"trigger_type"}),
 
+		DeploymentFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "deployment_failures_total",
+			Help:      "Total number of failed deployment attempts by provider type and error category.",
+		}, []string{"provider_type", "category", "retried"}),
+
 		TargetsTotal: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -76,6 +105,48 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 			Help:      "Number of target configurations by status.",
 		}, []string{"status"}),
 
+		CredentialChecks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "credential_checks_total",
+			Help:      "Total number of scheduled credential health checks by provider type and result (valid or the error category).",
+		}, []string{"provider_type", "result"}),
+
+		DriftDetected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "drift_detected_total",
+			Help:      "Total number of configurations found serving another certificate than last deployed, by provider type and check.",
+		}, []string{"provider_type", "source"}),
+
+		DeployedCertificateExpiryDays: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "deployed_certificate_expiry_days",
+			Help:      "Days until the certificate of the last successful deployment to a configuration expires; negative once expired.",
+		}, expiryLabels),
+
+		DeployedCertificateExpiryTimestamp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "deployed_certificate_expiry_timestamp_seconds",
+			Help:      "Unix time at which the certificate of the last successful deployment to a configuration expires.",
+		}, expiryLabels),
+
+		AuditIntegrityFindings: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "audit_integrity_findings",
+			Help:      "Audit log integrity findings from the last background verification, by kind.",
+		}, []string{"kind"}),
+
+		AuditEntriesArchived: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "audit_entries_archived_total",
+			Help:      "Total number of audit log entries archived and removed by retention.",
+		}),
+
 		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -95,9 +166,16 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 	prometheus.MustRegister(
 		c.JobsByStatus,
 		c.JobsByTrigger,
+		c.DeploymentFailures,
 		c.TargetsTotal,
 		c.TargetsAutoDeployEnabled,
 		c.ConfigurationsByStatus,
+		c.CredentialChecks,
+		c.DriftDetected,
+		c.DeployedCertificateExpiryDays,
+		c.DeployedCertificateExpiryTimestamp,
+		c.AuditIntegrityFindings,
+		c.AuditEntriesArchived,
 		c.RequestDuration,
 		c.RequestsTotal,
 	)
@@ -149,6 +227,11 @@ func (c *Collector) JobStatusChanged(oldStatus, newStatus string) {
 	c.JobsByStatus.WithLabelValues(newStatus).Inc()
 }
 
+// DeploymentFailed counts a failed deployment attempt and whether it will be retried.
+func (c *Collector) DeploymentFailed(providerType, category string, retried bool) {
+	c.DeploymentFailures.WithLabelValues(providerType, category, strconv.FormatBool(retried)).Inc()
+}
+
 // --- Target helpers ---
 
 // TargetCreated increments the target counters.
@@ -184,3 +267,62 @@ func (c *Collector) ConfigStatusChanged(oldStatus, newStatus string) {
 	c.ConfigurationsByStatus.WithLabelValues(oldStatus).Dec()
 	c.ConfigurationsByStatus.WithLabelValues(newStatus).Inc()
 }
+
+// CredentialChecked counts a credential health check; result is "valid" or
+// the error category of the failure.
+func (c *Collector) CredentialChecked(providerType, result string) {
+	c.CredentialChecks.WithLabelValues(providerType, result).Inc()
+}
+
+// DriftFound counts a newly detected drift; source is "verify" or "tls_probe".
+func (c *Collector) DriftFound(providerType, source string) {
+	c.DriftDetected.WithLabelValues(providerType, source).Inc()
+}
+
+// --- Expiry helpers ---
+
+// DeployedCertificateExpiries replaces the expiry gauges with the deployed
+// certificates of configs, which must have their deployment targets loaded.
+func (c *Collector) DeployedCertificateExpiries(configs []*data.TargetConfiguration, now time.Time) {
+	c.DeployedCertificateExpiryDays.Reset()
+	c.DeployedCertificateExpiryTimestamp.Reset()
+
+	for _, config := range configs {
+		if config.DeployedCertificateExpiresAt == nil {
+			continue
+		}
+		expiresAt := *config.DeployedCertificateExpiresAt
+		days := expiresAt.Sub(now).Hours() / 24
+
+		var tenantID string
+		if config.TenantID != nil {
+			tenantID = strconv.FormatUint(uint64(*config.TenantID), 10)
+		}
+		targets := []string{""}
+		if len(config.Edges.DeploymentTargets) > 0 {
+			targets = targets[:0]
+			for _, target := range config.Edges.DeploymentTargets {
+				targets = append(targets, target.Name)
+			}
+		}
+
+		for _, target := range targets {
+			c.DeployedCertificateExpiryDays.WithLabelValues(tenantID, target, config.Name, config.ProviderType).Set(days)
+			c.DeployedCertificateExpiryTimestamp.WithLabelValues(tenantID, target, config.Name, config.ProviderType).Set(float64(expiresAt.Unix()))
+		}
+	}
+}
+
+// --- Audit helpers ---
+
+// AuditVerified records the outcome of a background audit log verification.
+func (c *Collector) AuditVerified(tampered, unsigned, missing uint64) {
+	c.AuditIntegrityFindings.WithLabelValues("tampered").Set(float64(tampered))
+	c.AuditIntegrityFindings.WithLabelValues("unsigned").Set(float64(unsigned))
+	c.AuditIntegrityFindings.WithLabelValues("missing").Set(float64(missing))
+}
+
+// AuditArchived counts audit log entries removed by retention.
+func (c *Collector) AuditArchived(count int) {
+	c.AuditEntriesArchived.Add(float64(count))
+}
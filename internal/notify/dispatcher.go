@@ -0,0 +1,178 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DispatcherOptions tune digesting and retries
+type DispatcherOptions struct {
+	// DigestWindow collects events for a destination before sending them as
+	// one message; zero sends each event on its own
+	DigestWindow time.Duration
+	// MaxAttempts bounds deliveries of one message, at least 1
+	MaxAttempts int
+	// RetryDelay is the first backoff between attempts, doubled each time
+	RetryDelay time.Duration
+	// SendTimeout bounds a single delivery attempt
+	SendTimeout time.Duration
+}
+
+// Dispatcher digests events per key and delivers them over channels
+type Dispatcher struct {
+	log      *log.Helper
+	channels map[string]Channel
+	renderer *Renderer
+	opts     DispatcherOptions
+
+	mu      sync.Mutex
+	pending map[string]*batch
+	closed  bool
+	wg      sync.WaitGroup
+	// stop aborts retry backoffs on Close
+	stop chan struct{}
+}
+
+// batch is the events buffered for one key during a digest window
+type batch struct {
+	dest   *Destination
+	events []*Event
+	timer  *time.Timer
+}
+
+// NewDispatcher creates a dispatcher over channels keyed by channel type
+func NewDispatcher(logger *log.Helper, channels map[string]Channel, renderer *Renderer, opts DispatcherOptions) *Dispatcher {
+	if opts.MaxAttempts < 1 {
+		opts.MaxAttempts = 1
+	}
+	if opts.SendTimeout <= 0 {
+		opts.SendTimeout = 30 * time.Second
+	}
+	return &Dispatcher{
+		log:      logger,
+		channels: channels,
+		renderer: renderer,
+		opts:     opts,
+		pending:  make(map[string]*batch),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Enqueue buffers event for the destination identified by key. Events of a key
+// arriving within the digest window are delivered together; dest of the first
+// event of a window is used.
+func (d *Dispatcher) Enqueue(key string, dest *Destination, event *Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+
+	if b, ok := d.pending[key]; ok {
+		b.events = append(b.events, event)
+		return
+	}
+
+	b := &batch{dest: dest, events: []*Event{event}}
+	d.pending[key] = b
+	d.wg.Add(1)
+	if d.opts.DigestWindow <= 0 {
+		go d.flush(key, b)
+		return
+	}
+	b.timer = time.AfterFunc(d.opts.DigestWindow, func() { d.flush(key, b) })
+}
+
+// flush delivers the events of b, once
+func (d *Dispatcher) flush(key string, b *batch) {
+	defer d.wg.Done()
+
+	d.mu.Lock()
+	if d.pending[key] == b {
+		delete(d.pending, key)
+	}
+	events := b.events
+	d.mu.Unlock()
+
+	msg, err := d.renderer.Render(events)
+	if err != nil {
+		d.log.Errorf("notification %s: %v", key, err)
+		return
+	}
+	if err := d.deliver(b.dest, msg); err != nil {
+		d.log.Errorf("notification %s of %d events not delivered: %v", key, len(events), err)
+	}
+}
+
+// deliver sends msg, retrying transient failures with backoff
+func (d *Dispatcher) deliver(dest *Destination, msg *Message) error {
+	delay := d.opts.RetryDelay
+	var err error
+	for attempt := 1; attempt <= d.opts.MaxAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), d.opts.SendTimeout)
+		err = d.send(ctx, dest, msg)
+		cancel()
+		if err == nil || IsPermanent(err) || attempt == d.opts.MaxAttempts {
+			break
+		}
+
+		d.log.Warnf("notification attempt %d/%d failed, retrying in %s: %v", attempt, d.opts.MaxAttempts, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-d.stop:
+			return fmt.Errorf("shutting down after attempt %d: %w", attempt, err)
+		}
+		delay *= 2
+	}
+	return err
+}
+
+// send delivers msg once over the channel of dest
+func (d *Dispatcher) send(ctx context.Context, dest *Destination, msg *Message) error {
+	ch, ok := d.channels[dest.Channel]
+	if !ok {
+		return permanent("channel %q is not available", dest.Channel)
+	}
+	return ch.Send(ctx, dest, msg)
+}
+
+// Send renders events and delivers them immediately, without digesting or
+// retries, returning the delivery error
+func (d *Dispatcher) Send(ctx context.Context, dest *Destination, events ...*Event) error {
+	msg, err := d.renderer.Render(events)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, d.opts.SendTimeout)
+	defer cancel()
+	return d.send(ctx, dest, msg)
+}
+
+// Close flushes pending digests, ends retries after their current attempt and
+// waits for deliveries in flight
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return
+	}
+	d.closed = true
+	close(d.stop)
+	// Batches whose timer already fired are being flushed
+	due := make(map[string]*batch)
+	for key, b := range d.pending {
+		if b.timer != nil && b.timer.Stop() {
+			due[key] = b
+		}
+	}
+	d.mu.Unlock()
+
+	for key, b := range due {
+		go d.flush(key, b)
+	}
+	d.wg.Wait()
+}
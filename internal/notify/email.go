@@ -0,0 +1,140 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPConfig is the mail server email notifications are sent through
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// RequireTLS refuses servers that do not offer STARTTLS
+	RequireTLS bool
+}
+
+// EmailChannel sends notifications as plain text mail
+type EmailChannel struct {
+	cfg SMTPConfig
+}
+
+// NewEmailChannel creates an email channel; Port defaults to 587
+func NewEmailChannel(cfg SMTPConfig) *EmailChannel {
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &EmailChannel{cfg: cfg}
+}
+
+// Send mails msg to the recipients of dest
+func (c *EmailChannel) Send(ctx context.Context, dest *Destination, msg *Message) error {
+	if c.cfg.Host == "" || c.cfg.From == "" {
+		return permanent("email notifications need an SMTP host and sender")
+	}
+	if len(dest.Recipients) == 0 {
+		return permanent("no email recipients")
+	}
+	from, err := mail.ParseAddress(c.cfg.From)
+	if err != nil {
+		return permanent("invalid sender %q: %v", c.cfg.From, err)
+	}
+	recipients := make([]string, 0, len(dest.Recipients))
+	for _, r := range dest.Recipients {
+		addr, err := mail.ParseAddress(r)
+		if err != nil {
+			return permanent("invalid recipient %q: %v", r, err)
+		}
+		recipients = append(recipients, addr.Address)
+	}
+
+	addr := net.JoinHostPort(c.cfg.Host, strconv.Itoa(c.cfg.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connect to %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.cfg.Host}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	} else if c.cfg.RequireTLS {
+		return permanent("%s does not offer STARTTLS", addr)
+	}
+	if c.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, c.cfg.Host)); err != nil {
+			return smtpError("authenticate", err)
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return smtpError("sender", err)
+	}
+	for _, r := range recipients {
+		if err := client.Rcpt(r); err != nil {
+			return smtpError("recipient "+r, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return smtpError("data", err)
+	}
+	if _, err := w.Write(c.compose(from, recipients, msg)); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return smtpError("data", err)
+	}
+	return client.Quit()
+}
+
+// compose builds the RFC 5322 message
+func (c *EmailChannel) compose(from *mail.Address, recipients []string, msg *Message) []byte {
+	var b bytes.Buffer
+	header := func(k, v string) {
+		b.WriteString(k + ": " + v + "\r\n")
+	}
+	header("From", from.String())
+	header("To", strings.Join(recipients, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Text, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// smtpError marks rejections with a permanent (5xx) reply as permanent
+func smtpError(step string, err error) error {
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) && tpErr.Code >= 500 {
+		return permanent("%s: %v", step, err)
+	}
+	return fmt.Errorf("%s: %w", step, err)
+}
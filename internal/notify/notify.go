@@ -0,0 +1,92 @@
+// Package notify delivers notifications about deployment job outcomes over
+// pluggable channels: SMTP email, Slack/Teams-compatible incoming webhooks and
+// a generic HTTP JSON endpoint.
+//
+// Events are buffered per destination for a digest window, so that a burst of
+// failures becomes one message listing them instead of one message each, and
+// deliveries are retried with backoff.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Channel types
+const (
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+	ChannelHTTP    = "http"
+)
+
+// Event is a job status change worth notifying about
+type Event struct {
+	JobID             string    `json:"job_id"`
+	ParentJobID       string    `json:"parent_job_id,omitempty"`
+	TenantID          uint32    `json:"tenant_id"`
+	Status            string    `json:"status"`
+	PreviousStatus    string    `json:"previous_status,omitempty"`
+	Message           string    `json:"message,omitempty"`
+	ErrorCategory     string    `json:"error_category,omitempty"`
+	TargetID          string    `json:"target_id,omitempty"`
+	TargetName        string    `json:"target_name,omitempty"`
+	ConfigurationID   string    `json:"configuration_id,omitempty"`
+	ConfigurationName string    `json:"configuration_name,omitempty"`
+	ProviderType      string    `json:"provider_type,omitempty"`
+	CertificateID     string    `json:"certificate_id,omitempty"`
+	CertificateSerial string    `json:"certificate_serial,omitempty"`
+	CommonName        string    `json:"common_name,omitempty"`
+	OccurredAt        time.Time `json:"occurred_at"`
+}
+
+// Destination is where a channel delivers to
+type Destination struct {
+	Channel string
+	// Recipients of email
+	Recipients []string
+	// URL of webhooks and HTTP endpoints
+	URL string
+	// Headers added to HTTP requests, e.g. for authentication
+	Headers map[string]string
+}
+
+// Message is a rendered notification about one or more events
+type Message struct {
+	Subject string
+	Text    string
+	Events  []*Event
+	// Omitted counts events of a digest left out of Events
+	Omitted int
+}
+
+// Channel delivers messages to destinations of one type
+type Channel interface {
+	Send(ctx context.Context, dest *Destination, msg *Message) error
+}
+
+// PermanentError reports a delivery that fails the same way when retried,
+// e.g. a rejected recipient or a webhook URL that no longer exists
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// permanent wraps err as a PermanentError
+func permanent(format string, args ...any) error {
+	return &PermanentError{Err: fmt.Errorf(format, args...)}
+}
+
+// IsPermanent reports whether retrying err is pointless
+func IsPermanent(err error) bool {
+	var pe *PermanentError
+	return errors.As(err, &pe)
+}
@@ -0,0 +1,290 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// smtpStandIn is a minimal SMTP server capturing the mail it receives
+type smtpStandIn struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	rcpt []string
+	data []string
+	// rejectRcpt answers RCPT TO with a permanent failure
+	rejectRcpt atomic.Bool
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &smtpStandIn{ln: ln}
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStandIn) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStandIn) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("220 stand-in ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 stand-in")
+		case strings.HasPrefix(cmd, "MAIL FROM"):
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO"):
+			if s.rejectRcpt.Load() {
+				reply("550 no such user")
+				continue
+			}
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, strings.TrimSpace(line[len("RCPT TO:"):]))
+			s.mu.Unlock()
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var b strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				b.WriteString(l)
+			}
+			s.mu.Lock()
+			s.data = append(s.data, b.String())
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func (s *smtpStandIn) mails() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.data...)
+}
+
+func failedEvent(job string) *Event {
+	return &Event{
+		JobID:             job,
+		TenantID:          1,
+		Status:            "JOB_STATUS_FAILED",
+		PreviousStatus:    "JOB_STATUS_PROCESSING",
+		Message:           "connection refused",
+		ErrorCategory:     "transient",
+		TargetID:          "target-1",
+		TargetName:        "edge",
+		ConfigurationID:   "config-1",
+		ConfigurationName: "cdn",
+		ProviderType:      "dummy",
+		CertificateID:     "cert-1",
+		CommonName:        "www.example.com",
+		OccurredAt:        time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func testRenderer(t *testing.T) *Renderer {
+	t.Helper()
+	r, err := NewRenderer("", "")
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+	return r
+}
+
+func TestEmailChannel_SendsToStandIn(t *testing.T) {
+	server := newSMTPStandIn(t)
+	ch := NewEmailChannel(SMTPConfig{Host: "127.0.0.1", Port: server.port(), From: "Deployer <deployer@example.com>"})
+
+	msg, err := testRenderer(t).Render([]*Event{failedEvent("job-1")})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	dest := &Destination{Channel: ChannelEmail, Recipients: []string{"ops@example.com"}}
+	if err := ch.Send(context.Background(), dest, msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	mails := server.mails()
+	if len(mails) != 1 {
+		t.Fatalf("stand-in received %d mails, want 1", len(mails))
+	}
+	for _, want := range []string{"Subject: [deployer] Job failed: cdn", "Target: edge (target-1)", "Certificate: www.example.com (cert-1)", "Error (transient): connection refused"} {
+		if !strings.Contains(mails[0], want) {
+			t.Errorf("mail lacks %q:\n%s", want, mails[0])
+		}
+	}
+
+	server.rejectRcpt.Store(true)
+	if err := ch.Send(context.Background(), dest, msg); !IsPermanent(err) {
+		t.Fatalf("rejected recipient: %v, want a permanent error", err)
+	}
+}
+
+func TestWebhookAndHTTPChannels(t *testing.T) {
+	var got []map[string]any
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" && r.URL.Path == "/http" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		got = append(got, body)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	msg, err := testRenderer(t).Render([]*Event{failedEvent("job-1")})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if err := NewWebhookChannel(nil).Send(context.Background(), &Destination{URL: srv.URL + "/hook"}, msg); err != nil {
+		t.Fatalf("webhook: %v", err)
+	}
+	dest := &Destination{URL: srv.URL + "/http", Headers: map[string]string{"Authorization": "Bearer token"}}
+	if err := NewHTTPChannel(nil).Send(context.Background(), dest, msg); err != nil {
+		t.Fatalf("http: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("stand-in received %d posts, want 2", len(got))
+	}
+	if text, _ := got[0]["text"].(string); !strings.Contains(text, "connection refused") {
+		t.Errorf("webhook text %q lacks the error", text)
+	}
+	events, _ := got[1]["events"].([]any)
+	if len(events) != 1 || events[0].(map[string]any)["common_name"] != "www.example.com" {
+		t.Errorf("http events %v, want the failed job", got[1]["events"])
+	}
+
+	if err := NewHTTPChannel(nil).Send(context.Background(), &Destination{URL: srv.URL + "/gone"}, msg); !IsPermanent(err) {
+		t.Fatalf("404: %v, want a permanent error", err)
+	}
+}
+
+// recordingChannel fails the first sends, as many as failures, then records
+// messages
+type recordingChannel struct {
+	mu       sync.Mutex
+	failures int32
+	attempts atomic.Int32
+	msgs     []*Message
+}
+
+func (c *recordingChannel) Send(_ context.Context, _ *Destination, msg *Message) error {
+	if c.attempts.Add(1) <= c.failures {
+		return io.ErrUnexpectedEOF
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.msgs = append(c.msgs, msg)
+	return nil
+}
+
+func (c *recordingChannel) messages() []*Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Message(nil), c.msgs...)
+}
+
+func TestDispatcher_DigestsAndRetries(t *testing.T) {
+	ch := &recordingChannel{failures: 2}
+	d := NewDispatcher(log.NewHelper(log.DefaultLogger), map[string]Channel{ChannelHTTP: ch}, testRenderer(t), DispatcherOptions{
+		DigestWindow: 50 * time.Millisecond,
+		MaxAttempts:  3,
+		RetryDelay:   time.Millisecond,
+	})
+	dest := &Destination{Channel: ChannelHTTP}
+
+	for i := 0; i < 25; i++ {
+		d.Enqueue("rule:1", dest, failedEvent("job"))
+	}
+	d.Enqueue("rule:2", dest, failedEvent("other"))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(ch.messages()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	d.Close()
+
+	msgs := ch.messages()
+	if len(msgs) != 2 {
+		t.Fatalf("delivered %d messages, want one digest per rule", len(msgs))
+	}
+	var digest *Message
+	for _, m := range msgs {
+		if len(m.Events)+m.Omitted == 25 {
+			digest = m
+		}
+	}
+	if digest == nil || digest.Omitted != 5 || !strings.Contains(digest.Subject, "25 job updates") || !strings.Contains(digest.Text, "and 5 more") {
+		t.Fatalf("digest of 25 events not capped: %+v", msgs)
+	}
+	if n := ch.attempts.Load(); n != 4 {
+		t.Fatalf("%d attempts, want 2 failures retried plus 2 deliveries", n)
+	}
+}
+
+func TestDispatcher_CloseFlushesPending(t *testing.T) {
+	ch := &recordingChannel{}
+	d := NewDispatcher(log.NewHelper(log.DefaultLogger), map[string]Channel{ChannelHTTP: ch}, testRenderer(t), DispatcherOptions{DigestWindow: time.Hour})
+	d.Enqueue("rule:1", &Destination{Channel: ChannelHTTP}, failedEvent("job-1"))
+	d.Close()
+
+	if n := len(ch.messages()); n != 1 {
+		t.Fatalf("Close delivered %d messages, want the pending digest", n)
+	}
+	d.Enqueue("rule:1", &Destination{Channel: ChannelHTTP}, failedEvent("job-2"))
+}
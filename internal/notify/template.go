@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// maxDigestEvents bounds the events listed in one message
+const maxDigestEvents = 20
+
+// DefaultSubjectTemplate is the subject of messages unless configured otherwise
+const DefaultSubjectTemplate = `{{if eq (len .Events) 1}}{{with index .Events 0}}[deployer] Job {{status .Status}}: {{or .ConfigurationName .TargetName .JobID}}{{end}}{{else}}[deployer] {{total .}} job updates{{end}}`
+
+// DefaultBodyTemplate is the text of messages unless configured otherwise
+const DefaultBodyTemplate = `{{range .Events}}Job {{.JobID}} {{status .Status}}{{if .PreviousStatus}} (was {{status .PreviousStatus}}){{end}} at {{.OccurredAt.UTC.Format "2006-01-02 15:04:05 UTC"}}
+{{- if .TargetID}}
+Target: {{or .TargetName .TargetID}} ({{.TargetID}}){{end}}
+{{- if .ConfigurationID}}
+Configuration: {{or .ConfigurationName .ConfigurationID}} ({{.ProviderType}}){{end}}
+{{- if .CertificateID}}
+Certificate: {{or .CommonName "unknown CN"}} ({{.CertificateID}}{{if .CertificateSerial}}, serial {{.CertificateSerial}}{{end}}){{end}}
+{{- if .Message}}
+{{if .ErrorCategory}}Error ({{.ErrorCategory}}){{else}}Message{{end}}: {{.Message}}{{end}}
+
+{{end}}{{if .Omitted}}... and {{.Omitted}} more
+{{end}}`
+
+// Renderer renders events into messages with text templates
+type Renderer struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+var templateFuncs = template.FuncMap{
+	// status turns JOB_STATUS_FAILED into "failed"
+	"status": func(s string) string {
+		return strings.ToLower(strings.TrimPrefix(s, "JOB_STATUS_"))
+	},
+	"total": func(m *Message) int {
+		return len(m.Events) + m.Omitted
+	},
+}
+
+// NewRenderer parses the subject and body templates; empty ones fall back to
+// the defaults. Templates see a Message.
+func NewRenderer(subject, body string) (*Renderer, error) {
+	if subject == "" {
+		subject = DefaultSubjectTemplate
+	}
+	if body == "" {
+		body = DefaultBodyTemplate
+	}
+
+	subjectTmpl, err := template.New("subject").Funcs(templateFuncs).Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("parse subject template: %w", err)
+	}
+	bodyTmpl, err := template.New("body").Funcs(templateFuncs).Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("parse body template: %w", err)
+	}
+	return &Renderer{subject: subjectTmpl, body: bodyTmpl}, nil
+}
+
+// Render renders a message about events, listing at most maxDigestEvents
+func (r *Renderer) Render(events []*Event) (*Message, error) {
+	msg := &Message{Events: events}
+	if len(events) > maxDigestEvents {
+		msg.Events = events[:maxDigestEvents]
+		msg.Omitted = len(events) - maxDigestEvents
+	}
+
+	var subject, body bytes.Buffer
+	if err := r.subject.Execute(&subject, msg); err != nil {
+		return nil, fmt.Errorf("render subject: %w", err)
+	}
+	if err := r.body.Execute(&body, msg); err != nil {
+		return nil, fmt.Errorf("render body: %w", err)
+	}
+
+	// Subjects become mail headers
+	msg.Subject = strings.Join(strings.Fields(subject.String()), " ")
+	msg.Text = strings.TrimRight(body.String(), "\n") + "\n"
+	return msg, nil
+}
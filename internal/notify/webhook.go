@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WebhookChannel posts messages to Slack or Teams compatible incoming
+// webhooks, which both render the "text" field of a JSON payload
+type WebhookChannel struct {
+	client *http.Client
+}
+
+// NewWebhookChannel creates a webhook channel
+func NewWebhookChannel(client *http.Client) *WebhookChannel {
+	return &WebhookChannel{client: defaultClient(client)}
+}
+
+// Send posts msg to the webhook URL of dest
+func (c *WebhookChannel) Send(ctx context.Context, dest *Destination, msg *Message) error {
+	return postJSON(ctx, c.client, dest, map[string]any{
+		"text": "*" + msg.Subject + "*\n" + msg.Text,
+	})
+}
+
+// HTTPChannel posts the events of a message as JSON to any endpoint
+type HTTPChannel struct {
+	client *http.Client
+}
+
+// NewHTTPChannel creates a generic HTTP JSON channel
+func NewHTTPChannel(client *http.Client) *HTTPChannel {
+	return &HTTPChannel{client: defaultClient(client)}
+}
+
+// Send posts msg to the URL of dest
+func (c *HTTPChannel) Send(ctx context.Context, dest *Destination, msg *Message) error {
+	return postJSON(ctx, c.client, dest, map[string]any{
+		"subject": msg.Subject,
+		"text":    msg.Text,
+		"events":  msg.Events,
+		"omitted": msg.Omitted,
+	})
+}
+
+func defaultClient(client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return client
+}
+
+// postJSON posts payload to dest. Client errors other than rate limiting are
+// permanent.
+func postJSON(ctx context.Context, client *http.Client, dest *Destination, payload any) error {
+	if dest.URL == "" {
+		return permanent("no URL")
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return permanent("encode payload: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dest.URL, bytes.NewReader(body))
+	if err != nil {
+		return permanent("invalid URL: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range dest.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusRequestTimeout {
+		return permanent("endpoint answered %s: %s", resp.Status, bytes.TrimSpace(respBody))
+	}
+	return fmt.Errorf("endpoint answered %s: %s", resp.Status, bytes.TrimSpace(respBody))
+}
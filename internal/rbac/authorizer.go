@@ -0,0 +1,162 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// BindingSource looks up the role bindings of a caller
+type BindingSource interface {
+	// MatchBindings returns the bindings in tenantID granted to any of subjects
+	MatchBindings(ctx context.Context, tenantID uint32, subjects []Subject) ([]Binding, error)
+}
+
+// JobTargetSource resolves the deployment target of a deployment job
+type JobTargetSource interface {
+	// JobTargetID returns the job's deployment target ID, or "" when the job
+	// does not belong to a target
+	JobTargetID(ctx context.Context, jobID string) (string, error)
+}
+
+// ConfigurationTargetSource resolves the deployment targets of a target configuration
+type ConfigurationTargetSource interface {
+	// ConfigurationTargetIDs returns the IDs of the deployment targets the
+	// configuration belongs to; none when it belongs to no target
+	ConfigurationTargetIDs(ctx context.Context, configurationID string) ([]string, error)
+}
+
+// Options configures an Authorizer
+type Options struct {
+	// Enabled turns on enforcement; when false every caller holds every permission in its tenant
+	Enabled bool
+	// DefaultRole is granted tenant-wide to callers without any binding; empty for no access
+	DefaultRole Role
+}
+
+// Authorizer decides which RPCs a caller may invoke
+type Authorizer struct {
+	opts           Options
+	bindings       BindingSource
+	jobs           JobTargetSource
+	configurations ConfigurationTargetSource
+	log            *log.Helper
+}
+
+// NewAuthorizer creates an authorizer
+func NewAuthorizer(opts Options, bindings BindingSource, jobs JobTargetSource,
+	configurations ConfigurationTargetSource, logger log.Logger) (*Authorizer, error) {
+	if opts.DefaultRole != "" {
+		if _, ok := ParseRole(string(opts.DefaultRole)); !ok {
+			return nil, fmt.Errorf("rbac: unknown default role %q", opts.DefaultRole)
+		}
+	}
+	return &Authorizer{
+		opts:           opts,
+		bindings:       bindings,
+		jobs:           jobs,
+		configurations: configurations,
+		log:            log.NewHelper(log.With(logger, "module", "rbac")),
+	}, nil
+}
+
+// Enabled reports whether permissions are enforced
+func (a *Authorizer) Enabled() bool {
+	return a.opts.Enabled
+}
+
+// Grants returns the roles the caller holds in its tenant
+func (a *Authorizer) Grants(ctx context.Context, id caller.Identity) (*Grants, error) {
+	if !a.opts.Enabled || id.PlatformAdmin {
+		return &Grants{All: true}, nil
+	}
+
+	bindings, err := a.bindings.MatchBindings(ctx, id.TenantID, SubjectsOf(id))
+	if err != nil {
+		return nil, err
+	}
+
+	grants := &Grants{Targets: map[string][]Role{}}
+	for _, b := range bindings {
+		if b.TargetID == "" {
+			grants.Tenant = append(grants.Tenant, b.Role)
+		} else {
+			grants.Targets[b.TargetID] = append(grants.Targets[b.TargetID], b.Role)
+		}
+	}
+	if len(bindings) == 0 && a.opts.DefaultRole != "" {
+		grants.Tenant = []Role{a.opts.DefaultRole}
+	}
+	return grants, nil
+}
+
+// Authorize returns a PERMISSION_DENIED error unless the caller may invoke
+// operation with req
+func (a *Authorizer) Authorize(ctx context.Context, operation string, req interface{}) error {
+	if !a.opts.Enabled {
+		return nil
+	}
+
+	rule, ok := Operations[operation]
+	if !ok {
+		return a.deny(ctx, operation, "operation %s is not covered by any permission", operation)
+	}
+	if rule.Public {
+		return nil
+	}
+
+	id := caller.FromContext(ctx)
+	grants, err := a.Grants(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	targetIDs, err := a.targetIDs(ctx, rule, req)
+	if err != nil {
+		return err
+	}
+	if !grants.Allows(rule.Permission, targetIDs...) {
+		return a.deny(ctx, operation, "permission %s required", rule.Permission)
+	}
+	return nil
+}
+
+// targetIDs returns the deployment targets req acts on. It returns none when
+// a job or configuration of req belongs to no target, so that a tenant-wide
+// role is needed.
+func (a *Authorizer) targetIDs(ctx context.Context, rule Rule, req interface{}) ([]string, error) {
+	targetIDs := stringField(req, rule.TargetField)
+	for _, jobID := range stringField(req, rule.JobField) {
+		targetID, err := a.jobs.JobTargetID(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		if targetID == "" {
+			return nil, nil
+		}
+		targetIDs = append(targetIDs, targetID)
+	}
+	for _, configurationID := range stringField(req, rule.ConfigurationField) {
+		ids, err := a.configurations.ConfigurationTargetIDs(ctx, configurationID)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		targetIDs = append(targetIDs, ids...)
+	}
+	return targetIDs, nil
+}
+
+func (a *Authorizer) deny(ctx context.Context, operation, format string, args ...interface{}) error {
+	id := caller.FromContext(ctx)
+	a.log.Warnf("permission denied: caller=%s tenant=%d operation=%s: %s",
+		id.Name(), id.TenantID, operation, fmt.Sprintf(format, args...))
+	return deployerV1.ErrorPermissionDenied(format, args...)
+}
@@ -0,0 +1,23 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Server returns a middleware that checks every RPC against Operations. It
+// must run after the caller identity is attached to the context.
+func Server(a *Authorizer) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if err := a.Authorize(ctx, tr.Operation(), req); err != nil {
+					return nil, err
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
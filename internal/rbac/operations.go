@@ -0,0 +1,135 @@
+package rbac
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Rule is the permission an RPC requires
+type Rule struct {
+	// Public RPCs are allowed without any role
+	Public bool
+	// Permission required by the RPC
+	Permission Permission
+	// TargetField names the request field holding the deployment target ID the
+	// RPC acts on, so target-scoped bindings apply. Repeated fields are supported.
+	TargetField string
+	// JobField names the request field holding a deployment job ID; the job's
+	// deployment target decides which target-scoped bindings apply
+	JobField string
+	// ConfigurationField names the request field holding a target configuration
+	// ID; bindings must apply on every deployment target of the configuration.
+	// Repeated fields are supported.
+	ConfigurationField string
+}
+
+const (
+	targetService        = "/deployer.service.v1.DeploymentTargetService/"
+	configurationService = "/deployer.service.v1.TargetConfigurationService/"
+	jobService           = "/deployer.service.v1.DeploymentJobService/"
+	deploymentService    = "/deployer.service.v1.DeploymentService/"
+	statisticsService    = "/deployer.service.v1.DeployerStatisticsService/"
+	backupService        = "/deployer.service.v1.BackupService/"
+	auditLogService      = "/deployer.service.v1.AuditLogService/"
+	changeRecordService  = "/deployer.service.v1.ChangeRecordService/"
+	accessControlService = "/deployer.service.v1.AccessControlService/"
+	driftService         = "/deployer.service.v1.DriftService/"
+	notificationService  = "/deployer.service.v1.NotificationService/"
+	inventoryService     = "/deployer.service.v1.InventoryService/"
+)
+
+// Operations maps every RPC to the permission it requires. RPCs missing from
+// the map are denied.
+var Operations = map[string]Rule{
+	targetService + "CreateTarget":             {Permission: PermTargetWrite},
+	targetService + "GetTarget":                {Permission: PermTargetRead, TargetField: "id"},
+	targetService + "ListTargets":              {Permission: PermTargetRead},
+	targetService + "UpdateTarget":             {Permission: PermTargetWrite, TargetField: "id"},
+	targetService + "DeleteTarget":             {Permission: PermTargetWrite, TargetField: "id"},
+	targetService + "AddConfigurations":        {Permission: PermTargetWrite, TargetField: "id"},
+	targetService + "RemoveConfigurations":     {Permission: PermTargetWrite, TargetField: "id"},
+	targetService + "ListTargetConfigurations": {Permission: PermConfigurationRead, TargetField: "id"},
+
+	configurationService + "CreateConfiguration":        {Permission: PermConfigurationWrite},
+	configurationService + "GetConfiguration":           {Permission: PermConfigurationRead},
+	configurationService + "ListConfigurations":         {Permission: PermConfigurationRead},
+	configurationService + "UpdateConfiguration":        {Permission: PermConfigurationWrite},
+	configurationService + "DeleteConfiguration":        {Permission: PermConfigurationWrite},
+	configurationService + "ValidateCredentials":        {Permission: PermConfigurationWrite},
+	configurationService + "ListConfigurationRevisions": {Permission: PermConfigurationRead},
+	configurationService + "DiffConfigurationRevisions": {Permission: PermConfigurationRead},
+	configurationService + "RevertConfiguration":        {Permission: PermConfigurationWrite},
+	configurationService + "RotateEncryptionKey":        {Permission: PermConfigurationWrite},
+	configurationService + "ListExpiringConfigurations": {Permission: PermConfigurationRead},
+	configurationService + "ListProviders":              {Permission: PermConfigurationRead},
+
+	jobService + "CreateJob":    {Permission: PermDeploy, TargetField: "deployment_target_id"},
+	jobService + "GetJobStatus": {Permission: PermJobRead, JobField: "id"},
+	jobService + "GetJobResult": {Permission: PermJobRead, JobField: "id"},
+	jobService + "ListJobs":     {Permission: PermJobRead, TargetField: "deployment_target_id"},
+	jobService + "CancelJob":    {Permission: PermDeploy, JobField: "id"},
+	jobService + "RetryJob":     {Permission: PermDeploy, JobField: "id"},
+
+	deploymentService + "Deploy":                 {Permission: PermDeploy, ConfigurationField: "target_configuration_id"},
+	deploymentService + "DeployToTarget":         {Permission: PermDeploy, TargetField: "deployment_target_id"},
+	deploymentService + "DeployToConfigurations": {Permission: PermDeploy, ConfigurationField: "configuration_ids"},
+	deploymentService + "Verify":                 {Permission: PermDeploy, ConfigurationField: "target_configuration_id"},
+	deploymentService + "Rollback":               {Permission: PermDeploy, ConfigurationField: "target_configuration_id"},
+	deploymentService + "Undeploy":               {Permission: PermDeploy, ConfigurationField: "target_configuration_id"},
+	deploymentService + "PlanDeployment":         {Permission: PermDeploy, TargetField: "deployment_target_id", ConfigurationField: "target_configuration_id"},
+	deploymentService + "DeployToTargets":        {Permission: PermDeploy, TargetField: "target_ids"},
+
+	statisticsService + "GetStatistics":       {Permission: PermStatisticsRead},
+	statisticsService + "GetTenantStatistics": {Permission: PermStatisticsRead},
+	statisticsService + "HealthCheck":         {Public: true},
+
+	backupService + "ExportBackup": {Permission: PermBackupManage},
+	backupService + "ImportBackup": {Permission: PermBackupManage},
+
+	auditLogService + "VerifyAuditLog": {Permission: PermAuditRead},
+
+	changeRecordService + "ListChangeRecords": {Permission: PermChangeRead},
+
+	accessControlService + "ListRoleBindings":  {Permission: PermRBACManage},
+	accessControlService + "CreateRoleBinding": {Permission: PermRBACManage},
+	accessControlService + "DeleteRoleBinding": {Permission: PermRBACManage},
+	accessControlService + "GetMyPermissions":  {Public: true},
+
+	driftService + "ListDrift": {Permission: PermConfigurationRead},
+
+	inventoryService + "DiscoverInventory":      {Permission: PermConfigurationWrite, TargetField: "deployment_target_id"},
+	inventoryService + "ListInventorySnapshots": {Permission: PermConfigurationRead},
+
+	notificationService + "ListNotificationRules":  {Permission: PermTargetRead},
+	notificationService + "CreateNotificationRule": {Permission: PermTargetWrite, TargetField: "deployment_target_id"},
+	notificationService + "DeleteNotificationRule": {Permission: PermTargetWrite},
+	notificationService + "TestNotificationRule":   {Permission: PermTargetWrite},
+}
+
+// stringField returns the non-empty values of the named string field of req
+func stringField(req interface{}, name string) []string {
+	msg, ok := req.(proto.Message)
+	if !ok || name == "" {
+		return nil
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || !m.Has(fd) {
+		return nil
+	}
+
+	if fd.IsList() {
+		list := m.Get(fd).List()
+		values := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			if v := list.Get(i).String(); v != "" {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+	if v := m.Get(fd).String(); v != "" {
+		return []string{v}
+	}
+	return nil
+}
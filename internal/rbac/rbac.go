@@ -0,0 +1,203 @@
+// Package rbac implements role-based access control for the deployer RPCs.
+//
+// Roles are granted through role bindings, tenant-wide or on a single
+// deployment target, to a caller identity: the user ID forwarded by the admin
+// gateway, the common name of an mTLS client certificate or a role claim of
+// the caller's JWT. Every RPC requires one permission (see Operations); the
+// caller holds it when any of its roles includes it.
+package rbac
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+)
+
+// Permission is a single capability checked per RPC
+type Permission string
+
+const (
+	PermTargetRead        Permission = "target:read"
+	PermTargetWrite       Permission = "target:write"
+	PermConfigurationRead Permission = "configuration:read"
+	// PermConfigurationWrite covers configurations and their credentials
+	PermConfigurationWrite Permission = "configuration:write"
+	PermJobRead            Permission = "job:read"
+	// PermDeploy covers deploy, verify, rollback, retry and cancel
+	PermDeploy         Permission = "deployment:execute"
+	PermStatisticsRead Permission = "statistics:read"
+	PermChangeRead     Permission = "change:read"
+	PermAuditRead      Permission = "audit:read"
+	PermBackupManage   Permission = "backup:manage"
+	PermRBACManage     Permission = "rbac:manage"
+)
+
+// Role is a named set of permissions
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var viewerPermissions = []Permission{
+	PermTargetRead,
+	PermConfigurationRead,
+	PermJobRead,
+	PermStatisticsRead,
+	PermChangeRead,
+}
+
+var operatorPermissions = append(append([]Permission{}, viewerPermissions...),
+	PermDeploy,
+)
+
+var adminPermissions = append(append([]Permission{}, operatorPermissions...),
+	PermTargetWrite,
+	PermConfigurationWrite,
+	PermAuditRead,
+	PermBackupManage,
+	PermRBACManage,
+)
+
+// rolePermissions maps every role to the permissions it grants
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:   viewerPermissions,
+	RoleOperator: operatorPermissions,
+	RoleAdmin:    adminPermissions,
+}
+
+// ParseRole returns the role with the given name
+func ParseRole(name string) (Role, bool) {
+	r := Role(name)
+	_, ok := rolePermissions[r]
+	return r, ok
+}
+
+// AllPermissions returns every permission, sorted
+func AllPermissions() []Permission {
+	return sortedPermissions(adminPermissions)
+}
+
+// SubjectType is the kind of caller identity a binding matches
+type SubjectType string
+
+const (
+	SubjectUser      SubjectType = "SUBJECT_TYPE_USER"
+	SubjectClient    SubjectType = "SUBJECT_TYPE_CLIENT"
+	SubjectClaimRole SubjectType = "SUBJECT_TYPE_CLAIM_ROLE"
+)
+
+// Subject is one identity of a caller
+type Subject struct {
+	Type  SubjectType
+	Value string
+}
+
+// SubjectsOf returns every identity the caller may be bound by
+func SubjectsOf(id caller.Identity) []Subject {
+	var subjects []Subject
+	if id.UserID != 0 {
+		subjects = append(subjects, Subject{Type: SubjectUser, Value: strconv.FormatUint(uint64(id.UserID), 10)})
+	}
+	if id.ClientCN != "" {
+		subjects = append(subjects, Subject{Type: SubjectClient, Value: id.ClientCN})
+	}
+	for _, role := range id.Roles {
+		if role != "" {
+			subjects = append(subjects, Subject{Type: SubjectClaimRole, Value: role})
+		}
+	}
+	return subjects
+}
+
+// Binding is a role granted to one of the caller's subjects
+type Binding struct {
+	Role Role
+	// TargetID limits the binding to one deployment target; empty for tenant-wide bindings
+	TargetID string
+}
+
+// Grants are the roles a caller holds in its tenant
+type Grants struct {
+	// All is set for platform admins and when RBAC is disabled
+	All bool
+	// Tenant holds the tenant-wide roles
+	Tenant []Role
+	// Targets holds the roles per deployment target
+	Targets map[string][]Role
+}
+
+// Allows reports whether the grants include perm, tenant-wide or on every one of targetIDs
+func (g *Grants) Allows(perm Permission, targetIDs ...string) bool {
+	if g.All {
+		return true
+	}
+	if rolesAllow(g.Tenant, perm) {
+		return true
+	}
+	if len(targetIDs) == 0 {
+		return false
+	}
+	for _, id := range targetIDs {
+		if !rolesAllow(g.Targets[id], perm) {
+			return false
+		}
+	}
+	return true
+}
+
+// Roles returns the roles held tenant-wide, plus those on targetID when set
+func (g *Grants) Roles(targetID string) []Role {
+	if g.All {
+		return []Role{RoleAdmin}
+	}
+	seen := map[Role]bool{}
+	var roles []Role
+	for _, r := range append(append([]Role{}, g.Tenant...), g.Targets[targetID]...) {
+		if !seen[r] {
+			seen[r] = true
+			roles = append(roles, r)
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i] < roles[j] })
+	return roles
+}
+
+// Permissions returns the permissions held tenant-wide, plus those on targetID when set
+func (g *Grants) Permissions(targetID string) []Permission {
+	if g.All {
+		return AllPermissions()
+	}
+	var perms []Permission
+	for _, r := range g.Roles(targetID) {
+		perms = append(perms, rolePermissions[r]...)
+	}
+	return sortedPermissions(perms)
+}
+
+func rolesAllow(roles []Role, perm Permission) bool {
+	for _, r := range roles {
+		for _, p := range rolePermissions[r] {
+			if p == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedPermissions(perms []Permission) []Permission {
+	seen := map[Permission]bool{}
+	out := make([]Permission, 0, len(perms))
+	for _, p := range perms {
+		if !seen[p] {
+			seen[p] = true
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
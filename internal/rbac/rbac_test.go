@@ -0,0 +1,198 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+type staticBindings map[Subject][]Binding
+
+func (s staticBindings) MatchBindings(_ context.Context, _ uint32, subjects []Subject) ([]Binding, error) {
+	var out []Binding
+	for _, sub := range subjects {
+		out = append(out, s[sub]...)
+	}
+	return out, nil
+}
+
+type staticJobs map[string]string
+
+func (j staticJobs) JobTargetID(_ context.Context, id string) (string, error) {
+	return j[id], nil
+}
+
+type staticConfigurations map[string][]string
+
+func (c staticConfigurations) ConfigurationTargetIDs(_ context.Context, id string) ([]string, error) {
+	return c[id], nil
+}
+
+func newTestAuthorizer(t *testing.T, opts Options, bindings staticBindings) *Authorizer {
+	t.Helper()
+	a, err := NewAuthorizer(opts, bindings, staticJobs{"job-a": "target-a", "job-direct": ""},
+		staticConfigurations{"config-a": {"target-a"}, "config-ab": {"target-a", "target-b"}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewAuthorizer: %v", err)
+	}
+	return a
+}
+
+func userCtx(userID uint32, roles ...string) context.Context {
+	return caller.NewContext(context.Background(), caller.Identity{TenantID: 1, UserID: userID, Roles: roles})
+}
+
+func assertDenied(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected permission denied, got nil")
+	}
+	if e := errors.FromError(err); e.Code != 403 || e.Reason != deployerV1.DeployerErrorReason_PERMISSION_DENIED.String() {
+		t.Fatalf("expected PERMISSION_DENIED, got %v", err)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newTestAuthorizer(t, Options{Enabled: true}, staticBindings{
+		{Type: SubjectUser, Value: "1"}:                  {{Role: RoleViewer}},
+		{Type: SubjectUser, Value: "2"}:                  {{Role: RoleOperator, TargetID: "target-a"}},
+		{Type: SubjectClaimRole, Value: "deploy-admins"}: {{Role: RoleAdmin}},
+	})
+
+	get := targetService + "GetTarget"
+	update := targetService + "UpdateTarget"
+	deploy := deploymentService + "DeployToTarget"
+	retry := jobService + "RetryJob"
+
+	// Tenant-wide viewer reads but cannot write or deploy
+	if err := a.Authorize(userCtx(1), get, &deployerV1.GetTargetRequest{Id: "target-b"}); err != nil {
+		t.Fatalf("viewer GetTarget: %v", err)
+	}
+	assertDenied(t, a.Authorize(userCtx(1), update, &deployerV1.UpdateTargetRequest{Id: "target-b"}))
+	assertDenied(t, a.Authorize(userCtx(1), deploy, &deployerV1.DeployToTargetRequest{DeploymentTargetId: "target-b"}))
+
+	// Target operator deploys and retries only on its target
+	if err := a.Authorize(userCtx(2), deploy, &deployerV1.DeployToTargetRequest{DeploymentTargetId: "target-a"}); err != nil {
+		t.Fatalf("operator DeployToTarget: %v", err)
+	}
+	assertDenied(t, a.Authorize(userCtx(2), deploy, &deployerV1.DeployToTargetRequest{DeploymentTargetId: "target-b"}))
+	if err := a.Authorize(userCtx(2), retry, &deployerV1.RetryJobRequest{Id: "job-a"}); err != nil {
+		t.Fatalf("operator RetryJob: %v", err)
+	}
+	assertDenied(t, a.Authorize(userCtx(2), retry, &deployerV1.RetryJobRequest{Id: "job-direct"}))
+	assertDenied(t, a.Authorize(userCtx(2), targetService+"ListTargets", &deployerV1.ListTargetsRequest{}))
+
+	// Configuration RPCs need the role on every target of the configuration;
+	// configurations outside any target need a tenant-wide role
+	deployConfig := deploymentService + "Deploy"
+	if err := a.Authorize(userCtx(2), deployConfig, &deployerV1.DeployRequest{TargetConfigurationId: "config-a"}); err != nil {
+		t.Fatalf("operator Deploy: %v", err)
+	}
+	assertDenied(t, a.Authorize(userCtx(2), deployConfig, &deployerV1.DeployRequest{TargetConfigurationId: "config-ab"}))
+	assertDenied(t, a.Authorize(userCtx(2), deployConfig, &deployerV1.DeployRequest{TargetConfigurationId: "config-direct"}))
+	assertDenied(t, a.Authorize(userCtx(2), deploymentService+"Undeploy", &deployerV1.UndeployRequest{TargetConfigurationId: "config-ab"}))
+	deployConfigs := deploymentService + "DeployToConfigurations"
+	if err := a.Authorize(userCtx(2), deployConfigs, &deployerV1.DeployToConfigurationsRequest{ConfigurationIds: []string{"config-a"}}); err != nil {
+		t.Fatalf("operator DeployToConfigurations: %v", err)
+	}
+	assertDenied(t, a.Authorize(userCtx(2), deployConfigs, &deployerV1.DeployToConfigurationsRequest{ConfigurationIds: []string{"config-a", "config-direct"}}))
+	assertDenied(t, a.Authorize(userCtx(2), deploymentService+"DeployToTargets", &deployerV1.DeployToTargetsRequest{TargetIds: []string{"target-a", "target-b"}}))
+
+	// JWT role claims bind like users
+	if err := a.Authorize(userCtx(3, "deploy-admins"), update, &deployerV1.UpdateTargetRequest{Id: "target-b"}); err != nil {
+		t.Fatalf("claim admin UpdateTarget: %v", err)
+	}
+
+	// Callers without bindings are denied, public and platform admin calls pass
+	assertDenied(t, a.Authorize(userCtx(4), get, &deployerV1.GetTargetRequest{Id: "target-a"}))
+	if err := a.Authorize(userCtx(4), accessControlService+"GetMyPermissions", &deployerV1.GetMyPermissionsRequest{}); err != nil {
+		t.Fatalf("GetMyPermissions: %v", err)
+	}
+	adminCtx := caller.NewContext(context.Background(), caller.Identity{PlatformAdmin: true})
+	if err := a.Authorize(adminCtx, update, &deployerV1.UpdateTargetRequest{Id: "target-b"}); err != nil {
+		t.Fatalf("platform admin UpdateTarget: %v", err)
+	}
+
+	// Operations outside the map are denied
+	assertDenied(t, a.Authorize(adminCtx, "/deployer.service.v1.Unknown/Call", nil))
+}
+
+func TestAuthorizeDefaultRoleAndDisabled(t *testing.T) {
+	a := newTestAuthorizer(t, Options{Enabled: true, DefaultRole: RoleViewer}, staticBindings{
+		{Type: SubjectUser, Value: "2"}: {{Role: RoleOperator, TargetID: "target-a"}},
+	})
+
+	get := targetService + "GetTarget"
+	if err := a.Authorize(userCtx(4), get, &deployerV1.GetTargetRequest{Id: "target-b"}); err != nil {
+		t.Fatalf("default role GetTarget: %v", err)
+	}
+	// The default role only applies to callers without bindings
+	assertDenied(t, a.Authorize(userCtx(2), get, &deployerV1.GetTargetRequest{Id: "target-b"}))
+
+	disabled := newTestAuthorizer(t, Options{}, nil)
+	if err := disabled.Authorize(userCtx(4), targetService+"DeleteTarget", &deployerV1.DeleteTargetRequest{Id: "target-a"}); err != nil {
+		t.Fatalf("disabled DeleteTarget: %v", err)
+	}
+
+	if _, err := NewAuthorizer(Options{Enabled: true, DefaultRole: "owner"}, nil, nil, nil, log.DefaultLogger); err == nil {
+		t.Fatal("expected unknown default role to be rejected")
+	}
+}
+
+func TestGrantsPermissions(t *testing.T) {
+	g := &Grants{
+		Tenant:  []Role{RoleViewer},
+		Targets: map[string][]Role{"target-a": {RoleOperator}},
+	}
+
+	if got := g.Roles("target-a"); len(got) != 2 {
+		t.Fatalf("target roles = %v, want operator and viewer", got)
+	}
+	has := func(perms []Permission, p Permission) bool {
+		for _, x := range perms {
+			if x == p {
+				return true
+			}
+		}
+		return false
+	}
+	if has(g.Permissions(""), PermDeploy) {
+		t.Fatal("tenant-wide permissions must not include deploy")
+	}
+	if !has(g.Permissions("target-a"), PermDeploy) {
+		t.Fatal("target permissions must include deploy")
+	}
+	if len((&Grants{All: true}).Permissions("")) != len(AllPermissions()) {
+		t.Fatal("All grants must hold every permission")
+	}
+}
+
+// Every RPC of the deployer services must appear in Operations
+func TestOperationsCoverServices(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{
+		deployerV1.DeploymentTargetService_ServiceDesc,
+		deployerV1.TargetConfigurationService_ServiceDesc,
+		deployerV1.DeploymentJobService_ServiceDesc,
+		deployerV1.DeploymentService_ServiceDesc,
+		deployerV1.DeployerStatisticsService_ServiceDesc,
+		deployerV1.BackupService_ServiceDesc,
+		deployerV1.AuditLogService_ServiceDesc,
+		deployerV1.ChangeRecordService_ServiceDesc,
+		deployerV1.AccessControlService_ServiceDesc,
+		deployerV1.InventoryService_ServiceDesc,
+	} {
+		for _, m := range desc.Methods {
+			op := "/" + desc.ServiceName + "/" + m.MethodName
+			if _, ok := Operations[op]; !ok {
+				t.Errorf("operation %s has no rule", op)
+			}
+		}
+	}
+}
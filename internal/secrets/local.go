@@ -0,0 +1,104 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EnvResolver resolves env:NAME references. Only variables carrying the
+// configured prefix may be read, so tenants cannot pull the process's own
+// secrets (database passwords, encryption keys) into a deployment.
+type EnvResolver struct {
+	prefix string
+}
+
+// NewEnvResolver creates an env resolver restricted to variables starting with prefix
+func NewEnvResolver(prefix string) *EnvResolver {
+	return &EnvResolver{prefix: prefix}
+}
+
+// Resolve implements Resolver
+func (r *EnvResolver) Resolve(_ context.Context, name string) (string, error) {
+	if r.prefix == "" || !strings.HasPrefix(name, r.prefix) {
+		return "", fmt.Errorf("environment variable %q is not allowed, names must start with %q", name, r.prefix)
+	}
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %q is not set", name)
+	}
+	return value, nil
+}
+
+// CheckTenant implements TenantScoped: variables of a tenant carry
+// TenantEnvPrefix after the configured prefix
+func (r *EnvResolver) CheckTenant(tenantID uint32, name string) error {
+	if !strings.HasPrefix(name, r.prefix+TenantEnvPrefix(tenantID)) {
+		return fmt.Errorf("environment variable %q is not allowed, names must start with %q", name, r.prefix+TenantEnvPrefix(tenantID))
+	}
+	return nil
+}
+
+// FileResolver resolves file:/path references to files below a base directory
+type FileResolver struct {
+	dir string
+}
+
+// NewFileResolver creates a file resolver restricted to files below dir
+func NewFileResolver(dir string) *FileResolver {
+	return &FileResolver{dir: dir}
+}
+
+// Resolve implements Resolver. A single trailing newline is stripped.
+func (r *FileResolver) Resolve(_ context.Context, path string) (string, error) {
+	if r.dir == "" {
+		return "", fmt.Errorf("file references are disabled")
+	}
+	resolved, err := resolveBelow(r.dir, path)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(resolved)
+	if err != nil {
+		return "", fmt.Errorf("read secret file %q: %w", path, err)
+	}
+
+	value := strings.TrimSuffix(string(data), "\n")
+	return strings.TrimSuffix(value, "\r"), nil
+}
+
+// CheckTenant implements TenantScoped: files of a tenant live below the
+// TenantNamespace directory of the base directory
+func (r *FileResolver) CheckTenant(tenantID uint32, path string) error {
+	if r.dir == "" {
+		return fmt.Errorf("file references are disabled")
+	}
+	_, err := resolveBelow(filepath.Join(r.dir, TenantNamespace(tenantID)), path)
+	return err
+}
+
+// resolveBelow returns path with symlinks resolved, or an error unless it
+// points to a file below dir
+func resolveBelow(dir, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("file path %q must be absolute", path)
+	}
+
+	base, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("secret directory %q: %w", dir, err)
+	}
+	// Resolve symlinks so a link inside the directory cannot point outside it
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("secret file %q: %w", path, err)
+	}
+	rel, err := filepath.Rel(base, resolved)
+	if err != nil || rel == "." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || rel == ".." {
+		return "", fmt.Errorf("secret file %q is outside %q", path, dir)
+	}
+	return resolved, nil
+}
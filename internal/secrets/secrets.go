@@ -0,0 +1,198 @@
+// Package secrets resolves external secret references in provider credentials.
+//
+// A credential value of the form "<scheme>:<reference>" for a known scheme is
+// a reference rather than the secret itself:
+//
+//	env:DEPLOYER_SECRET_F5_PASSWORD
+//	file:/run/secrets/f5-password
+//	vault:kv/network/f5#password
+//
+// References are stored as-is and resolved at deploy and validation time.
+// References in tenant data are confined to the tenant's namespace, see
+// TenantScoped.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Known reference schemes. Values with one of these prefixes are always treated
+// as references, so a missing backend yields an error instead of the reference
+// string being sent to a device as a password.
+const (
+	SchemeEnv   = "env"
+	SchemeFile  = "file"
+	SchemeVault = "vault"
+)
+
+var knownSchemes = []string{SchemeEnv, SchemeFile, SchemeVault}
+
+// Resolver resolves references of one scheme
+type Resolver interface {
+	// Resolve returns the secret referenced by ref (the part after "<scheme>:")
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+// ResolveError reports a credential whose reference could not be resolved
+type ResolveError struct {
+	Field     string
+	Reference string
+	Err       error
+}
+
+func (e *ResolveError) Error() string {
+	return fmt.Sprintf("credential %q: cannot resolve %s: %v", e.Field, e.Reference, e.Err)
+}
+
+func (e *ResolveError) Unwrap() error {
+	return e.Err
+}
+
+type cacheEntry struct {
+	value   string
+	expires time.Time
+}
+
+// Manager dispatches references to the resolver of their scheme and caches results
+type Manager struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	resolvers map[string]Resolver
+	cache     map[string]cacheEntry
+}
+
+// NewManager creates a manager caching resolved values for ttl (0 disables caching)
+func NewManager(ttl time.Duration) *Manager {
+	return &Manager{
+		ttl:       ttl,
+		now:       time.Now,
+		resolvers: map[string]Resolver{},
+		cache:     map[string]cacheEntry{},
+	}
+}
+
+// Register installs the resolver for a scheme, replacing any previous one
+func (m *Manager) Register(scheme string, r Resolver) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resolvers[scheme] = r
+}
+
+// ParseReference splits a credential value into scheme and reference.
+// ok is false when the value is a literal secret.
+func ParseReference(value string) (scheme, ref string, ok bool) {
+	scheme, ref, found := strings.Cut(value, ":")
+	if !found || ref == "" {
+		return "", "", false
+	}
+	for _, s := range knownSchemes {
+		if scheme == s {
+			return scheme, ref, true
+		}
+	}
+	return "", "", false
+}
+
+// HasReferences reports whether any credential value is a reference
+func HasReferences(credentials map[string]any) bool {
+	for _, v := range credentials {
+		switch val := v.(type) {
+		case string:
+			if _, _, ok := ParseReference(val); ok {
+				return true
+			}
+		case map[string]any:
+			if HasReferences(val) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResolveCredentials returns a copy of credentials with every reference
+// replaced by its secret value. Literal values are copied unchanged. Only
+// for operator-supplied values; use ResolveTenantCredentials for tenant data.
+func (m *Manager) ResolveCredentials(ctx context.Context, credentials map[string]any) (map[string]any, error) {
+	return m.resolveMap(ctx, "", credentials, m.Resolve)
+}
+
+func (m *Manager) resolveMap(ctx context.Context, prefix string, in map[string]any,
+	resolve func(ctx context.Context, scheme, ref string) (string, error)) (map[string]any, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		field := k
+		if prefix != "" {
+			field = prefix + "." + k
+		}
+
+		switch val := v.(type) {
+		case string:
+			scheme, ref, ok := ParseReference(val)
+			if !ok {
+				out[k] = val
+				continue
+			}
+			secret, err := resolve(ctx, scheme, ref)
+			if err != nil {
+				return nil, &ResolveError{Field: field, Reference: scheme + ":" + ref, Err: err}
+			}
+			out[k] = secret
+		case map[string]any:
+			nested, err := m.resolveMap(ctx, field, val, resolve)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = nested
+		default:
+			out[k] = v
+		}
+	}
+	return out, nil
+}
+
+// Resolve resolves a single reference, serving it from the cache when possible
+func (m *Manager) Resolve(ctx context.Context, scheme, ref string) (string, error) {
+	key := scheme + ":" + ref
+
+	m.mu.Lock()
+	resolver, ok := m.resolvers[scheme]
+	if entry, hit := m.cache[key]; hit && m.now().Before(entry.expires) {
+		m.mu.Unlock()
+		return entry.value, nil
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return "", fmt.Errorf("no %q secret backend is configured", scheme)
+	}
+
+	value, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+
+	if m.ttl > 0 {
+		m.mu.Lock()
+		m.cache[key] = cacheEntry{value: value, expires: m.now().Add(m.ttl)}
+		m.mu.Unlock()
+	}
+	return value, nil
+}
+
+// Purge drops all cached values
+func (m *Manager) Purge() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cache = map[string]cacheEntry{}
+}
@@ -0,0 +1,61 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+)
+
+// TenantScoped is implemented by resolvers that can confine references to the
+// secrets of one tenant. References in tenant data (credentials, notification
+// rules, ingested certificates) must stay inside the tenant's namespace, so a
+// tenant cannot have another tenant's secrets resolved and sent to a device or
+// URL it controls.
+type TenantScoped interface {
+	// CheckTenant returns an error unless ref lies in the namespace of tenantID
+	CheckTenant(tenantID uint32, ref string) error
+}
+
+// TenantNamespace is the directory or path segment holding the secrets of a
+// tenant, e.g. file:/run/secrets/tenant-42/f5 or vault:kv/tenant-42/f5#password
+func TenantNamespace(tenantID uint32) string {
+	return fmt.Sprintf("tenant-%d", tenantID)
+}
+
+// TenantEnvPrefix is the part following the env prefix in the variables of a
+// tenant, e.g. DEPLOYER_SECRET_TENANT_42_F5_PASSWORD
+func TenantEnvPrefix(tenantID uint32) string {
+	return fmt.Sprintf("TENANT_%d_", tenantID)
+}
+
+// CheckTenantReference returns an error unless the reference scheme:ref lies
+// in the secret namespace of tenantID
+func (m *Manager) CheckTenantReference(tenantID uint32, scheme, ref string) error {
+	m.mu.Lock()
+	resolver, ok := m.resolvers[scheme]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no %q secret backend is configured", scheme)
+	}
+	scoped, ok := resolver.(TenantScoped)
+	if !ok {
+		return fmt.Errorf("%s references are not available to tenants", scheme)
+	}
+	return scoped.CheckTenant(tenantID, ref)
+}
+
+// ResolveTenant resolves a reference taken from the data of tenantID,
+// refusing references outside the tenant's namespace
+func (m *Manager) ResolveTenant(ctx context.Context, tenantID uint32, scheme, ref string) (string, error) {
+	if err := m.CheckTenantReference(tenantID, scheme, ref); err != nil {
+		return "", err
+	}
+	return m.Resolve(ctx, scheme, ref)
+}
+
+// ResolveTenantCredentials is ResolveCredentials for credentials of tenantID:
+// every reference must lie in the tenant's namespace
+func (m *Manager) ResolveTenantCredentials(ctx context.Context, tenantID uint32, credentials map[string]any) (map[string]any, error) {
+	return m.resolveMap(ctx, "", credentials, func(ctx context.Context, scheme, ref string) (string, error) {
+		return m.ResolveTenant(ctx, tenantID, scheme, ref)
+	})
+}
@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestManagerResolveTenantCredentials(t *testing.T) {
+	dir := t.TempDir()
+	for _, tenant := range []string{"tenant-1", "tenant-2"} {
+		if err := os.Mkdir(filepath.Join(dir, tenant), 0o700); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, tenant, "password"), []byte(tenant+"-secret\n"), 0o600); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	// A link inside tenant 1's directory pointing at tenant 2's secret
+	if err := os.Symlink(filepath.Join(dir, "tenant-2", "password"), filepath.Join(dir, "tenant-1", "stolen")); err != nil {
+		t.Fatalf("symlink: %v", err)
+	}
+	t.Setenv("DEPLOYER_SECRET_TENANT_1_TOKEN", "token-1")
+	t.Setenv("DEPLOYER_SECRET_TENANT_2_TOKEN", "token-2")
+	t.Setenv("DEPLOYER_SECRET_SHARED", "shared")
+
+	vault, err := NewVaultResolver(VaultOptions{Address: "https://vault.invalid", Token: "token"})
+	if err != nil {
+		t.Fatalf("NewVaultResolver: %v", err)
+	}
+	m := NewManager(0)
+	m.Register(SchemeEnv, NewEnvResolver("DEPLOYER_SECRET_"))
+	m.Register(SchemeFile, NewFileResolver(dir))
+	m.Register(SchemeVault, vault)
+
+	got, err := m.ResolveTenantCredentials(context.Background(), 1, map[string]any{
+		"token":    "env:DEPLOYER_SECRET_TENANT_1_TOKEN",
+		"password": "file:" + filepath.Join(dir, "tenant-1", "password"),
+		"literal":  "plain",
+	})
+	if err != nil {
+		t.Fatalf("ResolveTenantCredentials: %v", err)
+	}
+	if got["token"] != "token-1" || got["password"] != "tenant-1-secret" || got["literal"] != "plain" {
+		t.Fatalf("resolved %v", got)
+	}
+
+	for name, ref := range map[string]string{
+		"other tenant's variable": "env:DEPLOYER_SECRET_TENANT_2_TOKEN",
+		"tenant 12 prefix":        "env:DEPLOYER_SECRET_TENANT_12_TOKEN",
+		"shared variable":         "env:DEPLOYER_SECRET_SHARED",
+		"other tenant's file":     "file:" + filepath.Join(dir, "tenant-2", "password"),
+		"dot-dot path":            "file:" + filepath.Join(dir, "tenant-1") + "/../tenant-2/password",
+		"symlink out":             "file:" + filepath.Join(dir, "tenant-1", "stolen"),
+		"other tenant's vault":    "vault:kv/tenant-2/f5#password",
+		"vault dot segments":      "vault:kv/tenant-1/../tenant-2/f5#password",
+		"vault outside tenants":   "vault:kv/network/f5#password",
+	} {
+		if _, err := m.ResolveTenantCredentials(context.Background(), 1, map[string]any{"x": ref}); err == nil {
+			t.Errorf("%s: %s resolved for tenant 1", name, ref)
+		}
+	}
+
+	if err := m.CheckTenantReference(1, SchemeVault, "kv/tenant-1/f5#password"); err != nil {
+		t.Errorf("vault reference of tenant 1: %v", err)
+	}
+
+	// Operator-supplied values are not confined to a tenant
+	if _, err := m.ResolveCredentials(context.Background(), map[string]any{"x": "env:DEPLOYER_SECRET_SHARED"}); err != nil {
+		t.Errorf("ResolveCredentials: %v", err)
+	}
+}
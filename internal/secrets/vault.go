@@ -0,0 +1,202 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// VaultOptions configures the Vault resolver
+type VaultOptions struct {
+	Address   string
+	Token     string
+	TokenFile string
+	Namespace string
+	// KVVersion is the KV secrets engine version (1 or 2, default 2)
+	KVVersion int
+	Timeout   time.Duration
+	// HTTPClient overrides the default client (e.g. for custom TLS)
+	HTTPClient *http.Client
+}
+
+// VaultResolver resolves vault:<mount>/<path>#<field> references against the
+// Vault KV secrets engine
+type VaultResolver struct {
+	opts   VaultOptions
+	client *http.Client
+}
+
+// NewVaultResolver creates a Vault resolver
+func NewVaultResolver(opts VaultOptions) (*VaultResolver, error) {
+	if opts.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+	if _, err := url.Parse(opts.Address); err != nil {
+		return nil, fmt.Errorf("invalid vault address: %w", err)
+	}
+	if opts.Token == "" && opts.TokenFile == "" {
+		return nil, fmt.Errorf("vault token or token_file is required")
+	}
+	if opts.KVVersion == 0 {
+		opts.KVVersion = 2
+	}
+	if opts.KVVersion != 1 && opts.KVVersion != 2 {
+		return nil, fmt.Errorf("unsupported vault kv_version %d", opts.KVVersion)
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: opts.Timeout}
+	}
+
+	return &VaultResolver{opts: opts, client: client}, nil
+}
+
+// Resolve implements Resolver
+func (r *VaultResolver) Resolve(ctx context.Context, ref string) (string, error) {
+	path, mount, secretPath, field, err := parseVaultReference(ref)
+	if err != nil {
+		return "", err
+	}
+
+	apiPath := "/v1/" + escapePath(mount) + "/" + escapePath(secretPath)
+	if r.opts.KVVersion == 2 {
+		apiPath = "/v1/" + escapePath(mount) + "/data/" + escapePath(secretPath)
+	}
+
+	token, err := r.token()
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(r.opts.Address, "/")+apiPath, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if r.opts.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", r.opts.Namespace)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("read vault response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("vault secret %s not found", path)
+	case resp.StatusCode == http.StatusForbidden:
+		return "", fmt.Errorf("vault denied access to %s", path)
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("vault returned %d: %s", resp.StatusCode, vaultErrors(body))
+	}
+
+	var payload struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("decode vault response: %w", err)
+	}
+
+	data := payload.Data
+	if r.opts.KVVersion == 2 {
+		nested, _ := data["data"].(map[string]any)
+		data = nested
+	}
+
+	value, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("field %q not found in vault secret %s", field, path)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encode vault field %q: %w", field, err)
+	}
+	return string(encoded), nil
+}
+
+// CheckTenant implements TenantScoped: secrets of a tenant live below the
+// TenantNamespace segment of any mount, e.g. kv/tenant-42/f5#password
+func (r *VaultResolver) CheckTenant(tenantID uint32, ref string) error {
+	_, _, secretPath, _, err := parseVaultReference(ref)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(secretPath, TenantNamespace(tenantID)+"/") {
+		return fmt.Errorf("vault secret %q is not allowed, paths must start with <mount>/%s/", ref, TenantNamespace(tenantID))
+	}
+	return nil
+}
+
+// parseVaultReference splits <mount>/<path>#<field>. Dot segments are
+// refused so a path cannot leave its prefix.
+func parseVaultReference(ref string) (path, mount, secretPath, field string, err error) {
+	path, field, ok := strings.Cut(ref, "#")
+	if !ok || field == "" {
+		return "", "", "", "", fmt.Errorf("vault reference must be <mount>/<path>#<field>")
+	}
+	mount, secretPath, ok = strings.Cut(strings.Trim(path, "/"), "/")
+	if !ok || mount == "" || secretPath == "" {
+		return "", "", "", "", fmt.Errorf("vault reference must be <mount>/<path>#<field>")
+	}
+	for _, seg := range strings.Split(secretPath, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return "", "", "", "", fmt.Errorf("vault reference %q has an empty or dot path segment", ref)
+		}
+	}
+	return path, mount, secretPath, field, nil
+}
+
+// token returns the Vault token, re-reading the token file so agent-renewed tokens are picked up
+func (r *VaultResolver) token() (string, error) {
+	if r.opts.TokenFile == "" {
+		return r.opts.Token, nil
+	}
+	raw, err := os.ReadFile(r.opts.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("read vault token file: %w", err)
+	}
+	return strings.TrimSpace(string(raw)), nil
+}
+
+// escapePath escapes every segment of a slash-separated path
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+// vaultErrors extracts the error list of a Vault error response
+func vaultErrors(body []byte) string {
+	var payload struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && len(payload.Errors) > 0 {
+		return strings.Join(payload.Errors, "; ")
+	}
+	return "unexpected response"
+}
@@ -0,0 +1,219 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newVaultStandIn serves a minimal subset of the Vault KV API
+func newVaultStandIn(t *testing.T, kvVersion int) *httptest.Server {
+	t.Helper()
+
+	secrets := map[string]map[string]any{
+		"network/f5": {"password": "s3cret", "port": 8443},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		if r.Header.Get("X-Vault-Namespace") == "broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"errors":["internal error"]}`))
+			return
+		}
+
+		prefix := "/v1/kv/"
+		if kvVersion == 2 {
+			prefix = "/v1/kv/data/"
+		}
+		path, ok := strings.CutPrefix(r.URL.Path, prefix)
+		data, found := secrets[path]
+		if !ok || !found {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		payload := map[string]any{"data": data}
+		if kvVersion == 2 {
+			payload = map[string]any{"data": map[string]any{"data": data, "metadata": map[string]any{"version": 3}}}
+		}
+		_ = json.NewEncoder(w).Encode(payload)
+	}))
+}
+
+func TestVaultResolver(t *testing.T) {
+	tests := []struct {
+		name      string
+		kvVersion int
+		token     string
+		namespace string
+		ref       string
+		want      string
+		wantErr   string
+	}{
+		{"kv v2 string field", 2, "root-token", "", "kv/network/f5#password", "s3cret", ""},
+		{"kv v2 non-string field", 2, "root-token", "", "kv/network/f5#port", "8443", ""},
+		{"kv v1 string field", 1, "root-token", "", "kv/network/f5#password", "s3cret", ""},
+		{"missing field", 2, "root-token", "", "kv/network/f5#username", "", `field "username" not found`},
+		{"missing secret", 2, "root-token", "", "kv/network/other#password", "", "not found"},
+		{"wrong token", 2, "bad-token", "", "kv/network/f5#password", "", "denied access"},
+		{"server error", 2, "root-token", "broken", "kv/network/f5#password", "", "internal error"},
+		{"no field", 2, "root-token", "", "kv/network/f5", "", "<mount>/<path>#<field>"},
+		{"no path", 2, "root-token", "", "kv#password", "", "<mount>/<path>#<field>"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newVaultStandIn(t, tc.kvVersion)
+			defer srv.Close()
+
+			resolver, err := NewVaultResolver(VaultOptions{
+				Address:   srv.URL,
+				Token:     tc.token,
+				Namespace: tc.namespace,
+				KVVersion: tc.kvVersion,
+			})
+			if err != nil {
+				t.Fatalf("NewVaultResolver: %v", err)
+			}
+
+			got, err := resolver.Resolve(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Resolve(%q) error = %v, want error containing %q", tc.ref, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve(%q): %v", tc.ref, err)
+			}
+			if got != tc.want {
+				t.Fatalf("Resolve(%q) = %q, want %q", tc.ref, got, tc.want)
+			}
+		})
+	}
+}
+
+type countingResolver struct {
+	calls int
+	value string
+	err   error
+}
+
+func (r *countingResolver) Resolve(_ context.Context, _ string) (string, error) {
+	r.calls++
+	return r.value, r.err
+}
+
+func TestManagerResolveCredentials(t *testing.T) {
+	srv := newVaultStandIn(t, 2)
+	defer srv.Close()
+
+	vault, err := NewVaultResolver(VaultOptions{Address: srv.URL, Token: "root-token"})
+	if err != nil {
+		t.Fatalf("NewVaultResolver: %v", err)
+	}
+
+	m := NewManager(time.Minute)
+	m.Register(SchemeVault, vault)
+
+	creds := map[string]any{
+		"username": "admin",
+		"password": "vault:kv/network/f5#password",
+		"nested":   map[string]any{"token": "vault:kv/network/f5#port"},
+		"note":     "https://example.com", // unknown scheme stays literal
+	}
+
+	got, err := m.ResolveCredentials(context.Background(), creds)
+	if err != nil {
+		t.Fatalf("ResolveCredentials: %v", err)
+	}
+	if got["password"] != "s3cret" || got["username"] != "admin" || got["note"] != "https://example.com" {
+		t.Fatalf("unexpected resolved credentials: %v", got)
+	}
+	if nested, _ := got["nested"].(map[string]any); nested["token"] != "8443" {
+		t.Fatalf("nested reference not resolved: %v", got["nested"])
+	}
+	if creds["password"] != "vault:kv/network/f5#password" {
+		t.Fatalf("input credentials were modified")
+	}
+
+	// A scheme without a backend fails instead of passing the reference through
+	_, err = m.ResolveCredentials(context.Background(), map[string]any{"password": "file:/run/secrets/x"})
+	var resolveErr *ResolveError
+	if !errors.As(err, &resolveErr) || resolveErr.Field != "password" {
+		t.Fatalf("expected ResolveError for password, got %v", err)
+	}
+}
+
+func TestManagerCache(t *testing.T) {
+	now := time.Unix(1000, 0)
+	resolver := &countingResolver{value: "v"}
+
+	m := NewManager(time.Minute)
+	m.now = func() time.Time { return now }
+	m.Register(SchemeEnv, resolver)
+
+	for i := 0; i < 3; i++ {
+		if _, err := m.Resolve(context.Background(), SchemeEnv, "DEPLOYER_SECRET_X"); err != nil {
+			t.Fatalf("Resolve: %v", err)
+		}
+	}
+	if resolver.calls != 1 {
+		t.Fatalf("resolver called %d times, want 1", resolver.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := m.Resolve(context.Background(), SchemeEnv, "DEPLOYER_SECRET_X"); err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if resolver.calls != 2 {
+		t.Fatalf("expired entry not refreshed, resolver called %d times", resolver.calls)
+	}
+
+	// Failures are never cached
+	resolver.err = errors.New("boom")
+	m.Purge()
+	for i := 0; i < 2; i++ {
+		if _, err := m.Resolve(context.Background(), SchemeEnv, "DEPLOYER_SECRET_X"); err == nil {
+			t.Fatalf("expected error")
+		}
+	}
+	if resolver.calls != 4 {
+		t.Fatalf("failed lookups should not be cached, resolver called %d times", resolver.calls)
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		in     string
+		scheme string
+		ref    string
+		ok     bool
+	}{
+		{"env:DEPLOYER_SECRET_A", SchemeEnv, "DEPLOYER_SECRET_A", true},
+		{"file:/run/secrets/a", SchemeFile, "/run/secrets/a", true},
+		{"vault:kv/a#b", SchemeVault, "kv/a#b", true},
+		{"plain-password", "", "", false},
+		{"env:", "", "", false},
+		{"https://host", "", "", false},
+	}
+
+	for _, tc := range tests {
+		scheme, ref, ok := ParseReference(tc.in)
+		if scheme != tc.scheme || ref != tc.ref || ok != tc.ok {
+			t.Fatalf("ParseReference(%q) = %q, %q, %v", tc.in, scheme, ref, ok)
+		}
+	}
+}
@@ -12,13 +12,14 @@ import (
 
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
 	"github.com/go-tangra/go-tangra-deployer/internal/cert"
 	"github.com/go-tangra/go-tangra-deployer/internal/data"
 	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/internal/rbac"
 	"github.com/go-tangra/go-tangra-deployer/internal/service"
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 
-	appViewer "github.com/go-tangra/go-tangra-common/viewer"
 	"github.com/go-tangra/go-tangra-common/middleware/audit"
 	"github.com/go-tangra/go-tangra-common/middleware/mtls"
 )
@@ -28,12 +29,13 @@ var publicEndpoints = []string{
 	"/deployer.service.v1.DeployerStatisticsService/HealthCheck",
 }
 
-// systemViewerMiddleware injects system viewer context for all requests
-// This allows the deployer service to bypass tenant privacy checks
-func systemViewerMiddleware() middleware.Middleware {
+// callerMiddleware resolves the caller identity and injects the matching ENT
+// privacy viewer, so every repository query is scoped to the caller's tenant
+// (platform admins see all tenants)
+func callerMiddleware() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			ctx = appViewer.NewSystemViewerContext(ctx)
+			ctx = caller.NewContext(ctx, caller.FromContext(ctx))
 			return handler(ctx, req)
 		}
 	}
@@ -44,13 +46,13 @@ func newGrpcMiddleware(
 	logger log.Logger,
 	collector *metrics.Collector,
 	auditLogRepo *data.AuditLogRepo,
+	authorizer *rbac.Authorizer,
 	tlsEnabled bool,
 ) []middleware.Middleware {
 	var ms []middleware.Middleware
 
 	ms = append(ms, recovery.Recovery())
 	ms = append(ms, collector.Middleware())
-	ms = append(ms, systemViewerMiddleware()) // Inject system viewer for ENT privacy
 
 	// Add mTLS middleware for client certificate authentication
 	// This must run before audit logging to populate client context
@@ -61,6 +63,7 @@ func newGrpcMiddleware(
 		))
 	}
 
+	ms = append(ms, callerMiddleware()) // Inject caller viewer for ENT privacy
 	ms = append(ms, logging.Server(logger))
 
 	// Add audit logging middleware with cryptographic signing
@@ -76,6 +79,9 @@ func newGrpcMiddleware(
 		))
 	}
 
+	// Check RPC permissions after audit logging so that denials are audited
+	ms = append(ms, rbac.Server(authorizer))
+
 	ms = append(ms, validate.Validator())
 
 	return ms
@@ -87,12 +93,19 @@ func NewGRPCServer(
 	certManager *cert.CertManager,
 	collector *metrics.Collector,
 	auditLogRepo *data.AuditLogRepo,
+	authorizer *rbac.Authorizer,
 	targetSvc *service.DeploymentTargetService,
 	configSvc *service.TargetConfigurationService,
 	jobSvc *service.DeploymentJobService,
 	deploymentSvc *service.DeploymentService,
 	statisticsSvc *service.StatisticsService,
 	backupSvc *service.BackupService,
+	auditLogSvc *service.AuditLogService,
+	changeRecordSvc *service.ChangeRecordService,
+	accessControlSvc *service.AccessControlService,
+	driftSvc *service.DriftService,
+	notificationSvc *service.NotificationService,
+	inventorySvc *service.InventoryService,
 ) *grpc.Server {
 	cfg := ctx.GetConfig()
 	logger := ctx.GetLogger()
@@ -103,7 +116,7 @@ func NewGRPCServer(
 
 	// Create gRPC server options
 	opts := []grpc.ServerOption{
-		grpc.Middleware(newGrpcMiddleware(logger, collector, auditLogRepo, tlsEnabled)...),
+		grpc.Middleware(newGrpcMiddleware(logger, collector, auditLogRepo, authorizer, tlsEnabled)...),
 	}
 
 	// Add TLS configuration if certificate manager is available
@@ -139,6 +152,12 @@ func NewGRPCServer(
 	deployerV1.RegisterRedactedDeploymentServiceServer(srv, deploymentSvc, nil)
 	deployerV1.RegisterRedactedDeployerStatisticsServiceServer(srv, statisticsSvc, nil)
 	deployerV1.RegisterRedactedBackupServiceServer(srv, backupSvc, nil)
+	deployerV1.RegisterRedactedAuditLogServiceServer(srv, auditLogSvc, nil)
+	deployerV1.RegisterRedactedChangeRecordServiceServer(srv, changeRecordSvc, nil)
+	deployerV1.RegisterRedactedAccessControlServiceServer(srv, accessControlSvc, nil)
+	deployerV1.RegisterRedactedDriftServiceServer(srv, driftSvc, nil)
+	deployerV1.RegisterRedactedNotificationServiceServer(srv, notificationSvc, nil)
+	deployerV1.RegisterRedactedInventoryServiceServer(srv, inventorySvc, nil)
 
 	l.Info("gRPC server configured with all Deployer services")
 
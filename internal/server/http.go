@@ -1,6 +1,7 @@
 package server
 
 import (
+	"crypto/tls"
 	"io/fs"
 	"net/http"
 	"os"
@@ -9,10 +10,13 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	"github.com/go-tangra/go-tangra-deployer/cmd/server/assets"
+	"github.com/go-tangra/go-tangra-deployer/internal/cert"
+	"github.com/go-tangra/go-tangra-deployer/internal/event"
 )
 
-// NewHTTPServer creates a simple HTTP server for serving the frontend assets.
-func NewHTTPServer(ctx *bootstrap.Context) *kratosHttp.Server {
+// NewHTTPServer creates a simple HTTP server for serving the frontend assets
+// and accepting ingested events.
+func NewHTTPServer(ctx *bootstrap.Context, certManager *cert.CertManager, ingester *event.Ingester) *kratosHttp.Server {
 	l := ctx.NewLoggerHelper("deployer/http")
 
 	addr := os.Getenv("DEPLOYER_HTTP_ADDR")
@@ -20,7 +24,27 @@ func NewHTTPServer(ctx *bootstrap.Context) *kratosHttp.Server {
 		addr = "0.0.0.0:9201"
 	}
 
-	srv := kratosHttp.NewServer(kratosHttp.Address(addr))
+	opts := []kratosHttp.ServerOption{kratosHttp.Address(addr)}
+
+	// Ingestion sources may authenticate with client certificates of the module CA
+	if ingester != nil && ingester.MTLS() {
+		var tlsConfig *tls.Config
+		var err error
+		if certManager != nil && certManager.IsTLSEnabled() {
+			tlsConfig, err = certManager.GetServerTLSConfig()
+		}
+		if err != nil || tlsConfig == nil {
+			l.Warnf("Failed to get TLS config, serving HTTP without mTLS: %v", err)
+		} else {
+			tlsConfig = tlsConfig.Clone()
+			// Browsers of the frontend have no client certificate
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+			opts = append(opts, kratosHttp.TLSConfig(tlsConfig))
+			l.Info("mTLS enabled for HTTP server")
+		}
+	}
+
+	srv := kratosHttp.NewServer(opts...)
 
 	route := srv.Route("/")
 	route.GET("/health", func(ctx kratosHttp.Context) error {
@@ -46,7 +70,10 @@ func NewHTTPServer(ctx *bootstrap.Context) *kratosHttp.Server {
 		return err
 	})
 
-
+	if ingester != nil && ingester.Enabled() {
+		srv.Handle(event.IngestPath, ingester)
+		l.Infof("Accepting CloudEvents on %s", event.IngestPath)
+	}
 
 	fsys, err := fs.Sub(assets.FrontendDist, "frontend-dist")
 	if err == nil {
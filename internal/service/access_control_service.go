@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/rolebinding"
+	"github.com/go-tangra/go-tangra-deployer/internal/rbac"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// AccessControlService implements the AccessControlService gRPC service
+type AccessControlService struct {
+	deployerV1.UnimplementedAccessControlServiceServer
+
+	roleBindingRepo *data.RoleBindingRepo
+	targetRepo      *data.DeploymentTargetRepo
+	authorizer      *rbac.Authorizer
+	log             *log.Helper
+}
+
+// NewAccessControlService creates a new AccessControlService
+func NewAccessControlService(
+	ctx *bootstrap.Context,
+	roleBindingRepo *data.RoleBindingRepo,
+	targetRepo *data.DeploymentTargetRepo,
+	authorizer *rbac.Authorizer,
+) *AccessControlService {
+	return &AccessControlService{
+		roleBindingRepo: roleBindingRepo,
+		targetRepo:      targetRepo,
+		authorizer:      authorizer,
+		log:             ctx.NewLoggerHelper("deployer/service/access-control"),
+	}
+}
+
+// ListRoleBindings lists role bindings
+func (s *AccessControlService) ListRoleBindings(ctx context.Context, req *deployerV1.ListRoleBindingsRequest) (*deployerV1.ListRoleBindingsResponse, error) {
+	opts := &data.RoleBindingListOptions{
+		Subject:            req.Subject,
+		DeploymentTargetID: req.DeploymentTargetId,
+		Page:               1,
+		PageSize:           20,
+	}
+
+	// Only platform admins may look across tenants
+	opts.TenantID = caller.FromContext(ctx).TenantScope(req.TenantId)
+
+	if req.SubjectType != nil && *req.SubjectType != deployerV1.SubjectType_SUBJECT_TYPE_UNSPECIFIED {
+		st := rolebinding.SubjectType(req.SubjectType.String())
+		if err := rolebinding.SubjectTypeValidator(st); err != nil {
+			return nil, deployerV1.ErrorBadRequest("invalid subject_type")
+		}
+		opts.SubjectType = &st
+	}
+
+	if req.Page != nil && *req.Page > 0 {
+		opts.Page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		opts.PageSize = *req.PageSize
+	}
+
+	entities, total, err := s.roleBindingRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.RoleBinding, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, s.roleBindingRepo.ToProto(entity))
+	}
+
+	return &deployerV1.ListRoleBindingsResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
+
+// CreateRoleBinding grants a role to a subject, tenant-wide or on one deployment target
+func (s *AccessControlService) CreateRoleBinding(ctx context.Context, req *deployerV1.CreateRoleBindingRequest) (*deployerV1.CreateRoleBindingResponse, error) {
+	tenantID, err := requestTenantID(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	subjectType := rolebinding.SubjectType(req.SubjectType.String())
+	if err := rolebinding.SubjectTypeValidator(subjectType); err != nil {
+		return nil, deployerV1.ErrorBadRequest("invalid subject_type")
+	}
+	role := rolebinding.Role(req.Role.String())
+	if err := rolebinding.RoleValidator(role); err != nil {
+		return nil, deployerV1.ErrorBadRequest("invalid role")
+	}
+
+	targetID := req.GetDeploymentTargetId()
+	if targetID != "" {
+		target, err := s.targetRepo.GetByID(ctx, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil || target.TenantID == nil || *target.TenantID != tenantID {
+			return nil, deployerV1.ErrorTargetNotFound("deployment target not found")
+		}
+	}
+
+	entity, err := s.roleBindingRepo.Create(ctx, tenantID, subjectType, req.Subject, role, targetID,
+		caller.FromContext(ctx).UserIDPtr())
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("role %s granted to %s %q in tenant %d (target: %q)", role, subjectType, req.Subject, tenantID, targetID)
+
+	return &deployerV1.CreateRoleBindingResponse{
+		Binding: s.roleBindingRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteRoleBinding revokes a role binding
+func (s *AccessControlService) DeleteRoleBinding(ctx context.Context, req *deployerV1.DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	entity, err := s.roleBindingRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, deployerV1.ErrorNotFound("role binding not found")
+	}
+
+	if err := s.roleBindingRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("role binding %d revoked (%s %q, role %s)", entity.ID, entity.SubjectType, entity.Subject, entity.Role)
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetMyPermissions returns the caller's effective roles and permissions
+func (s *AccessControlService) GetMyPermissions(ctx context.Context, req *deployerV1.GetMyPermissionsRequest) (*deployerV1.GetMyPermissionsResponse, error) {
+	id := caller.FromContext(ctx)
+
+	grants, err := s.authorizer.Grants(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &deployerV1.GetMyPermissionsResponse{
+		TenantId:      id.TenantID,
+		PlatformAdmin: id.PlatformAdmin,
+		Roles:         protoRoles(grants.Roles("")),
+		Permissions:   permissionNames(grants.Permissions("")),
+	}
+
+	if targetID := req.GetDeploymentTargetId(); targetID != "" {
+		resp.TargetRoles = protoRoles(grants.Roles(targetID))
+		resp.TargetPermissions = permissionNames(grants.Permissions(targetID))
+	}
+
+	return resp, nil
+}
+
+func protoRoles(roles []rbac.Role) []deployerV1.Role {
+	out := make([]deployerV1.Role, 0, len(roles))
+	for _, r := range roles {
+		out = append(out, data.ProtoRole(r))
+	}
+	return out
+}
+
+func permissionNames(perms []rbac.Permission) []string {
+	out := make([]string, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, string(p))
+	}
+	return out
+}
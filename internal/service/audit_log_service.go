@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+const (
+	// auditVerifyBatchSize is the number of rows loaded per round trip while verifying
+	auditVerifyBatchSize = 500
+	// defaultAuditMaxFindings caps the findings returned when the caller sets no limit
+	defaultAuditMaxFindings = 100
+)
+
+// AuditLogService implements the AuditLogService gRPC service
+type AuditLogService struct {
+	deployerV1.UnimplementedAuditLogServiceServer
+
+	auditLogRepo *data.AuditLogRepo
+	log          *log.Helper
+}
+
+// NewAuditLogService creates a new AuditLogService
+func NewAuditLogService(ctx *bootstrap.Context, auditLogRepo *data.AuditLogRepo) *AuditLogService {
+	return &AuditLogService{
+		auditLogRepo: auditLogRepo,
+		log:          ctx.NewLoggerHelper("deployer/service/audit-log"),
+	}
+}
+
+// VerifyAuditLog recomputes hashes and checks signatures of audit rows in a time range
+func (s *AuditLogService) VerifyAuditLog(ctx context.Context, req *deployerV1.VerifyAuditLogRequest) (*deployerV1.VerifyAuditLogResponse, error) {
+	end := time.Now()
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	start := end.Add(-24 * time.Hour)
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if !start.Before(end) {
+		return nil, deployerV1.ErrorBadRequest("start_time must be before end_time")
+	}
+
+	// Only platform admins may look across tenants
+	tenantID := caller.FromContext(ctx).TenantScope(req.TenantId)
+
+	maxFindings := defaultAuditMaxFindings
+	if req.MaxFindings != nil && *req.MaxFindings > 0 {
+		maxFindings = int(*req.MaxFindings)
+	}
+
+	return s.Verify(ctx, start, end, tenantID, maxFindings)
+}
+
+// Verify walks all audit rows created in [start, end) and checks their seals
+// and their links to the rows they are chained to. A row whose predecessor is
+// gone reveals a deletion, unless retention removed everything up to it; ID
+// gaps alone are not evidence, since sequences skip IDs. Deleting the newest
+// rows leaves no successor to notice it.
+func (s *AuditLogService) Verify(ctx context.Context, start, end time.Time, tenantID *uint32, maxFindings int) (*deployerV1.VerifyAuditLogResponse, error) {
+	sealer := s.auditLogRepo.Sealer()
+
+	resp := &deployerV1.VerifyAuditLogResponse{
+		SigningEnabled: sealer.SigningEnabled(),
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(end),
+	}
+
+	addFinding := func(f *deployerV1.AuditLogFinding) {
+		if len(resp.Findings) >= maxFindings {
+			resp.Truncated = true
+			return
+		}
+		resp.Findings = append(resp.Findings, f)
+	}
+
+	oldestID, err := s.auditLogRepo.OldestID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reported := make(map[uint32]bool)
+
+	var lastID uint32
+	for {
+		rows, err := s.auditLogRepo.ListRange(ctx, &data.AuditLogRangeOptions{
+			TenantID:  tenantID,
+			StartTime: &start,
+			EndTime:   &end,
+			AfterID:   lastID,
+			Limit:     auditVerifyBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var prevIDs []uint32
+		for _, row := range rows {
+			if row.PrevID != nil {
+				prevIDs = append(prevIDs, *row.PrevID)
+			}
+		}
+		chain, err := s.auditLogRepo.ChainHashes(ctx, prevIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			lastID = row.ID
+			resp.CheckedCount++
+
+			switch sealer.Check(row) {
+			case data.AuditIntegrityValid:
+				resp.ValidCount++
+			case data.AuditIntegrityUnsigned:
+				resp.UnsignedCount++
+				if sealer.SigningEnabled() {
+					addFinding(auditFinding(row, deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_MISSING_SIGNATURE,
+						"row has no signature"))
+				}
+			case data.AuditIntegrityHashMismatch:
+				resp.TamperedCount++
+				addFinding(auditFinding(row, deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_HASH_MISMATCH,
+					"stored log hash does not match row content"))
+				continue
+			case data.AuditIntegrityInvalidSignature:
+				resp.TamperedCount++
+				addFinding(auditFinding(row, deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_INVALID_SIGNATURE,
+					"signature does not verify against row content"))
+				continue
+			}
+
+			// Rows written before chaining have no link to check
+			if row.PrevID == nil {
+				continue
+			}
+			prevID := *row.PrevID
+			prevHash, ok := chain[prevID]
+			switch {
+			case !ok && prevID >= oldestID:
+				if reported[prevID] {
+					continue
+				}
+				reported[prevID] = true
+				missing := uint32(1)
+				resp.MissingCount++
+				addFinding(&deployerV1.AuditLogFinding{
+					Kind:         deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_MISSING_ENTRY,
+					Id:           prevID,
+					MissingCount: &missing,
+					Detail:       fmt.Sprintf("audit log %d, which row %d is chained to, was deleted", prevID, row.ID),
+				})
+			case ok && prevHash != row.PrevHash:
+				resp.TamperedCount++
+				addFinding(auditFinding(row, deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_HASH_MISMATCH,
+					fmt.Sprintf("audit log %d no longer has the hash this row is chained to", prevID)))
+			}
+		}
+
+		if len(rows) < auditVerifyBatchSize {
+			break
+		}
+	}
+
+	resp.VerifiedAt = timestamppb.Now()
+
+	if resp.TamperedCount > 0 || resp.MissingCount > 0 {
+		s.log.Warnf("Audit log verification found problems: checked=%d tampered=%d unsigned=%d missing=%d",
+			resp.CheckedCount, resp.TamperedCount, resp.UnsignedCount, resp.MissingCount)
+	}
+
+	return resp, nil
+}
+
+// auditFinding builds a finding for a stored row
+func auditFinding(row *ent.AuditLog, kind deployerV1.AuditFindingKind, detail string) *deployerV1.AuditLogFinding {
+	f := &deployerV1.AuditLogFinding{
+		Kind:      kind,
+		Id:        row.ID,
+		AuditId:   &row.AuditID,
+		Operation: &row.Operation,
+		TenantId:  row.TenantID,
+		Detail:    detail,
+	}
+	if row.CreateTime != nil {
+		f.CreateTime = timestamppb.New(*row.CreateTime)
+	}
+	return f
+}
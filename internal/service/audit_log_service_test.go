@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	entSql "entgo.io/ent/dialect/sql"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-common/middleware/audit"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// newTestAuditLog returns an audit repo with rows written at the given times,
+// alternating between tenants A and B as the audit middleware would write them
+func newTestAuditLog(t *testing.T, times ...time.Time) (*data.AuditLogRepo, *ent.Client) {
+	t.Helper()
+
+	drv, err := entSql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	repo := data.NewAuditLogRepo(testBootstrap, entCrud.NewEntClient(client, drv))
+	for i, at := range times {
+		tenantID := tenantA
+		if i%2 == 1 {
+			tenantID = tenantB
+		}
+		if err := repo.CreateFromEntry(tenantCtx(tenantID), &audit.AuditLogEntry{
+			AuditID:     fmt.Sprintf("audit-%d", i+1),
+			TenantID:    tenantID,
+			Operation:   "/deployer.service.v1.DeploymentService/Deploy",
+			ServiceName: "deployer",
+			Success:     true,
+			Timestamp:   at,
+		}); err != nil {
+			t.Fatalf("CreateFromEntry: %v", err)
+		}
+	}
+	return repo, client
+}
+
+func TestAuditLogService_VerifyFollowsTheHashChain(t *testing.T) {
+	ctx := viewer.WithContext(context.Background(), &systemViewer{})
+	now := time.Now()
+	repo, client := newTestAuditLog(t, now, now, now, now, now)
+	svc := NewAuditLogService(testBootstrap, repo)
+	start, end := now.Add(-time.Hour), now.Add(time.Hour)
+
+	verify := func(tenantID *uint32) *deployerV1.VerifyAuditLogResponse {
+		t.Helper()
+		resp, err := svc.Verify(ctx, start, end, tenantID, 100)
+		if err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+		return resp
+	}
+
+	resp := verify(nil)
+	if resp.CheckedCount != 5 || resp.TamperedCount != 0 || resp.MissingCount != 0 || len(resp.Findings) != 0 {
+		t.Fatalf("intact log: %+v", resp)
+	}
+	// A tenant's rows have ID gaps that are no deletions
+	tenant := tenantA
+	if resp := verify(&tenant); resp.CheckedCount != 3 || resp.MissingCount != 0 || len(resp.Findings) != 0 {
+		t.Fatalf("intact log of tenant A: %+v", resp)
+	}
+
+	// Deleting a row breaks the link of the next one, for every viewer of it
+	client.AuditLog.DeleteOneID(3).ExecX(ctx)
+	for _, tenantID := range []*uint32{nil, &[]uint32{tenantB}[0]} {
+		resp := verify(tenantID)
+		if resp.MissingCount != 1 || len(resp.Findings) != 1 ||
+			resp.Findings[0].Kind != deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_MISSING_ENTRY || resp.Findings[0].Id != 3 {
+			t.Fatalf("log without row 3 (tenant %v): %+v", tenantID, resp)
+		}
+	}
+
+	// Rewriting a row and its hash breaks the link of its successor
+	row := client.AuditLog.GetX(ctx, 1)
+	row.ErrorMessage = "rewritten"
+	logHash, _, err := repo.Sealer().Seal(row)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	client.AuditLog.UpdateOneID(1).SetErrorMessage("rewritten").SetLogHash(logHash).ExecX(ctx)
+
+	resp = verify(nil)
+	var mismatch *deployerV1.AuditLogFinding
+	for _, f := range resp.Findings {
+		if f.Kind == deployerV1.AuditFindingKind_AUDIT_FINDING_KIND_HASH_MISMATCH {
+			mismatch = f
+		}
+	}
+	if resp.TamperedCount != 1 || mismatch == nil || mismatch.Id != 2 {
+		t.Fatalf("log with rewritten row 1: %+v", resp)
+	}
+}
+
+func TestAuditLogRepo_ConcurrentReplicasExtendOneChain(t *testing.T) {
+	// A file database with immediate transactions: SQLite serializes the
+	// writers with its database lock, as Postgres does with the chain head lock
+	dsn := fmt.Sprintf("file:%s?_fk=1&_txlock=immediate&_busy_timeout=10000", filepath.Join(t.TempDir(), "audit.db"))
+	drv, err := entSql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	const perReplica = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*perReplica)
+	for replica := 0; replica < 2; replica++ {
+		repo := data.NewAuditLogRepo(testBootstrap, entCrud.NewEntClient(client, drv))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perReplica; i++ {
+				errs <- repo.CreateFromEntry(tenantCtx(tenantA), &audit.AuditLogEntry{
+					AuditID:     fmt.Sprintf("audit-%d-%d", replica, i),
+					TenantID:    tenantA,
+					Operation:   "/deployer.service.v1.DeploymentService/Deploy",
+					ServiceName: "deployer",
+					Success:     true,
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("CreateFromEntry: %v", err)
+		}
+	}
+
+	ctx := viewer.WithContext(context.Background(), &systemViewer{})
+	rows := client.AuditLog.Query().Order(ent.Asc("id")).AllX(ctx)
+	if len(rows) != 2*perReplica {
+		t.Fatalf("got %d audit rows, want %d", len(rows), 2*perReplica)
+	}
+	for i, row := range rows {
+		if i == 0 {
+			if row.PrevID != nil {
+				t.Fatalf("first row chained to %d", *row.PrevID)
+			}
+			continue
+		}
+		if row.PrevID == nil || *row.PrevID != rows[i-1].ID || row.PrevHash != rows[i-1].LogHash {
+			t.Fatalf("row %d chained to %v, want row %d: the chain forked", row.ID, row.PrevID, rows[i-1].ID)
+		}
+	}
+
+	svc := NewAuditLogService(testBootstrap, data.NewAuditLogRepo(testBootstrap, entCrud.NewEntClient(client, drv)))
+	resp, err := svc.Verify(ctx, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), nil, 100)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if resp.CheckedCount != 2*perReplica || len(resp.Findings) != 0 {
+		t.Fatalf("concurrently written log: %+v", resp)
+	}
+}
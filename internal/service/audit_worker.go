@@ -0,0 +1,272 @@
+package service
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+// auditRetentionInterval is how often expired audit rows are archived and purged
+const auditRetentionInterval = 24 * time.Hour
+
+// AuditWorker periodically verifies audit log integrity and enforces audit retention
+type AuditWorker struct {
+	log          *log.Helper
+	auditLogRepo *data.AuditLogRepo
+	auditService *AuditLogService
+	collector    *metrics.Collector
+	config       *conf.AuditConfig
+	archiveDir   string
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewAuditWorker creates a new audit worker
+func NewAuditWorker(
+	ctx *bootstrap.Context,
+	auditLogRepo *data.AuditLogRepo,
+	auditService *AuditLogService,
+	collector *metrics.Collector,
+) *AuditWorker {
+	var auditCfg *conf.AuditConfig
+	dataDir := "./data"
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			auditCfg = deployerCfg.Audit
+			if deployerCfg.DataDir != "" {
+				dataDir = deployerCfg.DataDir
+			}
+		}
+	}
+
+	// Default config
+	if auditCfg == nil {
+		auditCfg = &conf.AuditConfig{
+			VerifyIntervalMinutes: 60,
+			VerifyWindowHours:     24,
+		}
+	}
+
+	archiveDir := auditCfg.ArchiveDir
+	if archiveDir == "" {
+		archiveDir = filepath.Join(dataDir, "audit-archive")
+	}
+
+	return &AuditWorker{
+		log:          ctx.NewLoggerHelper("deployer/audit-worker"),
+		auditLogRepo: auditLogRepo,
+		auditService: auditService,
+		collector:    collector,
+		config:       auditCfg,
+		archiveDir:   archiveDir,
+	}
+}
+
+// Start starts the verification and retention loops
+func (w *AuditWorker) Start() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return nil
+	}
+
+	// Use system viewer context for background operations (bypasses tenant privacy checks)
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	w.ctx, w.cancel = context.WithCancel(baseCtx)
+	w.running = true
+
+	if w.config.VerifyIntervalMinutes > 0 {
+		w.log.Infof("Starting audit verification every %d minutes", w.config.VerifyIntervalMinutes)
+		w.wg.Add(1)
+		go w.verifyWorker()
+	}
+
+	if w.config.RetentionDays > 0 {
+		w.log.Infof("Starting audit retention: keeping %d days, archiving to %s", w.config.RetentionDays, w.archiveDir)
+		w.wg.Add(1)
+		go w.retentionWorker()
+	}
+
+	return nil
+}
+
+// Stop stops the audit worker
+func (w *AuditWorker) Stop() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return nil
+	}
+
+	w.log.Info("Stopping audit worker")
+	w.cancel()
+	w.wg.Wait()
+	w.running = false
+
+	return nil
+}
+
+// verifyWorker periodically verifies the most recent audit window
+func (w *AuditWorker) verifyWorker() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(w.config.VerifyIntervalMinutes) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.runVerify()
+		}
+	}
+}
+
+// runVerify verifies all rows created within the configured window
+func (w *AuditWorker) runVerify() {
+	window := time.Duration(w.config.VerifyWindowHours) * time.Hour
+	if window <= 0 {
+		window = 24 * time.Hour
+	}
+	end := time.Now()
+
+	result, err := w.auditService.Verify(w.ctx, end.Add(-window), end, nil, defaultAuditMaxFindings)
+	if err != nil {
+		w.log.Errorf("Audit log verification failed: %v", err)
+		return
+	}
+
+	w.collector.AuditVerified(result.TamperedCount, result.UnsignedCount, result.MissingCount)
+	for _, f := range result.Findings {
+		w.log.Warnf("Audit integrity finding: kind=%s id=%d %s", f.Kind, f.Id, f.Detail)
+	}
+}
+
+// retentionWorker periodically archives and deletes expired audit rows
+func (w *AuditWorker) retentionWorker() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(auditRetentionInterval)
+	defer ticker.Stop()
+
+	// Run initial retention pass
+	w.runRetention()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.runRetention()
+		}
+	}
+}
+
+// runRetention archives rows older than the retention period and then deletes them.
+// Rows are only deleted once the archive has been fully written and synced.
+func (w *AuditWorker) runRetention() {
+	cutoff := time.Now().AddDate(0, 0, -int(w.config.RetentionDays))
+
+	archived, path, err := w.archiveBefore(cutoff)
+	if err != nil {
+		w.log.Errorf("Failed to archive audit logs, skipping deletion: %v", err)
+		return
+	}
+	if archived == 0 {
+		return
+	}
+
+	deleted, err := w.auditLogRepo.DeleteOlderThan(w.ctx, cutoff)
+	if err != nil {
+		w.log.Errorf("Failed to delete archived audit logs: %v", err)
+		return
+	}
+
+	w.collector.AuditArchived(deleted)
+	w.log.Infof("Archived %d audit logs to %s and deleted %d", archived, path, deleted)
+}
+
+// archiveBefore writes all rows created before cutoff to a gzip-compressed
+// JSON Lines file and returns the number of rows written
+func (w *AuditWorker) archiveBefore(cutoff time.Time) (int, string, error) {
+	if err := os.MkdirAll(w.archiveDir, 0o750); err != nil {
+		return 0, "", fmt.Errorf("create archive dir: %w", err)
+	}
+
+	path := filepath.Join(w.archiveDir, fmt.Sprintf("audit-%s.jsonl.gz", cutoff.UTC().Format("20060102T150405Z")))
+	tmpPath := path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return 0, "", fmt.Errorf("create archive file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(tmpPath)
+	}()
+
+	gz := gzip.NewWriter(f)
+	enc := json.NewEncoder(gz)
+
+	count := 0
+	var lastID uint32
+	for {
+		rows, err := w.auditLogRepo.ListRange(w.ctx, &data.AuditLogRangeOptions{
+			EndTime: &cutoff,
+			AfterID: lastID,
+			Limit:   auditVerifyBatchSize,
+		})
+		if err != nil {
+			return 0, "", err
+		}
+
+		for _, row := range rows {
+			if err := enc.Encode(row); err != nil {
+				return 0, "", fmt.Errorf("write archive entry: %w", err)
+			}
+			lastID = row.ID
+			count++
+		}
+
+		if len(rows) < auditVerifyBatchSize {
+			break
+		}
+	}
+
+	if count == 0 {
+		return 0, "", nil
+	}
+
+	if err := gz.Close(); err != nil {
+		return 0, "", fmt.Errorf("finish archive: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return 0, "", fmt.Errorf("sync archive: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return 0, "", fmt.Errorf("finalize archive: %w", err)
+	}
+
+	return count, path, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+)
+
+func TestAuditWorker_ArchivesBeforeDeleting(t *testing.T) {
+	ctx := viewer.WithContext(context.Background(), &systemViewer{})
+	now := time.Now()
+	expired := now.AddDate(0, 0, -40)
+	repo, client := newTestAuditLog(t, expired, expired, expired, now, now)
+	svc := NewAuditLogService(testBootstrap, repo)
+
+	worker := NewAuditWorker(testBootstrap, repo, svc, testCollector())
+	worker.config = &conf.AuditConfig{RetentionDays: 30}
+	worker.archiveDir = t.TempDir()
+	worker.ctx = ctx
+
+	worker.runRetention()
+
+	archives, err := filepath.Glob(filepath.Join(worker.archiveDir, "audit-*.jsonl.gz"))
+	if err != nil || len(archives) != 1 {
+		t.Fatalf("archives %v, %v", archives, err)
+	}
+	f, err := os.Open(archives[0])
+	if err != nil {
+		t.Fatalf("open archive: %v", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	var archived []string
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var row ent.AuditLog
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			t.Fatalf("archive entry: %v", err)
+		}
+		archived = append(archived, row.AuditID)
+	}
+	if len(archived) != 3 || archived[0] != "audit-1" || archived[2] != "audit-3" {
+		t.Fatalf("archived %v, want the three expired rows", archived)
+	}
+
+	if remaining := client.AuditLog.Query().CountX(ctx); remaining != 2 {
+		t.Fatalf("%d rows left, want 2", remaining)
+	}
+
+	// Retention is not mistaken for deletion
+	resp, err := svc.Verify(ctx, expired.Add(-time.Hour), now.Add(time.Hour), nil, 100)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if resp.CheckedCount != 2 || resp.MissingCount != 0 || len(resp.Findings) != 0 {
+		t.Fatalf("verification after retention: %+v", resp)
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -234,6 +235,10 @@ func (s *BackupService) importDeploymentTargets(ctx context.Context, client *ent
 		if full && e.TenantID != nil {
 			tid = *e.TenantID
 		}
+		// Archives taken before revocation policies existed
+		if e.RevocationPolicy == "" {
+			e.RevocationPolicy = deploymenttarget.DefaultRevocationPolicy
+		}
 
 		existing, getErr := client.DeploymentTarget.Get(ctx, e.ID)
 		if getErr != nil && !ent.IsNotFound(getErr) {
@@ -250,7 +255,9 @@ func (s *BackupService) importDeploymentTargets(ctx context.Context, client *ent
 				SetName(e.Name).
 				SetDescription(e.Description).
 				SetAutoDeployOnRenewal(e.AutoDeployOnRenewal).
+				SetRevocationPolicy(e.RevocationPolicy).
 				SetCertificateFilters(e.CertificateFilters).
+				SetRetryPolicy(e.RetryPolicy).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				Save(ctx)
@@ -267,7 +274,9 @@ func (s *BackupService) importDeploymentTargets(ctx context.Context, client *ent
 				SetName(e.Name).
 				SetDescription(e.Description).
 				SetAutoDeployOnRenewal(e.AutoDeployOnRenewal).
+				SetRevocationPolicy(e.RevocationPolicy).
 				SetCertificateFilters(e.CertificateFilters).
+				SetRetryPolicy(e.RetryPolicy).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				SetNillableCreateTime(e.CreateTime).
@@ -313,45 +322,55 @@ func (s *BackupService) importTargetConfigurations(ctx context.Context, client *
 				er.Skipped++
 				continue
 			}
-			_, err := client.TargetConfiguration.UpdateOneID(e.ID).
+			updated, err := client.TargetConfiguration.UpdateOneID(e.ID).
 				SetName(e.Name).
 				SetDescription(e.Description).
 				SetProviderType(e.ProviderType).
 				SetCredentialsEncrypted(e.CredentialsEncrypted).
+				SetCredentialRotatedAt(e.CredentialRotatedAt).
 				SetConfig(e.Config).
+				SetRetryPolicy(e.RetryPolicy).
+				SetTLSProbeAddress(e.TLSProbeAddress).
 				SetStatus(e.Status).
 				SetStatusMessage(e.StatusMessage).
 				SetNillableLastDeploymentAt(e.LastDeploymentAt).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
+				AddRevision(1).
 				Save(ctx)
 			if err != nil {
 				result.AddWarning(fmt.Sprintf("targetConfigurations: update %s: %v", e.ID, err))
 				er.Failed++
 				continue
 			}
+			s.captureRestoredRevision(ctx, client, updated, result)
 			er.Updated++
 		} else {
-			_, err := client.TargetConfiguration.Create().
+			created, err := client.TargetConfiguration.Create().
 				SetID(e.ID).
 				SetNillableTenantID(&tid).
 				SetName(e.Name).
 				SetDescription(e.Description).
 				SetProviderType(e.ProviderType).
 				SetCredentialsEncrypted(e.CredentialsEncrypted).
+				SetCredentialRotatedAt(e.CredentialRotatedAt).
 				SetConfig(e.Config).
+				SetRetryPolicy(e.RetryPolicy).
+				SetTLSProbeAddress(e.TLSProbeAddress).
 				SetStatus(e.Status).
 				SetStatusMessage(e.StatusMessage).
 				SetNillableLastDeploymentAt(e.LastDeploymentAt).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				SetNillableCreateTime(e.CreateTime).
+				SetRevision(1).
 				Save(ctx)
 			if err != nil {
 				result.AddWarning(fmt.Sprintf("targetConfigurations: create %s: %v", e.ID, err))
 				er.Failed++
 				continue
 			}
+			s.captureRestoredRevision(ctx, client, created, result)
 			er.Created++
 		}
 	}
@@ -359,6 +378,31 @@ func (s *BackupService) importTargetConfigurations(ctx context.Context, client *
 	result.AddResult(er)
 }
 
+// captureRestoredRevision stores the restored state of a configuration as its
+// current revision, so jobs never run against a stale revision snapshot
+func (s *BackupService) captureRestoredRevision(ctx context.Context, client *ent.Client, e *ent.TargetConfiguration, result *backup.RestoreResult) {
+	builder := client.ConfigurationRevision.Create().
+		SetTargetConfigurationID(e.ID).
+		SetRevision(e.Revision).
+		SetName(e.Name).
+		SetProviderType(e.ProviderType).
+		SetCredentialsEncrypted(e.CredentialsEncrypted).
+		SetNillableTenantID(e.TenantID).
+		SetActorName("system").
+		SetReason("Restored from backup").
+		SetCreateTime(time.Now())
+	if e.Description != "" {
+		builder.SetDescription(e.Description)
+	}
+	if e.Config != nil {
+		builder.SetConfig(e.Config)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		result.AddWarning(fmt.Sprintf("targetConfigurations: revision %s: %v", e.ID, err))
+	}
+}
+
 func (s *BackupService) importDeploymentJobs(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
 	jobs, err := backup.GetEntities[ent.DeploymentJob](a, "deploymentJobs")
 	if err != nil {
@@ -376,6 +420,10 @@ func (s *BackupService) importDeploymentJobs(ctx context.Context, client *ent.Cl
 		if full && e.TenantID != nil {
 			tid = *e.TenantID
 		}
+		// Archives taken before jobs could remove certificates
+		if e.Action == "" {
+			e.Action = deploymentjob.DefaultAction
+		}
 
 		existing, getErr := client.DeploymentJob.Get(ctx, e.ID)
 		if getErr != nil && !ent.IsNotFound(getErr) {
@@ -394,13 +442,20 @@ func (s *BackupService) importDeploymentJobs(ctx context.Context, client *ent.Cl
 				SetNillableParentJobID(e.ParentJobID).
 				SetCertificateID(e.CertificateID).
 				SetCertificateSerial(e.CertificateSerial).
+				SetCertificateLineage(e.CertificateLineage).
+				SetCertificateCommonName(e.CertificateCommonName).
+				SetAction(e.Action).
+				SetNillableIdempotencyKey(e.IdempotencyKey).
+				SetNillableSupersededByJobID(e.SupersededByJobID).
 				SetStatus(e.Status).
 				SetStatusMessage(e.StatusMessage).
 				SetProgress(e.Progress).
 				SetRetryCount(e.RetryCount).
 				SetMaxRetries(e.MaxRetries).
+				SetRetryPolicy(e.RetryPolicy).
 				SetTriggeredBy(e.TriggeredBy).
 				SetResult(e.Result).
+				SetNillableErrorCategory(e.ErrorCategory).
 				SetNillableStartedAt(e.StartedAt).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
@@ -419,13 +474,20 @@ func (s *BackupService) importDeploymentJobs(ctx context.Context, client *ent.Cl
 				SetNillableParentJobID(e.ParentJobID).
 				SetCertificateID(e.CertificateID).
 				SetCertificateSerial(e.CertificateSerial).
+				SetCertificateLineage(e.CertificateLineage).
+				SetCertificateCommonName(e.CertificateCommonName).
+				SetAction(e.Action).
+				SetNillableIdempotencyKey(e.IdempotencyKey).
+				SetNillableSupersededByJobID(e.SupersededByJobID).
 				SetStatus(e.Status).
 				SetStatusMessage(e.StatusMessage).
 				SetProgress(e.Progress).
 				SetRetryCount(e.RetryCount).
 				SetMaxRetries(e.MaxRetries).
+				SetRetryPolicy(e.RetryPolicy).
 				SetTriggeredBy(e.TriggeredBy).
 				SetResult(e.Result).
+				SetNillableErrorCategory(e.ErrorCategory).
 				SetNillableStartedAt(e.StartedAt).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
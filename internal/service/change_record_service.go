@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// ChangeRecordService implements the ChangeRecordService gRPC service
+type ChangeRecordService struct {
+	deployerV1.UnimplementedChangeRecordServiceServer
+
+	changeRecordRepo *data.ChangeRecordRepo
+	log              *log.Helper
+}
+
+// NewChangeRecordService creates a new ChangeRecordService
+func NewChangeRecordService(ctx *bootstrap.Context, changeRecordRepo *data.ChangeRecordRepo) *ChangeRecordService {
+	return &ChangeRecordService{
+		changeRecordRepo: changeRecordRepo,
+		log:              ctx.NewLoggerHelper("deployer/service/change-record"),
+	}
+}
+
+// ListChangeRecords lists change records of targets and configurations, newest first
+func (s *ChangeRecordService) ListChangeRecords(ctx context.Context, req *deployerV1.ListChangeRecordsRequest) (*deployerV1.ListChangeRecordsResponse, error) {
+	opts := &data.ChangeRecordListOptions{
+		ResourceID: req.ResourceId,
+		ActorID:    req.ActorId,
+		Page:       1,
+		PageSize:   20,
+	}
+
+	// Only platform admins may look across tenants
+	opts.TenantID = caller.FromContext(ctx).TenantScope(req.TenantId)
+
+	if req.ResourceType != nil && *req.ResourceType != deployerV1.ChangeResourceType_CHANGE_RESOURCE_TYPE_UNSPECIFIED {
+		rt := changerecord.ResourceType(req.ResourceType.String())
+		if err := changerecord.ResourceTypeValidator(rt); err != nil {
+			return nil, deployerV1.ErrorBadRequest("invalid resource_type")
+		}
+		opts.ResourceType = &rt
+	}
+	if req.Action != nil && *req.Action != deployerV1.ChangeAction_CHANGE_ACTION_UNSPECIFIED {
+		action := changerecord.Action(req.Action.String())
+		if err := changerecord.ActionValidator(action); err != nil {
+			return nil, deployerV1.ErrorBadRequest("invalid action")
+		}
+		opts.Action = &action
+	}
+	if req.CreatedAfter != nil {
+		t := req.CreatedAfter.AsTime()
+		opts.CreatedAfter = &t
+	}
+	if req.CreatedBefore != nil {
+		t := req.CreatedBefore.AsTime()
+		opts.CreatedBefore = &t
+	}
+	if opts.CreatedAfter != nil && opts.CreatedBefore != nil && opts.CreatedBefore.Before(*opts.CreatedAfter) {
+		return nil, deployerV1.ErrorBadRequest("created_after must not be after created_before")
+	}
+
+	if req.Page != nil && *req.Page > 0 {
+		opts.Page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		opts.PageSize = *req.PageSize
+	}
+
+	entities, total, err := s.changeRecordRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.ChangeRecord, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, s.changeRecordRepo.ToProto(entity))
+	}
+
+	return &deployerV1.ListChangeRecordsResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+)
+
+// maskedValue replaces secret values in change records
+const maskedValue = "******"
+
+// secretKeyHints mark config keys whose values must never be stored in clear text
+var secretKeyHints = []string{"password", "passwd", "secret", "token", "private_key", "privatekey", "api_key", "apikey"}
+
+// changeRecorder writes business-level change records for service mutations.
+// Failures are logged and never fail the mutation itself.
+type changeRecorder struct {
+	repo *data.ChangeRecordRepo
+	log  *log.Helper
+}
+
+func newChangeRecorder(repo *data.ChangeRecordRepo, logger *log.Helper) *changeRecorder {
+	return &changeRecorder{repo: repo, log: logger}
+}
+
+// record stores a change record, filling in the actor from the request context
+func (r *changeRecorder) record(ctx context.Context, in *data.ChangeRecordInput) {
+	if r == nil || r.repo == nil {
+		return
+	}
+
+	// Updates that did not change anything are not worth a record
+	if in.Action == changerecord.ActionCHANGE_ACTION_UPDATE && len(in.Changes) == 0 {
+		return
+	}
+
+	actor := caller.FromContext(ctx)
+	in.ActorID = actor.UserIDPtr()
+	in.ActorName = actor.Name()
+	if in.TenantID == 0 {
+		in.TenantID = actor.TenantID
+	}
+
+	if _, err := r.repo.Create(ctx, in); err != nil {
+		r.log.Errorf("Failed to record %s of %s %s: %v", in.Action, in.ResourceType, in.ResourceID, err)
+	}
+}
+
+// targetSnapshot flattens the user-visible state of a deployment target.
+// Linked configurations are included when the edge has been loaded.
+func targetSnapshot(t *data.DeploymentTarget) map[string]any {
+	if t == nil {
+		return nil
+	}
+
+	snapshot := map[string]any{
+		"name":                   t.Name,
+		"description":            t.Description,
+		"auto_deploy_on_renewal": t.AutoDeployOnRenewal,
+		"revocation_policy":      string(t.RevocationPolicy),
+		"certificate_filters":    t.CertificateFilters,
+		"retry_policy":           retryPolicySnapshot(t.RetryPolicy),
+	}
+
+	if t.Edges.Configurations != nil {
+		configs := make(map[string]any, len(t.Edges.Configurations))
+		for _, c := range t.Edges.Configurations {
+			configs[c.ID] = c.Name
+		}
+		snapshot["configurations"] = configs
+	}
+
+	return snapshot
+}
+
+// configurationSnapshot flattens the user-visible state of a target configuration.
+// Credentials are included only when given; their values are always masked in diffs.
+func configurationSnapshot(c *data.TargetConfiguration, credentials map[string]any) map[string]any {
+	if c == nil {
+		return nil
+	}
+
+	snapshot := map[string]any{
+		"name":          c.Name,
+		"description":   c.Description,
+		"provider_type": c.ProviderType,
+		"status":        string(c.Status),
+		"config":        c.Config,
+		"retry_policy":  retryPolicySnapshot(c.RetryPolicy),
+	}
+	if c.TLSProbeAddress != "" {
+		snapshot["tls_probe_address"] = c.TLSProbeAddress
+	}
+	if credentials != nil {
+		snapshot["credentials"] = credentials
+	}
+
+	return snapshot
+}
+
+// retryPolicySnapshot flattens the fields set in a retry policy override
+func retryPolicySnapshot(p *registry.RetryPolicy) map[string]any {
+	snapshot := map[string]any{}
+	if p == nil {
+		return snapshot
+	}
+	if p.MaxRetries != nil {
+		snapshot["max_retries"] = *p.MaxRetries
+	}
+	if p.InitialDelaySeconds != nil {
+		snapshot["initial_delay_seconds"] = *p.InitialDelaySeconds
+	}
+	if p.BackoffMultiplier != nil {
+		snapshot["backoff_multiplier"] = *p.BackoffMultiplier
+	}
+	if p.Jitter != nil {
+		snapshot["jitter"] = *p.Jitter
+	}
+	if p.MaxDelaySeconds != nil {
+		snapshot["max_delay_seconds"] = *p.MaxDelaySeconds
+	}
+	if p.AttemptTimeoutSeconds != nil {
+		snapshot["attempt_timeout_seconds"] = *p.AttemptTimeoutSeconds
+	}
+	return snapshot
+}
+
+// diffSnapshots returns the field-level differences between two snapshots,
+// sorted by field path. A nil snapshot means the resource did not exist.
+func diffSnapshots(before, after map[string]any) []schema.FieldChange {
+	oldFields := map[string]any{}
+	newFields := map[string]any{}
+	flattenInto(oldFields, "", before)
+	flattenInto(newFields, "", after)
+
+	paths := make([]string, 0, len(oldFields)+len(newFields))
+	for p := range oldFields {
+		paths = append(paths, p)
+	}
+	for p := range newFields {
+		if _, ok := oldFields[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	var changes []schema.FieldChange
+	for _, p := range paths {
+		oldVal, hadOld := oldFields[p]
+		newVal, hasNew := newFields[p]
+		if hadOld && hasNew && *renderValue(oldVal, false) == *renderValue(newVal, false) {
+			continue
+		}
+
+		change := schema.FieldChange{Field: p, Masked: isSecretPath(p)}
+		if hadOld {
+			change.Before = renderValue(oldVal, change.Masked)
+		}
+		if hasNew {
+			change.After = renderValue(newVal, change.Masked)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// flattenInto walks nested maps and stores leaf values under dotted paths
+func flattenInto(out map[string]any, prefix string, value map[string]any) {
+	for k, v := range value {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			flattenInto(out, path, nested)
+			continue
+		}
+		out[path] = v
+	}
+}
+
+// isSecretPath reports whether values at the given path must be masked
+func isSecretPath(path string) bool {
+	if path == "credentials" || strings.HasPrefix(path, "credentials.") {
+		return true
+	}
+	lower := strings.ToLower(path)
+	for _, hint := range secretKeyHints {
+		if strings.Contains(lower, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderValue renders a snapshot value as a string
+func renderValue(v any, masked bool) *string {
+	var s string
+	switch {
+	case masked:
+		s = maskedValue
+	case v == nil:
+		s = "null"
+	default:
+		if str, ok := v.(string); ok {
+			s = str
+		} else if b, err := json.Marshal(v); err == nil {
+			s = string(b)
+		} else {
+			s = fmt.Sprintf("%v", v)
+		}
+	}
+	return &s
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	// render flattens a diff to "field: before -> after", with "-" for an absent side
+	render := func(changes []schema.FieldChange) []string {
+		var out []string
+		for _, c := range changes {
+			before, after := "-", "-"
+			if c.Before != nil {
+				before = *c.Before
+			}
+			if c.After != nil {
+				after = *c.After
+			}
+			line := c.Field + ": " + before + " -> " + after
+			if c.Masked {
+				line += " (masked)"
+			}
+			out = append(out, line)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		name          string
+		before, after map[string]any
+		want          []string
+	}{
+		{
+			"unchanged fields omitted",
+			map[string]any{"name": "edge-1", "port": 443, "enabled": true},
+			map[string]any{"name": "edge-2", "port": 443, "enabled": true},
+			[]string{"name: edge-1 -> edge-2"},
+		},
+		{
+			"numbers reloaded from JSON compare equal",
+			map[string]any{"port": 443},
+			map[string]any{"port": float64(443)},
+			nil,
+		},
+		{
+			"nested maps",
+			map[string]any{"config": map[string]any{
+				"partition": "Common",
+				"tls":       map[string]any{"min_version": "1.2", "profile": "clientssl"},
+			}},
+			map[string]any{"config": map[string]any{
+				"partition": "Edge",
+				"tls":       map[string]any{"min_version": "1.2", "ciphers": []string{"ECDHE"}},
+			}},
+			[]string{
+				"config.partition: Common -> Edge",
+				`config.tls.ciphers: - -> ["ECDHE"]`,
+				"config.tls.profile: clientssl -> -",
+			},
+		},
+		{
+			"credential paths masked",
+			map[string]any{
+				"credentials": map[string]any{"username": "admin", "password": "old"},
+				"config":      map[string]any{"api_token": "t1", "host": "a"},
+			},
+			map[string]any{
+				"credentials": map[string]any{"username": "root", "password": "new"},
+				"config":      map[string]any{"api_token": "t2", "host": "a"},
+			},
+			[]string{
+				"config.api_token: ****** -> ****** (masked)",
+				"credentials.password: ****** -> ****** (masked)",
+				"credentials.username: ****** -> ****** (masked)",
+			},
+		},
+		{
+			"unchanged secrets omitted",
+			map[string]any{"credentials": map[string]any{"password": "same"}},
+			map[string]any{"credentials": map[string]any{"password": "same"}},
+			nil,
+		},
+		{
+			"created",
+			nil,
+			map[string]any{"name": "edge-1", "credentials": map[string]any{"password": "p"}},
+			[]string{"credentials.password: - -> ****** (masked)", "name: - -> edge-1"},
+		},
+		{
+			"deleted",
+			map[string]any{"name": "edge-1", "description": nil},
+			nil,
+			[]string{"description: null -> -", "name: edge-1 -> -"},
+		},
+	} {
+		if got := render(diffSnapshots(tc.before, tc.after)); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: diff = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestIsSecretPath(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		want bool
+	}{
+		{"credentials", true},
+		{"credentials.username", true},
+		{"config.Password", true},
+		{"config.vault.api_key", true},
+		{"config.auth.bearer_token", true},
+		{"config.private_key_ref", true},
+		{"config.partition", false},
+		{"name", false},
+		{"retry_policy.max_attempts", false},
+	} {
+		if got := isSecretPath(tc.path); got != tc.want {
+			t.Errorf("isSecretPath(%q) = %v, want %v", tc.path, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+)
+
+const (
+	defaultCircuitBreakerThreshold = 5
+	defaultCircuitProbeInterval    = 5 * time.Minute
+	// circuitProbeBatch bounds the configurations probed per run
+	circuitProbeBatch = 20
+	// circuitProbeTick is how often the executor looks for due probes
+	circuitProbeTick = 30 * time.Second
+	// circuitProbeTimeout bounds a single credential probe
+	circuitProbeTimeout = time.Minute
+)
+
+// CircuitBreaker stops deploying to configurations that keep failing. After
+// the configured number of failed attempts in a row a configuration moves to
+// ERROR (the circuit opens); its jobs then wait or fail fast until a probe
+// validating its credentials succeeds and moves it back to ACTIVE.
+type CircuitBreaker struct {
+	log           *log.Helper
+	configRepo    *data.TargetConfigurationRepo
+	configService *TargetConfigurationService
+	collector     *metrics.Collector
+
+	threshold     int32
+	probeInterval time.Duration
+	failFast      bool
+}
+
+// NewCircuitBreaker creates the circuit breaker from the jobs configuration
+func NewCircuitBreaker(
+	ctx *bootstrap.Context,
+	configRepo *data.TargetConfigurationRepo,
+	configService *TargetConfigurationService,
+	collector *metrics.Collector,
+) *CircuitBreaker {
+	var cfg *conf.JobConfig
+	if c, ok := ctx.GetCustomConfig("deployer"); ok && c != nil {
+		if deployerCfg, ok := c.(*conf.Deployer); ok {
+			cfg = deployerCfg.Jobs
+		}
+	}
+
+	threshold := cfg.GetCircuitBreakerThreshold()
+	if threshold == 0 {
+		threshold = defaultCircuitBreakerThreshold
+	}
+	probeInterval := defaultCircuitProbeInterval
+	if v := cfg.GetCircuitBreakerProbeSeconds(); v > 0 {
+		probeInterval = time.Duration(v) * time.Second
+	}
+
+	return &CircuitBreaker{
+		log:           ctx.NewLoggerHelper("deployer/circuit-breaker"),
+		configRepo:    configRepo,
+		configService: configService,
+		collector:     collector,
+		threshold:     threshold,
+		probeInterval: probeInterval,
+		failFast:      cfg.GetCircuitBreakerFailFast(),
+	}
+}
+
+// IsOpen reports whether jobs may not deploy to config
+func (b *CircuitBreaker) IsOpen(config *data.TargetConfiguration) bool {
+	return config.Status == targetconfiguration.StatusCONFIG_STATUS_ERROR
+}
+
+// FailFast reports whether jobs for an open circuit fail instead of waiting
+func (b *CircuitBreaker) FailFast() bool {
+	return b.failFast
+}
+
+// WaitingConfigurations returns the IDs of configurations whose jobs wait for
+// the circuit to close; none when jobs fail fast instead
+func (b *CircuitBreaker) WaitingConfigurations(ctx context.Context) ([]string, error) {
+	if b.failFast {
+		return nil, nil
+	}
+	return b.configRepo.ListIDsByStatus(ctx, targetconfiguration.StatusCONFIG_STATUS_ERROR)
+}
+
+// RecordResult counts the outcome of a deployment attempt to config. category
+// is empty for a success. Rate limits and conflicts say nothing about the
+// health of the device and are not counted.
+func (b *CircuitBreaker) RecordResult(ctx context.Context, config *data.TargetConfiguration, category registry.ErrorCategory, message string) {
+	switch category {
+	case "":
+		if config.ConsecutiveFailures == 0 {
+			return
+		}
+		if err := b.configRepo.RecordDeploymentSuccess(ctx, config.ID); err != nil {
+			b.log.Warnf("Failed to reset failures of configuration %s: %v", config.ID, err)
+		}
+		return
+	case registry.CategoryRateLimited, registry.CategoryConflict:
+		return
+	}
+	if b.threshold < 0 {
+		return
+	}
+
+	oldStatus, err := b.configRepo.RecordDeploymentFailure(ctx, config.ID, b.threshold, message, time.Now().Add(b.probeInterval))
+	if err != nil {
+		b.log.Warnf("Failed to record failure of configuration %s: %v", config.ID, err)
+		return
+	}
+	if oldStatus != "" {
+		b.log.Warnf("Configuration %s failed %d times in a row, circuit opened: %s", config.ID, b.threshold, message)
+		b.collector.ConfigStatusChanged(string(oldStatus), string(targetconfiguration.StatusCONFIG_STATUS_ERROR))
+	}
+}
+
+// Open opens the circuit of an active configuration at once, e.g. when a
+// health check found its credentials rejected
+func (b *CircuitBreaker) Open(ctx context.Context, config *data.TargetConfiguration, message string) {
+	opened, err := b.configRepo.OpenCircuit(ctx, config.ID, targetconfiguration.StatusCONFIG_STATUS_ACTIVE, message, time.Now().Add(b.probeInterval))
+	if err != nil {
+		b.log.Warnf("Failed to open circuit of configuration %s: %v", config.ID, err)
+		return
+	}
+	if opened {
+		b.log.Warnf("Circuit of configuration %s opened: %s", config.ID, message)
+		b.collector.ConfigStatusChanged(string(targetconfiguration.StatusCONFIG_STATUS_ACTIVE), string(targetconfiguration.StatusCONFIG_STATUS_ERROR))
+	}
+}
+
+// ProbeDue validates the credentials of the configurations whose open circuit
+// is due for a probe, closing the circuit of those that pass
+func (b *CircuitBreaker) ProbeDue(ctx context.Context) {
+	configs, err := b.configRepo.ListDueProbes(ctx, circuitProbeBatch)
+	if err != nil {
+		b.log.Errorf("Failed to list configurations due for a probe: %v", err)
+		return
+	}
+	for _, config := range configs {
+		b.probe(ctx, config)
+	}
+}
+
+// probe validates the current credentials of config against its device
+func (b *CircuitBreaker) probe(ctx context.Context, config *data.TargetConfiguration) {
+	nextProbeAt := time.Now().Add(b.probeInterval)
+	claimed, err := b.configRepo.ClaimProbe(ctx, config.ID, *config.NextProbeAt, nextProbeAt)
+	if err != nil || !claimed {
+		return
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, circuitProbeTimeout)
+	err = b.configService.CheckCredentials(probeCtx, config)
+	cancel()
+	validationErr := ""
+	if err != nil {
+		validationErr = err.Error()
+	}
+	if err := b.configRepo.RecordValidation(ctx, config.ID, validationErr); err != nil {
+		b.log.Warnf("Failed to record probe of configuration %s: %v", config.ID, err)
+	}
+	if err != nil {
+		b.log.Infof("Probe of configuration %s failed, circuit stays open: %v", config.ID, err)
+		if err := b.configRepo.ScheduleProbe(ctx, config.ID, nextProbeAt, "Probe failed: "+err.Error()); err != nil {
+			b.log.Warnf("Failed to schedule probe of configuration %s: %v", config.ID, err)
+		}
+		return
+	}
+
+	closed, err := b.configRepo.CloseCircuit(ctx, config.ID, "Recovered: credentials validated")
+	if err != nil {
+		b.log.Warnf("Failed to close circuit of configuration %s: %v", config.ID, err)
+		return
+	}
+	if closed {
+		b.log.Infof("Probe of configuration %s succeeded, circuit closed", config.ID)
+		b.collector.ConfigStatusChanged(string(targetconfiguration.StatusCONFIG_STATUS_ERROR), string(targetconfiguration.StatusCONFIG_STATUS_ACTIVE))
+	}
+}
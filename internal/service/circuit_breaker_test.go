@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+)
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	ctx := tenantCtx(tenantA)
+	configRepo := f.configs.configRepo
+
+	breaker := NewCircuitBreaker(testBootstrap, configRepo, f.configs, testCollector())
+	breaker.threshold = 2
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+
+	reload := func() targetconfiguration.Status {
+		t.Helper()
+		config, err := configRepo.GetByID(ctx, configID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		return config.Status
+	}
+	record := func(category registry.ErrorCategory) {
+		t.Helper()
+		config, err := configRepo.GetByID(ctx, configID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		breaker.RecordResult(ctx, config, category, "connection refused")
+	}
+
+	record(registry.CategoryTransient)
+	// A success in between resets the count
+	record("")
+	record(registry.CategoryTransient)
+	// Rate limits say nothing about the device
+	record(registry.CategoryRateLimited)
+	if got := reload(); got != targetconfiguration.StatusCONFIG_STATUS_ACTIVE {
+		t.Fatalf("status after one failure in a row: %s, want ACTIVE", got)
+	}
+
+	record(registry.CategoryTransient)
+	config, err := configRepo.GetByID(ctx, configID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if !breaker.IsOpen(config) || config.NextProbeAt == nil {
+		t.Fatalf("status after two failures in a row: %s, want ERROR with a probe scheduled", config.Status)
+	}
+
+	// Not due yet: the probe must not run
+	breaker.ProbeDue(systemCtx)
+	if got := reload(); got != targetconfiguration.StatusCONFIG_STATUS_ERROR {
+		t.Fatalf("status before the probe is due: %s, want ERROR", got)
+	}
+
+	if err := configRepo.ScheduleProbe(ctx, configID, time.Now().Add(-time.Second), config.StatusMessage); err != nil {
+		t.Fatalf("ScheduleProbe: %v", err)
+	}
+	breaker.ProbeDue(systemCtx)
+	config, err = configRepo.GetByID(ctx, configID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if config.Status != targetconfiguration.StatusCONFIG_STATUS_ACTIVE || config.ConsecutiveFailures != 0 || config.NextProbeAt != nil {
+		t.Fatalf("after a successful probe: status %s, failures %d, next probe %v; want ACTIVE, 0, none",
+			config.Status, config.ConsecutiveFailures, config.NextProbeAt)
+	}
+}
+
+func TestCircuitBreaker_IgnoresCertificateLoadFailures(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+	configRepo := f.configs.configRepo
+
+	breaker := NewCircuitBreaker(testBootstrap, configRepo, f.configs, testCollector())
+	breaker.threshold = 1
+
+	// Without an LCM client no certificate can be loaded
+	executor := NewJobExecutor(testBootstrap, f.jobs.jobRepo, configRepo, f.jobs.historyRepo, f.configs,
+		nil, nil, nil, data.NewRetryPolicies(testBootstrap), nil, breaker, testCollector())
+	executor.ctx = systemCtx
+
+	job := f.client.DeploymentJob.Query().
+		Where(deploymentjob.TargetConfigurationIDEQ(configID)).
+		OnlyX(systemCtx)
+	if err := executor.processJob(job); err != nil {
+		t.Fatalf("processJob: %v", err)
+	}
+
+	job = f.client.DeploymentJob.GetX(systemCtx, job.ID)
+	if job.Status != deploymentjob.StatusJOB_STATUS_RETRYING || job.ErrorCategory == nil || *job.ErrorCategory != deploymentjob.ErrorCategoryERROR_CATEGORY_TRANSIENT {
+		t.Fatalf("job after an LCM outage: status %s, category %v; want RETRYING, transient", job.Status, job.ErrorCategory)
+	}
+	config, err := configRepo.GetByID(systemCtx, configID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if config.Status != targetconfiguration.StatusCONFIG_STATUS_ACTIVE || config.ConsecutiveFailures != 0 {
+		t.Fatalf("configuration after an LCM outage: status %s, failures %d; want ACTIVE, 0", config.Status, config.ConsecutiveFailures)
+	}
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tx7do/go-crud/viewer"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/providers/bigip"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+func TestPatchCredentials(t *testing.T) {
+	provider, err := registry.Get(bigip.ProviderType)
+	if err != nil {
+		t.Fatalf("registry.Get: %v", err)
+	}
+	current := map[string]any{"host": "lb.example.com", "username": "admin", "password": "s3cret", "partition_token": "t1"}
+
+	for _, tc := range []struct {
+		name    string
+		patch   map[string]any
+		clear   []string
+		want    map[string]any
+		wantErr func(error) bool
+	}{
+		{
+			name:  "omitted fields keep their stored value",
+			patch: map[string]any{"password": "rotated"},
+			want:  map[string]any{"host": "lb.example.com", "username": "admin", "password": "rotated", "partition_token": "t1"},
+		},
+		{
+			name:  "cleared optional field is removed",
+			clear: []string{"partition_token"},
+			want:  map[string]any{"host": "lb.example.com", "username": "admin", "password": "s3cret"},
+		},
+		{
+			name:    "required field cannot be cleared",
+			clear:   []string{"password"},
+			wantErr: deployerV1.IsCredentialsInvalid,
+		},
+		{
+			name:    "field both patched and cleared",
+			patch:   map[string]any{"partition_token": "t2"},
+			clear:   []string{"partition_token"},
+			wantErr: deployerV1.IsBadRequest,
+		},
+	} {
+		got, err := patchCredentials(provider, bigip.ProviderType, current, tc.patch, tc.clear)
+		if tc.wantErr != nil {
+			if !tc.wantErr(err) {
+				t.Errorf("%s: got %v, want error", tc.name, err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %v, %v, want %v", tc.name, got, err, tc.want)
+		}
+	}
+
+	if current["password"] != "s3cret" || len(current) != 4 {
+		t.Fatalf("patchCredentials modified the stored credentials: %v", current)
+	}
+}
+
+func TestCredentialRotation(t *testing.T) {
+	earlier := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := earlier.Add(48 * time.Hour)
+
+	got := credentialRotation(
+		map[string]time.Time{"username": earlier, "password": earlier, "token": earlier},
+		map[string]any{"username": "admin", "password": "old", "token": "t"},
+		map[string]any{"username": "admin", "password": "new", "api_key": "k"},
+		now,
+	)
+	want := map[string]time.Time{"username": earlier, "password": now, "api_key": now}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("credentialRotation = %v, want %v", got, want)
+	}
+}
+
+func TestUpdateConfiguration_CredentialPatchKeepsOmittedSecrets(t *testing.T) {
+	f := newIsolationFixture(t)
+	ctx := tenantCtx(tenantA)
+
+	creds, _ := structpb.NewStruct(map[string]any{"token": "t1", "password": "p1"})
+	created, err := f.configs.CreateConfiguration(ctx, &deployerV1.CreateConfigurationRequest{
+		Name:         "patched",
+		ProviderType: "dummy",
+		Credentials:  creds,
+	})
+	if err != nil {
+		t.Fatalf("CreateConfiguration: %v", err)
+	}
+	configID := created.GetConfiguration().GetId()
+	if created.GetConfiguration().GetCredentials()["token"].GetLastRotatedAt() == nil {
+		t.Fatalf("created credentials %v carry no rotation time", created.GetConfiguration().GetCredentials())
+	}
+	tokenRotated := created.GetConfiguration().GetCredentials()["token"].GetLastRotatedAt().AsTime()
+	mark := time.Now()
+
+	patch, _ := structpb.NewStruct(map[string]any{"password": "p2"})
+	updated, err := f.configs.UpdateConfiguration(ctx, &deployerV1.UpdateConfigurationRequest{
+		Id:              configID,
+		CredentialPatch: patch,
+	})
+	if err != nil {
+		t.Fatalf("UpdateConfiguration: %v", err)
+	}
+
+	stored, err := f.configs.GetDecryptedCredentials(ctx, configID)
+	if err != nil {
+		t.Fatalf("GetDecryptedCredentials: %v", err)
+	}
+	if !reflect.DeepEqual(stored, map[string]any{"token": "t1", "password": "p2"}) {
+		t.Fatalf("stored credentials %v, want the token kept and the password patched", stored)
+	}
+
+	status := updated.GetConfiguration().GetCredentials()
+	if token := status["token"]; !token.GetSet() || !token.GetLastRotatedAt().AsTime().Equal(tokenRotated) {
+		t.Errorf("token status %v, want set and still rotated at %v", token, tokenRotated)
+	}
+	if password := status["password"]; !password.GetSet() || password.GetLastRotatedAt().AsTime().Before(mark) {
+		t.Errorf("password status %v, want set and rotated after %v", password, mark)
+	}
+
+	// Clearing a field drops it from the write-only view
+	cleared, err := f.configs.UpdateConfiguration(ctx, &deployerV1.UpdateConfigurationRequest{
+		Id:               configID,
+		ClearCredentials: []string{"password"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateConfiguration clearing password: %v", err)
+	}
+	if _, ok := cleared.GetConfiguration().GetCredentials()["password"]; ok {
+		t.Errorf("cleared password still reported: %v", cleared.GetConfiguration().GetCredentials())
+	}
+	if token := cleared.GetConfiguration().GetCredentials()["token"]; !token.GetLastRotatedAt().AsTime().Equal(tokenRotated) {
+		t.Errorf("token status after clearing password %v, want rotated at %v", token, tokenRotated)
+	}
+}
+
+func TestCreateConfiguration_StoresCredentialDefaults(t *testing.T) {
+	const providerType = "dummy-credential-defaults"
+	registry.Register(providerType, func() registry.Provider {
+		provider, _ := registry.Get("dummy")
+		return provider
+	}, &registry.ProviderInfo{
+		Type: providerType,
+		CredentialSchema: registry.Object(map[string]*registry.Schema{
+			"username": registry.String("User name").WithDefault("admin"),
+			"token":    registry.Secret("Token"),
+		}, "token"),
+	})
+
+	f := newIsolationFixture(t)
+	ctx := tenantCtx(tenantA)
+
+	creds, _ := structpb.NewStruct(map[string]any{"token": "t1"})
+	created, err := f.configs.CreateConfiguration(ctx, &deployerV1.CreateConfigurationRequest{
+		Name:         "defaulted",
+		ProviderType: providerType,
+		Credentials:  creds,
+	})
+	if err != nil {
+		t.Fatalf("CreateConfiguration: %v", err)
+	}
+	configID := created.GetConfiguration().GetId()
+
+	stored, err := f.configs.GetDecryptedCredentials(ctx, configID)
+	if err != nil {
+		t.Fatalf("GetDecryptedCredentials: %v", err)
+	}
+	if !reflect.DeepEqual(stored, map[string]any{"username": "admin", "token": "t1"}) {
+		t.Fatalf("stored credentials %v, want the username default filled in", stored)
+	}
+
+	// A patch leaves the stored default in place
+	patch, _ := structpb.NewStruct(map[string]any{"token": "t2"})
+	if _, err := f.configs.UpdateConfiguration(ctx, &deployerV1.UpdateConfigurationRequest{
+		Id:              configID,
+		CredentialPatch: patch,
+	}); err != nil {
+		t.Fatalf("UpdateConfiguration: %v", err)
+	}
+	stored, err = f.configs.GetDecryptedCredentials(ctx, configID)
+	if err != nil {
+		t.Fatalf("GetDecryptedCredentials after patch: %v", err)
+	}
+	if !reflect.DeepEqual(stored, map[string]any{"username": "admin", "token": "t2"}) {
+		t.Fatalf("stored credentials after patch %v, want the default kept", stored)
+	}
+}
+
+func TestUpdateConfiguration_ReportsCredentialsWithoutRotationTimes(t *testing.T) {
+	f := newIsolationFixture(t)
+	ctx := tenantCtx(tenantA)
+
+	creds, _ := structpb.NewStruct(map[string]any{"token": "t1"})
+	created, err := f.configs.CreateConfiguration(ctx, &deployerV1.CreateConfigurationRequest{
+		Name:         "legacy",
+		ProviderType: "dummy",
+		Credentials:  creds,
+	})
+	if err != nil {
+		t.Fatalf("CreateConfiguration: %v", err)
+	}
+	configID := created.GetConfiguration().GetId()
+
+	// Rows written before rotation tracking have no rotation times
+	f.client.TargetConfiguration.UpdateOneID(configID).ClearCredentialRotatedAt().
+		ExecX(viewer.WithContext(context.Background(), &systemViewer{}))
+
+	name := "legacy-renamed"
+	updated, err := f.configs.UpdateConfiguration(ctx, &deployerV1.UpdateConfigurationRequest{
+		Id:   configID,
+		Name: &name,
+	})
+	if err != nil {
+		t.Fatalf("UpdateConfiguration: %v", err)
+	}
+	if token := updated.GetConfiguration().GetCredentials()["token"]; !token.GetSet() {
+		t.Fatalf("credential status %v, want the token reported as set", updated.GetConfiguration().GetCredentials())
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tx7do/go-crud/viewer"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/configurationrevision"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+func TestConfigurationRevisions_RevertCreatesNewRevision(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	ctx := tenantCtx(tenantA)
+
+	update := func(req *deployerV1.UpdateConfigurationRequest) *deployerV1.TargetConfiguration {
+		t.Helper()
+		req.Id = configID
+		resp, err := f.configs.UpdateConfiguration(ctx, req)
+		if err != nil {
+			t.Fatalf("UpdateConfiguration: %v", err)
+		}
+		return resp.GetConfiguration()
+	}
+
+	name, description := "renamed", "moved to the edge"
+	config, _ := structpb.NewStruct(map[string]any{"simulate_delay_ms": 250})
+	creds, _ := structpb.NewStruct(map[string]any{"token": "rotated"})
+	if got := update(&deployerV1.UpdateConfigurationRequest{Name: &name, Config: config}).GetRevision(); got != 2 {
+		t.Fatalf("revision after first update = %d, want 2", got)
+	}
+	if got := update(&deployerV1.UpdateConfigurationRequest{Description: &description, Credentials: creds}).GetRevision(); got != 3 {
+		t.Fatalf("revision after second update = %d, want 3", got)
+	}
+
+	if _, err := f.configs.RevertConfiguration(ctx, &deployerV1.RevertConfigurationRequest{Id: configID, Revision: 3}); !deployerV1.IsBadRequest(err) {
+		t.Fatalf("revert to the current revision: got %v, want BadRequest", err)
+	}
+
+	resp, err := f.configs.RevertConfiguration(ctx, &deployerV1.RevertConfigurationRequest{Id: configID, Revision: 1})
+	if err != nil {
+		t.Fatalf("RevertConfiguration: %v", err)
+	}
+	reverted := resp.GetConfiguration()
+	if reverted.GetRevision() != 4 || reverted.GetName() != "config-1" || reverted.GetDescription() != "" ||
+		reverted.GetConfig().GetFields()["simulate_delay_ms"].GetNumberValue() != 1000 {
+		t.Fatalf("reverted configuration %+v, want revision 4 with the state of revision 1", reverted)
+	}
+	credentials, err := f.configs.GetDecryptedCredentials(ctx, configID)
+	if err != nil {
+		t.Fatalf("GetDecryptedCredentials: %v", err)
+	}
+	if credentials["token"] != "secret-of-1" {
+		t.Fatalf("credentials after revert %v, want those of revision 1", credentials)
+	}
+
+	// History is kept; the revert is a revision of its own
+	list, err := f.configs.ListConfigurationRevisions(ctx, &deployerV1.ListConfigurationRevisionsRequest{Id: configID})
+	if err != nil {
+		t.Fatalf("ListConfigurationRevisions: %v", err)
+	}
+	if list.GetTotal() != 4 {
+		t.Fatalf("%d revisions, want 4", list.GetTotal())
+	}
+	for _, r := range list.GetItems() {
+		if r.GetCurrent() != (r.GetRevision() == 4) {
+			t.Errorf("revision %d current = %v", r.GetRevision(), r.GetCurrent())
+		}
+		if r.GetRevision() == 4 && r.GetRevertedFrom() != 1 {
+			t.Errorf("revision 4 reverted from %d, want 1", r.GetRevertedFrom())
+		}
+	}
+}
+
+func TestConfigurationRevisions_EnsureRevisionIsIdempotent(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+	repo := f.configs.configRepo
+
+	ensure := func() uint32 {
+		t.Helper()
+		config, err := repo.GetByID(systemCtx, configID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		revision, err := repo.EnsureRevision(systemCtx, config)
+		if err != nil {
+			t.Fatalf("EnsureRevision: %v", err)
+		}
+		if revision.Revision != config.Revision && config.Revision != 0 {
+			t.Fatalf("EnsureRevision returned revision %d of a configuration at %d", revision.Revision, config.Revision)
+		}
+		return revision.ID
+	}
+	revisions := func() int {
+		return f.client.ConfigurationRevision.Query().
+			Where(configurationrevision.TargetConfigurationIDEQ(configID)).
+			CountX(systemCtx)
+	}
+
+	// A configuration with revisions gets its current one back
+	first := ensure()
+	if second := ensure(); second != first || revisions() != 1 {
+		t.Fatalf("EnsureRevision on revision 1: ids %d, %d and %d rows, want the same row", first, second, revisions())
+	}
+
+	// A configuration from before revisions gets its state captured once
+	f.client.ConfigurationRevision.Delete().ExecX(systemCtx)
+	f.client.TargetConfiguration.UpdateOneID(configID).SetRevision(0).ExecX(systemCtx)
+
+	first = ensure()
+	if second := ensure(); second != first || revisions() != 1 {
+		t.Fatalf("EnsureRevision on a legacy configuration: ids %d, %d and %d rows, want one captured row", first, second, revisions())
+	}
+	config, err := repo.GetByID(systemCtx, configID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if config.Revision != 1 {
+		t.Fatalf("legacy configuration at revision %d after capture, want 1", config.Revision)
+	}
+}
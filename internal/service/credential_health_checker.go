@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	// credentialCheckTask names the leader lease of the health checker
+	credentialCheckTask = "credential-health-check"
+	// credentialCheckTick is how often the leader looks for due checks
+	credentialCheckTick = time.Minute
+	// credentialCheckLease is how long leadership lasts without renewal
+	credentialCheckLease = 3 * time.Minute
+	// credentialCheckBatch bounds the configurations checked per run
+	credentialCheckBatch = 100
+)
+
+// CredentialHealthChecker periodically validates the credentials of active
+// configurations so that expired or revoked ones surface before a renewal
+// needs them. Only the elected leader replica runs checks. A check rejecting
+// the credentials opens the circuit of the configuration, whose probes then
+// move it back to ACTIVE once the credentials are fixed.
+type CredentialHealthChecker struct {
+	log           *log.Helper
+	configRepo    *data.TargetConfigurationRepo
+	configService *TargetConfigurationService
+	breaker       *CircuitBreaker
+	leader        *LeaderElection
+	collector     *metrics.Collector
+	config        *conf.HealthCheckConfig
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewCredentialHealthChecker creates a new credential health checker
+func NewCredentialHealthChecker(
+	ctx *bootstrap.Context,
+	configRepo *data.TargetConfigurationRepo,
+	configService *TargetConfigurationService,
+	breaker *CircuitBreaker,
+	leader *LeaderElection,
+	collector *metrics.Collector,
+) *CredentialHealthChecker {
+	var healthCfg *conf.HealthCheckConfig
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			healthCfg = deployerCfg.HealthCheck
+		}
+	}
+
+	// Default config
+	if healthCfg == nil {
+		healthCfg = &conf.HealthCheckConfig{
+			IntervalMinutes: 360,
+			Jitter:          0.1,
+		}
+	}
+	if healthCfg.Concurrency <= 0 {
+		healthCfg.Concurrency = 5
+	}
+	if healthCfg.TimeoutSeconds <= 0 {
+		healthCfg.TimeoutSeconds = 60
+	}
+
+	return &CredentialHealthChecker{
+		log:           ctx.NewLoggerHelper("deployer/credential-health-checker"),
+		configRepo:    configRepo,
+		configService: configService,
+		breaker:       breaker,
+		leader:        leader,
+		collector:     collector,
+		config:        healthCfg,
+	}
+}
+
+// Start starts the check loop
+func (c *CredentialHealthChecker) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.running || c.config.IntervalMinutes <= 0 {
+		return nil
+	}
+
+	// Use system viewer context for background operations (bypasses tenant privacy checks)
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	c.ctx, c.cancel = context.WithCancel(baseCtx)
+	c.running = true
+
+	c.log.Infof("Starting credential health checks every %d minutes (concurrency: %d)", c.config.IntervalMinutes, c.config.Concurrency)
+	c.wg.Add(1)
+	go c.checkWorker()
+
+	return nil
+}
+
+// Stop stops the checker and hands leadership to another replica
+func (c *CredentialHealthChecker) Stop() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.running {
+		return nil
+	}
+
+	c.log.Info("Stopping credential health checker")
+	c.cancel()
+	c.wg.Wait()
+	c.leader.Resign(c.ctx, credentialCheckTask)
+	c.running = false
+
+	return nil
+}
+
+// checkWorker periodically runs the due checks while this replica leads
+func (c *CredentialHealthChecker) checkWorker() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(credentialCheckTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.CheckDue(c.ctx)
+		}
+	}
+}
+
+// CheckDue checks the configurations whose health check is due, at most the
+// configured number at once. It does nothing unless this replica leads.
+func (c *CredentialHealthChecker) CheckDue(ctx context.Context) {
+	if !c.leader.IsLeader(ctx, credentialCheckTask, credentialCheckLease) {
+		return
+	}
+
+	configs, err := c.configRepo.ListDueValidations(ctx, credentialCheckBatch)
+	if err != nil {
+		c.log.Errorf("Failed to list configurations due for a credential check: %v", err)
+		return
+	}
+
+	sem := make(chan struct{}, c.config.Concurrency)
+	var wg sync.WaitGroup
+	for _, config := range configs {
+		// Renewing per check keeps the lease alive through long runs and
+		// stops this run as soon as another replica took over
+		if ctx.Err() != nil || !c.leader.IsLeader(ctx, credentialCheckTask, credentialCheckLease) {
+			break
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(config *data.TargetConfiguration) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			c.check(ctx, config)
+		}(config)
+	}
+	wg.Wait()
+}
+
+// check validates the credentials of config and records the outcome
+func (c *CredentialHealthChecker) check(ctx context.Context, config *data.TargetConfiguration) {
+	claimed, err := c.configRepo.ClaimValidation(ctx, config.ID, config.NextValidationAt, time.Now().Add(c.nextInterval()))
+	if err != nil || !claimed {
+		return
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, time.Duration(c.config.TimeoutSeconds)*time.Second)
+	err = c.configService.CheckCredentials(checkCtx, config)
+	cancel()
+
+	if err == nil {
+		c.collector.CredentialChecked(config.ProviderType, "valid")
+		if err := c.configRepo.RecordValidation(ctx, config.ID, ""); err != nil {
+			c.log.Warnf("Failed to record credential check of configuration %s: %v", config.ID, err)
+		}
+		return
+	}
+
+	category := registry.Classify(err)
+	c.collector.CredentialChecked(config.ProviderType, string(category))
+	c.log.Warnf("Credential check of configuration %s failed (%s): %v", config.ID, category, err)
+	if err := c.configRepo.RecordValidation(ctx, config.ID, err.Error()); err != nil {
+		c.log.Warnf("Failed to record credential check of configuration %s: %v", config.ID, err)
+	}
+
+	// Transient failures say nothing certain about the credentials; the next
+	// check or deployment will tell
+	if !category.Retryable() {
+		c.breaker.Open(ctx, config, "Credential check failed: "+err.Error())
+	}
+}
+
+// nextInterval returns the configured interval randomized by the jitter, so
+// that configurations created together are not checked together forever
+func (c *CredentialHealthChecker) nextInterval() time.Duration {
+	interval := time.Duration(c.config.IntervalMinutes) * time.Minute
+	jitter := float64(c.config.Jitter)
+	if jitter <= 0 {
+		return interval
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	return time.Duration(float64(interval) * (1 + jitter*(2*rand.Float64()-1)))
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tx7do/go-crud/viewer"
+)
+
+func TestCredentialHealthChecker_LeaderChecksDueConfigurations(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	configRepo := f.configs.configRepo
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+
+	newReplica := func() *CredentialHealthChecker {
+		breaker := NewCircuitBreaker(testBootstrap, configRepo, f.configs, testCollector())
+		return NewCredentialHealthChecker(testBootstrap, configRepo, f.configs, breaker,
+			NewLeaderElection(testBootstrap, f.locks), testCollector())
+	}
+	leader, follower := newReplica(), newReplica()
+
+	if !leader.leader.IsLeader(systemCtx, credentialCheckTask, time.Minute) {
+		t.Fatal("first replica did not become leader")
+	}
+	follower.CheckDue(systemCtx)
+	config, err := configRepo.GetByID(systemCtx, configID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if config.LastValidatedAt != nil {
+		t.Fatal("follower ran a credential check")
+	}
+
+	leader.CheckDue(systemCtx)
+	config, err = configRepo.GetByID(systemCtx, configID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if config.LastValidatedAt == nil || config.LastValidationError != "" {
+		t.Fatalf("after the check: last validated %v, error %q; want a time and no error", config.LastValidatedAt, config.LastValidationError)
+	}
+	if config.NextValidationAt == nil || time.Until(*config.NextValidationAt) < 5*time.Hour {
+		t.Fatalf("next check at %v, want about the default interval from now", config.NextValidationAt)
+	}
+
+	// Resigning hands the task over at once
+	leader.leader.Resign(systemCtx, credentialCheckTask)
+	if !follower.leader.IsLeader(systemCtx, credentialCheckTask, time.Minute) {
+		t.Fatal("follower did not take over after the leader resigned")
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
 	"github.com/go-tangra/go-tangra-deployer/internal/data"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
 
@@ -23,6 +25,7 @@ type DeploymentJobService struct {
 	targetRepo  *data.DeploymentTargetRepo
 	configRepo  *data.TargetConfigurationRepo
 	historyRepo *data.DeploymentHistoryRepo
+	policies    *data.RetryPolicies
 	collector   *metrics.Collector
 }
 
@@ -33,6 +36,7 @@ func NewDeploymentJobService(
 	targetRepo *data.DeploymentTargetRepo,
 	configRepo *data.TargetConfigurationRepo,
 	historyRepo *data.DeploymentHistoryRepo,
+	policies *data.RetryPolicies,
 	collector *metrics.Collector,
 ) *DeploymentJobService {
 	return &DeploymentJobService{
@@ -41,6 +45,7 @@ func NewDeploymentJobService(
 		targetRepo:  targetRepo,
 		configRepo:  configRepo,
 		historyRepo: historyRepo,
+		policies:    policies,
 		collector:   collector,
 	}
 }
@@ -59,27 +64,24 @@ func (s *DeploymentJobService) CreateJob(ctx context.Context, req *deployerV1.Cr
 		}
 	}
 
-	// Determine max retries
-	maxRetries := int32(3)
-	if req.MaxRetries != nil {
-		maxRetries = *req.MaxRetries
-	}
+	// An explicit max_retries overrides the resolved retry policy
+	override := &registry.RetryPolicy{MaxRetries: req.MaxRetries}
 
 	// Handle deployment to target group (parent + child jobs)
 	if req.DeploymentTargetId != nil && *req.DeploymentTargetId != "" {
-		return s.createTargetGroupJob(ctx, *req.DeploymentTargetId, req.GetCertificateId(), nil, triggerType, maxRetries)
+		return s.createTargetGroupJob(ctx, *req.DeploymentTargetId, req.GetCertificateId(), nil, triggerType, override, req.GetIdempotencyKey())
 	}
 
 	// Handle direct deployment to configuration
 	if req.TargetConfigurationId != nil && *req.TargetConfigurationId != "" {
-		return s.createDirectJob(ctx, *req.TargetConfigurationId, req.GetCertificateId(), nil, triggerType, maxRetries)
+		return s.createDirectJob(ctx, *req.TargetConfigurationId, req.GetCertificateId(), nil, triggerType, override, req.GetIdempotencyKey())
 	}
 
 	return nil, deployerV1.ErrorBadRequest("either deployment_target_id or target_configuration_id must be specified")
 }
 
 // createTargetGroupJob creates a parent job for a target group and child jobs for each configuration
-func (s *DeploymentJobService) createTargetGroupJob(ctx context.Context, targetID, certID string, certSerial *string, triggerType deploymentjob.TriggeredBy, maxRetries int32) (*deployerV1.CreateJobResponse, error) {
+func (s *DeploymentJobService) createTargetGroupJob(ctx context.Context, targetID, certID string, certSerial *string, triggerType deploymentjob.TriggeredBy, override *registry.RetryPolicy, idempotencyKey string) (*deployerV1.CreateJobResponse, error) {
 	s.log.Infof("CreateJob: deployment_target_id=%s, certificate_id=%s", targetID, certID)
 
 	// Validate target exists and get with configurations
@@ -102,6 +104,21 @@ func (s *DeploymentJobService) createTargetGroupJob(ctx context.Context, targetI
 		targetTenantID = *target.TenantID
 	}
 
+	// Return the job of an identical earlier request
+	existing, err := idempotentJob(ctx, s.jobRepo, targetTenantID, idempotencyKey, targetID, "", certID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing, err = s.jobRepo.GetByIDWithChildJobs(ctx, existing.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &deployerV1.CreateJobResponse{
+			Job: s.jobRepo.ToProto(existing),
+		}, nil
+	}
+
 	// Get serial number
 	serial := ""
 	if certSerial != nil {
@@ -109,7 +126,8 @@ func (s *DeploymentJobService) createTargetGroupJob(ctx context.Context, targetI
 	}
 
 	// Create parent job
-	parentJob, err := s.jobRepo.CreateParentJob(ctx, targetTenantID, targetID, certID, serial, triggerType, maxRetries)
+	parentJob, err := s.jobRepo.CreateParentJob(ctx, targetTenantID, targetID, certID, serial, idempotencyKey, triggerType,
+		s.policies.Resolve(nil, target).Merge(override))
 	if err != nil {
 		return nil, err
 	}
@@ -117,12 +135,14 @@ func (s *DeploymentJobService) createTargetGroupJob(ctx context.Context, targetI
 
 	// Create child jobs for each configuration
 	for _, config := range configs {
-		_, err := s.jobRepo.CreateChildJob(ctx, targetTenantID, parentJob.ID, config.ID, certID, serial, triggerType, maxRetries)
+		childJob, err := s.jobRepo.CreateChildJob(ctx, targetTenantID, parentJob.ID, config.ID, certID, serial, triggerType,
+			s.policies.Resolve(config, target).Merge(override))
 		if err != nil {
 			s.log.Errorf("Failed to create child job for configuration %s: %v", config.ID, err)
 			// Continue creating other child jobs
 		} else {
 			s.collector.JobCreated("pending", string(triggerType))
+			supersedeQueuedJobs(ctx, s.jobRepo, s.collector, s.log, childJob)
 		}
 	}
 
@@ -138,7 +158,7 @@ func (s *DeploymentJobService) createTargetGroupJob(ctx context.Context, targetI
 }
 
 // createDirectJob creates a direct job for a single configuration
-func (s *DeploymentJobService) createDirectJob(ctx context.Context, configID, certID string, certSerial *string, triggerType deploymentjob.TriggeredBy, maxRetries int32) (*deployerV1.CreateJobResponse, error) {
+func (s *DeploymentJobService) createDirectJob(ctx context.Context, configID, certID string, certSerial *string, triggerType deploymentjob.TriggeredBy, override *registry.RetryPolicy, idempotencyKey string) (*deployerV1.CreateJobResponse, error) {
 	s.log.Infof("CreateJob: target_configuration_id=%s, certificate_id=%s", configID, certID)
 
 	// Validate configuration exists
@@ -156,17 +176,30 @@ func (s *DeploymentJobService) createDirectJob(ctx context.Context, configID, ce
 		configTenantID = *config.TenantID
 	}
 
+	// Return the job of an identical earlier request
+	existing, err := idempotentJob(ctx, s.jobRepo, configTenantID, idempotencyKey, "", configID, certID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &deployerV1.CreateJobResponse{
+			Job: s.jobRepo.ToProto(existing),
+		}, nil
+	}
+
 	// Get serial number
 	serial := ""
 	if certSerial != nil {
 		serial = *certSerial
 	}
 
-	job, err := s.jobRepo.CreateDirectJob(ctx, configTenantID, configID, certID, serial, triggerType, maxRetries)
+	job, err := s.jobRepo.CreateDirectJob(ctx, configTenantID, configID, certID, serial, idempotencyKey, triggerType,
+		s.policies.Resolve(config, nil).Merge(override))
 	if err != nil {
 		return nil, err
 	}
 	s.collector.JobCreated("pending", string(triggerType))
+	supersedeQueuedJobs(ctx, s.jobRepo, s.collector, s.log, job)
 
 	return &deployerV1.CreateJobResponse{
 		Job: s.jobRepo.ToProto(job),
@@ -267,6 +300,10 @@ func (s *DeploymentJobService) ListJobs(ctx context.Context, req *deployerV1.Lis
 			t = deploymentjob.TriggeredByTRIGGER_TYPE_EVENT
 		case deployerV1.TriggerType_TRIGGER_TYPE_AUTO_RENEWAL:
 			t = deploymentjob.TriggeredByTRIGGER_TYPE_AUTO_RENEWAL
+		case deployerV1.TriggerType_TRIGGER_TYPE_DRIFT_REMEDIATION:
+			t = deploymentjob.TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION
+		case deployerV1.TriggerType_TRIGGER_TYPE_REVOCATION:
+			t = deploymentjob.TriggeredByTRIGGER_TYPE_REVOCATION
 		}
 		triggeredBy = &t
 	}
@@ -295,7 +332,8 @@ func (s *DeploymentJobService) ListJobs(ctx context.Context, req *deployerV1.Lis
 	includeChildJobs := req.GetIncludeChildJobs()
 
 	// Pass job type directly (repo expects proto type)
-	jobs, total, err := s.jobRepo.List(ctx, req.TenantId, req.DeploymentTargetId, req.TargetConfigurationId,
+	tenantID := caller.FromContext(ctx).TenantScope(req.TenantId)
+	jobs, total, err := s.jobRepo.List(ctx, tenantID, req.DeploymentTargetId, req.TargetConfigurationId,
 		req.CertificateId, req.ParentJobId, status, triggeredBy, req.JobType, createdAfter, createdBefore,
 		includeChildJobs, page, pageSize)
 	if err != nil {
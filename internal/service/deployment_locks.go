@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+)
+
+const (
+	// lockKeyConfiguration prefixes the lock serializing deployments to a configuration
+	lockKeyConfiguration = "configuration:"
+	// lockKeyHost prefixes the lock serializing deployments to a device host
+	lockKeyHost = "host:"
+	// lockKeyProvider prefixes the concurrency slots of a provider type
+	lockKeyProvider = "provider:"
+
+	// lockLeaseMargin extends a lease beyond the attempt timeout to cover the
+	// work around the provider call
+	lockLeaseMargin = 5 * time.Minute
+	// lockPollInterval is how often a waiting synchronous deployment retries
+	lockPollInterval = time.Second
+)
+
+// DeploymentLocks serializes deployments across replicas: one deployment per
+// configuration at a time, optionally one per device host, and at most the
+// configured number per provider type. Deployments that cannot get their
+// locks stay queued.
+type DeploymentLocks struct {
+	log           *log.Helper
+	repo          *data.DeployLockRepo
+	configService *TargetConfigurationService
+	concurrency   map[string]int32
+	byHost        bool
+}
+
+// NewDeploymentLocks creates the deployment locks from the jobs configuration
+func NewDeploymentLocks(ctx *bootstrap.Context, repo *data.DeployLockRepo, configService *TargetConfigurationService) *DeploymentLocks {
+	var cfg *conf.JobConfig
+	if c, ok := ctx.GetCustomConfig("deployer"); ok && c != nil {
+		if deployerCfg, ok := c.(*conf.Deployer); ok {
+			cfg = deployerCfg.Jobs
+		}
+	}
+
+	return &DeploymentLocks{
+		log:           ctx.NewLoggerHelper("deployer/deployment-locks"),
+		repo:          repo,
+		configService: configService,
+		concurrency:   cfg.GetProviderConcurrency(),
+		byHost:        cfg.GetSerializeByHost(),
+	}
+}
+
+// TryAcquire takes the locks of a deployment to config for holder, valid for
+// ttl. It returns false without waiting when a lock is busy.
+func (l *DeploymentLocks) TryAcquire(ctx context.Context, holder string, config *data.TargetConfiguration, ttl time.Duration) (bool, error) {
+	keys := l.keys(config)
+	expiresAt := time.Now().Add(ttl)
+
+	limit := l.concurrency[config.ProviderType]
+	if limit <= 0 {
+		return l.repo.TryAcquire(ctx, holder, expiresAt, keys...)
+	}
+	for slot := int32(0); slot < limit; slot++ {
+		slotKey := fmt.Sprintf("%s%s/%d", lockKeyProvider, config.ProviderType, slot)
+		ok, err := l.repo.TryAcquire(ctx, holder, expiresAt, append(keys, slotKey)...)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// Wait takes the locks like TryAcquire, polling until they are free or ctx is
+// done. It returns false when ctx ended first.
+func (l *DeploymentLocks) Wait(ctx context.Context, holder string, config *data.TargetConfiguration, ttl time.Duration) (bool, error) {
+	ticker := time.NewTicker(lockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := l.TryAcquire(ctx, holder, config, ttl)
+		if err != nil || ok {
+			return ok, err
+		}
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Release drops the locks of holder. It runs even when ctx is cancelled so a
+// finished deployment never leaves its locks behind until they expire.
+func (l *DeploymentLocks) Release(ctx context.Context, holder string) {
+	if err := l.repo.Release(context.WithoutCancel(ctx), holder); err != nil {
+		l.log.Warnf("Failed to release deployment locks of job %s: %v", holder, err)
+	}
+}
+
+// BusyConfigurations returns the IDs of configurations with a deployment in progress
+func (l *DeploymentLocks) BusyConfigurations(ctx context.Context) ([]string, error) {
+	return l.repo.HeldKeys(ctx, lockKeyConfiguration)
+}
+
+// keys returns the mutual-exclusion keys of a deployment to config
+func (l *DeploymentLocks) keys(config *data.TargetConfiguration) []string {
+	keys := []string{lockKeyConfiguration + config.ID}
+	if !l.byHost {
+		return keys
+	}
+	if host := l.host(config); host != "" {
+		keys = append(keys, lockKeyHost+host)
+	}
+	return keys
+}
+
+// host returns the normalized device host of config, read from its
+// credentials, or "" when the provider has none. Secret references are not
+// resolved; the same reference always names the same host.
+func (l *DeploymentLocks) host(config *data.TargetConfiguration) string {
+	info, err := registry.GetInfo(config.ProviderType)
+	if err != nil || info.HostField == "" || len(config.CredentialsEncrypted) == 0 {
+		return ""
+	}
+	credentials, err := l.configService.decryptCredentials(config.CredentialsEncrypted)
+	if err != nil {
+		// The deployment fails on its credentials anyway
+		l.log.Warnf("Failed to decrypt credentials of configuration %s for its host lock: %v", config.ID, err)
+		return ""
+	}
+	host, _ := credentials[info.HostField].(string)
+	return strings.ToLower(strings.TrimSpace(host))
+}
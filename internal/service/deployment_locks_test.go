@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	entSql "entgo.io/ent/dialect/sql"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/providers/bigip"
+)
+
+func newTestLocks(t *testing.T, concurrency map[string]int32, byHost bool) *DeploymentLocks {
+	t.Helper()
+
+	drv, err := entSql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	entClient := entCrud.NewEntClient(client, drv)
+	configService := NewTargetConfigurationService(testBootstrap, data.NewTargetConfigurationRepo(testBootstrap, entClient),
+		data.NewConfigurationRevisionRepo(testBootstrap, entClient), data.NewChangeRecordRepo(testBootstrap, entClient),
+		newTestCipher(t), secrets.NewManager(0), testCollector())
+
+	locks := NewDeploymentLocks(testBootstrap, data.NewDeployLockRepo(testBootstrap, entClient), configService)
+	locks.concurrency = concurrency
+	locks.byHost = byHost
+	return locks
+}
+
+// bigipConfig returns a BIG-IP configuration whose credentials address host
+func bigipConfig(t *testing.T, l *DeploymentLocks, id, host string) *data.TargetConfiguration {
+	t.Helper()
+	credentials, err := l.configService.encryptCredentials(map[string]any{"host": host, "username": "admin", "password": "secret"})
+	if err != nil {
+		t.Fatalf("encrypt credentials: %v", err)
+	}
+	return &data.TargetConfiguration{
+		ID:                   id,
+		ProviderType:         bigip.ProviderType,
+		Config:               map[string]any{"partition": "Common"},
+		CredentialsEncrypted: credentials,
+	}
+}
+
+func mustAcquire(t *testing.T, l *DeploymentLocks, holder string, config *data.TargetConfiguration, ttl time.Duration, want bool) {
+	t.Helper()
+	got, err := l.TryAcquire(context.Background(), holder, config, ttl)
+	if err != nil {
+		t.Fatalf("TryAcquire(%s): %v", holder, err)
+	}
+	if got != want {
+		t.Fatalf("TryAcquire(%s) = %v, want %v", holder, got, want)
+	}
+}
+
+func TestDeploymentLocks_SerializesConfiguration(t *testing.T) {
+	l := newTestLocks(t, nil, false)
+	ctx := context.Background()
+
+	mustAcquire(t, l, "job-1", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, true)
+	mustAcquire(t, l, "job-2", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, false)
+	// Without host serialization another configuration on the same device may run
+	mustAcquire(t, l, "job-3", bigipConfig(t, l, "cfg-2", "lb1"), time.Minute, true)
+
+	busy, err := l.BusyConfigurations(ctx)
+	if err != nil {
+		t.Fatalf("BusyConfigurations: %v", err)
+	}
+	if len(busy) != 2 {
+		t.Errorf("BusyConfigurations = %v, want cfg-1 and cfg-2", busy)
+	}
+
+	l.Release(ctx, "job-1")
+	mustAcquire(t, l, "job-2", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, true)
+}
+
+func TestDeploymentLocks_SerializesHost(t *testing.T) {
+	l := newTestLocks(t, nil, true)
+
+	mustAcquire(t, l, "job-1", bigipConfig(t, l, "cfg-1", "LB1.example.com"), time.Minute, true)
+	mustAcquire(t, l, "job-2", bigipConfig(t, l, "cfg-2", " lb1.example.com "), time.Minute, false)
+	mustAcquire(t, l, "job-3", bigipConfig(t, l, "cfg-3", "lb2.example.com"), time.Minute, true)
+
+	// A failed acquisition must not leave its configuration lock behind
+	busy, err := l.BusyConfigurations(context.Background())
+	if err != nil {
+		t.Fatalf("BusyConfigurations: %v", err)
+	}
+	for _, id := range busy {
+		if id == "cfg-2" {
+			t.Errorf("cfg-2 is locked after a failed acquisition")
+		}
+	}
+}
+
+func TestDeploymentLocks_ProviderConcurrency(t *testing.T) {
+	l := newTestLocks(t, map[string]int32{bigip.ProviderType: 2}, false)
+
+	mustAcquire(t, l, "job-1", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, true)
+	mustAcquire(t, l, "job-2", bigipConfig(t, l, "cfg-2", "lb2"), time.Minute, true)
+	mustAcquire(t, l, "job-3", bigipConfig(t, l, "cfg-3", "lb3"), time.Minute, false)
+
+	l.Release(context.Background(), "job-1")
+	mustAcquire(t, l, "job-3", bigipConfig(t, l, "cfg-3", "lb3"), time.Minute, true)
+}
+
+func TestDeploymentLocks_ExpiredLeaseIsTakenOver(t *testing.T) {
+	l := newTestLocks(t, nil, false)
+
+	mustAcquire(t, l, "job-1", bigipConfig(t, l, "cfg-1", "lb1"), -time.Second, true)
+	mustAcquire(t, l, "job-2", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, true)
+
+	// Releasing the stale holder must not drop the new lease
+	l.Release(context.Background(), "job-1")
+	mustAcquire(t, l, "job-3", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, false)
+}
+
+func TestDeploymentLocks_WaitStopsAtDeadline(t *testing.T) {
+	l := newTestLocks(t, nil, false)
+	mustAcquire(t, l, "job-1", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute, true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	locked, err := l.Wait(ctx, "job-2", bigipConfig(t, l, "cfg-1", "lb1"), time.Minute)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if locked {
+		t.Fatal("Wait acquired a busy configuration")
+	}
+}
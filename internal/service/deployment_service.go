@@ -27,7 +27,13 @@ type DeploymentService struct {
 	configRepo    *data.TargetConfigurationRepo
 	historyRepo   *data.DeploymentHistoryRepo
 	configService *TargetConfigurationService
+	lcmClient     *data.LcmClient
+	certRepo      *data.ExternalCertificateRepo
+	policies      *data.RetryPolicies
+	locks         *DeploymentLocks
+	breaker       *CircuitBreaker
 	collector     *metrics.Collector
+	executor      *JobExecutor
 }
 
 // NewDeploymentService creates a new DeploymentService
@@ -38,7 +44,13 @@ func NewDeploymentService(
 	configRepo *data.TargetConfigurationRepo,
 	historyRepo *data.DeploymentHistoryRepo,
 	configService *TargetConfigurationService,
+	lcmClient *data.LcmClient,
+	certRepo *data.ExternalCertificateRepo,
+	policies *data.RetryPolicies,
+	locks *DeploymentLocks,
+	breaker *CircuitBreaker,
 	collector *metrics.Collector,
+	executor *JobExecutor,
 ) *DeploymentService {
 	return &DeploymentService{
 		log:           ctx.NewLoggerHelper("deployer/service/deployment"),
@@ -47,7 +59,13 @@ func NewDeploymentService(
 		configRepo:    configRepo,
 		historyRepo:   historyRepo,
 		configService: configService,
+		lcmClient:     lcmClient,
+		certRepo:      certRepo,
+		policies:      policies,
+		locks:         locks,
+		breaker:       breaker,
 		collector:     collector,
+		executor:      executor,
 	}
 }
 
@@ -75,12 +93,25 @@ func (s *DeploymentService) Deploy(ctx context.Context, req *deployerV1.DeployRe
 	if config.TenantID != nil {
 		tenantID = *config.TenantID
 	}
+	// Return the job of an identical earlier request
+	existing, err := idempotentJob(ctx, s.jobRepo, tenantID, req.GetIdempotencyKey(), "", configID, req.GetCertificateId())
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &deployerV1.DeployResponse{
+			Job: s.jobRepo.ToProto(existing),
+		}, nil
+	}
+
+	policy := s.policies.Resolve(config, nil)
 	job, err := s.jobRepo.CreateDirectJob(ctx, tenantID, configID, req.GetCertificateId(),
-		"", deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL, 3)
+		"", req.GetIdempotencyKey(), deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL, policy)
 	if err != nil {
 		return nil, err
 	}
 	s.collector.JobCreated("pending", string(deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL))
+	supersedeQueuedJobs(ctx, s.jobRepo, s.collector, s.log, job)
 
 	// If not waiting, return immediately
 	if !req.GetWaitForCompletion() {
@@ -90,18 +121,28 @@ func (s *DeploymentService) Deploy(ctx context.Context, req *deployerV1.DeployRe
 	}
 
 	// Execute deployment synchronously
-	timeout := 300 * time.Second
+	timeout := policy.AttemptTimeout()
 	if req.TimeoutSeconds != nil && *req.TimeoutSeconds > 0 {
 		timeout = time.Duration(*req.TimeoutSeconds) * time.Second
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	deployCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	result, err := s.executeDeployment(ctx, job, config)
+	// Wait for running deployments to the same configuration, host or provider
+	// slots; when they outlast the timeout the job stays queued for the executor
+	locked, err := s.locks.Wait(deployCtx, job.ID, config, policy.AttemptTimeout()+lockLeaseMargin)
 	if err != nil {
 		return nil, err
 	}
+	var result *deployerV1.DeploymentResult
+	if locked {
+		result, err = s.executeDeployment(deployCtx, job, config)
+		s.locks.Release(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Reload job to get updated status
 	job, err = s.jobRepo.GetByID(ctx, job.ID)
@@ -164,8 +205,16 @@ func (s *DeploymentService) DeployToTargets(ctx context.Context, req *deployerV1
 		if config.TenantID != nil {
 			tid = *config.TenantID
 		}
-		job, err := s.jobRepo.CreateDirectJob(ctx, tid, configID, req.GetCertificateId(),
-			"", triggeredBy, 3)
+		key := configurationIdempotencyKey(req.GetIdempotencyKey(), configID)
+		job, err := idempotentJob(ctx, s.jobRepo, tid, key, "", configID, req.GetCertificateId())
+		if err == nil && job == nil {
+			job, err = s.jobRepo.CreateDirectJob(ctx, tid, configID, req.GetCertificateId(),
+				"", key, triggeredBy, s.policies.Resolve(config, nil))
+			if err == nil {
+				s.collector.JobCreated("pending", string(triggeredBy))
+				supersedeQueuedJobs(ctx, s.jobRepo, s.collector, s.log, job)
+			}
+		}
 		if err != nil {
 			errMsg := err.Error()
 			result.Error = &errMsg
@@ -173,7 +222,6 @@ func (s *DeploymentService) DeployToTargets(ctx context.Context, req *deployerV1
 			results = append(results, result)
 			continue
 		}
-		s.collector.JobCreated("pending", string(triggeredBy))
 
 		result.Job = s.jobRepo.ToProto(job)
 		succeeded++
@@ -212,6 +260,21 @@ func (s *DeploymentService) DeployToTarget(ctx context.Context, req *deployerV1.
 		tenantID = *target.TenantID
 	}
 
+	// Return the job of an identical earlier request
+	existing, err := idempotentJob(ctx, s.jobRepo, tenantID, req.GetIdempotencyKey(), target.ID, "", req.GetCertificateId())
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing, err = s.jobRepo.GetByIDWithChildJobs(ctx, existing.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &deployerV1.DeployToTargetResponse{
+			Job: s.jobRepo.ToProto(existing),
+		}, nil
+	}
+
 	// Determine trigger type
 	triggeredBy := deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL
 	if req.TriggeredBy != nil {
@@ -225,7 +288,7 @@ func (s *DeploymentService) DeployToTarget(ctx context.Context, req *deployerV1.
 
 	// Create parent job
 	parentJob, err := s.jobRepo.CreateParentJob(ctx, tenantID, req.GetDeploymentTargetId(),
-		req.GetCertificateId(), "", triggeredBy, 3)
+		req.GetCertificateId(), "", req.GetIdempotencyKey(), triggeredBy, s.policies.Resolve(nil, target))
 	if err != nil {
 		return nil, err
 	}
@@ -233,12 +296,13 @@ func (s *DeploymentService) DeployToTarget(ctx context.Context, req *deployerV1.
 
 	// Create child jobs for each configuration
 	for _, config := range configs {
-		_, err := s.jobRepo.CreateChildJob(ctx, tenantID, parentJob.ID, config.ID,
-			req.GetCertificateId(), "", triggeredBy, 3)
+		childJob, err := s.jobRepo.CreateChildJob(ctx, tenantID, parentJob.ID, config.ID,
+			req.GetCertificateId(), "", triggeredBy, s.policies.Resolve(config, target))
 		if err != nil {
 			s.log.Errorf("Failed to create child job for configuration %s: %v", config.ID, err)
 		} else {
 			s.collector.JobCreated("pending", string(triggeredBy))
+			supersedeQueuedJobs(ctx, s.jobRepo, s.collector, s.log, childJob)
 		}
 	}
 
@@ -301,8 +365,16 @@ func (s *DeploymentService) DeployToConfigurations(ctx context.Context, req *dep
 		if config.TenantID != nil {
 			tid = *config.TenantID
 		}
-		job, err := s.jobRepo.CreateDirectJob(ctx, tid, configID, req.GetCertificateId(),
-			"", triggeredBy, 3)
+		key := configurationIdempotencyKey(req.GetIdempotencyKey(), configID)
+		job, err := idempotentJob(ctx, s.jobRepo, tid, key, "", configID, req.GetCertificateId())
+		if err == nil && job == nil {
+			job, err = s.jobRepo.CreateDirectJob(ctx, tid, configID, req.GetCertificateId(),
+				"", key, triggeredBy, s.policies.Resolve(config, nil))
+			if err == nil {
+				s.collector.JobCreated("pending", string(triggeredBy))
+				supersedeQueuedJobs(ctx, s.jobRepo, s.collector, s.log, job)
+			}
+		}
 		if err != nil {
 			errMsg := err.Error()
 			result.Error = &errMsg
@@ -310,7 +382,6 @@ func (s *DeploymentService) DeployToConfigurations(ctx context.Context, req *dep
 			results = append(results, result)
 			continue
 		}
-		s.collector.JobCreated("pending", string(triggeredBy))
 
 		result.Job = s.jobRepo.ToProto(job)
 		succeeded++
@@ -413,18 +484,24 @@ func (s *DeploymentService) Rollback(ctx context.Context, req *deployerV1.Rollba
 	if config.TenantID != nil {
 		rollbackTenantID = *config.TenantID
 	}
+	// Rollbacks keep the configuration's timeouts but allow a single retry
+	rollbackRetries := int32(1)
+	policy := s.policies.Resolve(config, nil).Merge(&registry.RetryPolicy{MaxRetries: &rollbackRetries})
 	job, err := s.jobRepo.CreateDirectJob(ctx, rollbackTenantID, configID, req.GetCertificateId(),
-		"", deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL, 1)
+		"", "", deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL, policy)
 	if err != nil {
 		return nil, err
 	}
 	s.collector.JobCreated("pending", string(deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL))
 
-	// Get credentials
-	credentials, err := s.configService.GetDecryptedCredentials(ctx, config.ID)
+	// Resolve the revision the rollback executes with
+	revision, credentials, err := s.configService.ResolveRevision(ctx, config)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.jobRepo.SetConfigurationRevision(ctx, job.ID, revision.ID); err != nil {
+		s.log.Warnf("Failed to record configuration revision for job %s: %v", job.ID, err)
+	}
 
 	// Get certificate data (placeholder)
 	certData := &registry.CertificateData{
@@ -432,20 +509,44 @@ func (s *DeploymentService) Rollback(ctx context.Context, req *deployerV1.Rollba
 		SerialNumber: "",
 	}
 
+	// Wait for running deployments to the same configuration, host or provider slots
+	waitCtx, cancel := context.WithTimeout(ctx, policy.AttemptTimeout())
+	locked, err := s.locks.Wait(waitCtx, job.ID, config, policy.AttemptTimeout()+lockLeaseMargin)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		message := "Another deployment to the configuration is still running"
+		if _, err := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_CANCELLED, message, 0); err != nil {
+			s.log.Warnf("Failed to cancel rollback job %s: %v", job.ID, err)
+		} else {
+			s.collector.JobStatusChanged("pending", "cancelled")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PENDING, deploymentjob.StatusJOB_STATUS_CANCELLED, message, "", certData)
+		}
+		return nil, deployerV1.ErrorJobAlreadyRunning("another deployment to the configuration is still running")
+	}
+	defer s.locks.Release(ctx, job.ID)
+
 	// Execute rollback
 	startTime := time.Now()
-	_, err = s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_PROCESSING, "Rolling back", 0)
+	claimed, err := s.jobRepo.ClaimJob(ctx, job.ID, deploymentjob.StatusJOB_STATUS_PENDING)
 	if err != nil {
 		return nil, err
 	}
+	if !claimed {
+		return nil, deployerV1.ErrorJobAlreadyRunning("rollback job is no longer pending")
+	}
 	s.collector.JobStatusChanged("pending", "processing")
+	s.report(job, deploymentjob.StatusJOB_STATUS_PENDING, deploymentjob.StatusJOB_STATUS_PROCESSING, "", "", certData)
 
-	result, err := provider.Rollback(ctx, certData, config.Config, credentials)
+	result, err := provider.Rollback(ctx, certData, revision.Config, credentials)
 	if err != nil {
-		if _, statusErr := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, err.Error(), 0); statusErr != nil {
+		if _, statusErr := s.jobRepo.MarkFailed(ctx, job.ID, err.Error(), registry.Classify(err)); statusErr != nil {
 			s.log.Warnf("Failed to update job %s status after rollback error: %v", job.ID, statusErr)
 		} else {
 			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, err.Error(), registry.Classify(err), certData)
 		}
 		return nil, err
 	}
@@ -466,12 +567,14 @@ func (s *DeploymentService) Rollback(ctx context.Context, req *deployerV1.Rollba
 			s.log.Warnf("Failed to update job %s status after rollback: %v", job.ID, err)
 		} else {
 			s.collector.JobStatusChanged("processing", "completed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_COMPLETED, "Rollback complete", "", certData)
 		}
 	} else {
-		if _, err := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, result.Message, 0); err != nil {
+		if _, err := s.jobRepo.MarkFailed(ctx, job.ID, result.Message, registry.CategoryUnknown); err != nil {
 			s.log.Warnf("Failed to update job %s status after rollback failure: %v", job.ID, err)
 		} else {
 			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, result.Message, registry.CategoryUnknown, certData)
 		}
 	}
 
@@ -487,38 +590,252 @@ func (s *DeploymentService) Rollback(ctx context.Context, req *deployerV1.Rollba
 	}, nil
 }
 
-// executeDeployment executes a deployment and records the result
+// Undeploy creates a job removing the certificate deployed to a configuration.
+// The provider refuses to remove objects the target still uses.
+func (s *DeploymentService) Undeploy(ctx context.Context, req *deployerV1.UndeployRequest) (*deployerV1.UndeployResponse, error) {
+	configID := req.GetTargetConfigurationId()
+	if configID == "" {
+		return nil, deployerV1.ErrorBadRequest("target_configuration_id is required")
+	}
+
+	s.log.Infof("Undeploy: config_id=%s, certificate_id=%s", configID, req.GetCertificateId())
+
+	// Get configuration
+	config, err := s.configRepo.GetByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+	}
+
+	// Get provider
+	provider, err := registry.Get(config.ProviderType)
+	if err != nil {
+		return nil, deployerV1.ErrorProviderNotFound("provider not found")
+	}
+	if !provider.GetCapabilities().SupportsRemoval {
+		return nil, deployerV1.ErrorUnprocessableEntity("provider does not support removal")
+	}
+
+	tenantID := uint32(0)
+	if config.TenantID != nil {
+		tenantID = *config.TenantID
+	}
+
+	// Return the job of an identical earlier request
+	if key := req.GetIdempotencyKey(); key != "" {
+		existing, err := s.jobRepo.GetByIdempotencyKey(ctx, tenantID, key)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			if existing.Action != deploymentjob.ActionJOB_ACTION_REMOVE ||
+				existing.TargetConfigurationID == nil || *existing.TargetConfigurationID != configID ||
+				(req.CertificateId != nil && existing.CertificateID != req.GetCertificateId()) {
+				return nil, deployerV1.ErrorConflict("idempotency key was already used for a different deployment")
+			}
+			return &deployerV1.UndeployResponse{
+				Job: s.jobRepo.ToProto(existing),
+			}, nil
+		}
+	}
+
+	// Remove what is actually deployed
+	last, err := s.jobRepo.LastCompleted(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	if last == nil {
+		return nil, deployerV1.ErrorCertificateNotFound("no certificate is deployed to the configuration")
+	}
+	if req.CertificateId != nil && last.CertificateID != req.GetCertificateId() {
+		return nil, deployerV1.ErrorConflict("certificate %s is not the one deployed to the configuration", req.GetCertificateId())
+	}
+
+	policy := s.policies.Resolve(config, nil)
+	job, err := s.jobRepo.CreateRemovalJob(ctx, tenantID, configID, last.CertificateID, last.CertificateSerial,
+		last.CertificateCommonName, req.GetIdempotencyKey(), deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL, policy)
+	if err != nil {
+		return nil, err
+	}
+	s.collector.JobCreated("pending", string(deploymentjob.TriggeredByTRIGGER_TYPE_MANUAL))
+
+	return &deployerV1.UndeployResponse{
+		Job: s.jobRepo.ToProto(job),
+	}, nil
+}
+
+// PlanDeployment previews the changes deploying a certificate would make on a
+// configuration or on every configuration of a target group. Targets are
+// inspected but left unchanged.
+func (s *DeploymentService) PlanDeployment(ctx context.Context, req *deployerV1.PlanDeploymentRequest) (*deployerV1.PlanDeploymentResponse, error) {
+	s.log.Infof("PlanDeployment: config_id=%s, target_id=%s, certificate_id=%s",
+		req.GetTargetConfigurationId(), req.GetDeploymentTargetId(), req.GetCertificateId())
+
+	configs, err := resolveConfigurations(ctx, s.configRepo, s.targetRepo, req.GetTargetConfigurationId(), req.GetDeploymentTargetId())
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*deployerV1.ConfigurationPlan, 0, len(configs))
+	for _, config := range configs {
+		plans = append(plans, s.planConfiguration(ctx, config, req.GetCertificateId()))
+	}
+
+	return &deployerV1.PlanDeploymentResponse{
+		Plans: plans,
+	}, nil
+}
+
+// resolveConfigurations returns the configuration configID, or every
+// configuration of the target group targetID. Exactly one must be set.
+func resolveConfigurations(ctx context.Context, configRepo *data.TargetConfigurationRepo, targetRepo *data.DeploymentTargetRepo, configID, targetID string) ([]*data.TargetConfiguration, error) {
+	switch {
+	case configID != "" && targetID != "":
+		return nil, deployerV1.ErrorBadRequest("only one of target_configuration_id and deployment_target_id may be set")
+	case configID != "":
+		config, err := configRepo.GetByID(ctx, configID)
+		if err != nil {
+			return nil, err
+		}
+		if config == nil {
+			return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+		}
+		return []*data.TargetConfiguration{config}, nil
+	case targetID != "":
+		target, err := targetRepo.GetByIDWithConfigurations(ctx, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			return nil, deployerV1.ErrorTargetNotFound("deployment target not found")
+		}
+		return target.Edges.Configurations, nil
+	default:
+		return nil, deployerV1.ErrorBadRequest("target_configuration_id or deployment_target_id is required")
+	}
+}
+
+// planConfiguration previews deploying a certificate to config. Failures to
+// inspect the target are reported in the plan rather than returned.
+func (s *DeploymentService) planConfiguration(ctx context.Context, config *data.TargetConfiguration, certificateID string) *deployerV1.ConfigurationPlan {
+	plan := &deployerV1.ConfigurationPlan{
+		ConfigurationId:   config.ID,
+		ConfigurationName: config.Name,
+		ProviderType:      config.ProviderType,
+	}
+	fail := func(err error) *deployerV1.ConfigurationPlan {
+		message := err.Error()
+		plan.Error = &message
+		return plan
+	}
+
+	provider, err := registry.Get(config.ProviderType)
+	if err != nil {
+		return fail(err)
+	}
+	planner, ok := provider.(registry.Planner)
+	if !ok {
+		return plan
+	}
+	plan.Supported = true
+
+	credentials, err := s.configService.GetDecryptedCredentials(ctx, config.ID)
+	if err != nil {
+		return fail(err)
+	}
+
+	actions, err := planner.Plan(ctx, s.planCertificate(ctx, config, certificateID), config.Config, credentials)
+	if err != nil {
+		return fail(err)
+	}
+	for _, action := range actions {
+		plan.Actions = append(plan.Actions, toProtoAction(action))
+	}
+	return plan
+}
+
+// planCertificate returns the certificate a plan is made for, without private
+// key. Object names depend on its common name and serial.
+func (s *DeploymentService) planCertificate(ctx context.Context, config *data.TargetConfiguration, certificateID string) *registry.CertificateData {
+	if s.certRepo != nil && config.TenantID != nil {
+		cert, err := s.certRepo.Get(ctx, *config.TenantID, certificateID)
+		if err != nil {
+			s.log.Warnf("Failed to fetch ingested certificate %s: %v", certificateID, err)
+		} else if cert != nil {
+			return fromExternalCertificate(cert)
+		}
+	}
+	if s.lcmClient != nil {
+		lcmCert, err := s.lcmClient.GetCertificateByJobID(ctx, certificateID, false)
+		if err == nil {
+			return toRegistryCertificate(lcmCert)
+		}
+		s.log.Warnf("Failed to fetch certificate %s from LCM: %v", certificateID, err)
+	}
+	return &registry.CertificateData{ID: certificateID}
+}
+
+// executeDeployment executes a deployment and records the result. It returns
+// no result when a worker already picked up the job or the job waits for the
+// circuit of the configuration to close.
 func (s *DeploymentService) executeDeployment(ctx context.Context, job *data.DeploymentJob, config *data.TargetConfiguration) (*deployerV1.DeploymentResult, error) {
 	startTime := time.Now()
 
-	// Update job to processing
-	_, err := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_PROCESSING, "Starting deployment", 0)
+	circuitOpen := s.breaker.IsOpen(config)
+	if circuitOpen && !s.breaker.FailFast() {
+		return nil, nil
+	}
+
+	// Claim the job so the executor cannot run it as well
+	claimed, err := s.jobRepo.ClaimJob(ctx, job.ID, deploymentjob.StatusJOB_STATUS_PENDING)
 	if err != nil {
 		return nil, err
 	}
+	if !claimed {
+		return nil, nil
+	}
 	s.collector.JobStatusChanged("pending", "processing")
+	s.report(job, deploymentjob.StatusJOB_STATUS_PENDING, deploymentjob.StatusJOB_STATUS_PROCESSING, "", "", nil)
+
+	if circuitOpen {
+		message := "Configuration is in error state: " + config.StatusMessage
+		if _, err := s.jobRepo.MarkFailed(ctx, job.ID, message, registry.CategoryUnknown); err != nil {
+			s.log.Warnf("Failed to update job %s status: %v", job.ID, err)
+		} else {
+			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, message, registry.CategoryUnknown, nil)
+		}
+		return nil, nil
+	}
 
 	// Get provider
 	provider, err := registry.Get(config.ProviderType)
 	if err != nil {
-		if _, statusErr := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, "Provider not found", 0); statusErr != nil {
+		if _, statusErr := s.jobRepo.MarkFailed(ctx, job.ID, "Provider not found", registry.CategoryValidation); statusErr != nil {
 			s.log.Warnf("Failed to update job %s status: %v", job.ID, statusErr)
 		} else {
 			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, "Provider not found", registry.CategoryValidation, nil)
 		}
 		return nil, err
 	}
 
-	// Get credentials
-	credentials, err := s.configService.GetDecryptedCredentials(ctx, config.ID)
+	// Resolve the revision the job executes with
+	revision, credentials, err := s.configService.ResolveRevision(ctx, config)
 	if err != nil {
-		if _, statusErr := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, "Failed to get credentials", 0); statusErr != nil {
+		if _, statusErr := s.jobRepo.MarkFailed(ctx, job.ID, "Failed to get credentials", registry.Classify(err)); statusErr != nil {
 			s.log.Warnf("Failed to update job %s status: %v", job.ID, statusErr)
 		} else {
 			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, "Failed to get credentials", registry.Classify(err), nil)
 		}
 		return nil, err
 	}
+	if err := s.jobRepo.SetConfigurationRevision(ctx, job.ID, revision.ID); err != nil {
+		s.log.Warnf("Failed to record configuration revision for job %s: %v", job.ID, err)
+	}
 
 	// Get certificate data (placeholder - in real implementation, fetch from LCM)
 	certData := &registry.CertificateData{
@@ -534,15 +851,19 @@ func (s *DeploymentService) executeDeployment(ctx context.Context, job *data.Dep
 	}
 
 	// Execute deployment
-	result, err := provider.Deploy(ctx, certData, config.Config, credentials, progressCb)
+	result, err := provider.Deploy(ctx, certData, revision.Config, credentials, progressCb)
 	if err != nil {
-		if _, statusErr := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, err.Error(), 0); statusErr != nil {
+		category := registry.Classify(err)
+		s.breaker.RecordResult(ctx, config, category, err.Error())
+		if _, statusErr := s.jobRepo.MarkFailed(ctx, job.ID, err.Error(), category); statusErr != nil {
 			s.log.Warnf("Failed to update job %s status after deploy error: %v", job.ID, statusErr)
 		} else {
 			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, err.Error(), category, certData)
 		}
 		if _, histErr := s.historyRepo.Create(ctx, job.ID, deploymenthistory.ActionACTION_DEPLOY,
-			deploymenthistory.ResultRESULT_FAILURE, err.Error(), time.Since(startTime).Milliseconds(), nil); histErr != nil {
+			deploymenthistory.ResultRESULT_FAILURE, err.Error(), time.Since(startTime).Milliseconds(),
+			map[string]any{"error_category": string(category)}); histErr != nil {
 			s.log.Warnf("Failed to create deploy failure history for job %s: %v", job.ID, histErr)
 		}
 		return nil, err
@@ -552,6 +873,9 @@ func (s *DeploymentService) executeDeployment(ctx context.Context, job *data.Dep
 	historyResult := deploymenthistory.ResultRESULT_SUCCESS
 	if !result.Success {
 		historyResult = deploymenthistory.ResultRESULT_FAILURE
+		s.breaker.RecordResult(ctx, config, registry.CategoryUnknown, result.Message)
+	} else {
+		s.breaker.RecordResult(ctx, config, "", "")
 	}
 	if _, err := s.historyRepo.Create(ctx, job.ID, deploymenthistory.ActionACTION_DEPLOY,
 		historyResult, result.Message, result.DurationMs, result.Details); err != nil {
@@ -564,21 +888,36 @@ func (s *DeploymentService) executeDeployment(ctx context.Context, job *data.Dep
 			s.log.Warnf("Failed to update job %s status after deployment: %v", job.ID, err)
 		} else {
 			s.collector.JobStatusChanged("processing", "completed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_COMPLETED, "Deployment complete", "", certData)
 		}
-		if err := s.configRepo.UpdateLastDeployment(ctx, config.ID); err != nil {
+		if err := s.configRepo.UpdateLastDeployment(ctx, config.ID, job.CertificateID, certificateExpiry(certData)); err != nil {
 			s.log.Warnf("Failed to update last deployment for config %s: %v", config.ID, err)
 		}
 	} else {
-		if _, err := s.jobRepo.UpdateStatus(ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, result.Message, 0); err != nil {
+		if _, err := s.jobRepo.MarkFailed(ctx, job.ID, result.Message, registry.CategoryUnknown); err != nil {
 			s.log.Warnf("Failed to update job %s status after deployment failure: %v", job.ID, err)
 		} else {
 			s.collector.JobStatusChanged("processing", "failed")
+			s.report(job, deploymentjob.StatusJOB_STATUS_PROCESSING, deploymentjob.StatusJOB_STATUS_FAILED, result.Message, registry.CategoryUnknown, certData)
 		}
 	}
 
 	return toProtoResult(result), nil
 }
 
+// report tells the job observers about a status change of a job run
+// synchronously, as the executor does for the jobs it runs
+func (s *DeploymentService) report(job *data.DeploymentJob, from, to deploymentjob.Status, message string, category registry.ErrorCategory, cert *registry.CertificateData) {
+	s.executor.Report(&JobTransition{
+		Job:         job,
+		From:        from,
+		To:          to,
+		Message:     message,
+		Category:    category,
+		Certificate: cert,
+	})
+}
+
 func toProtoResult(result *registry.DeploymentResult) *deployerV1.DeploymentResult {
 	if result == nil {
 		return nil
@@ -603,3 +942,16 @@ func toProtoResult(result *registry.DeploymentResult) *deployerV1.DeploymentResu
 
 	return proto
 }
+
+func toProtoAction(action registry.PlannedAction) *deployerV1.PlannedAction {
+	proto := &deployerV1.PlannedAction{
+		Operation:    action.Operation,
+		ResourceType: action.ResourceType,
+		Resource:     action.Resource,
+		Description:  action.String(),
+	}
+	if action.Detail != "" {
+		proto.Detail = &action.Detail
+	}
+	return proto
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
 	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenttarget"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
@@ -22,6 +25,7 @@ type DeploymentTargetService struct {
 	targetRepo *data.DeploymentTargetRepo
 	configRepo *data.TargetConfigurationRepo
 	collector  *metrics.Collector
+	changes    *changeRecorder
 }
 
 // NewDeploymentTargetService creates a new DeploymentTargetService
@@ -29,13 +33,16 @@ func NewDeploymentTargetService(
 	ctx *bootstrap.Context,
 	targetRepo *data.DeploymentTargetRepo,
 	configRepo *data.TargetConfigurationRepo,
+	changeRecordRepo *data.ChangeRecordRepo,
 	collector *metrics.Collector,
 ) *DeploymentTargetService {
+	l := ctx.NewLoggerHelper("deployer/service/target")
 	return &DeploymentTargetService{
-		log:        ctx.NewLoggerHelper("deployer/service/target"),
+		log:        l,
 		targetRepo: targetRepo,
 		configRepo: configRepo,
 		collector:  collector,
+		changes:    newChangeRecorder(changeRecordRepo, l),
 	}
 }
 
@@ -43,8 +50,13 @@ func NewDeploymentTargetService(
 func (s *DeploymentTargetService) CreateTarget(ctx context.Context, req *deployerV1.CreateTargetRequest) (*deployerV1.CreateTargetResponse, error) {
 	s.log.Infof("CreateTarget: tenant_id=%d, name=%s", req.GetTenantId(), req.GetName())
 
+	tenantID, err := requestTenantID(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
 	// Check for duplicate name
-	existing, err := s.targetRepo.GetByTenantAndName(ctx, req.GetTenantId(), req.GetName())
+	existing, err := s.targetRepo.GetByTenantAndName(ctx, tenantID, req.GetName())
 	if err != nil {
 		return nil, err
 	}
@@ -88,10 +100,21 @@ func (s *DeploymentTargetService) CreateTarget(ctx context.Context, req *deploye
 		if len(configs) != len(req.ConfigurationIds) {
 			return nil, deployerV1.ErrorConfigurationNotFound("one or more configuration IDs not found")
 		}
+		// Platform admins see every tenant; a target may still only group its own tenant's configurations
+		for _, config := range configs {
+			if config.TenantID == nil || *config.TenantID != tenantID {
+				return nil, deployerV1.ErrorConfigurationNotFound("one or more configuration IDs not found")
+			}
+		}
 	}
 
-	entity, err := s.targetRepo.Create(ctx, req.GetTenantId(), req.GetName(), description,
-		autoDeployOnRenewal, filters, req.ConfigurationIds)
+	var revocationPolicy deploymenttarget.RevocationPolicy
+	if p := data.RevocationPolicyFromProto(req.RevocationPolicy); p != nil {
+		revocationPolicy = *p
+	}
+
+	entity, err := s.targetRepo.Create(ctx, tenantID, req.GetName(), description,
+		autoDeployOnRenewal, revocationPolicy, filters, data.RetryPolicyFromProto(req.RetryPolicy), req.ConfigurationIds)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +127,8 @@ func (s *DeploymentTargetService) CreateTarget(ctx context.Context, req *deploye
 		return nil, err
 	}
 
+	s.recordTargetChange(ctx, changerecord.ActionCHANGE_ACTION_CREATE, nil, entity, req.GetReason())
+
 	return &deployerV1.CreateTargetResponse{
 		Target: s.targetRepo.ToProto(entity, s.configRepo),
 	}, nil
@@ -113,7 +138,7 @@ func (s *DeploymentTargetService) CreateTarget(ctx context.Context, req *deploye
 func (s *DeploymentTargetService) GetTarget(ctx context.Context, req *deployerV1.GetTargetRequest) (*deployerV1.GetTargetResponse, error) {
 	s.log.Infof("GetTarget: id=%s, includeConfigs=%v", req.GetId(), req.GetIncludeConfigurations())
 
-	var entity interface{}
+	var entity *data.DeploymentTarget
 	var err error
 
 	if req.GetIncludeConfigurations() {
@@ -130,7 +155,7 @@ func (s *DeploymentTargetService) GetTarget(ctx context.Context, req *deployerV1
 	}
 
 	return &deployerV1.GetTargetResponse{
-		Target: s.targetRepo.ToProto(entity.(*data.DeploymentTarget), s.configRepo),
+		Target: s.targetRepo.ToProto(entity, s.configRepo),
 	}, nil
 }
 
@@ -149,7 +174,8 @@ func (s *DeploymentTargetService) ListTargets(ctx context.Context, req *deployer
 
 	includeConfigs := req.GetIncludeConfigurations()
 
-	entities, total, err := s.targetRepo.List(ctx, req.TenantId, req.AutoDeployOnRenewal, includeConfigs, page, pageSize)
+	tenantID := caller.FromContext(ctx).TenantScope(req.TenantId)
+	entities, total, err := s.targetRepo.List(ctx, tenantID, req.AutoDeployOnRenewal, includeConfigs, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +196,7 @@ func (s *DeploymentTargetService) UpdateTarget(ctx context.Context, req *deploye
 	s.log.Infof("UpdateTarget: id=%s", req.GetId())
 
 	// Validate target exists
-	existing, err := s.targetRepo.GetByID(ctx, req.GetId())
+	existing, err := s.targetRepo.GetByIDWithConfigurations(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +222,7 @@ func (s *DeploymentTargetService) UpdateTarget(ctx context.Context, req *deploye
 	}
 
 	entity, err := s.targetRepo.Update(ctx, req.GetId(), req.Name, req.Description,
-		req.AutoDeployOnRenewal, filters)
+		req.AutoDeployOnRenewal, data.RevocationPolicyFromProto(req.RevocationPolicy), filters, data.RetryPolicyFromProto(req.RetryPolicy))
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +242,8 @@ func (s *DeploymentTargetService) UpdateTarget(ctx context.Context, req *deploye
 		return nil, err
 	}
 
+	s.recordTargetChange(ctx, changerecord.ActionCHANGE_ACTION_UPDATE, existing, entity, req.GetReason())
+
 	return &deployerV1.UpdateTargetResponse{
 		Target: s.targetRepo.ToProto(entity, s.configRepo),
 	}, nil
@@ -225,8 +253,8 @@ func (s *DeploymentTargetService) UpdateTarget(ctx context.Context, req *deploye
 func (s *DeploymentTargetService) DeleteTarget(ctx context.Context, req *deployerV1.DeleteTargetRequest) (*emptypb.Empty, error) {
 	s.log.Infof("DeleteTarget: id=%s", req.GetId())
 
-	// Get target before deletion for metrics
-	existing, err := s.targetRepo.GetByID(ctx, req.GetId())
+	// Get target before deletion for metrics and the change record
+	existing, err := s.targetRepo.GetByIDWithConfigurations(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +268,8 @@ func (s *DeploymentTargetService) DeleteTarget(ctx context.Context, req *deploye
 	}
 	s.collector.TargetDeleted(autoDeploy)
 
+	s.recordTargetChange(ctx, changerecord.ActionCHANGE_ACTION_DELETE, existing, nil, req.GetReason())
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -248,7 +278,7 @@ func (s *DeploymentTargetService) AddConfigurations(ctx context.Context, req *de
 	s.log.Infof("AddConfigurations: target=%s, configs=%v", req.GetId(), req.ConfigurationIds)
 
 	// Validate target exists
-	existing, err := s.targetRepo.GetByID(ctx, req.GetId())
+	existing, err := s.targetRepo.GetByIDWithConfigurations(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +306,8 @@ func (s *DeploymentTargetService) AddConfigurations(ctx context.Context, req *de
 		return nil, err
 	}
 
+	s.recordTargetChange(ctx, changerecord.ActionCHANGE_ACTION_ADD_CONFIGURATIONS, existing, entity, req.GetReason())
+
 	return &deployerV1.AddConfigurationsResponse{
 		Target: s.targetRepo.ToProto(entity, s.configRepo),
 	}, nil
@@ -286,7 +318,7 @@ func (s *DeploymentTargetService) RemoveConfigurations(ctx context.Context, req
 	s.log.Infof("RemoveConfigurations: target=%s, configs=%v", req.GetId(), req.ConfigurationIds)
 
 	// Validate target exists
-	existing, err := s.targetRepo.GetByID(ctx, req.GetId())
+	existing, err := s.targetRepo.GetByIDWithConfigurations(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +337,8 @@ func (s *DeploymentTargetService) RemoveConfigurations(ctx context.Context, req
 		return nil, err
 	}
 
+	s.recordTargetChange(ctx, changerecord.ActionCHANGE_ACTION_REMOVE_CONFIGURATIONS, existing, entity, req.GetReason())
+
 	return &deployerV1.RemoveConfigurationsResponse{
 		Target: s.targetRepo.ToProto(entity, s.configRepo),
 	}, nil
@@ -338,3 +372,30 @@ func (s *DeploymentTargetService) ListTargetConfigurations(ctx context.Context,
 		Total: uint64(total),
 	}, nil
 }
+
+// recordTargetChange records a mutation of a deployment target. before is nil
+// for creations and after is nil for deletions.
+func (s *DeploymentTargetService) recordTargetChange(ctx context.Context, action changerecord.Action, before, after *data.DeploymentTarget, reason string) {
+	current := after
+	if current == nil {
+		current = before
+	}
+	if current == nil {
+		return
+	}
+
+	var tenantID uint32
+	if current.TenantID != nil {
+		tenantID = *current.TenantID
+	}
+
+	s.changes.record(ctx, &data.ChangeRecordInput{
+		TenantID:     tenantID,
+		ResourceType: changerecord.ResourceTypeCHANGE_RESOURCE_TYPE_DEPLOYMENT_TARGET,
+		ResourceID:   current.ID,
+		ResourceName: current.Name,
+		Action:       action,
+		Changes:      diffSnapshots(targetSnapshot(before), targetSnapshot(after)),
+		Reason:       reason,
+	})
+}
@@ -0,0 +1,462 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	// driftCheckTask names the leader lease of the drift detector
+	driftCheckTask = "drift-detection"
+	// driftCheckTick is how often the leader looks for due checks
+	driftCheckTick = time.Minute
+	// driftCheckLease is how long leadership lasts without renewal
+	driftCheckLease = 3 * time.Minute
+	// driftCheckBatch bounds the configurations checked per run
+	driftCheckBatch = 100
+)
+
+// drift is a mismatch found by one of the checks
+type drift struct {
+	source         driftevent.Source
+	message        string
+	observedSerial string
+	details        map[string]any
+}
+
+// DriftDetector periodically checks that the devices of active configurations
+// still serve the certificate of their last successful deployment, using the
+// provider's Verify and, where a TLS probe address is configured, the
+// certificate served there. Drift is recorded as an open drift event until a
+// later check passes; with auto remediation a job redeploying the expected
+// certificate is created. Only the elected leader replica runs checks.
+type DriftDetector struct {
+	log           *log.Helper
+	configRepo    *data.TargetConfigurationRepo
+	jobRepo       *data.DeploymentJobRepo
+	driftRepo     *data.DriftEventRepo
+	configService *TargetConfigurationService
+	lcmClient     *data.LcmClient
+	policies      *data.RetryPolicies
+	leader        *LeaderElection
+	collector     *metrics.Collector
+	publisher     *EventPublisher
+	config        *conf.DriftConfig
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewDriftDetector creates a new drift detector
+func NewDriftDetector(
+	ctx *bootstrap.Context,
+	configRepo *data.TargetConfigurationRepo,
+	jobRepo *data.DeploymentJobRepo,
+	driftRepo *data.DriftEventRepo,
+	configService *TargetConfigurationService,
+	lcmClient *data.LcmClient,
+	policies *data.RetryPolicies,
+	leader *LeaderElection,
+	collector *metrics.Collector,
+	publisher *EventPublisher,
+) *DriftDetector {
+	var driftCfg *conf.DriftConfig
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			driftCfg = deployerCfg.Drift
+		}
+	}
+
+	// Default config
+	if driftCfg == nil {
+		driftCfg = &conf.DriftConfig{
+			IntervalMinutes: 720,
+			Jitter:          0.1,
+		}
+	}
+	if driftCfg.Concurrency <= 0 {
+		driftCfg.Concurrency = 5
+	}
+	if driftCfg.TimeoutSeconds <= 0 {
+		driftCfg.TimeoutSeconds = 60
+	}
+
+	return &DriftDetector{
+		log:           ctx.NewLoggerHelper("deployer/drift-detector"),
+		configRepo:    configRepo,
+		jobRepo:       jobRepo,
+		driftRepo:     driftRepo,
+		configService: configService,
+		lcmClient:     lcmClient,
+		policies:      policies,
+		leader:        leader,
+		collector:     collector,
+		publisher:     publisher,
+		config:        driftCfg,
+	}
+}
+
+// Start starts the check loop
+func (d *DriftDetector) Start() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.running || d.config.IntervalMinutes <= 0 {
+		return nil
+	}
+
+	// Use system viewer context for background operations (bypasses tenant privacy checks)
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	d.ctx, d.cancel = context.WithCancel(baseCtx)
+	d.running = true
+
+	d.log.Infof("Starting drift detection every %d minutes (concurrency: %d, auto remediate: %v)",
+		d.config.IntervalMinutes, d.config.Concurrency, d.config.AutoRemediate)
+	d.wg.Add(1)
+	go d.checkWorker()
+
+	return nil
+}
+
+// Stop stops the detector and hands leadership to another replica
+func (d *DriftDetector) Stop() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.running {
+		return nil
+	}
+
+	d.log.Info("Stopping drift detector")
+	d.cancel()
+	d.wg.Wait()
+	d.leader.Resign(d.ctx, driftCheckTask)
+	d.running = false
+
+	return nil
+}
+
+// checkWorker periodically runs the due checks while this replica leads
+func (d *DriftDetector) checkWorker() {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(driftCheckTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			d.CheckDue(d.ctx)
+		}
+	}
+}
+
+// CheckDue checks the configurations whose drift check is due, at most the
+// configured number at once. It does nothing unless this replica leads.
+func (d *DriftDetector) CheckDue(ctx context.Context) {
+	if !d.leader.IsLeader(ctx, driftCheckTask, driftCheckLease) {
+		return
+	}
+
+	configs, err := d.configRepo.ListDueDriftChecks(ctx, driftCheckBatch)
+	if err != nil {
+		d.log.Errorf("Failed to list configurations due for a drift check: %v", err)
+		return
+	}
+
+	sem := make(chan struct{}, d.config.Concurrency)
+	var wg sync.WaitGroup
+	for _, config := range configs {
+		if ctx.Err() != nil || !d.leader.IsLeader(ctx, driftCheckTask, driftCheckLease) {
+			break
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(config *data.TargetConfiguration) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			d.check(ctx, config)
+		}(config)
+	}
+	wg.Wait()
+}
+
+// check compares the device of config with its last successful deployment
+func (d *DriftDetector) check(ctx context.Context, config *data.TargetConfiguration) {
+	claimed, err := d.configRepo.ClaimDriftCheck(ctx, config.ID, config.NextDriftCheckAt, time.Now().Add(d.nextInterval()))
+	if err != nil || !claimed {
+		return
+	}
+
+	job, err := d.jobRepo.LastCompleted(ctx, config.ID)
+	if err != nil || job == nil {
+		return
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, time.Duration(d.config.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	cert := d.expectedCertificate(checkCtx, job)
+
+	var found *drift
+	checked, inconclusive := 0, false
+
+	// Ask the provider whether the certificate is still in place
+	provider, err := registry.Get(config.ProviderType)
+	if err == nil && provider.GetCapabilities().SupportsVerification {
+		revision, credentials, err := d.configService.ResolveRevision(checkCtx, config)
+		if err == nil {
+			var result *registry.DeploymentResult
+			result, err = provider.Verify(checkCtx, cert, revision.Config, credentials)
+			if err == nil && result != nil {
+				checked++
+				if !result.Success {
+					found = &drift{
+						source:  driftevent.SourceDRIFT_SOURCE_VERIFY,
+						message: result.Message,
+						details: result.Details,
+					}
+					found.observedSerial, _ = result.Details["observed_serial"].(string)
+				}
+			}
+		}
+		if err != nil {
+			// A device that cannot be asked has not necessarily drifted;
+			// failures reaching it surface through the credential checks
+			inconclusive = true
+			d.log.Warnf("Drift check of configuration %s: verification failed: %v", config.ID, err)
+		}
+	}
+
+	// Compare the certificate actually served
+	if found == nil && config.TLSProbeAddress != "" {
+		mismatch, err := probeTLS(checkCtx, config.TLSProbeAddress, cert)
+		if err != nil {
+			inconclusive = true
+			d.log.Warnf("Drift check of configuration %s: TLS probe of %s failed: %v", config.ID, config.TLSProbeAddress, err)
+		} else {
+			checked++
+			found = mismatch
+		}
+	}
+
+	if found != nil {
+		d.recordDrift(ctx, config, job, found)
+		return
+	}
+	if checked == 0 || inconclusive {
+		return
+	}
+
+	resolved, err := d.driftRepo.ResolveOpen(ctx, config.ID)
+	if err != nil {
+		d.log.Warnf("Failed to resolve drift of configuration %s: %v", config.ID, err)
+	} else if resolved > 0 {
+		d.log.Infof("Configuration %s serves its deployed certificate again", config.ID)
+	}
+}
+
+// expectedCertificate returns the certificate of job, without its private key
+func (d *DriftDetector) expectedCertificate(ctx context.Context, job *data.DeploymentJob) *registry.CertificateData {
+	if d.lcmClient != nil {
+		lcmCert, err := d.lcmClient.GetCertificateByJobID(ctx, job.CertificateID, false)
+		if err == nil {
+			cert := toRegistryCertificate(lcmCert)
+			if cert.SerialNumber == "" {
+				cert.SerialNumber = job.CertificateSerial
+			}
+			return cert
+		}
+		d.log.Warnf("Failed to fetch certificate %s from LCM: %v", job.CertificateID, err)
+	}
+	return &registry.CertificateData{
+		ID:           job.CertificateID,
+		SerialNumber: job.CertificateSerial,
+	}
+}
+
+// recordDrift opens a drift event for config unless one is open already and,
+// with auto remediation, redeploys the certificate of job
+func (d *DriftDetector) recordDrift(ctx context.Context, config *data.TargetConfiguration, job *data.DeploymentJob, found *drift) {
+	open, err := d.driftRepo.GetOpen(ctx, config.ID)
+	if err != nil || open != nil {
+		return
+	}
+
+	var tenantID uint32
+	if config.TenantID != nil {
+		tenantID = *config.TenantID
+	}
+
+	event, err := d.driftRepo.Create(ctx, &data.DriftEventInput{
+		TenantID:              tenantID,
+		TargetConfigurationID: config.ID,
+		JobID:                 job.ID,
+		CertificateID:         job.CertificateID,
+		CertificateSerial:     job.CertificateSerial,
+		Source:                found.source,
+		Message:               found.message,
+		ObservedSerial:        found.observedSerial,
+		Details:               found.details,
+	})
+	if err != nil {
+		return
+	}
+
+	source := strings.ToLower(strings.TrimPrefix(string(found.source), "DRIFT_SOURCE_"))
+	d.collector.DriftFound(config.ProviderType, source)
+	d.log.Warnf("Configuration %s drifted from job %s (%s): %s", config.ID, job.ID, source, found.message)
+
+	if d.config.AutoRemediate {
+		d.remediate(ctx, tenantID, config, job, event)
+	}
+	d.publisher.DriftDetected(ctx, event)
+}
+
+// remediate creates a job redeploying the certificate of job and links it to event
+func (d *DriftDetector) remediate(ctx context.Context, tenantID uint32, config *data.TargetConfiguration, job *data.DeploymentJob, event *ent.DriftEvent) {
+	triggeredBy := deploymentjob.TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION
+	remediation, err := d.jobRepo.CreateDirectJob(ctx, tenantID, config.ID, job.CertificateID,
+		job.CertificateSerial, "", triggeredBy, d.policies.Resolve(config, nil))
+	if err != nil {
+		d.log.Errorf("Failed to create remediation job for configuration %s: %v", config.ID, err)
+		return
+	}
+	d.collector.JobCreated("pending", string(triggeredBy))
+	supersedeQueuedJobs(ctx, d.jobRepo, d.collector, d.log, remediation)
+
+	if err := d.driftRepo.SetRemediationJob(ctx, event.ID, remediation.ID); err != nil {
+		d.log.Warnf("Failed to link remediation job %s to drift event %d: %v", remediation.ID, event.ID, err)
+	} else {
+		event.RemediationJobID = &remediation.ID
+	}
+	d.log.Infof("Created remediation job %s for configuration %s", remediation.ID, config.ID)
+}
+
+// nextInterval returns the configured interval randomized by the jitter
+func (d *DriftDetector) nextInterval() time.Duration {
+	interval := time.Duration(d.config.IntervalMinutes) * time.Minute
+	jitter := float64(d.config.Jitter)
+	if jitter <= 0 {
+		return interval
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	return time.Duration(float64(interval) * (1 + jitter*(2*rand.Float64()-1)))
+}
+
+// probeTLS connects to address and compares the certificate it serves with
+// cert, by DER when the PEM is known and by serial otherwise. It returns nil
+// when they match.
+func probeTLS(ctx context.Context, address string, cert *registry.CertificateData) (*drift, error) {
+	var expected *x509.Certificate
+	if block, _ := pem.Decode([]byte(cert.CertificatePEM)); block != nil {
+		parsed, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse expected certificate: %w", err)
+		}
+		expected = parsed
+	}
+	if expected == nil && cert.SerialNumber == "" {
+		return nil, fmt.Errorf("expected certificate unknown")
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &tls.Dialer{Config: &tls.Config{
+		ServerName: probeServerName(host, cert),
+		// The served certificate is compared, not trusted
+		InsecureSkipVerify: true, //nolint:gosec
+	}}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	peers := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(peers) == 0 {
+		return nil, fmt.Errorf("no certificate served")
+	}
+	served := peers[0]
+
+	if expected != nil {
+		if bytes.Equal(served.Raw, expected.Raw) {
+			return nil, nil
+		}
+	} else if registry.NormalizeSerial(cert.SerialNumber) == registry.NormalizeSerial(served.SerialNumber.Text(16)) {
+		return nil, nil
+	}
+
+	return &drift{
+		source:         driftevent.SourceDRIFT_SOURCE_TLS_PROBE,
+		message:        fmt.Sprintf("%s serves %q instead of the deployed certificate", address, served.Subject.CommonName),
+		observedSerial: formatSerial(served.SerialNumber),
+		details: map[string]any{
+			"address":     address,
+			"common_name": served.Subject.CommonName,
+			"not_after":   served.NotAfter.UTC().Format(time.RFC3339),
+		},
+	}, nil
+}
+
+// probeServerName returns the SNI of a probe: the probed host unless it is an
+// IP address, else a name of the certificate
+func probeServerName(host string, cert *registry.CertificateData) string {
+	if net.ParseIP(host) == nil {
+		return host
+	}
+	for _, name := range append([]string{cert.CommonName}, cert.SANs...) {
+		if name != "" && !strings.HasPrefix(name, "*.") && net.ParseIP(name) == nil {
+			return name
+		}
+	}
+	return ""
+}
+
+// formatSerial formats a serial as colon-separated hex
+func formatSerial(serial *big.Int) string {
+	hex := serial.Text(16)
+	if len(hex)%2 == 1 {
+		hex = "0" + hex
+	}
+	parts := make([]string, 0, len(hex)/2)
+	for i := 0; i < len(hex); i += 2 {
+		parts = append(parts, hex[i:i+2])
+	}
+	return strings.ToUpper(strings.Join(parts, ":"))
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tx7do/go-crud/viewer"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+func TestDriftDetector_RecordsRemediatesAndResolves(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	configRepo := f.configs.configRepo
+	jobRepo := f.jobs.jobRepo
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+
+	// The seeded job deployed successfully
+	deployed, err := f.client.DeploymentJob.Query().Only(systemCtx)
+	if err != nil {
+		t.Fatalf("seeded job: %v", err)
+	}
+	if _, err := jobRepo.UpdateStatus(systemCtx, deployed.ID, deploymentjob.StatusJOB_STATUS_COMPLETED, "done", 100); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+	if err := configRepo.UpdateLastDeployment(systemCtx, configID, deployed.CertificateID, nil); err != nil {
+		t.Fatalf("UpdateLastDeployment: %v", err)
+	}
+
+	driftRepo := f.drift
+	detector := NewDriftDetector(testBootstrap, configRepo, jobRepo, driftRepo, f.configs, nil,
+		data.NewRetryPolicies(testBootstrap), NewLeaderElection(testBootstrap, f.locks), testCollector(), nil)
+	detector.config.AutoRemediate = true
+	// Like the common system viewer, keep the tenant of what the detector creates
+	workerCtx := adminCtx()
+
+	setVerifyFails := func(fail bool) {
+		t.Helper()
+		cfg, _ := structpb.NewStruct(map[string]any{"should_fail": fail, "simulate_delay_ms": 0})
+		if _, err := f.configs.UpdateConfiguration(tenantCtx(tenantA), &deployerV1.UpdateConfigurationRequest{
+			Id:     configID,
+			Config: cfg,
+		}); err != nil {
+			t.Fatalf("UpdateConfiguration: %v", err)
+		}
+		// Make the next check due
+		if err := f.client.TargetConfiguration.UpdateOneID(configID).ClearNextDriftCheckAt().Exec(systemCtx); err != nil {
+			t.Fatalf("clear next drift check: %v", err)
+		}
+	}
+
+	// The device no longer has the certificate
+	setVerifyFails(true)
+	detector.CheckDue(workerCtx)
+
+	events, _, err := driftRepo.List(systemCtx, &data.DriftEventListOptions{UnresolvedOnly: true})
+	if err != nil || len(events) != 1 {
+		t.Fatalf("open drift events: %d, %v; want 1", len(events), err)
+	}
+	event := events[0]
+	if event.Source != driftevent.SourceDRIFT_SOURCE_VERIFY || event.JobID != deployed.ID {
+		t.Fatalf("drift event source %s, job %s; want VERIFY for job %s", event.Source, event.JobID, deployed.ID)
+	}
+	if event.RemediationJobID == nil {
+		t.Fatal("no remediation job created")
+	}
+	remediation, err := jobRepo.GetByID(systemCtx, *event.RemediationJobID)
+	if err != nil || remediation == nil {
+		t.Fatalf("remediation job: %v, %v", remediation, err)
+	}
+	if remediation.TriggeredBy != deploymentjob.TriggeredByTRIGGER_TYPE_DRIFT_REMEDIATION || remediation.CertificateID != deployed.CertificateID {
+		t.Fatalf("remediation job triggered by %s for %s; want drift remediation of %s", remediation.TriggeredBy, remediation.CertificateID, deployed.CertificateID)
+	}
+
+	// Drift found again while the event is open is not recorded twice
+	setVerifyFails(true)
+	detector.CheckDue(workerCtx)
+	if _, total, _ := driftRepo.List(systemCtx, &data.DriftEventListOptions{}); total != 1 {
+		t.Fatalf("drift events after a repeated finding: %d, want 1", total)
+	}
+
+	// The certificate is back in place
+	setVerifyFails(false)
+	detector.CheckDue(workerCtx)
+	if _, open, _ := driftRepo.List(systemCtx, &data.DriftEventListOptions{UnresolvedOnly: true}); open != 0 {
+		t.Fatalf("open drift events after a passing check: %d, want 0", open)
+	}
+
+	// ListDrift stays within the caller's tenant
+	drift := NewDriftService(testBootstrap, driftRepo)
+	resp, err := drift.ListDrift(tenantCtx(tenantB), &deployerV1.ListDriftRequest{})
+	if err != nil {
+		t.Fatalf("ListDrift: %v", err)
+	}
+	if resp.GetTotal() != 0 {
+		t.Fatalf("tenant %d sees %d drift events of tenant %d", tenantB, resp.GetTotal(), tenantA)
+	}
+	resp, err = drift.ListDrift(tenantCtx(tenantA), &deployerV1.ListDriftRequest{})
+	if err != nil || resp.GetTotal() != 1 || resp.GetItems()[0].GetResolvedAt() == nil {
+		t.Fatalf("ListDrift of tenant %d: %v, %v; want one resolved event", tenantA, resp, err)
+	}
+}
+
+func TestProbeTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(nil)
+	defer srv.Close()
+	address := strings.TrimPrefix(srv.URL, "https://")
+	served := srv.Certificate()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	servedPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: served.Raw}))
+	if found, err := probeTLS(ctx, address, &registry.CertificateData{CertificatePEM: servedPEM}); err != nil || found != nil {
+		t.Fatalf("probe of the expected certificate: %v, %v; want no drift", found, err)
+	}
+	if found, err := probeTLS(ctx, address, &registry.CertificateData{SerialNumber: formatSerial(served.SerialNumber)}); err != nil || found != nil {
+		t.Fatalf("probe by serial: %v, %v; want no drift", found, err)
+	}
+
+	found, err := probeTLS(ctx, address, &registry.CertificateData{SerialNumber: "01"})
+	if err != nil || found == nil {
+		t.Fatalf("probe of another certificate: %v, %v; want drift", found, err)
+	}
+	if found.source != driftevent.SourceDRIFT_SOURCE_TLS_PROBE || registry.NormalizeSerial(found.observedSerial) != registry.NormalizeSerial(served.SerialNumber.Text(16)) {
+		t.Fatalf("drift %+v, want a TLS probe drift observing serial %x", found, served.SerialNumber)
+	}
+}
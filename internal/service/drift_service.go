@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// DriftService implements the DriftService gRPC service
+type DriftService struct {
+	deployerV1.UnimplementedDriftServiceServer
+
+	driftRepo *data.DriftEventRepo
+	log       *log.Helper
+}
+
+// NewDriftService creates a new DriftService
+func NewDriftService(ctx *bootstrap.Context, driftRepo *data.DriftEventRepo) *DriftService {
+	return &DriftService{
+		driftRepo: driftRepo,
+		log:       ctx.NewLoggerHelper("deployer/service/drift"),
+	}
+}
+
+// ListDrift lists drift events, newest first
+func (s *DriftService) ListDrift(ctx context.Context, req *deployerV1.ListDriftRequest) (*deployerV1.ListDriftResponse, error) {
+	opts := &data.DriftEventListOptions{
+		TargetConfigurationID: req.TargetConfigurationId,
+		UnresolvedOnly:        req.GetUnresolvedOnly(),
+		Page:                  1,
+		PageSize:              20,
+	}
+
+	// Only platform admins may look across tenants
+	opts.TenantID = caller.FromContext(ctx).TenantScope(req.TenantId)
+
+	if req.Page != nil && *req.Page > 0 {
+		opts.Page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		opts.PageSize = *req.PageSize
+	}
+
+	entities, total, err := s.driftRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.DriftEvent, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, s.driftRepo.ToProto(entity))
+	}
+
+	return &deployerV1.ListDriftResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/event"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	// eventRelayTask names the leader lease of the outbox relay
+	eventRelayTask = "event-outbox"
+	// eventRelayLease is how long leadership lasts without renewal
+	eventRelayLease = time.Minute
+	// eventRelayMaxBackoff bounds the delay between attempts of an event
+	eventRelayMaxBackoff = 5 * time.Minute
+	// eventPruneInterval is how often published events past retention are removed
+	eventPruneInterval = time.Hour
+)
+
+// jobEventTypes maps the job statuses that are published to their event types
+var jobEventTypes = map[deploymentjob.Status]string{
+	deploymentjob.StatusJOB_STATUS_PROCESSING: event.EventJobStarted,
+	deploymentjob.StatusJOB_STATUS_COMPLETED:  event.EventJobCompleted,
+	deploymentjob.StatusJOB_STATUS_FAILED:     event.EventJobFailed,
+	deploymentjob.StatusJOB_STATUS_PARTIAL:    event.EventJobPartial,
+}
+
+// EventPublisher publishes job and drift lifecycle events on the event bus,
+// in the envelope of the events the deployer consumes. Events are written to
+// an outbox table when they happen and relayed to Redis by the elected
+// leader replica, so that they survive Redis being briefly unavailable.
+// Consumers may see an event more than once and deduplicate by its ID.
+type EventPublisher struct {
+	log        *log.Helper
+	outboxRepo *data.OutboxRepo
+	leader     *LeaderElection
+	config     *conf.PublishConfig
+	publish    func(ctx context.Context, channel string, payload []byte) error
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewEventPublisher creates an event publisher and subscribes it to the job executor
+func NewEventPublisher(
+	ctx *bootstrap.Context,
+	outboxRepo *data.OutboxRepo,
+	rdb *redis.Client,
+	leader *LeaderElection,
+	executor *JobExecutor,
+) *EventPublisher {
+	var publishCfg *conf.PublishConfig
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			publishCfg = deployerCfg.Publish
+		}
+	}
+
+	// Default config
+	if publishCfg == nil {
+		publishCfg = &conf.PublishConfig{}
+	}
+	if publishCfg.TopicPrefix == "" {
+		publishCfg.TopicPrefix = "deployer"
+	}
+	if publishCfg.PollIntervalSeconds <= 0 {
+		publishCfg.PollIntervalSeconds = 2
+	}
+	if publishCfg.BatchSize <= 0 {
+		publishCfg.BatchSize = 100
+	}
+	if publishCfg.RetentionHours <= 0 {
+		publishCfg.RetentionHours = 24
+	}
+
+	p := &EventPublisher{
+		log:        ctx.NewLoggerHelper("deployer/event-publisher"),
+		outboxRepo: outboxRepo,
+		leader:     leader,
+		config:     publishCfg,
+	}
+
+	switch publishCfg.Format {
+	case "":
+		publishCfg.Format = event.FormatLCM
+	case event.FormatLCM, event.FormatCloudEvents:
+	default:
+		p.log.Warnf("Unknown event format %q, publishing LCM envelopes", publishCfg.Format)
+		publishCfg.Format = event.FormatLCM
+	}
+
+	if publishCfg.Enabled && rdb == nil {
+		p.log.Warn("Redis client not available, lifecycle events will not be published")
+		publishCfg.Enabled = false
+	}
+	if !publishCfg.Enabled {
+		return p
+	}
+
+	p.publish = func(ctx context.Context, channel string, payload []byte) error {
+		return rdb.Publish(ctx, channel, payload).Err()
+	}
+	executor.Subscribe(p)
+
+	return p
+}
+
+// Start starts relaying the outbox
+func (p *EventPublisher) Start() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.running || !p.config.Enabled {
+		return nil
+	}
+
+	// Use system viewer context for background operations (bypasses tenant privacy checks)
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	p.ctx, p.cancel = context.WithCancel(baseCtx)
+	p.running = true
+
+	p.log.Infof("Publishing lifecycle events on %s.* (poll interval: %ds)", p.config.TopicPrefix, p.config.PollIntervalSeconds)
+	p.wg.Add(1)
+	go p.relayWorker()
+
+	return nil
+}
+
+// Stop stops relaying and hands leadership to another replica. Events not
+// relayed yet stay in the outbox.
+func (p *EventPublisher) Stop() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.running {
+		return nil
+	}
+
+	p.log.Info("Stopping event publisher")
+	p.cancel()
+	p.wg.Wait()
+	p.leader.Resign(p.ctx, eventRelayTask)
+	p.running = false
+
+	return nil
+}
+
+// relayWorker periodically relays due events and prunes published ones
+func (p *EventPublisher) relayWorker() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(p.config.PollIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	var lastPrune time.Time
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			if !p.leader.IsLeader(p.ctx, eventRelayTask, eventRelayLease) {
+				continue
+			}
+			p.RelayDue(p.ctx)
+			if time.Since(lastPrune) >= eventPruneInterval {
+				p.prune(p.ctx)
+				lastPrune = time.Now()
+			}
+		}
+	}
+}
+
+// JobTransitioned writes the event of a published job status change to the outbox
+func (p *EventPublisher) JobTransitioned(ctx context.Context, t *JobTransition) {
+	eventType, ok := jobEventTypes[t.To]
+	if !ok {
+		return
+	}
+
+	job := t.Job
+	var tenantID uint32
+	if job.TenantID != nil {
+		tenantID = *job.TenantID
+	}
+
+	payload := &event.JobEventData{
+		JobID:          job.ID,
+		TenantID:       tenantID,
+		Status:         string(t.To),
+		PreviousStatus: string(t.From),
+		TriggeredBy:    string(job.TriggeredBy),
+		RetryCount:     job.RetryCount,
+		CertificateID:  job.CertificateID,
+		SerialNumber:   job.CertificateSerial,
+		Message:        t.Message,
+		ErrorCategory:  string(t.Category),
+		OccurredAt:     time.Now().UTC(),
+	}
+	if job.ParentJobID != nil {
+		payload.ParentJobID = *job.ParentJobID
+	}
+	if job.DeploymentTargetID != nil {
+		payload.DeploymentTargetID = *job.DeploymentTargetID
+	}
+	if job.TargetConfigurationID != nil {
+		payload.TargetConfigurationID = *job.TargetConfigurationID
+	}
+	if t.Certificate != nil {
+		payload.CommonName = t.Certificate.CommonName
+		if t.Certificate.SerialNumber != "" {
+			payload.SerialNumber = t.Certificate.SerialNumber
+		}
+	}
+
+	p.enqueue(ctx, eventType, tenantID, job.ID, payload)
+}
+
+// DriftDetected writes a drift.detected event of a new drift event to the
+// outbox. It is safe to call on a nil publisher.
+func (p *EventPublisher) DriftDetected(ctx context.Context, entity *ent.DriftEvent) {
+	if p == nil || !p.config.Enabled {
+		return
+	}
+
+	var tenantID uint32
+	if entity.TenantID != nil {
+		tenantID = *entity.TenantID
+	}
+
+	payload := &event.DriftDetectedData{
+		DriftEventID:          entity.ID,
+		TenantID:              tenantID,
+		TargetConfigurationID: entity.TargetConfigurationID,
+		JobID:                 entity.JobID,
+		CertificateID:         entity.CertificateID,
+		SerialNumber:          entity.CertificateSerial,
+		Source:                string(entity.Source),
+		Message:               entity.Message,
+		ObservedSerial:        entity.ObservedSerial,
+		DetectedAt:            time.Now().UTC(),
+	}
+	if entity.RemediationJobID != nil {
+		payload.RemediationJobID = *entity.RemediationJobID
+	}
+	if entity.CreateTime != nil {
+		payload.DetectedAt = entity.CreateTime.UTC()
+	}
+
+	p.enqueue(ctx, event.EventDriftDetected, tenantID, entity.TargetConfigurationID, payload)
+}
+
+// enqueue wraps data in an event envelope of the configured format and
+// writes it to the outbox. CloudEvents take the channel as type and what the
+// event is about as subject.
+func (p *EventPublisher) enqueue(ctx context.Context, eventType string, tenantID uint32, subject string, payload any) {
+	dataBytes, err := json.Marshal(payload)
+	if err != nil {
+		p.log.Errorf("Failed to marshal %s event: %v", eventType, err)
+		return
+	}
+
+	envelope := &event.LCMEvent{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		Source:    event.SourceDeployer,
+		Timestamp: time.Now().UTC(),
+		TenantID:  tenantID,
+		Data:      dataBytes,
+	}
+	channel := p.config.TopicPrefix + "." + eventType
+
+	var body []byte
+	if p.config.Format == event.FormatCloudEvents {
+		body, err = json.Marshal(event.NewCloudEvent(envelope, channel, subject))
+	} else {
+		body, err = json.Marshal(envelope)
+	}
+	if err != nil {
+		p.log.Errorf("Failed to marshal %s event: %v", eventType, err)
+		return
+	}
+
+	// The event belongs to the change that already happened
+	ctx = context.WithoutCancel(ctx)
+	if err := p.outboxRepo.Enqueue(ctx, envelope.ID, channel, body); err != nil {
+		p.log.Errorf("Failed to write %s event to the outbox: %v", eventType, err)
+	}
+}
+
+// RelayDue publishes the pending events of the outbox in order. It stops at
+// the first failure, which is retried with backoff, and at events whose
+// backoff has not passed, so that later events never overtake them.
+func (p *EventPublisher) RelayDue(ctx context.Context) {
+	events, err := p.outboxRepo.ListPending(ctx, int(p.config.BatchSize))
+	if err != nil {
+		return
+	}
+
+	for _, e := range events {
+		if ctx.Err() != nil || e.NextAttemptAt.After(time.Now()) {
+			return
+		}
+		if err := p.publish(ctx, e.Channel, []byte(e.Payload)); err != nil {
+			next := time.Now().Add(p.backoff(e.Attempts))
+			p.log.Warnf("Failed to publish event %s on %s (attempt %d), retrying at %v: %v",
+				e.EventID, e.Channel, e.Attempts+1, next, err)
+			_ = p.outboxRepo.MarkFailed(ctx, e.ID, err.Error(), next)
+			return
+		}
+		if err := p.outboxRepo.MarkPublished(ctx, e.ID); err != nil {
+			// Published again next time; consumers deduplicate by ID
+			return
+		}
+	}
+}
+
+// backoff returns the delay after a failed attempt, doubling the poll
+// interval with every previous failure
+func (p *EventPublisher) backoff(attempts int32) time.Duration {
+	delay := time.Duration(p.config.PollIntervalSeconds) * time.Second
+	for i := int32(0); i < attempts && delay < eventRelayMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, eventRelayMaxBackoff)
+}
+
+// prune removes published events past retention
+func (p *EventPublisher) prune(ctx context.Context) {
+	cutoff := time.Now().Add(-time.Duration(p.config.RetentionHours) * time.Hour)
+	n, err := p.outboxRepo.DeletePublishedBefore(ctx, cutoff)
+	if err != nil {
+		return
+	}
+	if n > 0 {
+		p.log.Infof("Removed %d published events from the outbox", n)
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/driftevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-deployer/internal/event"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+)
+
+func TestEventPublisher_RelaysOutboxInOrderAfterFailures(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+
+	publisher := NewEventPublisher(testBootstrap, f.outbox, nil, NewLeaderElection(testBootstrap, f.locks), &JobExecutor{})
+	publisher.config.Enabled = true
+
+	type published struct {
+		channel  string
+		envelope event.LCMEvent
+	}
+	var sent []published
+	redisDown := true
+	publisher.publish = func(_ context.Context, channel string, payload []byte) error {
+		if redisDown {
+			return errors.New("connection refused")
+		}
+		var envelope event.LCMEvent
+		if err := json.Unmarshal(payload, &envelope); err != nil {
+			t.Fatalf("published payload %s: %v", payload, err)
+		}
+		sent = append(sent, published{channel: channel, envelope: envelope})
+		return nil
+	}
+
+	job, err := f.client.DeploymentJob.Query().Only(systemCtx)
+	if err != nil {
+		t.Fatalf("seeded job: %v", err)
+	}
+	cert := &registry.CertificateData{ID: job.CertificateID, CommonName: "www.example.com"}
+	for _, to := range []deploymentjob.Status{
+		deploymentjob.StatusJOB_STATUS_PROCESSING,
+		// Retries are not published
+		deploymentjob.StatusJOB_STATUS_RETRYING,
+		deploymentjob.StatusJOB_STATUS_FAILED,
+	} {
+		publisher.JobTransitioned(systemCtx, &JobTransition{Job: job, To: to, Message: "status " + string(to), Certificate: cert})
+	}
+	drift, err := f.drift.Create(adminCtx(), &data.DriftEventInput{
+		TenantID:              tenantA,
+		TargetConfigurationID: configID,
+		JobID:                 job.ID,
+		CertificateID:         job.CertificateID,
+		Source:                driftevent.SourceDRIFT_SOURCE_TLS_PROBE,
+	})
+	if err != nil {
+		t.Fatalf("create drift event: %v", err)
+	}
+	publisher.DriftDetected(systemCtx, drift)
+
+	// Nothing is lost or reordered while Redis is down
+	publisher.RelayDue(systemCtx)
+	pending, err := f.client.OutboxEvent.Query().Order(outboxevent.ByID()).All(systemCtx)
+	if err != nil || len(pending) != 3 {
+		t.Fatalf("outbox holds %d events (%v), want 3", len(pending), err)
+	}
+	if pending[0].Attempts != 1 || !pending[0].NextAttemptAt.After(time.Now()) || pending[1].Attempts != 0 {
+		t.Fatalf("after a failed relay: first event has %d attempts, next at %v; second %d attempts",
+			pending[0].Attempts, pending[0].NextAttemptAt, pending[1].Attempts)
+	}
+
+	redisDown = false
+	publisher.RelayDue(systemCtx)
+	if len(sent) != 0 {
+		t.Fatalf("published %d events before the backoff of the first one passed", len(sent))
+	}
+
+	if err := f.client.OutboxEvent.Update().SetNextAttemptAt(time.Now().Add(-time.Second)).Exec(systemCtx); err != nil {
+		t.Fatalf("expire backoff: %v", err)
+	}
+	publisher.RelayDue(systemCtx)
+
+	wantChannels := []string{"deployer.job.started", "deployer.job.failed", "deployer.drift.detected"}
+	if len(sent) != len(wantChannels) {
+		t.Fatalf("published %v, want %v", sent, wantChannels)
+	}
+	for i, want := range wantChannels {
+		if sent[i].channel != want || sent[i].envelope.Source != event.SourceDeployer || sent[i].envelope.TenantID != tenantA {
+			t.Errorf("event %d published on %s from %s for tenant %d, want %s from %s for tenant %d",
+				i, sent[i].channel, sent[i].envelope.Source, sent[i].envelope.TenantID, want, event.SourceDeployer, tenantA)
+		}
+	}
+
+	var failed event.JobEventData
+	if err := json.Unmarshal(sent[1].envelope.Data, &failed); err != nil {
+		t.Fatalf("job.failed data: %v", err)
+	}
+	if failed.JobID != job.ID || failed.Status != string(deploymentjob.StatusJOB_STATUS_FAILED) ||
+		failed.CommonName != "www.example.com" || failed.TargetConfigurationID != configID {
+		t.Errorf("job.failed data = %+v", failed)
+	}
+
+	// Published events are kept until retention passed
+	publisher.RelayDue(systemCtx)
+	if len(sent) != len(wantChannels) {
+		t.Fatalf("published events were relayed again")
+	}
+	if n, err := f.outbox.DeletePublishedBefore(systemCtx, time.Now().Add(time.Minute)); err != nil || n != 3 {
+		t.Fatalf("pruned %d events (%v), want 3", n, err)
+	}
+}
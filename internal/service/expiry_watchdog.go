@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	// expiryLookupBatch bounds the certificate expiries looked up per refresh
+	expiryLookupBatch = 100
+	// expiryLookupTimeout bounds a single expiry lookup in LCM
+	expiryLookupTimeout = 30 * time.Second
+)
+
+// ExpiryWatchdog exports how long the certificates actually deployed to each
+// configuration remain valid, as opposed to LCM's view of issuance. A newer
+// successful deployment replaces the certificate a configuration is watched
+// for. Expiries unknown at deployment time are looked up in LCM. Every replica
+// exports the gauges.
+type ExpiryWatchdog struct {
+	log        *log.Helper
+	configRepo *data.TargetConfigurationRepo
+	jobRepo    *data.DeploymentJobRepo
+	lcmClient  *data.LcmClient
+	collector  *metrics.Collector
+	config     *conf.ExpiryConfig
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewExpiryWatchdog creates a new expiry watchdog
+func NewExpiryWatchdog(
+	ctx *bootstrap.Context,
+	configRepo *data.TargetConfigurationRepo,
+	jobRepo *data.DeploymentJobRepo,
+	lcmClient *data.LcmClient,
+	collector *metrics.Collector,
+) *ExpiryWatchdog {
+	var expiryCfg *conf.ExpiryConfig
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			expiryCfg = deployerCfg.Expiry
+		}
+	}
+
+	// Default config
+	if expiryCfg == nil {
+		expiryCfg = &conf.ExpiryConfig{
+			IntervalMinutes: 15,
+		}
+	}
+
+	return &ExpiryWatchdog{
+		log:        ctx.NewLoggerHelper("deployer/expiry-watchdog"),
+		configRepo: configRepo,
+		jobRepo:    jobRepo,
+		lcmClient:  lcmClient,
+		collector:  collector,
+		config:     expiryCfg,
+	}
+}
+
+// Start starts the refresh loop
+func (w *ExpiryWatchdog) Start() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running || w.config.IntervalMinutes <= 0 {
+		return nil
+	}
+
+	// Use system viewer context for background operations (bypasses tenant privacy checks)
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	w.ctx, w.cancel = context.WithCancel(baseCtx)
+	w.running = true
+
+	w.log.Infof("Starting expiry watchdog every %d minutes", w.config.IntervalMinutes)
+	w.wg.Add(1)
+	go w.refreshWorker()
+
+	return nil
+}
+
+// Stop stops the watchdog
+func (w *ExpiryWatchdog) Stop() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return nil
+	}
+
+	w.log.Info("Stopping expiry watchdog")
+	w.cancel()
+	w.wg.Wait()
+	w.running = false
+
+	return nil
+}
+
+// refreshWorker refreshes the gauges at start and then periodically
+func (w *ExpiryWatchdog) refreshWorker() {
+	defer w.wg.Done()
+
+	w.Refresh(w.ctx)
+
+	ticker := time.NewTicker(time.Duration(w.config.IntervalMinutes) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.Refresh(w.ctx)
+		}
+	}
+}
+
+// Refresh looks up unknown expiries and recomputes the expiry gauges
+func (w *ExpiryWatchdog) Refresh(ctx context.Context) {
+	w.lookupMissing(ctx)
+
+	configs, _, err := w.configRepo.ListExpiring(ctx, nil, nil, 0, 0)
+	if err != nil {
+		w.log.Errorf("Failed to list deployed certificate expiries: %v", err)
+		return
+	}
+	w.collector.DeployedCertificateExpiries(configs, time.Now())
+}
+
+// lookupMissing fetches from LCM the expiry of deployed certificates that was
+// not known when they were deployed
+func (w *ExpiryWatchdog) lookupMissing(ctx context.Context) {
+	if w.lcmClient == nil {
+		return
+	}
+
+	configs, err := w.configRepo.ListMissingExpiry(ctx, expiryLookupBatch)
+	if err != nil {
+		w.log.Errorf("Failed to list configurations missing a certificate expiry: %v", err)
+		return
+	}
+
+	for _, config := range configs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Configurations deployed before certificates were recorded
+		certificateID := config.DeployedCertificateID
+		if certificateID == "" {
+			job, err := w.jobRepo.LastCompleted(ctx, config.ID)
+			if err != nil || job == nil {
+				continue
+			}
+			certificateID = job.CertificateID
+		}
+
+		lookupCtx, cancel := context.WithTimeout(ctx, expiryLookupTimeout)
+		cert, err := w.lcmClient.GetCertificateByJobID(lookupCtx, certificateID, false)
+		cancel()
+		if err != nil {
+			w.log.Debugf("Failed to look up expiry of certificate %s deployed to configuration %s: %v", certificateID, config.ID, err)
+			continue
+		}
+		expiresAt := certificateExpiry(toRegistryCertificate(cert))
+		if expiresAt == nil {
+			continue
+		}
+
+		if err := w.configRepo.SetDeployedCertificateExpiry(ctx, config.ID, certificateID, *expiresAt); err != nil {
+			w.log.Warnf("Failed to record expiry of certificate deployed to configuration %s: %v", config.ID, err)
+		}
+	}
+}
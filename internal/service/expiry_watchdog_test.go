@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/tx7do/go-crud/viewer"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+func TestExpiryWatchdog_GaugesAndListExpiring(t *testing.T) {
+	f := newIsolationFixture(t)
+	configA, _ := f.seedTenant(t, tenantA)
+	configB, _ := f.seedTenant(t, tenantB)
+	configRepo := f.configs.configRepo
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+
+	soon := time.Now().Add(10 * 24 * time.Hour)
+	later := time.Now().Add(100 * 24 * time.Hour)
+	if err := configRepo.UpdateLastDeployment(systemCtx, configA, "cert-1", &soon); err != nil {
+		t.Fatalf("UpdateLastDeployment: %v", err)
+	}
+	if err := configRepo.UpdateLastDeployment(systemCtx, configB, "cert-1", &later); err != nil {
+		t.Fatalf("UpdateLastDeployment: %v", err)
+	}
+
+	collector := testCollector()
+	watchdog := NewExpiryWatchdog(testBootstrap, configRepo, f.jobs.jobRepo, nil, collector)
+	watchdog.Refresh(systemCtx)
+
+	days := testutil.ToFloat64(collector.DeployedCertificateExpiryDays.WithLabelValues(
+		fmt.Sprint(tenantA), fmt.Sprintf("target-%d", tenantA), fmt.Sprintf("config-%d", tenantA), "dummy"))
+	if math.Abs(days-10) > 0.01 {
+		t.Fatalf("expiry gauge of tenant %d: %.2f days, want 10", tenantA, days)
+	}
+	if n := testutil.CollectAndCount(collector.DeployedCertificateExpiryDays); n != 2 {
+		t.Fatalf("expiry gauge has %d series, want 2", n)
+	}
+
+	resp, err := f.configs.ListExpiringConfigurations(tenantCtx(tenantA), &deployerV1.ListExpiringConfigurationsRequest{})
+	if err != nil {
+		t.Fatalf("ListExpiringConfigurations: %v", err)
+	}
+	if resp.GetTotal() != 1 || resp.GetItems()[0].GetConfiguration().GetId() != configA || len(resp.GetItems()[0].GetDeploymentTargetIds()) != 1 {
+		t.Fatalf("tenant %d expiring within 30 days: %v; want its configuration with its target", tenantA, resp.GetItems())
+	}
+
+	// Another tenant's certificate does not show up, even in a wider window
+	within := uint32(365)
+	resp, err = f.configs.ListExpiringConfigurations(tenantCtx(tenantA), &deployerV1.ListExpiringConfigurationsRequest{WithinDays: &within})
+	if err != nil || resp.GetTotal() != 1 {
+		t.Fatalf("tenant %d expiring within a year: %v, %v; want only its own", tenantA, resp.GetItems(), err)
+	}
+
+	// A newer deployment replaces the watched certificate; its unknown
+	// expiry drops the series until it is looked up
+	if err := configRepo.UpdateLastDeployment(systemCtx, configA, "cert-2", nil); err != nil {
+		t.Fatalf("UpdateLastDeployment: %v", err)
+	}
+	watchdog.Refresh(systemCtx)
+	if n := testutil.CollectAndCount(collector.DeployedCertificateExpiryDays); n != 1 {
+		t.Fatalf("expiry gauge has %d series after a redeployment, want 1", n)
+	}
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// InventoryService implements the InventoryService gRPC service
+type InventoryService struct {
+	deployerV1.UnimplementedInventoryServiceServer
+
+	log           *log.Helper
+	snapshotRepo  *data.InventorySnapshotRepo
+	jobRepo       *data.DeploymentJobRepo
+	targetRepo    *data.DeploymentTargetRepo
+	configRepo    *data.TargetConfigurationRepo
+	configService *TargetConfigurationService
+}
+
+// NewInventoryService creates a new InventoryService
+func NewInventoryService(
+	ctx *bootstrap.Context,
+	snapshotRepo *data.InventorySnapshotRepo,
+	jobRepo *data.DeploymentJobRepo,
+	targetRepo *data.DeploymentTargetRepo,
+	configRepo *data.TargetConfigurationRepo,
+	configService *TargetConfigurationService,
+) *InventoryService {
+	return &InventoryService{
+		log:           ctx.NewLoggerHelper("deployer/service/inventory"),
+		snapshotRepo:  snapshotRepo,
+		jobRepo:       jobRepo,
+		targetRepo:    targetRepo,
+		configRepo:    configRepo,
+		configService: configService,
+	}
+}
+
+// DiscoverInventory lists the certificates on the devices of a configuration
+// or of every configuration of a target group, stores them as snapshots and
+// suggests filters covering the certificates the deployer does not manage.
+// Devices are inspected but left unchanged.
+func (s *InventoryService) DiscoverInventory(ctx context.Context, req *deployerV1.DiscoverInventoryRequest) (*deployerV1.DiscoverInventoryResponse, error) {
+	s.log.Infof("DiscoverInventory: config_id=%s, target_id=%s",
+		req.GetTargetConfigurationId(), req.GetDeploymentTargetId())
+
+	configs, err := resolveConfigurations(ctx, s.configRepo, s.targetRepo, req.GetTargetConfigurationId(), req.GetDeploymentTargetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &deployerV1.DiscoverInventoryResponse{}
+	unmanaged := make(map[string][]schema.InventoryCertificate)
+	for _, config := range configs {
+		provider, err := registry.Get(config.ProviderType)
+		if err != nil {
+			resp.UnsupportedConfigurationIds = append(resp.UnsupportedConfigurationIds, config.ID)
+			continue
+		}
+		lister, ok := provider.(registry.InventoryLister)
+		if !ok {
+			resp.UnsupportedConfigurationIds = append(resp.UnsupportedConfigurationIds, config.ID)
+			continue
+		}
+
+		input := &data.InventorySnapshotInput{
+			TargetConfigurationID: config.ID,
+			ProviderType:          config.ProviderType,
+		}
+		if config.TenantID != nil {
+			input.TenantID = *config.TenantID
+		}
+		if err := s.inventory(ctx, config, lister, input); err != nil {
+			s.log.Warnf("Inventory of configuration %s failed: %v", config.ID, err)
+			input.Error = err.Error()
+		}
+
+		snapshot, err := s.snapshotRepo.Create(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		resp.Snapshots = append(resp.Snapshots, s.snapshotRepo.ToProto(snapshot))
+
+		for _, cert := range input.Certificates {
+			if !cert.Managed {
+				unmanaged[config.ID] = append(unmanaged[config.ID], cert)
+			}
+		}
+	}
+
+	resp.Suggestions = suggestFilters(unmanaged)
+	return resp, nil
+}
+
+// inventory lists the certificates on the device of config into input and
+// flags the ones the deployer deployed there
+func (s *InventoryService) inventory(ctx context.Context, config *data.TargetConfiguration, lister registry.InventoryLister, input *data.InventorySnapshotInput) error {
+	credentials, err := s.configService.GetDecryptedCredentials(ctx, config.ID)
+	if err != nil {
+		return err
+	}
+	found, err := lister.Inventory(ctx, config.Config, credentials)
+	if err != nil {
+		return err
+	}
+	jobs, err := s.jobRepo.ListDeployed(ctx, config.ID)
+	if err != nil {
+		return err
+	}
+
+	input.Certificates = make([]schema.InventoryCertificate, 0, len(found))
+	for _, cert := range found {
+		item := schema.InventoryCertificate{
+			Name:         cert.Name,
+			CommonName:   cert.CommonName,
+			SANs:         cert.SANs,
+			SerialNumber: cert.SerialNumber,
+			Issuer:       cert.Issuer,
+			References:   cert.References,
+		}
+		if cert.ExpiresAt > 0 {
+			expiresAt := time.Unix(cert.ExpiresAt, 0)
+			item.ExpiresAt = &expiresAt
+		}
+		input.Certificates = append(input.Certificates, item)
+	}
+	input.UnmanagedCount = markManaged(input.Certificates, jobs)
+	return nil
+}
+
+// markManaged flags the certificates deployed by jobs and returns how many
+// are not. Certificates are matched by serial, or by name when the device
+// does not report serials.
+func markManaged(certs []schema.InventoryCertificate, jobs []*ent.DeploymentJob) int {
+	serials := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, job := range jobs {
+		if job.CertificateSerial != "" {
+			serials[registry.NormalizeSerial(job.CertificateSerial)] = true
+		}
+		if job.CertificateCommonName != "" {
+			names[strings.ToLower(job.CertificateCommonName)] = true
+		}
+	}
+
+	unmanaged := 0
+	for i := range certs {
+		cert := &certs[i]
+		if cert.SerialNumber != "" {
+			cert.Managed = serials[registry.NormalizeSerial(cert.SerialNumber)]
+		} else {
+			for _, name := range append([]string{cert.CommonName}, cert.SANs...) {
+				if name != "" && names[strings.ToLower(name)] {
+					cert.Managed = true
+					break
+				}
+			}
+		}
+		if !cert.Managed {
+			unmanaged++
+		}
+	}
+	return unmanaged
+}
+
+// suggestFilters groups unmanaged certificates, keyed by configuration, by
+// parent domain and suggests a common name filter for each group
+func suggestFilters(unmanaged map[string][]schema.InventoryCertificate) []*deployerV1.FilterSuggestion {
+	type group struct {
+		names   map[string]bool
+		configs map[string]bool
+	}
+	groups := make(map[string]*group)
+	for configID, certs := range unmanaged {
+		for _, cert := range certs {
+			if cert.CommonName == "" {
+				continue
+			}
+			domain := parentDomain(cert.CommonName)
+			g, ok := groups[domain]
+			if !ok {
+				g = &group{names: make(map[string]bool), configs: make(map[string]bool)}
+				groups[domain] = g
+			}
+			g.names[cert.CommonName] = true
+			g.configs[configID] = true
+		}
+	}
+
+	domains := make([]string, 0, len(groups))
+	for domain := range groups {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	suggestions := make([]*deployerV1.FilterSuggestion, 0, len(domains))
+	for _, domain := range domains {
+		pattern := `(^|\.)` + regexp.QuoteMeta(domain) + `$`
+		suggestions = append(suggestions, &deployerV1.FilterSuggestion{
+			Filter:           &deployerV1.CertificateFilter{CommonNamePattern: &pattern},
+			CommonNames:      sortedKeys(groups[domain].names),
+			ConfigurationIds: sortedKeys(groups[domain].configs),
+		})
+	}
+	return suggestions
+}
+
+// parentDomain returns the domain a filter for name should cover: the domain
+// of a wildcard, or name without its first label, keeping at least two labels
+func parentDomain(name string) string {
+	name = strings.ToLower(name)
+	if domain, ok := strings.CutPrefix(name, "*."); ok {
+		return domain
+	}
+	if net.ParseIP(name) != nil {
+		return name
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) >= 3 {
+		return strings.Join(labels[1:], ".")
+	}
+	return name
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ListInventorySnapshots lists inventory snapshots, newest first
+func (s *InventoryService) ListInventorySnapshots(ctx context.Context, req *deployerV1.ListInventorySnapshotsRequest) (*deployerV1.ListInventorySnapshotsResponse, error) {
+	opts := &data.InventorySnapshotListOptions{
+		TargetConfigurationID: req.TargetConfigurationId,
+		Page:                  1,
+		PageSize:              20,
+	}
+
+	// Only platform admins may look across tenants
+	opts.TenantID = caller.FromContext(ctx).TenantScope(req.TenantId)
+
+	if req.Page != nil && *req.Page > 0 {
+		opts.Page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		opts.PageSize = *req.PageSize
+	}
+
+	entities, total, err := s.snapshotRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.InventorySnapshot, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, s.snapshotRepo.ToProto(entity))
+	}
+
+	return &deployerV1.ListInventorySnapshotsResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
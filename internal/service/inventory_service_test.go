@@ -0,0 +1,78 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/schema"
+)
+
+func TestMarkManaged(t *testing.T) {
+	jobs := []*ent.DeploymentJob{
+		{CertificateSerial: "0A:1B:2C", CertificateCommonName: "www.example.com"},
+		{CertificateCommonName: "API.example.com"},
+	}
+	certs := []schema.InventoryCertificate{
+		{Name: "deployed", CommonName: "www.example.com", SerialNumber: "a1b2c"},
+		{Name: "same name, other serial", CommonName: "www.example.com", SerialNumber: "ff"},
+		{Name: "no serial, matched by SAN", CommonName: "example.net", SANs: []string{"api.example.com"}},
+		{Name: "no serial, unknown", CommonName: "shop.example.org"},
+	}
+
+	if unmanaged := markManaged(certs, jobs); unmanaged != 2 {
+		t.Fatalf("unmanaged = %d, want 2", unmanaged)
+	}
+	for i, want := range []bool{true, false, true, false} {
+		if certs[i].Managed != want {
+			t.Errorf("%s: managed = %v, want %v", certs[i].Name, certs[i].Managed, want)
+		}
+	}
+}
+
+func TestSuggestFilters(t *testing.T) {
+	unmanaged := map[string][]schema.InventoryCertificate{
+		"config-1": {
+			{CommonName: "www.example.com"},
+			{CommonName: "*.shop.example.com"},
+			{CommonName: "example.org"},
+			{Name: "no common name"},
+		},
+		"config-2": {
+			{CommonName: "api.example.com"},
+		},
+	}
+
+	suggestions := suggestFilters(unmanaged)
+	if len(suggestions) != 3 {
+		t.Fatalf("got %d suggestions, want 3", len(suggestions))
+	}
+
+	want := []struct {
+		names   string
+		configs string
+	}{
+		{"api.example.com,www.example.com", "config-1,config-2"},
+		{"example.org", "config-1"},
+		{"*.shop.example.com", "config-1"},
+	}
+	for i, suggestion := range suggestions {
+		if got := strings.Join(suggestion.GetCommonNames(), ","); got != want[i].names {
+			t.Errorf("suggestion %d covers %s, want %s", i, got, want[i].names)
+		}
+		if got := strings.Join(suggestion.GetConfigurationIds(), ","); got != want[i].configs {
+			t.Errorf("suggestion %d configurations %s, want %s", i, got, want[i].configs)
+		}
+
+		pattern := regexp.MustCompile(suggestion.GetFilter().GetCommonNamePattern())
+		for _, name := range suggestion.GetCommonNames() {
+			if !pattern.MatchString(name) {
+				t.Errorf("pattern %s does not match %s", pattern, name)
+			}
+		}
+		if pattern.MatchString("www.notexample.com") {
+			t.Errorf("pattern %s matches an unrelated domain", pattern)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// idempotentJob returns the job an earlier request with the same idempotency
+// key created, or nil when the key is new. Reusing a key for another target,
+// configuration or certificate is a conflict.
+func idempotentJob(ctx context.Context, jobRepo *data.DeploymentJobRepo, tenantID uint32, key, targetID, configID, certID string) (*data.DeploymentJob, error) {
+	if key == "" {
+		return nil, nil
+	}
+	job, err := jobRepo.GetByIdempotencyKey(ctx, tenantID, key)
+	if err != nil || job == nil {
+		return nil, err
+	}
+	if job.CertificateID != certID ||
+		(targetID != "" && (job.DeploymentTargetID == nil || *job.DeploymentTargetID != targetID)) ||
+		(configID != "" && (job.TargetConfigurationID == nil || *job.TargetConfigurationID != configID)) {
+		return nil, deployerV1.ErrorConflict("idempotency key was already used for a different deployment")
+	}
+	return job, nil
+}
+
+// configurationIdempotencyKey derives the key of one configuration of a
+// request that deploys to several
+func configurationIdempotencyKey(key, configID string) string {
+	if key == "" {
+		return ""
+	}
+	return key + ":" + configID
+}
+
+// supersedeQueuedJobs cancels the queued jobs a new child or direct job
+// replaces. Failures are logged only; the new job is valid either way.
+func supersedeQueuedJobs(ctx context.Context, jobRepo *data.DeploymentJobRepo, collector *metrics.Collector, logger *log.Helper, job *data.DeploymentJob) {
+	changes, err := jobRepo.SupersedeQueued(ctx, job, "")
+	for _, c := range changes {
+		logger.Infof("Job %s: %s -> %s (superseded by job %s)", c.JobID, c.From, c.To, job.ID)
+		collector.JobStatusChanged(jobStatusLabel(c.From), jobStatusLabel(c.To))
+	}
+	if err != nil {
+		logger.Warnf("Failed to supersede queued jobs for job %s: %v", job.ID, err)
+	}
+}
+
+// jobStatusLabel returns the metrics label of a job status
+func jobStatusLabel(status deploymentjob.Status) string {
+	return strings.ToLower(strings.TrimPrefix(string(status), "JOB_STATUS_"))
+}
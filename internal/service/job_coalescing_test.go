@@ -0,0 +1,153 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+func TestJobCoalescing_IdempotencyKey(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	ctx := tenantCtx(tenantA)
+
+	key := "renewal-42"
+	req := &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-2", IdempotencyKey: &key}
+	first, err := f.jobs.CreateJob(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	again, err := f.jobs.CreateJob(ctx, req)
+	if err != nil {
+		t.Fatalf("repeated CreateJob: %v", err)
+	}
+	if again.GetJob().GetId() != first.GetJob().GetId() {
+		t.Fatalf("repeated CreateJob created job %s, want %s", again.GetJob().GetId(), first.GetJob().GetId())
+	}
+	if again.GetJob().GetStatus() != deployerV1.JobStatus_JOB_STATUS_PENDING {
+		t.Fatalf("coalesced job must not supersede itself, status %s", again.GetJob().GetStatus())
+	}
+
+	_, err = f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-3", IdempotencyKey: &key})
+	if !deployerV1.IsConflict(err) {
+		t.Fatalf("key reused for another certificate: got %v, want Conflict", err)
+	}
+}
+
+func TestJobCoalescing_SupersedesQueuedJobs(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	ctx := tenantCtx(tenantA)
+	jobRepo := f.jobs.jobRepo
+
+	older, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-1"})
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	newer, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-1"})
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+
+	job, err := jobRepo.GetByID(ctx, older.GetJob().GetId())
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got := jobRepo.ToProto(job); got.GetStatus() != deployerV1.JobStatus_JOB_STATUS_CANCELLED ||
+		got.GetSupersededByJobId() != newer.GetJob().GetId() {
+		t.Fatalf("older job: status %s superseded by %q, want CANCELLED by %s",
+			got.GetStatus(), got.GetSupersededByJobId(), newer.GetJob().GetId())
+	}
+
+	// A renewed certificate supersedes jobs of the certificate it replaces
+	renewed, err := jobRepo.CreateDirectJob(ctx, tenantA, configID, "cert-9", "", "", deploymentjob.TriggeredByTRIGGER_TYPE_AUTO_RENEWAL, nil)
+	if err != nil {
+		t.Fatalf("CreateDirectJob: %v", err)
+	}
+	changes, err := jobRepo.SupersedeQueued(ctx, renewed, "cert-1")
+	if err != nil {
+		t.Fatalf("SupersedeQueued: %v", err)
+	}
+	if len(changes) != 1 || changes[0].JobID != newer.GetJob().GetId() {
+		t.Fatalf("renewal superseded %v, want only job %s", changes, newer.GetJob().GetId())
+	}
+	job, err = jobRepo.GetByID(ctx, renewed.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if job.CertificateLineage != "cert-1" {
+		t.Fatalf("renewed job lineage %q, want cert-1", job.CertificateLineage)
+	}
+}
+
+func TestJobCoalescing_SupersededParentIsCancelled(t *testing.T) {
+	f := newIsolationFixture(t)
+	_, targetID := f.seedTenant(t, tenantA)
+	ctx := tenantCtx(tenantA)
+
+	older, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{DeploymentTargetId: &targetID, CertificateId: "cert-2"})
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	if _, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{DeploymentTargetId: &targetID, CertificateId: "cert-2"}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+
+	parent, err := f.jobs.jobRepo.GetByID(ctx, older.GetJob().GetId())
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got := f.jobs.jobRepo.ToProto(parent).GetStatus(); got != deployerV1.JobStatus_JOB_STATUS_CANCELLED {
+		t.Fatalf("parent without active children: status %s, want CANCELLED", got)
+	}
+}
+
+func TestJobCoalescing_OlderCertificateKeepsQueuedRenewal(t *testing.T) {
+	f := newIsolationFixture(t)
+	configID, _ := f.seedTenant(t, tenantA)
+	ctx := tenantCtx(tenantA)
+	jobRepo := f.jobs.jobRepo
+
+	if _, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-a"}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	renewed, err := jobRepo.CreateDirectJob(ctx, tenantA, configID, "cert-b", "", "", deploymentjob.TriggeredByTRIGGER_TYPE_AUTO_RENEWAL, nil)
+	if err != nil {
+		t.Fatalf("CreateDirectJob: %v", err)
+	}
+	if _, err := jobRepo.SupersedeQueued(ctx, renewed, "cert-a"); err != nil {
+		t.Fatalf("SupersedeQueued: %v", err)
+	}
+
+	// A manual deployment of the older certificate A leaves the queued B alone
+	manual, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-a"})
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	job, err := jobRepo.GetByID(ctx, renewed.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if job.Status != deploymentjob.StatusJOB_STATUS_PENDING {
+		t.Fatalf("renewal job after redeploying the older certificate: status %s, want PENDING", job.Status)
+	}
+
+	// Deploying B again supersedes both
+	latest, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configID, CertificateId: "cert-b"})
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	for _, id := range []string{renewed.ID, manual.GetJob().GetId()} {
+		job, err := jobRepo.GetByID(ctx, id)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got := jobRepo.ToProto(job); got.GetStatus() != deployerV1.JobStatus_JOB_STATUS_CANCELLED ||
+			got.GetSupersededByJobId() != latest.GetJob().GetId() {
+			t.Fatalf("job %s of certificate %s: status %s superseded by %q, want CANCELLED by %s",
+				id, job.CertificateID, got.GetStatus(), got.GetSupersededByJobId(), latest.GetJob().GetId())
+		}
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymenthistory"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
 	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
 	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 
 	appViewer "github.com/go-tangra/go-tangra-common/viewer"
@@ -29,8 +31,14 @@ type JobExecutor struct {
 	historyRepo   *data.DeploymentHistoryRepo
 	configService *TargetConfigurationService
 	lcmClient     *data.LcmClient
+	certRepo      *data.ExternalCertificateRepo
+	secrets       *secrets.Manager
 	config        *conf.JobConfig
+	policies      *data.RetryPolicies
+	locks         *DeploymentLocks
+	breaker       *CircuitBreaker
 	collector     *metrics.Collector
+	observers     []JobObserver
 
 	ctx     context.Context
 	cancel  context.CancelFunc
@@ -39,6 +47,24 @@ type JobExecutor struct {
 	mu      sync.Mutex
 }
 
+// JobTransition is a status change of a job made by the executor or reported
+// to it
+type JobTransition struct {
+	// Job as loaded before the change
+	Job      *ent.DeploymentJob
+	From, To deploymentjob.Status
+	Message  string
+	Category registry.ErrorCategory
+	// Certificate being deployed, if fetched yet
+	Certificate *registry.CertificateData
+}
+
+// JobObserver is told about the status changes the executor makes. Observers
+// are called synchronously from the workers and must not block.
+type JobObserver interface {
+	JobTransitioned(ctx context.Context, t *JobTransition)
+}
+
 // NewJobExecutor creates a new job executor
 func NewJobExecutor(
 	ctx *bootstrap.Context,
@@ -47,6 +73,11 @@ func NewJobExecutor(
 	historyRepo *data.DeploymentHistoryRepo,
 	configService *TargetConfigurationService,
 	lcmClient *data.LcmClient,
+	certRepo *data.ExternalCertificateRepo,
+	secretManager *secrets.Manager,
+	policies *data.RetryPolicies,
+	locks *DeploymentLocks,
+	breaker *CircuitBreaker,
 	collector *metrics.Collector,
 ) *JobExecutor {
 	// Get config
@@ -60,12 +91,8 @@ func NewJobExecutor(
 	// Default config
 	if jobCfg == nil {
 		jobCfg = &conf.JobConfig{
-			WorkerCount:            5,
-			MaxRetries:             3,
-			RetryDelaySeconds:      60,
-			RetryBackoffMultiplier: 2.0,
-			JobTimeoutSeconds:      300,
-			CleanupDays:            30,
+			WorkerCount: 5,
+			CleanupDays: 30,
 		}
 	}
 
@@ -76,11 +103,37 @@ func NewJobExecutor(
 		historyRepo:   historyRepo,
 		configService: configService,
 		lcmClient:     lcmClient,
+		certRepo:      certRepo,
+		secrets:       secretManager,
 		config:        jobCfg,
+		policies:      policies,
+		locks:         locks,
+		breaker:       breaker,
 		collector:     collector,
 	}
 }
 
+// Subscribe registers an observer of job status changes; call it before Start
+func (e *JobExecutor) Subscribe(o JobObserver) {
+	e.observers = append(e.observers, o)
+}
+
+// emit tells the observers about a status change
+func (e *JobExecutor) emit(t *JobTransition) {
+	for _, o := range e.observers {
+		o.JobTransitioned(e.ctx, t)
+	}
+}
+
+// Report tells the observers about a status change made outside the
+// executor, such as by a deployment run synchronously for a request
+func (e *JobExecutor) Report(t *JobTransition) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	for _, o := range e.observers {
+		o.JobTransitioned(ctx, t)
+	}
+}
+
 // Start starts the job executor
 func (e *JobExecutor) Start() error {
 	e.mu.Lock()
@@ -112,6 +165,10 @@ func (e *JobExecutor) Start() error {
 	e.wg.Add(1)
 	go e.cleanupWorker()
 
+	// Start the probes of configurations whose circuit is open
+	e.wg.Add(1)
+	go e.probeWorker()
+
 	return nil
 }
 
@@ -154,55 +211,106 @@ func (e *JobExecutor) worker(id int32) {
 
 // processJobs processes pending and retryable jobs
 func (e *JobExecutor) processJobs() {
+	// Jobs of configurations with a deployment in progress wait for it
+	busy, err := e.locks.BusyConfigurations(e.ctx)
+	if err != nil {
+		e.log.Errorf("Failed to list busy configurations: %v", err)
+		return
+	}
+	// So do jobs of configurations whose circuit is open
+	waiting, err := e.breaker.WaitingConfigurations(e.ctx)
+	if err != nil {
+		e.log.Errorf("Failed to list configurations in error: %v", err)
+		return
+	}
+	busy = append(busy, waiting...)
+
 	// Process pending jobs
-	pendingJobs, err := e.jobRepo.ListPending(e.ctx, 10)
+	pendingJobs, err := e.jobRepo.ListPending(e.ctx, 10, busy)
 	if err != nil {
 		e.log.Errorf("Failed to list pending jobs: %v", err)
 		return
 	}
 
 	for _, job := range pendingJobs {
+		if !e.lockJob(job) {
+			continue
+		}
+
 		// Try to claim the job atomically - only one worker will succeed
 		claimed, err := e.jobRepo.ClaimJob(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_PENDING)
 		if err != nil {
 			e.log.Errorf("Failed to claim job %s: %v", job.ID, err)
+			e.locks.Release(e.ctx, job.ID)
 			continue
 		}
 		if !claimed {
 			// Job was already claimed by another worker, skip it
+			e.locks.Release(e.ctx, job.ID)
 			continue
 		}
 		e.collector.JobStatusChanged("pending", "processing")
+		e.emit(&JobTransition{Job: job, From: deploymentjob.StatusJOB_STATUS_PENDING, To: deploymentjob.StatusJOB_STATUS_PROCESSING})
 
 		if err := e.processJob(job); err != nil {
 			e.log.Errorf("Failed to process job %s: %v", job.ID, err)
 		}
+		e.locks.Release(e.ctx, job.ID)
 	}
 
 	// Process retryable jobs
-	retryJobs, err := e.jobRepo.ListRetryable(e.ctx, 10)
+	retryJobs, err := e.jobRepo.ListRetryable(e.ctx, 10, busy)
 	if err != nil {
 		e.log.Errorf("Failed to list retryable jobs: %v", err)
 		return
 	}
 
 	for _, job := range retryJobs {
+		if !e.lockJob(job) {
+			continue
+		}
+
 		// Try to claim the job atomically - only one worker will succeed
 		claimed, err := e.jobRepo.ClaimJob(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_RETRYING)
 		if err != nil {
 			e.log.Errorf("Failed to claim retry job %s: %v", job.ID, err)
+			e.locks.Release(e.ctx, job.ID)
 			continue
 		}
 		if !claimed {
 			// Job was already claimed by another worker, skip it
+			e.locks.Release(e.ctx, job.ID)
 			continue
 		}
 		e.collector.JobStatusChanged("retrying", "processing")
+		e.emit(&JobTransition{Job: job, From: deploymentjob.StatusJOB_STATUS_RETRYING, To: deploymentjob.StatusJOB_STATUS_PROCESSING})
 
 		if err := e.processJob(job); err != nil {
 			e.log.Errorf("Failed to process retry job %s: %v", job.ID, err)
 		}
+		e.locks.Release(e.ctx, job.ID)
+	}
+}
+
+// lockJob takes the deployment locks of a job. Jobs whose configuration,
+// device host or provider concurrency slots are busy stay queued.
+func (e *JobExecutor) lockJob(job *ent.DeploymentJob) bool {
+	config := job.Edges.TargetConfiguration
+	if config == nil {
+		// processJob fails jobs whose configuration is gone
+		return true
+	}
+
+	ttl := e.policies.ForJob(job, config).AttemptTimeout() + lockLeaseMargin
+	locked, err := e.locks.TryAcquire(e.ctx, job.ID, config, ttl)
+	if err != nil {
+		e.log.Errorf("Failed to lock job %s: %v", job.ID, err)
+		return false
+	}
+	if !locked {
+		e.log.Debugf("Job %s waits for a busy deployment resource of configuration %s", job.ID, config.ID)
 	}
+	return locked
 }
 
 // processJob processes a single job
@@ -220,67 +328,77 @@ func (e *JobExecutor) processJob(job *ent.DeploymentJob) error {
 	// Get target configuration
 	config, err := e.configRepo.GetByID(e.ctx, configID)
 	if err != nil {
-		return e.failJob(job, "Failed to get configuration: "+err.Error())
+		return e.failJob(job, "Failed to get configuration: "+err.Error(), registry.CategoryUnknown)
 	}
 	if config == nil {
-		return e.failJob(job, "Configuration not found")
+		return e.failJob(job, "Configuration not found", registry.CategoryNotFound)
+	}
+
+	// Keep off configurations whose circuit is open
+	if e.breaker.IsOpen(config) {
+		if e.breaker.FailFast() {
+			return e.failJobAndUpdateParent(job, "Configuration is in error state: "+config.StatusMessage, registry.CategoryUnknown, nil)
+		}
+		return e.requeueJob(job, "Waiting for the configuration to recover")
 	}
 
 	// Get provider
 	provider, err := registry.Get(config.ProviderType)
 	if err != nil {
-		return e.failJob(job, "Provider not found: "+err.Error())
+		return e.failJob(job, "Provider not found: "+err.Error(), registry.CategoryValidation)
 	}
 
-	// Get credentials
-	credentials, err := e.configService.GetDecryptedCredentials(e.ctx, config.ID)
+	// Resolve the revision this job executes with; failures here are ours, not
+	// the device's, and stay out of the circuit breaker
+	revision, credentials, err := e.configService.ResolveRevision(e.ctx, config)
 	if err != nil {
-		return e.failJob(job, "Failed to get credentials: "+err.Error())
+		return e.failJob(job, "Failed to get credentials: "+err.Error(), registry.Classify(err))
+	}
+	if err := e.jobRepo.SetConfigurationRevision(e.ctx, job.ID, revision.ID); err != nil {
+		e.log.Warnf("Failed to record configuration revision for job %s: %v", job.ID, err)
 	}
 
-	// Fetch certificate data from LCM service
+	// Bound the attempt by the job's retry policy
+	policy := e.policies.ForJob(job, config)
+
+	// Removals only need to name the certificate
+	removal := job.Action == deploymentjob.ActionJOB_ACTION_REMOVE
 	var certData *registry.CertificateData
-	if e.lcmClient != nil {
-		lcmCert, err := e.lcmClient.GetCertificateByJobID(e.ctx, job.CertificateID, true)
-		if err != nil {
-			e.log.Warnf("Failed to fetch certificate from LCM: %v, using placeholder data", err)
-			certData = &registry.CertificateData{
-				ID:           job.CertificateID,
-				SerialNumber: job.CertificateSerial,
-			}
-		} else {
-			certData = &registry.CertificateData{
-				ID:               lcmCert.JobID,
-				SerialNumber:     lcmCert.SerialNumber,
-				CommonName:       lcmCert.CommonName,
-				SANs:             lcmCert.SANs,
-				CertificatePEM:   lcmCert.CertificatePEM,
-				CertificateChain: lcmCert.CACertificatePEM,
-				PrivateKeyPEM:    lcmCert.PrivateKeyPEM,
-				ExpiresAt:        lcmCert.ExpiresAt,
-			}
-			e.log.Infof("Fetched certificate from LCM: serial=%s, cn=%s, sans=%v", lcmCert.SerialNumber, lcmCert.CommonName, lcmCert.SANs)
+	if removal {
+		if !provider.GetCapabilities().SupportsRemoval {
+			return e.failJobAndUpdateParent(job, "Provider "+config.ProviderType+" cannot remove certificates", registry.CategoryValidation, nil)
+		}
 
-			// Update the job with the certificate serial from LCM
-			if lcmCert.SerialNumber != "" && job.CertificateSerial == "" {
-				if _, err := e.jobRepo.UpdateCertificateSerial(e.ctx, job.ID, lcmCert.SerialNumber); err != nil {
-					e.log.Warnf("Failed to update certificate serial for job %s: %v", job.ID, err)
-				}
-			}
+		// Never remove a certificate that was replaced or removed meanwhile
+		last, err := e.jobRepo.LastCompleted(e.ctx, config.ID)
+		if err != nil {
+			return e.failJob(job, "Failed to look up the deployed certificate: "+err.Error(), registry.CategoryUnknown)
+		}
+		if last == nil || last.CertificateID != job.CertificateID || last.CertificateSerial != job.CertificateSerial {
+			return e.cancelJob(job, "Certificate is no longer deployed to the configuration")
 		}
+		certData = e.removalCertificate(job)
 	} else {
-		e.log.Warn("LCM client not available, using placeholder certificate data")
-		certData = &registry.CertificateData{
-			ID:           job.CertificateID,
-			SerialNumber: job.CertificateSerial,
+		certData, err = e.loadCertificate(job)
+		if err != nil {
+			return e.retryOrFail(job, policy, "Failed to load certificate: "+err.Error(), registry.Classify(err), registry.RetryAfter(err), nil)
+		}
+		// Record the serial and name; removals and versioned objects rely on them
+		if certData.SerialNumber != "" && job.CertificateSerial == "" {
+			if _, err := e.jobRepo.UpdateCertificateSerial(e.ctx, job.ID, certData.SerialNumber); err != nil {
+				e.log.Warnf("Failed to update certificate serial for job %s: %v", job.ID, err)
+			}
+		}
+		if certData.CommonName != "" && certData.CommonName != job.CertificateCommonName {
+			if err := e.jobRepo.SetCertificateCommonName(e.ctx, job.ID, certData.CommonName); err != nil {
+				e.log.Warnf("Failed to record certificate common name for job %s: %v", job.ID, err)
+			}
 		}
 	}
 
 	// Job is already claimed with PROCESSING status by ClaimJob()
 
-	// Create context with timeout
-	timeout := time.Duration(e.config.JobTimeoutSeconds) * time.Second
-	ctx, cancel := context.WithTimeout(e.ctx, timeout)
+	ctx, cancel := context.WithTimeout(e.ctx, policy.AttemptTimeout())
 	defer cancel()
 
 	// Progress callback
@@ -292,49 +410,70 @@ func (e *JobExecutor) processJob(job *ent.DeploymentJob) error {
 
 	// Execute deployment
 	startTime := time.Now()
-	result, err := provider.Deploy(ctx, certData, config.Config, credentials, progressCb)
+	historyAction := deploymenthistory.ActionACTION_DEPLOY
+	successMessage := "Deployment successful"
+	var result *registry.DeploymentResult
+	if removal {
+		historyAction = deploymenthistory.ActionACTION_REMOVE
+		successMessage = "Certificate removed"
+		result, err = provider.Remove(ctx, certData, revision.Config, credentials)
+	} else {
+		result, err = provider.Deploy(ctx, certData, revision.Config, credentials, progressCb)
+	}
 
 	// Record history
 	historyResult := deploymenthistory.ResultRESULT_SUCCESS
 	historyMessage := ""
+	var historyDetails map[string]any
+	var category registry.ErrorCategory
 	if err != nil {
 		historyResult = deploymenthistory.ResultRESULT_FAILURE
 		historyMessage = err.Error()
+		category = registry.Classify(err)
 	} else if !result.Success {
 		historyResult = deploymenthistory.ResultRESULT_FAILURE
 		historyMessage = result.Message
+		category = registry.CategoryUnknown
 	} else {
 		historyMessage = result.Message
 	}
+	if category != "" {
+		historyDetails = map[string]any{"error_category": string(category)}
+	}
 
-	if _, err := e.historyRepo.Create(e.ctx, job.ID, deploymenthistory.ActionACTION_DEPLOY,
-		historyResult, historyMessage, time.Since(startTime).Milliseconds(), nil); err != nil {
+	if _, err := e.historyRepo.Create(e.ctx, job.ID, historyAction,
+		historyResult, historyMessage, time.Since(startTime).Milliseconds(), historyDetails); err != nil {
 		e.log.Warnf("Failed to create deployment history for job %s: %v", job.ID, err)
 	}
 
-	// Handle result
-	if err != nil || !result.Success {
-		errMsg := historyMessage
-		if err != nil {
-			errMsg = err.Error()
-		}
+	e.breaker.RecordResult(e.ctx, config, category, historyMessage)
 
-		// Check if we should retry
-		if job.RetryCount < job.MaxRetries {
-			return e.scheduleRetry(job, errMsg)
-		}
-		return e.failJobAndUpdateParent(job, errMsg)
+	// Handle result
+	if category != "" {
+		e.collector.DeploymentFailed(config.ProviderType, string(category), category.Retryable() && job.RetryCount < job.MaxRetries)
+		return e.retryOrFail(job, policy, historyMessage, category, registry.RetryAfter(err), certData)
 	}
 
 	// Success
-	_, err = e.jobRepo.UpdateStatus(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_COMPLETED, "Deployment successful", 100)
+	_, err = e.jobRepo.UpdateStatus(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_COMPLETED, successMessage, 100)
 	if err != nil {
 		return err
 	}
 	e.collector.JobStatusChanged("processing", "completed")
+	e.emit(&JobTransition{
+		Job:         job,
+		From:        deploymentjob.StatusJOB_STATUS_PROCESSING,
+		To:          deploymentjob.StatusJOB_STATUS_COMPLETED,
+		Message:     successMessage,
+		Certificate: certData,
+	})
 
 	// Update configuration last deployment
-	if err := e.configRepo.UpdateLastDeployment(e.ctx, config.ID); err != nil {
+	if removal {
+		if err := e.configRepo.ClearDeployedCertificate(e.ctx, config.ID); err != nil {
+			e.log.Warnf("Failed to clear deployed certificate of config %s: %v", config.ID, err)
+		}
+	} else if err := e.configRepo.UpdateLastDeployment(e.ctx, config.ID, job.CertificateID, certificateExpiry(certData)); err != nil {
 		e.log.Warnf("Failed to update last deployment for config %s: %v", config.ID, err)
 	}
 
@@ -347,26 +486,67 @@ func (e *JobExecutor) processJob(job *ent.DeploymentJob) error {
 	return nil
 }
 
+// retryOrFail schedules a retry of a failed attempt while retries remain.
+// Only failures that may go away are retried; auth, permission, not-found and
+// validation errors fail immediately.
+func (e *JobExecutor) retryOrFail(job *ent.DeploymentJob, policy *registry.RetryPolicy, message string, category registry.ErrorCategory, retryAfter time.Duration, cert *registry.CertificateData) error {
+	if category.Retryable() && job.RetryCount < job.MaxRetries {
+		return e.scheduleRetry(job, policy, message, category, retryAfter, cert)
+	}
+	if !category.Retryable() {
+		e.log.Warnf("Job %s hit a non-retryable %s error, not retrying", job.ID, category)
+	}
+	return e.failJobAndUpdateParent(job, message, category, cert)
+}
+
+// requeueJob hands a claimed job back to the queue without counting an attempt
+func (e *JobExecutor) requeueJob(job *ent.DeploymentJob, message string) error {
+	e.log.Infof("Job %s requeued: %s", job.ID, message)
+	_, err := e.jobRepo.UpdateStatus(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_PENDING, message, job.Progress)
+	if err == nil {
+		e.collector.JobStatusChanged("processing", "pending")
+		e.emit(&JobTransition{Job: job, From: deploymentjob.StatusJOB_STATUS_PROCESSING, To: deploymentjob.StatusJOB_STATUS_PENDING, Message: message})
+	}
+	return err
+}
+
+// cancelJob cancels a claimed job that has nothing left to do
+func (e *JobExecutor) cancelJob(job *ent.DeploymentJob, message string) error {
+	e.log.Infof("Job %s cancelled: %s", job.ID, message)
+	_, err := e.jobRepo.UpdateStatus(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_CANCELLED, message, job.Progress)
+	if err != nil {
+		return err
+	}
+	e.collector.JobStatusChanged("processing", "cancelled")
+	e.emit(&JobTransition{Job: job, From: deploymentjob.StatusJOB_STATUS_PROCESSING, To: deploymentjob.StatusJOB_STATUS_CANCELLED, Message: message})
+	if job.ParentJobID != nil && *job.ParentJobID != "" {
+		e.updateParentJobStatus(*job.ParentJobID)
+	}
+	return nil
+}
+
 // failJob marks a job as failed (for non-child jobs or during claim)
-func (e *JobExecutor) failJob(job *ent.DeploymentJob, message string) error {
-	e.log.Warnf("Job %s failed: %s", job.ID, message)
-	_, err := e.jobRepo.UpdateStatus(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, message, 0)
+func (e *JobExecutor) failJob(job *ent.DeploymentJob, message string, category registry.ErrorCategory) error {
+	e.log.Warnf("Job %s failed (%s): %s", job.ID, category, message)
+	_, err := e.jobRepo.MarkFailed(e.ctx, job.ID, message, category)
 	if err == nil {
 		e.collector.JobStatusChanged("processing", "failed")
+		e.emitFailed(job, message, category, nil)
 	}
 	return err
 }
 
 // failJobAndUpdateParent marks a job as failed and updates parent job status
-func (e *JobExecutor) failJobAndUpdateParent(job *ent.DeploymentJob, message string) error {
-	e.log.Warnf("Job %s failed: %s", job.ID, message)
-	_, err := e.jobRepo.UpdateStatus(e.ctx, job.ID, deploymentjob.StatusJOB_STATUS_FAILED, message, 0)
+func (e *JobExecutor) failJobAndUpdateParent(job *ent.DeploymentJob, message string, category registry.ErrorCategory, cert *registry.CertificateData) error {
+	e.log.Warnf("Job %s failed (%s): %s", job.ID, category, message)
+	_, err := e.jobRepo.MarkFailed(e.ctx, job.ID, message, category)
 	if err == nil {
 		e.collector.JobStatusChanged("processing", "failed")
 	}
 	if err != nil {
 		return err
 	}
+	e.emitFailed(job, message, category, cert)
 
 	// Update parent job status if this is a child job
 	if job.ParentJobID != nil && *job.ParentJobID != "" {
@@ -378,79 +558,59 @@ func (e *JobExecutor) failJobAndUpdateParent(job *ent.DeploymentJob, message str
 
 // updateParentJobStatus updates the parent job status based on child job results
 func (e *JobExecutor) updateParentJobStatus(parentJobID string) {
-	// Get current parent job to know old status
-	parentJob, err := e.jobRepo.GetByID(e.ctx, parentJobID)
+	change, err := e.jobRepo.RefreshParentStatus(e.ctx, parentJobID)
 	if err != nil {
-		e.log.Errorf("Failed to get parent job %s: %v", parentJobID, err)
+		e.log.Errorf("Failed to update parent job %s status: %v", parentJobID, err)
 		return
 	}
-	oldStatus := string(parentJob.Status)
-
-	// Get child job counts (returns: total, completed, failed)
-	total, completed, failed, err := e.jobRepo.GetChildJobCounts(e.ctx, parentJobID)
-	if err != nil {
-		e.log.Errorf("Failed to get child job counts for parent %s: %v", parentJobID, err)
+	if change.From == change.To {
 		return
 	}
+	e.collector.JobStatusChanged(string(change.From), string(change.To))
 
-	// Determine parent status
-	var status deploymentjob.Status
-	var message string
-	var progress int32
-
-	finishedCount := completed + failed
-
-	if finishedCount < total {
-		// Still processing
-		status = deploymentjob.StatusJOB_STATUS_PROCESSING
-		progress = int32((finishedCount * 100) / total)
-		message = "Deploying to targets"
-	} else if failed == 0 {
-		// All completed successfully
-		status = deploymentjob.StatusJOB_STATUS_COMPLETED
-		progress = 100
-		message = "All deployments completed successfully"
-	} else if completed == 0 {
-		// All failed
-		status = deploymentjob.StatusJOB_STATUS_FAILED
-		progress = 0
-		message = "All deployments failed"
-	} else {
-		// Partial success
-		status = deploymentjob.StatusJOB_STATUS_PARTIAL
-		progress = int32((completed * 100) / total)
-		message = "Some deployments failed"
+	if len(e.observers) == 0 {
+		return
 	}
-
-	_, err = e.jobRepo.UpdateStatus(e.ctx, parentJobID, status, message, progress)
-	if err != nil {
-		e.log.Errorf("Failed to update parent job %s status: %v", parentJobID, err)
-	} else if oldStatus != string(status) {
-		e.collector.JobStatusChanged(oldStatus, string(status))
+	parent, err := e.jobRepo.GetByID(e.ctx, parentJobID)
+	if err != nil || parent == nil {
+		e.log.Warnf("Failed to load parent job %s to report its status: %v", parentJobID, err)
+		return
 	}
+	e.emit(&JobTransition{Job: parent, From: change.From, To: change.To, Message: parent.StatusMessage})
 }
 
-// scheduleRetry schedules a job for retry
-func (e *JobExecutor) scheduleRetry(job *ent.DeploymentJob, message string) error {
-	// Calculate next retry time with exponential backoff
-	delay := float64(e.config.RetryDelaySeconds) * float64(time.Second)
-	multiplier := e.config.RetryBackoffMultiplier
-	if multiplier <= 0 {
-		multiplier = 2.0
-	}
-
-	for i := int32(0); i < job.RetryCount; i++ {
-		delay *= float64(multiplier)
-	}
+// emitFailed tells the observers a processing job failed
+func (e *JobExecutor) emitFailed(job *ent.DeploymentJob, message string, category registry.ErrorCategory, cert *registry.CertificateData) {
+	e.emit(&JobTransition{
+		Job:         job,
+		From:        deploymentjob.StatusJOB_STATUS_PROCESSING,
+		To:          deploymentjob.StatusJOB_STATUS_FAILED,
+		Message:     message,
+		Category:    category,
+		Certificate: cert,
+	})
+}
 
-	nextRetry := time.Now().Add(time.Duration(delay))
+// scheduleRetry schedules a job for retry using the backoff of its policy, no
+// earlier than the retry-after the target asked for
+func (e *JobExecutor) scheduleRetry(job *ent.DeploymentJob, policy *registry.RetryPolicy, message string, category registry.ErrorCategory, retryAfter time.Duration, cert *registry.CertificateData) error {
+	delay := max(policy.Delay(job.RetryCount), retryAfter)
+	nextRetry := time.Now().Add(delay)
 
-	e.log.Infof("Scheduling job %s for retry at %v (attempt %d/%d)",
-		job.ID, nextRetry, job.RetryCount+1, job.MaxRetries)
+	e.log.Infof("Scheduling job %s for retry at %v after %s error (attempt %d/%d)",
+		job.ID, nextRetry, category, job.RetryCount+1, job.MaxRetries)
 
-	_, err := e.jobRepo.MarkForRetry(e.ctx, job.ID, nextRetry)
+	_, err := e.jobRepo.MarkForRetry(e.ctx, job.ID, nextRetry, message, category)
 	if err == nil {
 		e.collector.JobStatusChanged("processing", "retrying")
+		e.emit(&JobTransition{
+			Job:         job,
+			From:        deploymentjob.StatusJOB_STATUS_PROCESSING,
+			To:          deploymentjob.StatusJOB_STATUS_RETRYING,
+			Message:     message,
+			Category:    category,
+			Certificate: cert,
+		})
 	}
 	return err
 }
@@ -476,6 +636,23 @@ func (e *JobExecutor) cleanupWorker() {
 	}
 }
 
+// probeWorker periodically probes configurations whose circuit is open
+func (e *JobExecutor) probeWorker() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(circuitProbeTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+			e.breaker.ProbeDue(e.ctx)
+		}
+	}
+}
+
 // runCleanup removes old completed/failed jobs
 func (e *JobExecutor) runCleanup() {
 	days := e.config.CleanupDays
@@ -493,3 +670,113 @@ func (e *JobExecutor) runCleanup() {
 		e.log.Infof("Cleaned up %d old jobs", deleted)
 	}
 }
+
+// loadCertificate returns the certificate of job with its private key. When
+// LCM cannot be asked the error is transient, so the job is retried rather
+// than deployed without a certificate.
+func (e *JobExecutor) loadCertificate(job *ent.DeploymentJob) (*registry.CertificateData, error) {
+	// Certificates of ingested events are kept by the deployer
+	certData, err := e.externalCertificate(job)
+	if err != nil {
+		return nil, err
+	}
+	if certData != nil {
+		e.log.Infof("Using ingested certificate: serial=%s, cn=%s", certData.SerialNumber, certData.CommonName)
+		return certData, nil
+	}
+
+	// Fetch certificate data from LCM service
+	if e.lcmClient == nil {
+		return nil, registry.Errorf(registry.CategoryTransient, "LCM client not available")
+	}
+	lcmCert, err := e.lcmClient.GetCertificateByJobID(e.ctx, job.CertificateID, true)
+	if err != nil {
+		return nil, registry.Wrap(registry.CategoryTransient, err, "fetch certificate from LCM")
+	}
+	e.log.Infof("Fetched certificate from LCM: serial=%s, cn=%s, sans=%v", lcmCert.SerialNumber, lcmCert.CommonName, lcmCert.SANs)
+	return toRegistryCertificate(lcmCert), nil
+}
+
+// removalCertificate returns the certificate a removal job removes, without
+// its private key. The recorded common name identifies it on the device even
+// when LCM no longer knows it.
+func (e *JobExecutor) removalCertificate(job *ent.DeploymentJob) *registry.CertificateData {
+	cert := &registry.CertificateData{
+		ID:           job.CertificateID,
+		SerialNumber: job.CertificateSerial,
+		CommonName:   job.CertificateCommonName,
+	}
+	if cert.CommonName == "" && e.lcmClient != nil {
+		lcmCert, err := e.lcmClient.GetCertificateByJobID(e.ctx, job.CertificateID, false)
+		if err != nil {
+			e.log.Warnf("Failed to fetch certificate %s from LCM: %v", job.CertificateID, err)
+			return cert
+		}
+		cert.CommonName = lcmCert.CommonName
+		cert.SANs = lcmCert.SANs
+	}
+	return cert
+}
+
+// externalCertificate returns the ingested certificate of job with its
+// private key, or nil when the certificate is not an ingested one
+func (e *JobExecutor) externalCertificate(job *ent.DeploymentJob) (*registry.CertificateData, error) {
+	if e.certRepo == nil || job.TenantID == nil {
+		return nil, nil
+	}
+	cert, err := e.certRepo.Get(e.ctx, *job.TenantID, job.CertificateID)
+	if err != nil || cert == nil {
+		return nil, err
+	}
+
+	certData := fromExternalCertificate(cert)
+	if scheme, ref, ok := secrets.ParseReference(cert.PrivateKeyRef); ok && e.secrets != nil {
+		key, err := e.secrets.ResolveTenant(e.ctx, *job.TenantID, scheme, ref)
+		if err != nil {
+			return nil, fmt.Errorf("resolve private key of certificate %s: %w", cert.CertificateID, err)
+		}
+		certData.PrivateKeyPEM = key
+	}
+	return certData, nil
+}
+
+// fromExternalCertificate converts an ingested certificate into what
+// providers take, without its private key
+func fromExternalCertificate(cert *ent.ExternalCertificate) *registry.CertificateData {
+	certData := &registry.CertificateData{
+		ID:               cert.CertificateID,
+		SerialNumber:     cert.SerialNumber,
+		CommonName:       cert.CommonName,
+		SANs:             cert.Sans,
+		CertificatePEM:   cert.CertificatePem,
+		CertificateChain: cert.CaCertificatePem,
+	}
+	if cert.ExpiresAt != nil {
+		certData.ExpiresAt = cert.ExpiresAt.Unix()
+	}
+	return certData
+}
+
+// toRegistryCertificate converts certificate data fetched from LCM into what
+// providers take
+func toRegistryCertificate(cert *data.CertificateData) *registry.CertificateData {
+	return &registry.CertificateData{
+		ID:               cert.JobID,
+		SerialNumber:     cert.SerialNumber,
+		CommonName:       cert.CommonName,
+		SANs:             cert.SANs,
+		CertificatePEM:   cert.CertificatePEM,
+		CertificateChain: cert.CACertificatePEM,
+		PrivateKeyPEM:    cert.PrivateKeyPEM,
+		ExpiresAt:        cert.ExpiresAt,
+	}
+}
+
+// certificateExpiry returns the expiry of cert, or nil when it is unknown
+func certificateExpiry(cert *registry.CertificateData) *time.Time {
+	if cert.ExpiresAt <= 0 {
+		return nil
+	}
+	expiresAt := time.Unix(cert.ExpiresAt, 0)
+	return &expiresAt
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+)
+
+// lockKeyLeader prefixes the lease of the replica leading a background task
+const lockKeyLeader = "leader:"
+
+// LeaderElection picks one replica per background task with expiring leases
+// in the deployment locks table. The leader keeps its lease by renewing it;
+// when it stops or dies, another replica takes over once the lease expired.
+type LeaderElection struct {
+	log       *log.Helper
+	repo      *data.DeployLockRepo
+	replicaID string
+}
+
+// NewLeaderElection creates the leader election of this replica
+func NewLeaderElection(ctx *bootstrap.Context, repo *data.DeployLockRepo) *LeaderElection {
+	hostname, _ := os.Hostname()
+	return &LeaderElection{
+		log:       ctx.NewLoggerHelper("deployer/leader-election"),
+		repo:      repo,
+		replicaID: hostname + "/" + uuid.NewString(),
+	}
+}
+
+// IsLeader takes or renews the lease of task for ttl and reports whether this
+// replica leads task
+func (l *LeaderElection) IsLeader(ctx context.Context, task string, ttl time.Duration) bool {
+	key := lockKeyLeader + task
+	holder := l.holder(task)
+	expiresAt := time.Now().Add(ttl)
+
+	renewed, err := l.repo.Renew(ctx, holder, key, expiresAt)
+	if err != nil {
+		return false
+	}
+	if renewed {
+		return true
+	}
+
+	acquired, err := l.repo.TryAcquire(ctx, holder, expiresAt, key)
+	if err != nil {
+		return false
+	}
+	if acquired {
+		l.log.Infof("Replica %s now leads %s", l.replicaID, task)
+	}
+	return acquired
+}
+
+// Resign drops the lease of task so another replica can take over at once
+func (l *LeaderElection) Resign(ctx context.Context, task string) {
+	if err := l.repo.Release(context.WithoutCancel(ctx), l.holder(task)); err != nil {
+		l.log.Warnf("Failed to resign leadership of %s: %v", task, err)
+	}
+}
+
+func (l *LeaderElection) holder(task string) string {
+	return l.replicaID + "/" + task
+}
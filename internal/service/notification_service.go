@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// NotificationService implements the NotificationService gRPC service
+type NotificationService struct {
+	deployerV1.UnimplementedNotificationServiceServer
+
+	ruleRepo   *data.NotificationRuleRepo
+	targetRepo *data.DeploymentTargetRepo
+	notifier   *Notifier
+	log        *log.Helper
+}
+
+// NewNotificationService creates a new NotificationService
+func NewNotificationService(
+	ctx *bootstrap.Context,
+	ruleRepo *data.NotificationRuleRepo,
+	targetRepo *data.DeploymentTargetRepo,
+	notifier *Notifier,
+) *NotificationService {
+	return &NotificationService{
+		ruleRepo:   ruleRepo,
+		targetRepo: targetRepo,
+		notifier:   notifier,
+		log:        ctx.NewLoggerHelper("deployer/service/notification"),
+	}
+}
+
+// ListNotificationRules lists notification rules
+func (s *NotificationService) ListNotificationRules(ctx context.Context, req *deployerV1.ListNotificationRulesRequest) (*deployerV1.ListNotificationRulesResponse, error) {
+	opts := &data.NotificationRuleListOptions{
+		DeploymentTargetID: req.DeploymentTargetId,
+		Page:               1,
+		PageSize:           20,
+	}
+
+	// Only platform admins may look across tenants
+	opts.TenantID = caller.FromContext(ctx).TenantScope(req.TenantId)
+
+	if req.Page != nil && *req.Page > 0 {
+		opts.Page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		opts.PageSize = *req.PageSize
+	}
+
+	entities, total, err := s.ruleRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.NotificationRule, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, s.ruleRepo.ToProto(entity))
+	}
+
+	return &deployerV1.ListNotificationRulesResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
+
+// CreateNotificationRule creates a notification rule, tenant-wide or for one deployment target
+func (s *NotificationService) CreateNotificationRule(ctx context.Context, req *deployerV1.CreateNotificationRuleRequest) (*deployerV1.CreateNotificationRuleResponse, error) {
+	tenantID, err := requestTenantID(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := notificationrule.Channel(req.Channel.String())
+	if err := notificationrule.ChannelValidator(channel); err != nil {
+		return nil, deployerV1.ErrorBadRequest("invalid channel")
+	}
+	if err := validateNotificationEndpoint(channel, req); err != nil {
+		return nil, err
+	}
+	if err := s.notifier.checkReferences(tenantID, req.GetUrl(), req.Headers); err != nil {
+		return nil, deployerV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	statuses := make([]string, 0, len(req.Statuses))
+	for _, status := range req.Statuses {
+		statuses = append(statuses, status.String())
+	}
+
+	targetID := req.GetDeploymentTargetId()
+	if targetID != "" {
+		target, err := s.targetRepo.GetByID(ctx, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil || target.TenantID == nil || *target.TenantID != tenantID {
+			return nil, deployerV1.ErrorTargetNotFound("deployment target not found")
+		}
+	}
+
+	entity, err := s.ruleRepo.Create(ctx, &data.NotificationRuleInput{
+		TenantID:           tenantID,
+		Name:               req.Name,
+		Channel:            channel,
+		Recipients:         req.Recipients,
+		URL:                req.GetUrl(),
+		Headers:            req.Headers,
+		Statuses:           statuses,
+		DeploymentTargetID: targetID,
+		CreateBy:           caller.FromContext(ctx).UserIDPtr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("notification rule %d (%s, %s) created in tenant %d (target: %q)", entity.ID, entity.Name, channel, tenantID, targetID)
+
+	return &deployerV1.CreateNotificationRuleResponse{
+		Rule: s.ruleRepo.ToProto(entity),
+	}, nil
+}
+
+// validateNotificationEndpoint checks that a rule has what its channel delivers to
+func validateNotificationEndpoint(channel notificationrule.Channel, req *deployerV1.CreateNotificationRuleRequest) error {
+	if channel == notificationrule.ChannelNOTIFICATION_CHANNEL_EMAIL {
+		if len(req.Recipients) == 0 {
+			return deployerV1.ErrorBadRequest("email rules need recipients")
+		}
+		for _, r := range req.Recipients {
+			if _, err := mail.ParseAddress(r); err != nil {
+				return deployerV1.ErrorBadRequest("invalid recipient %q", r)
+			}
+		}
+		return nil
+	}
+
+	raw := req.GetUrl()
+	if raw == "" {
+		return deployerV1.ErrorBadRequest("webhook and HTTP rules need a url")
+	}
+	if _, _, ok := secrets.ParseReference(raw); ok {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return deployerV1.ErrorBadRequest("url must be an http(s) URL or a secret reference")
+	}
+	return nil
+}
+
+// DeleteNotificationRule deletes a notification rule
+func (s *NotificationService) DeleteNotificationRule(ctx context.Context, req *deployerV1.DeleteNotificationRuleRequest) (*emptypb.Empty, error) {
+	entity, err := s.ruleRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, deployerV1.ErrorNotFound("notification rule not found")
+	}
+
+	if err := s.ruleRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("notification rule %d (%s) deleted", entity.ID, entity.Name)
+
+	return &emptypb.Empty{}, nil
+}
+
+// TestNotificationRule sends a sample notification over a rule right away
+func (s *NotificationService) TestNotificationRule(ctx context.Context, req *deployerV1.TestNotificationRuleRequest) (*deployerV1.TestNotificationRuleResponse, error) {
+	entity, err := s.ruleRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, deployerV1.ErrorNotFound("notification rule not found")
+	}
+
+	if err := s.notifier.Test(ctx, entity); err != nil {
+		message := err.Error()
+		return &deployerV1.TestNotificationRuleResponse{Success: false, Message: &message}, nil
+	}
+	return &deployerV1.TestNotificationRuleResponse{Success: true}, nil
+}
@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/notificationrule"
+	"github.com/go-tangra/go-tangra-deployer/internal/notify"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+// defaultNotifiedStatuses are notified about by rules without statuses
+var defaultNotifiedStatuses = []string{
+	string(deploymentjob.StatusJOB_STATUS_FAILED),
+	string(deploymentjob.StatusJOB_STATUS_PARTIAL),
+}
+
+// notifyChannels maps rule channels to notify channel types
+var notifyChannels = map[notificationrule.Channel]string{
+	notificationrule.ChannelNOTIFICATION_CHANNEL_EMAIL:   notify.ChannelEmail,
+	notificationrule.ChannelNOTIFICATION_CHANNEL_WEBHOOK: notify.ChannelWebhook,
+	notificationrule.ChannelNOTIFICATION_CHANNEL_HTTP:    notify.ChannelHTTP,
+}
+
+// Notifier observes the job executor and sends the status changes matching
+// the notification rules of their tenant and target. Changes are queued and
+// matched by a worker so that the executor never waits on the database or a
+// channel; delivery is digested and retried per rule by the dispatcher.
+type Notifier struct {
+	log        *log.Helper
+	ruleRepo   *data.NotificationRuleRepo
+	jobRepo    *data.DeploymentJobRepo
+	targetRepo *data.DeploymentTargetRepo
+	configRepo *data.TargetConfigurationRepo
+	secrets    *secrets.Manager
+	dispatcher *notify.Dispatcher
+	config     *conf.NotificationConfig
+
+	queue   chan *JobTransition
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.RWMutex
+}
+
+// NewNotifier creates a notifier and subscribes it to the job executor
+func NewNotifier(
+	ctx *bootstrap.Context,
+	ruleRepo *data.NotificationRuleRepo,
+	jobRepo *data.DeploymentJobRepo,
+	targetRepo *data.DeploymentTargetRepo,
+	configRepo *data.TargetConfigurationRepo,
+	secretManager *secrets.Manager,
+	executor *JobExecutor,
+) (*Notifier, error) {
+	var notifyCfg *conf.NotificationConfig
+	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
+		if deployerCfg, ok := cfg.(*conf.Deployer); ok {
+			notifyCfg = deployerCfg.Notifications
+		}
+	}
+
+	// Default config
+	if notifyCfg == nil {
+		notifyCfg = &conf.NotificationConfig{}
+	}
+	if notifyCfg.DigestSeconds == 0 {
+		notifyCfg.DigestSeconds = 60
+	}
+	if notifyCfg.MaxAttempts <= 0 {
+		notifyCfg.MaxAttempts = 3
+	}
+	if notifyCfg.RetryDelaySeconds <= 0 {
+		notifyCfg.RetryDelaySeconds = 10
+	}
+	if notifyCfg.QueueSize <= 0 {
+		notifyCfg.QueueSize = 1000
+	}
+
+	renderer, err := notify.NewRenderer(notifyCfg.SubjectTemplate, notifyCfg.BodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("notifications: %w", err)
+	}
+
+	l := ctx.NewLoggerHelper("deployer/notifier")
+	channels := map[string]notify.Channel{
+		notify.ChannelWebhook: notify.NewWebhookChannel(nil),
+		notify.ChannelHTTP:    notify.NewHTTPChannel(nil),
+	}
+	if smtpCfg := notifyCfg.GetSmtp(); smtpCfg.GetHost() != "" {
+		password := smtpCfg.GetPassword()
+		if scheme, ref, ok := secrets.ParseReference(password); ok {
+			resolveCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			password, err = secretManager.Resolve(resolveCtx, scheme, ref)
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("notifications: resolve SMTP password: %w", err)
+			}
+		}
+		channels[notify.ChannelEmail] = notify.NewEmailChannel(notify.SMTPConfig{
+			Host:       smtpCfg.GetHost(),
+			Port:       int(smtpCfg.GetPort()),
+			Username:   smtpCfg.GetUsername(),
+			Password:   password,
+			From:       smtpCfg.GetFrom(),
+			RequireTLS: smtpCfg.GetRequireTls(),
+		})
+	}
+
+	n := &Notifier{
+		log:        l,
+		ruleRepo:   ruleRepo,
+		jobRepo:    jobRepo,
+		targetRepo: targetRepo,
+		configRepo: configRepo,
+		secrets:    secretManager,
+		config:     notifyCfg,
+		dispatcher: notify.NewDispatcher(l, channels, renderer, notify.DispatcherOptions{
+			DigestWindow: time.Duration(max(notifyCfg.DigestSeconds, 0)) * time.Second,
+			MaxAttempts:  int(notifyCfg.MaxAttempts),
+			RetryDelay:   time.Duration(notifyCfg.RetryDelaySeconds) * time.Second,
+		}),
+		queue: make(chan *JobTransition, notifyCfg.QueueSize),
+	}
+	executor.Subscribe(n)
+	return n, nil
+}
+
+// Start starts matching job status changes against notification rules
+func (n *Notifier) Start() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.running || !n.config.Enabled {
+		return nil
+	}
+
+	// Use system viewer context for background operations (bypasses tenant privacy checks)
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	n.ctx, n.cancel = context.WithCancel(baseCtx)
+	n.running = true
+
+	n.log.Infof("Starting notifier (digest: %ds, max attempts: %d)", n.config.DigestSeconds, n.config.MaxAttempts)
+	n.wg.Add(1)
+	go n.worker()
+
+	return nil
+}
+
+// Stop matches the queued changes, then flushes pending digests
+func (n *Notifier) Stop() error {
+	n.mu.Lock()
+	if !n.running {
+		n.mu.Unlock()
+		return nil
+	}
+	n.running = false
+	n.mu.Unlock()
+
+	n.log.Info("Stopping notifier")
+	n.cancel()
+	n.wg.Wait()
+	n.dispatcher.Close()
+
+	return nil
+}
+
+// JobTransitioned queues a job status change; changes are dropped while the
+// notifier is stopped or its queue is full
+func (n *Notifier) JobTransitioned(_ context.Context, t *JobTransition) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if !n.running {
+		return
+	}
+
+	select {
+	case n.queue <- t:
+	default:
+		n.log.Warnf("Notification queue full, dropping status change of job %s to %s", t.Job.ID, t.To)
+	}
+}
+
+// worker matches queued changes until stopped, then drains the queue
+func (n *Notifier) worker() {
+	defer n.wg.Done()
+
+	// Queued changes are still matched after cancellation
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	for {
+		select {
+		case t := <-n.queue:
+			n.Handle(ctx, t)
+		case <-n.ctx.Done():
+			for {
+				select {
+				case t := <-n.queue:
+					n.Handle(ctx, t)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Handle enqueues a notification of t for every matching enabled rule
+func (n *Notifier) Handle(ctx context.Context, t *JobTransition) {
+	job := t.Job
+	if job.TenantID == nil {
+		return
+	}
+	targetID := n.jobTargetID(ctx, job)
+
+	rules, err := n.ruleRepo.ListEnabled(ctx, *job.TenantID, targetID)
+	if err != nil {
+		return
+	}
+	rules = slices.DeleteFunc(rules, func(r *ent.NotificationRule) bool {
+		statuses := r.Statuses
+		if len(statuses) == 0 {
+			statuses = defaultNotifiedStatuses
+		}
+		return !slices.Contains(statuses, string(t.To))
+	})
+	if len(rules) == 0 {
+		return
+	}
+
+	event := n.buildEvent(ctx, t, targetID)
+	for _, rule := range rules {
+		dest, err := n.destination(ctx, rule)
+		if err != nil {
+			n.log.Errorf("Notification rule %d (%s): %v", rule.ID, rule.Name, err)
+			continue
+		}
+		n.dispatcher.Enqueue(fmt.Sprintf("rule:%d", rule.ID), dest, event)
+	}
+}
+
+// Test sends a sample notification over rule right away
+func (n *Notifier) Test(ctx context.Context, rule *ent.NotificationRule) error {
+	dest, err := n.destination(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	var tenantID uint32
+	if rule.TenantID != nil {
+		tenantID = *rule.TenantID
+	}
+	return n.dispatcher.Send(ctx, dest, &notify.Event{
+		JobID:      "test",
+		TenantID:   tenantID,
+		Status:     string(deploymentjob.StatusJOB_STATUS_FAILED),
+		Message:    fmt.Sprintf("Test notification of rule %q", rule.Name),
+		TargetID:   rule.DeploymentTargetID,
+		OccurredAt: time.Now(),
+	})
+}
+
+// jobTargetID returns the deployment target of a job: its own for parent
+// jobs, that of its parent for child jobs, none for direct jobs
+func (n *Notifier) jobTargetID(ctx context.Context, job *ent.DeploymentJob) string {
+	if job.DeploymentTargetID != nil && *job.DeploymentTargetID != "" {
+		return *job.DeploymentTargetID
+	}
+	if job.ParentJobID == nil || *job.ParentJobID == "" {
+		return ""
+	}
+
+	parent := job.Edges.ParentJob
+	if parent == nil {
+		var err error
+		if parent, err = n.jobRepo.GetByID(ctx, *job.ParentJobID); err != nil || parent == nil {
+			return ""
+		}
+	}
+	if parent.DeploymentTargetID == nil {
+		return ""
+	}
+	return *parent.DeploymentTargetID
+}
+
+// buildEvent describes t with the names of its target and configuration
+func (n *Notifier) buildEvent(ctx context.Context, t *JobTransition, targetID string) *notify.Event {
+	job := t.Job
+	event := &notify.Event{
+		JobID:             job.ID,
+		TenantID:          *job.TenantID,
+		Status:            string(t.To),
+		PreviousStatus:    string(t.From),
+		Message:           t.Message,
+		ErrorCategory:     string(t.Category),
+		TargetID:          targetID,
+		CertificateID:     job.CertificateID,
+		CertificateSerial: job.CertificateSerial,
+		OccurredAt:        time.Now(),
+	}
+	if job.ParentJobID != nil {
+		event.ParentJobID = *job.ParentJobID
+	}
+	if t.Certificate != nil {
+		event.CommonName = t.Certificate.CommonName
+		if t.Certificate.SerialNumber != "" {
+			event.CertificateSerial = t.Certificate.SerialNumber
+		}
+	}
+
+	if targetID != "" {
+		if target, err := n.targetRepo.GetByID(ctx, targetID); err == nil && target != nil {
+			event.TargetName = target.Name
+		}
+	}
+	if job.TargetConfigurationID != nil && *job.TargetConfigurationID != "" {
+		event.ConfigurationID = *job.TargetConfigurationID
+		config := job.Edges.TargetConfiguration
+		if config == nil {
+			config, _ = n.configRepo.GetByID(ctx, event.ConfigurationID)
+		}
+		if config != nil {
+			event.ConfigurationName = config.Name
+			event.ProviderType = config.ProviderType
+		}
+	}
+	return event
+}
+
+// checkReferences refuses secret references in the URL or headers of a rule
+// of tenantID that lie outside the tenant's secret namespace
+func (n *Notifier) checkReferences(tenantID uint32, url string, headers map[string]string) error {
+	values := []string{url}
+	for _, v := range headers {
+		values = append(values, v)
+	}
+	for _, v := range values {
+		if scheme, ref, ok := secrets.ParseReference(v); ok {
+			if err := n.secrets.CheckTenantReference(tenantID, scheme, ref); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// destination resolves where rule delivers to, replacing secret references
+// in its URL and headers
+func (n *Notifier) destination(ctx context.Context, rule *ent.NotificationRule) (*notify.Destination, error) {
+	endpoint := map[string]any{"url": rule.URL}
+	headers := make(map[string]any, len(rule.Headers))
+	for k, v := range rule.Headers {
+		headers[k] = v
+	}
+	endpoint["headers"] = headers
+
+	var tenantID uint32
+	if rule.TenantID != nil {
+		tenantID = *rule.TenantID
+	}
+	resolved, err := n.secrets.ResolveTenantCredentials(ctx, tenantID, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := &notify.Destination{
+		Channel:    notifyChannels[rule.Channel],
+		Recipients: rule.Recipients,
+		URL:        resolved["url"].(string),
+		Headers:    make(map[string]string, len(rule.Headers)),
+	}
+	for k, v := range resolved["headers"].(map[string]any) {
+		dest.Headers[k] = v.(string)
+	}
+	return dest, nil
+}
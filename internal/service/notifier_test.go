@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/deploymentjob"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+func TestNotifier_MatchesRulesOfTenantAndTarget(t *testing.T) {
+	f := newIsolationFixture(t)
+	_, targetA := f.seedTenant(t, tenantA)
+	f.seedTenant(t, tenantB)
+	systemCtx := viewer.WithContext(context.Background(), &systemViewer{})
+
+	var mu sync.Mutex
+	received := map[string][]map[string]any{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		received[r.URL.Path] = append(received[r.URL.Path], body)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	// Endpoint URLs may be secret references within the tenant's namespace
+	hookVar := "DEPLOYER_SECRET_" + secrets.TenantEnvPrefix(tenantA) + "TARGET_HOOK"
+	t.Setenv(hookVar, srv.URL+"/target")
+	manager := secrets.NewManager(0)
+	manager.Register(secrets.SchemeEnv, secrets.NewEnvResolver("DEPLOYER_SECRET_"))
+
+	notifier, err := NewNotifier(testBootstrap, f.rules, f.jobs.jobRepo, f.targets.targetRepo, f.configs.configRepo, manager, &JobExecutor{})
+	if err != nil {
+		t.Fatalf("NewNotifier: %v", err)
+	}
+	svc := NewNotificationService(testBootstrap, f.rules, f.targets.targetRepo, notifier)
+
+	createRule := func(tenantID uint32, req *deployerV1.CreateNotificationRuleRequest) *deployerV1.NotificationRule {
+		t.Helper()
+		req.TenantId = tenantID
+		req.Channel = deployerV1.NotificationChannel_NOTIFICATION_CHANNEL_HTTP
+		resp, err := svc.CreateNotificationRule(tenantCtx(tenantID), req)
+		if err != nil {
+			t.Fatalf("tenant %d CreateNotificationRule %s: %v", tenantID, req.Name, err)
+		}
+		return resp.GetRule()
+	}
+	url := func(path string) *string {
+		u := srv.URL + path
+		return &u
+	}
+	ref := "env:" + hookVar
+	createRule(tenantA, &deployerV1.CreateNotificationRuleRequest{Name: "target failures", Url: &ref, DeploymentTargetId: &targetA})
+	createRule(tenantA, &deployerV1.CreateNotificationRuleRequest{Name: "completions", Url: url("/completed"),
+		Statuses: []deployerV1.JobStatus{deployerV1.JobStatus_JOB_STATUS_COMPLETED}})
+	if _, err := svc.CreateNotificationRule(tenantCtx(tenantB), &deployerV1.CreateNotificationRuleRequest{
+		TenantId: tenantB, Name: "stolen", Channel: deployerV1.NotificationChannel_NOTIFICATION_CHANNEL_HTTP, Url: &ref,
+	}); err == nil {
+		t.Fatalf("tenant %d created a rule using a secret of tenant %d", tenantB, tenantA)
+	}
+	ruleB := createRule(tenantB, &deployerV1.CreateNotificationRuleRequest{Name: "failures", Url: url("/tenant-b"),
+		Headers: map[string]string{"Authorization": "Bearer b"}})
+
+	// A child job of tenant A's target fails
+	if _, err := f.jobs.CreateJob(tenantCtx(tenantA), &deployerV1.CreateJobRequest{DeploymentTargetId: &targetA, CertificateId: "cert-2"}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	child, err := f.client.DeploymentJob.Query().Where(deploymentjob.ParentJobIDNotNil()).Only(systemCtx)
+	if err != nil {
+		t.Fatalf("child job: %v", err)
+	}
+	notifier.Handle(systemCtx, &JobTransition{
+		Job:         child,
+		From:        deploymentjob.StatusJOB_STATUS_PROCESSING,
+		To:          deploymentjob.StatusJOB_STATUS_FAILED,
+		Message:     "connection refused",
+		Category:    registry.CategoryTransient,
+		Certificate: &registry.CertificateData{ID: "cert-2", CommonName: "www.example.com"},
+	})
+	notifier.dispatcher.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 1 || len(received["/target"]) != 1 {
+		t.Fatalf("notifications sent to %v, want one to tenant %d's target rule only", received, tenantA)
+	}
+	events, _ := received["/target"][0]["events"].([]any)
+	if len(events) != 1 {
+		t.Fatalf("notification has events %v, want the failed child job", received["/target"][0]["events"])
+	}
+	event := events[0].(map[string]any)
+	for field, want := range map[string]any{
+		"job_id":             child.ID,
+		"status":             "JOB_STATUS_FAILED",
+		"target_name":        fmt.Sprintf("target-%d", tenantA),
+		"configuration_name": fmt.Sprintf("config-%d", tenantA),
+		"common_name":        "www.example.com",
+		"message":            "connection refused",
+	} {
+		if event[field] != want {
+			t.Errorf("event %s = %v, want %v", field, event[field], want)
+		}
+	}
+
+	// Tenants only see their own rules, without endpoint secrets
+	list, err := svc.ListNotificationRules(tenantCtx(tenantB), &deployerV1.ListNotificationRulesRequest{})
+	if err != nil || list.GetTotal() != 1 || list.GetItems()[0].GetId() != ruleB.GetId() {
+		t.Fatalf("tenant %d rules: %v, %v; want only its own", tenantB, list.GetItems(), err)
+	}
+	if !ruleB.GetHasUrl() || len(ruleB.GetHeaderNames()) != 1 || ruleB.GetHeaderNames()[0] != "Authorization" {
+		t.Fatalf("rule %v does not describe its endpoint", ruleB)
+	}
+	if _, err := svc.TestNotificationRule(tenantCtx(tenantA), &deployerV1.TestNotificationRuleRequest{Id: ruleB.GetId()}); err == nil {
+		t.Fatalf("tenant %d tested tenant %d's rule", tenantA, tenantB)
+	}
+}
+
+func TestNotificationService_TestRuleReportsDelivery(t *testing.T) {
+	f := newIsolationFixture(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer srv.Close()
+
+	notifier, err := NewNotifier(testBootstrap, f.rules, f.jobs.jobRepo, f.targets.targetRepo, f.configs.configRepo, secrets.NewManager(0), &JobExecutor{})
+	if err != nil {
+		t.Fatalf("NewNotifier: %v", err)
+	}
+	svc := NewNotificationService(testBootstrap, f.rules, f.targets.targetRepo, notifier)
+	ctx := tenantCtx(tenantA)
+
+	for path, wantSuccess := range map[string]bool{"/hook": true, "/gone": false} {
+		u := srv.URL + path
+		created, err := svc.CreateNotificationRule(ctx, &deployerV1.CreateNotificationRuleRequest{
+			TenantId: tenantA,
+			Name:     path,
+			Channel:  deployerV1.NotificationChannel_NOTIFICATION_CHANNEL_WEBHOOK,
+			Url:      &u,
+		})
+		if err != nil {
+			t.Fatalf("CreateNotificationRule: %v", err)
+		}
+		resp, err := svc.TestNotificationRule(ctx, &deployerV1.TestNotificationRuleRequest{Id: created.GetRule().GetId()})
+		if err != nil || resp.GetSuccess() != wantSuccess {
+			t.Fatalf("test of %s: %v, %v; want success %v", path, resp, err, wantSuccess)
+		}
+	}
+
+	// Email rules fail without an SMTP server configured
+	email, err := svc.CreateNotificationRule(ctx, &deployerV1.CreateNotificationRuleRequest{
+		TenantId:   tenantA,
+		Name:       "email",
+		Channel:    deployerV1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL,
+		Recipients: []string{"ops@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("CreateNotificationRule: %v", err)
+	}
+	resp, err := svc.TestNotificationRule(ctx, &deployerV1.TestNotificationRuleRequest{Id: email.GetRule().GetId()})
+	if err != nil || resp.GetSuccess() || !strings.Contains(resp.GetMessage(), "not available") {
+		t.Fatalf("test of an email rule without SMTP: %v, %v; want a failure", resp, err)
+	}
+}
@@ -21,10 +21,26 @@ var ProviderSet = wire.NewSet(
 	service.NewTargetConfigurationService,
 	service.NewDeploymentTargetService,
 	service.NewDeploymentJobService,
+	service.NewDeploymentLocks,
+	service.NewCircuitBreaker,
 	service.NewDeploymentService,
 	service.NewJobExecutor,
 	service.NewStatisticsService,
 	service.NewBackupService,
+	service.NewAuditLogService,
+	service.NewChangeRecordService,
+	service.NewAccessControlService,
+	service.NewAuditWorker,
+	service.NewLeaderElection,
+	service.NewCredentialHealthChecker,
+	service.NewDriftDetector,
+	service.NewDriftService,
+	service.NewInventoryService,
+	service.NewExpiryWatchdog,
+	service.NewNotifier,
+	service.NewNotificationService,
+	service.NewEventPublisher,
 	event.NewHandler,
 	event.NewSubscriber,
+	event.NewIngester,
 )
@@ -8,6 +8,7 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
 	"github.com/go-tangra/go-tangra-deployer/internal/data"
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
@@ -38,11 +39,8 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *deployerV1.G
 		recentErrorsLimit = *req.RecentErrorsLimit
 	}
 
-	// Optional tenant filter
-	var tenantID *uint32
-	if req.TenantId != nil {
-		tenantID = req.TenantId
-	}
+	// Optional tenant filter, only platform admins may look across tenants
+	tenantID := caller.FromContext(ctx).TenantScope(req.TenantId)
 
 	response := &deployerV1.GetStatisticsResponse{
 		GeneratedAt: timestamppb.Now(),
@@ -61,16 +59,17 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *deployerV1.G
 		stats7d, _ := s.statsRepo.GetJobTimeStats(ctx, tenantID, last7Days)
 
 		response.Jobs = &deployerV1.JobStatistics{
-			TotalCount:      jobStats.TotalCount,
-			PendingCount:    jobStats.PendingCount,
-			ProcessingCount: jobStats.ProcessingCount,
-			CompletedCount:  jobStats.CompletedCount,
-			FailedCount:     jobStats.FailedCount,
-			CancelledCount:  jobStats.CancelledCount,
-			RetryingCount:   jobStats.RetryingCount,
-			PartialCount:    jobStats.PartialCount,
-			ByStatus:        jobStats.ByStatus,
-			ByTriggerType:   jobStats.ByTriggerType,
+			TotalCount:         jobStats.TotalCount,
+			PendingCount:       jobStats.PendingCount,
+			ProcessingCount:    jobStats.ProcessingCount,
+			CompletedCount:     jobStats.CompletedCount,
+			FailedCount:        jobStats.FailedCount,
+			CancelledCount:     jobStats.CancelledCount,
+			RetryingCount:      jobStats.RetryingCount,
+			PartialCount:       jobStats.PartialCount,
+			ByStatus:           jobStats.ByStatus,
+			ByTriggerType:      jobStats.ByTriggerType,
+			FailuresByCategory: jobStats.FailuresByCategory,
 		}
 
 		if stats24h != nil {
@@ -143,6 +142,7 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *deployerV1.G
 				ErrorMessage:      e.ErrorMessage,
 				TenantId:          e.TenantID,
 				ProviderType:      e.ProviderType,
+				ErrorCategory:     e.ErrorCategory,
 			})
 		}
 	}
@@ -172,7 +172,12 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *deployerV1.G
 func (s *StatisticsService) GetTenantStatistics(ctx context.Context, req *deployerV1.GetTenantStatisticsRequest) (*deployerV1.TenantStatistics, error) {
 	s.log.Infof("GetTenantStatistics called for tenant %d", req.TenantId)
 
-	return s.getTenantStatistics(ctx, req.TenantId)
+	tenantID, err := requestTenantID(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getTenantStatistics(ctx, tenantID)
 }
 
 // getTenantStatistics is a helper function to get statistics for a specific tenant
@@ -186,16 +191,17 @@ func (s *StatisticsService) getTenantStatistics(ctx context.Context, tenantID ui
 	jobStats, err := s.statsRepo.GetJobStats(ctx, &tid)
 	if err == nil {
 		result.Jobs = &deployerV1.JobStatistics{
-			TotalCount:      jobStats.TotalCount,
-			PendingCount:    jobStats.PendingCount,
-			ProcessingCount: jobStats.ProcessingCount,
-			CompletedCount:  jobStats.CompletedCount,
-			FailedCount:     jobStats.FailedCount,
-			CancelledCount:  jobStats.CancelledCount,
-			RetryingCount:   jobStats.RetryingCount,
-			PartialCount:    jobStats.PartialCount,
-			ByStatus:        jobStats.ByStatus,
-			ByTriggerType:   jobStats.ByTriggerType,
+			TotalCount:         jobStats.TotalCount,
+			PendingCount:       jobStats.PendingCount,
+			ProcessingCount:    jobStats.ProcessingCount,
+			CompletedCount:     jobStats.CompletedCount,
+			FailedCount:        jobStats.FailedCount,
+			CancelledCount:     jobStats.CancelledCount,
+			RetryingCount:      jobStats.RetryingCount,
+			PartialCount:       jobStats.PartialCount,
+			ByStatus:           jobStats.ByStatus,
+			ByTriggerType:      jobStats.ByTriggerType,
+			FailuresByCategory: jobStats.FailuresByCategory,
 		}
 	}
 
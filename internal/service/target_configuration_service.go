@@ -2,21 +2,26 @@ package service
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
 	"encoding/json"
-	"io"
+	"fmt"
+	"net"
+	"reflect"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
-	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-common/grpcx"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
 	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/changerecord"
 	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
 	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
 	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
 )
@@ -25,40 +30,34 @@ import (
 type TargetConfigurationService struct {
 	deployerV1.UnimplementedTargetConfigurationServiceServer
 
-	log           *log.Helper
-	configRepo    *data.TargetConfigurationRepo
-	encryptionKey []byte
-	collector     *metrics.Collector
+	log          *log.Helper
+	configRepo   *data.TargetConfigurationRepo
+	revisionRepo *data.ConfigurationRevisionRepo
+	cipher       *data.CredentialCipher
+	secrets      *secrets.Manager
+	collector    *metrics.Collector
+	changes      *changeRecorder
 }
 
 // NewTargetConfigurationService creates a new TargetConfigurationService
 func NewTargetConfigurationService(
 	ctx *bootstrap.Context,
 	configRepo *data.TargetConfigurationRepo,
+	revisionRepo *data.ConfigurationRevisionRepo,
+	changeRecordRepo *data.ChangeRecordRepo,
+	credentialCipher *data.CredentialCipher,
+	secretManager *secrets.Manager,
 	collector *metrics.Collector,
 ) *TargetConfigurationService {
-	// Get encryption key from config
-	var encryptionKey []byte
-	if cfg, ok := ctx.GetCustomConfig("deployer"); ok && cfg != nil {
-		if deployerCfg, ok := cfg.(*conf.Deployer); ok && deployerCfg.Encryption != nil {
-			encryptionKey = []byte(deployerCfg.Encryption.Key)
-		}
-	}
-
-	// Ensure key is 32 bytes for AES-256
-	if len(encryptionKey) < 32 {
-		padded := make([]byte, 32)
-		copy(padded, encryptionKey)
-		encryptionKey = padded
-	} else if len(encryptionKey) > 32 {
-		encryptionKey = encryptionKey[:32]
-	}
-
+	l := ctx.NewLoggerHelper("deployer/service/target-configuration")
 	return &TargetConfigurationService{
-		log:           ctx.NewLoggerHelper("deployer/service/target-configuration"),
-		configRepo:    configRepo,
-		encryptionKey: encryptionKey,
-		collector:     collector,
+		log:          l,
+		configRepo:   configRepo,
+		revisionRepo: revisionRepo,
+		cipher:       credentialCipher,
+		secrets:      secretManager,
+		collector:    collector,
+		changes:      newChangeRecorder(changeRecordRepo, l),
 	}
 }
 
@@ -71,8 +70,13 @@ func (s *TargetConfigurationService) CreateConfiguration(ctx context.Context, re
 		return nil, deployerV1.ErrorProviderNotFound("provider type '%s' not found", req.GetProviderType())
 	}
 
+	tenantID, err := requestTenantID(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
 	// Check for duplicate name
-	existing, err := s.configRepo.GetByTenantAndName(ctx, req.GetTenantId(), req.GetName())
+	existing, err := s.configRepo.GetByTenantAndName(ctx, tenantID, req.GetName())
 	if err != nil {
 		return nil, err
 	}
@@ -80,18 +84,36 @@ func (s *TargetConfigurationService) CreateConfiguration(ctx context.Context, re
 		return nil, deployerV1.ErrorConfigurationNameExists("configuration with name '%s' already exists", req.GetName())
 	}
 
-	// Convert config
-	config := structToMap(req.GetConfig())
+	if err := validateTLSProbeAddress(req.GetTlsProbeAddress()); err != nil {
+		return nil, err
+	}
+
+	// Validate config against the provider schema, filling in defaults
+	configSchema, credentialSchema := providerSchemas(req.GetProviderType())
+	config, err := applySchema(configSchema, "config", structToMap(req.GetConfig()), deployerV1.ErrorInvalidConfig)
+	if err != nil {
+		return nil, err
+	}
 
-	// Validate and encrypt credentials
+	// Validate and encrypt credentials. Secret references are validated with
+	// their resolved values but stored as references.
 	credentials := structToMap(req.GetCredentials())
 	provider, err := registry.Get(req.GetProviderType())
 	if err != nil {
 		return nil, err
 	}
-	if err := provider.ValidateCredentials(ctx, credentials, config); err != nil {
+	resolved, err := s.resolveSecrets(ctx, tenantID, credentials)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err = applySchema(credentialSchema, "credentials", resolved, deployerV1.ErrorInvalidCredentials)
+	if err != nil {
+		return nil, err
+	}
+	if err := provider.ValidateCredentials(ctx, resolved, config); err != nil {
 		return nil, deployerV1.ErrorCredentialsInvalid("credentials validation failed: %v", err)
 	}
+	credentials = withDefaults(credentials, resolved)
 
 	encryptedCreds, err := s.encryptCredentials(credentials)
 	if err != nil {
@@ -104,15 +126,19 @@ func (s *TargetConfigurationService) CreateConfiguration(ctx context.Context, re
 		description = *req.Description
 	}
 
-	entity, err := s.configRepo.Create(ctx, req.GetTenantId(), req.GetName(), description,
-		req.GetProviderType(), encryptedCreds, config)
+	entity, err := s.configRepo.Create(ctx, tenantID, req.GetName(), description,
+		req.GetProviderType(), encryptedCreds, credentialRotation(nil, nil, credentials, time.Now()),
+		config, data.RetryPolicyFromProto(req.RetryPolicy), req.GetTlsProbeAddress(), revisionMeta(ctx, req.GetReason()))
 	if err != nil {
 		return nil, err
 	}
 	s.collector.ConfigCreated(string(entity.Status))
 
+	s.recordConfigurationChange(ctx, changerecord.ActionCHANGE_ACTION_CREATE,
+		nil, configurationSnapshot(entity, credentials), entity, req.GetReason())
+
 	return &deployerV1.CreateConfigurationResponse{
-		Configuration: s.configRepo.ToProto(entity),
+		Configuration: s.toProto(entity),
 	}, nil
 }
 
@@ -129,7 +155,7 @@ func (s *TargetConfigurationService) GetConfiguration(ctx context.Context, req *
 	}
 
 	return &deployerV1.GetConfigurationResponse{
-		Configuration: s.configRepo.ToProto(entity),
+		Configuration: s.toProto(entity),
 	}, nil
 }
 
@@ -161,14 +187,15 @@ func (s *TargetConfigurationService) ListConfigurations(ctx context.Context, req
 		pageSize = *req.PageSize
 	}
 
-	entities, total, err := s.configRepo.List(ctx, req.TenantId, req.ProviderType, status, page, pageSize)
+	tenantID := caller.FromContext(ctx).TenantScope(req.TenantId)
+	entities, total, err := s.configRepo.List(ctx, tenantID, req.ProviderType, status, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
 
 	items := make([]*deployerV1.TargetConfiguration, 0, len(entities))
 	for _, entity := range entities {
-		items = append(items, s.configRepo.ToProto(entity))
+		items = append(items, s.toProto(entity))
 	}
 
 	return &deployerV1.ListConfigurationsResponse{
@@ -189,30 +216,75 @@ func (s *TargetConfigurationService) UpdateConfiguration(ctx context.Context, re
 	if existing == nil {
 		return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
 	}
+	if err := validateTLSProbeAddress(req.GetTlsProbeAddress()); err != nil {
+		return nil, err
+	}
 
-	// Convert config
+	// Validate a new config against the provider schema, filling in defaults
+	configSchema, credentialSchema := providerSchemas(existing.ProviderType)
 	var config map[string]any
 	if req.Config != nil {
-		config = structToMap(req.Config)
+		config, err = applySchema(configSchema, "config", structToMap(req.Config), deployerV1.ErrorInvalidConfig)
+		if err != nil {
+			return nil, err
+		}
 	} else if existing.Config != nil {
 		config = existing.Config
 	}
 
-	// Handle credentials update
+	// Handle credentials update: either a full replacement or a patch of
+	// individual fields on top of the stored credentials
+	patching := req.CredentialPatch != nil || len(req.ClearCredentials) > 0
+	if req.Credentials != nil && patching {
+		return nil, deployerV1.ErrorBadRequest("credentials cannot be combined with credential_patch or clear_credentials")
+	}
+
 	var encryptedCreds []byte
-	if req.Credentials != nil {
-		credentials := structToMap(req.Credentials)
+	var credentials, oldCredentials map[string]any
+	var rotatedAt map[string]time.Time
+	if req.Credentials != nil || patching {
 		provider, err := registry.Get(existing.ProviderType)
 		if err != nil {
 			return nil, err
 		}
-		if err := provider.ValidateCredentials(ctx, credentials, config); err != nil {
+
+		oldCredentials, err = s.decryptCredentials(existing.CredentialsEncrypted)
+		if err != nil {
+			if patching {
+				s.log.Errorf("Failed to decrypt credentials of %s for patch: %v", existing.ID, err)
+				return nil, deployerV1.ErrorInternalServerError("failed to decrypt credentials")
+			}
+			s.log.Warnf("Failed to decrypt previous credentials of %s for change record: %v", existing.ID, err)
+			oldCredentials = map[string]any{}
+		}
+
+		if req.Credentials != nil {
+			credentials = structToMap(req.Credentials)
+		} else {
+			credentials, err = patchCredentials(provider, existing.ProviderType, oldCredentials,
+				structToMap(req.CredentialPatch), req.ClearCredentials)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resolved, err := s.resolveSecrets(ctx, configTenantID(existing), credentials)
+		if err != nil {
+			return nil, err
+		}
+		resolved, err = applySchema(credentialSchema, "credentials", resolved, deployerV1.ErrorInvalidCredentials)
+		if err != nil {
+			return nil, err
+		}
+		if err := provider.ValidateCredentials(ctx, resolved, config); err != nil {
 			return nil, deployerV1.ErrorCredentialsInvalid("credentials validation failed: %v", err)
 		}
+		credentials = withDefaults(credentials, resolved)
 		encryptedCreds, err = s.encryptCredentials(credentials)
 		if err != nil {
 			return nil, deployerV1.ErrorInternalServerError("failed to encrypt credentials")
 		}
+		rotatedAt = credentialRotation(existing.CredentialRotatedAt, oldCredentials, credentials, time.Now())
 	}
 
 	// Convert status
@@ -230,8 +302,15 @@ func (s *TargetConfigurationService) UpdateConfiguration(ctx context.Context, re
 		status = &st
 	}
 
+	// Edits of the configuration content create a new revision; status-only
+	// changes are operational and keep the current revision
+	var meta *data.RevisionMeta
+	if req.Name != nil || req.Description != nil || req.Config != nil || credentials != nil {
+		meta = revisionMeta(ctx, req.GetReason())
+	}
+
 	entity, err := s.configRepo.Update(ctx, req.GetId(), req.Name, req.Description,
-		encryptedCreds, config, status)
+		encryptedCreds, rotatedAt, config, status, data.RetryPolicyFromProto(req.RetryPolicy), req.TlsProbeAddress, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +320,12 @@ func (s *TargetConfigurationService) UpdateConfiguration(ctx context.Context, re
 		s.collector.ConfigStatusChanged(string(existing.Status), string(*status))
 	}
 
+	// Credentials are only compared when they were changed
+	s.recordConfigurationChange(ctx, changerecord.ActionCHANGE_ACTION_UPDATE,
+		configurationSnapshot(existing, oldCredentials), configurationSnapshot(entity, credentials), entity, req.GetReason())
+
 	return &deployerV1.UpdateConfigurationResponse{
-		Configuration: s.configRepo.ToProto(entity),
+		Configuration: s.toProto(entity),
 	}, nil
 }
 
@@ -265,9 +348,339 @@ func (s *TargetConfigurationService) DeleteConfiguration(ctx context.Context, re
 	}
 	s.collector.ConfigDeleted(oldStatus)
 
+	s.recordConfigurationChange(ctx, changerecord.ActionCHANGE_ACTION_DELETE,
+		configurationSnapshot(existing, nil), nil, existing, req.GetReason())
+
 	return &emptypb.Empty{}, nil
 }
 
+// ListConfigurationRevisions lists revisions of a target configuration, newest first
+func (s *TargetConfigurationService) ListConfigurationRevisions(ctx context.Context, req *deployerV1.ListConfigurationRevisionsRequest) (*deployerV1.ListConfigurationRevisionsResponse, error) {
+	s.log.Infof("ListConfigurationRevisions: id=%s", req.GetId())
+
+	config, err := s.configRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+	}
+
+	page := uint32(1)
+	pageSize := uint32(20)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.revisionRepo.List(ctx, config.ID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.ConfigurationRevision, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, s.revisionRepo.ToProto(entity, config.Revision))
+	}
+
+	return &deployerV1.ListConfigurationRevisionsResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
+
+// DiffConfigurationRevisions returns the field-level differences between two revisions
+func (s *TargetConfigurationService) DiffConfigurationRevisions(ctx context.Context, req *deployerV1.DiffConfigurationRevisionsRequest) (*deployerV1.DiffConfigurationRevisionsResponse, error) {
+	s.log.Infof("DiffConfigurationRevisions: id=%s, from=%d, to=%v", req.GetId(), req.GetFromRevision(), req.ToRevision)
+
+	config, err := s.configRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+	}
+
+	toNumber := config.Revision
+	if req.ToRevision != nil {
+		toNumber = *req.ToRevision
+	}
+
+	from, err := s.getRevision(ctx, config.ID, req.GetFromRevision())
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getRevision(ctx, config.ID, toNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	fromSnapshot, err := s.revisionSnapshot(from)
+	if err != nil {
+		return nil, err
+	}
+	toSnapshot, err := s.revisionSnapshot(to)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &deployerV1.DiffConfigurationRevisionsResponse{
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+	}
+	for _, c := range diffSnapshots(fromSnapshot, toSnapshot) {
+		resp.Changes = append(resp.Changes, &deployerV1.FieldChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+			Masked: c.Masked,
+		})
+	}
+
+	return resp, nil
+}
+
+// RevertConfiguration restores an earlier revision of a target configuration
+func (s *TargetConfigurationService) RevertConfiguration(ctx context.Context, req *deployerV1.RevertConfigurationRequest) (*deployerV1.RevertConfigurationResponse, error) {
+	s.log.Infof("RevertConfiguration: id=%s, revision=%d", req.GetId(), req.GetRevision())
+
+	existing, err := s.configRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, deployerV1.ErrorConfigurationNotFound("target configuration not found")
+	}
+	if req.GetRevision() == existing.Revision {
+		return nil, deployerV1.ErrorBadRequest("configuration is already at revision %d", existing.Revision)
+	}
+
+	revision, err := s.getRevision(ctx, existing.ID, req.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	// The restored name may have been taken by another configuration since
+	if revision.Name != existing.Name {
+		var tenantID uint32
+		if existing.TenantID != nil {
+			tenantID = *existing.TenantID
+		}
+		other, err := s.configRepo.GetByTenantAndName(ctx, tenantID, revision.Name)
+		if err != nil {
+			return nil, err
+		}
+		if other != nil {
+			return nil, deployerV1.ErrorConfigurationNameExists("configuration with name '%s' already exists", revision.Name)
+		}
+	}
+
+	oldCredentials, err := s.decryptCredentials(existing.CredentialsEncrypted)
+	if err != nil {
+		s.log.Warnf("Failed to decrypt current credentials of %s for change record: %v", existing.ID, err)
+		oldCredentials = map[string]any{}
+	}
+	// Rotation times stay unknown (nil) when the restored credentials cannot be read
+	var rotatedAt map[string]time.Time
+	newCredentials, err := s.decryptCredentials(revision.CredentialsEncrypted)
+	if err != nil {
+		s.log.Warnf("Failed to decrypt credentials of %s revision %d for change record: %v", existing.ID, revision.Revision, err)
+		newCredentials = map[string]any{}
+	} else {
+		rotatedAt = credentialRotation(existing.CredentialRotatedAt, oldCredentials, newCredentials, time.Now())
+	}
+
+	reason := req.GetReason()
+	if reason == "" {
+		reason = fmt.Sprintf("Reverted to revision %d", revision.Revision)
+	}
+
+	entity, err := s.configRepo.Revert(ctx, existing.ID, revision, rotatedAt, revisionMeta(ctx, reason))
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordConfigurationChange(ctx, changerecord.ActionCHANGE_ACTION_UPDATE,
+		configurationSnapshot(existing, oldCredentials), configurationSnapshot(entity, newCredentials), entity, reason)
+
+	return &deployerV1.RevertConfigurationResponse{
+		Configuration: s.toProto(entity),
+	}, nil
+}
+
+// ResolveRevision returns the current revision of a configuration together with
+// its decrypted credentials, secret references resolved (internal use). Jobs
+// execute against the revision rather than the live row so config and
+// credentials always belong together.
+func (s *TargetConfigurationService) ResolveRevision(ctx context.Context, config *data.TargetConfiguration) (*data.ConfigurationRevision, map[string]any, error) {
+	revision, err := s.configRepo.EnsureRevision(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	credentials, err := s.decryptCredentials(revision.CredentialsEncrypted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	credentials, err = s.resolveSecrets(ctx, configTenantID(config), credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return revision, credentials, nil
+}
+
+// CheckCredentials validates the credentials of the current revision of a
+// configuration against its device (internal use)
+func (s *TargetConfigurationService) CheckCredentials(ctx context.Context, config *data.TargetConfiguration) error {
+	provider, err := registry.Get(config.ProviderType)
+	if err != nil {
+		return err
+	}
+	revision, credentials, err := s.ResolveRevision(ctx, config)
+	if err != nil {
+		return err
+	}
+	return provider.ValidateCredentials(ctx, credentials, revision.Config)
+}
+
+// getRevision loads a revision of a configuration by number
+func (s *TargetConfigurationService) getRevision(ctx context.Context, configID string, number uint32) (*data.ConfigurationRevision, error) {
+	revision, err := s.revisionRepo.GetByNumber(ctx, configID, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return nil, deployerV1.ErrorNotFound("revision %d of configuration not found", number)
+	}
+	return revision, nil
+}
+
+// revisionSnapshot flattens a revision for diffing, including its decrypted credentials
+func (s *TargetConfigurationService) revisionSnapshot(revision *data.ConfigurationRevision) (map[string]any, error) {
+	credentials, err := s.decryptCredentials(revision.CredentialsEncrypted)
+	if err != nil {
+		s.log.Errorf("Failed to decrypt credentials of revision %d: %v", revision.ID, err)
+		return nil, deployerV1.ErrorInternalServerError("failed to decrypt revision credentials")
+	}
+
+	return map[string]any{
+		"name":          revision.Name,
+		"description":   revision.Description,
+		"provider_type": revision.ProviderType,
+		"config":        revision.Config,
+		"credentials":   credentials,
+	}, nil
+}
+
+// RotateEncryptionKey re-wraps the credentials of all configurations and
+// revisions with the active key-encryption key
+func (s *TargetConfigurationService) RotateEncryptionKey(ctx context.Context, req *deployerV1.RotateEncryptionKeyRequest) (*deployerV1.RotateEncryptionKeyResponse, error) {
+	if !grpcx.IsPlatformAdmin(ctx) {
+		return nil, deployerV1.ErrorForbidden("only platform admins can rotate encryption keys")
+	}
+
+	s.log.Infof("RotateEncryptionKey: active_key=%s, dry_run=%v", s.cipher.ActiveKeyID(), req.GetDryRun())
+
+	resp := &deployerV1.RotateEncryptionKeyResponse{
+		ActiveKeyId: s.cipher.ActiveKeyID(),
+		DryRun:      req.GetDryRun(),
+	}
+
+	// Configurations
+	var lastConfigID string
+	for {
+		rows, err := s.configRepo.ListCredentialsAfter(ctx, lastConfigID, keyRotationBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			lastConfigID = row.ID
+			switch s.rewrap(row.CredentialsEncrypted, req.GetDryRun(), func(old, replacement []byte) (bool, error) {
+				return s.configRepo.ReplaceCredentialsEncrypted(ctx, row.ID, old, replacement)
+			}) {
+			case rewrapDone:
+				resp.ConfigurationsRewrapped++
+			case rewrapCurrent:
+				resp.AlreadyCurrent++
+			default:
+				s.log.Errorf("Failed to re-wrap credentials of configuration %s", row.ID)
+				resp.Failed++
+			}
+		}
+		if len(rows) < keyRotationBatchSize {
+			break
+		}
+	}
+
+	// Revisions
+	var lastRevisionID uint32
+	for {
+		rows, err := s.revisionRepo.ListCredentialsAfter(ctx, lastRevisionID, keyRotationBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			lastRevisionID = row.ID
+			switch s.rewrap(row.CredentialsEncrypted, req.GetDryRun(), func(old, replacement []byte) (bool, error) {
+				return s.revisionRepo.ReplaceCredentialsEncrypted(ctx, row.ID, old, replacement)
+			}) {
+			case rewrapDone:
+				resp.RevisionsRewrapped++
+			case rewrapCurrent:
+				resp.AlreadyCurrent++
+			default:
+				s.log.Errorf("Failed to re-wrap credentials of configuration revision %d", row.ID)
+				resp.Failed++
+			}
+		}
+		if len(rows) < keyRotationBatchSize {
+			break
+		}
+	}
+
+	s.log.Infof("RotateEncryptionKey finished: configurations=%d revisions=%d current=%d failed=%d",
+		resp.ConfigurationsRewrapped, resp.RevisionsRewrapped, resp.AlreadyCurrent, resp.Failed)
+
+	return resp, nil
+}
+
+// rewrapOutcome is the result of re-wrapping a single record
+type rewrapOutcome int
+
+const (
+	rewrapDone rewrapOutcome = iota
+	rewrapCurrent
+	rewrapFailed
+)
+
+// keyRotationBatchSize is the number of records loaded per round trip during key rotation
+const keyRotationBatchSize = 200
+
+// rewrap re-wraps one ciphertext and stores it through replace. A record that
+// was changed concurrently has been written with the active key already.
+func (s *TargetConfigurationService) rewrap(ciphertext []byte, dryRun bool, replace func(old, replacement []byte) (bool, error)) rewrapOutcome {
+	replacement, changed, err := s.cipher.Rewrap(ciphertext)
+	if err != nil {
+		s.log.Warnf("Re-wrap failed (key %q): %v", s.cipher.KeyID(ciphertext), err)
+		return rewrapFailed
+	}
+	if !changed {
+		return rewrapCurrent
+	}
+	if dryRun {
+		return rewrapDone
+	}
+
+	if _, err := replace(ciphertext, replacement); err != nil {
+		return rewrapFailed
+	}
+	return rewrapDone
+}
+
 // ValidateCredentials validates provider credentials
 func (s *TargetConfigurationService) ValidateCredentials(ctx context.Context, req *deployerV1.ValidateConfigurationCredentialsRequest) (*deployerV1.ValidateConfigurationCredentialsResponse, error) {
 	s.log.Infof("ValidateCredentials: provider=%s", req.GetProviderType())
@@ -280,8 +693,24 @@ func (s *TargetConfigurationService) ValidateCredentials(ctx context.Context, re
 		}, nil
 	}
 
-	credentials := structToMap(req.GetCredentials())
-	config := structToMap(req.GetConfig())
+	credentials, err := s.resolveSecrets(ctx, caller.FromContext(ctx).TenantID, structToMap(req.GetCredentials()))
+	if err != nil {
+		return &deployerV1.ValidateConfigurationCredentialsResponse{
+			Valid:   false,
+			Message: stringPtr(errors.FromError(err).GetMessage()),
+		}, nil
+	}
+	configSchema, credentialSchema := providerSchemas(req.GetProviderType())
+	config, err := applySchema(configSchema, "config", structToMap(req.GetConfig()), deployerV1.ErrorInvalidConfig)
+	if err == nil {
+		credentials, err = applySchema(credentialSchema, "credentials", credentials, deployerV1.ErrorInvalidCredentials)
+	}
+	if err != nil {
+		return &deployerV1.ValidateConfigurationCredentialsResponse{
+			Valid:   false,
+			Message: stringPtr(errors.FromError(err).GetMessage()),
+		}, nil
+	}
 	if err := provider.ValidateCredentials(ctx, credentials, config); err != nil {
 		return &deployerV1.ValidateConfigurationCredentialsResponse{
 			Valid:   false,
@@ -295,6 +724,53 @@ func (s *TargetConfigurationService) ValidateCredentials(ctx context.Context, re
 	}, nil
 }
 
+// defaultExpiryWindowDays is the window of ListExpiringConfigurations unless requested otherwise
+const defaultExpiryWindowDays = 30
+
+// ListExpiringConfigurations lists configurations whose deployed certificate
+// expires within the requested number of days, soonest first
+func (s *TargetConfigurationService) ListExpiringConfigurations(ctx context.Context, req *deployerV1.ListExpiringConfigurationsRequest) (*deployerV1.ListExpiringConfigurationsResponse, error) {
+	withinDays := uint32(defaultExpiryWindowDays)
+	if req.WithinDays != nil {
+		withinDays = *req.WithinDays
+	}
+
+	page := uint32(1)
+	pageSize := uint32(20)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = *req.PageSize
+	}
+
+	now := time.Now()
+	before := now.AddDate(0, 0, int(withinDays))
+	tenantID := caller.FromContext(ctx).TenantScope(req.TenantId)
+	entities, total, err := s.configRepo.ListExpiring(ctx, tenantID, &before, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*deployerV1.ExpiringConfiguration, 0, len(entities))
+	for _, entity := range entities {
+		targetIDs := make([]string, 0, len(entity.Edges.DeploymentTargets))
+		for _, target := range entity.Edges.DeploymentTargets {
+			targetIDs = append(targetIDs, target.ID)
+		}
+		items = append(items, &deployerV1.ExpiringConfiguration{
+			Configuration:       s.toProto(entity),
+			DaysUntilExpiry:     entity.DeployedCertificateExpiresAt.Sub(now).Hours() / 24,
+			DeploymentTargetIds: targetIDs,
+		})
+	}
+
+	return &deployerV1.ListExpiringConfigurationsResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
+
 // ListProviders lists available providers
 func (s *TargetConfigurationService) ListProviders(ctx context.Context, req *deployerV1.ListConfigurationProvidersRequest) (*deployerV1.ListConfigurationProvidersResponse, error) {
 	s.log.Info("ListProviders")
@@ -309,8 +785,13 @@ func (s *TargetConfigurationService) ListProviders(ctx context.Context, req *dep
 			Description:              info.Description,
 			SupportsVerification:     info.Caps.SupportsVerification,
 			SupportsRollback:         info.Caps.SupportsRollback,
+			SupportsRemoval:          info.Caps.SupportsRemoval,
+			SupportsPlan:             registry.SupportsPlan(info.Type),
+			SupportsInventory:        registry.SupportsInventory(info.Type),
 			RequiredConfigFields:     info.Caps.RequiredConfigFields,
 			RequiredCredentialFields: info.Caps.RequiredCredFields,
+			ConfigSchema:             schemaStruct(info.ConfigSchema),
+			CredentialSchema:         schemaStruct(info.CredentialSchema),
 		})
 	}
 
@@ -319,71 +800,220 @@ func (s *TargetConfigurationService) ListProviders(ctx context.Context, req *dep
 	}, nil
 }
 
-// GetDecryptedCredentials gets the decrypted credentials for a configuration (internal use)
+// GetDecryptedCredentials gets the decrypted credentials for a configuration with
+// secret references resolved (internal use)
 func (s *TargetConfigurationService) GetDecryptedCredentials(ctx context.Context, configID string) (map[string]any, error) {
-	encryptedCreds, err := s.configRepo.GetCredentialsEncrypted(ctx, configID)
+	encryptedCreds, tenantID, err := s.configRepo.GetCredentialsEncrypted(ctx, configID)
 	if err != nil {
 		return nil, err
 	}
-	return s.decryptCredentials(encryptedCreds)
+	credentials, err := s.decryptCredentials(encryptedCreds)
+	if err != nil {
+		return nil, err
+	}
+	return s.resolveSecrets(ctx, tenantID, credentials)
+}
+
+// resolveSecrets replaces env:, file: and vault: references in credentials of
+// tenantID with the secrets they point to. References must lie in the
+// tenant's secret namespace.
+func (s *TargetConfigurationService) resolveSecrets(ctx context.Context, tenantID uint32, credentials map[string]any) (map[string]any, error) {
+	if !secrets.HasReferences(credentials) {
+		return credentials, nil
+	}
+	resolved, err := s.secrets.ResolveTenantCredentials(ctx, tenantID, credentials)
+	if err != nil {
+		s.log.Warnf("Secret reference resolution failed: %v", err)
+		return nil, deployerV1.ErrorSecretUnresolved("%s", err.Error())
+	}
+	return resolved, nil
 }
 
-// encryptCredentials encrypts credentials using AES-GCM
+// encryptCredentials encrypts credentials with a fresh data key (envelope encryption)
 func (s *TargetConfigurationService) encryptCredentials(credentials map[string]any) ([]byte, error) {
 	plaintext, err := json.Marshal(credentials)
 	if err != nil {
 		return nil, err
 	}
+	return s.cipher.Encrypt(plaintext)
+}
 
-	block, err := aes.NewCipher(s.encryptionKey)
+// decryptCredentials decrypts credentials written by encryptCredentials (or legacy records)
+func (s *TargetConfigurationService) decryptCredentials(ciphertext []byte) (map[string]any, error) {
+	plaintext, err := s.cipher.Decrypt(ciphertext)
 	if err != nil {
 		return nil, err
 	}
 
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
+	var credentials map[string]any
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
 		return nil, err
 	}
 
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return nil, err
+	return credentials, nil
+}
+
+// revisionMeta describes the caller of a configuration change for its revision
+func revisionMeta(ctx context.Context, reason string) *data.RevisionMeta {
+	actor := caller.FromContext(ctx)
+	return &data.RevisionMeta{
+		ActorID:   actor.UserIDPtr(),
+		ActorName: actor.Name(),
+		Reason:    reason,
 	}
+}
 
-	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+// recordConfigurationChange records a mutation of a target configuration
+func (s *TargetConfigurationService) recordConfigurationChange(ctx context.Context, action changerecord.Action, before, after map[string]any, entity *data.TargetConfiguration, reason string) {
+	var tenantID uint32
+	if entity.TenantID != nil {
+		tenantID = *entity.TenantID
+	}
+
+	s.changes.record(ctx, &data.ChangeRecordInput{
+		TenantID:     tenantID,
+		ResourceType: changerecord.ResourceTypeCHANGE_RESOURCE_TYPE_TARGET_CONFIGURATION,
+		ResourceID:   entity.ID,
+		ResourceName: entity.Name,
+		Action:       action,
+		Changes:      diffSnapshots(before, after),
+		Reason:       reason,
+	})
 }
 
-// decryptCredentials decrypts credentials using AES-GCM
-func (s *TargetConfigurationService) decryptCredentials(ciphertext []byte) (map[string]any, error) {
-	block, err := aes.NewCipher(s.encryptionKey)
-	if err != nil {
-		return nil, err
+// toProto converts a configuration for reads. Configurations stored before
+// credential rotation tracking report their set fields without rotation times.
+func (s *TargetConfigurationService) toProto(entity *data.TargetConfiguration) *deployerV1.TargetConfiguration {
+	proto := s.configRepo.ToProto(entity)
+	if proto == nil || entity.CredentialRotatedAt != nil {
+		return proto
 	}
 
-	gcm, err := cipher.NewGCM(block)
+	credentials, err := s.decryptCredentials(entity.CredentialsEncrypted)
 	if err != nil {
-		return nil, err
+		s.log.Warnf("Failed to decrypt credentials of %s for credential status: %v", entity.ID, err)
+		return proto
 	}
+	proto.Credentials = data.CredentialStatus(entity.ProviderType, credentialRotation(nil, nil, credentials, time.Time{}))
+	return proto
+}
 
-	nonceSize := gcm.NonceSize()
-	if len(ciphertext) < nonceSize {
-		return nil, deployerV1.ErrorInternalServerError("invalid ciphertext")
+// validateTLSProbeAddress checks that a TLS probe address, when set, is host:port
+func validateTLSProbeAddress(address string) error {
+	if address == "" {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || host == "" || port == "" {
+		return deployerV1.ErrorBadRequest("tls_probe_address must be host:port")
 	}
+	return nil
+}
 
-	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+// providerSchemas returns the config and credential schemas of a provider
+func providerSchemas(providerType string) (config, credentials *registry.Schema) {
+	info, err := registry.GetInfo(providerType)
 	if err != nil {
-		return nil, err
+		return nil, nil
 	}
+	return info.ConfigSchema, info.CredentialSchema
+}
 
-	var credentials map[string]any
-	if err := json.Unmarshal(plaintext, &credentials); err != nil {
-		return nil, err
+// applySchema validates doc against schema and returns it with defaults filled
+// in. Failures are reported with newErr; the error metadata maps each failing
+// field to its message.
+func applySchema(schema *registry.Schema, root string, doc map[string]any,
+	newErr func(format string, args ...interface{}) *errors.Error) (map[string]any, error) {
+	out, err := schema.Apply(root, doc)
+	if err == nil {
+		return out, nil
+	}
+
+	verr, ok := err.(registry.ValidationError)
+	if !ok {
+		return nil, newErr("%v", err)
 	}
+	fields := make(map[string]string, len(verr))
+	for _, fe := range verr {
+		fields[fe.Field] = fe.Message
+	}
+	return nil, newErr("%s", verr.Error()).WithMetadata(fields)
+}
 
+// withDefaults returns credentials with the fields the schema defaulted in
+// resolved added. Fields already given keep their value, so secret references
+// are stored as references.
+func withDefaults(credentials, resolved map[string]any) map[string]any {
+	out := make(map[string]any, len(resolved))
+	for k, v := range resolved {
+		if _, ok := credentials[k]; !ok {
+			out[k] = v
+		}
+	}
+	for k, v := range credentials {
+		out[k] = v
+	}
+	return out
+}
+
+// patchCredentials applies a credential patch: fields in patch are set, fields
+// in clear are removed. Required fields cannot be cleared.
+func patchCredentials(provider registry.Provider, providerType string, current, patch map[string]any, clear []string) (map[string]any, error) {
+	required := make(map[string]bool)
+	for _, name := range provider.GetCapabilities().RequiredCredFields {
+		required[name] = true
+	}
+
+	credentials := make(map[string]any, len(current)+len(patch))
+	for k, v := range current {
+		credentials[k] = v
+	}
+	for _, name := range clear {
+		if _, ok := patch[name]; ok {
+			return nil, deployerV1.ErrorBadRequest("credential field '%s' is both patched and cleared", name)
+		}
+		if required[name] {
+			return nil, deployerV1.ErrorCredentialsInvalid("credential field '%s' is required by provider '%s' and cannot be cleared", name, providerType)
+		}
+		delete(credentials, name)
+	}
+	for k, v := range patch {
+		credentials[k] = v
+	}
 	return credentials, nil
 }
 
+// credentialRotation returns the rotation time of every field in credentials:
+// fields whose value did not change keep their previous time, all others are
+// rotated at now
+func credentialRotation(previous map[string]time.Time, before, after map[string]any, now time.Time) map[string]time.Time {
+	rotatedAt := make(map[string]time.Time, len(after))
+	for name, value := range after {
+		if old, ok := before[name]; ok && reflect.DeepEqual(old, value) {
+			rotatedAt[name] = previous[name]
+			continue
+		}
+		rotatedAt[name] = now
+	}
+	return rotatedAt
+}
+
+// schemaStruct converts a provider schema to a protobuf Struct
+func schemaStruct(schema *registry.Schema) *structpb.Struct {
+	if schema == nil {
+		return nil
+	}
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil
+	}
+	st := &structpb.Struct{}
+	if err := st.UnmarshalJSON(raw); err != nil {
+		return nil
+	}
+	return st
+}
+
 // structToMap converts a protobuf Struct to a Go map
 func structToMap(s *structpb.Struct) map[string]any {
 	if s == nil {
@@ -399,3 +1029,11 @@ func stringPtr(s string) *string {
 	}
 	return &s
 }
+
+// configTenantID returns the tenant owning config
+func configTenantID(config *data.TargetConfiguration) uint32 {
+	if config.TenantID == nil {
+		return 0
+	}
+	return *config.TenantID
+}
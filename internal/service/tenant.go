@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+// requestTenantID returns the tenant a request acts on. Platform admins may
+// name any tenant; everyone else acts on their own tenant and is refused when
+// the request names a different one.
+func requestTenantID(ctx context.Context, requested uint32) (uint32, error) {
+	id := caller.FromContext(ctx)
+	if id.PlatformAdmin {
+		return requested, nil
+	}
+	if requested != 0 && requested != id.TenantID {
+		return 0, deployerV1.ErrorForbidden("access to tenant %d denied", requested)
+	}
+	return id.TenantID, nil
+}
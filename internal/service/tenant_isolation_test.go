@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"testing"
+
+	entSql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-deployer/internal/caller"
+	"github.com/go-tangra/go-tangra-deployer/internal/conf"
+	"github.com/go-tangra/go-tangra-deployer/internal/data"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent"
+	"github.com/go-tangra/go-tangra-deployer/internal/data/ent/targetconfiguration"
+	"github.com/go-tangra/go-tangra-deployer/internal/metrics"
+	"github.com/go-tangra/go-tangra-deployer/internal/secrets"
+	_ "github.com/go-tangra/go-tangra-deployer/pkg/deploy/providers/dummy"
+
+	deployerV1 "github.com/go-tangra/go-tangra-deployer/gen/go/deployer/service/v1"
+)
+
+const (
+	tenantA uint32 = 1
+	tenantB uint32 = 2
+)
+
+var (
+	testBootstrap = bootstrap.NewContextWithParam(context.Background(), nil, nil, log.DefaultLogger)
+	// The collector registers global Prometheus metrics and may only be built once
+	testCollector = sync.OnceValue(func() *metrics.Collector { return metrics.NewCollector(testBootstrap) })
+)
+
+type isolationFixture struct {
+	client    *ent.Client
+	targets   *DeploymentTargetService
+	configs   *TargetConfigurationService
+	jobs      *DeploymentJobService
+	stats     *StatisticsService
+	changeLog *ChangeRecordService
+	locks     *data.DeployLockRepo
+	drift     *data.DriftEventRepo
+	rules     *data.NotificationRuleRepo
+	outbox    *data.OutboxRepo
+}
+
+func newIsolationFixture(t *testing.T) *isolationFixture {
+	t.Helper()
+
+	drv, err := entSql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	entClient := entCrud.NewEntClient(client, drv)
+
+	cipher := newTestCipher(t)
+
+	ctx := testBootstrap
+	targetRepo := data.NewDeploymentTargetRepo(ctx, entClient)
+	configRepo := data.NewTargetConfigurationRepo(ctx, entClient)
+	revisionRepo := data.NewConfigurationRevisionRepo(ctx, entClient)
+	changeRecordRepo := data.NewChangeRecordRepo(ctx, entClient)
+	jobRepo := data.NewDeploymentJobRepo(ctx, entClient)
+	historyRepo := data.NewDeploymentHistoryRepo(ctx, entClient)
+	collector := testCollector()
+
+	return &isolationFixture{
+		client:    client,
+		targets:   NewDeploymentTargetService(ctx, targetRepo, configRepo, changeRecordRepo, collector),
+		configs:   NewTargetConfigurationService(ctx, configRepo, revisionRepo, changeRecordRepo, cipher, secrets.NewManager(0), collector),
+		jobs:      NewDeploymentJobService(ctx, jobRepo, targetRepo, configRepo, historyRepo, data.NewRetryPolicies(ctx), collector),
+		stats:     NewStatisticsService(ctx, data.NewStatisticsRepo(ctx, entClient)),
+		changeLog: NewChangeRecordService(ctx, changeRecordRepo),
+		locks:     data.NewDeployLockRepo(ctx, entClient),
+		drift:     data.NewDriftEventRepo(ctx, entClient),
+		rules:     data.NewNotificationRuleRepo(ctx, entClient),
+		outbox:    data.NewOutboxRepo(ctx, entClient),
+	}
+}
+
+// newTestCipher returns a credential cipher with a random key
+func newTestCipher(t *testing.T) *data.CredentialCipher {
+	t.Helper()
+
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	cipher, err := data.NewCredentialCipherFromConfig(&conf.EncryptionConfig{
+		Keys:        []*conf.EncryptionKey{{Id: "test", Key: base64.StdEncoding.EncodeToString(key)}},
+		ActiveKeyId: "test",
+	})
+	if err != nil {
+		t.Fatalf("credential cipher: %v", err)
+	}
+	return cipher
+}
+
+func tenantCtx(tenantID uint32) context.Context {
+	return caller.NewContext(context.Background(), caller.Identity{TenantID: tenantID, UserID: tenantID * 100})
+}
+
+func adminCtx() context.Context {
+	return caller.NewContext(context.Background(), caller.Identity{PlatformAdmin: true, UserID: 1})
+}
+
+// seedTenant creates a configuration and a target grouping it as the given tenant
+func (f *isolationFixture) seedTenant(t *testing.T, tenantID uint32) (configID, targetID string) {
+	t.Helper()
+	ctx := tenantCtx(tenantID)
+
+	creds, _ := structpb.NewStruct(map[string]any{"token": fmt.Sprintf("secret-of-%d", tenantID)})
+	configResp, err := f.configs.CreateConfiguration(ctx, &deployerV1.CreateConfigurationRequest{
+		TenantId:     tenantID,
+		Name:         fmt.Sprintf("config-%d", tenantID),
+		ProviderType: "dummy",
+		Credentials:  creds,
+	})
+	if err != nil {
+		t.Fatalf("tenant %d CreateConfiguration: %v", tenantID, err)
+	}
+	configID = configResp.GetConfiguration().GetId()
+
+	targetResp, err := f.targets.CreateTarget(ctx, &deployerV1.CreateTargetRequest{
+		TenantId:         tenantID,
+		Name:             fmt.Sprintf("target-%d", tenantID),
+		ConfigurationIds: []string{configID},
+	})
+	if err != nil {
+		t.Fatalf("tenant %d CreateTarget: %v", tenantID, err)
+	}
+	targetID = targetResp.GetTarget().GetId()
+
+	if _, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{
+		TargetConfigurationId: &configID,
+		CertificateId:         "cert-1",
+	}); err != nil {
+		t.Fatalf("tenant %d CreateJob: %v", tenantID, err)
+	}
+
+	return configID, targetID
+}
+
+func TestTenantIsolation_CreateIgnoresForeignTenant(t *testing.T) {
+	f := newIsolationFixture(t)
+	ctx := tenantCtx(tenantA)
+
+	_, err := f.configs.CreateConfiguration(ctx, &deployerV1.CreateConfigurationRequest{
+		TenantId:     tenantB,
+		Name:         "smuggled",
+		ProviderType: "dummy",
+	})
+	if !deployerV1.IsForbidden(err) {
+		t.Fatalf("CreateConfiguration for another tenant: got %v, want Forbidden", err)
+	}
+
+	_, err = f.targets.CreateTarget(ctx, &deployerV1.CreateTargetRequest{TenantId: tenantB, Name: "smuggled"})
+	if !deployerV1.IsForbidden(err) {
+		t.Fatalf("CreateTarget for another tenant: got %v, want Forbidden", err)
+	}
+
+	// The tenant is stamped from the caller, not the request
+	resp, err := f.targets.CreateTarget(ctx, &deployerV1.CreateTargetRequest{TenantId: 0, Name: "implicit"})
+	if err != nil {
+		t.Fatalf("CreateTarget without tenant: %v", err)
+	}
+	if got := resp.GetTarget().GetTenantId(); got != tenantA {
+		t.Fatalf("target created in tenant %d, want %d", got, tenantA)
+	}
+}
+
+func TestTenantIsolation_ReadsAreScoped(t *testing.T) {
+	f := newIsolationFixture(t)
+	configA, targetA := f.seedTenant(t, tenantA)
+	f.seedTenant(t, tenantB)
+
+	ctx := tenantCtx(tenantB)
+
+	if _, err := f.configs.GetConfiguration(ctx, &deployerV1.GetConfigurationRequest{Id: configA}); !deployerV1.IsConfigurationNotFound(err) {
+		t.Fatalf("GetConfiguration of another tenant: got %v, want ConfigurationNotFound", err)
+	}
+	if _, err := f.configs.GetDecryptedCredentials(ctx, configA); err == nil {
+		t.Fatalf("GetDecryptedCredentials of another tenant succeeded")
+	}
+	if _, err := f.targets.GetTarget(ctx, &deployerV1.GetTargetRequest{Id: targetA}); !deployerV1.IsTargetNotFound(err) {
+		t.Fatalf("GetTarget of another tenant: got %v, want TargetNotFound", err)
+	}
+	if _, err := f.configs.ListConfigurationRevisions(ctx, &deployerV1.ListConfigurationRevisionsRequest{Id: configA}); err == nil {
+		t.Fatalf("ListConfigurationRevisions of another tenant succeeded")
+	}
+
+	// Asking for another tenant's rows in list requests yields the caller's own
+	foreign := tenantA
+	configs, err := f.configs.ListConfigurations(ctx, &deployerV1.ListConfigurationsRequest{TenantId: &foreign})
+	if err != nil {
+		t.Fatalf("ListConfigurations: %v", err)
+	}
+	for _, c := range configs.GetItems() {
+		if c.GetTenantId() != tenantB {
+			t.Fatalf("ListConfigurations leaked configuration %s of tenant %d", c.GetId(), c.GetTenantId())
+		}
+	}
+
+	targets, err := f.targets.ListTargets(ctx, &deployerV1.ListTargetsRequest{TenantId: &foreign})
+	if err != nil {
+		t.Fatalf("ListTargets: %v", err)
+	}
+	for _, tg := range targets.GetItems() {
+		if tg.GetTenantId() != tenantB {
+			t.Fatalf("ListTargets leaked target %s of tenant %d", tg.GetId(), tg.GetTenantId())
+		}
+	}
+
+	jobs, err := f.jobs.ListJobs(ctx, &deployerV1.ListJobsRequest{TenantId: &foreign})
+	if err != nil {
+		t.Fatalf("ListJobs: %v", err)
+	}
+	if len(jobs.GetItems()) != 1 {
+		t.Fatalf("ListJobs returned %d jobs, want only tenant B's one", len(jobs.GetItems()))
+	}
+	for _, j := range jobs.GetItems() {
+		if j.GetTenantId() != tenantB {
+			t.Fatalf("ListJobs leaked job %s of tenant %d", j.GetId(), j.GetTenantId())
+		}
+	}
+
+	records, err := f.changeLog.ListChangeRecords(ctx, &deployerV1.ListChangeRecordsRequest{TenantId: &foreign})
+	if err != nil {
+		t.Fatalf("ListChangeRecords: %v", err)
+	}
+	for _, r := range records.GetItems() {
+		if r.GetTenantId() != tenantB {
+			t.Fatalf("ListChangeRecords leaked record %d of tenant %d", r.GetId(), r.GetTenantId())
+		}
+	}
+
+	if _, err := f.stats.GetTenantStatistics(ctx, &deployerV1.GetTenantStatisticsRequest{TenantId: tenantA}); !deployerV1.IsForbidden(err) {
+		t.Fatalf("GetTenantStatistics of another tenant: got %v, want Forbidden", err)
+	}
+}
+
+func TestTenantIsolation_WritesAreScoped(t *testing.T) {
+	f := newIsolationFixture(t)
+	configA, targetA := f.seedTenant(t, tenantA)
+	_, targetB := f.seedTenant(t, tenantB)
+
+	ctx := tenantCtx(tenantB)
+	name := "hijacked"
+
+	if _, err := f.configs.UpdateConfiguration(ctx, &deployerV1.UpdateConfigurationRequest{Id: configA, Name: &name}); !deployerV1.IsConfigurationNotFound(err) {
+		t.Fatalf("UpdateConfiguration of another tenant: got %v, want ConfigurationNotFound", err)
+	}
+	if _, err := f.configs.DeleteConfiguration(ctx, &deployerV1.DeleteConfigurationRequest{Id: configA}); !deployerV1.IsConfigurationNotFound(err) {
+		t.Fatalf("DeleteConfiguration of another tenant: got %v, want ConfigurationNotFound", err)
+	}
+	if _, err := f.targets.DeleteTarget(ctx, &deployerV1.DeleteTargetRequest{Id: targetA}); !deployerV1.IsTargetNotFound(err) {
+		t.Fatalf("DeleteTarget of another tenant: got %v, want TargetNotFound", err)
+	}
+	if _, err := f.targets.AddConfigurations(ctx, &deployerV1.AddConfigurationsRequest{Id: targetB, ConfigurationIds: []string{configA}}); !deployerV1.IsConfigurationNotFound(err) {
+		t.Fatalf("AddConfigurations with another tenant's configuration: got %v, want ConfigurationNotFound", err)
+	}
+	if _, err := f.jobs.CreateJob(ctx, &deployerV1.CreateJobRequest{TargetConfigurationId: &configA, CertificateId: "cert-2"}); !deployerV1.IsConfigurationNotFound(err) {
+		t.Fatalf("CreateJob on another tenant's configuration: got %v, want ConfigurationNotFound", err)
+	}
+
+	// Repository-level mutations by ID are scoped by the privacy policy too
+	if _, err := f.client.TargetConfiguration.UpdateOneID(configA).SetName(name).Save(ctx); !ent.IsNotFound(err) {
+		t.Fatalf("direct update of another tenant's configuration: got %v, want not found", err)
+	}
+	affected, err := f.client.TargetConfiguration.Delete().Where(targetconfiguration.IDEQ(configA)).Exec(ctx)
+	if err != nil || affected != 0 {
+		t.Fatalf("direct delete of another tenant's configuration: affected=%d err=%v", affected, err)
+	}
+
+	// Tenant A's data is untouched
+	got, err := f.configs.GetConfiguration(tenantCtx(tenantA), &deployerV1.GetConfigurationRequest{Id: configA})
+	if err != nil {
+		t.Fatalf("GetConfiguration as owner: %v", err)
+	}
+	if got.GetConfiguration().GetName() == name {
+		t.Fatalf("configuration of tenant A was renamed by tenant B")
+	}
+}
+
+func TestTenantIsolation_PlatformAdminSeesAllTenants(t *testing.T) {
+	f := newIsolationFixture(t)
+	configA, _ := f.seedTenant(t, tenantA)
+	f.seedTenant(t, tenantB)
+
+	ctx := adminCtx()
+
+	all, err := f.configs.ListConfigurations(ctx, &deployerV1.ListConfigurationsRequest{})
+	if err != nil {
+		t.Fatalf("ListConfigurations: %v", err)
+	}
+	if all.GetTotal() != 2 {
+		t.Fatalf("admin sees %d configurations, want 2", all.GetTotal())
+	}
+
+	filter := tenantA
+	onlyA, err := f.configs.ListConfigurations(ctx, &deployerV1.ListConfigurationsRequest{TenantId: &filter})
+	if err != nil {
+		t.Fatalf("ListConfigurations: %v", err)
+	}
+	if onlyA.GetTotal() != 1 || onlyA.GetItems()[0].GetId() != configA {
+		t.Fatalf("admin tenant filter returned %v", onlyA.GetItems())
+	}
+
+	// Admins create on behalf of a tenant, but may not mix tenants in a target
+	if _, err := f.targets.CreateTarget(ctx, &deployerV1.CreateTargetRequest{
+		TenantId:         tenantB,
+		Name:             "mixed",
+		ConfigurationIds: []string{configA},
+	}); !deployerV1.IsConfigurationNotFound(err) {
+		t.Fatalf("CreateTarget mixing tenants: got %v, want ConfigurationNotFound", err)
+	}
+}
+
+func TestTenantIsolation_MissingViewerIsDenied(t *testing.T) {
+	f := newIsolationFixture(t)
+	f.seedTenant(t, tenantA)
+
+	if _, err := f.client.TargetConfiguration.Query().All(context.Background()); err == nil {
+		t.Fatalf("query without viewer succeeded")
+	}
+	if _, err := f.client.TargetConfiguration.Update().SetName("x").Save(context.Background()); err == nil {
+		t.Fatalf("update without viewer succeeded")
+	}
+
+	// Background workers run as the system viewer and see everything
+	sys := &systemViewer{}
+	count, err := f.client.TargetConfiguration.Query().Count(viewer.WithContext(context.Background(), sys))
+	if err != nil || count != 1 {
+		t.Fatalf("system viewer count=%d err=%v", count, err)
+	}
+}
+
+// systemViewer stands in for the common system viewer used by background workers
+type systemViewer struct{ viewer.Context }
+
+func (systemViewer) IsPlatformContext() bool { return false }
+func (systemViewer) IsSystemContext() bool   { return true }
+func (systemViewer) TenantID() uint64        { return 0 }
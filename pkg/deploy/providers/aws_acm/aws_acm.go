@@ -12,6 +12,15 @@ const (
 	ProviderType = "aws_acm"
 )
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"region": {Type: "string", Description: "AWS region of the ACM certificate store", Pattern: `^[a-z]{2}(-[a-z]+)+-\d+$`},
+}, "region")
+
+var credentialSchema = registry.Object(map[string]*registry.Schema{
+	"access_key_id":     registry.Secret("IAM access key ID"),
+	"secret_access_key": registry.Secret("IAM secret access key"),
+}, "access_key_id", "secret_access_key")
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{}
@@ -22,9 +31,12 @@ func init() {
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
 			SupportsRollback:     false,
+			SupportsRemoval:      false,
 			RequiredConfigFields: []string{"region"},
 			RequiredCredFields:   []string{"access_key_id", "secret_access_key"},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
 	})
 }
 
@@ -36,6 +48,7 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
 		SupportsRollback:     false,
+		SupportsRemoval:      false,
 		RequiredConfigFields: []string{"region"},
 		RequiredCredFields:   []string{"access_key_id", "secret_access_key"},
 	}
@@ -45,12 +58,12 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config map[string]any) error {
 	accessKeyID, ok := credentials["access_key_id"].(string)
 	if !ok || accessKeyID == "" {
-		return fmt.Errorf("access_key_id is required")
+		return registry.Errorf(registry.CategoryValidation, "access_key_id is required")
 	}
 
 	secretAccessKey, ok := credentials["secret_access_key"].(string)
 	if !ok || secretAccessKey == "" {
-		return fmt.Errorf("secret_access_key is required")
+		return registry.Errorf(registry.CategoryValidation, "secret_access_key is required")
 	}
 
 	// TODO: Implement actual AWS credential validation
@@ -71,13 +84,13 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 
 	region, ok := config["region"].(string)
 	if !ok || region == "" {
-		return nil, fmt.Errorf("region is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "region is required in config")
 	}
 
 	progressCb(10, "Validating certificate data")
 
 	if cert.CertificatePEM == "" || cert.PrivateKeyPEM == "" {
-		return nil, fmt.Errorf("certificate and private key are required")
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate and private key are required")
 	}
 
 	progressCb(30, "Preparing certificate for ACM")
@@ -119,7 +132,7 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 
 	region, ok := config["region"].(string)
 	if !ok || region == "" {
-		return nil, fmt.Errorf("region is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "region is required in config")
 	}
 
 	// TODO: Implement actual verification
@@ -146,3 +159,12 @@ func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData,
 		DurationMs: 0,
 	}, nil
 }
+
+// Remove is not supported for AWS ACM
+func (p *Provider) Remove(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
+	return &registry.DeploymentResult{
+		Success:    false,
+		Message:    "Remove is not supported for AWS ACM provider",
+		DurationMs: 0,
+	}, nil
+}
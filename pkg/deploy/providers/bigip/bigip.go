@@ -2,12 +2,14 @@ package bigip
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +20,19 @@ const (
 	ProviderType = "bigip"
 )
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"partition":         registry.String("Administrative partition the certificate and key are stored in").WithDefault("Common"),
+	"ssl_profile":       registry.String("Client SSL profile to create or update with the certificate"),
+	"versioned_objects": registry.Boolean("Create new certificate and key objects named after the serial on every deployment").WithDefault(false),
+	"retain_versions":   registry.Integer("Versions kept in versioned mode, including the deployed one", 1, 100).WithDefault(float64(registry.DefaultRetainVersions)),
+})
+
+var credentialSchema = registry.Object(map[string]*registry.Schema{
+	"host":     {Type: "string", Description: "Management address of the BIG-IP", Format: "hostname"},
+	"username": {Type: "string", Description: "iControl REST user", MinLength: registry.Int(1)},
+	"password": registry.Secret("Password of the iControl REST user"),
+}, "host", "username", "password")
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{}
@@ -27,10 +42,14 @@ func init() {
 		Description: "Deploy SSL/TLS certificates to F5 BIG-IP load balancers via iControl REST API",
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
-			SupportsRollback:     true,
+			SupportsRollback:     false,
+			SupportsRemoval:      true,
 			RequiredConfigFields: []string{"partition"},
 			RequiredCredFields:   []string{"host", "username", "password"},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
+		HostField:        "host",
 	})
 }
 
@@ -41,7 +60,8 @@ type Provider struct{}
 func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
-		SupportsRollback:     true,
+		SupportsRollback:     false,
+		SupportsRemoval:      true,
 		RequiredConfigFields: []string{"partition"},
 		RequiredCredFields:   []string{"host", "username", "password"},
 	}
@@ -51,17 +71,17 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config map[string]any) error {
 	host, ok := credentials["host"].(string)
 	if !ok || host == "" {
-		return fmt.Errorf("host is required")
+		return registry.Errorf(registry.CategoryValidation, "host is required")
 	}
 
 	username, ok := credentials["username"].(string)
 	if !ok || username == "" {
-		return fmt.Errorf("username is required")
+		return registry.Errorf(registry.CategoryValidation, "username is required")
 	}
 
 	password, ok := credentials["password"].(string)
 	if !ok || password == "" {
-		return fmt.Errorf("password is required")
+		return registry.Errorf(registry.CategoryValidation, "password is required")
 	}
 
 	// Create HTTP client with TLS skip verify (BIG-IP often uses self-signed certs)
@@ -83,11 +103,11 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 401 {
-		return fmt.Errorf("authentication failed: invalid username or password")
+		return registry.Errorf(registry.CategoryAuth, "authentication failed: invalid username or password")
 	}
 	if resp.StatusCode != 200 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("BIG-IP API error (HTTP %d): %s", resp.StatusCode, string(body))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "BIG-IP API error (HTTP %d): %s", resp.StatusCode, string(body))
 	}
 
 	return nil
@@ -111,16 +131,13 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 		partition = p
 	}
 
-	// Generate certificate name from common name
-	certName := sanitizeName(cert.CommonName)
-	if certName == "" {
-		certName = fmt.Sprintf("cert-%s", cert.ID[:8])
-	}
+	// Generate object names from the common name, and serial in versioned mode
+	certName := objectName(cert, config)
 
 	progressCb(10, "Validating certificate data")
 
 	if cert.CertificatePEM == "" || cert.PrivateKeyPEM == "" {
-		return nil, fmt.Errorf("certificate and private key are required")
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate and private key are required")
 	}
 
 	client := p.createHTTPClient()
@@ -167,6 +184,15 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 		return nil, fmt.Errorf("verification failed: %w", err)
 	}
 
+	// Only the verified version and the newest older ones stay
+	versioned, retain := registry.Versioning(config)
+	var pruned []string
+	var pruneErr error
+	if versioned {
+		progressCb(95, "Deleting superseded certificate versions")
+		pruned, pruneErr = p.pruneVersions(ctx, client, host, username, password, partition, baseName(cert), certFullName, retain-1)
+	}
+
 	progressCb(100, "Deployment complete")
 
 	resourceID := certFullName
@@ -179,6 +205,12 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 	if sslProfileName != "" {
 		details["ssl_profile"] = sslProfileName
 	}
+	if versioned {
+		details["deleted_versions"] = strings.Join(pruned, ",")
+	}
+	if pruneErr != nil {
+		details["prune_error"] = pruneErr.Error()
+	}
 
 	return &registry.DeploymentResult{
 		Success:    true,
@@ -206,18 +238,36 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 		partition = p
 	}
 
-	certName := sanitizeName(cert.CommonName)
-	if certName == "" {
-		certName = fmt.Sprintf("cert-%s", cert.ID[:8])
-	}
+	certName := objectName(cert, config)
 
 	client := p.createHTTPClient()
 	certFullName := fmt.Sprintf("/%s/%s.crt", partition, certName)
 
-	if err := p.verifyCertExists(ctx, client, host, username, password, certFullName); err != nil {
+	// A device that cannot be asked is not evidence of a missing certificate
+	var object struct {
+		SerialNumber string `json:"serialNumber"`
+	}
+	err := p.getResource(ctx, client, host, username, password, "sys/crypto/cert/"+strings.ReplaceAll(certFullName, "/", "~"), &object)
+	if registry.Classify(err) == registry.CategoryNotFound {
 		return &registry.DeploymentResult{
 			Success:    false,
-			Message:    fmt.Sprintf("Certificate not found: %v", err),
+			Message:    fmt.Sprintf("Certificate %s not found on BIG-IP", certFullName),
+			DurationMs: time.Since(startTime).Milliseconds(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify certificate %s: %w", certFullName, err)
+	}
+
+	// The object may hold another certificate, e.g. after restoring an old UCS
+	if registry.SerialMismatch(cert.SerialNumber, object.SerialNumber) {
+		return &registry.DeploymentResult{
+			Success: false,
+			Message: fmt.Sprintf("Certificate %s has serial %s, expected %s", certFullName, object.SerialNumber, cert.SerialNumber),
+			Details: map[string]any{
+				"certificate_name": certFullName,
+				"observed_serial":  object.SerialNumber,
+			},
 			DurationMs: time.Since(startTime).Milliseconds(),
 		}, nil
 	}
@@ -235,8 +285,20 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 	}, nil
 }
 
-// Rollback removes a deployed certificate
+// Rollback is not supported for BIG-IP: the previous certificate is not
+// kept on the device. Use Remove to delete a deployed certificate.
 func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
+	return &registry.DeploymentResult{
+		Success:    false,
+		Message:    "Rollback is not supported for BIG-IP provider",
+		DurationMs: 0,
+	}, nil
+}
+
+// Remove deletes the SSL profile, certificate, key and chain of a deployed
+// certificate. It refuses while a virtual server uses the SSL profile or
+// another SSL profile uses the certificate or key.
+func (p *Provider) Remove(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
 	startTime := time.Now()
 
 	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
@@ -252,59 +314,422 @@ func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData,
 		partition = p
 	}
 
-	certName := sanitizeName(cert.CommonName)
-	if certName == "" {
-		certName = fmt.Sprintf("cert-%s", cert.ID[:8])
+	certName := objectName(cert, config)
+
+	certFullName := fmt.Sprintf("/%s/%s.crt", partition, certName)
+	keyFullName := fmt.Sprintf("/%s/%s.key", partition, certName)
+	chainFullName := fmt.Sprintf("/%s/%s_chain.crt", partition, certName)
+	profileFullName := ""
+	if sslProfileName, ok := config["ssl_profile"].(string); ok && sslProfileName != "" {
+		profileFullName = fmt.Sprintf("/%s/%s", partition, sslProfileName)
 	}
 
 	client := p.createHTTPClient()
 
-	// Delete SSL profile first if it exists
-	if sslProfileName, ok := config["ssl_profile"].(string); ok && sslProfileName != "" {
-		profileFullName := fmt.Sprintf("/%s/%s", partition, sslProfileName)
+	// Leave everything in place while traffic may still depend on it
+	refs, err := p.findReferences(ctx, client, host, username, password, profileFullName, certFullName, keyFullName, chainFullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check references: %w", err)
+	}
+	if len(refs) > 0 {
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate is still in use by %s", strings.Join(refs, ", "))
+	}
+
+	// The profile references the certificate and key, so it goes first
+	if profileFullName != "" {
 		if err := p.deleteResource(ctx, client, host, username, password, "ltm/profile/client-ssl", profileFullName); err != nil {
-			// SSL profile deletion is best-effort; continue with cert cleanup
-			_ = err
+			return nil, fmt.Errorf("failed to delete SSL profile: %w", err)
 		}
 	}
-
-	// Delete the certificate and key
-	certFullName := fmt.Sprintf("/%s/%s.crt", partition, certName)
-	keyFullName := fmt.Sprintf("/%s/%s.key", partition, certName)
-	chainFullName := fmt.Sprintf("/%s/%s_chain.crt", partition, certName)
-
-	var errors []string
-
 	if err := p.deleteResource(ctx, client, host, username, password, "sys/crypto/cert", certFullName); err != nil {
-		errors = append(errors, fmt.Sprintf("certificate: %v", err))
+		return nil, fmt.Errorf("failed to delete certificate: %w", err)
 	}
 	if err := p.deleteResource(ctx, client, host, username, password, "sys/crypto/key", keyFullName); err != nil {
-		errors = append(errors, fmt.Sprintf("key: %v", err))
+		return nil, fmt.Errorf("failed to delete private key: %w", err)
 	}
 	// Chain cert may not exist if the original deployment didn't include one
 	if err := p.deleteResource(ctx, client, host, username, password, "sys/crypto/cert", chainFullName); err != nil {
 		_ = err // intentionally ignored: chain cert is optional
 	}
 
-	if len(errors) > 0 {
-		return &registry.DeploymentResult{
-			Success:    false,
-			Message:    fmt.Sprintf("Rollback partially failed: %s", strings.Join(errors, "; ")),
-			DurationMs: time.Since(startTime).Milliseconds(),
-		}, nil
+	details := map[string]any{
+		"deleted_cert": certFullName,
+		"deleted_key":  keyFullName,
+	}
+	if profileFullName != "" {
+		details["deleted_ssl_profile"] = profileFullName
+	}
+
+	// Versions retained for rollback go as well
+	if versioned, _ := registry.Versioning(config); versioned {
+		pruned, err := p.pruneVersions(ctx, client, host, username, password, partition, baseName(cert), certFullName, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete retained versions: %w", err)
+		}
+		details["deleted_versions"] = strings.Join(pruned, ",")
 	}
 
 	return &registry.DeploymentResult{
 		Success:    true,
 		Message:    "Certificate and key removed from BIG-IP",
 		DurationMs: time.Since(startTime).Milliseconds(),
-		Details: map[string]any{
-			"deleted_cert": certFullName,
-			"deleted_key":  keyFullName,
-		},
+		Details:    details,
 	}, nil
 }
 
+// Plan reports which certificate, key and chain objects and which SSL profile
+// a deployment creates or updates, and the versions it deletes
+func (p *Provider) Plan(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) ([]registry.PlannedAction, error) {
+	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
+		return nil, err
+	}
+
+	host := credentials["host"].(string)
+	username := credentials["username"].(string)
+	password := credentials["password"].(string)
+
+	partition := "Common"
+	if p, ok := config["partition"].(string); ok && p != "" {
+		partition = p
+	}
+
+	certName := objectName(cert, config)
+	certFullName := fmt.Sprintf("/%s/%s.crt", partition, certName)
+
+	client := p.createHTTPClient()
+
+	type object struct{ resourceType, path, name string }
+	objects := []object{
+		{"cert", "sys/crypto/cert", certFullName},
+		{"key", "sys/crypto/key", fmt.Sprintf("/%s/%s.key", partition, certName)},
+	}
+	if cert.CertificateChain != "" {
+		objects = append(objects, object{"cert", "sys/crypto/cert", fmt.Sprintf("/%s/%s_chain.crt", partition, certName)})
+	}
+
+	var actions []registry.PlannedAction
+	for _, obj := range objects {
+		exists, err := p.resourceExists(ctx, client, host, username, password, obj.path, obj.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s %s: %w", obj.resourceType, obj.name, err)
+		}
+		operation := registry.PlanCreate
+		if exists {
+			operation = registry.PlanUpdate
+		}
+		actions = append(actions, registry.PlannedAction{Operation: operation, ResourceType: obj.resourceType, Resource: obj.name})
+	}
+
+	if name, ok := config["ssl_profile"].(string); ok && name != "" {
+		profileFullName := fmt.Sprintf("/%s/%s", partition, name)
+		exists, err := p.resourceExists(ctx, client, host, username, password, "ltm/profile/client-ssl", profileFullName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check SSL profile: %w", err)
+		}
+		action := registry.PlannedAction{Operation: registry.PlanCreate, ResourceType: "profile", Resource: profileFullName}
+		if exists {
+			action.Operation = registry.PlanUpdate
+			// Traffic of these virtual servers switches to the new certificate
+			refs, err := p.findReferences(ctx, client, host, username, password, profileFullName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check references: %w", err)
+			}
+			if len(refs) > 0 {
+				action.Detail = "used by " + strings.Join(refs, ", ")
+			}
+		}
+		actions = append(actions, action)
+	}
+
+	if versioned, retain := registry.Versioning(config); versioned {
+		stale, err := p.staleVersions(ctx, client, host, username, password, partition, baseName(cert), certFullName, retain-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list certificate versions: %w", err)
+		}
+		for _, name := range stale {
+			actions = append(actions, registry.PlannedAction{
+				Operation: registry.PlanDelete, ResourceType: "cert", Resource: name + ".crt", Detail: "superseded version",
+			})
+		}
+	}
+
+	return actions, nil
+}
+
+// findReferences lists the virtual servers using profileName and the SSL
+// profiles other than profileName using any of the crypto objects
+func (p *Provider) findReferences(ctx context.Context, client *http.Client, host, username, password, profileName string, objects ...string) ([]string, error) {
+	var refs []string
+
+	if profileName != "" {
+		var virtuals struct {
+			Items []struct {
+				FullPath          string `json:"fullPath"`
+				ProfilesReference struct {
+					Items []struct {
+						FullPath string `json:"fullPath"`
+					} `json:"items"`
+				} `json:"profilesReference"`
+			} `json:"items"`
+		}
+		if err := p.getResource(ctx, client, host, username, password, "ltm/virtual?expandSubcollections=true", &virtuals); err != nil {
+			return nil, err
+		}
+		for _, vs := range virtuals.Items {
+			for _, profile := range vs.ProfilesReference.Items {
+				if profile.FullPath == profileName {
+					refs = append(refs, "virtual server "+vs.FullPath)
+				}
+			}
+		}
+	}
+
+	for _, kind := range sslProfileKinds {
+		profiles, err := p.listSSLProfiles(ctx, client, host, username, password, kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range profiles {
+			if kind == "client-ssl" && profile.FullPath == profileName {
+				// Removed along with the certificate
+				continue
+			}
+			if slices.ContainsFunc(profile.objects(), func(name string) bool { return slices.Contains(objects, name) }) {
+				refs = append(refs, kind+" profile "+profile.FullPath)
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+// sslProfileKinds are the kinds of SSL profiles using crypto objects
+var sslProfileKinds = []string{"client-ssl", "server-ssl"}
+
+// sslProfile is a client or server SSL profile as listed by BIG-IP
+type sslProfile struct {
+	FullPath     string `json:"fullPath"`
+	Cert         string `json:"cert"`
+	Key          string `json:"key"`
+	Chain        string `json:"chain"`
+	CertKeyChain []struct {
+		Cert  string `json:"cert"`
+		Key   string `json:"key"`
+		Chain string `json:"chain"`
+	} `json:"certKeyChain"`
+}
+
+// objects returns the crypto objects the profile uses
+func (s sslProfile) objects() []string {
+	var names []string
+	add := func(candidates ...string) {
+		for _, name := range candidates {
+			if name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	add(s.Cert, s.Key, s.Chain)
+	for _, entry := range s.CertKeyChain {
+		add(entry.Cert, entry.Key, entry.Chain)
+	}
+	return names
+}
+
+// listSSLProfiles lists the SSL profiles of a kind
+func (p *Provider) listSSLProfiles(ctx context.Context, client *http.Client, host, username, password, kind string) ([]sslProfile, error) {
+	var profiles struct {
+		Items []sslProfile `json:"items"`
+	}
+	if err := p.getResource(ctx, client, host, username, password, "ltm/profile/"+kind, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles.Items, nil
+}
+
+// systemCertificates are the certificates BIG-IP ships with
+var systemCertificates = []string{"/Common/ca-bundle.crt", "/Common/default.crt", "/Common/f5-ca-bundle.crt", "/Common/f5-irule.crt"}
+
+// Inventory lists the certificates in sys/crypto/cert of every partition with
+// the SSL profiles using them. Certificates shipped with BIG-IP and chains
+// stored next to a certificate are left out.
+func (p *Provider) Inventory(ctx context.Context, config, credentials map[string]any) ([]registry.InventoryCertificate, error) {
+	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
+		return nil, err
+	}
+
+	host := credentials["host"].(string)
+	username := credentials["username"].(string)
+	password := credentials["password"].(string)
+
+	client := p.createHTTPClient()
+
+	var certs struct {
+		Items []struct {
+			FullPath               string `json:"fullPath"`
+			CommonName             string `json:"commonName"`
+			SubjectAlternativeName string `json:"subjectAlternativeName"`
+			SerialNumber           string `json:"serialNumber"`
+			Issuer                 string `json:"issuer"`
+			ExpirationDate         int64  `json:"expirationDate"`
+		} `json:"items"`
+	}
+	if err := p.getResource(ctx, client, host, username, password, "sys/crypto/cert", &certs); err != nil {
+		return nil, fmt.Errorf("failed to list certificates: %w", err)
+	}
+
+	refs := make(map[string][]string)
+	for _, kind := range sslProfileKinds {
+		profiles, err := p.listSSLProfiles(ctx, client, host, username, password, kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SSL profiles: %w", err)
+		}
+		for _, profile := range profiles {
+			for _, name := range profile.objects() {
+				refs[name] = append(refs[name], kind+" profile "+profile.FullPath)
+			}
+		}
+	}
+
+	paths := make(map[string]bool, len(certs.Items))
+	for _, item := range certs.Items {
+		paths[item.FullPath] = true
+	}
+
+	var inventory []registry.InventoryCertificate
+	for _, item := range certs.Items {
+		if slices.Contains(systemCertificates, item.FullPath) {
+			continue
+		}
+		if base, ok := strings.CutSuffix(item.FullPath, "_chain.crt"); ok && paths[base+".crt"] {
+			continue
+		}
+		var sans []string
+		for _, entry := range strings.Split(item.SubjectAlternativeName, ",") {
+			if name, ok := strings.CutPrefix(strings.TrimSpace(entry), "DNS:"); ok {
+				sans = append(sans, name)
+			}
+		}
+		inventory = append(inventory, registry.InventoryCertificate{
+			Name:         item.FullPath,
+			CommonName:   item.CommonName,
+			SANs:         sans,
+			SerialNumber: item.SerialNumber,
+			Issuer:       item.Issuer,
+			ExpiresAt:    item.ExpirationDate,
+			References:   refs[item.FullPath],
+		})
+	}
+	return inventory, nil
+}
+
+// pruneVersions deletes the versioned objects of baseName in partition other
+// than the current certificate, except the keep newest ones and those an SSL
+// profile still uses. It returns the deleted certificates.
+func (p *Provider) pruneVersions(ctx context.Context, client *http.Client, host, username, password, partition, baseName, current string, keep int) ([]string, error) {
+	stale, err := p.staleVersions(ctx, client, host, username, password, partition, baseName, current, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []string
+	for _, name := range stale {
+		certName, keyName, chainName := name+".crt", name+".key", name+"_chain.crt"
+		if err := p.deleteResource(ctx, client, host, username, password, "sys/crypto/cert", certName); err != nil {
+			return pruned, err
+		}
+		if err := p.deleteResource(ctx, client, host, username, password, "sys/crypto/key", keyName); err != nil {
+			return pruned, err
+		}
+		if err := p.deleteResource(ctx, client, host, username, password, "sys/crypto/cert", chainName); err != nil {
+			_ = err // intentionally ignored: chain cert is optional
+		}
+		pruned = append(pruned, certName)
+	}
+	return pruned, nil
+}
+
+// staleVersions returns the versions pruneVersions deletes, as object paths
+// without extension
+func (p *Provider) staleVersions(ctx context.Context, client *http.Client, host, username, password, partition, baseName, current string, keep int) ([]string, error) {
+	var certs struct {
+		Items []struct {
+			FullPath       string `json:"fullPath"`
+			ExpirationDate int64  `json:"expirationDate"`
+		} `json:"items"`
+	}
+	if err := p.getResource(ctx, client, host, username, password, "sys/crypto/cert", &certs); err != nil {
+		return nil, err
+	}
+
+	type version struct {
+		name      string
+		expiresAt int64
+	}
+	prefix := fmt.Sprintf("/%s/%s_", partition, baseName)
+	var versions []version
+	for _, item := range certs.Items {
+		name, ok := strings.CutSuffix(item.FullPath, ".crt")
+		if !ok || item.FullPath == current || !strings.HasPrefix(name, prefix) ||
+			!registry.IsObjectVersion(strings.TrimPrefix(name, prefix)) {
+			continue
+		}
+		versions = append(versions, version{name: name, expiresAt: item.ExpirationDate})
+	}
+	// Renewals expire later, so the newest version comes first
+	slices.SortFunc(versions, func(a, b version) int { return cmp.Compare(b.expiresAt, a.expiresAt) })
+
+	var stale []string
+	for i, v := range versions {
+		if i < keep {
+			continue
+		}
+		refs, err := p.findReferences(ctx, client, host, username, password, "", v.name+".crt", v.name+".key", v.name+"_chain.crt")
+		if err != nil {
+			return nil, err
+		}
+		if len(refs) == 0 {
+			stale = append(stale, v.name)
+		}
+	}
+	return stale, nil
+}
+
+// getResource reads a collection or resource from BIG-IP into out
+func (p *Provider) getResource(ctx context.Context, client *http.Client, host, username, password, path string, out any) error {
+	url := fmt.Sprintf("https://%s/mgmt/tm/%s", host, path)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(username, password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// resourceExists checks if a resource exists on BIG-IP
+func (p *Provider) resourceExists(ctx context.Context, client *http.Client, host, username, password, resourceType, name string) (bool, error) {
+	var resource map[string]any
+	err := p.getResource(ctx, client, host, username, password, resourceType+"/"+strings.ReplaceAll(name, "/", "~"), &resource)
+	if registry.Classify(err) == registry.CategoryNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // createHTTPClient creates an HTTP client for BIG-IP API calls
 func (p *Provider) createHTTPClient() *http.Client {
 	return &http.Client{
@@ -340,7 +765,7 @@ func (p *Provider) uploadFile(ctx context.Context, client *http.Client, host, us
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("file upload error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "file upload error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -386,7 +811,7 @@ func (p *Provider) uploadCertificate(ctx context.Context, client *http.Client, h
 		if resp.StatusCode == 409 || strings.Contains(string(respBody), "already exists") {
 			return p.updateCertificate(ctx, client, host, username, password, name, certPEM)
 		}
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -429,7 +854,7 @@ func (p *Provider) updateCertificate(ctx context.Context, client *http.Client, h
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -475,7 +900,7 @@ func (p *Provider) uploadKey(ctx context.Context, client *http.Client, host, use
 		if resp.StatusCode == 409 || strings.Contains(string(respBody), "already exists") {
 			return p.updateKey(ctx, client, host, username, password, name, keyPEM)
 		}
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -518,7 +943,7 @@ func (p *Provider) updateKey(ctx context.Context, client *http.Client, host, use
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -561,7 +986,7 @@ func (p *Provider) createOrUpdateSSLProfile(ctx context.Context, client *http.Cl
 	}
 
 	respBody, _ := io.ReadAll(resp.Body)
-	return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 }
 
 // updateSSLProfile updates an existing client-ssl profile
@@ -591,7 +1016,7 @@ func (p *Provider) updateSSLProfile(ctx context.Context, client *http.Client, ho
 
 	if resp.StatusCode != 200 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -616,11 +1041,11 @@ func (p *Provider) verifyCertExists(ctx context.Context, client *http.Client, ho
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 404 {
-		return fmt.Errorf("certificate not found")
+		return registry.Errorf(registry.CategoryNotFound, "certificate not found")
 	}
 	if resp.StatusCode != 200 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -650,12 +1075,33 @@ func (p *Provider) deleteResource(ctx context.Context, client *http.Client, host
 	}
 	if resp.StatusCode != 200 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
 }
 
+// baseName returns the name of cert's objects without version
+func baseName(cert *registry.CertificateData) string {
+	name := sanitizeName(cert.CommonName)
+	if name == "" {
+		name = fmt.Sprintf("cert-%s", cert.ID[:min(len(cert.ID), 8)])
+	}
+	return name
+}
+
+// objectName returns the name of cert's certificate, key and chain objects,
+// suffixed with the certificate version in versioned mode
+func objectName(cert *registry.CertificateData, config map[string]any) string {
+	name := baseName(cert)
+	if versioned, _ := registry.Versioning(config); versioned {
+		if version := registry.ObjectVersion(cert); version != "" {
+			name += "_" + version
+		}
+	}
+	return name
+}
+
 // sanitizeName converts a domain/common name to a valid BIG-IP resource name.
 // Rules:
 //   - Wildcard "*" is replaced with "star"
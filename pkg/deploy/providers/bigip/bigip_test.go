@@ -0,0 +1,289 @@
+package bigip
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
+)
+
+func TestRemoveRefusesObjectsInUse(t *testing.T) {
+	virtuals := `{"items":[{"fullPath":"/Common/vs_www","profilesReference":{"items":[{"fullPath":"/Common/tcp"},{"fullPath":"/Common/www_ssl"}]}}]}`
+	clientSSL := `{"items":[{"fullPath":"/Common/www_ssl","cert":"/Common/www_example_com.crt","key":"/Common/www_example_com.key"}]}`
+	serverSSL := `{"items":[]}`
+
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/mgmt/tm/"))
+		case r.URL.Path == "/mgmt/tm/sys/version":
+			_, _ = w.Write([]byte(`{}`))
+		case r.URL.Path == "/mgmt/tm/ltm/virtual":
+			_, _ = w.Write([]byte(virtuals))
+		case r.URL.Path == "/mgmt/tm/ltm/profile/client-ssl":
+			_, _ = w.Write([]byte(clientSSL))
+		case r.URL.Path == "/mgmt/tm/ltm/profile/server-ssl":
+			_, _ = w.Write([]byte(serverSSL))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p := &Provider{}
+	cert := &registry.CertificateData{ID: "cert-1", CommonName: "www.example.com"}
+	config := map[string]any{"partition": "Common", "ssl_profile": "www_ssl"}
+	credentials := map[string]any{"host": server.Listener.Addr().String(), "username": "admin", "password": "secret"}
+
+	// A virtual server still uses the profile
+	if _, err := p.Remove(context.Background(), cert, config, credentials); registry.Classify(err) != registry.CategoryValidation ||
+		!strings.Contains(err.Error(), "virtual server /Common/vs_www") {
+		t.Fatalf("Remove with profile in use: %v", err)
+	}
+
+	// Another profile still uses the certificate
+	mu.Lock()
+	virtuals = `{"items":[]}`
+	serverSSL = `{"items":[{"fullPath":"/Common/backend","certKeyChain":[{"cert":"/Common/www_example_com.crt"}]}]}`
+	mu.Unlock()
+	if _, err := p.Remove(context.Background(), cert, config, credentials); err == nil ||
+		!strings.Contains(err.Error(), "server-ssl profile /Common/backend") {
+		t.Fatalf("Remove with certificate in use: %v", err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("deleted %v while in use", deleted)
+	}
+
+	// Unused objects are removed, the profile first
+	mu.Lock()
+	serverSSL = `{"items":[]}`
+	mu.Unlock()
+	result, err := p.Remove(context.Background(), cert, config, credentials)
+	if err != nil || !result.Success {
+		t.Fatalf("Remove: %v, %+v", err, result)
+	}
+	want := []string{
+		"ltm/profile/client-ssl/~Common~www_ssl",
+		"sys/crypto/cert/~Common~www_example_com.crt",
+		"sys/crypto/key/~Common~www_example_com.key",
+		"sys/crypto/cert/~Common~www_example_com_chain.crt",
+	}
+	if strings.Join(deleted, ",") != strings.Join(want, ",") {
+		t.Fatalf("deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestPruneVersionsKeepsNewestAndReferencedVersions(t *testing.T) {
+	certs := `{"items":[
+		{"fullPath":"/Common/www_example_com.crt","expirationDate":100},
+		{"fullPath":"/Common/www_example_com_00000001.crt","expirationDate":100},
+		{"fullPath":"/Common/www_example_com_00000002.crt","expirationDate":200},
+		{"fullPath":"/Common/www_example_com_00000002_chain.crt","expirationDate":900},
+		{"fullPath":"/Common/www_example_com_00000003.crt","expirationDate":300},
+		{"fullPath":"/Common/www_example_com_00000004.crt","expirationDate":400},
+		{"fullPath":"/Common/www_example_com_00000005.crt","expirationDate":500},
+		{"fullPath":"/Common/api_example_com_00000001.crt","expirationDate":100}]}`
+	// An SSL profile outside the deployer's control still uses version 1
+	clientSSL := `{"items":[{"fullPath":"/Common/legacy","cert":"/Common/www_example_com_00000001.crt"}]}`
+
+	var deleted []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/mgmt/tm/"))
+		case r.URL.Path == "/mgmt/tm/sys/crypto/cert":
+			_, _ = w.Write([]byte(certs))
+		case r.URL.Path == "/mgmt/tm/ltm/profile/client-ssl":
+			_, _ = w.Write([]byte(clientSSL))
+		default:
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	p := &Provider{}
+	client := p.createHTTPClient()
+	cert := &registry.CertificateData{CommonName: "www.example.com", SerialNumber: "05"}
+	config := map[string]any{"versioned_objects": true}
+	if name := objectName(cert, config); name != "www_example_com_00000005" {
+		t.Fatalf("objectName = %q", name)
+	}
+
+	// The deployed version 5 and the newest older one (4) stay
+	pruned, err := p.pruneVersions(context.Background(), client, server.Listener.Addr().String(), "admin", "secret",
+		"Common", "www_example_com", "/Common/www_example_com_00000005.crt", 1)
+	if err != nil {
+		t.Fatalf("pruneVersions: %v", err)
+	}
+	want := []string{"/Common/www_example_com_00000003.crt", "/Common/www_example_com_00000002.crt"}
+	if strings.Join(pruned, ",") != strings.Join(want, ",") {
+		t.Fatalf("pruned %v, want %v", pruned, want)
+	}
+	for _, path := range deleted {
+		if strings.Contains(path, "00000001") || strings.Contains(path, "00000004") || strings.Contains(path, "api_") {
+			t.Fatalf("deleted %s", path)
+		}
+	}
+	if len(deleted) != 6 {
+		t.Fatalf("deleted %v, want certificate, key and chain of two versions", deleted)
+	}
+}
+
+func TestPlanInspectsWithoutChanges(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("plan sent %s %s", r.Method, r.URL.Path)
+		}
+		switch r.URL.Path {
+		case "/mgmt/tm/sys/version", "/mgmt/tm/sys/crypto/cert/~Common~www_example_com_00000005.crt",
+			"/mgmt/tm/ltm/profile/client-ssl/~Common~www_ssl":
+			_, _ = w.Write([]byte(`{}`))
+		case "/mgmt/tm/sys/crypto/cert":
+			_, _ = w.Write([]byte(`{"items":[
+				{"fullPath":"/Common/www_example_com_00000005.crt","expirationDate":500},
+				{"fullPath":"/Common/www_example_com_00000004.crt","expirationDate":400},
+				{"fullPath":"/Common/www_example_com_00000003.crt","expirationDate":300}]}`))
+		case "/mgmt/tm/ltm/virtual":
+			_, _ = w.Write([]byte(`{"items":[{"fullPath":"/Common/vs_www","profilesReference":{"items":[{"fullPath":"/Common/www_ssl"}]}}]}`))
+		case "/mgmt/tm/ltm/profile/client-ssl", "/mgmt/tm/ltm/profile/server-ssl":
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p := &Provider{}
+	cert := &registry.CertificateData{CommonName: "www.example.com", SerialNumber: "05", CertificateChain: "chain"}
+	config := map[string]any{"partition": "Common", "ssl_profile": "www_ssl", "versioned_objects": true, "retain_versions": float64(2)}
+	credentials := map[string]any{"host": server.Listener.Addr().String(), "username": "admin", "password": "secret"}
+
+	actions, err := p.Plan(context.Background(), cert, config, credentials)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	var got []string
+	for _, action := range actions {
+		got = append(got, action.String())
+	}
+	want := []string{
+		"update cert /Common/www_example_com_00000005.crt",
+		"create key /Common/www_example_com_00000005.key",
+		"create cert /Common/www_example_com_00000005_chain.crt",
+		"update profile /Common/www_ssl (used by virtual server /Common/vs_www)",
+		"delete cert /Common/www_example_com_00000003.crt (superseded version)",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestInventoryListsCertificatesWithReferences(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("inventory sent %s %s", r.Method, r.URL.Path)
+		}
+		switch r.URL.Path {
+		case "/mgmt/tm/sys/version":
+			_, _ = w.Write([]byte(`{}`))
+		case "/mgmt/tm/sys/crypto/cert":
+			_, _ = w.Write([]byte(`{"items":[
+				{"fullPath":"/Common/default.crt","commonName":"localhost"},
+				{"fullPath":"/Common/www_example_com.crt","commonName":"www.example.com",
+					"subjectAlternativeName":"DNS:www.example.com, DNS:example.com, IP Address:10.0.0.1",
+					"serialNumber":"0a:1b","expirationDate":1700000000},
+				{"fullPath":"/Common/www_example_com_chain.crt","commonName":"Example CA"},
+				{"fullPath":"/Partner/legacy.crt","commonName":"legacy.example.org"}]}`))
+		case "/mgmt/tm/ltm/profile/client-ssl":
+			_, _ = w.Write([]byte(`{"items":[{"fullPath":"/Common/www_ssl","cert":"/Common/www_example_com.crt",
+				"chain":"/Common/www_example_com_chain.crt"}]}`))
+		case "/mgmt/tm/ltm/profile/server-ssl":
+			_, _ = w.Write([]byte(`{"items":[{"fullPath":"/Common/backend","certKeyChain":[{"cert":"/Common/www_example_com.crt"}]}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p := &Provider{}
+	credentials := map[string]any{"host": server.Listener.Addr().String(), "username": "admin", "password": "secret"}
+
+	inventory, err := p.Inventory(context.Background(), map[string]any{}, credentials)
+	if err != nil {
+		t.Fatalf("Inventory: %v", err)
+	}
+	if len(inventory) != 2 {
+		t.Fatalf("inventory %+v, want the deployed and the partner certificate", inventory)
+	}
+	www := inventory[0]
+	if www.Name != "/Common/www_example_com.crt" || www.CommonName != "www.example.com" ||
+		strings.Join(www.SANs, ",") != "www.example.com,example.com" || www.SerialNumber != "0a:1b" || www.ExpiresAt != 1700000000 {
+		t.Fatalf("certificate %+v", www)
+	}
+	if got := strings.Join(www.References, ","); got != "client-ssl profile /Common/www_ssl,server-ssl profile /Common/backend" {
+		t.Fatalf("references %s", got)
+	}
+	if inventory[1].Name != "/Partner/legacy.crt" || len(inventory[1].References) != 0 {
+		t.Fatalf("certificate %+v", inventory[1])
+	}
+}
+
+func TestVerifyComparesSerials(t *testing.T) {
+	var mu sync.Mutex
+	status, body := http.StatusOK, `{"serialNumber":"0a:1b"}`
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/mgmt/tm/sys/version":
+			_, _ = w.Write([]byte(`{}`))
+		case "/mgmt/tm/sys/crypto/cert/~Common~www_example_com.crt":
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p := &Provider{}
+	cert := &registry.CertificateData{CommonName: "www.example.com", SerialNumber: "0A1B"}
+	config := map[string]any{"partition": "Common"}
+	credentials := map[string]any{"host": server.Listener.Addr().String(), "username": "admin", "password": "secret"}
+
+	if result, err := p.Verify(context.Background(), cert, config, credentials); err != nil || !result.Success {
+		t.Fatalf("Verify with matching serial: %v, %+v", err, result)
+	}
+
+	// Another certificate under the same name
+	mu.Lock()
+	body = `{"serialNumber":"ff"}`
+	mu.Unlock()
+	result, err := p.Verify(context.Background(), cert, config, credentials)
+	if err != nil || result.Success || result.Details["observed_serial"] != "ff" {
+		t.Fatalf("Verify with other serial: %v, %+v", err, result)
+	}
+
+	mu.Lock()
+	status, body = http.StatusNotFound, `{"code":404}`
+	mu.Unlock()
+	if result, err := p.Verify(context.Background(), cert, config, credentials); err != nil || result.Success {
+		t.Fatalf("Verify of a missing certificate: %v, %+v", err, result)
+	}
+
+	// A failing device is inconclusive, not drift
+	mu.Lock()
+	status, body = http.StatusInternalServerError, `{"code":500}`
+	mu.Unlock()
+	if result, err := p.Verify(context.Background(), cert, config, credentials); err == nil {
+		t.Fatalf("Verify with a failing device: %+v", result)
+	}
+}
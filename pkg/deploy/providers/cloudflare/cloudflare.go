@@ -17,6 +17,14 @@ const (
 	cloudflareAPIBase = "https://api.cloudflare.com/client/v4"
 )
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"zone_id": {Type: "string", Description: "ID of the Cloudflare zone", Pattern: `^[0-9a-f]{32}$`},
+}, "zone_id")
+
+var credentialSchema = registry.Object(map[string]*registry.Schema{
+	"api_token": registry.Secret("API token with SSL and Certificates edit permission"),
+}, "api_token")
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{}
@@ -27,9 +35,12 @@ func init() {
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
 			SupportsRollback:     false,
+			SupportsRemoval:      true,
 			RequiredConfigFields: []string{"zone_id"},
 			RequiredCredFields:   []string{"api_token"},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
 	})
 }
 
@@ -41,6 +52,7 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
 		SupportsRollback:     false,
+		SupportsRemoval:      true,
 		RequiredConfigFields: []string{"zone_id"},
 		RequiredCredFields:   []string{"api_token"},
 	}
@@ -50,12 +62,12 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config map[string]any) error {
 	apiToken, ok := credentials["api_token"].(string)
 	if !ok || apiToken == "" {
-		return fmt.Errorf("api_token is required")
+		return registry.Errorf(registry.CategoryValidation, "api_token is required")
 	}
 
 	// Basic token format validation
 	if len(apiToken) < 20 {
-		return fmt.Errorf("api_token appears to be invalid (too short)")
+		return registry.Errorf(registry.CategoryValidation, "api_token appears to be invalid (too short)")
 	}
 
 	client := &http.Client{Timeout: 30 * time.Second}
@@ -96,9 +108,9 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 		}
 
 		if len(result.Errors) > 0 {
-			return fmt.Errorf("credentials validation failed: %s (code: %d)", result.Errors[0].Message, result.Errors[0].Code)
+			return registry.HTTPError(resp.StatusCode, resp.Header, "credentials validation failed: %s (code: %d)", result.Errors[0].Message, result.Errors[0].Code)
 		}
-		return fmt.Errorf("credentials validation failed: could not access zone")
+		return registry.HTTPError(resp.StatusCode, resp.Header, "credentials validation failed: could not access zone")
 	}
 
 	// Fallback: try generic endpoints when no zone_id provided
@@ -144,7 +156,7 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 
 		for _, e := range result.Errors {
 			if e.Code == 10000 || e.Code == 9109 || e.Code == 1000 {
-				lastErr = fmt.Errorf("%s (code: %d)", e.Message, e.Code)
+				lastErr = registry.Errorf(registry.CategoryAuth, "%s (code: %d)", e.Message, e.Code)
 			}
 		}
 	}
@@ -152,7 +164,7 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 	if lastErr != nil {
 		return fmt.Errorf("credentials validation failed: %w", lastErr)
 	}
-	return fmt.Errorf("credentials validation failed: could not verify API token")
+	return registry.Errorf(registry.CategoryAuth, "credentials validation failed: could not verify API token")
 }
 
 // Deploy deploys a certificate to Cloudflare
@@ -161,18 +173,18 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 
 	apiToken, ok := credentials["api_token"].(string)
 	if !ok || apiToken == "" {
-		return nil, fmt.Errorf("api_token is required")
+		return nil, registry.Errorf(registry.CategoryValidation, "api_token is required")
 	}
 
 	zoneID, ok := config["zone_id"].(string)
 	if !ok || zoneID == "" {
-		return nil, fmt.Errorf("zone_id is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "zone_id is required in config")
 	}
 
 	progressCb(10, "Validating certificate data")
 
 	if cert.CertificatePEM == "" || cert.PrivateKeyPEM == "" {
-		return nil, fmt.Errorf("certificate and private key are required")
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate and private key are required")
 	}
 
 	progressCb(20, "Preparing certificate upload")
@@ -233,12 +245,12 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 
 	apiToken, ok := credentials["api_token"].(string)
 	if !ok || apiToken == "" {
-		return nil, fmt.Errorf("api_token is required")
+		return nil, registry.Errorf(registry.CategoryValidation, "api_token is required")
 	}
 
 	zoneID, ok := config["zone_id"].(string)
 	if !ok || zoneID == "" {
-		return nil, fmt.Errorf("zone_id is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "zone_id is required in config")
 	}
 
 	// Find the certificate
@@ -280,13 +292,148 @@ func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData,
 	}, nil
 }
 
+// Remove deletes the custom certificate of the certificate's hostname from
+// the zone; the zone falls back to its universal certificate
+func (p *Provider) Remove(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
+	startTime := time.Now()
+
+	apiToken, ok := credentials["api_token"].(string)
+	if !ok || apiToken == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "api_token is required")
+	}
+
+	zoneID, ok := config["zone_id"].(string)
+	if !ok || zoneID == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "zone_id is required in config")
+	}
+
+	certID, err := p.findExistingCert(ctx, apiToken, zoneID, cert.CommonName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate: %w", err)
+	}
+	if certID == "" {
+		return &registry.DeploymentResult{
+			Success:    true,
+			Message:    "Certificate not found in Cloudflare zone, nothing to remove",
+			DurationMs: time.Since(startTime).Milliseconds(),
+		}, nil
+	}
+
+	if err := p.deleteCert(ctx, apiToken, zoneID, certID); err != nil {
+		return nil, fmt.Errorf("failed to delete certificate: %w", err)
+	}
+
+	return &registry.DeploymentResult{
+		Success:    true,
+		Message:    "Certificate removed from Cloudflare zone",
+		ResourceID: certID,
+		Details: map[string]any{
+			"zone_id":        zoneID,
+			"certificate_id": certID,
+		},
+		DurationMs: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// Plan reports whether a deployment replaces the custom certificate of the
+// certificate's hostname or uploads a new one
+func (p *Provider) Plan(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) ([]registry.PlannedAction, error) {
+	apiToken, ok := credentials["api_token"].(string)
+	if !ok || apiToken == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "api_token is required")
+	}
+
+	zoneID, ok := config["zone_id"].(string)
+	if !ok || zoneID == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "zone_id is required in config")
+	}
+
+	certID, err := p.findExistingCert(ctx, apiToken, zoneID, cert.CommonName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing certificates: %w", err)
+	}
+	if certID != "" {
+		return []registry.PlannedAction{{
+			Operation: registry.PlanReplace, ResourceType: "custom cert", Resource: certID,
+			Detail: fmt.Sprintf("serving %s in zone %s", cert.CommonName, zoneID),
+		}}, nil
+	}
+	return []registry.PlannedAction{{
+		Operation: registry.PlanCreate, ResourceType: "custom cert", Resource: cert.CommonName,
+		Detail: "in zone " + zoneID,
+	}}, nil
+}
+
+// Inventory lists the custom certificates of the zone. Cloudflare reports
+// hostnames, issuer and expiry but no serials.
+func (p *Provider) Inventory(ctx context.Context, config, credentials map[string]any) ([]registry.InventoryCertificate, error) {
+	apiToken, ok := credentials["api_token"].(string)
+	if !ok || apiToken == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "api_token is required")
+	}
+
+	zoneID, ok := config["zone_id"].(string)
+	if !ok || zoneID == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "zone_id is required in config")
+	}
+
+	certs, err := p.listCustomCerts(ctx, apiToken, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list certificates: %w", err)
+	}
+
+	inventory := make([]registry.InventoryCertificate, 0, len(certs))
+	for _, cert := range certs {
+		item := registry.InventoryCertificate{
+			Name:   cert.ID,
+			SANs:   cert.Hosts,
+			Issuer: cert.Issuer,
+		}
+		if len(cert.Hosts) > 0 {
+			item.CommonName = cert.Hosts[0]
+		}
+		if expiresOn, err := time.Parse(time.RFC3339, cert.ExpiresOn); err == nil {
+			item.ExpiresAt = expiresOn.Unix()
+		}
+		inventory = append(inventory, item)
+	}
+	return inventory, nil
+}
+
+// customCertificate is a custom certificate of a zone
+type customCertificate struct {
+	ID        string   `json:"id"`
+	Hosts     []string `json:"hosts"`
+	Issuer    string   `json:"issuer"`
+	ExpiresOn string   `json:"expires_on"`
+}
+
 // findExistingCert finds an existing custom certificate by hostname
 func (p *Provider) findExistingCert(ctx context.Context, apiToken, zoneID, hostname string) (string, error) {
+	certs, err := p.listCustomCerts(ctx, apiToken, zoneID)
+	if err != nil {
+		return "", err
+	}
+
+	// Find certificate matching the hostname
+	for _, cert := range certs {
+		for _, host := range cert.Hosts {
+			if host == hostname {
+				return cert.ID, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// listCustomCerts lists the custom certificates of a zone
+func (p *Provider) listCustomCerts(ctx context.Context, apiToken, zoneID string) ([]customCertificate, error) {
 	url := fmt.Sprintf("%s/zones/%s/custom_certificates", cloudflareAPIBase, zoneID)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	req.Header.Set("Authorization", "Bearer "+apiToken)
 	req.Header.Set("Content-Type", "application/json")
@@ -294,43 +441,31 @@ func (p *Provider) findExistingCert(ctx context.Context, apiToken, zoneID, hostn
 	client := &http.Client{Timeout: 30 * time.Second}
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	var result struct {
-		Success bool `json:"success"`
-		Result  []struct {
-			ID    string   `json:"id"`
-			Hosts []string `json:"hosts"`
-		} `json:"result"`
-		Errors []struct {
+		Success bool                `json:"success"`
+		Result  []customCertificate `json:"result"`
+		Errors  []struct {
 			Code    int    `json:"code"`
 			Message string `json:"message"`
 		} `json:"errors"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if !result.Success {
 		if len(result.Errors) > 0 {
-			return "", fmt.Errorf("cloudflare API error: %s (code: %d)", result.Errors[0].Message, result.Errors[0].Code)
-		}
-		return "", fmt.Errorf("cloudflare API returned unsuccessful response (HTTP %d)", resp.StatusCode)
-	}
-
-	// Find certificate matching the hostname
-	for _, cert := range result.Result {
-		for _, host := range cert.Hosts {
-			if host == hostname {
-				return cert.ID, nil
-			}
+			return nil, registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API error: %s (code: %d)", result.Errors[0].Message, result.Errors[0].Code)
 		}
+		return nil, registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API returned unsuccessful response (HTTP %d)", resp.StatusCode)
 	}
 
-	return "", nil
+	return result.Result, nil
 }
 
 // uploadCert uploads a new custom certificate
@@ -374,9 +509,9 @@ func (p *Provider) uploadCert(ctx context.Context, apiToken, zoneID, certBundle,
 
 	if !result.Success {
 		if len(result.Errors) > 0 {
-			return "", fmt.Errorf("cloudflare API error: %s", result.Errors[0].Message)
+			return "", registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API error: %s", result.Errors[0].Message)
 		}
-		return "", fmt.Errorf("cloudflare API returned unsuccessful response")
+		return "", registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API returned unsuccessful response (HTTP %d)", resp.StatusCode)
 	}
 
 	return result.Result.ID, nil
@@ -423,10 +558,54 @@ func (p *Provider) updateCert(ctx context.Context, apiToken, zoneID, certID, cer
 
 	if !result.Success {
 		if len(result.Errors) > 0 {
-			return "", fmt.Errorf("cloudflare API error: %s", result.Errors[0].Message)
+			return "", registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API error: %s", result.Errors[0].Message)
 		}
-		return "", fmt.Errorf("cloudflare API returned unsuccessful response")
+		return "", registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API returned unsuccessful response (HTTP %d)", resp.StatusCode)
 	}
 
 	return result.Result.ID, nil
 }
+
+// deleteCert deletes a custom certificate
+func (p *Provider) deleteCert(ctx context.Context, apiToken, zoneID, certID string) error {
+	url := fmt.Sprintf("%s/zones/%s/custom_certificates/%s", cloudflareAPIBase, zoneID, certID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		// Already deleted
+		return nil
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if !result.Success {
+		if len(result.Errors) > 0 {
+			return registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API error: %s", result.Errors[0].Message)
+		}
+		return registry.HTTPError(resp.StatusCode, resp.Header, "cloudflare API returned unsuccessful response (HTTP %d)", resp.StatusCode)
+	}
+
+	return nil
+}
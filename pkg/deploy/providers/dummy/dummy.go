@@ -12,6 +12,31 @@ import (
 
 const ProviderType = "dummy"
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"simulate_delay_ms":        registry.Integer("Simulated deployment time", 0, 600000).WithDefault(float64(1000)),
+	"should_fail":              registry.Boolean("Fail every deployment").WithDefault(false),
+	"fail_message":             registry.String("Error message of failed deployments"),
+	"fail_category":            {Type: "string", Description: "Return failures as provider errors of this category", Enum: categoryEnum()},
+	"fail_retry_after_seconds": registry.Integer("Retry-After of simulated rate_limited failures", 0, 3600),
+	"simulate_progress_steps":  registry.Integer("Number of progress updates", 0, 100).WithDefault(float64(5)),
+})
+
+func categoryEnum() []any {
+	out := make([]any, 0, len(registry.Categories))
+	for _, c := range registry.Categories {
+		out = append(out, string(c))
+	}
+	return out
+}
+
+// credentialSchema accepts any credentials; should_fail fails validation
+var credentialSchema = &registry.Schema{
+	Type: "object",
+	Properties: map[string]*registry.Schema{
+		"should_fail": registry.Boolean("Fail credential validation"),
+	},
+}
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{
@@ -24,9 +49,12 @@ func init() {
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
 			SupportsRollback:     true,
+			SupportsRemoval:      true,
 			RequiredConfigFields: []string{},
 			RequiredCredFields:   []string{},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
 	})
 }
 
@@ -38,6 +66,10 @@ type Config struct {
 	ShouldFail bool `json:"should_fail"`
 	// FailMessage is the error message when ShouldFail is true
 	FailMessage string `json:"fail_message"`
+	// FailCategory, if set, makes failed deployments return a provider error of this category
+	FailCategory registry.ErrorCategory `json:"fail_category"`
+	// FailRetryAfterSeconds is the retry-after of simulated failures
+	FailRetryAfterSeconds int64 `json:"fail_retry_after_seconds"`
 	// SimulateProgressSteps is the number of progress updates to send
 	SimulateProgressSteps int `json:"simulate_progress_steps"`
 }
@@ -132,6 +164,14 @@ func (p *Provider) Deploy(
 		}
 		p.log.Warnf("[DUMMY] Simulating failure: %s", failMsg)
 
+		if cfg.FailCategory != "" {
+			return nil, &registry.Error{
+				Category:   cfg.FailCategory,
+				Message:    failMsg,
+				RetryAfter: time.Duration(cfg.FailRetryAfterSeconds) * time.Second,
+			}
+		}
+
 		return &registry.DeploymentResult{
 			Success:    false,
 			Message:    failMsg,
@@ -269,13 +309,72 @@ func (p *Provider) Rollback(
 	}, nil
 }
 
+// Remove simulates removing a deployed certificate
+func (p *Provider) Remove(
+	ctx context.Context,
+	cert *registry.CertificateData,
+	config map[string]any,
+	credentials map[string]any,
+) (*registry.DeploymentResult, error) {
+	startTime := time.Now()
+
+	cfg := p.parseConfig(config)
+
+	p.log.Infof("[DUMMY] Removing certificate: %s", cert.ID)
+
+	// Simulate delay
+	delay := time.Duration(cfg.SimulateDelayMs) * time.Millisecond
+	if delay < 100*time.Millisecond {
+		delay = 100 * time.Millisecond
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(delay / 2):
+	}
+
+	if cfg.ShouldFail {
+		failMsg := cfg.FailMessage
+		if failMsg == "" {
+			failMsg = "Simulated removal failure"
+		}
+		if cfg.FailCategory != "" {
+			return nil, &registry.Error{
+				Category:   cfg.FailCategory,
+				Message:    failMsg,
+				RetryAfter: time.Duration(cfg.FailRetryAfterSeconds) * time.Second,
+			}
+		}
+		return &registry.DeploymentResult{
+			Success:    false,
+			Message:    failMsg,
+			DurationMs: time.Since(startTime).Milliseconds(),
+		}, nil
+	}
+
+	p.log.Infof("[DUMMY] Removal successful for certificate: %s", cert.ID)
+
+	return &registry.DeploymentResult{
+		Success:    true,
+		Message:    "Certificate removed successfully (dummy)",
+		DurationMs: time.Since(startTime).Milliseconds(),
+		Details: map[string]any{
+			"provider":       ProviderType,
+			"certificate_id": cert.ID,
+			"removed":        true,
+			"simulated":      true,
+		},
+	}, nil
+}
+
 // ValidateCredentials validates the provided credentials
 func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config map[string]any) error {
 	p.log.Infof("[DUMMY] Validating credentials: %v", getKeys(credentials))
 
 	// Check if should_fail is set in credentials for testing
 	if shouldFail, ok := credentials["should_fail"].(bool); ok && shouldFail {
-		return fmt.Errorf("simulated credential validation failure")
+		return registry.Errorf(registry.CategoryAuth, "simulated credential validation failure")
 	}
 
 	// Always succeed otherwise
@@ -288,6 +387,7 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
 		SupportsRollback:     true,
+		SupportsRemoval:      true,
 		RequiredConfigFields: []string{},
 		RequiredCredFields:   []string{},
 	}
@@ -322,6 +422,14 @@ func (p *Provider) parseConfig(config map[string]any) *Config {
 		cfg.FailMessage = v
 	}
 
+	if v, ok := config["fail_category"].(string); ok {
+		cfg.FailCategory = registry.ErrorCategory(v)
+	}
+
+	if v, ok := config["fail_retry_after_seconds"].(float64); ok {
+		cfg.FailRetryAfterSeconds = int64(v)
+	}
+
 	if v, ok := config["simulate_progress_steps"].(float64); ok {
 		cfg.SimulateProgressSteps = int(v)
 	} else if v, ok := config["simulate_progress_steps"].(int); ok {
@@ -2,6 +2,7 @@ package fortigate
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/tls"
 	"encoding/base64"
@@ -9,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,6 +21,18 @@ const (
 	ProviderType = "fortigate"
 )
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"vdom":              registry.String("Virtual domain the certificate is imported into").WithDefault("root"),
+	"vips":              {Type: "array", Description: "Firewall virtual IPs whose SSL certificate is switched to each deployed certificate", Items: &registry.Schema{Type: "string", MinLength: registry.Int(1)}},
+	"versioned_objects": registry.Boolean("Import a new local certificate named after the serial on every deployment").WithDefault(false),
+	"retain_versions":   registry.Integer("Versions kept in versioned mode, including the deployed one", 1, 100).WithDefault(float64(registry.DefaultRetainVersions)),
+})
+
+var credentialSchema = registry.Object(map[string]*registry.Schema{
+	"host":      {Type: "string", Description: "Management address of the FortiGate", Format: "hostname"},
+	"api_token": registry.Secret("REST API administrator token"),
+}, "host", "api_token")
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{}
@@ -28,10 +42,14 @@ func init() {
 		Description: "Deploy SSL/TLS certificates to FortiGate firewalls via REST API",
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
-			SupportsRollback:     true,
+			SupportsRollback:     false,
+			SupportsRemoval:      true,
 			RequiredConfigFields: []string{"vdom"},
 			RequiredCredFields:   []string{"host", "api_token"},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
+		HostField:        "host",
 	})
 }
 
@@ -42,7 +60,8 @@ type Provider struct{}
 func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
-		SupportsRollback:     true,
+		SupportsRollback:     false,
+		SupportsRemoval:      true,
 		RequiredConfigFields: []string{"vdom"},
 		RequiredCredFields:   []string{"host", "api_token"},
 	}
@@ -52,12 +71,12 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config map[string]any) error {
 	host, ok := credentials["host"].(string)
 	if !ok || host == "" {
-		return fmt.Errorf("host is required")
+		return registry.Errorf(registry.CategoryValidation, "host is required")
 	}
 
 	apiToken, ok := credentials["api_token"].(string)
 	if !ok || apiToken == "" {
-		return fmt.Errorf("api_token is required")
+		return registry.Errorf(registry.CategoryValidation, "api_token is required")
 	}
 
 	vdom := "root"
@@ -83,11 +102,11 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 401 || resp.StatusCode == 403 {
-		return fmt.Errorf("authentication failed: invalid API token")
+		return registry.Errorf(registry.CategoryAuth, "authentication failed: invalid API token")
 	}
 	if resp.StatusCode != 200 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("FortiGate API error (HTTP %d): %s", resp.StatusCode, string(body))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "FortiGate API error (HTTP %d): %s", resp.StatusCode, string(body))
 	}
 
 	return nil
@@ -110,16 +129,13 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 		vdom = v
 	}
 
-	// Generate certificate name from common name
-	certName := sanitizeName(cert.CommonName)
-	if certName == "" {
-		certName = fmt.Sprintf("cert-%s", cert.ID[:8])
-	}
+	// Generate the certificate name from the common name, and serial in versioned mode
+	certName := objectName(cert, config)
 
 	progressCb(10, "Validating certificate data")
 
 	if cert.CertificatePEM == "" || cert.PrivateKeyPEM == "" {
-		return nil, fmt.Errorf("certificate and private key are required")
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate and private key are required")
 	}
 
 	client := p.createHTTPClient()
@@ -155,8 +171,39 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 
 	// Verify the certificate was uploaded
 	exists, err = p.certExists(ctx, client, host, apiToken, vdom, certName)
-	if err != nil || !exists {
-		return nil, fmt.Errorf("verification failed: certificate not found after upload")
+	if err != nil {
+		return nil, fmt.Errorf("verification failed: %w", err)
+	}
+	if !exists {
+		return nil, registry.Errorf(registry.CategoryNotFound, "verification failed: certificate not found after upload")
+	}
+
+	// Each VIP switches to the new certificate in a single update
+	vips := stringList(config["vips"])
+	for _, vip := range vips {
+		if err := p.switchVIPCertificate(ctx, client, host, apiToken, vdom, vip, certName); err != nil {
+			return nil, fmt.Errorf("failed to switch virtual IP %s: %w", vip, err)
+		}
+	}
+
+	details := map[string]any{
+		"host":             host,
+		"vdom":             vdom,
+		"certificate_name": certName,
+		"was_update":       exists,
+	}
+	if len(vips) > 0 {
+		details["vips"] = strings.Join(vips, ",")
+	}
+
+	// Only the verified version and the newest older ones stay
+	if versioned, retain := registry.Versioning(config); versioned {
+		progressCb(95, "Deleting superseded certificate versions")
+		pruned, err := p.pruneVersions(ctx, client, host, apiToken, vdom, baseName(cert), certName, retain-1)
+		details["deleted_versions"] = strings.Join(pruned, ",")
+		if err != nil {
+			details["prune_error"] = err.Error()
+		}
 	}
 
 	progressCb(100, "Deployment complete")
@@ -165,12 +212,7 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 		Success:    true,
 		Message:    "Certificate deployed successfully to FortiGate",
 		ResourceID: certName,
-		Details: map[string]any{
-			"host":             host,
-			"vdom":             vdom,
-			"certificate_name": certName,
-			"was_update":       exists,
-		},
+		Details:    details,
 		DurationMs: time.Since(startTime).Milliseconds(),
 	}, nil
 }
@@ -191,28 +233,43 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 		vdom = v
 	}
 
-	certName := sanitizeName(cert.CommonName)
-	if certName == "" {
-		certName = fmt.Sprintf("cert-%s", cert.ID[:8])
-	}
+	certName := objectName(cert, config)
 
 	client := p.createHTTPClient()
 
-	exists, err := p.certExists(ctx, client, host, apiToken, vdom, certName)
-	if err != nil {
+	// A device that cannot be asked is not evidence of a missing certificate
+	var result struct {
+		Results []localCertificate `json:"results"`
+	}
+	err := p.getResource(ctx, client, host, apiToken, vdom, "certificate/local/"+certName, "", &result)
+	if registry.Classify(err) == registry.CategoryNotFound || (err == nil && len(result.Results) == 0) {
 		return &registry.DeploymentResult{
 			Success:    false,
-			Message:    fmt.Sprintf("Failed to verify: %v", err),
+			Message:    fmt.Sprintf("Certificate %s not found on FortiGate", certName),
 			DurationMs: time.Since(startTime).Milliseconds(),
 		}, nil
 	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify certificate %s: %w", certName, err)
+	}
 
-	if !exists {
-		return &registry.DeploymentResult{
-			Success:    false,
-			Message:    "Certificate not found on FortiGate",
-			DurationMs: time.Since(startTime).Milliseconds(),
-		}, nil
+	// The certificate may have been replaced in place under the same name
+	if pem := result.Results[0].Certificate; pem != "" {
+		observed, err := registry.InventoryFromPEM(certName, pem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify certificate %s: %w", certName, err)
+		}
+		if registry.SerialMismatch(cert.SerialNumber, observed.SerialNumber) {
+			return &registry.DeploymentResult{
+				Success: false,
+				Message: fmt.Sprintf("Certificate %s has serial %s, expected %s", certName, observed.SerialNumber, cert.SerialNumber),
+				Details: map[string]any{
+					"certificate_name": certName,
+					"observed_serial":  observed.SerialNumber,
+				},
+				DurationMs: time.Since(startTime).Milliseconds(),
+			}, nil
+		}
 	}
 
 	return &registry.DeploymentResult{
@@ -228,8 +285,19 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 	}, nil
 }
 
-// Rollback removes a deployed certificate
+// Rollback is not supported for FortiGate: the previous certificate is not
+// kept on the device. Use Remove to delete a deployed certificate.
 func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
+	return &registry.DeploymentResult{
+		Success:    false,
+		Message:    "Rollback is not supported for FortiGate provider",
+		DurationMs: 0,
+	}, nil
+}
+
+// Remove deletes a deployed local certificate. It refuses while firewall
+// policies, VIPs, SSL inspection profiles or other objects reference it.
+func (p *Provider) Remove(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
 	startTime := time.Now()
 
 	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
@@ -244,31 +312,156 @@ func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData,
 		vdom = v
 	}
 
-	certName := sanitizeName(cert.CommonName)
-	if certName == "" {
-		certName = fmt.Sprintf("cert-%s", cert.ID[:8])
-	}
+	certName := objectName(cert, config)
 
 	client := p.createHTTPClient()
 
+	refs, err := p.certReferences(ctx, client, host, apiToken, vdom, certName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check references: %w", err)
+	}
+	if refs > 0 {
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate %s is still referenced by %d configuration object(s)", certName, refs)
+	}
+
 	if err := p.deleteCertificate(ctx, client, host, apiToken, vdom, certName); err != nil {
-		return &registry.DeploymentResult{
-			Success:    false,
-			Message:    fmt.Sprintf("Rollback failed: %v", err),
-			DurationMs: time.Since(startTime).Milliseconds(),
-		}, nil
+		return nil, fmt.Errorf("failed to delete certificate: %w", err)
+	}
+
+	details := map[string]any{
+		"deleted_cert": certName,
+	}
+
+	// Versions retained for rollback go as well
+	if versioned, _ := registry.Versioning(config); versioned {
+		pruned, err := p.pruneVersions(ctx, client, host, apiToken, vdom, baseName(cert), certName, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete retained versions: %w", err)
+		}
+		details["deleted_versions"] = strings.Join(pruned, ",")
 	}
 
 	return &registry.DeploymentResult{
 		Success:    true,
 		Message:    "Certificate removed from FortiGate",
 		DurationMs: time.Since(startTime).Milliseconds(),
-		Details: map[string]any{
-			"deleted_cert": certName,
-		},
+		Details:    details,
 	}, nil
 }
 
+// Plan reports whether a deployment imports or replaces the local
+// certificate, which virtual IPs it switches and the versions it deletes
+func (p *Provider) Plan(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) ([]registry.PlannedAction, error) {
+	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
+		return nil, err
+	}
+
+	host := credentials["host"].(string)
+	apiToken := credentials["api_token"].(string)
+
+	vdom := "root"
+	if v, ok := config["vdom"].(string); ok && v != "" {
+		vdom = v
+	}
+
+	certName := objectName(cert, config)
+
+	client := p.createHTTPClient()
+
+	exists, err := p.certExists(ctx, client, host, apiToken, vdom, certName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing certificate: %w", err)
+	}
+	action := registry.PlannedAction{Operation: registry.PlanCreate, ResourceType: "local certificate", Resource: certName}
+	if exists {
+		// Updates delete and re-import the certificate
+		action.Operation = registry.PlanReplace
+		refs, err := p.certReferences(ctx, client, host, apiToken, vdom, certName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check references: %w", err)
+		}
+		if refs > 0 {
+			action.Detail = fmt.Sprintf("referenced by %d configuration object(s)", refs)
+		}
+	}
+	actions := []registry.PlannedAction{action}
+
+	for _, vip := range stringList(config["vips"]) {
+		current, err := p.vipCertificate(ctx, client, host, apiToken, vdom, vip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read virtual IP %s: %w", vip, err)
+		}
+		action := registry.PlannedAction{Operation: registry.PlanSwitch, ResourceType: "vip", Resource: vip, Detail: "from " + current + " to " + certName}
+		if current == certName {
+			action = registry.PlannedAction{Operation: registry.PlanUnchanged, ResourceType: "vip", Resource: vip, Detail: "already uses " + certName}
+		}
+		actions = append(actions, action)
+	}
+
+	if versioned, retain := registry.Versioning(config); versioned {
+		stale, err := p.staleVersions(ctx, client, host, apiToken, vdom, baseName(cert), certName, retain-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list certificate versions: %w", err)
+		}
+		for _, name := range stale {
+			actions = append(actions, registry.PlannedAction{
+				Operation: registry.PlanDelete, ResourceType: "local certificate", Resource: name, Detail: "superseded version",
+			})
+		}
+	}
+
+	return actions, nil
+}
+
+// Inventory lists the local certificates of the vdom (vpn.certificate local)
+// with the virtual IPs using them. Factory certificates are left out.
+func (p *Provider) Inventory(ctx context.Context, config, credentials map[string]any) ([]registry.InventoryCertificate, error) {
+	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
+		return nil, err
+	}
+
+	host := credentials["host"].(string)
+	apiToken := credentials["api_token"].(string)
+
+	vdom := "root"
+	if v, ok := config["vdom"].(string); ok && v != "" {
+		vdom = v
+	}
+
+	client := p.createHTTPClient()
+
+	certs, err := p.listLocalCertificates(ctx, client, host, apiToken, vdom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list certificates: %w", err)
+	}
+	vips, err := p.vipReferences(ctx, client, host, apiToken, vdom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual IPs: %w", err)
+	}
+
+	var inventory []registry.InventoryCertificate
+	for _, c := range certs {
+		if c.Source == "factory" {
+			continue
+		}
+		item := registry.InventoryCertificate{Name: c.Name}
+		if c.Certificate != "" {
+			parsed, err := registry.InventoryFromPEM(c.Name, c.Certificate)
+			if err != nil {
+				return nil, err
+			}
+			item = parsed
+		}
+		item.References = vips[c.Name]
+		// Policies, SSL inspection profiles and other objects are only counted
+		if others := c.QRef - len(item.References); others > 0 {
+			item.References = append(item.References, fmt.Sprintf("%d other object(s)", others))
+		}
+		inventory = append(inventory, item)
+	}
+	return inventory, nil
+}
+
 // createHTTPClient creates an HTTP client for FortiGate API calls
 func (p *Provider) createHTTPClient() *http.Client {
 	return &http.Client{
@@ -303,12 +496,53 @@ func (p *Provider) certExists(ctx context.Context, client *http.Client, host, ap
 	}
 	if resp.StatusCode != 200 {
 		body, _ := io.ReadAll(resp.Body)
-		return false, fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(body))
+		return false, registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(body))
 	}
 
 	return true, nil
 }
 
+// certReferences returns how many configuration objects reference a local
+// certificate; a missing certificate has none
+func (p *Provider) certReferences(ctx context.Context, client *http.Client, host, apiToken, vdom, name string) (int, error) {
+	url := fmt.Sprintf("https://%s/api/v2/cmdb/certificate/local/%s?vdom=%s&with_meta=1", host, name, vdom)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		return 0, nil
+	}
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return 0, registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Results []struct {
+			QRef int `json:"q_ref"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	refs := 0
+	for _, r := range result.Results {
+		refs += r.QRef
+	}
+	return refs, nil
+}
+
 // uploadCertificate uploads a new certificate to FortiGate
 func (p *Provider) uploadCertificate(ctx context.Context, client *http.Client, host, apiToken, vdom, name, certPEM, keyPEM string) error {
 	url := fmt.Sprintf("https://%s/api/v2/monitor/vpn-certificate/local/import?vdom=%s", host, vdom)
@@ -338,7 +572,7 @@ func (p *Provider) uploadCertificate(ctx context.Context, client *http.Client, h
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -382,12 +616,229 @@ func (p *Provider) deleteCertificate(ctx context.Context, client *http.Client, h
 	}
 	if resp.StatusCode != 200 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
 }
 
+// switchVIPCertificate points the SSL certificate of a firewall virtual IP
+// at a local certificate
+func (p *Provider) switchVIPCertificate(ctx context.Context, client *http.Client, host, apiToken, vdom, vip, certName string) error {
+	url := fmt.Sprintf("https://%s/api/v2/cmdb/firewall/vip/%s?vdom=%s", host, vip, vdom)
+
+	body, _ := json.Marshal(map[string]any{"ssl-certificate": certName})
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// vipCertificate returns the SSL certificate a firewall virtual IP uses
+func (p *Provider) vipCertificate(ctx context.Context, client *http.Client, host, apiToken, vdom, vip string) (string, error) {
+	url := fmt.Sprintf("https://%s/api/v2/cmdb/firewall/vip/%s?vdom=%s", host, vip, vdom)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return "", registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Results []struct {
+			SSLCertificate string `json:"ssl-certificate"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	if len(result.Results) == 0 {
+		return "", registry.Errorf(registry.CategoryNotFound, "virtual IP %s not found", vip)
+	}
+	return result.Results[0].SSLCertificate, nil
+}
+
+// localCertificate is a local certificate as listed with metadata
+type localCertificate struct {
+	Name        string `json:"name"`
+	Source      string `json:"source"`
+	Certificate string `json:"certificate"`
+	QRef        int    `json:"q_ref"`
+	LastUpdated int64  `json:"last-updated"`
+}
+
+// listLocalCertificates lists the local certificates of vdom with metadata
+func (p *Provider) listLocalCertificates(ctx context.Context, client *http.Client, host, apiToken, vdom string) ([]localCertificate, error) {
+	var result struct {
+		Results []localCertificate `json:"results"`
+	}
+	if err := p.getResource(ctx, client, host, apiToken, vdom, "certificate/local", "&with_meta=1", &result); err != nil {
+		return nil, err
+	}
+	return result.Results, nil
+}
+
+// vipReferences maps local certificates to the firewall virtual IPs using them
+func (p *Provider) vipReferences(ctx context.Context, client *http.Client, host, apiToken, vdom string) (map[string][]string, error) {
+	var result struct {
+		Results []struct {
+			Name           string `json:"name"`
+			SSLCertificate string `json:"ssl-certificate"`
+		} `json:"results"`
+	}
+	if err := p.getResource(ctx, client, host, apiToken, vdom, "firewall/vip", "", &result); err != nil {
+		return nil, err
+	}
+	refs := make(map[string][]string)
+	for _, vip := range result.Results {
+		if vip.SSLCertificate != "" {
+			refs[vip.SSLCertificate] = append(refs[vip.SSLCertificate], "vip "+vip.Name)
+		}
+	}
+	return refs, nil
+}
+
+// getResource reads a CMDB table of vdom into out; query is appended to the
+// URL's query string
+func (p *Provider) getResource(ctx context.Context, client *http.Client, host, apiToken, vdom, path, query string, out any) error {
+	url := fmt.Sprintf("https://%s/api/v2/cmdb/%s?vdom=%s%s", host, path, vdom, query)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return registry.HTTPError(resp.StatusCode, resp.Header, "API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// pruneVersions deletes the versioned local certificates of baseName other
+// than current, except the keep most recently updated ones and those still
+// referenced. It returns the deleted certificates.
+func (p *Provider) pruneVersions(ctx context.Context, client *http.Client, host, apiToken, vdom, baseName, current string, keep int) ([]string, error) {
+	stale, err := p.staleVersions(ctx, client, host, apiToken, vdom, baseName, current, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []string
+	for _, name := range stale {
+		if err := p.deleteCertificate(ctx, client, host, apiToken, vdom, name); err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, name)
+	}
+	return pruned, nil
+}
+
+// staleVersions returns the local certificates pruneVersions deletes
+func (p *Provider) staleVersions(ctx context.Context, client *http.Client, host, apiToken, vdom, baseName, current string, keep int) ([]string, error) {
+	certs, err := p.listLocalCertificates(ctx, client, host, apiToken, vdom)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []localCertificate
+	for _, c := range certs {
+		version, ok := strings.CutPrefix(c.Name, versionPrefix(baseName))
+		if ok && c.Name != current && registry.IsObjectVersion(version) {
+			versions = append(versions, c)
+		}
+	}
+	// Newest first
+	slices.SortFunc(versions, func(a, b localCertificate) int { return cmp.Compare(b.LastUpdated, a.LastUpdated) })
+
+	var stale []string
+	for i, c := range versions {
+		if i >= keep && c.QRef == 0 {
+			stale = append(stale, c.Name)
+		}
+	}
+	return stale, nil
+}
+
+// baseName returns the name of cert's local certificate without version
+func baseName(cert *registry.CertificateData) string {
+	name := sanitizeName(cert.CommonName)
+	if name == "" {
+		name = fmt.Sprintf("cert-%s", cert.ID[:min(len(cert.ID), 8)])
+	}
+	return name
+}
+
+// versionPrefix returns the part of versioned names before the version,
+// shortened so that names stay within FortiGate's 35-character limit
+func versionPrefix(baseName string) string {
+	const maxBase = 35 - 1 - 8
+	if len(baseName) > maxBase {
+		baseName = strings.TrimRight(baseName[:maxBase], "_")
+	}
+	return baseName + "_"
+}
+
+// objectName returns the name of cert's local certificate, suffixed with the
+// certificate version in versioned mode
+func objectName(cert *registry.CertificateData, config map[string]any) string {
+	name := baseName(cert)
+	if versioned, _ := registry.Versioning(config); versioned {
+		if version := registry.ObjectVersion(cert); version != "" {
+			name = versionPrefix(name) + version
+		}
+	}
+	return name
+}
+
+// stringList returns the strings of a config list
+func stringList(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok && s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
 // sanitizeName converts a common name to a valid FortiGate resource name.
 // Uses the same rules as the BIG-IP provider so the same certificate ends up
 // with a consistent identifier across devices:
@@ -1,8 +1,23 @@
 package fortigate
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/go-tangra/go-tangra-deployer/pkg/deploy/registry"
 )
 
 func TestSanitizeName(t *testing.T) {
@@ -40,3 +55,131 @@ func TestSanitizeName(t *testing.T) {
 		})
 	}
 }
+
+func testCertificatePEM(t *testing.T, serial int64) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestVerifyComparesSerials(t *testing.T) {
+	var mu sync.Mutex
+	status, certPEM := http.StatusOK, testCertificatePEM(t, 0x0a1b)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/api/v2/cmdb/system/global":
+			_, _ = w.Write([]byte(`{}`))
+		case "/api/v2/cmdb/certificate/local/www_example_com":
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"results": []map[string]any{{"name": "www_example_com", "certificate": certPEM}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p := &Provider{}
+	cert := &registry.CertificateData{CommonName: "www.example.com", SerialNumber: "0A:1B"}
+	config := map[string]any{}
+	credentials := map[string]any{"host": server.Listener.Addr().String(), "api_token": "token"}
+
+	if result, err := p.Verify(context.Background(), cert, config, credentials); err != nil || !result.Success {
+		t.Fatalf("Verify with matching serial: %v, %+v", err, result)
+	}
+
+	// Another certificate under the same name
+	mu.Lock()
+	certPEM = testCertificatePEM(t, 0xff)
+	mu.Unlock()
+	result, err := p.Verify(context.Background(), cert, config, credentials)
+	if err != nil || result.Success || result.Details["observed_serial"] != "ff" {
+		t.Fatalf("Verify with other serial: %v, %+v", err, result)
+	}
+
+	mu.Lock()
+	status = http.StatusNotFound
+	mu.Unlock()
+	if result, err := p.Verify(context.Background(), cert, config, credentials); err != nil || result.Success {
+		t.Fatalf("Verify of a missing certificate: %v, %+v", err, result)
+	}
+
+	// A failing device is inconclusive, not drift
+	mu.Lock()
+	status = http.StatusInternalServerError
+	mu.Unlock()
+	if result, err := p.Verify(context.Background(), cert, config, credentials); err == nil {
+		t.Fatalf("Verify with a failing device: %+v", result)
+	}
+}
+
+func TestPruneVersionsKeepsNewestAndReferencedVersions(t *testing.T) {
+	p := &Provider{}
+	cert := &registry.CertificateData{CommonName: "portal.customer-services.example.com", SerialNumber: "05"}
+	base := baseName(cert)
+	// The base name is cut so that base, separator and version fit 35 characters
+	name := objectName(cert, map[string]any{"versioned_objects": true})
+	if base != "portal_customer-services_example_co" || name != "portal_customer-services_e_00000005" {
+		t.Fatalf("base %q, objectName %q", base, name)
+	}
+	version := func(v string) string { return "portal_customer-services_e_" + v }
+
+	// Retention follows last-updated, not the version order; version 3 is
+	// still used by a profile outside the deployer's control
+	certs := []localCertificate{
+		{Name: version("00000001"), LastUpdated: 100},
+		{Name: version("00000003"), LastUpdated: 300, QRef: 1},
+		{Name: name, LastUpdated: 500},
+		{Name: version("00000004"), LastUpdated: 400},
+		{Name: version("00000002"), LastUpdated: 450},
+		{Name: base, LastUpdated: 50},
+		{Name: version("chain"), LastUpdated: 10},
+		{Name: "www_example_com_00000001", LastUpdated: 10},
+	}
+
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v2/cmdb/certificate/local/"))
+		case r.URL.Path == "/api/v2/cmdb/certificate/local" && r.URL.Query().Get("with_meta") == "1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": certs})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// The deployed version 5 and the most recently updated older one (2) stay
+	pruned, err := p.pruneVersions(context.Background(), p.createHTTPClient(), server.Listener.Addr().String(), "token",
+		"root", base, name, 1)
+	if err != nil {
+		t.Fatalf("pruneVersions: %v", err)
+	}
+	want := []string{version("00000004"), version("00000001")}
+	if strings.Join(pruned, ",") != strings.Join(want, ",") {
+		t.Fatalf("pruned %v, want %v", pruned, want)
+	}
+	if strings.Join(deleted, ",") != strings.Join(want, ",") {
+		t.Fatalf("deleted %v, want %v", deleted, want)
+	}
+}
@@ -20,11 +20,12 @@ import (
 )
 
 // ErrNoPusher is returned when the package-level pusher has not been wired.
-// This happens when the provider's Deploy/Verify/Rollback is called before
+// This happens when the provider's Deploy/Verify/Remove is called before
 // SetPusher is invoked during application bootstrap.
 var ErrNoPusher = errors.New("tangra-client provider: pusher not initialized — call SetPusher() during bootstrap")
 
-// PushResult is returned per target client by Pusher.PushCertificate.
+// PushResult is returned per target client by Pusher.PushCertificate and
+// Pusher.RemoveCertificate.
 type PushResult struct {
 	ClientID string
 	Success  bool
@@ -62,6 +63,11 @@ type Pusher interface {
 	// success counts as success.
 	PushCertificate(ctx context.Context, clientIDs []string, certName string, cert *registry.CertificateData) ([]PushResult, error)
 
+	// RemoveCertificate emits one certificate.removed event per client_id
+	// asking the agent to uninstall `certName`. Returns a per-client result
+	// list like PushCertificate.
+	RemoveCertificate(ctx context.Context, clientIDs []string, certName string, cert *registry.CertificateData) ([]PushResult, error)
+
 	// VerifyInstalled queries LCM for the agent-reported install state of
 	// `certName` on each of `clientIDs`. Clients that have not reported are
 	// represented by an entry with Status="UNKNOWN". Used by Provider.Verify
@@ -19,6 +19,17 @@ import (
 // ProviderType is the registry key for this provider.
 const ProviderType = "tangra-client"
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"client_ids":          {Type: "array", Description: "Registered tangra-client IDs to deliver to", Items: &registry.Schema{Type: "string", MinLength: registry.Int(1)}},
+	"client_id":           registry.String("Single tangra-client ID, added to client_ids"),
+	"labels":              registry.StringMap("Client metadata selector, used when no client IDs are given (all pairs must match)"),
+	"cert_name":           registry.String("Certificate name advertised to the agent (default: common name)"),
+	"require_all_success": registry.Boolean("Fail the deployment when any client push fails").WithDefault(false),
+})
+
+// credentialSchema accepts no credentials: pushes use the deployer's mTLS identity
+var credentialSchema = registry.Object(map[string]*registry.Schema{})
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{
@@ -31,9 +42,12 @@ func init() {
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
 			SupportsRollback:     false,
+			SupportsRemoval:      true,
 			RequiredConfigFields: []string{},
 			RequiredCredFields:   []string{},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
 	})
 }
 
@@ -69,6 +83,7 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
 		SupportsRollback:     false,
+		SupportsRemoval:      true,
 		RequiredConfigFields: []string{},
 		RequiredCredFields:   []string{},
 	}
@@ -83,7 +98,7 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 		return err
 	}
 	if len(cfg.ClientIDs) == 0 && len(cfg.Labels) == 0 {
-		return fmt.Errorf("tangra-client config requires either 'client_ids' or 'labels'")
+		return registry.Errorf(registry.CategoryValidation, "tangra-client config requires either 'client_ids' or 'labels'")
 	}
 	return nil
 }
@@ -100,10 +115,10 @@ func (p *Provider) Deploy(
 
 	cfg, err := parseConfig(config)
 	if err != nil {
-		return nil, fmt.Errorf("invalid config: %w", err)
+		return nil, registry.Wrap(registry.CategoryValidation, err, "invalid config")
 	}
 	if cert == nil || cert.CertificatePEM == "" {
-		return nil, fmt.Errorf("certificate PEM is required for tangra-client deployment")
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate PEM is required for tangra-client deployment")
 	}
 
 	pu, err := getPusher()
@@ -205,10 +220,10 @@ func (p *Provider) Verify(
 
 	cfg, err := parseConfig(config)
 	if err != nil {
-		return nil, fmt.Errorf("invalid config: %w", err)
+		return nil, registry.Wrap(registry.CategoryValidation, err, "invalid config")
 	}
 	if cert == nil || cert.CertificatePEM == "" {
-		return nil, fmt.Errorf("certificate PEM is required for tangra-client verification")
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate PEM is required for tangra-client verification")
 	}
 
 	pu, err := getPusher()
@@ -345,6 +360,91 @@ func (p *Provider) Rollback(
 	}, nil
 }
 
+// Remove asks each target tangra-client to uninstall the certificate. Like
+// Deploy, delivery is reported per client and partial success counts as
+// success unless require_all_success is set.
+func (p *Provider) Remove(
+	ctx context.Context,
+	cert *registry.CertificateData,
+	config map[string]any,
+	credentials map[string]any,
+) (*registry.DeploymentResult, error) {
+	start := time.Now()
+
+	cfg, err := parseConfig(config)
+	if err != nil {
+		return nil, registry.Wrap(registry.CategoryValidation, err, "invalid config")
+	}
+
+	certName := cfg.CertName
+	if certName == "" && cert != nil {
+		certName = cert.CommonName
+	}
+	if certName == "" {
+		return nil, registry.Errorf(registry.CategoryValidation, "certificate name or common name is required for tangra-client removal")
+	}
+
+	pu, err := getPusher()
+	if err != nil {
+		return nil, err
+	}
+
+	clientIDs, err := pu.ResolveClients(ctx, cfg.ClientIDs, cfg.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("resolve clients: %w", err)
+	}
+	if len(clientIDs) == 0 {
+		return &registry.DeploymentResult{
+			Success:    true,
+			Message:    "no tangra-clients matched the target configuration, nothing to remove",
+			DurationMs: time.Since(start).Milliseconds(),
+		}, nil
+	}
+
+	p.log.Infof("[tangra-client] Removing certificate %q from %d client(s)", certName, len(clientIDs))
+
+	results, err := pu.RemoveCertificate(ctx, clientIDs, certName, cert)
+	if err != nil {
+		return nil, fmt.Errorf("remove certificate: %w", err)
+	}
+
+	successCount := 0
+	var failedSummary []string
+	for _, r := range results {
+		if r.Success {
+			successCount++
+			continue
+		}
+		failedSummary = append(failedSummary, fmt.Sprintf("%s: %s", r.ClientID, r.Message))
+	}
+
+	details := map[string]any{
+		"provider":   ProviderType,
+		"total":      len(results),
+		"succeeded":  successCount,
+		"failed":     len(results) - successCount,
+		"client_ids": clientIDs,
+		"cert_name":  certName,
+	}
+
+	if successCount < len(results) && (cfg.RequireAllSuccess || successCount == 0) {
+		return &registry.DeploymentResult{
+			Success:    false,
+			Message:    fmt.Sprintf("Failed to reach %d/%d client(s): %s", len(results)-successCount, len(results), strings.Join(failedSummary, "; ")),
+			Details:    details,
+			DurationMs: time.Since(start).Milliseconds(),
+		}, nil
+	}
+
+	return &registry.DeploymentResult{
+		Success:    true,
+		Message:    fmt.Sprintf("Removal requested from %d/%d tangra-client(s)", successCount, len(results)),
+		ResourceID: strings.Join(clientIDs, ","),
+		Details:    details,
+		DurationMs: time.Since(start).Milliseconds(),
+	}, nil
+}
+
 // parseConfig coerces the loose map produced by the proto Struct into a typed
 // Config. Unknown fields are ignored.
 func parseConfig(raw map[string]any) (*Config, error) {
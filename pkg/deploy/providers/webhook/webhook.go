@@ -18,6 +18,24 @@ const (
 	ProviderType = "webhook"
 )
 
+var configSchema = registry.Object(map[string]*registry.Schema{
+	"url":             {Type: "string", Description: "Endpoint receiving deploy requests", Format: "uri"},
+	"verify_url":      {Type: "string", Description: "Endpoint receiving verify requests (default: url)", Format: "uri"},
+	"rollback_url":    {Type: "string", Description: "Endpoint receiving rollback requests (default: url)", Format: "uri"},
+	"remove_url":      {Type: "string", Description: "Endpoint receiving remove requests (default: url)", Format: "uri"},
+	"headers":         registry.StringMap("Additional HTTP headers"),
+	"metadata":        registry.StringMap("Metadata included in every payload"),
+	"timeout_seconds": registry.Integer("Request timeout", 1, 600).WithDefault(float64(60)),
+	"skip_tls_verify": registry.Boolean("Skip verification of the endpoint's TLS certificate").WithDefault(false),
+}, "url")
+
+var credentialSchema = registry.Object(map[string]*registry.Schema{
+	"authorization": registry.Secret("Value of the Authorization header"),
+	"api_key":       registry.Secret("Value of the X-API-Key header (when authorization is unset)"),
+	"token":         registry.Secret("Bearer token (when authorization and api_key are unset)"),
+	"secret":        registry.Secret("Value of the X-Webhook-Secret header"),
+})
+
 func init() {
 	registry.Register(ProviderType, func() registry.Provider {
 		return &Provider{}
@@ -28,9 +46,12 @@ func init() {
 		Caps: &registry.ProviderCapabilities{
 			SupportsVerification: true,
 			SupportsRollback:     true,
+			SupportsRemoval:      true,
 			RequiredConfigFields: []string{"url"},
 			RequiredCredFields:   []string{},
 		},
+		ConfigSchema:     configSchema,
+		CredentialSchema: credentialSchema,
 	})
 }
 
@@ -39,7 +60,7 @@ type Provider struct{}
 
 // WebhookPayload is the payload sent to the webhook endpoint
 type WebhookPayload struct {
-	Action           string            `json:"action"` // deploy, verify, rollback
+	Action           string            `json:"action"` // deploy, verify, rollback, remove
 	CertificateID    string            `json:"certificate_id"`
 	SerialNumber     string            `json:"serial_number"`
 	CommonName       string            `json:"common_name"`
@@ -64,6 +85,7 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 	return &registry.ProviderCapabilities{
 		SupportsVerification: true,
 		SupportsRollback:     true,
+		SupportsRemoval:      true,
 		RequiredConfigFields: []string{"url"},
 		RequiredCredFields:   []string{},
 	}
@@ -73,18 +95,18 @@ func (p *Provider) GetCapabilities() *registry.ProviderCapabilities {
 func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config map[string]any) error {
 	url, ok := config["url"].(string)
 	if !ok || url == "" {
-		return fmt.Errorf("url is required")
+		return registry.Errorf(registry.CategoryValidation, "url is required")
 	}
 
 	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
-		return fmt.Errorf("url must start with http:// or https://")
+		return registry.Errorf(registry.CategoryValidation, "url must start with http:// or https://")
 	}
 
 	// Optionally test the endpoint with a HEAD request
 	client := p.createHTTPClient(config)
 	req, err := http.NewRequestWithContext(ctx, "HEAD", url, nil)
 	if err != nil {
-		return fmt.Errorf("invalid URL: %w", err)
+		return registry.Wrap(registry.CategoryValidation, err, "invalid URL")
 	}
 
 	p.addHeaders(req, credentials, config)
@@ -102,7 +124,7 @@ func (p *Provider) ValidateCredentials(ctx context.Context, credentials, config
 	}
 
 	if resp.StatusCode == 401 || resp.StatusCode == 403 {
-		return fmt.Errorf("authentication failed (HTTP %d)", resp.StatusCode)
+		return registry.HTTPError(resp.StatusCode, resp.Header, "authentication failed (HTTP %d)", resp.StatusCode)
 	}
 
 	return nil
@@ -114,7 +136,7 @@ func (p *Provider) Deploy(ctx context.Context, cert *registry.CertificateData, c
 
 	url, ok := config["url"].(string)
 	if !ok || url == "" {
-		return nil, fmt.Errorf("url is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "url is required in config")
 	}
 
 	progressCb(10, "Preparing webhook payload")
@@ -182,7 +204,7 @@ func (p *Provider) Verify(ctx context.Context, cert *registry.CertificateData, c
 	} else if mainURL, ok := config["url"].(string); ok {
 		url = mainURL
 	} else {
-		return nil, fmt.Errorf("url is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "url is required in config")
 	}
 
 	payload := WebhookPayload{
@@ -222,7 +244,7 @@ func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData,
 	} else if mainURL, ok := config["url"].(string); ok {
 		url = mainURL
 	} else {
-		return nil, fmt.Errorf("url is required in config")
+		return nil, registry.Errorf(registry.CategoryValidation, "url is required in config")
 	}
 
 	payload := WebhookPayload{
@@ -251,6 +273,55 @@ func (p *Provider) Rollback(ctx context.Context, cert *registry.CertificateData,
 	}, nil
 }
 
+// Remove sends a remove request to the webhook endpoint
+func (p *Provider) Remove(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) (*registry.DeploymentResult, error) {
+	startTime := time.Now()
+
+	// Use remove_url if configured, otherwise use main url
+	url := ""
+	if removeURL, ok := config["remove_url"].(string); ok && removeURL != "" {
+		url = removeURL
+	} else if mainURL, ok := config["url"].(string); ok {
+		url = mainURL
+	} else {
+		return nil, registry.Errorf(registry.CategoryValidation, "url is required in config")
+	}
+
+	payload := WebhookPayload{
+		Action:        "remove",
+		CertificateID: cert.ID,
+		SerialNumber:  cert.SerialNumber,
+		CommonName:    cert.CommonName,
+		SANs:          cert.SANs,
+	}
+
+	result, err := p.sendWebhook(ctx, url, payload, config, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("webhook request failed: %w", err)
+	}
+
+	return &registry.DeploymentResult{
+		Success:    result.Success,
+		Message:    result.Message,
+		ResourceID: result.ResourceID,
+		Details:    result.Details,
+		DurationMs: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// Plan reports the deploy request a deployment sends. Only the endpoint's
+// reachability is checked: what the receiver does with it is unknown.
+func (p *Provider) Plan(ctx context.Context, cert *registry.CertificateData, config, credentials map[string]any) ([]registry.PlannedAction, error) {
+	if err := p.ValidateCredentials(ctx, credentials, config); err != nil {
+		return nil, err
+	}
+
+	return []registry.PlannedAction{{
+		Operation: registry.PlanSend, ResourceType: "deploy request", Resource: config["url"].(string),
+		Detail: "certificate " + cert.CommonName,
+	}}, nil
+}
+
 // sendWebhook sends a webhook request and parses the response
 func (p *Provider) sendWebhook(ctx context.Context, url string, payload WebhookPayload, config, credentials map[string]any) (*WebhookResponse, error) {
 	body, err := json.Marshal(payload)
@@ -289,10 +360,7 @@ func (p *Provider) sendWebhook(ctx context.Context, url string, payload WebhookP
 				Message: fmt.Sprintf("Webhook returned HTTP %d", resp.StatusCode),
 			}, nil
 		}
-		return &WebhookResponse{
-			Success: false,
-			Message: fmt.Sprintf("Webhook returned HTTP %d: %s", resp.StatusCode, string(respBody)),
-		}, nil
+		return nil, registry.HTTPError(resp.StatusCode, resp.Header, "webhook returned HTTP %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	// If success field is not set, infer from HTTP status
@@ -305,10 +373,10 @@ func (p *Provider) sendWebhook(ctx context.Context, url string, payload WebhookP
 			result.Success = true
 		}
 	} else if resp.StatusCode >= 400 {
-		result.Success = false
 		if result.Message == "" {
-			result.Message = fmt.Sprintf("Webhook returned HTTP %d", resp.StatusCode)
+			return nil, registry.HTTPError(resp.StatusCode, resp.Header, "webhook returned HTTP %d", resp.StatusCode)
 		}
+		return nil, registry.HTTPError(resp.StatusCode, resp.Header, "webhook returned HTTP %d: %s", resp.StatusCode, result.Message)
 	}
 
 	return &result, nil
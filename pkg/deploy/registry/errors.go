@@ -0,0 +1,189 @@
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrorCategory classifies a provider failure
+type ErrorCategory string
+
+const (
+	// CategoryUnknown is an unclassified failure; it is retried
+	CategoryUnknown ErrorCategory = "unknown"
+	// CategoryAuth means the credentials were rejected
+	CategoryAuth ErrorCategory = "auth"
+	// CategoryPermission means the credentials lack a required permission
+	CategoryPermission ErrorCategory = "permission"
+	// CategoryNotFound means a resource the deployment depends on does not exist
+	CategoryNotFound ErrorCategory = "not_found"
+	// CategoryValidation means the certificate, config or request was rejected as invalid
+	CategoryValidation ErrorCategory = "validation"
+	// CategoryRateLimited means the target throttled the request
+	CategoryRateLimited ErrorCategory = "rate_limited"
+	// CategoryTransient is a network failure, timeout or server-side error
+	CategoryTransient ErrorCategory = "transient"
+	// CategoryConflict means the target refused the change because of its current state
+	CategoryConflict ErrorCategory = "conflict"
+)
+
+// Categories lists every error category
+var Categories = []ErrorCategory{
+	CategoryUnknown,
+	CategoryAuth,
+	CategoryPermission,
+	CategoryNotFound,
+	CategoryValidation,
+	CategoryRateLimited,
+	CategoryTransient,
+	CategoryConflict,
+}
+
+// Retryable reports whether a failure of this category may succeed when retried
+func (c ErrorCategory) Retryable() bool {
+	switch c {
+	case CategoryAuth, CategoryPermission, CategoryNotFound, CategoryValidation:
+		return false
+	}
+	return true
+}
+
+// Error is a classified provider failure. Providers wrap it with fmt.Errorf
+// and %w freely; Classify finds it anywhere in the chain.
+type Error struct {
+	Category ErrorCategory
+	Message  string
+	// RetryAfter is how long the target asked to wait before retrying (rate limits)
+	RetryAfter time.Duration
+	// StatusCode is the HTTP status the target answered with, if any
+	StatusCode int
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Errorf returns a provider error of the given category
+func Errorf(category ErrorCategory, format string, args ...any) error {
+	return &Error{Category: category, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap classifies err under category, prefixing it with message
+func Wrap(category ErrorCategory, err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Category: category, Message: message, Err: err}
+}
+
+// HTTPError classifies a non-success HTTP response from a target. The header
+// is consulted for Retry-After and may be nil.
+func HTTPError(statusCode int, header http.Header, format string, args ...any) error {
+	e := &Error{
+		Category:   StatusCategory(statusCode),
+		Message:    fmt.Sprintf(format, args...),
+		StatusCode: statusCode,
+	}
+	if header != nil {
+		e.RetryAfter = ParseRetryAfter(header.Get("Retry-After"), time.Now())
+	}
+	return e
+}
+
+// StatusCategory maps an HTTP status code to an error category
+func StatusCategory(statusCode int) ErrorCategory {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return CategoryAuth
+	case statusCode == http.StatusForbidden:
+		return CategoryPermission
+	case statusCode == http.StatusNotFound || statusCode == http.StatusGone:
+		return CategoryNotFound
+	case statusCode == http.StatusConflict || statusCode == http.StatusPreconditionFailed || statusCode == http.StatusLocked:
+		return CategoryConflict
+	case statusCode == http.StatusTooManyRequests:
+		return CategoryRateLimited
+	case statusCode == http.StatusRequestTimeout || statusCode >= 500:
+		return CategoryTransient
+	case statusCode >= 400:
+		return CategoryValidation
+	}
+	return CategoryUnknown
+}
+
+// ParseRetryAfter parses a Retry-After header given in seconds or as an HTTP
+// date. It returns 0 when the header is absent or invalid.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// Classify returns the category of err. Provider errors keep their category;
+// timeouts and network failures are transient, TLS verification and schema
+// validation failures are validation errors, and anything else is unknown.
+func Classify(err error) ErrorCategory {
+	if err == nil {
+		return ""
+	}
+
+	var pe *Error
+	if errors.As(err, &pe) && pe.Category != "" {
+		return pe.Category
+	}
+	var ve ValidationError
+	if errors.As(err, &ve) {
+		return CategoryValidation
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return CategoryTransient
+	}
+	// An untrusted target certificate does not fix itself
+	var tlsErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &tlsErr) || errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) {
+		return CategoryValidation
+	}
+	var ne net.Error
+	if errors.As(err, &ne) {
+		return CategoryTransient
+	}
+	return CategoryUnknown
+}
+
+// RetryAfter returns the delay the target asked for before retrying err, or 0
+func RetryAfter(err error) time.Duration {
+	var pe *Error
+	if errors.As(err, &pe) {
+		return pe.RetryAfter
+	}
+	return 0
+}
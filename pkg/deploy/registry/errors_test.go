@@ -0,0 +1,71 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	header := http.Header{"Retry-After": []string{"120"}}
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		want ErrorCategory
+	}{
+		{"wrapped auth", fmt.Errorf("deploy: %w", Errorf(CategoryAuth, "invalid API token")), CategoryAuth},
+		{"http 403", HTTPError(403, nil, "forbidden"), CategoryPermission},
+		{"http 404", HTTPError(404, nil, "missing"), CategoryNotFound},
+		{"http 409", HTTPError(409, nil, "exists"), CategoryConflict},
+		{"http 422", HTTPError(422, nil, "bad"), CategoryValidation},
+		{"http 429", HTTPError(429, header, "slow down"), CategoryRateLimited},
+		{"http 503", HTTPError(503, nil, "unavailable"), CategoryTransient},
+		{"deadline", fmt.Errorf("request failed: %w", context.DeadlineExceeded), CategoryTransient},
+		{"schema", ValidationError{{Field: "config.url", Message: "is required"}}, CategoryValidation},
+		{"plain", errors.New("boom"), CategoryUnknown},
+	} {
+		if got := Classify(tc.err); got != tc.want {
+			t.Errorf("%s: Classify = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+
+	if Classify(nil) != "" {
+		t.Error("Classify(nil) must be empty")
+	}
+	if got := RetryAfter(fmt.Errorf("x: %w", HTTPError(429, header, "slow down"))); got != 2*time.Minute {
+		t.Errorf("RetryAfter = %v, want 2m", got)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	for _, c := range []ErrorCategory{CategoryAuth, CategoryPermission, CategoryNotFound, CategoryValidation} {
+		if c.Retryable() {
+			t.Errorf("%s must not be retryable", c)
+		}
+	}
+	for _, c := range []ErrorCategory{CategoryRateLimited, CategoryTransient, CategoryConflict, CategoryUnknown} {
+		if !c.Retryable() {
+			t.Errorf("%s must be retryable", c)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if got := ParseRetryAfter("30", now); got != 30*time.Second {
+		t.Errorf("seconds: got %v", got)
+	}
+	if got := ParseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now); got != time.Minute {
+		t.Errorf("date: got %v", got)
+	}
+	for _, v := range []string{"", "-5", "soon", now.Add(-time.Minute).Format(http.TimeFormat)} {
+		if got := ParseRetryAfter(v, now); got != 0 {
+			t.Errorf("ParseRetryAfter(%q) = %v, want 0", v, got)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package registry
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// InventoryCertificate is a certificate object found on a target
+type InventoryCertificate struct {
+	// Name identifies the object on the target
+	Name         string
+	CommonName   string
+	SANs         []string
+	SerialNumber string
+	Issuer       string
+	ExpiresAt    int64 // Unix timestamp, 0 when unknown
+	// References lists the objects on the target using the certificate
+	References []string
+}
+
+// InventoryLister is implemented by providers that can list the certificates
+// on a target
+type InventoryLister interface {
+	// Inventory lists the certificate objects on the target without changing
+	// anything on the target
+	Inventory(ctx context.Context, config, credentials map[string]any) ([]InventoryCertificate, error)
+}
+
+// SupportsInventory reports whether providers of providerType implement
+// InventoryLister
+func SupportsInventory(providerType string) bool {
+	provider, err := Get(providerType)
+	if err != nil {
+		return false
+	}
+	_, ok := provider.(InventoryLister)
+	return ok
+}
+
+// InventoryFromPEM returns the inventory entry named name for the first
+// certificate of certPEM
+func InventoryFromPEM(name, certPEM string) (InventoryCertificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return InventoryCertificate{}, fmt.Errorf("certificate %s is not PEM encoded", name)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return InventoryCertificate{}, fmt.Errorf("parse certificate %s: %w", name, err)
+	}
+	return InventoryCertificate{
+		Name:         name,
+		CommonName:   cert.Subject.CommonName,
+		SANs:         cert.DNSNames,
+		SerialNumber: cert.SerialNumber.Text(16),
+		Issuer:       cert.Issuer.String(),
+		ExpiresAt:    cert.NotAfter.Unix(),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package registry
+
+import (
+	"context"
+	"strings"
+)
+
+// Operations of planned actions
+const (
+	PlanCreate    = "create"
+	PlanUpdate    = "update"
+	PlanReplace   = "replace"
+	PlanDelete    = "delete"
+	PlanSwitch    = "switch"
+	PlanSend      = "send"
+	PlanUnchanged = "unchanged"
+)
+
+// PlannedAction is a change a deployment would make on the target
+type PlannedAction struct {
+	// Operation is one of the Plan* operations
+	Operation string
+	// ResourceType is the kind of object, such as "cert" or "profile"
+	ResourceType string
+	// Resource names the object on the target
+	Resource string
+	// Detail adds context, such as what else uses the object
+	Detail string
+}
+
+// String describes the action, e.g. "create cert /Common/x.crt"
+func (a PlannedAction) String() string {
+	s := strings.Join([]string{a.Operation, a.ResourceType, a.Resource}, " ")
+	if a.Detail != "" {
+		s += " (" + a.Detail + ")"
+	}
+	return s
+}
+
+// Planner is implemented by providers that can preview a deployment
+type Planner interface {
+	// Plan inspects the target and returns the actions Deploy would take for
+	// cert, without changing anything on the target
+	Plan(ctx context.Context, cert *CertificateData, config, credentials map[string]any) ([]PlannedAction, error)
+}
+
+// SupportsPlan reports whether providers of providerType implement Planner
+func SupportsPlan(providerType string) bool {
+	provider, err := Get(providerType)
+	if err != nil {
+		return false
+	}
+	_, ok := provider.(Planner)
+	return ok
+}
@@ -0,0 +1,141 @@
+package registry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy controls how often and how patiently a deployment is retried.
+// Unset fields inherit from the next less specific policy, see Merge.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries *int32 `json:"max_retries,omitempty"`
+	// InitialDelaySeconds is the delay before the first retry
+	InitialDelaySeconds *int32 `json:"initial_delay_seconds,omitempty"`
+	// BackoffMultiplier grows the delay after every retry
+	BackoffMultiplier *float64 `json:"backoff_multiplier,omitempty"`
+	// Jitter randomizes each delay by up to this fraction (0-1) in either direction
+	Jitter *float64 `json:"jitter,omitempty"`
+	// MaxDelaySeconds caps the delay between retries (0 means no cap)
+	MaxDelaySeconds *int32 `json:"max_delay_seconds,omitempty"`
+	// AttemptTimeoutSeconds bounds a single deployment attempt
+	AttemptTimeoutSeconds *int32 `json:"attempt_timeout_seconds,omitempty"`
+}
+
+// Built-in policy values used when no layer sets a field
+const (
+	DefaultMaxRetries            int32   = 3
+	DefaultInitialDelaySeconds   int32   = 60
+	DefaultBackoffMultiplier     float64 = 2.0
+	DefaultAttemptTimeoutSeconds int32   = 300
+)
+
+// DefaultRetryPolicy returns the built-in policy with every field set
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:            ptr(DefaultMaxRetries),
+		InitialDelaySeconds:   ptr(DefaultInitialDelaySeconds),
+		BackoffMultiplier:     ptr(DefaultBackoffMultiplier),
+		Jitter:                ptr(0.0),
+		MaxDelaySeconds:       ptr(int32(0)),
+		AttemptTimeoutSeconds: ptr(DefaultAttemptTimeoutSeconds),
+	}
+}
+
+// IsZero reports whether the policy sets no field
+func (p *RetryPolicy) IsZero() bool {
+	return p == nil || *p == RetryPolicy{}
+}
+
+// Merge returns a copy of p with the fields set in each override applied in
+// order, so later overrides win. p itself is not modified.
+func (p *RetryPolicy) Merge(overrides ...*RetryPolicy) *RetryPolicy {
+	merged := &RetryPolicy{}
+	if p != nil {
+		*merged = *p
+	}
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+		if o.MaxRetries != nil {
+			merged.MaxRetries = ptr(*o.MaxRetries)
+		}
+		if o.InitialDelaySeconds != nil {
+			merged.InitialDelaySeconds = ptr(*o.InitialDelaySeconds)
+		}
+		if o.BackoffMultiplier != nil {
+			merged.BackoffMultiplier = ptr(*o.BackoffMultiplier)
+		}
+		if o.Jitter != nil {
+			merged.Jitter = ptr(*o.Jitter)
+		}
+		if o.MaxDelaySeconds != nil {
+			merged.MaxDelaySeconds = ptr(*o.MaxDelaySeconds)
+		}
+		if o.AttemptTimeoutSeconds != nil {
+			merged.AttemptTimeoutSeconds = ptr(*o.AttemptTimeoutSeconds)
+		}
+	}
+	return merged
+}
+
+// Retries returns the maximum number of retries
+func (p *RetryPolicy) Retries() int32 {
+	if p == nil || p.MaxRetries == nil || *p.MaxRetries < 0 {
+		return DefaultMaxRetries
+	}
+	return *p.MaxRetries
+}
+
+// AttemptTimeout returns the time a single attempt may take
+func (p *RetryPolicy) AttemptTimeout() time.Duration {
+	if p == nil || p.AttemptTimeoutSeconds == nil || *p.AttemptTimeoutSeconds <= 0 {
+		return time.Duration(DefaultAttemptTimeoutSeconds) * time.Second
+	}
+	return time.Duration(*p.AttemptTimeoutSeconds) * time.Second
+}
+
+// Delay returns the backoff before the retry that follows retryCount earlier
+// retries, with jitter applied and capped at MaxDelaySeconds
+func (p *RetryPolicy) Delay(retryCount int32) time.Duration {
+	return p.delay(retryCount, rand.Float64())
+}
+
+// delay computes the backoff for a random value r in [0, 1)
+func (p *RetryPolicy) delay(retryCount int32, r float64) time.Duration {
+	initial := DefaultInitialDelaySeconds
+	multiplier := DefaultBackoffMultiplier
+	var jitter float64
+	var maxDelay int32
+	if p != nil {
+		if p.InitialDelaySeconds != nil && *p.InitialDelaySeconds >= 0 {
+			initial = *p.InitialDelaySeconds
+		}
+		if p.BackoffMultiplier != nil && *p.BackoffMultiplier >= 1 {
+			multiplier = *p.BackoffMultiplier
+		}
+		if p.Jitter != nil {
+			jitter = min(max(*p.Jitter, 0), 1)
+		}
+		if p.MaxDelaySeconds != nil {
+			maxDelay = *p.MaxDelaySeconds
+		}
+	}
+
+	seconds := float64(initial) * math.Pow(multiplier, float64(max(retryCount, 0)))
+	seconds *= 1 + jitter*(2*r-1)
+	if maxDelay > 0 {
+		seconds = min(seconds, float64(maxDelay))
+	}
+	// Saturate instead of overflowing time.Duration on runaway backoff
+	if seconds >= float64(math.MaxInt64/int64(time.Second)) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,70 @@
+package registry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyMerge(t *testing.T) {
+	retries := int32(7)
+	timeout := int32(30)
+	zero := int32(0)
+
+	base := DefaultRetryPolicy()
+	merged := base.Merge(
+		&RetryPolicy{MaxRetries: &retries},
+		nil,
+		&RetryPolicy{AttemptTimeoutSeconds: &timeout},
+		&RetryPolicy{MaxRetries: &zero},
+	)
+
+	if got := merged.Retries(); got != 0 {
+		t.Errorf("Retries = %d, want 0 from the last override", got)
+	}
+	if got := merged.AttemptTimeout(); got != 30*time.Second {
+		t.Errorf("AttemptTimeout = %v, want 30s", got)
+	}
+	if got := *merged.InitialDelaySeconds; got != DefaultInitialDelaySeconds {
+		t.Errorf("InitialDelaySeconds = %d, want inherited %d", got, DefaultInitialDelaySeconds)
+	}
+	if *base.MaxRetries != DefaultMaxRetries {
+		t.Error("Merge must not modify the receiver")
+	}
+
+	if !(&RetryPolicy{}).IsZero() || merged.IsZero() {
+		t.Error("IsZero mismatch")
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	initial := int32(10)
+	multiplier := 3.0
+	maxDelay := int32(200)
+	jitter := 0.5
+	p := &RetryPolicy{InitialDelaySeconds: &initial, BackoffMultiplier: &multiplier, MaxDelaySeconds: &maxDelay}
+
+	for retry, want := range []time.Duration{10 * time.Second, 30 * time.Second, 90 * time.Second, 200 * time.Second, 200 * time.Second} {
+		if got := p.Delay(int32(retry)); got != want {
+			t.Errorf("Delay(%d) = %v, want %v", retry, got, want)
+		}
+	}
+
+	p.Jitter = &jitter
+	if got := p.delay(1, 0); got != 15*time.Second {
+		t.Errorf("lowest jitter: got %v, want 15s", got)
+	}
+	if got := p.delay(1, 0.5); got != 30*time.Second {
+		t.Errorf("neutral jitter: got %v, want 30s", got)
+	}
+	if got := p.delay(3, 0.99); got != 200*time.Second {
+		t.Errorf("jitter must stay capped: got %v", got)
+	}
+
+	var unset *RetryPolicy
+	if got := unset.Delay(1); got != 120*time.Second {
+		t.Errorf("default Delay(1) = %v, want 120s", got)
+	}
+	if got := unset.Delay(1000); got <= 0 {
+		t.Errorf("runaway backoff must not overflow: got %v", got)
+	}
+}
@@ -19,6 +19,7 @@ type DeploymentResult struct {
 type ProviderCapabilities struct {
 	SupportsVerification bool
 	SupportsRollback     bool
+	SupportsRemoval      bool
 	RequiredConfigFields []string
 	RequiredCredFields   []string
 }
@@ -37,6 +38,10 @@ type Provider interface {
 	// Rollback rolls back a deployment
 	Rollback(ctx context.Context, cert *CertificateData, config, credentials map[string]any) (*DeploymentResult, error)
 
+	// Remove removes a deployed certificate from the target. Objects still in
+	// use on the target are left in place and reported as a validation error.
+	Remove(ctx context.Context, cert *CertificateData, config, credentials map[string]any) (*DeploymentResult, error)
+
 	// ValidateCredentials validates provider credentials with optional config context
 	ValidateCredentials(ctx context.Context, credentials, config map[string]any) error
 
@@ -62,6 +67,13 @@ type ProviderInfo struct {
 	DisplayName string
 	Description string
 	Caps        *ProviderCapabilities
+	// ConfigSchema describes TargetConfiguration.config; nil accepts any config
+	ConfigSchema *Schema
+	// CredentialSchema describes the credentials; nil accepts any credentials
+	CredentialSchema *Schema
+	// HostField names the credential field holding the device address, used to
+	// serialize deployments to the same device; empty when not applicable
+	HostField string
 }
 
 // ProviderFactory is a function that creates a new provider instance
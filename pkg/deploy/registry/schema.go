@@ -0,0 +1,301 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Schema is the subset of JSON Schema providers use to describe their config
+// and credentials. It marshals to a standard JSON Schema document.
+type Schema struct {
+	Type        string             `json:"type,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	// AdditionalProperties validates keys not listed in Properties; use
+	// NoAdditionalProperties to reject them
+	AdditionalProperties *Schema `json:"additionalProperties,omitempty"`
+	Items                *Schema `json:"items,omitempty"`
+	Enum                 []any   `json:"enum,omitempty"`
+	// Default is applied when the property is absent
+	Default   any      `json:"default,omitempty"`
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Format    string   `json:"format,omitempty"` // "uri" or "hostname"
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+	MinItems  *int     `json:"minItems,omitempty"`
+	// WriteOnly marks secrets that are never returned
+	WriteOnly bool `json:"writeOnly,omitempty"`
+
+	never bool
+}
+
+// NoAdditionalProperties rejects object keys not listed in Properties
+var NoAdditionalProperties = &Schema{never: true}
+
+// MarshalJSON encodes the schema; NoAdditionalProperties encodes as false
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.never {
+		return []byte("false"), nil
+	}
+	type plain Schema
+	return json.Marshal((*plain)(s))
+}
+
+// Object returns an object schema with the given properties
+func Object(properties map[string]*Schema, required ...string) *Schema {
+	return &Schema{
+		Type:                 "object",
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: NoAdditionalProperties,
+	}
+}
+
+// String returns a string schema
+func String(description string) *Schema {
+	return &Schema{Type: "string", Description: description}
+}
+
+// Secret returns a write-only, non-empty string schema
+func Secret(description string) *Schema {
+	return &Schema{Type: "string", Description: description, MinLength: Int(1), WriteOnly: true}
+}
+
+// Integer returns an integer schema bounded by min and max
+func Integer(description string, min, max float64) *Schema {
+	return &Schema{Type: "integer", Description: description, Minimum: &min, Maximum: &max}
+}
+
+// Boolean returns a boolean schema
+func Boolean(description string) *Schema {
+	return &Schema{Type: "boolean", Description: description}
+}
+
+// StringMap returns a schema for an object of string values
+func StringMap(description string) *Schema {
+	return &Schema{Type: "object", Description: description, AdditionalProperties: &Schema{Type: "string"}}
+}
+
+// WithDefault sets the default value of s and returns it
+func (s *Schema) WithDefault(v any) *Schema {
+	s.Default = v
+	return s
+}
+
+// Int returns a pointer to n, for MinLength and similar fields
+func Int(n int) *int {
+	return &n
+}
+
+// FieldError is a validation failure of a single field
+type FieldError struct {
+	// Field is the dotted path of the field, prefixed with the validated document (e.g. "config.timeout_seconds")
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError lists every field that failed validation, sorted by field
+type ValidationError []FieldError
+
+func (e ValidationError) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Apply validates doc against the object schema s and returns a copy of doc
+// with defaults filled in. root prefixes field paths in errors. A nil schema
+// accepts any document unchanged.
+func (s *Schema) Apply(root string, doc map[string]any) (map[string]any, error) {
+	if s == nil {
+		return doc, nil
+	}
+	if doc == nil {
+		doc = map[string]any{}
+	}
+
+	var errs ValidationError
+	out := s.apply(root, doc, &errs)
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Field < errs[j].Field })
+		return nil, errs
+	}
+	return out.(map[string]any), nil
+}
+
+func (s *Schema) apply(path string, v any, errs *ValidationError) any {
+	fail := func(format string, args ...any) any {
+		*errs = append(*errs, FieldError{Field: path, Message: fmt.Sprintf(format, args...)})
+		return v
+	}
+
+	if s.never {
+		return fail("unknown field")
+	}
+
+	switch s.Type {
+	case "object":
+		m, ok := v.(map[string]any)
+		if !ok {
+			return fail("must be an object")
+		}
+		return s.applyObject(path, m, errs)
+
+	case "array":
+		list, ok := v.([]any)
+		if !ok {
+			return fail("must be an array")
+		}
+		if s.MinItems != nil && len(list) < *s.MinItems {
+			if *s.MinItems == 1 {
+				return fail("must not be empty")
+			}
+			return fail("must have at least %d items", *s.MinItems)
+		}
+		out := make([]any, len(list))
+		for i, item := range list {
+			out[i] = item
+			if s.Items != nil {
+				out[i] = s.Items.apply(fmt.Sprintf("%s[%d]", path, i), item, errs)
+			}
+		}
+		return out
+
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fail("must be a string")
+		}
+		if s.MinLength != nil && len(str) < *s.MinLength {
+			if *s.MinLength == 1 {
+				return fail("must not be empty")
+			}
+			return fail("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && len(str) > *s.MaxLength {
+			return fail("must be at most %d characters", *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			if re, err := regexp.Compile(s.Pattern); err == nil && !re.MatchString(str) {
+				return fail("must match %s", s.Pattern)
+			}
+		}
+		switch s.Format {
+		case "uri":
+			if u, err := url.Parse(str); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fail("must be an http:// or https:// URL")
+			}
+		case "hostname":
+			if str == "" || strings.ContainsAny(str, "/ ") || strings.Contains(str, "://") {
+				return fail("must be a host name or address, optionally with a port")
+			}
+		}
+
+	case "integer", "number":
+		n, ok := toFloat(v)
+		if !ok {
+			return fail("must be a number")
+		}
+		if s.Type == "integer" && n != math.Trunc(n) {
+			return fail("must be an integer")
+		}
+		if s.Minimum != nil && n < *s.Minimum {
+			return fail("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			return fail("must be at most %v", *s.Maximum)
+		}
+
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fail("must be a boolean")
+		}
+	}
+
+	if len(s.Enum) > 0 {
+		for _, e := range s.Enum {
+			if reflect.DeepEqual(e, v) {
+				return v
+			}
+		}
+		return fail("must be one of %v", s.Enum)
+	}
+	return v
+}
+
+func (s *Schema) applyObject(path string, m map[string]any, errs *ValidationError) map[string]any {
+	out := make(map[string]any, len(m)+len(s.Properties))
+
+	for _, name := range s.Required {
+		if v, ok := m[name]; !ok || v == nil {
+			*errs = append(*errs, FieldError{Field: join(path, name), Message: "is required"})
+		}
+	}
+
+	for name, v := range m {
+		if v == nil {
+			continue
+		}
+		if prop, ok := s.Properties[name]; ok {
+			out[name] = prop.apply(join(path, name), v, errs)
+		} else if s.AdditionalProperties != nil {
+			out[name] = s.AdditionalProperties.apply(join(path, name), v, errs)
+		} else {
+			out[name] = v
+		}
+	}
+
+	for name, prop := range s.Properties {
+		if _, ok := out[name]; !ok && prop.Default != nil {
+			out[name] = prop.Default
+		}
+	}
+
+	return out
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,102 @@
+package registry
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func testSchema() *Schema {
+	return Object(map[string]*Schema{
+		"url":             {Type: "string", Format: "uri"},
+		"host":            {Type: "string", Format: "hostname"},
+		"timeout_seconds": Integer("timeout", 1, 600).WithDefault(float64(60)),
+		"skip_tls_verify": Boolean("skip").WithDefault(false),
+		"headers":         StringMap("headers"),
+		"mode":            {Type: "string", Enum: []any{"a", "b"}},
+		"ids":             {Type: "array", Items: &Schema{Type: "string"}, MinItems: Int(1)},
+	}, "url")
+}
+
+func TestSchemaApplyDefaults(t *testing.T) {
+	doc := map[string]any{"url": "https://example.com/hook", "timeout_seconds": float64(5)}
+
+	out, err := testSchema().Apply("config", doc)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if out["timeout_seconds"] != float64(5) {
+		t.Errorf("timeout_seconds = %v, want the given 5", out["timeout_seconds"])
+	}
+	if out["skip_tls_verify"] != false {
+		t.Errorf("skip_tls_verify = %v, want default false", out["skip_tls_verify"])
+	}
+	if _, ok := doc["skip_tls_verify"]; ok {
+		t.Error("Apply must not modify its input")
+	}
+}
+
+func TestSchemaApplyFieldErrors(t *testing.T) {
+	doc := map[string]any{
+		"host":            float64(10),
+		"timeout_seconds": 1.5,
+		"headers":         map[string]any{"X-Ok": "1", "X-Bad": true},
+		"mode":            "c",
+		"ids":             []any{},
+		"extra":           "x",
+	}
+
+	_, err := testSchema().Apply("config", doc)
+	verr, ok := err.(ValidationError)
+	if !ok {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+
+	want := map[string]string{
+		"config.url":             "is required",
+		"config.host":            "must be a string",
+		"config.timeout_seconds": "must be an integer",
+		"config.headers.X-Bad":   "must be a string",
+		"config.mode":            "must be one of",
+		"config.ids":             "must not be empty",
+		"config.extra":           "unknown field",
+	}
+	if len(verr) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(verr), len(want), verr)
+	}
+	for _, fe := range verr {
+		if msg, ok := want[fe.Field]; !ok || !strings.HasPrefix(fe.Message, msg) {
+			t.Errorf("unexpected error %q", fe.Error())
+		}
+	}
+}
+
+func TestSchemaFormats(t *testing.T) {
+	s := testSchema()
+	for _, tc := range []struct {
+		doc map[string]any
+		ok  bool
+	}{
+		{map[string]any{"url": "ftp://example.com"}, false},
+		{map[string]any{"url": "https://"}, false},
+		{map[string]any{"url": "http://example.com", "host": "10.0.0.1:8443"}, true},
+		{map[string]any{"url": "http://example.com", "host": "https://bigip.local"}, false},
+	} {
+		if _, err := s.Apply("config", tc.doc); (err == nil) != tc.ok {
+			t.Errorf("Apply(%v) error = %v, want ok=%v", tc.doc, err, tc.ok)
+		}
+	}
+}
+
+func TestSchemaMarshal(t *testing.T) {
+	raw, err := json.Marshal(Object(map[string]*Schema{"token": Secret("token")}, "token"))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	got := string(raw)
+	for _, want := range []string{`"additionalProperties":false`, `"writeOnly":true`, `"required":["token"]`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("schema %s does not contain %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package registry
+
+import "strings"
+
+// NormalizeSerial makes hex serials comparable regardless of separators, case
+// and leading zeros
+func NormalizeSerial(serial string) string {
+	serial = strings.ToLower(strings.NewReplacer(":", "", "-", "", " ", "").Replace(serial))
+	serial = strings.TrimLeft(serial, "0")
+	if serial == "" {
+		return "0"
+	}
+	return serial
+}
+
+// SerialMismatch reports whether the serial a target reports differs from the
+// expected one. It is false when either is unknown.
+func SerialMismatch(expected, observed string) bool {
+	return expected != "" && observed != "" && NormalizeSerial(expected) != NormalizeSerial(observed)
+}
@@ -0,0 +1,57 @@
+package registry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// DefaultRetainVersions is the number of certificate versions kept on a
+// device in versioned-object mode, including the deployed one
+const DefaultRetainVersions = 3
+
+// versionLength is the length of the version suffix of object names
+const versionLength = 8
+
+// Versioning returns whether config asks for versioned objects, named after
+// the certificate version so each deployment creates new ones, and how many
+// versions to keep ("versioned_objects" and "retain_versions")
+func Versioning(config map[string]any) (bool, int) {
+	enabled, _ := config["versioned_objects"].(bool)
+	retain := DefaultRetainVersions
+	if v, ok := toFloat(config["retain_versions"]); ok && v >= 1 {
+		retain = int(v)
+	}
+	return enabled, retain
+}
+
+// ObjectVersion returns the version suffix of cert's objects: the last eight
+// hex digits of its serial, or of its PEM's SHA-256 when the serial is
+// unknown. It is empty when neither is known.
+func ObjectVersion(cert *CertificateData) string {
+	serial := strings.ToLower(strings.NewReplacer(":", "", "-", "", " ", "").Replace(cert.SerialNumber))
+	if serial == "" && cert.CertificatePEM != "" {
+		sum := sha256.Sum256([]byte(cert.CertificatePEM))
+		serial = hex.EncodeToString(sum[:])
+	}
+	if serial == "" {
+		return ""
+	}
+	if len(serial) > versionLength {
+		return serial[len(serial)-versionLength:]
+	}
+	return strings.Repeat("0", versionLength-len(serial)) + serial
+}
+
+// IsObjectVersion reports whether s has the form of an ObjectVersion
+func IsObjectVersion(s string) bool {
+	if len(s) != versionLength {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
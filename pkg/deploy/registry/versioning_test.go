@@ -0,0 +1,38 @@
+package registry
+
+import "testing"
+
+func TestObjectVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		cert *CertificateData
+		want string
+	}{
+		{"long serial keeps the last digits", &CertificateData{SerialNumber: "4F:2A:91:0C:DE:AD:BE:EF"}, "deadbeef"},
+		{"short serial is padded", &CertificateData{SerialNumber: "1a2b"}, "00001a2b"},
+		{"unknown serial uses the PEM", &CertificateData{CertificatePEM: "pem"}, "4c3005eb"},
+		{"nothing known", &CertificateData{}, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ObjectVersion(tc.cert)
+			if got != tc.want {
+				t.Fatalf("ObjectVersion = %q, want %q", got, tc.want)
+			}
+			if got != "" && !IsObjectVersion(got) {
+				t.Fatalf("IsObjectVersion(%q) = false", got)
+			}
+		})
+	}
+
+	if IsObjectVersion("com") || IsObjectVersion("cafebabz") {
+		t.Fatal("IsObjectVersion accepted a name that is no version")
+	}
+
+	if versioned, retain := Versioning(map[string]any{"versioned_objects": true, "retain_versions": float64(5)}); !versioned || retain != 5 {
+		t.Fatalf("Versioning = %v, %d", versioned, retain)
+	}
+	if versioned, retain := Versioning(nil); versioned || retain != DefaultRetainVersions {
+		t.Fatalf("Versioning of empty config = %v, %d", versioned, retain)
+	}
+}